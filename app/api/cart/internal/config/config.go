@@ -6,6 +6,7 @@ package config
 import (
     commoncfg "NatsumeAI/app/common/config"
     "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/go-zero/core/stores/redis"
     "github.com/zeromicro/go-zero/rest"
     "github.com/zeromicro/go-zero/zrpc"
     "github.com/zeromicro/zero-contrib/zrpc/registry/consul"
@@ -22,4 +23,13 @@ type Config struct {
     LogConf logx.LogConf
 
     CasbinMiddleware commoncfg.CasbinMiddlewareConf
+
+    GuestLimit GuestLimitConf
+}
+
+// GuestLimitConf 游客购物车接口无需登录，每个 IP 每 Period 秒最多 Quota 次请求
+type GuestLimitConf struct {
+    Redis  redis.RedisConf
+    Period int `json:",default=60"`
+    Quota  int `json:",default=120"`
 }
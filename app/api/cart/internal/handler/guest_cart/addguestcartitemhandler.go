@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package guest_cart
+
+import (
+	"net/http"
+
+	"NatsumeAI/app/api/cart/internal/logic/guest_cart"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func AddGuestCartItemHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AddGuestCartItemRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := guest_cart.NewAddGuestCartItemLogic(r.Context(), svcCtx)
+		resp, err := l.AddGuestCartItem(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
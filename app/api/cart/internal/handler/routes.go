@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	cart "NatsumeAI/app/api/cart/internal/handler/cart"
+	guest_cart "NatsumeAI/app/api/cart/internal/handler/guest_cart"
 	"NatsumeAI/app/api/cart/internal/svc"
 
 	"github.com/zeromicro/go-zero/rest"
@@ -37,6 +38,74 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/v1/cart/:productId",
 					Handler: cart.DeleteCartItemHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/cart/batch-delete",
+					Handler: cart.BatchDeleteCartItemsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/v1/cart/saved",
+					Handler: cart.SaveForLaterHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/v1/cart/selection",
+					Handler: cart.SelectCartItemsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/cart/view",
+					Handler: cart.GetCartViewHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.GuestLimitMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/cart/guest",
+					Handler: guest_cart.GetGuestCartItemsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/cart/guest",
+					Handler: guest_cart.AddGuestCartItemHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/v1/cart/guest/:productId",
+					Handler: guest_cart.UpdateGuestCartItemHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/v1/cart/guest/:productId",
+					Handler: guest_cart.DeleteGuestCartItemHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/cart/guest/batch-delete",
+					Handler: guest_cart.BatchDeleteGuestCartItemsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/v1/cart/guest/saved",
+					Handler: guest_cart.SaveGuestForLaterHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/v1/cart/guest/selection",
+					Handler: guest_cart.SelectGuestCartItemsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/cart/guest/view",
+					Handler: guest_cart.GetGuestCartViewHandler(serverCtx),
+				},
 			}...,
 		),
 	)
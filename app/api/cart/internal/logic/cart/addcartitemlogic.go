@@ -58,6 +58,7 @@ func (l *AddCartItemLogic) AddCartItem(req *types.AddCartItemRequest) (resp *typ
 	resp = &types.CartActionResponse{
 		StatusCode: res.StatusCode,
 		StatusMsg:  res.StatusMsg,
+		Quantity:   res.Quantity,
 	}
 
 	return
@@ -0,0 +1,57 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/util"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type BatchDeleteCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBatchDeleteCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteCartItemsLogic {
+	return &BatchDeleteCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BatchDeleteCartItemsLogic) BatchDeleteCartItems(req *types.BatchDeleteCartItemsRequest) (resp *types.CartBatchResponse, err error) {
+	if req == nil || len(req.ProductIds) == 0 {
+		return nil, errors.New(int(errno.InvalidParam), "invalid cart payload")
+	}
+
+	userID, err := util.UserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := l.svcCtx.CartRpc.BatchDeleteCartItems(l.ctx, &cartsvc.BatchDeleteCartItemsReq{
+		UserId:     userID,
+		ProductIds: req.ProductIds,
+	})
+	if err != nil {
+		l.Logger.Error("logic: batch delete cart items rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty batch delete cart items response")
+	}
+
+	return helper.ToCartBatch(res), nil
+}
@@ -57,6 +57,7 @@ func (l *DeleteCartItemLogic) DeleteCartItem(req *types.DeleteCartItemRequest) (
 	resp = &types.CartActionResponse{
 		StatusCode: res.StatusCode,
 		StatusMsg:  res.StatusMsg,
+		Quantity:   res.Quantity,
 	}
 
 	return
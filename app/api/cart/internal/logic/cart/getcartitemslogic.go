@@ -6,6 +6,7 @@ package cart
 import (
 	"context"
 
+	"NatsumeAI/app/api/cart/internal/logic/helper"
 	"NatsumeAI/app/api/cart/internal/svc"
 	"NatsumeAI/app/api/cart/internal/types"
 	"NatsumeAI/app/common/consts/errno"
@@ -37,7 +38,8 @@ func (l *GetCartItemsLogic) GetCartItems(req *types.GetCartItemsRequest) (resp *
 	}
 
 	in := &cartsvc.GetCartItemListReq{
-		UserId: userID,
+		UserId:       userID,
+		SelectedOnly: req.SelectedOnly,
 	}
 
 	res, err := l.svcCtx.CartRpc.GetCartItemList(l.ctx, in)
@@ -49,25 +51,5 @@ func (l *GetCartItemsLogic) GetCartItems(req *types.GetCartItemsRequest) (resp *
 		return nil, errors.New(int(errno.InternalError), "empty get cart response")
 	}
 
-	items := make([]types.CartItem, 0, len(res.Data))
-	for _, item := range res.Data {
-		if item == nil {
-			continue
-		}
-		items = append(items, types.CartItem{
-			Id:        item.GetId(),
-			UserId:    item.GetUserId(),
-			ProductId: item.GetProductId(),
-			Quantity:  item.GetQuantity(),
-		})
-	}
-
-	resp = &types.GetCartItemsResponse{
-		StatusCode: res.StatusCode,
-		StatusMsg:  res.StatusMsg,
-		Total:      res.Total,
-		Items:      items,
-	}
-
-	return
+	return helper.ToCartItems(res), nil
 }
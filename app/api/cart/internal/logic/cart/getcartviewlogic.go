@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/util"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type GetCartViewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetCartViewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCartViewLogic {
+	return &GetCartViewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetCartViewLogic) GetCartView(req *types.GetCartViewRequest) (resp *types.GetCartViewResponse, err error) {
+	userID, err := util.UserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := l.svcCtx.CartRpc.GetCartView(l.ctx, &cartsvc.GetCartViewReq{
+		UserId: userID,
+	})
+	if err != nil {
+		l.Logger.Error("logic: get cart view rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty get cart view response")
+	}
+
+	return helper.ToCartView(res), nil
+}
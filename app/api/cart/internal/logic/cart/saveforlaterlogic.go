@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/util"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type SaveForLaterLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSaveForLaterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveForLaterLogic {
+	return &SaveForLaterLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SaveForLaterLogic) SaveForLater(req *types.SaveForLaterRequest) (resp *types.CartBatchResponse, err error) {
+	if req == nil || len(req.ProductIds) == 0 {
+		return nil, errors.New(int(errno.InvalidParam), "invalid cart payload")
+	}
+
+	userID, err := util.UserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := l.svcCtx.CartRpc.SaveForLater(l.ctx, &cartsvc.SaveForLaterReq{
+		UserId:     userID,
+		ProductIds: req.ProductIds,
+		Saved:      req.Saved,
+	})
+	if err != nil {
+		l.Logger.Error("logic: save for later rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty save for later response")
+	}
+
+	return helper.ToCartBatch(res), nil
+}
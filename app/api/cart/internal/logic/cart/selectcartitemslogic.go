@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/util"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type SelectCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSelectCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectCartItemsLogic {
+	return &SelectCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SelectCartItemsLogic) SelectCartItems(req *types.SelectCartItemsRequest) (resp *types.CartBatchResponse, err error) {
+	if req == nil {
+		return nil, errors.New(int(errno.InvalidParam), "invalid cart payload")
+	}
+
+	userID, err := util.UserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := l.svcCtx.CartRpc.SelectCartItems(l.ctx, &cartsvc.SelectCartItemsReq{
+		UserId:     userID,
+		ProductIds: req.ProductIds,
+		Selected:   req.Selected,
+	})
+	if err != nil {
+		l.Logger.Error("logic: select cart items rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty select cart items response")
+	}
+
+	return helper.ToCartBatch(res), nil
+}
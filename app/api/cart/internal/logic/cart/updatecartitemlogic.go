@@ -58,6 +58,7 @@ func (l *UpdateCartItemLogic) UpdateCartItem(req *types.UpdateCartItemRequest) (
 	resp = &types.CartActionResponse{
 		StatusCode: res.StatusCode,
 		StatusMsg:  res.StatusMsg,
+		Quantity:   res.Quantity,
 	}
 
 	return
@@ -0,0 +1,57 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package guest_cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type AddGuestCartItemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAddGuestCartItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddGuestCartItemLogic {
+	return &AddGuestCartItemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AddGuestCartItemLogic) AddGuestCartItem(req *types.AddGuestCartItemRequest) (resp *types.CartActionResponse, err error) {
+	if req == nil || req.GuestToken == "" || req.ProductId <= 0 || req.Quantity <= 0 {
+		return nil, errors.New(int(errno.InvalidParam), "invalid cart payload")
+	}
+
+	res, err := l.svcCtx.CartRpc.AddCartItem(l.ctx, &cartsvc.CreateCartItemReq{
+		GuestToken: req.GuestToken,
+		ProductId:  req.ProductId,
+		Count:      req.Quantity,
+	})
+	if err != nil {
+		l.Logger.Error("logic: add guest cart item rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty add guest cart response")
+	}
+
+	resp = &types.CartActionResponse{
+		StatusCode: res.StatusCode,
+		StatusMsg:  res.StatusMsg,
+		Quantity:   res.Quantity,
+	}
+
+	return
+}
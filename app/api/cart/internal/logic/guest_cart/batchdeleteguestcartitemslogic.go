@@ -0,0 +1,51 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package guest_cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type BatchDeleteGuestCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBatchDeleteGuestCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteGuestCartItemsLogic {
+	return &BatchDeleteGuestCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BatchDeleteGuestCartItemsLogic) BatchDeleteGuestCartItems(req *types.BatchDeleteGuestCartItemsRequest) (resp *types.CartBatchResponse, err error) {
+	if req == nil || req.GuestToken == "" || len(req.ProductIds) == 0 {
+		return nil, errors.New(int(errno.InvalidParam), "invalid cart payload")
+	}
+
+	res, err := l.svcCtx.CartRpc.BatchDeleteCartItems(l.ctx, &cartsvc.BatchDeleteCartItemsReq{
+		GuestToken: req.GuestToken,
+		ProductIds: req.ProductIds,
+	})
+	if err != nil {
+		l.Logger.Error("logic: batch delete guest cart items rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty batch delete guest cart items response")
+	}
+
+	return helper.ToCartBatch(res), nil
+}
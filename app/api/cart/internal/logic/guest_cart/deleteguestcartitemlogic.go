@@ -0,0 +1,56 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package guest_cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type DeleteGuestCartItemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeleteGuestCartItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteGuestCartItemLogic {
+	return &DeleteGuestCartItemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteGuestCartItemLogic) DeleteGuestCartItem(req *types.DeleteGuestCartItemRequest) (resp *types.CartActionResponse, err error) {
+	if req == nil || req.GuestToken == "" || req.ProductId <= 0 {
+		return nil, errors.New(int(errno.InvalidParam), "invalid cart payload")
+	}
+
+	res, err := l.svcCtx.CartRpc.DeleteCartItem(l.ctx, &cartsvc.DelCartItemReq{
+		GuestToken: req.GuestToken,
+		ProductId:  req.ProductId,
+	})
+	if err != nil {
+		l.Logger.Error("logic: delete guest cart item rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty delete guest cart response")
+	}
+
+	resp = &types.CartActionResponse{
+		StatusCode: res.StatusCode,
+		StatusMsg:  res.StatusMsg,
+		Quantity:   res.Quantity,
+	}
+
+	return
+}
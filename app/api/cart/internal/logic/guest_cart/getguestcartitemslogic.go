@@ -0,0 +1,51 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package guest_cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type GetGuestCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetGuestCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGuestCartItemsLogic {
+	return &GetGuestCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetGuestCartItemsLogic) GetGuestCartItems(req *types.GetGuestCartItemsRequest) (resp *types.GetCartItemsResponse, err error) {
+	if req == nil || req.GuestToken == "" {
+		return nil, errors.New(int(errno.InvalidParam), "guest token required")
+	}
+
+	res, err := l.svcCtx.CartRpc.GetCartItemList(l.ctx, &cartsvc.GetCartItemListReq{
+		GuestToken:   req.GuestToken,
+		SelectedOnly: req.SelectedOnly,
+	})
+	if err != nil {
+		l.Logger.Error("logic: get guest cart items rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty get guest cart response")
+	}
+
+	return helper.ToCartItems(res), nil
+}
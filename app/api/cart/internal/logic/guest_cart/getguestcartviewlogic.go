@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package guest_cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type GetGuestCartViewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetGuestCartViewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGuestCartViewLogic {
+	return &GetGuestCartViewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetGuestCartViewLogic) GetGuestCartView(req *types.GetGuestCartViewRequest) (resp *types.GetCartViewResponse, err error) {
+	if req == nil || req.GuestToken == "" {
+		return nil, errors.New(int(errno.InvalidParam), "guest token required")
+	}
+
+	res, err := l.svcCtx.CartRpc.GetCartView(l.ctx, &cartsvc.GetCartViewReq{
+		GuestToken: req.GuestToken,
+	})
+	if err != nil {
+		l.Logger.Error("logic: get guest cart view rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty get guest cart view response")
+	}
+
+	return helper.ToCartView(res), nil
+}
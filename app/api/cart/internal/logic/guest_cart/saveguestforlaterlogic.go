@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package guest_cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type SaveGuestForLaterLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSaveGuestForLaterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveGuestForLaterLogic {
+	return &SaveGuestForLaterLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SaveGuestForLaterLogic) SaveGuestForLater(req *types.SaveGuestForLaterRequest) (resp *types.CartBatchResponse, err error) {
+	if req == nil || req.GuestToken == "" || len(req.ProductIds) == 0 {
+		return nil, errors.New(int(errno.InvalidParam), "invalid cart payload")
+	}
+
+	res, err := l.svcCtx.CartRpc.SaveForLater(l.ctx, &cartsvc.SaveForLaterReq{
+		GuestToken: req.GuestToken,
+		ProductIds: req.ProductIds,
+		Saved:      req.Saved,
+	})
+	if err != nil {
+		l.Logger.Error("logic: save guest cart for later rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty save guest cart for later response")
+	}
+
+	return helper.ToCartBatch(res), nil
+}
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package guest_cart
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/cart/internal/logic/helper"
+	"NatsumeAI/app/api/cart/internal/svc"
+	"NatsumeAI/app/api/cart/internal/types"
+	"NatsumeAI/app/common/consts/errno"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/x/errors"
+)
+
+type SelectGuestCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSelectGuestCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectGuestCartItemsLogic {
+	return &SelectGuestCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SelectGuestCartItemsLogic) SelectGuestCartItems(req *types.SelectGuestCartItemsRequest) (resp *types.CartBatchResponse, err error) {
+	if req == nil || req.GuestToken == "" {
+		return nil, errors.New(int(errno.InvalidParam), "invalid cart payload")
+	}
+
+	res, err := l.svcCtx.CartRpc.SelectCartItems(l.ctx, &cartsvc.SelectCartItemsReq{
+		GuestToken: req.GuestToken,
+		ProductIds: req.ProductIds,
+		Selected:   req.Selected,
+	})
+	if err != nil {
+		l.Logger.Error("logic: select guest cart items rpc failed: ", err)
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(int(errno.InternalError), "empty select guest cart items response")
+	}
+
+	return helper.ToCartBatch(res), nil
+}
@@ -0,0 +1,111 @@
+package helper
+
+import (
+	"NatsumeAI/app/api/cart/internal/types"
+	cartsvc "NatsumeAI/app/services/cart/cartservice"
+)
+
+// ToCartItems 购物车列表响应转换为接口结构
+func ToCartItems(res *cartsvc.GetCartItemListResp) *types.GetCartItemsResponse {
+	return &types.GetCartItemsResponse{
+		StatusCode: res.StatusCode,
+		StatusMsg:  res.StatusMsg,
+		Total:      res.Total,
+		Items:      toCartItems(res.Data),
+		SavedItems: toCartItems(res.SavedItems),
+	}
+}
+
+func toCartItems(data []*cartsvc.CartInfoResp) []types.CartItem {
+	items := make([]types.CartItem, 0, len(data))
+	for _, item := range data {
+		if item == nil {
+			continue
+		}
+		items = append(items, types.CartItem{
+			Id:            item.GetId(),
+			UserId:        item.GetUserId(),
+			ProductId:     item.GetProductId(),
+			Quantity:      item.GetQuantity(),
+			Selected:      item.GetSelected(),
+			SavedForLater: item.GetSavedForLater(),
+		})
+	}
+	return items
+}
+
+// ToCartView 购物车详情响应转换为接口结构
+func ToCartView(res *cartsvc.GetCartViewResp) *types.GetCartViewResponse {
+	groups := make([]types.CartMerchantGroup, 0, len(res.Groups))
+	for _, g := range res.Groups {
+		if g == nil {
+			continue
+		}
+		groups = append(groups, types.CartMerchantGroup{
+			MerchantId: g.MerchantId,
+			Lines:      toCartLines(g.Lines),
+			Subtotal:   g.Subtotal,
+		})
+	}
+
+	discount := types.CartDiscount{Promotions: []types.CartPromotion{}}
+	if d := res.BestDiscount; d != nil {
+		discount.DiscountAmount = d.DiscountAmount
+		discount.PromotionAmount = d.PromotionAmount
+		discount.CouponId = d.CouponId
+		discount.CouponAmount = d.CouponAmount
+		for _, p := range d.Promotions {
+			discount.Promotions = append(discount.Promotions, types.CartPromotion{
+				PromotionId:    p.GetPromotionId(),
+				Name:           p.GetName(),
+				Description:    p.GetDescription(),
+				DiscountAmount: p.GetDiscountAmount(),
+			})
+		}
+	}
+
+	return &types.GetCartViewResponse{
+		StatusCode:    res.StatusCode,
+		StatusMsg:     res.StatusMsg,
+		Groups:        groups,
+		Subtotal:      res.Subtotal,
+		TotalQuantity: res.TotalQuantity,
+		BestDiscount:  discount,
+		PayableAmount: res.PayableAmount,
+		Currency:      res.Currency,
+		SavedLines:    toCartLines(res.SavedLines),
+		AllSelected:   res.AllSelected,
+	}
+}
+
+func toCartLines(data []*cartsvc.CartLine) []types.CartLine {
+	lines := make([]types.CartLine, 0, len(data))
+	for _, line := range data {
+		lines = append(lines, types.CartLine{
+			Id:                line.GetId(),
+			ProductId:         line.GetProductId(),
+			Quantity:          line.GetQuantity(),
+			Name:              line.GetName(),
+			Picture:           line.GetPicture(),
+			Price:             line.GetPrice(),
+			AddedPrice:        line.GetAddedPrice(),
+			Stock:             line.GetStock(),
+			MerchantId:        line.GetMerchantId(),
+			Amount:            line.GetAmount(),
+			PriceChanged:      line.GetPriceChanged(),
+			Unavailable:       line.GetUnavailable(),
+			UnavailableReason: line.GetUnavailableReason(),
+			Selected:          line.GetSelected(),
+		})
+	}
+	return lines
+}
+
+// ToCartBatch 批量操作响应转换为接口结构
+func ToCartBatch(res *cartsvc.CartBatchResp) *types.CartBatchResponse {
+	return &types.CartBatchResponse{
+		StatusCode: res.StatusCode,
+		StatusMsg:  res.StatusMsg,
+		Affected:   res.Affected,
+	}
+}
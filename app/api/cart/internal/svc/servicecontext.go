@@ -9,6 +9,7 @@ import (
     "NatsumeAI/app/services/auth/authservice"
     "NatsumeAI/app/services/cart/cartservice"
 
+    "github.com/zeromicro/go-zero/core/stores/redis"
     "github.com/zeromicro/go-zero/rest"
     "github.com/zeromicro/go-zero/zrpc"
 )
@@ -17,6 +18,7 @@ type ServiceContext struct {
     Config         config.Config
     AuthMiddleware rest.Middleware
     CasbinMiddleware rest.Middleware
+    GuestLimitMiddleware rest.Middleware
     CartRpc cartservice.CartService
 }
 
@@ -27,6 +29,8 @@ func NewServiceContext(c config.Config) *ServiceContext {
             authservice.NewAuthService(zrpc.MustNewClient(c.AuthRpc))).Handle,
         CasbinMiddleware: middleware.NewCasbinMiddleware(
             c.CasbinMiddleware.MustNewDistributedEnforcer()).Handle,
+        GuestLimitMiddleware: middleware.NewGuestLimitMiddleware(c.GuestLimit.Period, c.GuestLimit.Quota,
+            redis.MustNewRedis(c.GuestLimit.Redis), "cart:guest:limit:").Handle,
         CartRpc: cartservice.NewCartService(zrpc.MustNewClient(c.CartRpc)),
     }
 }
@@ -8,23 +8,91 @@ type AddCartItemRequest struct {
 	Quantity  int64 `json:"quantity"`
 }
 
+type AddGuestCartItemRequest struct {
+	GuestToken string `header:"X-Guest-Token"`
+	ProductId  int64  `json:"productId"`
+	Quantity   int64  `json:"quantity"`
+}
+
+type BatchDeleteCartItemsRequest struct {
+	ProductIds []int64 `json:"productIds"`
+}
+
+type BatchDeleteGuestCartItemsRequest struct {
+	GuestToken string  `header:"X-Guest-Token"`
+	ProductIds []int64 `json:"productIds"`
+}
+
 type CartActionResponse struct {
 	StatusCode int32  `json:"statusCode"`
 	StatusMsg  string `json:"statusMsg"`
+	Quantity   int64  `json:"quantity"` // 操作后的商品数量，删除时为 0
+}
+
+type CartBatchResponse struct {
+	StatusCode int32  `json:"statusCode"`
+	StatusMsg  string `json:"statusMsg"`
+	Affected   int64  `json:"affected"` // 实际处理的商品行数
+}
+
+type CartDiscount struct {
+	DiscountAmount  int64           `json:"discountAmount"`
+	PromotionAmount int64           `json:"promotionAmount"`
+	CouponId        int64           `json:"couponId"` // 推荐使用的券，0 表示不用券
+	CouponAmount    int64           `json:"couponAmount"`
+	Promotions      []CartPromotion `json:"promotions"`
 }
 
 type CartItem struct {
-	Id        int64 `json:"id"`
-	UserId    int64 `json:"userId"`
-	ProductId int64 `json:"productId"`
-	Quantity  int64 `json:"quantity"`
+	Id            int64 `json:"id"`
+	UserId        int64 `json:"userId"`
+	ProductId     int64 `json:"productId"`
+	Quantity      int64 `json:"quantity"`
+	Selected      bool  `json:"selected"`
+	SavedForLater bool  `json:"savedForLater"`
+}
+
+type CartLine struct {
+	Id                int64  `json:"id"`
+	ProductId         int64  `json:"productId"`
+	Quantity          int64  `json:"quantity"`
+	Name              string `json:"name"`
+	Picture           string `json:"picture"`
+	Price             int64  `json:"price"`      // 当前单价
+	AddedPrice        int64  `json:"addedPrice"` // 加入购物车时的单价，0 表示未记录
+	Stock             int64  `json:"stock"`
+	MerchantId        int64  `json:"merchantId"`
+	Amount            int64  `json:"amount"` // 不可购买时为 0
+	PriceChanged      bool   `json:"priceChanged"`
+	Unavailable       bool   `json:"unavailable"`
+	UnavailableReason string `json:"unavailableReason"`
+	Selected          bool   `json:"selected"`
+}
+
+type CartMerchantGroup struct {
+	MerchantId int64      `json:"merchantId"` // 0 为已下架商品
+	Lines      []CartLine `json:"lines"`
+	Subtotal   int64      `json:"subtotal"`
+}
+
+type CartPromotion struct {
+	PromotionId    int64  `json:"promotionId"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	DiscountAmount int64  `json:"discountAmount"`
 }
 
 type DeleteCartItemRequest struct {
 	ProductId int64 `path:"productId"`
 }
 
+type DeleteGuestCartItemRequest struct {
+	GuestToken string `header:"X-Guest-Token"`
+	ProductId  int64  `path:"productId"`
+}
+
 type GetCartItemsRequest struct {
+	SelectedOnly bool `form:"selectedOnly,optional"` // 只返回已勾选的商品，供结算使用
 }
 
 type GetCartItemsResponse struct {
@@ -32,9 +100,63 @@ type GetCartItemsResponse struct {
 	StatusMsg  string     `json:"statusMsg"`
 	Total      int64      `json:"total"`
 	Items      []CartItem `json:"items"`
+	SavedItems []CartItem `json:"savedItems"` // 稍后再买，不计入 total
+}
+
+type GetCartViewRequest struct {
+}
+
+type GetCartViewResponse struct {
+	StatusCode    int32               `json:"statusCode"`
+	StatusMsg     string              `json:"statusMsg"`
+	Groups        []CartMerchantGroup `json:"groups"`
+	Subtotal      int64               `json:"subtotal"`
+	TotalQuantity int64               `json:"totalQuantity"`
+	BestDiscount  CartDiscount        `json:"bestDiscount"`
+	PayableAmount int64               `json:"payableAmount"`
+	Currency      string              `json:"currency"`
+	SavedLines    []CartLine          `json:"savedLines"` // 稍后再买，不计入金额
+	AllSelected   bool                `json:"allSelected"`
+}
+
+type GetGuestCartItemsRequest struct {
+	GuestToken   string `header:"X-Guest-Token"`
+	SelectedOnly bool   `form:"selectedOnly,optional"`
+}
+
+type GetGuestCartViewRequest struct {
+	GuestToken string `header:"X-Guest-Token"`
+}
+
+type SaveForLaterRequest struct {
+	ProductIds []int64 `json:"productIds"`
+	Saved      bool    `json:"saved"`
+}
+
+type SaveGuestForLaterRequest struct {
+	GuestToken string  `header:"X-Guest-Token"`
+	ProductIds []int64 `json:"productIds"`
+	Saved      bool    `json:"saved"`
+}
+
+type SelectCartItemsRequest struct {
+	ProductIds []int64 `json:"productIds,optional"`
+	Selected   bool    `json:"selected"`
+}
+
+type SelectGuestCartItemsRequest struct {
+	GuestToken string  `header:"X-Guest-Token"`
+	ProductIds []int64 `json:"productIds,optional"`
+	Selected   bool    `json:"selected"`
 }
 
 type UpdateCartItemRequest struct {
 	ProductId int64 `path:"productId"`
 	Quantity  int64 `json:"quantity"`
 }
+
+type UpdateGuestCartItemRequest struct {
+	GuestToken string `header:"X-Guest-Token"`
+	ProductId  int64  `path:"productId"`
+	Quantity   int64  `json:"quantity"`
+}
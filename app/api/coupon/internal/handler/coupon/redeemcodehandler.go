@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon
+
+import (
+	"net/http"
+
+	"NatsumeAI/app/api/coupon/internal/logic/coupon"
+	"NatsumeAI/app/api/coupon/internal/svc"
+	"NatsumeAI/app/api/coupon/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func RedeemCodeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.RedeemCodeRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := coupon.NewRedeemCodeLogic(r.Context(), svcCtx)
+		resp, err := l.RedeemCode(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_campaign
+
+import (
+	"net/http"
+
+	"NatsumeAI/app/api/coupon/internal/logic/coupon_campaign"
+	"NatsumeAI/app/api/coupon/internal/svc"
+	"NatsumeAI/app/api/coupon/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func CreateCampaignHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CreateCampaignRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := coupon_campaign.NewCreateCampaignLogic(r.Context(), svcCtx)
+		resp, err := l.CreateCampaign(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
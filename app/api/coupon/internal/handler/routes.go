@@ -7,7 +7,12 @@ import (
 	"net/http"
 
 	coupon "NatsumeAI/app/api/coupon/internal/handler/coupon"
+	coupon_campaign "NatsumeAI/app/api/coupon/internal/handler/coupon_campaign"
+	coupon_code "NatsumeAI/app/api/coupon/internal/handler/coupon_code"
 	coupon_manage "NatsumeAI/app/api/coupon/internal/handler/coupon_manage"
+	coupon_merchant "NatsumeAI/app/api/coupon/internal/handler/coupon_merchant"
+	coupon_promotion "NatsumeAI/app/api/coupon/internal/handler/coupon_promotion"
+	coupon_stats "NatsumeAI/app/api/coupon/internal/handler/coupon_stats"
 	"NatsumeAI/app/api/coupon/internal/svc"
 
 	"github.com/zeromicro/go-zero/rest"
@@ -28,19 +33,164 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/v1/coupons/claim",
 					Handler: coupon.ClaimCouponHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/redeem",
+					Handler: coupon.RedeemCodeHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/campaigns",
+					Handler: coupon_campaign.CreateCampaignHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/campaigns",
+					Handler: coupon_campaign.ListCampaignsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/campaigns/:campaignId",
+					Handler: coupon_campaign.GetCampaignHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/campaigns/cancel",
+					Handler: coupon_campaign.CancelCampaignHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/codes/batches",
+					Handler: coupon_code.CreateCodeBatchHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/codes/batches/:batchId",
+					Handler: coupon_code.GetCodeBatchHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/codes/batches/:batchId/export",
+					Handler: coupon_code.ExportCodeBatchHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/codes/batches/disable",
+					Handler: coupon_code.DisableCodeBatchHandler(serverCtx),
+				},
 			}...,
 		),
 	)
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
 			[]rest.Route{
 				{
 					Method:  http.MethodPost,
 					Path:    "/api/v1/coupons/publish",
 					Handler: coupon_manage.PublishCouponHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/revoke",
+					Handler: coupon_manage.RevokeCouponHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/templates",
+					Handler: coupon_manage.ListCouponTemplatesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/templates/stats",
+					Handler: coupon_manage.GetPlatformCouponStatsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/update",
+					Handler: coupon_manage.UpdateCouponHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/merchant/publish",
+					Handler: coupon_merchant.PublishMerchantCouponHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/merchant/revoke",
+					Handler: coupon_merchant.RevokeMerchantCouponHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/merchant/templates",
+					Handler: coupon_merchant.ListMerchantCouponTemplatesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/merchant/update",
+					Handler: coupon_merchant.UpdateMerchantCouponHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/promotions",
+					Handler: coupon_promotion.CreatePromotionHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/promotions",
+					Handler: coupon_promotion.ListPromotionsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/coupons/promotions/disable",
+					Handler: coupon_promotion.DisablePromotionHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/coupons/stats",
+					Handler: coupon_stats.GetCouponStatsHandler(serverCtx),
+				},
 			}...,
 		),
 	)
@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    "NatsumeAI/app/common/util"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type RedeemCodeLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewRedeemCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedeemCodeLogic {
+    return &RedeemCodeLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *RedeemCodeLogic) RedeemCode(req *types.RedeemCodeRequest) (resp *types.RedeemCodeResponse, err error) {
+    if req == nil || req.Code == "" {
+        return nil, errors.New(int(errno.InvalidParam), "invalid code")
+    }
+
+    userID, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    res, err := l.svcCtx.CouponRpc.RedeemCode(l.ctx, &couponsvc.RedeemCodeReq{
+        UserId: userID,
+        Code:   req.Code,
+    })
+    if err != nil {
+        l.Logger.Error("logic: redeem code rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty redeem code response")
+    }
+
+    return &types.RedeemCodeResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Coupon:     helper.ToCouponItem(res.Coupon),
+    }, nil
+}
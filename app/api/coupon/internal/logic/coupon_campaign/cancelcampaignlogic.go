@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_campaign
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type CancelCampaignLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewCancelCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelCampaignLogic {
+    return &CancelCampaignLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *CancelCampaignLogic) CancelCampaign(req *types.CancelCampaignRequest) (resp *types.CouponActionResponse, err error) {
+    if req == nil || req.CampaignId <= 0 {
+        return nil, errors.New(int(errno.InvalidParam), "invalid campaign id")
+    }
+
+    res, err := l.svcCtx.CouponRpc.CancelCampaign(l.ctx, &couponsvc.CancelCampaignReq{
+        CampaignId: req.CampaignId,
+    })
+    if err != nil {
+        l.Logger.Error("logic: cancel campaign rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty cancel campaign response")
+    }
+
+    return &types.CouponActionResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+    }, nil
+}
@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_campaign
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+    proto "NatsumeAI/app/services/coupon/coupon"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type CreateCampaignLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewCreateCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCampaignLogic {
+    return &CreateCampaignLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *CreateCampaignLogic) CreateCampaign(req *types.CreateCampaignRequest) (resp *types.CreateCampaignResponse, err error) {
+    if req == nil {
+        return nil, errors.New(int(errno.InvalidParam), "invalid campaign payload")
+    }
+
+    res, err := l.svcCtx.CouponRpc.CreateCampaign(l.ctx, &couponsvc.CreateCampaignReq{
+        Name:     req.Name,
+        CouponId: req.CouponId,
+        Trigger:  proto.CampaignTrigger(req.Trigger),
+        Segment:  helper.ToSegmentProto(req.Segment),
+        StartAt:  req.StartAt,
+        EndAt:    req.EndAt,
+    })
+    if err != nil {
+        l.Logger.Error("logic: create campaign rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty create campaign response")
+    }
+
+    return &types.CreateCampaignResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        CampaignId: res.CampaignId,
+    }, nil
+}
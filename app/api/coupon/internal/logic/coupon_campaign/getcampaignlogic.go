@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_campaign
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type GetCampaignLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewGetCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCampaignLogic {
+    return &GetCampaignLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *GetCampaignLogic) GetCampaign(req *types.GetCampaignRequest) (resp *types.GetCampaignResponse, err error) {
+    if req == nil || req.CampaignId <= 0 {
+        return nil, errors.New(int(errno.InvalidParam), "invalid campaign id")
+    }
+
+    res, err := l.svcCtx.CouponRpc.GetCampaign(l.ctx, &couponsvc.GetCampaignReq{
+        CampaignId: req.CampaignId,
+    })
+    if err != nil {
+        l.Logger.Error("logic: get campaign rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty get campaign response")
+    }
+
+    return &types.GetCampaignResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Campaign:   helper.ToCampaignItem(res.Campaign),
+    }, nil
+}
@@ -0,0 +1,64 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_campaign
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+    proto "NatsumeAI/app/services/coupon/coupon"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type ListCampaignsLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewListCampaignsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCampaignsLogic {
+    return &ListCampaignsLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *ListCampaignsLogic) ListCampaigns(req *types.ListCampaignsRequest) (resp *types.ListCampaignsResponse, err error) {
+    if req == nil {
+        return nil, errors.New(int(errno.InvalidParam), "invalid list payload")
+    }
+
+    res, err := l.svcCtx.CouponRpc.ListCampaigns(l.ctx, &couponsvc.ListCampaignsReq{
+        Trigger:  proto.CampaignTrigger(req.Trigger),
+        Status:   proto.CampaignStatus(req.Status),
+        Page:     req.Page,
+        PageSize: req.PageSize,
+    })
+    if err != nil {
+        l.Logger.Error("logic: list campaigns rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty list campaigns response")
+    }
+
+    items := make([]types.CampaignItem, 0, len(res.Campaigns))
+    for _, c := range res.Campaigns {
+        items = append(items, helper.ToCampaignItem(c))
+    }
+
+    return &types.ListCampaignsResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Campaigns:  items,
+        Total:      res.Total,
+    }, nil
+}
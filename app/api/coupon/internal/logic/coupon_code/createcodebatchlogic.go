@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_code
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+    proto "NatsumeAI/app/services/coupon/coupon"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type CreateCodeBatchLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewCreateCodeBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCodeBatchLogic {
+    return &CreateCodeBatchLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *CreateCodeBatchLogic) CreateCodeBatch(req *types.CreateCodeBatchRequest) (resp *types.CodeBatchResponse, err error) {
+    if req == nil {
+        return nil, errors.New(int(errno.InvalidParam), "invalid code batch payload")
+    }
+
+    res, err := l.svcCtx.CouponRpc.CreateCodeBatch(l.ctx, &couponsvc.CreateCodeBatchReq{
+        CouponId:   req.CouponId,
+        Name:       req.Name,
+        CodeType:   proto.CodeType(req.CodeType),
+        SharedCode: req.SharedCode,
+        Quantity:   req.Quantity,
+    })
+    if err != nil {
+        l.Logger.Error("logic: create code batch rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty create code batch response")
+    }
+
+    return &types.CodeBatchResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Batch:      helper.ToCodeBatchItem(res.Batch),
+    }, nil
+}
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_code
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type DisableCodeBatchLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewDisableCodeBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisableCodeBatchLogic {
+    return &DisableCodeBatchLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *DisableCodeBatchLogic) DisableCodeBatch(req *types.DisableCodeBatchRequest) (resp *types.CouponActionResponse, err error) {
+    if req == nil || req.BatchId <= 0 {
+        return nil, errors.New(int(errno.InvalidParam), "invalid batch id")
+    }
+
+    res, err := l.svcCtx.CouponRpc.DisableCodeBatch(l.ctx, &couponsvc.DisableCodeBatchReq{
+        BatchId: req.BatchId,
+    })
+    if err != nil {
+        l.Logger.Error("logic: disable code batch rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty disable code batch response")
+    }
+
+    return &types.CouponActionResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+    }, nil
+}
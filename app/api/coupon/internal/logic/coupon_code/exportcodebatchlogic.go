@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_code
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type ExportCodeBatchLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewExportCodeBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportCodeBatchLogic {
+    return &ExportCodeBatchLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+// ExportCodeBatch 返回 CSV 文件名与内容，由 handler 直接写出
+func (l *ExportCodeBatchLogic) ExportCodeBatch(req *types.CodeBatchRequest) (fileName string, content []byte, err error) {
+    if req == nil || req.BatchId <= 0 {
+        return "", nil, errors.New(int(errno.InvalidParam), "invalid batch id")
+    }
+
+    res, err := l.svcCtx.CouponRpc.ExportCodeBatch(l.ctx, &couponsvc.ExportCodeBatchReq{
+        BatchId: req.BatchId,
+    })
+    if err != nil {
+        l.Logger.Error("logic: export code batch rpc failed: ", err)
+        return "", nil, err
+    }
+    if res == nil {
+        return "", nil, errors.New(int(errno.InternalError), "empty export code batch response")
+    }
+    if res.StatusCode != errno.StatusOK {
+        return "", nil, errors.New(int(res.StatusCode), res.StatusMsg)
+    }
+
+    return res.FileName, res.Content, nil
+}
@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_code
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type GetCodeBatchLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewGetCodeBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCodeBatchLogic {
+    return &GetCodeBatchLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *GetCodeBatchLogic) GetCodeBatch(req *types.CodeBatchRequest) (resp *types.CodeBatchResponse, err error) {
+    if req == nil || req.BatchId <= 0 {
+        return nil, errors.New(int(errno.InvalidParam), "invalid batch id")
+    }
+
+    res, err := l.svcCtx.CouponRpc.GetCodeBatch(l.ctx, &couponsvc.GetCodeBatchReq{
+        BatchId: req.BatchId,
+    })
+    if err != nil {
+        l.Logger.Error("logic: get code batch rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty get code batch response")
+    }
+
+    return &types.CodeBatchResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Batch:      helper.ToCodeBatchItem(res.Batch),
+    }, nil
+}
@@ -0,0 +1,64 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_manage
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    proto "NatsumeAI/app/services/coupon/coupon"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type ListCouponTemplatesLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewListCouponTemplatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCouponTemplatesLogic {
+    return &ListCouponTemplatesLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *ListCouponTemplatesLogic) ListCouponTemplates(req *types.ListCouponTemplatesRequest) (resp *types.ListCouponTemplatesResponse, err error) {
+    if req == nil {
+        return nil, errors.New(int(errno.InvalidParam), "invalid list payload")
+    }
+
+    res, err := l.svcCtx.CouponRpc.ListCouponTemplates(l.ctx, &couponsvc.ListCouponTemplatesReq{
+        IssuerType: proto.CouponIssuer_COUPON_ISSUER_PLATFORM,
+        Page:       req.Page,
+        PageSize:   req.PageSize,
+    })
+    if err != nil {
+        l.Logger.Error("logic: list coupon templates rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty list coupon templates response")
+    }
+
+    templates := make([]types.CouponTemplateItem, 0, len(res.Templates))
+    for _, t := range res.Templates {
+        templates = append(templates, helper.ToCouponTemplateItem(t))
+    }
+
+    return &types.ListCouponTemplatesResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Templates:  templates,
+        Total:      res.Total,
+    }, nil
+}
@@ -6,10 +6,10 @@ package coupon_manage
 import (
     "context"
 
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
     "NatsumeAI/app/api/coupon/internal/svc"
     "NatsumeAI/app/api/coupon/internal/types"
     "NatsumeAI/app/common/consts/errno"
-    couponsvc "NatsumeAI/app/services/coupon/couponservice"
     proto "NatsumeAI/app/services/coupon/coupon"
 
     "github.com/zeromicro/go-zero/core/logx"
@@ -35,18 +35,8 @@ func (l *PublishCouponLogic) PublishCoupon(req *types.PublishCouponRequest) (res
         return nil, errors.New(int(errno.InvalidParam), "invalid publish payload")
     }
 
-    in := &couponsvc.PublishCouponReq{
-        CouponType:      proto.CouponType(req.CouponType),
-        DiscountAmount:  req.DiscountAmount,
-        DiscountPercent: int32(req.DiscountPercent),
-        MinSpendAmount:  req.MinSpendAmount,
-        TotalIssue:      req.TotalIssue,
-        PerUserLimit:    req.PerUserLimit,
-        StartAt:         req.StartAt,
-        EndAt:           req.EndAt,
-        Source:          req.Source,
-        Remarks:         req.Remarks,
-    }
+    in := helper.ToPublishCouponProto(req)
+    in.IssuerType = proto.CouponIssuer_COUPON_ISSUER_PLATFORM
 
     res, err := l.svcCtx.CouponRpc.PublishCoupon(l.ctx, in)
     if err != nil {
@@ -0,0 +1,71 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_merchant
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    "NatsumeAI/app/common/util"
+    proto "NatsumeAI/app/services/coupon/coupon"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type ListMerchantCouponTemplatesLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewListMerchantCouponTemplatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMerchantCouponTemplatesLogic {
+    return &ListMerchantCouponTemplatesLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *ListMerchantCouponTemplatesLogic) ListMerchantCouponTemplates(req *types.ListCouponTemplatesRequest) (resp *types.ListCouponTemplatesResponse, err error) {
+    if req == nil {
+        return nil, errors.New(int(errno.InvalidParam), "invalid list payload")
+    }
+
+    merchantID, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    res, err := l.svcCtx.CouponRpc.ListCouponTemplates(l.ctx, &couponsvc.ListCouponTemplatesReq{
+        IssuerType: proto.CouponIssuer_COUPON_ISSUER_MERCHANT,
+        IssuerId:   merchantID,
+        Page:       req.Page,
+        PageSize:   req.PageSize,
+    })
+    if err != nil {
+        l.Logger.Error("logic: list coupon templates rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty list coupon templates response")
+    }
+
+    templates := make([]types.CouponTemplateItem, 0, len(res.Templates))
+    for _, t := range res.Templates {
+        templates = append(templates, helper.ToCouponTemplateItem(t))
+    }
+
+    return &types.ListCouponTemplatesResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Templates:  templates,
+        Total:      res.Total,
+    }, nil
+}
@@ -0,0 +1,64 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_merchant
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    "NatsumeAI/app/common/util"
+    proto "NatsumeAI/app/services/coupon/coupon"
+
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type PublishMerchantCouponLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewPublishMerchantCouponLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishMerchantCouponLogic {
+    return &PublishMerchantCouponLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *PublishMerchantCouponLogic) PublishMerchantCoupon(req *types.PublishCouponRequest) (resp *types.PublishCouponResponse, err error) {
+    if req == nil {
+        return nil, errors.New(int(errno.InvalidParam), "invalid publish payload")
+    }
+
+    merchantID, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    // 商家券由商家出资，rpc 会将适用范围限定为本店商品
+    in := helper.ToPublishCouponProto(req)
+    in.IssuerType = proto.CouponIssuer_COUPON_ISSUER_MERCHANT
+    in.IssuerId = merchantID
+
+    res, err := l.svcCtx.CouponRpc.PublishCoupon(l.ctx, in)
+    if err != nil {
+        l.Logger.Error("logic: publish merchant coupon rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty publish response")
+    }
+
+    return &types.PublishCouponResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        CampaignId: res.CampaignId,
+    }, nil
+}
@@ -0,0 +1,62 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_merchant
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    "NatsumeAI/app/common/util"
+    proto "NatsumeAI/app/services/coupon/coupon"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type RevokeMerchantCouponLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewRevokeMerchantCouponLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeMerchantCouponLogic {
+    return &RevokeMerchantCouponLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *RevokeMerchantCouponLogic) RevokeMerchantCoupon(req *types.RevokeCouponRequest) (resp *types.CouponActionResponse, err error) {
+    if req == nil || req.CouponId <= 0 {
+        return nil, errors.New(int(errno.InvalidParam), "invalid coupon id")
+    }
+
+    merchantID, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    res, err := l.svcCtx.CouponRpc.RevokeCoupon(l.ctx, &couponsvc.RevokeCouponReq{
+        CouponId:   req.CouponId,
+        IssuerType: proto.CouponIssuer_COUPON_ISSUER_MERCHANT,
+        IssuerId:   merchantID,
+    })
+    if err != nil {
+        l.Logger.Error("logic: revoke coupon rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty revoke coupon response")
+    }
+
+    return &types.CouponActionResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+    }, nil
+}
@@ -0,0 +1,64 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_promotion
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+    proto "NatsumeAI/app/services/coupon/coupon"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type CreatePromotionLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewCreatePromotionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePromotionLogic {
+    return &CreatePromotionLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *CreatePromotionLogic) CreatePromotion(req *types.CreatePromotionRequest) (resp *types.PromotionResponse, err error) {
+    if req == nil {
+        return nil, errors.New(int(errno.InvalidParam), "invalid promotion payload")
+    }
+
+    res, err := l.svcCtx.CouponRpc.CreatePromotion(l.ctx, &couponsvc.CreatePromotionReq{
+        Name:            req.Name,
+        PromoType:       proto.PromotionType(req.PromoType),
+        FundedBy:        proto.PromotionFunder(req.FundedBy),
+        MerchantId:      req.MerchantId,
+        Rule:            helper.ToPromotionRuleProto(req.Rule),
+        MutexGroup:      req.MutexGroup,
+        StackWithCoupon: req.StackWithCoupon,
+        Priority:        req.Priority,
+        StartAt:         req.StartAt,
+        EndAt:           req.EndAt,
+    })
+    if err != nil {
+        l.Logger.Error("logic: create promotion rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty create promotion response")
+    }
+
+    return &types.PromotionResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Promotion:  helper.ToPromotionItem(res.Promotion),
+    }, nil
+}
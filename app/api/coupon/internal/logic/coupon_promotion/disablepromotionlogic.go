@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_promotion
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type DisablePromotionLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewDisablePromotionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisablePromotionLogic {
+    return &DisablePromotionLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *DisablePromotionLogic) DisablePromotion(req *types.DisablePromotionRequest) (resp *types.CouponActionResponse, err error) {
+    if req == nil || req.PromotionId <= 0 {
+        return nil, errors.New(int(errno.InvalidParam), "invalid promotion id")
+    }
+
+    res, err := l.svcCtx.CouponRpc.DisablePromotion(l.ctx, &couponsvc.DisablePromotionReq{
+        PromotionId: req.PromotionId,
+    })
+    if err != nil {
+        l.Logger.Error("logic: disable promotion rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty disable promotion response")
+    }
+
+    return &types.CouponActionResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+    }, nil
+}
@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_promotion
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type ListPromotionsLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewListPromotionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPromotionsLogic {
+    return &ListPromotionsLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *ListPromotionsLogic) ListPromotions(req *types.ListPromotionsRequest) (resp *types.ListPromotionsResponse, err error) {
+    if req == nil {
+        return nil, errors.New(int(errno.InvalidParam), "invalid list payload")
+    }
+
+    res, err := l.svcCtx.CouponRpc.ListPromotions(l.ctx, &couponsvc.ListPromotionsReq{
+        MerchantId: req.MerchantId,
+        ActiveOnly: req.ActiveOnly,
+        Page:       req.Page,
+        PageSize:   req.PageSize,
+    })
+    if err != nil {
+        l.Logger.Error("logic: list promotions rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty list promotions response")
+    }
+
+    items := make([]types.PromotionItem, 0, len(res.Promotions))
+    for _, p := range res.Promotions {
+        items = append(items, helper.ToPromotionItem(p))
+    }
+
+    return &types.ListPromotionsResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Promotions: items,
+        Total:      res.Total,
+    }, nil
+}
@@ -0,0 +1,70 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package coupon_stats
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/coupon/internal/logic/helper"
+    "NatsumeAI/app/api/coupon/internal/svc"
+    "NatsumeAI/app/api/coupon/internal/types"
+    "NatsumeAI/app/common/consts/errno"
+    "NatsumeAI/app/common/util"
+    proto "NatsumeAI/app/services/coupon/coupon"
+    couponsvc "NatsumeAI/app/services/coupon/couponservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+    "github.com/zeromicro/x/errors"
+)
+
+type GetCouponStatsLogic struct {
+    logx.Logger
+    ctx    context.Context
+    svcCtx *svc.ServiceContext
+}
+
+func NewGetCouponStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCouponStatsLogic {
+    return &GetCouponStatsLogic{
+        Logger: logx.WithContext(ctx),
+        ctx:    ctx,
+        svcCtx: svcCtx,
+    }
+}
+
+func (l *GetCouponStatsLogic) GetCouponStats(req *types.GetCouponStatsRequest) (resp *types.GetCouponStatsResponse, err error) {
+    if req == nil || len(req.CouponIds) == 0 {
+        return nil, errors.New(int(errno.InvalidParam), "couponIds required")
+    }
+
+    merchantID, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    res, err := l.svcCtx.CouponRpc.GetCouponStats(l.ctx, &couponsvc.GetCouponStatsReq{
+        CouponIds:  req.CouponIds,
+        StartAt:    req.StartAt,
+        EndAt:      req.EndAt,
+        IssuerType: proto.CouponIssuer_COUPON_ISSUER_MERCHANT,
+        IssuerId:   merchantID,
+    })
+    if err != nil {
+        l.Logger.Error("logic: get coupon stats rpc failed: ", err)
+        return nil, err
+    }
+    if res == nil {
+        return nil, errors.New(int(errno.InternalError), "empty coupon stats response")
+    }
+
+    items := make([]types.CouponStatsItem, 0, len(res.Stats))
+    for _, st := range res.Stats {
+        items = append(items, helper.ToCouponStatsItem(st))
+    }
+
+    return &types.GetCouponStatsResponse{
+        StatusCode: res.StatusCode,
+        StatusMsg:  res.StatusMsg,
+        Stats:      items,
+    }, nil
+}
@@ -2,6 +2,7 @@ package helper
 
 import (
     "NatsumeAI/app/api/coupon/internal/types"
+    proto "NatsumeAI/app/services/coupon/coupon"
     couponsvc "NatsumeAI/app/services/coupon/couponservice"
 )
 
@@ -22,6 +23,204 @@ func ToCouponItem(in *couponsvc.CouponInfo) *types.CouponItem {
         EndAt:           in.EndAt,
         Source:          in.Source,
         Remarks:         in.Remarks,
+        Scope:           toCouponScope(in.Scope),
+        IssuerType:      int32(in.IssuerType),
+        IssuerId:        in.IssuerId,
     }
 }
 
+func toCouponScope(in *couponsvc.CouponScope) *types.CouponScope {
+    if in == nil {
+        return nil
+    }
+    return &types.CouponScope{
+        IncludeProductIds: in.IncludeProductIds,
+        ExcludeProductIds: in.ExcludeProductIds,
+        IncludeCategories: in.IncludeCategories,
+        ExcludeCategories: in.ExcludeCategories,
+        MerchantId:        in.MerchantId,
+    }
+}
+
+// ToScopeProto 将接口层的适用范围转换为 rpc 请求参数
+func ToScopeProto(in *types.CouponScope) *couponsvc.CouponScope {
+    if in == nil {
+        return nil
+    }
+    return &couponsvc.CouponScope{
+        IncludeProductIds: in.IncludeProductIds,
+        ExcludeProductIds: in.ExcludeProductIds,
+        IncludeCategories: in.IncludeCategories,
+        ExcludeCategories: in.ExcludeCategories,
+        MerchantId:        in.MerchantId,
+    }
+}
+
+
+// ToPublishCouponProto 将发券请求转换为 rpc 请求参数，出资方由调用方填写
+func ToPublishCouponProto(req *types.PublishCouponRequest) *couponsvc.PublishCouponReq {
+    return &couponsvc.PublishCouponReq{
+        CouponType:      proto.CouponType(req.CouponType),
+        DiscountAmount:  req.DiscountAmount,
+        DiscountPercent: int32(req.DiscountPercent),
+        MinSpendAmount:  req.MinSpendAmount,
+        TotalIssue:      req.TotalIssue,
+        PerUserLimit:    req.PerUserLimit,
+        StartAt:         req.StartAt,
+        EndAt:           req.EndAt,
+        Source:          req.Source,
+        Remarks:         req.Remarks,
+        Scope:           ToScopeProto(req.Scope),
+    }
+}
+
+func ToCouponTemplateItem(in *couponsvc.CouponTemplate) types.CouponTemplateItem {
+    if in == nil {
+        return types.CouponTemplateItem{}
+    }
+    return types.CouponTemplateItem{
+        CouponId:        in.CouponId,
+        CouponType:      int32(in.CouponType),
+        DiscountAmount:  in.DiscountAmount,
+        DiscountPercent: in.DiscountPercent,
+        MinSpendAmount:  in.MinSpendAmount,
+        TotalIssue:      in.TotalIssue,
+        PerUserLimit:    in.PerUserLimit,
+        IssuedQuantity:  in.IssuedQuantity,
+        StartAt:         in.StartAt,
+        EndAt:           in.EndAt,
+        Source:          in.Source,
+        Remarks:         in.Remarks,
+        Scope:           toCouponScope(in.Scope),
+        IssuerType:      int32(in.IssuerType),
+        IssuerId:        in.IssuerId,
+        RevokedAt:       in.RevokedAt,
+    }
+}
+
+func ToCampaignItem(in *couponsvc.CampaignInfo) types.CampaignItem {
+    if in == nil {
+        return types.CampaignItem{}
+    }
+    item := types.CampaignItem{
+        CampaignId:   in.CampaignId,
+        Name:         in.Name,
+        CouponId:     in.CouponId,
+        Trigger:      int32(in.Trigger),
+        Status:       int32(in.Status),
+        StartAt:      in.StartAt,
+        EndAt:        in.EndAt,
+        ScannedCount: in.ScannedCount,
+        IssuedCount:  in.IssuedCount,
+        SkippedCount: in.SkippedCount,
+        FailedCount:  in.FailedCount,
+        LastError:    in.LastError,
+        FinishedAt:   in.FinishedAt,
+        CreatedAt:    in.CreatedAt,
+    }
+    if in.Segment != nil {
+        item.Segment = &types.CampaignSegment{
+            MinOrderCount:    in.Segment.MinOrderCount,
+            MaxOrderCount:    in.Segment.MaxOrderCount,
+            MinSpend:         in.Segment.MinSpend,
+            ActiveWithinDays: in.Segment.ActiveWithinDays,
+        }
+    }
+    return item
+}
+
+func ToSegmentProto(in *types.CampaignSegment) *couponsvc.CampaignSegment {
+    if in == nil {
+        return nil
+    }
+    return &couponsvc.CampaignSegment{
+        MinOrderCount:    in.MinOrderCount,
+        MaxOrderCount:    in.MaxOrderCount,
+        MinSpend:         in.MinSpend,
+        ActiveWithinDays: in.ActiveWithinDays,
+    }
+}
+
+func ToCodeBatchItem(in *couponsvc.CodeBatchInfo) types.CodeBatchItem {
+    if in == nil {
+        return types.CodeBatchItem{}
+    }
+    return types.CodeBatchItem{
+        BatchId:       in.BatchId,
+        CouponId:      in.CouponId,
+        Name:          in.Name,
+        CodeType:      int32(in.CodeType),
+        SharedCode:    in.SharedCode,
+        TotalQuantity: in.TotalQuantity,
+        RedeemedCount: in.RedeemedCount,
+        Disabled:      in.Disabled,
+        CreatedAt:     in.CreatedAt,
+    }
+}
+
+func ToCouponStatsItem(in *couponsvc.CouponStats) types.CouponStatsItem {
+    if in == nil {
+        return types.CouponStatsItem{}
+    }
+    return types.CouponStatsItem{
+        CouponId:       in.CouponId,
+        TotalQuantity:  in.TotalQuantity,
+        IssuedQuantity: in.IssuedQuantity,
+        Claimed:        in.Claimed,
+        Unused:         in.Unused,
+        Locked:         in.Locked,
+        Used:           in.Used,
+        Expired:        in.Expired,
+        RedemptionRate: in.RedemptionRate,
+        DiscountAmount: in.DiscountAmount,
+        Gmv:            in.Gmv,
+        OrderCount:     in.OrderCount,
+    }
+}
+
+func ToPromotionItem(in *couponsvc.PromotionInfo) types.PromotionItem {
+    if in == nil {
+        return types.PromotionItem{}
+    }
+    item := types.PromotionItem{
+        PromotionId:     in.PromotionId,
+        Name:            in.Name,
+        PromoType:       int32(in.PromoType),
+        FundedBy:        int32(in.FundedBy),
+        MerchantId:      in.MerchantId,
+        MutexGroup:      in.MutexGroup,
+        StackWithCoupon: in.StackWithCoupon,
+        Priority:        in.Priority,
+        Disabled:        in.Disabled,
+        StartAt:         in.StartAt,
+        EndAt:           in.EndAt,
+        CreatedAt:       in.CreatedAt,
+    }
+    if in.Rule != nil {
+        item.Rule.BundlePrice = in.Rule.BundlePrice
+        item.Rule.ProductIds = in.Rule.ProductIds
+        item.Rule.Categories = in.Rule.Categories
+        for _, t := range in.Rule.Tiers {
+            item.Rule.Tiers = append(item.Rule.Tiers, types.PromotionTier{Threshold: t.Threshold, Discount: t.Discount})
+        }
+        for _, s := range in.Rule.Steps {
+            item.Rule.Steps = append(item.Rule.Steps, types.MultiBuyStep{MinQuantity: s.MinQuantity, OffPercent: s.OffPercent})
+        }
+    }
+    return item
+}
+
+func ToPromotionRuleProto(in types.PromotionRule) *couponsvc.PromotionRule {
+    out := &couponsvc.PromotionRule{
+        BundlePrice: in.BundlePrice,
+        ProductIds:  in.ProductIds,
+        Categories:  in.Categories,
+    }
+    for _, t := range in.Tiers {
+        out.Tiers = append(out.Tiers, &couponsvc.PromotionTier{Threshold: t.Threshold, Discount: t.Discount})
+    }
+    for _, s := range in.Steps {
+        out.Steps = append(out.Steps, &couponsvc.MultiBuyStep{MinQuantity: s.MinQuantity, OffPercent: s.OffPercent})
+    }
+    return out
+}
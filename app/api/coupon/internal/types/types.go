@@ -3,28 +3,230 @@
 
 package types
 
+type CampaignItem struct {
+	CampaignId   int64            `json:"campaignId"`
+	Name         string           `json:"name"`
+	CouponId     int64            `json:"couponId"`
+	Trigger      int32            `json:"trigger"` // 1:注册 2:首单 3:生日 4:人群
+	Segment      *CampaignSegment `json:"segment,omitempty"`
+	Status       int32            `json:"status"` // 1:待执行 2:进行中 3:已完成 4:已取消
+	StartAt      int64            `json:"startAt"`
+	EndAt        int64            `json:"endAt"`
+	ScannedCount int64            `json:"scannedCount"`
+	IssuedCount  int64            `json:"issuedCount"`
+	SkippedCount int64            `json:"skippedCount"`
+	FailedCount  int64            `json:"failedCount"`
+	LastError    string           `json:"lastError,omitempty"`
+	FinishedAt   int64            `json:"finishedAt,omitempty"`
+	CreatedAt    int64            `json:"createdAt"`
+}
+
+type CampaignSegment struct {
+	MinOrderCount    int64 `json:"minOrderCount,optional"`
+	MaxOrderCount    int64 `json:"maxOrderCount,optional"` // -1 表示未下过单
+	MinSpend         int64 `json:"minSpend,optional"`      // 分
+	ActiveWithinDays int32 `json:"activeWithinDays,optional"`
+}
+
+type CancelCampaignRequest struct {
+	CampaignId int64 `json:"campaignId"`
+}
+
 type ClaimCouponRequest struct {
 	CampaignId int64 `json:"campaignId"`
 }
 
+type CodeBatchItem struct {
+	BatchId       int64  `json:"batchId"`
+	CouponId      int64  `json:"couponId"`
+	Name          string `json:"name"`
+	CodeType      int32  `json:"codeType"` // 1:公共码 2:一次性码
+	SharedCode    string `json:"sharedCode,omitempty"`
+	TotalQuantity int64  `json:"totalQuantity"`
+	RedeemedCount int64  `json:"redeemedCount"`
+	Disabled      bool   `json:"disabled"`
+	CreatedAt     int64  `json:"createdAt"`
+}
+
+type CodeBatchRequest struct {
+	BatchId int64 `path:"batchId"`
+}
+
+type CodeBatchResponse struct {
+	StatusCode int32         `json:"statusCode"`
+	StatusMsg  string        `json:"statusMsg"`
+	Batch      CodeBatchItem `json:"batch"`
+}
+
 type CouponActionResponse struct {
 	StatusCode int32  `json:"statusCode"`
 	StatusMsg  string `json:"statusMsg"`
 }
 
 type CouponItem struct {
-	CouponId        int64  `json:"couponId"`   // 实例ID（用户持有的券）
-	CouponType      int32  `json:"couponType"` // 1:现金券 2:折扣券
-	Status          int32  `json:"status"`     // 1:未使用 2:已锁定 3:已使用 4:已过期
-	DiscountAmount  int64  `json:"discountAmount"`
-	DiscountPercent int32  `json:"discountPercent"`
-	MinSpendAmount  int64  `json:"minSpendAmount"`
-	UserId          int64  `json:"userId"`
-	LockedPreorder  int64  `json:"lockedPreorder"`
-	StartAt         int64  `json:"startAt"`
-	EndAt           int64  `json:"endAt"`
-	Source          string `json:"source"`
-	Remarks         string `json:"remarks"`
+	CouponId        int64        `json:"couponId"`   // 实例ID（用户持有的券）
+	CouponType      int32        `json:"couponType"` // 1:现金券 2:折扣券
+	Status          int32        `json:"status"`     // 1:未使用 2:已锁定 3:已使用 4:已过期
+	DiscountAmount  int64        `json:"discountAmount"`
+	DiscountPercent int32        `json:"discountPercent"`
+	MinSpendAmount  int64        `json:"minSpendAmount"`
+	UserId          int64        `json:"userId"`
+	LockedPreorder  int64        `json:"lockedPreorder"`
+	StartAt         int64        `json:"startAt"`
+	EndAt           int64        `json:"endAt"`
+	Source          string       `json:"source"`
+	Remarks         string       `json:"remarks"`
+	Scope           *CouponScope `json:"scope,omitempty"` // 适用范围，全场券为空
+	IssuerType      int32        `json:"issuerType"`      // 出资方 1:平台 2:商家
+	IssuerId        int64        `json:"issuerId"`        // 出资商家ID，平台券为0
+}
+
+type CouponScope struct {
+	IncludeProductIds []int64  `json:"includeProductIds,optional"`
+	ExcludeProductIds []int64  `json:"excludeProductIds,optional"`
+	IncludeCategories []string `json:"includeCategories,optional"`
+	ExcludeCategories []string `json:"excludeCategories,optional"`
+	MerchantId        int64    `json:"merchantId,optional"` // 仅限该商家商品，0 表示不限
+}
+
+type CouponStatsItem struct {
+	CouponId       int64   `json:"couponId"`
+	TotalQuantity  int64   `json:"totalQuantity"`
+	IssuedQuantity int64   `json:"issuedQuantity"`
+	Claimed        int64   `json:"claimed"`
+	Unused         int64   `json:"unused"`
+	Locked         int64   `json:"locked"`
+	Used           int64   `json:"used"`
+	Expired        int64   `json:"expired"`
+	RedemptionRate float64 `json:"redemptionRate"`
+	DiscountAmount int64   `json:"discountAmount"`
+	Gmv            int64   `json:"gmv"`
+	OrderCount     int64   `json:"orderCount"`
+}
+
+type CouponTemplateItem struct {
+	CouponId        int64        `json:"couponId"`
+	CouponType      int32        `json:"couponType"`
+	DiscountAmount  int64        `json:"discountAmount"`
+	DiscountPercent int32        `json:"discountPercent"`
+	MinSpendAmount  int64        `json:"minSpendAmount"`
+	TotalIssue      int64        `json:"totalIssue"`
+	PerUserLimit    int64        `json:"perUserLimit"`
+	IssuedQuantity  int64        `json:"issuedQuantity"`
+	StartAt         int64        `json:"startAt"`
+	EndAt           int64        `json:"endAt"`
+	Source          string       `json:"source"`
+	Remarks         string       `json:"remarks"`
+	Scope           *CouponScope `json:"scope,omitempty"`
+	IssuerType      int32        `json:"issuerType"`
+	IssuerId        int64        `json:"issuerId"`
+	RevokedAt       int64        `json:"revokedAt"` // 0 表示未撤销
+}
+
+type CreateCampaignRequest struct {
+	Name     string           `json:"name"`
+	CouponId int64            `json:"couponId"`
+	Trigger  int32            `json:"trigger"`
+	Segment  *CampaignSegment `json:"segment,optional"` // 仅人群活动使用
+	StartAt  int64            `json:"startAt"`          // 秒级时间戳，人群活动为计划执行时间
+	EndAt    int64            `json:"endAt"`
+}
+
+type CreateCampaignResponse struct {
+	StatusCode int32  `json:"statusCode"`
+	StatusMsg  string `json:"statusMsg"`
+	CampaignId int64  `json:"campaignId"`
+}
+
+type CreateCodeBatchRequest struct {
+	CouponId   int64  `json:"couponId"`
+	Name       string `json:"name"`
+	CodeType   int32  `json:"codeType"`
+	SharedCode string `json:"sharedCode,optional"` // 公共码自定义内容，不传随机生成
+	Quantity   int64  `json:"quantity"`            // 公共码为兑换次数上限，一次性码为生成数量
+}
+
+type CreatePromotionRequest struct {
+	Name            string        `json:"name"`
+	PromoType       int32         `json:"promoType"`
+	FundedBy        int32         `json:"fundedBy"`
+	MerchantId      int64         `json:"merchantId,optional"` // 商家出资必填
+	Rule            PromotionRule `json:"rule"`
+	MutexGroup      string        `json:"mutexGroup,optional"` // 同组活动只取优惠最大的一个
+	StackWithCoupon bool          `json:"stackWithCoupon,optional"`
+	Priority        int32         `json:"priority,optional"` // 越大越先计算
+	StartAt         int64         `json:"startAt"`
+	EndAt           int64         `json:"endAt"`
+}
+
+type DisableCodeBatchRequest struct {
+	BatchId int64 `json:"batchId"`
+}
+
+type DisablePromotionRequest struct {
+	PromotionId int64 `json:"promotionId"`
+}
+
+type GetCampaignRequest struct {
+	CampaignId int64 `path:"campaignId"`
+}
+
+type GetCampaignResponse struct {
+	StatusCode int32        `json:"statusCode"`
+	StatusMsg  string       `json:"statusMsg"`
+	Campaign   CampaignItem `json:"campaign"`
+}
+
+type GetCouponStatsRequest struct {
+	CouponIds []int64 `form:"couponIds"` // 最多 50 个
+	StartAt   int64   `form:"startAt,optional"`
+	EndAt     int64   `form:"endAt,optional"`
+}
+
+type GetCouponStatsResponse struct {
+	StatusCode int32             `json:"statusCode"`
+	StatusMsg  string            `json:"statusMsg"`
+	Stats      []CouponStatsItem `json:"stats"`
+}
+
+type ListCampaignsRequest struct {
+	Trigger  int32 `form:"trigger,optional"`
+	Status   int32 `form:"status,optional"`
+	Page     int32 `form:"page,optional"`
+	PageSize int32 `form:"pageSize,optional"`
+}
+
+type ListCampaignsResponse struct {
+	StatusCode int32          `json:"statusCode"`
+	StatusMsg  string         `json:"statusMsg"`
+	Campaigns  []CampaignItem `json:"campaigns"`
+	Total      int64          `json:"total"`
+}
+
+type ListCouponTemplatesRequest struct {
+	Page     int32 `form:"page,optional"`
+	PageSize int32 `form:"pageSize,optional"`
+}
+
+type ListCouponTemplatesResponse struct {
+	StatusCode int32                `json:"statusCode"`
+	StatusMsg  string               `json:"statusMsg"`
+	Templates  []CouponTemplateItem `json:"templates"`
+	Total      int64                `json:"total"`
+}
+
+type ListPromotionsRequest struct {
+	MerchantId int64 `form:"merchantId,default=-1"` // -1 不过滤，0 平台活动
+	ActiveOnly bool  `form:"activeOnly,optional"`
+	Page       int32 `form:"page,optional"`
+	PageSize   int32 `form:"pageSize,optional"`
+}
+
+type ListPromotionsResponse struct {
+	StatusCode int32           `json:"statusCode"`
+	StatusMsg  string          `json:"statusMsg"`
+	Promotions []PromotionItem `json:"promotions"`
+	Total      int64           `json:"total"`
 }
 
 type ListUserCouponsRequest struct {
@@ -40,17 +242,58 @@ type ListUserCouponsResponse struct {
 	Total      int64        `json:"total"`
 }
 
+type MultiBuyStep struct {
+	MinQuantity int64 `json:"minQuantity"`
+	OffPercent  int32 `json:"offPercent"` // 优惠百分比，20 即 8 折
+}
+
+type PromotionItem struct {
+	PromotionId     int64         `json:"promotionId"`
+	Name            string        `json:"name"`
+	PromoType       int32         `json:"promoType"` // 1:满减 2:多件折扣 3:组合价
+	FundedBy        int32         `json:"fundedBy"`  // 1:平台 2:商家
+	MerchantId      int64         `json:"merchantId"`
+	Rule            PromotionRule `json:"rule"`
+	MutexGroup      string        `json:"mutexGroup"`
+	StackWithCoupon bool          `json:"stackWithCoupon"`
+	Priority        int32         `json:"priority"`
+	Disabled        bool          `json:"disabled"`
+	StartAt         int64         `json:"startAt"`
+	EndAt           int64         `json:"endAt"`
+	CreatedAt       int64         `json:"createdAt"`
+}
+
+type PromotionResponse struct {
+	StatusCode int32         `json:"statusCode"`
+	StatusMsg  string        `json:"statusMsg"`
+	Promotion  PromotionItem `json:"promotion"`
+}
+
+type PromotionRule struct {
+	Tiers       []PromotionTier `json:"tiers,optional"`       // 满减
+	Steps       []MultiBuyStep  `json:"steps,optional"`       // 多件折扣
+	BundlePrice int64           `json:"bundlePrice,optional"` // 组合价(分)
+	ProductIds  []int64         `json:"productIds,optional"`
+	Categories  []string        `json:"categories,optional"`
+}
+
+type PromotionTier struct {
+	Threshold int64 `json:"threshold"` // 满(分)
+	Discount  int64 `json:"discount"`  // 减(分)
+}
+
 type PublishCouponRequest struct {
-	CouponType      int32  `json:"couponType"` // 1:现金券 2:折扣券
-	DiscountAmount  int64  `json:"discountAmount"`
-	DiscountPercent int32  `json:"discountPercent"`
-	MinSpendAmount  int64  `json:"minSpendAmount"`
-	TotalIssue      int64  `json:"totalIssue"`
-	PerUserLimit    int64  `json:"perUserLimit"`
-	StartAt         int64  `json:"startAt"` // 秒级时间戳
-	EndAt           int64  `json:"endAt"`
-	Source          string `json:"source"`
-	Remarks         string `json:"remarks"`
+	CouponType      int32        `json:"couponType"` // 1:现金券 2:折扣券
+	DiscountAmount  int64        `json:"discountAmount"`
+	DiscountPercent int32        `json:"discountPercent"`
+	MinSpendAmount  int64        `json:"minSpendAmount"`
+	TotalIssue      int64        `json:"totalIssue"`
+	PerUserLimit    int64        `json:"perUserLimit"`
+	StartAt         int64        `json:"startAt"` // 秒级时间戳
+	EndAt           int64        `json:"endAt"`
+	Source          string       `json:"source"`
+	Remarks         string       `json:"remarks"`
+	Scope           *CouponScope `json:"scope,optional"`
 }
 
 type PublishCouponResponse struct {
@@ -58,3 +301,25 @@ type PublishCouponResponse struct {
 	StatusMsg  string `json:"statusMsg"`
 	CampaignId int64  `json:"campaignId"`
 }
+
+type RedeemCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type RedeemCodeResponse struct {
+	StatusCode int32       `json:"statusCode"`
+	StatusMsg  string      `json:"statusMsg"`
+	Coupon     *CouponItem `json:"coupon,omitempty"`
+}
+
+type RevokeCouponRequest struct {
+	CouponId int64 `json:"couponId"`
+}
+
+type UpdateCouponRequest struct {
+	CouponId     int64  `json:"couponId"`
+	TotalIssue   int64  `json:"totalIssue"` // 0 不限
+	PerUserLimit int64  `json:"perUserLimit"`
+	EndAt        int64  `json:"endAt"`
+	Remarks      string `json:"remarks,optional"`
+}
@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package presale
+
+import (
+	"net/http"
+
+	"NatsumeAI/app/api/order/internal/logic/presale"
+	"NatsumeAI/app/api/order/internal/svc"
+	"NatsumeAI/app/api/order/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func PlacePresaleDepositHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PlacePresaleDepositRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := presale.NewPlacePresaleDepositLogic(r.Context(), svcCtx)
+		resp, err := l.PlacePresaleDeposit(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"net/http"
 
 	order "NatsumeAI/app/api/order/internal/handler/order"
+	presale "NatsumeAI/app/api/order/internal/handler/presale"
+	presale_manage "NatsumeAI/app/api/order/internal/handler/presale_manage"
 	"NatsumeAI/app/api/order/internal/svc"
 
 	"github.com/zeromicro/go-zero/rest"
@@ -32,6 +34,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/v1/order/checkout",
 					Handler: order.CheckoutHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/order/complete",
+					Handler: order.CompleteOrderHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodGet,
 					Path:    "/api/v1/order/detail",
@@ -45,4 +52,50 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 			}...,
 		),
 	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/order/presale/balance",
+					Handler: presale.PlacePresaleBalanceHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/order/presale/cancel",
+					Handler: presale.CancelPresaleOrderHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/order/presale/deposit",
+					Handler: presale.PlacePresaleDepositHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/order/presale/detail",
+					Handler: presale.GetPresaleOrderHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/order/presale/campaigns",
+					Handler: presale_manage.CreatePresaleCampaignHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/order/presale/campaigns/cancel",
+					Handler: presale_manage.CancelPresaleCampaignHandler(serverCtx),
+				},
+			}...,
+		),
+	)
 }
@@ -49,9 +49,33 @@ func ToOrderInfo(src *ordersrv.OrderInfo) types.OrderInfo {
         Items:            items,
         Payment_method:   src.PaymentMethod,
         Address_snapshot: src.AddressSnapshot,
+        Order_type:       src.OrderType,
+        Completed_at:     src.CompletedAt,
+        Currency:         src.Currency,
+        Price_currency:   src.PriceCurrency,
+        Fx_rate:          src.FxRate,
+        Promotion_amount: src.PromotionAmount,
+        Promotions:       ToOrderPromotions(src.Promotions),
     }
 }
 
+func ToOrderPromotions(list []*ordersrv.OrderPromotion) []types.OrderPromotion {
+    out := make([]types.OrderPromotion, 0, len(list))
+    for _, p := range list {
+        if p == nil {
+            continue
+        }
+        out = append(out, types.OrderPromotion{
+            Promotion_id:    p.PromotionId,
+            Name:            p.Name,
+            Description:     p.Description,
+            Discount_amount: p.DiscountAmount,
+            Funded_by:       p.FundedBy,
+        })
+    }
+    return out
+}
+
 func ToOrderInfos(list []*ordersrv.OrderInfo) []types.OrderInfo {
     if len(list) == 0 {
         return nil
@@ -63,3 +87,46 @@ func ToOrderInfos(list []*ordersrv.OrderInfo) []types.OrderInfo {
     return res
 }
 
+
+func ToPresaleCampaign(src *ordersrv.PresaleCampaign) types.PresaleCampaign {
+    if src == nil {
+        return types.PresaleCampaign{}
+    }
+    return types.PresaleCampaign{
+        Campaign_id:        src.CampaignId,
+        Merchant_id:        src.MerchantId,
+        Product_id:         src.ProductId,
+        Title:              src.Title,
+        Presale_price:      src.PresalePrice,
+        Deposit_amount:     src.DepositAmount,
+        Deposit_deduction:  src.DepositDeduction,
+        Total_quantity:     src.TotalQuantity,
+        Reserved_quantity:  src.ReservedQuantity,
+        Per_user_limit:     src.PerUserLimit,
+        Deposit_start_at:   src.DepositStartAt,
+        Deposit_end_at:     src.DepositEndAt,
+        Balance_start_at:   src.BalanceStartAt,
+        Balance_end_at:     src.BalanceEndAt,
+        Deposit_refundable: src.DepositRefundable,
+        Status:             src.Status,
+    }
+}
+
+func ToPresaleOrderInfo(src *ordersrv.PresaleOrderInfo) types.PresaleOrderInfo {
+    if src == nil {
+        return types.PresaleOrderInfo{}
+    }
+    return types.PresaleOrderInfo{
+        Presale_id:       src.PresaleId,
+        User_id:          src.UserId,
+        Quantity:         src.Quantity,
+        Deposit_amount:   src.DepositAmount,
+        Balance_amount:   src.BalanceAmount,
+        Deposit_order_id: src.DepositOrderId,
+        Balance_order_id: src.BalanceOrderId,
+        Status:           int32(src.Status),
+        Cancel_reason:    src.CancelReason,
+        Created_at:       src.CreatedAt,
+        Campaign:         ToPresaleCampaign(src.Campaign),
+    }
+}
@@ -6,6 +6,7 @@ package order
 import (
     "context"
 
+    "NatsumeAI/app/api/order/internal/logic/helper"
     "NatsumeAI/app/api/order/internal/svc"
     "NatsumeAI/app/api/order/internal/types"
     "NatsumeAI/app/common/util"
@@ -34,6 +35,7 @@ func (l *CheckoutLogic) Checkout(req *types.CheckoutRequest) (resp *types.Checko
     in := &orderservice.CheckoutReq{
         UserId:   uid,
         CouponId: req.Coupon_id,
+        Currency: req.Currency,
         Item: &orderservice.Item{
             ProductId: req.Item.Product_id,
             Quantity:  req.Item.Quantity,
@@ -44,9 +46,14 @@ func (l *CheckoutLogic) Checkout(req *types.CheckoutRequest) (resp *types.Checko
         return nil, err
     }
     return &types.CheckoutResponse{
-        Status_code: out.StatusCode,
-        Status_msg:  out.StatusMsg,
-        Preorder_id: out.PreorderId,
-        Expired_at:  out.ExpiredAt,
+        Status_code:      out.StatusCode,
+        Status_msg:       out.StatusMsg,
+        Preorder_id:      out.PreorderId,
+        Expired_at:       out.ExpiredAt,
+        Original_amount:  out.OriginalAmount,
+        Promotion_amount: out.PromotionAmount,
+        Coupon_amount:    out.CouponAmount,
+        Final_amount:     out.FinalAmount,
+        Promotions:       helper.ToOrderPromotions(out.Promotions),
     }, nil
 }
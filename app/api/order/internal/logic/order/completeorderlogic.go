@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package order
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/order/internal/svc"
+	"NatsumeAI/app/api/order/internal/types"
+	"NatsumeAI/app/common/util"
+	"NatsumeAI/app/services/order/orderservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CompleteOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCompleteOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CompleteOrderLogic {
+	return &CompleteOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CompleteOrderLogic) CompleteOrder(req *types.CompleteOrderRequest) (resp *types.CompleteOrderResponse, err error) {
+	uid, _ := util.UserIdFromCtx(l.ctx)
+	out, err := l.svcCtx.OrderRpc.CompleteOrder(l.ctx, &orderservice.CompleteOrderReq{
+		OrderId: req.Order_id,
+		UserId:  uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CompleteOrderResponse{
+		Status_code: out.StatusCode,
+		Status_msg:  out.StatusMsg,
+		Status:      int32(out.Status),
+	}, nil
+}
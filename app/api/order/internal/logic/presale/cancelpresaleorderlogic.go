@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package presale
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/order/internal/svc"
+    "NatsumeAI/app/api/order/internal/types"
+    "NatsumeAI/app/common/util"
+    "NatsumeAI/app/services/order/orderservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelPresaleOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelPresaleOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelPresaleOrderLogic {
+	return &CancelPresaleOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelPresaleOrderLogic) CancelPresaleOrder(req *types.CancelPresaleOrderRequest) (resp *types.CancelPresaleOrderResponse, err error) {
+    uid, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+    out, err := l.svcCtx.OrderRpc.CancelPresaleOrder(l.ctx, &orderservice.CancelPresaleOrderReq{
+        UserId:    uid,
+        PresaleId: req.Presale_id,
+        Reason:    req.Reason,
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &types.CancelPresaleOrderResponse{
+        Status_code: out.StatusCode,
+        Status_msg:  out.StatusMsg,
+        Status:      int32(out.Status),
+    }, nil
+}
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package presale
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/order/internal/logic/helper"
+    "NatsumeAI/app/api/order/internal/svc"
+    "NatsumeAI/app/api/order/internal/types"
+    "NatsumeAI/app/common/util"
+    "NatsumeAI/app/services/order/orderservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPresaleOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPresaleOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPresaleOrderLogic {
+	return &GetPresaleOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPresaleOrderLogic) GetPresaleOrder(req *types.GetPresaleOrderRequest) (resp *types.GetPresaleOrderResponse, err error) {
+    uid, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+    out, err := l.svcCtx.OrderRpc.GetPresaleOrder(l.ctx, &orderservice.GetPresaleOrderReq{
+        UserId:    uid,
+        PresaleId: req.Presale_id,
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &types.GetPresaleOrderResponse{
+        Status_code: out.StatusCode,
+        Status_msg:  out.StatusMsg,
+        Presale:     helper.ToPresaleOrderInfo(out.Presale),
+    }, nil
+}
@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package presale
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/order/internal/svc"
+    "NatsumeAI/app/api/order/internal/types"
+    "NatsumeAI/app/common/util"
+    "NatsumeAI/app/services/order/orderservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+)
+
+type PlacePresaleBalanceLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPlacePresaleBalanceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PlacePresaleBalanceLogic {
+	return &PlacePresaleBalanceLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PlacePresaleBalanceLogic) PlacePresaleBalance(req *types.PlacePresaleBalanceRequest) (resp *types.PlacePresaleBalanceResponse, err error) {
+    uid, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+    out, err := l.svcCtx.OrderRpc.PlacePresaleBalance(l.ctx, &orderservice.PlacePresaleBalanceReq{
+        UserId:    uid,
+        PresaleId: req.Presale_id,
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &types.PlacePresaleBalanceResponse{
+        Status_code:      out.StatusCode,
+        Status_msg:       out.StatusMsg,
+        Balance_order_id: out.BalanceOrderId,
+        Balance_amount:   out.BalanceAmount,
+        Expired_at:       out.ExpiredAt,
+    }, nil
+}
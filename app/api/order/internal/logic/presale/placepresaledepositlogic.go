@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package presale
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/order/internal/svc"
+    "NatsumeAI/app/api/order/internal/types"
+    "NatsumeAI/app/common/util"
+    "NatsumeAI/app/services/order/orderservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+)
+
+type PlacePresaleDepositLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPlacePresaleDepositLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PlacePresaleDepositLogic {
+	return &PlacePresaleDepositLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PlacePresaleDepositLogic) PlacePresaleDeposit(req *types.PlacePresaleDepositRequest) (resp *types.PlacePresaleDepositResponse, err error) {
+    uid, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+    out, err := l.svcCtx.OrderRpc.PlacePresaleDeposit(l.ctx, &orderservice.PlacePresaleDepositReq{
+        UserId:     uid,
+        CampaignId: req.Campaign_id,
+        Quantity:   req.Quantity,
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &types.PlacePresaleDepositResponse{
+        Status_code:      out.StatusCode,
+        Status_msg:       out.StatusMsg,
+        Presale_id:       out.PresaleId,
+        Deposit_order_id: out.DepositOrderId,
+        Deposit_amount:   out.DepositAmount,
+        Expired_at:       out.ExpiredAt,
+    }, nil
+}
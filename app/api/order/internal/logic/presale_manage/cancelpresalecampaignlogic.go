@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package presale_manage
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/order/internal/svc"
+    "NatsumeAI/app/api/order/internal/types"
+    "NatsumeAI/app/common/util"
+    "NatsumeAI/app/services/order/orderservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelPresaleCampaignLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelPresaleCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelPresaleCampaignLogic {
+	return &CancelPresaleCampaignLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelPresaleCampaignLogic) CancelPresaleCampaign(req *types.CancelPresaleCampaignRequest) (resp *types.CancelPresaleCampaignResponse, err error) {
+    merchantID, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+    out, err := l.svcCtx.OrderRpc.CancelPresaleCampaign(l.ctx, &orderservice.CancelPresaleCampaignReq{
+        MerchantId: merchantID,
+        CampaignId: req.Campaign_id,
+        Reason:     req.Reason,
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &types.CancelPresaleCampaignResponse{
+        Status_code: out.StatusCode,
+        Status_msg:  out.StatusMsg,
+    }, nil
+}
@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package presale_manage
+
+import (
+    "context"
+
+    "NatsumeAI/app/api/order/internal/logic/helper"
+    "NatsumeAI/app/api/order/internal/svc"
+    "NatsumeAI/app/api/order/internal/types"
+    "NatsumeAI/app/common/util"
+    "NatsumeAI/app/services/order/orderservice"
+
+    "github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePresaleCampaignLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreatePresaleCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePresaleCampaignLogic {
+	return &CreatePresaleCampaignLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreatePresaleCampaignLogic) CreatePresaleCampaign(req *types.CreatePresaleCampaignRequest) (resp *types.CreatePresaleCampaignResponse, err error) {
+    merchantID, err := util.UserIdFromCtx(l.ctx)
+    if err != nil {
+        return nil, err
+    }
+    out, err := l.svcCtx.OrderRpc.CreatePresaleCampaign(l.ctx, &orderservice.CreatePresaleCampaignReq{
+        MerchantId:        merchantID,
+        ProductId:         req.Product_id,
+        Title:             req.Title,
+        PresalePrice:      req.Presale_price,
+        DepositAmount:     req.Deposit_amount,
+        DepositDeduction:  req.Deposit_deduction,
+        TotalQuantity:     req.Total_quantity,
+        PerUserLimit:      req.Per_user_limit,
+        DepositStartAt:    req.Deposit_start_at,
+        DepositEndAt:      req.Deposit_end_at,
+        BalanceStartAt:    req.Balance_start_at,
+        BalanceEndAt:      req.Balance_end_at,
+        DepositRefundable: req.Deposit_refundable,
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &types.CreatePresaleCampaignResponse{
+        Status_code: out.StatusCode,
+        Status_msg:  out.StatusMsg,
+        Campaign:    helper.ToPresaleCampaign(out.Campaign),
+    }, nil
+}
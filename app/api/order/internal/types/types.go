@@ -15,16 +15,74 @@ type CancelOrderResponse struct {
 	Status      int32  `json:"status"` // OrderStatus enum value
 }
 
+type CancelPresaleCampaignRequest struct {
+	Campaign_id int64  `json:"campaign_id"`
+	Reason      string `json:"reason,optional"`
+}
+
+type CancelPresaleCampaignResponse struct {
+	Status_code int64  `json:"status_code"`
+	Status_msg  string `json:"status_msg"`
+}
+
+type CancelPresaleOrderRequest struct {
+	Presale_id int64  `json:"presale_id"`
+	Reason     string `json:"reason,optional"`
+}
+
+type CancelPresaleOrderResponse struct {
+	Status_code int64  `json:"status_code"`
+	Status_msg  string `json:"status_msg"`
+	Status      int32  `json:"status"` // PresaleStatus enum value
+}
+
 type CheckoutRequest struct {
-	Coupon_id int64 `json:"coupon_id,optional"`
-	Item      Item  `json:"item"`
+	Coupon_id int64  `json:"coupon_id,optional"`
+	Item      Item   `json:"item"`
+	Currency  string `json:"currency,optional"` // 支付币种，默认 CNY
 }
 
 type CheckoutResponse struct {
+	Status_code      int64            `json:"status_code"`
+	Status_msg       string           `json:"status_msg"`
+	Preorder_id      int64            `json:"preorder_id"`
+	Expired_at       int64            `json:"expired_at"`
+	Original_amount  int64            `json:"original_amount"`  // 商品原价合计(分)
+	Promotion_amount int64            `json:"promotion_amount"` // 促销优惠(分)
+	Coupon_amount    int64            `json:"coupon_amount"`    // 优惠券优惠(分)
+	Final_amount     int64            `json:"final_amount"`     // 应付金额(分)
+	Promotions       []OrderPromotion `json:"promotions"`
+}
+
+type CompleteOrderRequest struct {
+	Order_id int64 `json:"order_id"`
+}
+
+type CompleteOrderResponse struct {
 	Status_code int64  `json:"status_code"`
 	Status_msg  string `json:"status_msg"`
-	Preorder_id int64  `json:"preorder_id"`
-	Expired_at  int64  `json:"expired_at"`
+	Status      int32  `json:"status"` // OrderStatus enum value
+}
+
+type CreatePresaleCampaignRequest struct {
+	Product_id         int64  `json:"product_id"`
+	Title              string `json:"title,optional"`
+	Presale_price      int64  `json:"presale_price"`
+	Deposit_amount     int64  `json:"deposit_amount"`
+	Deposit_deduction  int64  `json:"deposit_deduction,optional"`
+	Total_quantity     int64  `json:"total_quantity,optional"`
+	Per_user_limit     int64  `json:"per_user_limit,optional"`
+	Deposit_start_at   int64  `json:"deposit_start_at"`
+	Deposit_end_at     int64  `json:"deposit_end_at"`
+	Balance_start_at   int64  `json:"balance_start_at"`
+	Balance_end_at     int64  `json:"balance_end_at"`
+	Deposit_refundable bool   `json:"deposit_refundable,optional"`
+}
+
+type CreatePresaleCampaignResponse struct {
+	Status_code int64           `json:"status_code"`
+	Status_msg  string          `json:"status_msg"`
+	Campaign    PresaleCampaign `json:"campaign"`
 }
 
 type GetOrderRequest struct {
@@ -37,6 +95,16 @@ type GetOrderResponse struct {
 	Order       OrderInfo `json:"order"`
 }
 
+type GetPresaleOrderRequest struct {
+	Presale_id int64 `form:"presale_id"`
+}
+
+type GetPresaleOrderResponse struct {
+	Status_code int64            `json:"status_code"`
+	Status_msg  string           `json:"status_msg"`
+	Presale     PresaleOrderInfo `json:"presale"`
+}
+
 type Item struct {
 	Product_id int64 `json:"product_id"`
 	Quantity   int64 `json:"quantity"`
@@ -56,18 +124,25 @@ type ListOrdersResponse struct {
 }
 
 type OrderInfo struct {
-	Order_id         int64       `json:"order_id"`
-	Preorder_id      int64       `json:"preorder_id"`
-	User_id          int64       `json:"user_id"`
-	Status           int32       `json:"status"` // OrderStatus enum value
-	Total_amount     int64       `json:"total_amount"`
-	Pay_amount       int64       `json:"pay_amount"`
-	Created_at       int64       `json:"created_at"`
-	Paid_at          int64       `json:"paid_at"`
-	Cancelled_at     int64       `json:"cancelled_at"`
-	Items            []OrderItem `json:"items"`
-	Payment_method   string      `json:"payment_method"`
-	Address_snapshot string      `json:"address_snapshot"`
+	Order_id         int64            `json:"order_id"`
+	Preorder_id      int64            `json:"preorder_id"`
+	User_id          int64            `json:"user_id"`
+	Status           int32            `json:"status"` // OrderStatus enum value
+	Total_amount     int64            `json:"total_amount"`
+	Pay_amount       int64            `json:"pay_amount"`
+	Created_at       int64            `json:"created_at"`
+	Paid_at          int64            `json:"paid_at"`
+	Cancelled_at     int64            `json:"cancelled_at"`
+	Items            []OrderItem      `json:"items"`
+	Payment_method   string           `json:"payment_method"`
+	Address_snapshot string           `json:"address_snapshot"`
+	Order_type       string           `json:"order_type"`
+	Completed_at     int64            `json:"completed_at"`
+	Currency         string           `json:"currency"`
+	Price_currency   string           `json:"price_currency"`
+	Fx_rate          int64            `json:"fx_rate"`          // 标价币种 → 支付币种汇率快照，放大 1e8 倍
+	Promotion_amount int64            `json:"promotion_amount"` // 促销优惠(分)，不含优惠券
+	Promotions       []OrderPromotion `json:"promotions"`
 }
 
 type OrderItem struct {
@@ -83,6 +158,14 @@ type OrderItemSnapshot struct {
 	Attributes  string `json:"attributes"`
 }
 
+type OrderPromotion struct {
+	Promotion_id    int64  `json:"promotion_id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"` // 如"满200减30"
+	Discount_amount int64  `json:"discount_amount"`
+	Funded_by       string `json:"funded_by"` // PLATFORM / MERCHANT
+}
+
 type PlaceOrderRequest struct {
 	Preorder_id int64  `json:"preorder_id"`
 	Address_id  int64  `json:"address_id,optional"`
@@ -96,3 +179,62 @@ type PlaceOrderResponse struct {
 	Order_id    int64  `json:"order_id"`
 	Status      int32  `json:"status"` // OrderStatus enum value
 }
+
+type PlacePresaleBalanceRequest struct {
+	Presale_id int64 `json:"presale_id"`
+}
+
+type PlacePresaleBalanceResponse struct {
+	Status_code      int64  `json:"status_code"`
+	Status_msg       string `json:"status_msg"`
+	Balance_order_id int64  `json:"balance_order_id"`
+	Balance_amount   int64  `json:"balance_amount"`
+	Expired_at       int64  `json:"expired_at"`
+}
+
+type PlacePresaleDepositRequest struct {
+	Campaign_id int64 `json:"campaign_id"`
+	Quantity    int64 `json:"quantity"`
+}
+
+type PlacePresaleDepositResponse struct {
+	Status_code      int64  `json:"status_code"`
+	Status_msg       string `json:"status_msg"`
+	Presale_id       int64  `json:"presale_id"`
+	Deposit_order_id int64  `json:"deposit_order_id"`
+	Deposit_amount   int64  `json:"deposit_amount"`
+	Expired_at       int64  `json:"expired_at"`
+}
+
+type PresaleCampaign struct {
+	Campaign_id        int64  `json:"campaign_id"`
+	Merchant_id        int64  `json:"merchant_id"`
+	Product_id         int64  `json:"product_id"`
+	Title              string `json:"title"`
+	Presale_price      int64  `json:"presale_price"`
+	Deposit_amount     int64  `json:"deposit_amount"`
+	Deposit_deduction  int64  `json:"deposit_deduction"`
+	Total_quantity     int64  `json:"total_quantity"`
+	Reserved_quantity  int64  `json:"reserved_quantity"`
+	Per_user_limit     int64  `json:"per_user_limit"`
+	Deposit_start_at   int64  `json:"deposit_start_at"`
+	Deposit_end_at     int64  `json:"deposit_end_at"`
+	Balance_start_at   int64  `json:"balance_start_at"`
+	Balance_end_at     int64  `json:"balance_end_at"`
+	Deposit_refundable bool   `json:"deposit_refundable"`
+	Status             string `json:"status"`
+}
+
+type PresaleOrderInfo struct {
+	Presale_id       int64           `json:"presale_id"`
+	User_id          int64           `json:"user_id"`
+	Quantity         int64           `json:"quantity"`
+	Deposit_amount   int64           `json:"deposit_amount"`
+	Balance_amount   int64           `json:"balance_amount"`
+	Deposit_order_id int64           `json:"deposit_order_id"`
+	Balance_order_id int64           `json:"balance_order_id"`
+	Status           int32           `json:"status"` // PresaleStatus enum value
+	Cancel_reason    string          `json:"cancel_reason"`
+	Created_at       int64           `json:"created_at"`
+	Campaign         PresaleCampaign `json:"campaign"`
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notify
+
+import (
+	"io"
+	"net/http"
+
+	"NatsumeAI/app/api/payment/internal/logic/notify"
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 渠道通知报文上限
+const maxNotifyBodyBytes = 64 << 10
+
+// 渠道通知需要原始报文验签，不能走 httpx.Parse（会消费表单/JSON body）
+func PaymentNotifyHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PaymentNotifyRequest
+		if err := httpx.ParsePath(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxNotifyBodyBytes))
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		headers := make(map[string]string, len(r.Header))
+		for k := range r.Header {
+			headers[k] = r.Header.Get(k)
+		}
+
+		l := notify.NewPaymentNotifyLogic(r.Context(), svcCtx)
+		status, ack, err := l.PaymentNotify(&req, headers, body)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(ack))
+	}
+}
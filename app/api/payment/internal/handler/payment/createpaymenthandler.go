@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package payment
+
+import (
+	"net/http"
+
+	"NatsumeAI/app/api/payment/internal/logic/payment"
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func CreatePaymentHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CreatePaymentRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := payment.NewCreatePaymentLogic(r.Context(), svcCtx)
+		resp, err := l.CreatePayment(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
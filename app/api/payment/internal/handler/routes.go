@@ -0,0 +1,68 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.2
+
+package handler
+
+import (
+	"net/http"
+
+	notify "NatsumeAI/app/api/payment/internal/handler/notify"
+	payment "NatsumeAI/app/api/payment/internal/handler/payment"
+	wallet "NatsumeAI/app/api/payment/internal/handler/wallet"
+	"NatsumeAI/app/api/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/rest"
+)
+
+func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/v1/payment/notify/:channel",
+				Handler: notify.PaymentNotifyHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/payment",
+					Handler: payment.CreatePaymentHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/payment/detail",
+					Handler: payment.GetPaymentHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.CasbinMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/wallet",
+					Handler: wallet.GetWalletHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/wallet/entries",
+					Handler: wallet.ListWalletEntriesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/wallet/topup",
+					Handler: wallet.CreateTopupHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+}
@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wallet
+
+import (
+	"net/http"
+
+	"NatsumeAI/app/api/payment/internal/logic/wallet"
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func CreateTopupHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CreateTopupRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := wallet.NewCreateTopupLogic(r.Context(), svcCtx)
+		resp, err := l.CreateTopup(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
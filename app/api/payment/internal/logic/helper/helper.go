@@ -0,0 +1,36 @@
+package helper
+
+import (
+	"NatsumeAI/app/api/payment/internal/types"
+	"NatsumeAI/app/services/payment/paymentservice"
+)
+
+func ToPaymentInfo(p *paymentservice.PaymentInfo) types.PaymentInfo {
+	if p == nil {
+		return types.PaymentInfo{}
+	}
+	info := types.PaymentInfo{
+		Payment_id:     p.PaymentId,
+		Payment_no:     p.PaymentNo,
+		Status:         int32(p.Status),
+		Order_id:       p.OrderId,
+		Amount:         p.Amount,
+		Currency:       p.Currency,
+		Channel:        p.Channel,
+		Credential:     p.Credential,
+		Timeout_at:     p.TimeoutAt,
+		Channel_amount: p.ChannelAmount,
+		Price_currency: p.PriceCurrency,
+		Fx_rate:        p.FxRate,
+	}
+	for _, s := range p.Splits {
+		info.Splits = append(info.Splits, types.PaymentSplit{
+			Leg_no:  s.LegNo,
+			Channel: s.Channel,
+			Amount:  s.Amount,
+			Points:  s.Points,
+			Status:  s.Status,
+		})
+	}
+	return info
+}
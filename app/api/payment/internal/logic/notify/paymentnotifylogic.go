@@ -0,0 +1,51 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notify
+
+import (
+	"context"
+	"net/http"
+
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"NatsumeAI/app/services/payment/paymentservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PaymentNotifyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPaymentNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PaymentNotifyLogic {
+	return &PaymentNotifyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// PaymentNotify 转发渠道通知到支付服务，返回 HTTP 状态码与渠道应答报文。
+// 处理失败时返回非 200，渠道会按自身策略重试。
+func (l *PaymentNotifyLogic) PaymentNotify(req *types.PaymentNotifyRequest, headers map[string]string, body []byte) (int, string, error) {
+	out, err := l.svcCtx.PaymentRpc.HandleNotify(l.ctx, &paymentservice.HandleNotifyReq{
+		Channel: req.Channel,
+		Headers: headers,
+		Body:    body,
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	switch out.StatusCode {
+	case 0:
+		return http.StatusOK, out.Ack, nil
+	case 400, 404, 409:
+		l.Logger.Infof("payment notify rejected: channel=%s code=%d msg=%s", req.Channel, out.StatusCode, out.StatusMsg)
+		return http.StatusBadRequest, out.Ack, nil
+	default:
+		return http.StatusInternalServerError, out.Ack, nil
+	}
+}
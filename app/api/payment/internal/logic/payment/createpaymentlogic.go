@@ -0,0 +1,73 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	helper "NatsumeAI/app/api/payment/internal/logic/helper"
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"NatsumeAI/app/common/util"
+	"NatsumeAI/app/services/order/orderservice"
+	"NatsumeAI/app/services/payment/paymentservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePaymentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreatePaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePaymentLogic {
+	return &CreatePaymentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreatePaymentLogic) CreatePayment(req *types.CreatePaymentRequest) (resp *types.CreatePaymentResponse, err error) {
+	uid, _ := util.UserIdFromCtx(l.ctx)
+
+	// 金额、币种与汇率快照以订单为准，不信任客户端传值
+	ord, err := l.svcCtx.OrderRpc.GetOrder(l.ctx, &orderservice.GetOrderReq{
+		OrderId: req.Order_id,
+		UserId:  uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ord.StatusCode != 0 {
+		return &types.CreatePaymentResponse{
+			Status_code: ord.StatusCode,
+			Status_msg:  ord.StatusMsg,
+		}, nil
+	}
+
+	out, err := l.svcCtx.PaymentRpc.CreatePayment(l.ctx, &paymentservice.CreatePaymentReq{
+		OrderId:       ord.Order.OrderId,
+		UserId:        uid,
+		Amount:        ord.Order.PayAmount,
+		Currency:      ord.Order.Currency,
+		PriceCurrency: ord.Order.PriceCurrency,
+		FxRate:        ord.Order.FxRate,
+		Channel:       req.Channel,
+		WalletAmount:  req.Wallet_amount,
+		Points:        req.Points,
+		ClientIp:      req.Client_ip,
+		Subject:       fmt.Sprintf("NatsumeAI 订单 %d", ord.Order.OrderId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CreatePaymentResponse{
+		Status_code: out.StatusCode,
+		Status_msg:  out.StatusMsg,
+		Payment:     helper.ToPaymentInfo(out.Payment),
+	}, nil
+}
@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package payment
+
+import (
+	"context"
+
+	helper "NatsumeAI/app/api/payment/internal/logic/helper"
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"NatsumeAI/app/common/util"
+	"NatsumeAI/app/services/payment/paymentservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPaymentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPaymentLogic {
+	return &GetPaymentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPaymentLogic) GetPayment(req *types.GetPaymentRequest) (resp *types.GetPaymentResponse, err error) {
+	uid, _ := util.UserIdFromCtx(l.ctx)
+	out, err := l.svcCtx.PaymentRpc.GetPayment(l.ctx, &paymentservice.GetPaymentReq{
+		PaymentId: req.Payment_id,
+		OrderId:   req.Order_id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 支付服务不做归属校验，这里只允许查看本人的支付单
+	if out.StatusCode == 0 && out.Payment.GetUserId() != uid {
+		return &types.GetPaymentResponse{
+			Status_code: 403,
+			Status_msg:  "forbidden",
+		}, nil
+	}
+	return &types.GetPaymentResponse{
+		Status_code: out.StatusCode,
+		Status_msg:  out.StatusMsg,
+		Payment:     helper.ToPaymentInfo(out.Payment),
+	}, nil
+}
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wallet
+
+import (
+	"context"
+
+	helper "NatsumeAI/app/api/payment/internal/logic/helper"
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"NatsumeAI/app/common/util"
+	"NatsumeAI/app/services/payment/paymentservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateTopupLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateTopupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateTopupLogic {
+	return &CreateTopupLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateTopupLogic) CreateTopup(req *types.CreateTopupRequest) (resp *types.CreateTopupResponse, err error) {
+	uid, _ := util.UserIdFromCtx(l.ctx)
+	out, err := l.svcCtx.PaymentRpc.CreateTopup(l.ctx, &paymentservice.CreateTopupReq{
+		UserId:   uid,
+		Amount:   req.Amount,
+		Currency: req.Currency,
+		Channel:  req.Channel,
+		ClientIp: req.Client_ip,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CreateTopupResponse{
+		Status_code: out.StatusCode,
+		Status_msg:  out.StatusMsg,
+		Payment:     helper.ToPaymentInfo(out.Payment),
+	}, nil
+}
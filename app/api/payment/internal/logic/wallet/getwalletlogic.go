@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wallet
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"NatsumeAI/app/common/util"
+	"NatsumeAI/app/services/payment/paymentservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWalletLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetWalletLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWalletLogic {
+	return &GetWalletLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetWalletLogic) GetWallet(req *types.GetWalletRequest) (resp *types.GetWalletResponse, err error) {
+	uid, _ := util.UserIdFromCtx(l.ctx)
+	out, err := l.svcCtx.PaymentRpc.GetWallet(l.ctx, &paymentservice.GetWalletReq{
+		UserId:   uid,
+		Currency: req.Currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.GetWalletResponse{
+		Status_code: out.StatusCode,
+		Status_msg:  out.StatusMsg,
+	}
+	if w := out.Wallet; w != nil {
+		resp.Wallet = types.WalletInfo{
+			Currency: w.Currency,
+			Balance:  w.Balance,
+			Status:   w.Status,
+			Points:   w.Points,
+		}
+	}
+	return resp, nil
+}
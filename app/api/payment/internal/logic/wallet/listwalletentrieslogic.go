@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wallet
+
+import (
+	"context"
+
+	"NatsumeAI/app/api/payment/internal/svc"
+	"NatsumeAI/app/api/payment/internal/types"
+	"NatsumeAI/app/common/util"
+	"NatsumeAI/app/services/payment/paymentservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWalletEntriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListWalletEntriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWalletEntriesLogic {
+	return &ListWalletEntriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListWalletEntriesLogic) ListWalletEntries(req *types.ListWalletEntriesRequest) (resp *types.ListWalletEntriesResponse, err error) {
+	uid, _ := util.UserIdFromCtx(l.ctx)
+	out, err := l.svcCtx.PaymentRpc.ListWalletEntries(l.ctx, &paymentservice.ListWalletEntriesReq{
+		UserId:   uid,
+		Currency: req.Currency,
+		Page:     req.Page,
+		PageSize: req.Page_size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.ListWalletEntriesResponse{
+		Status_code: out.StatusCode,
+		Status_msg:  out.StatusMsg,
+		Entries:     make([]types.WalletEntry, 0, len(out.Entries)),
+		Total:       out.Total,
+	}
+	for _, e := range out.Entries {
+		resp.Entries = append(resp.Entries, types.WalletEntry{
+			Entry_no:      e.EntryNo,
+			Biz_type:      e.BizType,
+			Biz_no:        e.BizNo,
+			Direction:     e.Direction,
+			Amount:        e.Amount,
+			Balance_after: e.BalanceAfter,
+			Remark:        e.Remark,
+			Created_at:    e.CreatedAt,
+		})
+	}
+	return resp, nil
+}
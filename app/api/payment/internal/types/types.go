@@ -0,0 +1,111 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.2
+
+package types
+
+type CreatePaymentRequest struct {
+	Order_id      int64  `json:"order_id"`
+	Channel       string `json:"channel,optional"`       // ALIPAY / WECHAT / MOCK / WALLET，积分、钱包全额抵扣时可为空
+	Wallet_amount int64  `json:"wallet_amount,optional"` // 钱包余额抵扣金额，单位分
+	Points        int64  `json:"points,optional"`        // 使用积分数
+	Client_ip     string `header:"X-Real-Ip,optional"`
+}
+
+type CreatePaymentResponse struct {
+	Status_code int64       `json:"status_code"`
+	Status_msg  string      `json:"status_msg"`
+	Payment     PaymentInfo `json:"payment"`
+}
+
+type CreateTopupRequest struct {
+	Amount    int64  `json:"amount"` // 充值金额，单位分
+	Currency  string `json:"currency,optional"`
+	Channel   string `json:"channel"` // ALIPAY / WECHAT / MOCK
+	Client_ip string `header:"X-Real-Ip,optional"`
+}
+
+type CreateTopupResponse struct {
+	Status_code int64       `json:"status_code"`
+	Status_msg  string      `json:"status_msg"`
+	Payment     PaymentInfo `json:"payment"`
+}
+
+type GetPaymentRequest struct {
+	Payment_id int64 `form:"payment_id,optional"`
+	Order_id   int64 `form:"order_id,optional"`
+}
+
+type GetPaymentResponse struct {
+	Status_code int64       `json:"status_code"`
+	Status_msg  string      `json:"status_msg"`
+	Payment     PaymentInfo `json:"payment"`
+}
+
+type GetWalletRequest struct {
+	Currency string `form:"currency,optional"`
+}
+
+type GetWalletResponse struct {
+	Status_code int64      `json:"status_code"`
+	Status_msg  string     `json:"status_msg"`
+	Wallet      WalletInfo `json:"wallet"`
+}
+
+type ListWalletEntriesRequest struct {
+	Currency  string `form:"currency,optional"`
+	Page      int64  `form:"page,optional"`
+	Page_size int64  `form:"page_size,optional"`
+}
+
+type ListWalletEntriesResponse struct {
+	Status_code int64         `json:"status_code"`
+	Status_msg  string        `json:"status_msg"`
+	Entries     []WalletEntry `json:"entries"`
+	Total       int64         `json:"total"`
+}
+
+type PaymentInfo struct {
+	Payment_id     int64          `json:"payment_id"`
+	Payment_no     string         `json:"payment_no"`
+	Status         int32          `json:"status"` // PaymentStatus enum value
+	Order_id       int64          `json:"order_id"`
+	Amount         int64          `json:"amount"`
+	Currency       string         `json:"currency"`
+	Channel        string         `json:"channel"`
+	Credential     string         `json:"credential"` // 支付凭证：收银台链接/二维码链接
+	Timeout_at     int64          `json:"timeout_at"`
+	Channel_amount int64          `json:"channel_amount"` // 主渠道应付金额，扣除积分、钱包抵扣后的部分
+	Splits         []PaymentSplit `json:"splits"`
+	Price_currency string         `json:"price_currency"` // 商品标价币种
+	Fx_rate        int64          `json:"fx_rate"`        // 标价币种 → 支付币种汇率快照，放大 1e8 倍
+}
+
+type PaymentNotifyRequest struct {
+	Channel string `path:"channel"`
+}
+
+type PaymentSplit struct {
+	Leg_no  int64  `json:"leg_no"`
+	Channel string `json:"channel"` // POINTS / WALLET / 外部渠道
+	Amount  int64  `json:"amount"`
+	Points  int64  `json:"points"`
+	Status  string `json:"status"`
+}
+
+type WalletEntry struct {
+	Entry_no      string `json:"entry_no"`
+	Biz_type      string `json:"biz_type"` // TOPUP / PAYMENT / REFUND
+	Biz_no        string `json:"biz_no"`
+	Direction     string `json:"direction"` // DEBIT 支出 / CREDIT 收入
+	Amount        int64  `json:"amount"`
+	Balance_after int64  `json:"balance_after"`
+	Remark        string `json:"remark"`
+	Created_at    int64  `json:"created_at"`
+}
+
+type WalletInfo struct {
+	Currency string `json:"currency"`
+	Balance  int64  `json:"balance"` // 余额，单位分
+	Status   string `json:"status"`
+	Points   int64  `json:"points"` // 积分余额
+}
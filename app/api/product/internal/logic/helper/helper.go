@@ -11,16 +11,19 @@ func ToProduct(src *productsrv.Product) types.Product {
 	}
 
 	dst := types.Product{
-		ProductId:   src.Id,
-		MerchantId:  src.MerchantId,
-		Name:        src.Name,
-		Description: src.Description,
-		Picture:     src.Picture,
-		Price:       src.Price,
-		Stock:       src.Stock,
-		Sold:        src.Sold,
-		CreatedAt:   src.CreatedAt,
-		UpdatedAt:   src.UpdatedAt,
+		ProductId:       src.Id,
+		MerchantId:      src.MerchantId,
+		Name:            src.Name,
+		Description:     src.Description,
+		Picture:         src.Picture,
+		Price:           src.Price,
+		Currency:        src.Currency,
+		DisplayPrice:    src.DisplayPrice,
+		DisplayCurrency: src.DisplayCurrency,
+		Stock:           src.Stock,
+		Sold:            src.Sold,
+		CreatedAt:       src.CreatedAt,
+		UpdatedAt:       src.UpdatedAt,
 	}
 
 	if len(src.Categories) > 0 {
@@ -42,6 +45,7 @@ func ToProductSummary(src *productsrv.ProductSummary) types.ProductSummary {
 		Description: src.Description,
 		Picture:     src.Picture,
 		Price:       src.Price,
+		Currency:    src.Currency,
 	}
 
 	if len(src.Categories) > 0 {
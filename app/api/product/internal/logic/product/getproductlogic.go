@@ -43,6 +43,7 @@ func (l *GetProductLogic) GetProduct(req *types.GetProductRequest) (resp *types.
 	in := &productsrv.GetProductReq{
 		ProductId: req.ProductId,
 		UserId:    userID,
+		Currency:  req.Currency,
 	}
 
 	res, err := l.svcCtx.ProductRpc.GetProduct(l.ctx, in)
@@ -55,6 +55,7 @@ func (l *CreateProductLogic) CreateProduct(req *types.CreateProductRequest) (res
 		Stock:       req.Stock,
 		Categories:  req.Categories,
 		MerchantId:  merchantID,
+		Currency:    req.Currency,
 	}
 
 	res, err := l.svcCtx.ProductRpc.CreateProduct(l.ctx, in)
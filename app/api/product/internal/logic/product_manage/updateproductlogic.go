@@ -58,6 +58,7 @@ func (l *UpdateProductLogic) UpdateProduct(req *types.UpdateProductRequest) (res
 		Picture:     picture,
 		Price:       req.Price,
 		MerchantId:  merchantID,
+		Currency:    req.Currency,
 	}
 
 	res, err := l.svcCtx.ProductRpc.UpdateProduct(l.ctx, in)
@@ -18,6 +18,7 @@ type CreateProductRequest struct {
 	Description string   `json:"description"`
 	Picture     string   `json:"picture"`
 	Price       int64    `json:"price"`
+	Currency    string   `json:"currency,optional"`
 	Stock       int64    `json:"stock"`
 	Categories  []string `json:"categories,omitempty"`
 }
@@ -50,7 +51,8 @@ type FeedProductsResponse struct {
 }
 
 type GetProductRequest struct {
-	ProductId int64 `path:"productId"`
+	ProductId int64  `path:"productId"`
+	Currency  string `form:"currency,optional"`
 }
 
 type GetProductResponse struct {
@@ -60,17 +62,20 @@ type GetProductResponse struct {
 }
 
 type Product struct {
-	ProductId   int64    `json:"productId"`
-	MerchantId  int64    `json:"merchantId"`
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	Picture     string   `json:"picture"`
-	Price       int64    `json:"price"`
-	Stock       int64    `json:"stock"`
-	Sold        int64    `json:"sold"`
-	Categories  []string `json:"categories"`
-	CreatedAt   string   `json:"createdAt"`
-	UpdatedAt   string   `json:"updatedAt"`
+	ProductId       int64    `json:"productId"`
+	MerchantId      int64    `json:"merchantId"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	Picture         string   `json:"picture"`
+	Price           int64    `json:"price"`
+	Currency        string   `json:"currency"`
+	DisplayPrice    int64    `json:"displayPrice"`
+	DisplayCurrency string   `json:"displayCurrency"`
+	Stock           int64    `json:"stock"`
+	Sold            int64    `json:"sold"`
+	Categories      []string `json:"categories"`
+	CreatedAt       string   `json:"createdAt"`
+	UpdatedAt       string   `json:"updatedAt"`
 }
 
 type ProductSummary struct {
@@ -80,6 +85,7 @@ type ProductSummary struct {
 	Description string   `json:"description"`
 	Picture     string   `json:"picture"`
 	Price       int64    `json:"price"`
+	Currency    string   `json:"currency"`
 	Categories  []string `json:"categories"`
 }
 
@@ -108,6 +114,7 @@ type UpdateProductRequest struct {
 	Description string `json:"description"`
 	Picture     string `json:"picture"`
 	Price       int64  `json:"price"`
+	Currency    string `json:"currency,optional"`
 }
 
 type UpdateProductResponse struct {
@@ -14,6 +14,7 @@ func ToUserProfile(u *userservice.UserProfile) types.UserProfile {
 	return types.UserProfile{
 		UserId:    u.UserId,
 		Username:  u.Username,
+		Birthday:  u.Birthday,
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
 	}
@@ -32,8 +32,9 @@ func NewLoginUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginUs
 
 func (l *LoginUserLogic) LoginUser(req *types.LoginUserRequest) (resp *types.LoginUserResponse, err error) {
 	in := &userservice.LoginUserRequest{
-		Username: req.Username,
-		Password: req.Password,
+		Username:   req.Username,
+		Password:   req.Password,
+		GuestToken: req.GuestToken,
 	}
 
 	res, err := l.svcCtx.UserRpc.LoginUser(l.ctx, in)
@@ -34,6 +34,7 @@ func (l *RegisterUserLogic) RegisterUser(req *types.RegisterUserRequest) (resp *
 	in := &userservice.RegisterUserRequest{
 		Username: req.Username,
 		Password: req.Password,
+		Birthday: req.Birthday,
 	}
 
 	res, err := l.svcCtx.UserRpc.RegisterUser(l.ctx, in)
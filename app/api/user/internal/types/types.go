@@ -44,8 +44,9 @@ type ListAddressesResponse struct {
 }
 
 type LoginUserRequest struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	GuestToken string `json:"guestToken,optional"` // 游客购物车令牌，登录后合并到用户购物车
 }
 
 type LoginUserResponse struct {
@@ -66,6 +67,7 @@ type MerchantApplicationInput struct {
 type RegisterUserRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	Birthday string `json:"birthday,optional"` // YYYY-MM-DD，用于生日券
 }
 
 type RegisterUserResponse struct {
@@ -80,6 +82,7 @@ type UpdateAddressRequest struct {
 type UserProfile struct {
 	UserId    int64  `json:"userId"`
 	Username  string `json:"username"`
+	Birthday  string `json:"birthday,omitempty"`
 	CreatedAt int64  `json:"createdAt"`
 	UpdatedAt int64  `json:"updatedAt"`
 }
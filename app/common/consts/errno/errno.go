@@ -31,6 +31,15 @@ const (
 	CouponExpired
 	CouponStatusInvalid
 	CouponOwnershipInvalid
+	CurrencyUnsupported
+	CampaignNotFound
+	CampaignStatusInvalid
+	CouponCodeInvalid
+	CouponCodeRedeemed
+	TooManyAttempts
+	PromotionNotFound
+	CartFull
+	CartQuantityLimit
 )
 
 const (
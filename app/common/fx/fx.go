@@ -0,0 +1,64 @@
+package fx
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	// BaseCurrency 汇率表的基准币种，汇率均为 1 单位外币折合的基准币数量
+	BaseCurrency = "CNY"
+	// RateScale 汇率放大倍数，数据库与接口中的汇率均为整数
+	RateScale int64 = 100000000
+)
+
+var ErrInvalidRate = errors.New("invalid fx rate")
+
+// Normalize 统一币种写法，空值视为基准币
+func Normalize(currency string) string {
+	c := strings.ToUpper(strings.TrimSpace(currency))
+	if c == "" {
+		return BaseCurrency
+	}
+	return c
+}
+
+// CrossRate 由两个币种对基准币的汇率计算 from → to 的汇率（×RateScale，四舍五入）
+func CrossRate(fromRate, toRate int64) (int64, error) {
+	if fromRate <= 0 || toRate <= 0 {
+		return 0, ErrInvalidRate
+	}
+	return mulDiv(fromRate, RateScale, toRate), nil
+}
+
+// Convert 按汇率快照换算金额：amount × rate / RateScale，四舍五入到最小货币单位
+func Convert(amount, rate int64) int64 {
+	if rate <= 0 || rate == RateScale {
+		return amount
+	}
+	return mulDiv(amount, rate, RateScale)
+}
+
+// Revert 按同一汇率快照将换算后的金额折回原币种，用于退款、结算还原标价币种金额
+func Revert(amount, rate int64) int64 {
+	if rate <= 0 || rate == RateScale {
+		return amount
+	}
+	return mulDiv(amount, RateScale, rate)
+}
+
+// mulDiv 计算 a×b/c 并四舍五入，中间结果用大整数避免溢出
+func mulDiv(a, b, c int64) int64 {
+	n := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	d := big.NewInt(c)
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() != 0 && new(big.Int).Mul(r.Abs(r), big.NewInt(2)).Cmp(d.Abs(d)) >= 0 {
+		if n.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q.Int64()
+}
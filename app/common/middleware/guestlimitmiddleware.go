@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"NatsumeAI/app/common/consts/errno"
+	"net"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/limit"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"github.com/zeromicro/x/errors"
+)
+
+// GuestLimitMiddleware 按客户端 IP 限制免登录接口的请求频率
+type GuestLimitMiddleware struct {
+	limiter *limit.PeriodLimit
+}
+
+// NewGuestLimitMiddleware 每个 IP 每 period 秒最多 quota 次请求，计数存放在 Redis 中，多实例共享
+func NewGuestLimitMiddleware(period, quota int, store *redis.Redis, keyPrefix string) *GuestLimitMiddleware {
+	return &GuestLimitMiddleware{
+		limiter: limit.NewPeriodLimit(period, quota, store, keyPrefix),
+	}
+}
+
+func (m *GuestLimitMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		code, err := m.limiter.TakeCtx(r.Context(), clientIP(r))
+		if err != nil {
+			// Redis 不可用时放行，避免限流故障拖垮游客购物车
+			logx.WithContext(r.Context()).Errorf("guest limit failed: %v", err)
+			next(w, r)
+			return
+		}
+		if code == limit.OverQuota {
+			httpx.Error(w, errors.New(int(errno.TooManyAttempts), "too many requests"))
+			return
+		}
+		next(w, r)
+	}
+}
+
+// clientIP 取连接对端 IP；X-Forwarded-For 可由客户端任意伪造，不用于限流
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -3,6 +3,7 @@ package cart
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlc"
@@ -18,6 +19,8 @@ type (
 		cartModel
 		FindOneByUserProduct(ctx context.Context, userId, productId int64) (*Cart, error)
 		ListByUserId(ctx context.Context, userId int64) ([]*Cart, error)
+		// ReplaceByUserIds 在同一事务中以 items 覆盖这些用户的购物车，用于 Redis 购物车批量落库
+		ReplaceByUserIds(ctx context.Context, userIds []int64, items []*Cart) error
 	}
 
 	customCartModel struct {
@@ -59,3 +62,32 @@ func (m *customCartModel) ListByUserId(ctx context.Context, userId int64) ([]*Ca
 		return nil, err
 	}
 }
+
+func (m *customCartModel) ReplaceByUserIds(ctx context.Context, userIds []int64, items []*Cart) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ",")
+	args := make([]any, 0, len(userIds))
+	for _, id := range userIds {
+		args = append(args, id)
+	}
+	return m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("delete from %s where `user_id` in (%s)", m.table, placeholders)
+		if _, err := session.ExecCtx(ctx, query, args...); err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		// 保留 Redis 中分配的 id，重建时行 id 不变
+		values := strings.TrimSuffix(strings.Repeat("(?, ?, ?, ?, ?, ?, ?),", len(items)), ",")
+		rowArgs := make([]any, 0, len(items)*7)
+		for _, item := range items {
+			rowArgs = append(rowArgs, item.Id, item.ProductId, item.UserId, item.Quantity, item.AddedPrice, item.Selected, item.SavedForLater)
+		}
+		query = fmt.Sprintf("insert into %s (`id`, `product_id`, `user_id`, `quantity`, `added_price`, `selected`, `saved_for_later`) values %s", m.table, values)
+		_, err := session.ExecCtx(ctx, query, rowArgs...)
+		return err
+	})
+}
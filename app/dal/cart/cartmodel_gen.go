@@ -40,10 +40,13 @@ type (
 	}
 
 	Cart struct {
-		Id        int64 `db:"id"`         // 主键，纯摆设
-		ProductId int64 `db:"product_id"` // 关联的商品id
-		UserId    int64 `db:"user_id"`    // 关联的用户id
-		Quantity  int64 `db:"quantity"`   // 商品数量
+		Id            int64 `db:"id"`              // 主键，纯摆设
+		ProductId     int64 `db:"product_id"`      // 关联的商品id
+		UserId        int64 `db:"user_id"`         // 关联的用户id
+		Quantity      int64 `db:"quantity"`        // 商品数量
+		AddedPrice    int64 `db:"added_price"`     // 加入购物车时的单价(分，CNY)，0 表示未记录
+		Selected      int64 `db:"selected"`        // 是否勾选结算
+		SavedForLater int64 `db:"saved_for_later"` // 是否移入稍后再买
 	}
 )
 
@@ -83,8 +86,8 @@ func (m *defaultCartModel) FindOne(ctx context.Context, id int64) (*Cart, error)
 func (m *defaultCartModel) Insert(ctx context.Context, data *Cart) (sql.Result, error) {
 	cartIdKey := fmt.Sprintf("%s%v", cacheCartIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, cartRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.ProductId, data.UserId, data.Quantity)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, cartRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ProductId, data.UserId, data.Quantity, data.AddedPrice, data.Selected, data.SavedForLater)
 	}, cartIdKey)
 	return ret, err
 }
@@ -93,7 +96,7 @@ func (m *defaultCartModel) Update(ctx context.Context, data *Cart) error {
 	cartIdKey := fmt.Sprintf("%s%v", cacheCartIdPrefix, data.Id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, cartRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.ProductId, data.UserId, data.Quantity, data.Id)
+		return conn.ExecCtx(ctx, query, data.ProductId, data.UserId, data.Quantity, data.AddedPrice, data.Selected, data.SavedForLater, data.Id)
 	}, cartIdKey)
 	return err
 }
@@ -0,0 +1,546 @@
+package cart
+
+import (
+	"cmp"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// 同一用户的 key 使用相同 hash tag
+	cartItemsKeyPattern = "cart:{%d}:items"
+	// 游客购物车只存 Redis，不落库
+	guestItemsKeyPattern = "cart:guest:{%s}:items"
+	cartFlushKeyPattern  = "cart:{%d}:flushing"
+	// 待落库的用户集合
+	cartDirtyKey = "cart:dirty"
+
+	// 占位字段，标记购物车已从 MySQL 重建
+	cartWarmField = "_"
+
+	// 落库锁过期时间，避免多个实例同时写同一用户的购物车
+	cartFlushLockSeconds = 30
+)
+
+var (
+	//go:embed warm_cart.lua
+	warmCartScriptText string
+	//go:embed upsert_cart.lua
+	upsertCartScriptText string
+	//go:embed incr_cart.lua
+	incrCartScriptText string
+	//go:embed remove_cart.lua
+	removeCartScriptText string
+	//go:embed set_cart.lua
+	setCartScriptText string
+	//go:embed take_cart.lua
+	takeCartScriptText string
+	//go:embed select_cart.lua
+	selectCartScriptText string
+	//go:embed save_cart.lua
+	saveCartScriptText string
+
+	warmCartScript   = redis.NewScript(warmCartScriptText)
+	upsertCartScript = redis.NewScript(upsertCartScriptText)
+	incrCartScript   = redis.NewScript(incrCartScriptText)
+	removeCartScript = redis.NewScript(removeCartScriptText)
+	setCartScript    = redis.NewScript(setCartScriptText)
+	takeCartScript   = redis.NewScript(takeCartScriptText)
+	selectCartScript = redis.NewScript(selectCartScriptText)
+	saveCartScript   = redis.NewScript(saveCartScriptText)
+	popDirtyScript   = redis.NewScript(`return redis.call("SPOP", KEYS[1], ARGV[1])`)
+)
+
+type (
+	// Owner 购物车归属：登录用户，或以设备/Cookie 令牌标识的游客
+	Owner struct {
+		UserId     int64
+		GuestToken string
+	}
+
+	// CartStoreModel 购物车以 Redis hash 保存，读写只访问 Redis。
+	// 用户购物车变更后记入待落库集合，由 Flush 批量写回 MySQL，Redis 中不存在时从 MySQL 重建；
+	// 游客购物车只存 Redis，登录后由 TakeGuest 取出合并。
+	CartStoreModel interface {
+		// List 返回购物车，按加入时间倒序
+		List(ctx context.Context, owner Owner) ([]*Cart, error)
+		// Get 查询购物车中的商品，不存在时返回 ErrNotFound
+		Get(ctx context.Context, owner Owner, productId int64) (*Cart, error)
+		// Upsert 加入商品：不存在时写入 item，已存在时数量累加 item.Quantity 并移回购物车、勾选；
+		// 返回是否新建与加入后的数量。商品行数、单个商品数量超过上限时返回 ErrCartFull、ErrQuantityLimit
+		Upsert(ctx context.Context, owner Owner, item *Cart) (bool, int64, error)
+		// Set 写入商品行，已存在时覆盖
+		Set(ctx context.Context, owner Owner, item *Cart) error
+		// Incr 调整商品数量并返回调整后的数量，不大于 0 时删除该行；增加后超过单个商品上限时返回 ErrQuantityLimit
+		Incr(ctx context.Context, owner Owner, productId, delta int64) (int64, error)
+		// Select 勾选/取消勾选商品，productIds 为空时处理全部；稍后再买的商品不参与。返回处理的行数
+		Select(ctx context.Context, owner Owner, productIds []int64, selected bool) (int64, error)
+		// SaveForLater 移入（saved 为 true）或移出稍后再买，返回处理的行数
+		SaveForLater(ctx context.Context, owner Owner, productIds []int64, saved bool) (int64, error)
+		// Remove 删除购物车中的商品，返回删除的行数
+		Remove(ctx context.Context, owner Owner, productIds ...int64) (int64, error)
+		// TakeGuest 取出并删除游客购物车
+		TakeGuest(ctx context.Context, token string) ([]*Cart, error)
+		// Flush 取出至多 batch 个待落库用户写回 MySQL，返回落库的用户数
+		Flush(ctx context.Context, batch int) (int, error)
+	}
+
+	defaultCartStoreModel struct {
+		redis    *redis.Redis
+		carts    CartModel
+		ttl      time.Duration
+		guestTTL time.Duration
+		limits   StoreLimits
+	}
+
+	// StoreLimits 每个购物车的商品行数与单个商品数量上限，0 表示不限；游客购物车无需登录即可写入，必须配置
+	StoreLimits struct {
+		MaxLines    int64
+		MaxQuantity int64
+	}
+
+	// cartEntry Redis 中保存的商品行，id 以字符串编码，Lua 脚本读写时不损失精度；
+	// 勾选状态以 unselected 保存，缺省即为勾选
+	cartEntry struct {
+		Id         int64 `json:"id,string"`
+		Quantity   int64 `json:"quantity"`
+		AddedPrice int64 `json:"added_price"`
+		Unselected bool  `json:"unselected,omitempty"`
+		Saved      bool  `json:"saved,omitempty"`
+	}
+)
+
+// NewCartStoreModel ttl、guestTTL 为用户、游客购物车在 Redis 中的保留时间，每次写入后顺延
+func NewCartStoreModel(r *redis.Redis, carts CartModel, ttl, guestTTL time.Duration, limits StoreLimits) CartStoreModel {
+	return &defaultCartStoreModel{
+		redis:    r,
+		carts:    carts,
+		ttl:      ttl,
+		guestTTL: guestTTL,
+		limits:   limits,
+	}
+}
+
+// UserCart 登录用户的购物车
+func UserCart(userId int64) Owner {
+	return Owner{UserId: userId}
+}
+
+// GuestCart 游客购物车，token 由调用方校验
+func GuestCart(token string) Owner {
+	return Owner{GuestToken: token}
+}
+
+func (o Owner) guest() bool {
+	return o.UserId <= 0
+}
+
+func (o Owner) key() string {
+	if o.guest() {
+		return fmt.Sprintf(guestItemsKeyPattern, o.GuestToken)
+	}
+	return cartItemsKey(o.UserId)
+}
+
+func cartItemsKey(userId int64) string {
+	return fmt.Sprintf(cartItemsKeyPattern, userId)
+}
+
+func (m *defaultCartStoreModel) ttlSeconds(owner Owner) int64 {
+	if owner.guest() {
+		return int64(m.guestTTL / time.Second)
+	}
+	return int64(m.ttl / time.Second)
+}
+
+func (m *defaultCartStoreModel) List(ctx context.Context, owner Owner) ([]*Cart, error) {
+	key := owner.key()
+	fields, err := m.redis.HgetallCtx(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 && !owner.guest() {
+		if err := m.warm(ctx, owner); err != nil {
+			return nil, err
+		}
+		if fields, err = m.redis.HgetallCtx(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+	return decodeCart(owner.UserId, fields)
+}
+
+func (m *defaultCartStoreModel) Get(ctx context.Context, owner Owner, productId int64) (*Cart, error) {
+	key := owner.key()
+	field := strconv.FormatInt(productId, 10)
+	vals, err := m.redis.HmgetCtx(ctx, key, cartWarmField, field)
+	if err != nil {
+		return nil, err
+	}
+	if vals[0] == "" && !owner.guest() {
+		if err := m.warm(ctx, owner); err != nil {
+			return nil, err
+		}
+		if vals, err = m.redis.HmgetCtx(ctx, key, cartWarmField, field); err != nil {
+			return nil, err
+		}
+	}
+	if vals[1] == "" {
+		return nil, ErrNotFound
+	}
+	return decodeEntry(owner.UserId, productId, vals[1])
+}
+
+func (m *defaultCartStoreModel) Upsert(ctx context.Context, owner Owner, item *Cart) (bool, int64, error) {
+	raw, err := encodeEntry(item)
+	if err != nil {
+		return false, 0, err
+	}
+	args := []any{strconv.FormatInt(item.ProductId, 10), raw, item.Quantity, m.ttlSeconds(owner), m.limits.MaxLines, m.limits.MaxQuantity}
+	code, quantity, err := m.runCounted(ctx, owner, upsertCartScript, args)
+	if err != nil {
+		return false, 0, err
+	}
+	switch code {
+	case "CREATED", "UPDATED":
+		return code == "CREATED", quantity, m.markDirty(ctx, owner)
+	case "CART_FULL":
+		return false, 0, ErrCartFull
+	case "QUANTITY_LIMIT":
+		return false, quantity, ErrQuantityLimit
+	default:
+		return false, 0, fmt.Errorf("cart upsert script returned %q", code)
+	}
+}
+
+func (m *defaultCartStoreModel) Set(ctx context.Context, owner Owner, item *Cart) error {
+	raw, err := encodeEntry(item)
+	if err != nil {
+		return err
+	}
+	args := []any{strconv.FormatInt(item.ProductId, 10), raw, m.ttlSeconds(owner)}
+	code, err := m.runWarmed(ctx, owner, func() (string, error) {
+		res, err := m.redis.ScriptRunCtx(ctx, setCartScript, []string{owner.key()}, args...)
+		code, _ := res.(string)
+		return code, err
+	})
+	if err != nil {
+		return err
+	}
+	if code != "OK" {
+		return fmt.Errorf("cart set script returned %q", code)
+	}
+	return m.markDirty(ctx, owner)
+}
+
+func (m *defaultCartStoreModel) Incr(ctx context.Context, owner Owner, productId, delta int64) (int64, error) {
+	args := []any{strconv.FormatInt(productId, 10), delta, m.ttlSeconds(owner), m.limits.MaxQuantity}
+	code, quantity, err := m.runCounted(ctx, owner, incrCartScript, args)
+	if err != nil {
+		return 0, err
+	}
+	switch code {
+	case "OK":
+		return quantity, m.markDirty(ctx, owner)
+	case "NOT_FOUND":
+		return 0, ErrNotFound
+	case "QUANTITY_LIMIT":
+		return quantity, ErrQuantityLimit
+	default:
+		return 0, fmt.Errorf("cart incr script returned %q", code)
+	}
+}
+
+func (m *defaultCartStoreModel) Select(ctx context.Context, owner Owner, productIds []int64, selected bool) (int64, error) {
+	args := append([]any{flagArg(selected), m.ttlSeconds(owner)}, productArgs(productIds)...)
+	return m.runBatch(ctx, owner, selectCartScript, args)
+}
+
+func (m *defaultCartStoreModel) SaveForLater(ctx context.Context, owner Owner, productIds []int64, saved bool) (int64, error) {
+	if len(productIds) == 0 {
+		return 0, nil
+	}
+	args := append([]any{flagArg(saved), m.ttlSeconds(owner)}, productArgs(productIds)...)
+	return m.runBatch(ctx, owner, saveCartScript, args)
+}
+
+func (m *defaultCartStoreModel) Remove(ctx context.Context, owner Owner, productIds ...int64) (int64, error) {
+	if len(productIds) == 0 {
+		return 0, nil
+	}
+	args := append([]any{m.ttlSeconds(owner)}, productArgs(productIds)...)
+	return m.runBatch(ctx, owner, removeCartScript, args)
+}
+
+// runBatch 执行批量修改脚本，返回受影响的商品行数，有变更时记录待落库
+func (m *defaultCartStoreModel) runBatch(ctx context.Context, owner Owner, script *redis.Script, args []any) (int64, error) {
+	code, n, err := m.runCounted(ctx, owner, script, args)
+	if err != nil {
+		return 0, err
+	}
+	if code != "OK" {
+		return 0, fmt.Errorf("cart script returned %q", code)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return n, m.markDirty(ctx, owner)
+}
+
+// runCounted 执行返回 { code, n } 的写脚本
+func (m *defaultCartStoreModel) runCounted(ctx context.Context, owner Owner, script *redis.Script, args []any) (string, int64, error) {
+	var n int64
+	code, err := m.runWarmed(ctx, owner, func() (string, error) {
+		res, err := m.redis.ScriptRunCtx(ctx, script, []string{owner.key()}, args...)
+		if err != nil {
+			return "", err
+		}
+		list, ok := res.([]any)
+		if !ok || len(list) != 2 {
+			return "", errors.New("unexpected cart script result")
+		}
+		n, _ = list[1].(int64)
+		code, _ := list[0].(string)
+		return code, nil
+	})
+	return code, n, err
+}
+
+// runWarmed 执行写脚本，购物车未重建时先重建再重试一次
+func (m *defaultCartStoreModel) runWarmed(ctx context.Context, owner Owner, run func() (string, error)) (string, error) {
+	code, err := run()
+	if err != nil || code != "NO_CART" {
+		return code, err
+	}
+	if err := m.warm(ctx, owner); err != nil {
+		return "", err
+	}
+	return run()
+}
+
+// markDirty 记录待落库用户，游客购物车不落库；写入失败时返回错误，由调用方重试，下次变更也会再次记录
+func (m *defaultCartStoreModel) markDirty(ctx context.Context, owner Owner) error {
+	if owner.guest() {
+		return nil
+	}
+	_, err := m.redis.SaddCtx(ctx, cartDirtyKey, owner.UserId)
+	return err
+}
+
+// warm 以 MySQL 中已落库的购物车重建 Redis，游客购物车只写入占位字段
+func (m *defaultCartStoreModel) warm(ctx context.Context, owner Owner) error {
+	var items []*Cart
+	if !owner.guest() {
+		var err error
+		items, err = m.carts.ListByUserId(ctx, owner.UserId)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+	}
+	args := make([]any, 0, len(items)*2+1)
+	args = append(args, m.ttlSeconds(owner))
+	for _, item := range items {
+		raw, err := encodeEntry(item)
+		if err != nil {
+			return err
+		}
+		args = append(args, strconv.FormatInt(item.ProductId, 10), raw)
+	}
+	_, err := m.redis.ScriptRunCtx(ctx, warmCartScript, []string{owner.key()}, args...)
+	return err
+}
+
+func (m *defaultCartStoreModel) TakeGuest(ctx context.Context, token string) ([]*Cart, error) {
+	res, err := m.redis.ScriptRunCtx(ctx, takeCartScript, []string{GuestCart(token).key()})
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	list, _ := res.([]any)
+	fields := make(map[string]string, len(list)/2)
+	for i := 0; i+1 < len(list); i += 2 {
+		field, _ := list[i].(string)
+		val, _ := list[i+1].(string)
+		fields[field] = val
+	}
+	return decodeCart(0, fields)
+}
+
+func (m *defaultCartStoreModel) Flush(ctx context.Context, batch int) (int, error) {
+	res, err := m.redis.ScriptRunCtx(ctx, popDirtyScript, []string{cartDirtyKey}, batch)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	members, _ := res.([]any)
+	if len(members) == 0 {
+		return 0, nil
+	}
+
+	// 其他实例正在落库的用户放回集合，下一轮再处理
+	userIds := make([]int64, 0, len(members))
+	var busy []any
+	for _, member := range members {
+		str, _ := member.(string)
+		userId, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			continue
+		}
+		ok, err := m.redis.SetnxExCtx(ctx, fmt.Sprintf(cartFlushKeyPattern, userId), "1", cartFlushLockSeconds)
+		if err != nil || !ok {
+			busy = append(busy, userId)
+			continue
+		}
+		userIds = append(userIds, userId)
+	}
+	defer m.unlockFlush(userIds)
+	if len(busy) > 0 {
+		if _, err := m.redis.SaddCtx(ctx, cartDirtyKey, busy...); err != nil {
+			logx.WithContext(ctx).Errorf("cart flush requeue busy users failed: users=%v err=%v", busy, err)
+		}
+	}
+	if len(userIds) == 0 {
+		return 0, nil
+	}
+
+	// Redis 中已过期的购物车以 MySQL 为准，不覆盖
+	persist := make([]int64, 0, len(userIds))
+	var items []*Cart
+	for _, userId := range userIds {
+		fields, err := m.redis.HgetallCtx(ctx, cartItemsKey(userId))
+		if err != nil {
+			m.requeue(ctx, userIds)
+			return 0, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		cart, err := decodeCart(userId, fields)
+		if err != nil {
+			m.requeue(ctx, userIds)
+			return 0, err
+		}
+		persist = append(persist, userId)
+		items = append(items, cart...)
+	}
+	if len(persist) == 0 {
+		return 0, nil
+	}
+	if err := m.carts.ReplaceByUserIds(ctx, persist, items); err != nil {
+		m.requeue(ctx, userIds)
+		return 0, err
+	}
+	return len(persist), nil
+}
+
+func (m *defaultCartStoreModel) requeue(ctx context.Context, userIds []int64) {
+	members := make([]any, 0, len(userIds))
+	for _, id := range userIds {
+		members = append(members, id)
+	}
+	if _, err := m.redis.SaddCtx(ctx, cartDirtyKey, members...); err != nil {
+		logx.WithContext(ctx).Errorf("cart flush requeue failed: users=%v err=%v", userIds, err)
+	}
+}
+
+func (m *defaultCartStoreModel) unlockFlush(userIds []int64) {
+	if len(userIds) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(userIds))
+	for _, id := range userIds {
+		keys = append(keys, fmt.Sprintf(cartFlushKeyPattern, id))
+	}
+	// 上游 ctx 可能已取消，解锁使用独立的 ctx
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	for _, key := range keys {
+		if _, err := m.redis.DelCtx(ctx, key); err != nil {
+			logx.WithContext(ctx).Errorf("cart flush unlock failed: key=%s err=%v", key, err)
+		}
+	}
+}
+
+func decodeCart(userId int64, fields map[string]string) ([]*Cart, error) {
+	items := make([]*Cart, 0, len(fields))
+	for field, raw := range fields {
+		if field == cartWarmField {
+			continue
+		}
+		productId, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		item, err := decodeEntry(userId, productId, raw)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	// 新加入的商品 id 更大，排在前面
+	slices.SortFunc(items, func(a, b *Cart) int { return cmp.Compare(b.Id, a.Id) })
+	return items, nil
+}
+
+func decodeEntry(userId, productId int64, raw string) (*Cart, error) {
+	var e cartEntry
+	if err := json.Unmarshal([]byte(raw), &e); err != nil {
+		return nil, err
+	}
+	item := &Cart{
+		Id:         e.Id,
+		ProductId:  productId,
+		UserId:     userId,
+		Quantity:   e.Quantity,
+		AddedPrice: e.AddedPrice,
+	}
+	if !e.Unselected {
+		item.Selected = 1
+	}
+	if e.Saved {
+		item.SavedForLater = 1
+	}
+	return item, nil
+}
+
+func encodeEntry(item *Cart) (string, error) {
+	if item.Id == 0 {
+		return "", errors.New("cart item id required")
+	}
+	raw, err := json.Marshal(cartEntry{
+		Id:         item.Id,
+		Quantity:   item.Quantity,
+		AddedPrice: item.AddedPrice,
+		Unselected: item.Selected == 0,
+		Saved:      item.SavedForLater != 0,
+	})
+	return string(raw), err
+}
+
+func productArgs(productIds []int64) []any {
+	args := make([]any, 0, len(productIds))
+	for _, id := range productIds {
+		args = append(args, strconv.FormatInt(id, 10))
+	}
+	return args
+}
+
+func flagArg(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,36 @@
+-- 调整商品数量，结果不大于 0 时删除该行
+-- KEYS:
+--   1: cart_key
+-- ARGV:
+--   1: product_id
+--   2: delta
+--   3: ttl (秒)
+--   4: 单个商品数量上限，0 表示不限；只限制增加数量
+
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return { "NO_CART", 0 }
+end
+local raw = redis.call("HGET", KEYS[1], ARGV[1])
+if not raw then
+    return { "NOT_FOUND", 0 }
+end
+
+-- id 以字符串保存，cjson 不会损失精度
+local item = cjson.decode(raw)
+local current = tonumber(item.quantity) or 0
+local delta = tonumber(ARGV[2]) or 0
+local quantity = current + delta
+local maxQuantity = tonumber(ARGV[4]) or 0
+if delta > 0 and maxQuantity > 0 and quantity > maxQuantity then
+    return { "QUANTITY_LIMIT", current }
+end
+if quantity <= 0 then
+    redis.call("HDEL", KEYS[1], ARGV[1])
+    quantity = 0
+else
+    item.quantity = quantity
+    redis.call("HSET", KEYS[1], ARGV[1], cjson.encode(item))
+end
+redis.call("EXPIRE", KEYS[1], ARGV[3])
+
+return { "OK", quantity }
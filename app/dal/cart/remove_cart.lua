@@ -0,0 +1,17 @@
+-- KEYS:
+--   1: cart_key
+-- ARGV:
+--   1: ttl (秒)
+--   2..: product_id
+
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return { "NO_CART", 0 }
+end
+
+local n = 0
+for i = 2, #ARGV do
+    n = n + redis.call("HDEL", KEYS[1], ARGV[i])
+end
+redis.call("EXPIRE", KEYS[1], ARGV[1])
+
+return { "OK", n }
@@ -0,0 +1,31 @@
+-- 移入/移出稍后再买：移入时取消勾选，移回购物车时勾选
+-- KEYS:
+--   1: cart_key
+-- ARGV:
+--   1: saved (1/0)
+--   2: ttl (秒)
+--   3..: product_id
+
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return { "NO_CART", 0 }
+end
+
+local n = 0
+for i = 3, #ARGV do
+    local raw = redis.call("HGET", KEYS[1], ARGV[i])
+    if raw then
+        local item = cjson.decode(raw)
+        if ARGV[1] == "1" then
+            item.saved = true
+            item.unselected = true
+        else
+            item.saved = nil
+            item.unselected = nil
+        end
+        redis.call("HSET", KEYS[1], ARGV[i], cjson.encode(item))
+        n = n + 1
+    end
+end
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+
+return { "OK", n }
@@ -0,0 +1,44 @@
+-- 勾选/取消勾选商品，稍后再买的商品不参与
+-- KEYS:
+--   1: cart_key
+-- ARGV:
+--   1: selected (1/0)
+--   2: ttl (秒)
+--   3..: product_id，为空时处理全部商品
+
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return { "NO_CART", 0 }
+end
+
+local ids = {}
+if #ARGV > 2 then
+    for i = 3, #ARGV do
+        ids[#ids + 1] = ARGV[i]
+    end
+else
+    for _, field in ipairs(redis.call("HKEYS", KEYS[1])) do
+        if field ~= "_" then
+            ids[#ids + 1] = field
+        end
+    end
+end
+
+local n = 0
+for _, id in ipairs(ids) do
+    local raw = redis.call("HGET", KEYS[1], id)
+    if raw then
+        local item = cjson.decode(raw)
+        if not item.saved then
+            if ARGV[1] == "1" then
+                item.unselected = nil
+            else
+                item.unselected = true
+            end
+            redis.call("HSET", KEYS[1], id, cjson.encode(item))
+            n = n + 1
+        end
+    end
+end
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+
+return { "OK", n }
@@ -0,0 +1,16 @@
+-- 写入商品行，已存在时覆盖
+-- KEYS:
+--   1: cart_key
+-- ARGV:
+--   1: product_id
+--   2: 商品行 JSON
+--   3: ttl (秒)
+
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return "NO_CART"
+end
+
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+redis.call("EXPIRE", KEYS[1], ARGV[3])
+
+return "OK"
@@ -0,0 +1,10 @@
+-- 取出整个购物车并删除，保证同一游客购物车只被合并一次
+-- KEYS:
+--   1: cart_key
+
+local fields = redis.call("HGETALL", KEYS[1])
+if #fields > 0 then
+    redis.call("DEL", KEYS[1])
+end
+
+return fields
@@ -0,0 +1,49 @@
+-- 加入商品：不存在时写入新商品行；已存在时累加数量，并移回购物车、勾选
+-- KEYS:
+--   1: cart_key
+-- ARGV:
+--   1: product_id
+--   2: 新商品行 JSON
+--   3: delta
+--   4: ttl (秒)
+--   5: 商品行数上限，0 表示不限
+--   6: 单个商品数量上限，0 表示不限
+
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return { "NO_CART", 0 }
+end
+
+local delta = tonumber(ARGV[3]) or 0
+local maxLines = tonumber(ARGV[5]) or 0
+local maxQuantity = tonumber(ARGV[6]) or 0
+
+local raw = redis.call("HGET", KEYS[1], ARGV[1])
+if not raw then
+    if maxLines > 0 then
+        -- 占位字段不算商品行
+        local lines = redis.call("HLEN", KEYS[1]) - redis.call("HEXISTS", KEYS[1], "_")
+        if lines >= maxLines then
+            return { "CART_FULL", 0 }
+        end
+    end
+    if maxQuantity > 0 and delta > maxQuantity then
+        return { "QUANTITY_LIMIT", 0 }
+    end
+    redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+    redis.call("EXPIRE", KEYS[1], ARGV[4])
+    return { "CREATED", delta }
+end
+
+local item = cjson.decode(raw)
+local current = tonumber(item.quantity) or 0
+local quantity = current + delta
+if maxQuantity > 0 and quantity > maxQuantity then
+    return { "QUANTITY_LIMIT", current }
+end
+item.quantity = quantity
+item.unselected = nil
+item.saved = nil
+redis.call("HSET", KEYS[1], ARGV[1], cjson.encode(item))
+redis.call("EXPIRE", KEYS[1], ARGV[4])
+
+return { "UPDATED", quantity }
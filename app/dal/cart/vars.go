@@ -1,5 +1,15 @@
 package cart
 
-import "github.com/zeromicro/go-zero/core/stores/sqlx"
+import (
+	"errors"
 
-var ErrNotFound = sqlx.ErrNotFound
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var (
+	ErrNotFound = sqlx.ErrNotFound
+	// ErrCartFull 购物车商品行数达到上限
+	ErrCartFull = errors.New("cart is full")
+	// ErrQuantityLimit 单个商品数量超过上限
+	ErrQuantityLimit = errors.New("cart item quantity exceeds limit")
+)
@@ -0,0 +1,19 @@
+-- 从 MySQL 重建用户购物车，已存在时不覆盖
+-- KEYS:
+--   1: cart_key (cart:{user_id}:items，product_id -> 商品行 JSON，"_" 为占位字段)
+-- ARGV:
+--   1: ttl (秒)
+--   2..: product_id, 商品行 JSON 成对出现
+
+if redis.call("EXISTS", KEYS[1]) == 1 then
+    return 0
+end
+
+-- 空购物车也写入占位字段，避免每次读取都回源
+redis.call("HSET", KEYS[1], "_", "1")
+for i = 2, #ARGV, 2 do
+    redis.call("HSET", KEYS[1], ARGV[i], ARGV[i + 1])
+end
+redis.call("EXPIRE", KEYS[1], ARGV[1])
+
+return 1
@@ -0,0 +1,51 @@
+-- KEYS:
+--   1: meta_key    (coupon:{id}:meta，字段 stock/per_user_limit/start_at/end_at/claimed)
+--   2: users_key   (coupon:{id}:users，user_id -> 已领数量)
+--   3: pending_key (coupon:{id}:pending，claim_no -> user_id，尚未落库的领取)
+-- ARGV:
+--   1: user_id
+--   2: now (unix 秒)
+--   3: claim_no
+--   4: 1 表示不校验每人限领（活动发券、兑换码）
+
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return { "NO_META" }
+end
+
+local meta = redis.call("HMGET", KEYS[1], "stock", "per_user_limit", "start_at", "end_at")
+local stock = tonumber(meta[1]) or 0
+local limit = tonumber(meta[2]) or 0
+local now = tonumber(ARGV[2]) or 0
+
+if now < (tonumber(meta[3]) or 0) then
+    return { "NOT_STARTED" }
+end
+if now > (tonumber(meta[4]) or 0) then
+    return { "EXPIRED" }
+end
+
+if limit > 0 and ARGV[4] ~= "1" then
+    local owned = tonumber(redis.call("HGET", KEYS[2], ARGV[1]) or "0")
+    if owned >= limit then
+        return { "LIMIT" }
+    end
+end
+
+-- stock 为 -1 表示不限量
+if stock == 0 then
+    return { "SOLD_OUT" }
+end
+if stock > 0 then
+    redis.call("HINCRBY", KEYS[1], "stock", -1)
+end
+redis.call("HINCRBY", KEYS[2], ARGV[1], 1)
+redis.call("HINCRBY", KEYS[1], "claimed", 1)
+
+-- 在途记录与预热数据同时过期
+redis.call("HSET", KEYS[3], ARGV[3], ARGV[1])
+local ttl = redis.call("TTL", KEYS[1])
+if ttl > 0 then
+    redis.call("EXPIRE", KEYS[3], ttl)
+end
+
+return { "OK" }
@@ -0,0 +1,51 @@
+package coupon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ CouponCampaignIssuesModel = (*customCouponCampaignIssuesModel)(nil)
+
+type (
+	// CouponCampaignIssuesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customCouponCampaignIssuesModel.
+	CouponCampaignIssuesModel interface {
+		couponCampaignIssuesModel
+		// InsertIgnoreWithSession 占用活动内的用户发券名额，返回 0 表示已发过
+		InsertIgnoreWithSession(ctx context.Context, session sqlx.Session, campaignId, userId int64, bizKey string) (int64, error)
+		SetInstanceWithSession(ctx context.Context, session sqlx.Session, id, instanceId int64) error
+	}
+
+	customCouponCampaignIssuesModel struct {
+		*defaultCouponCampaignIssuesModel
+	}
+)
+
+// NewCouponCampaignIssuesModel returns a model for the database table.
+func NewCouponCampaignIssuesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) CouponCampaignIssuesModel {
+	return &customCouponCampaignIssuesModel{
+		defaultCouponCampaignIssuesModel: newCouponCampaignIssuesModel(conn, c, opts...),
+	}
+}
+
+func (m *customCouponCampaignIssuesModel) InsertIgnoreWithSession(ctx context.Context, session sqlx.Session, campaignId, userId int64, bizKey string) (int64, error) {
+	query := fmt.Sprintf("insert ignore into %s (`campaign_id`, `user_id`, `biz_key`) values (?, ?, ?)", m.table)
+	res, err := session.ExecCtx(ctx, query, campaignId, userId, bizKey)
+	if err != nil {
+		return 0, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (m *customCouponCampaignIssuesModel) SetInstanceWithSession(ctx context.Context, session sqlx.Session, id, instanceId int64) error {
+	query := fmt.Sprintf("update %s set `instance_id` = ? where `id` = ?", m.table)
+	_, err := session.ExecCtx(ctx, query, instanceId, id)
+	return err
+}
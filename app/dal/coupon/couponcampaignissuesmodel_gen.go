@@ -0,0 +1,150 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	couponCampaignIssuesFieldNames          = builder.RawFieldNames(&CouponCampaignIssues{})
+	couponCampaignIssuesRows                = strings.Join(couponCampaignIssuesFieldNames, ",")
+	couponCampaignIssuesRowsExpectAutoSet   = strings.Join(stringx.Remove(couponCampaignIssuesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	couponCampaignIssuesRowsWithPlaceHolder = strings.Join(stringx.Remove(couponCampaignIssuesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheCouponCampaignIssuesIdPrefix                     = "cache:couponCampaignIssues:id:"
+	cacheCouponCampaignIssuesCampaignIdUserIdBizKeyPrefix = "cache:couponCampaignIssues:campaignId:userId:bizKey:"
+)
+
+type (
+	couponCampaignIssuesModel interface {
+		Insert(ctx context.Context, data *CouponCampaignIssues) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*CouponCampaignIssues, error)
+		FindOneByCampaignIdUserIdBizKey(ctx context.Context, campaignId int64, userId int64, bizKey string) (*CouponCampaignIssues, error)
+		Update(ctx context.Context, data *CouponCampaignIssues) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultCouponCampaignIssuesModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	CouponCampaignIssues struct {
+		Id         int64     `db:"id"`          // 主键
+		CampaignId int64     `db:"campaign_id"` // 活动ID
+		UserId     int64     `db:"user_id"`     // 用户ID
+		BizKey     string    `db:"biz_key"`     // 幂等维度，生日券为年份，其余为空
+		InstanceId int64     `db:"instance_id"` // 发放的券实例ID
+		CreatedAt  time.Time `db:"created_at"`
+	}
+)
+
+func newCouponCampaignIssuesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultCouponCampaignIssuesModel {
+	return &defaultCouponCampaignIssuesModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`coupon_campaign_issues`",
+	}
+}
+
+func (m *defaultCouponCampaignIssuesModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	couponCampaignIssuesCampaignIdUserIdBizKeyKey := fmt.Sprintf("%s%v:%v:%v", cacheCouponCampaignIssuesCampaignIdUserIdBizKeyPrefix, data.CampaignId, data.UserId, data.BizKey)
+	couponCampaignIssuesIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignIssuesIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, couponCampaignIssuesCampaignIdUserIdBizKeyKey, couponCampaignIssuesIdKey)
+	return err
+}
+
+func (m *defaultCouponCampaignIssuesModel) FindOne(ctx context.Context, id int64) (*CouponCampaignIssues, error) {
+	couponCampaignIssuesIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignIssuesIdPrefix, id)
+	var resp CouponCampaignIssues
+	err := m.QueryRowCtx(ctx, &resp, couponCampaignIssuesIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCampaignIssuesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCampaignIssuesModel) FindOneByCampaignIdUserIdBizKey(ctx context.Context, campaignId int64, userId int64, bizKey string) (*CouponCampaignIssues, error) {
+	couponCampaignIssuesCampaignIdUserIdBizKeyKey := fmt.Sprintf("%s%v:%v:%v", cacheCouponCampaignIssuesCampaignIdUserIdBizKeyPrefix, campaignId, userId, bizKey)
+	var resp CouponCampaignIssues
+	err := m.QueryRowIndexCtx(ctx, &resp, couponCampaignIssuesCampaignIdUserIdBizKeyKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `campaign_id` = ? and `user_id` = ? and `biz_key` = ? limit 1", couponCampaignIssuesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, campaignId, userId, bizKey); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCampaignIssuesModel) Insert(ctx context.Context, data *CouponCampaignIssues) (sql.Result, error) {
+	couponCampaignIssuesCampaignIdUserIdBizKeyKey := fmt.Sprintf("%s%v:%v:%v", cacheCouponCampaignIssuesCampaignIdUserIdBizKeyPrefix, data.CampaignId, data.UserId, data.BizKey)
+	couponCampaignIssuesIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignIssuesIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", m.table, couponCampaignIssuesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.CampaignId, data.UserId, data.BizKey, data.InstanceId)
+	}, couponCampaignIssuesCampaignIdUserIdBizKeyKey, couponCampaignIssuesIdKey)
+	return ret, err
+}
+
+func (m *defaultCouponCampaignIssuesModel) Update(ctx context.Context, newData *CouponCampaignIssues) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	couponCampaignIssuesCampaignIdUserIdBizKeyKey := fmt.Sprintf("%s%v:%v:%v", cacheCouponCampaignIssuesCampaignIdUserIdBizKeyPrefix, data.CampaignId, data.UserId, data.BizKey)
+	couponCampaignIssuesIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignIssuesIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, couponCampaignIssuesRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.CampaignId, newData.UserId, newData.BizKey, newData.InstanceId, newData.Id)
+	}, couponCampaignIssuesCampaignIdUserIdBizKeyKey, couponCampaignIssuesIdKey)
+	return err
+}
+
+func (m *defaultCouponCampaignIssuesModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheCouponCampaignIssuesIdPrefix, primary)
+}
+
+func (m *defaultCouponCampaignIssuesModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCampaignIssuesRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultCouponCampaignIssuesModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,132 @@
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ CouponCampaignsModel = (*customCouponCampaignsModel)(nil)
+
+type (
+	// CouponCampaignsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customCouponCampaignsModel.
+	CouponCampaignsModel interface {
+		couponCampaignsModel
+		// ListActive 查询当前生效中的某类事件活动
+		ListActive(ctx context.Context, triggerType string, now time.Time) ([]*CouponCampaigns, error)
+		// ListDue 查询已到生效时间、尚未结束的活动，供调度任务推进
+		ListDue(ctx context.Context, now time.Time) ([]*CouponCampaigns, error)
+		List(ctx context.Context, triggerType, status string, offset, limit int) ([]*CouponCampaigns, int64, error)
+		// UpdateStatus 按前置状态迁移活动状态，返回是否更新
+		UpdateStatus(ctx context.Context, id int64, from []string, to string, lastError string) (bool, error)
+		// AddProgress 累加执行进度，cursor 大于 0 时同时推进 SEGMENT 扫描游标
+		AddProgress(ctx context.Context, id int64, p CampaignProgress) error
+		MarkRunDate(ctx context.Context, id int64, date time.Time) error
+	}
+
+	// CampaignProgress 一批用户的执行结果
+	CampaignProgress struct {
+		Scanned   int64
+		Issued    int64
+		Skipped   int64
+		Failed    int64
+		Cursor    int64
+		LastError string
+	}
+
+	customCouponCampaignsModel struct {
+		*defaultCouponCampaignsModel
+	}
+)
+
+// NewCouponCampaignsModel returns a model for the database table.
+func NewCouponCampaignsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) CouponCampaignsModel {
+	return &customCouponCampaignsModel{
+		defaultCouponCampaignsModel: newCouponCampaignsModel(conn, c, opts...),
+	}
+}
+
+func (m *customCouponCampaignsModel) ListActive(ctx context.Context, triggerType string, now time.Time) ([]*CouponCampaigns, error) {
+	query := fmt.Sprintf("select %s from %s where `trigger_type` = ? and `status` in (?, ?) and `start_at` <= ? and `end_at` >= ? order by `id` asc", couponCampaignsRows, m.table)
+	var list []*CouponCampaigns
+	err := m.QueryRowsNoCacheCtx(ctx, &list, query, triggerType, CampaignStatusScheduled, CampaignStatusRunning, now, now)
+	return list, err
+}
+
+func (m *customCouponCampaignsModel) ListDue(ctx context.Context, now time.Time) ([]*CouponCampaigns, error) {
+	query := fmt.Sprintf("select %s from %s where `status` in (?, ?) and `start_at` <= ? order by `id` asc", couponCampaignsRows, m.table)
+	var list []*CouponCampaigns
+	err := m.QueryRowsNoCacheCtx(ctx, &list, query, CampaignStatusScheduled, CampaignStatusRunning, now)
+	return list, err
+}
+
+func (m *customCouponCampaignsModel) List(ctx context.Context, triggerType, status string, offset, limit int) ([]*CouponCampaigns, int64, error) {
+	conds := []string{"1 = 1"}
+	var args []any
+	if triggerType != "" {
+		conds = append(conds, "`trigger_type` = ?")
+		args = append(args, triggerType)
+	}
+	if status != "" {
+		conds = append(conds, "`status` = ?")
+		args = append(args, status)
+	}
+	where := strings.Join(conds, " and ")
+
+	var total int64
+	countQuery := fmt.Sprintf("select count(1) from %s where %s", m.table, where)
+	if err := m.QueryRowNoCacheCtx(ctx, &total, countQuery, args...); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf("select %s from %s where %s order by `id` desc limit ? offset ?", couponCampaignsRows, m.table, where)
+	var list []*CouponCampaigns
+	if err := m.QueryRowsNoCacheCtx(ctx, &list, query, append(args, limit, offset)...); err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+func (m *customCouponCampaignsModel) UpdateStatus(ctx context.Context, id int64, from []string, to string, lastError string) (bool, error) {
+	couponCampaignsIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignsIdPrefix, id)
+	res, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(from)), ",")
+		args := []any{to, lastError, lastError, to, CampaignStatusCompleted, CampaignStatusCancelled, id}
+		for _, st := range from {
+			args = append(args, st)
+		}
+		// 完成、取消时记录结束时间
+		query := fmt.Sprintf("update %s set `status` = ?, `last_error` = if(? = '', `last_error`, ?), `finished_at` = if(? in (?, ?), now(), `finished_at`) where `id` = ? and `status` in (%s)", m.table, placeholders)
+		return conn.ExecCtx(ctx, query, args...)
+	}, couponCampaignsIdKey)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (m *customCouponCampaignsModel) AddProgress(ctx context.Context, id int64, p CampaignProgress) error {
+	couponCampaignsIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignsIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `scanned_count` = `scanned_count` + ?, `issued_count` = `issued_count` + ?, `skipped_count` = `skipped_count` + ?, `failed_count` = `failed_count` + ?, "+
+			"`cursor_user_id` = greatest(`cursor_user_id`, ?), `last_error` = if(? = '', `last_error`, ?) where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, p.Scanned, p.Issued, p.Skipped, p.Failed, p.Cursor, p.LastError, p.LastError, id)
+	}, couponCampaignsIdKey)
+	return err
+}
+
+func (m *customCouponCampaignsModel) MarkRunDate(ctx context.Context, id int64, date time.Time) error {
+	couponCampaignsIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignsIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `last_run_date` = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, date.Format(time.DateOnly), id)
+	}, couponCampaignsIdKey)
+	return err
+}
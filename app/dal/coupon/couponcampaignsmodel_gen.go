@@ -0,0 +1,127 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	couponCampaignsFieldNames          = builder.RawFieldNames(&CouponCampaigns{})
+	couponCampaignsRows                = strings.Join(couponCampaignsFieldNames, ",")
+	couponCampaignsRowsExpectAutoSet   = strings.Join(stringx.Remove(couponCampaignsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	couponCampaignsRowsWithPlaceHolder = strings.Join(stringx.Remove(couponCampaignsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheCouponCampaignsIdPrefix = "cache:couponCampaigns:id:"
+)
+
+type (
+	couponCampaignsModel interface {
+		Insert(ctx context.Context, data *CouponCampaigns) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*CouponCampaigns, error)
+		Update(ctx context.Context, data *CouponCampaigns) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultCouponCampaignsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	CouponCampaigns struct {
+		Id           int64          `db:"id"`             // 活动ID
+		Name         string         `db:"name"`           // 活动名称
+		CouponId     int64          `db:"coupon_id"`      // 发放的券模板ID
+		TriggerType  string         `db:"trigger_type"`   // 触发方式：注册/首单/生日/人群
+		Segment      sql.NullString `db:"segment"`        // 人群圈选条件，仅 SEGMENT 使用
+		Status       string         `db:"status"`         // 状态
+		StartAt      time.Time      `db:"start_at"`       // 生效时间，SEGMENT 为计划执行时间
+		EndAt        time.Time      `db:"end_at"`         // 结束时间
+		CursorUserId int64          `db:"cursor_user_id"` // SEGMENT 已扫描到的用户ID，中断后从此处继续
+		LastRunDate  sql.NullTime   `db:"last_run_date"`  // BIRTHDAY 最近一次执行的日期
+		ScannedCount int64          `db:"scanned_count"`  // 已扫描用户数
+		IssuedCount  int64          `db:"issued_count"`   // 已发券数
+		SkippedCount int64          `db:"skipped_count"`  // 不满足条件或已发过
+		FailedCount  int64          `db:"failed_count"`   // 发券失败数
+		LastError    string         `db:"last_error"`     // 最近一次失败原因
+		FinishedAt   sql.NullTime   `db:"finished_at"`    // 完成/取消时间
+		CreatedAt    time.Time      `db:"created_at"`
+		UpdatedAt    time.Time      `db:"updated_at"`
+	}
+)
+
+func newCouponCampaignsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultCouponCampaignsModel {
+	return &defaultCouponCampaignsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`coupon_campaigns`",
+	}
+}
+
+func (m *defaultCouponCampaignsModel) Delete(ctx context.Context, id int64) error {
+	couponCampaignsIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignsIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, couponCampaignsIdKey)
+	return err
+}
+
+func (m *defaultCouponCampaignsModel) FindOne(ctx context.Context, id int64) (*CouponCampaigns, error) {
+	couponCampaignsIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignsIdPrefix, id)
+	var resp CouponCampaigns
+	err := m.QueryRowCtx(ctx, &resp, couponCampaignsIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCampaignsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCampaignsModel) Insert(ctx context.Context, data *CouponCampaigns) (sql.Result, error) {
+	couponCampaignsIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignsIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, couponCampaignsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Name, data.CouponId, data.TriggerType, data.Segment, data.Status, data.StartAt, data.EndAt, data.CursorUserId, data.LastRunDate, data.ScannedCount, data.IssuedCount, data.SkippedCount, data.FailedCount, data.LastError, data.FinishedAt)
+	}, couponCampaignsIdKey)
+	return ret, err
+}
+
+func (m *defaultCouponCampaignsModel) Update(ctx context.Context, data *CouponCampaigns) error {
+	couponCampaignsIdKey := fmt.Sprintf("%s%v", cacheCouponCampaignsIdPrefix, data.Id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, couponCampaignsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.Name, data.CouponId, data.TriggerType, data.Segment, data.Status, data.StartAt, data.EndAt, data.CursorUserId, data.LastRunDate, data.ScannedCount, data.IssuedCount, data.SkippedCount, data.FailedCount, data.LastError, data.FinishedAt, data.Id)
+	}, couponCampaignsIdKey)
+	return err
+}
+
+func (m *defaultCouponCampaignsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheCouponCampaignsIdPrefix, primary)
+}
+
+func (m *defaultCouponCampaignsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCampaignsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultCouponCampaignsModel) tableName() string {
+	return m.table
+}
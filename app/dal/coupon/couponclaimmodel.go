@@ -0,0 +1,274 @@
+package coupon
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	// 同一张券的 key 使用相同 hash tag，保证脚本涉及的 key 落在同一 slot
+	claimMetaKeyPattern    = "coupon:{%d}:meta"
+	claimUsersKeyPattern   = "coupon:{%d}:users"
+	claimPendingKeyPattern = "coupon:{%d}:pending"
+	claimStagingKeyPattern = "coupon:{%d}:users:%d"
+
+	// 预热数据在券结束后保留的时间
+	claimKeyRetention = 24 * time.Hour
+	// 预热时分批读取在途记录、写入每人已领数量
+	claimWarmBatch = 500
+	// 暂存 key 的过期时间，预热中途失败时自动清理
+	claimStagingTTL = 300
+)
+
+var (
+	//go:embed claim.lua
+	claimScriptText string
+	//go:embed unclaim.lua
+	unclaimScriptText string
+	//go:embed warm_claim.lua
+	warmClaimScriptText string
+	//go:embed sync_claim.lua
+	syncClaimScriptText string
+
+	claimScript     = redis.NewScript(claimScriptText)
+	unclaimScript   = redis.NewScript(unclaimScriptText)
+	warmClaimScript = redis.NewScript(warmClaimScriptText)
+	syncClaimScript = redis.NewScript(syncClaimScriptText)
+)
+
+type (
+	// CouponClaimModel 基于 Redis 的领券判定：库存、每人限领与有效期在 Lua 脚本中原子完成，
+	// 券实例由调用方异步落库。尚未落库的领取按流水号记为在途，重新预热时计入。
+	CouponClaimModel interface {
+		// Claim 领取一张券，未预热时先从 MySQL 预热
+		Claim(ctx context.Context, couponId, userId int64, claimNo string, now time.Time) error
+		// Reserve 与 Claim 相同但不校验每人限领，供活动发券、兑换码占用库存；
+		// 券实例由调用方同步落库后 Confirm，失败时 Unclaim
+		Reserve(ctx context.Context, couponId, userId int64, claimNo string, now time.Time) error
+		// Unclaim 撤销一次领取，用于领取消息投递失败时回滚
+		Unclaim(ctx context.Context, couponId, userId int64, claimNo string) error
+		// Confirm 领取落库后移除在途记录
+		Confirm(ctx context.Context, couponId int64, claimNo string) error
+		// Claimed 返回 Redis 中记录的已领取数量，未预热时 ok 为 false
+		Claimed(ctx context.Context, couponId int64) (claimed int64, ok bool, err error)
+		// Sync 券模板修改或撤销后按 MySQL 中的配置原地更新预热数据，已领数量保持不变
+		Sync(ctx context.Context, couponId int64) error
+		// Invalidate 删除预热元数据，下次领券从 MySQL 重新预热；用于 Sync 失败时兜底
+		Invalidate(ctx context.Context, couponId int64) error
+	}
+
+	defaultCouponClaimModel struct {
+		redis     *redis.Redis
+		conn      sqlx.SqlConn
+		coupons   CouponsModel
+		instances CouponInstancesModel
+	}
+)
+
+func NewCouponClaimModel(r *redis.Redis, conn sqlx.SqlConn, coupons CouponsModel, instances CouponInstancesModel) CouponClaimModel {
+	return &defaultCouponClaimModel{
+		redis:     r,
+		conn:      conn,
+		coupons:   coupons,
+		instances: instances,
+	}
+}
+
+func claimKeys(couponId int64) []string {
+	return []string{
+		fmt.Sprintf(claimMetaKeyPattern, couponId),
+		fmt.Sprintf(claimUsersKeyPattern, couponId),
+		fmt.Sprintf(claimPendingKeyPattern, couponId),
+	}
+}
+
+func (m *defaultCouponClaimModel) Claim(ctx context.Context, couponId, userId int64, claimNo string, now time.Time) error {
+	return m.claim(ctx, couponId, userId, claimNo, now, false)
+}
+
+func (m *defaultCouponClaimModel) Reserve(ctx context.Context, couponId, userId int64, claimNo string, now time.Time) error {
+	return m.claim(ctx, couponId, userId, claimNo, now, true)
+}
+
+func (m *defaultCouponClaimModel) claim(ctx context.Context, couponId, userId int64, claimNo string, now time.Time, ignoreLimit bool) error {
+	keys := claimKeys(couponId)
+	args := []any{strconv.FormatInt(userId, 10), now.Unix(), claimNo, "0"}
+	if ignoreLimit {
+		args[3] = "1"
+	}
+
+	code, err := m.runClaim(ctx, keys, args)
+	if err != nil {
+		return err
+	}
+	if code == "NO_META" {
+		if err := m.warm(ctx, couponId); err != nil {
+			return err
+		}
+		if code, err = m.runClaim(ctx, keys, args); err != nil {
+			return err
+		}
+	}
+
+	switch code {
+	case "OK":
+		return nil
+	case "NOT_STARTED":
+		return ErrCouponNotStarted
+	case "EXPIRED":
+		return ErrCouponExpired
+	case "LIMIT":
+		return ErrCouponClaimLimit
+	case "SOLD_OUT":
+		return ErrCouponSoldOut
+	default:
+		return fmt.Errorf("coupon claim script returned %q", code)
+	}
+}
+
+func (m *defaultCouponClaimModel) runClaim(ctx context.Context, keys []string, args []any) (string, error) {
+	res, err := m.redis.ScriptRunCtx(ctx, claimScript, keys, args...)
+	if err != nil {
+		return "", err
+	}
+	list, ok := res.([]any)
+	if !ok || len(list) == 0 {
+		return "", errors.New("unexpected coupon claim script result")
+	}
+	code, _ := list[0].(string)
+	return code, nil
+}
+
+func (m *defaultCouponClaimModel) Unclaim(ctx context.Context, couponId, userId int64, claimNo string) error {
+	_, err := m.redis.ScriptRunCtx(ctx, unclaimScript, claimKeys(couponId), strconv.FormatInt(userId, 10), claimNo)
+	return err
+}
+
+func (m *defaultCouponClaimModel) Confirm(ctx context.Context, couponId int64, claimNo string) error {
+	_, err := m.redis.HdelCtx(ctx, fmt.Sprintf(claimPendingKeyPattern, couponId), claimNo)
+	return err
+}
+
+func (m *defaultCouponClaimModel) Claimed(ctx context.Context, couponId int64) (int64, bool, error) {
+	val, err := m.redis.HgetCtx(ctx, fmt.Sprintf(claimMetaKeyPattern, couponId), "claimed")
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	claimed, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return claimed, true, nil
+}
+
+func (m *defaultCouponClaimModel) Sync(ctx context.Context, couponId int64) error {
+	tpl, err := m.coupons.FindOne(ctx, couponId)
+	if err != nil {
+		return err
+	}
+	args := []any{tpl.TotalQuantity, tpl.PerUserLimit, tpl.EndAt.Unix(), tpl.EndAt.Add(claimKeyRetention).Unix()}
+	_, err = m.redis.ScriptRunCtx(ctx, syncClaimScript, claimKeys(couponId), args...)
+	return err
+}
+
+func (m *defaultCouponClaimModel) Invalidate(ctx context.Context, couponId int64) error {
+	_, err := m.redis.DelCtx(ctx, fmt.Sprintf(claimMetaKeyPattern, couponId))
+	return err
+}
+
+// warm 以 MySQL 中已落库的券实例加上 Redis 中的在途领取预热剩余库存与每人已领数量。
+// 先读在途记录再查 MySQL：期间落库的领取最多被重复计入，不会漏算。
+func (m *defaultCouponClaimModel) warm(ctx context.Context, couponId int64) error {
+	tpl, err := m.coupons.FindOne(ctx, couponId)
+	if err != nil {
+		return err
+	}
+	owned, err := m.pendingByUser(ctx, couponId)
+	if err != nil {
+		return err
+	}
+	counts, err := m.instances.CountUsersByCoupon(ctx, m.conn, couponId)
+	if err != nil {
+		return err
+	}
+	for _, c := range counts {
+		owned[c.UserId] += c.Count
+	}
+
+	var claimed int64
+	staging := fmt.Sprintf(claimStagingKeyPattern, couponId, time.Now().UnixNano())
+	batch := make(map[string]string, claimWarmBatch)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := m.redis.HmsetCtx(ctx, staging, batch); err != nil {
+			return err
+		}
+		clear(batch)
+		return m.redis.ExpireCtx(ctx, staging, claimStagingTTL)
+	}
+	for userId, count := range owned {
+		claimed += count
+		batch[strconv.FormatInt(userId, 10)] = strconv.FormatInt(count, 10)
+		if len(batch) >= claimWarmBatch {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	stock := int64(-1)
+	if tpl.TotalQuantity > 0 {
+		stock = max(tpl.TotalQuantity-claimed, 0)
+	}
+	keys := []string{
+		fmt.Sprintf(claimMetaKeyPattern, couponId),
+		fmt.Sprintf(claimUsersKeyPattern, couponId),
+		staging,
+	}
+	args := []any{stock, tpl.PerUserLimit, tpl.StartAt.Unix(), tpl.EndAt.Unix(), claimed, tpl.EndAt.Add(claimKeyRetention).Unix()}
+	_, err = m.redis.ScriptRunCtx(ctx, warmClaimScript, keys, args...)
+	return err
+}
+
+// pendingByUser 分批扫描在途领取，按用户汇总数量；HSCAN 可能重复返回，按流水号去重
+func (m *defaultCouponClaimModel) pendingByUser(ctx context.Context, couponId int64) (map[int64]int64, error) {
+	key := fmt.Sprintf(claimPendingKeyPattern, couponId)
+	claims := make(map[string]int64)
+	var cursor uint64
+	for {
+		kvs, next, err := m.redis.HscanCtx(ctx, key, cursor, "", claimWarmBatch)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i+1 < len(kvs); i += 2 {
+			if userId, err := strconv.ParseInt(kvs[i+1], 10, 64); err == nil {
+				claims[kvs[i]] = userId
+			}
+		}
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+
+	owned := make(map[int64]int64, len(claims))
+	for _, userId := range claims {
+		owned[userId]++
+	}
+	return owned, nil
+}
@@ -0,0 +1,104 @@
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ CouponCodeBatchesModel = (*customCouponCodeBatchesModel)(nil)
+
+type (
+	// CouponCodeBatchesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customCouponCodeBatchesModel.
+	CouponCodeBatchesModel interface {
+		couponCodeBatchesModel
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *CouponCodeBatches) (int64, error)
+		// FindBySharedCode 不走缓存，兑换时读取最新的兑换次数与状态
+		FindBySharedCode(ctx context.Context, code string) (*CouponCodeBatches, error)
+		// IncrRedeemedWithSession 累加兑换次数，capped 时超过 total_quantity 返回 ErrCouponSoldOut
+		IncrRedeemedWithSession(ctx context.Context, session sqlx.Session, id int64, capped bool) error
+		UpdateStatus(ctx context.Context, id int64, status string) error
+		// DelCache 事务内更新批次后清理缓存
+		DelCache(ctx context.Context, data *CouponCodeBatches) error
+	}
+
+	customCouponCodeBatchesModel struct {
+		*defaultCouponCodeBatchesModel
+	}
+)
+
+// NewCouponCodeBatchesModel returns a model for the database table.
+func NewCouponCodeBatchesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) CouponCodeBatchesModel {
+	return &customCouponCodeBatchesModel{
+		defaultCouponCodeBatchesModel: newCouponCodeBatchesModel(conn, c, opts...),
+	}
+}
+
+func (m *customCouponCodeBatchesModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *CouponCodeBatches) (int64, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?)", m.table, couponCodeBatchesRowsExpectAutoSet)
+	res, err := session.ExecCtx(ctx, query, data.CouponId, data.Name, data.CodeType, data.SharedCode, data.TotalQuantity, data.RedeemedCount, data.Status)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (m *customCouponCodeBatchesModel) FindBySharedCode(ctx context.Context, code string) (*CouponCodeBatches, error) {
+	var resp CouponCodeBatches
+	query := fmt.Sprintf("select %s from %s where `shared_code` = ? limit 1", couponCodeBatchesRows, m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, code)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *customCouponCodeBatchesModel) IncrRedeemedWithSession(ctx context.Context, session sqlx.Session, id int64, capped bool) error {
+	query := fmt.Sprintf("update %s set `redeemed_count` = `redeemed_count` + 1 where `id` = ?", m.table)
+	if capped {
+		query += " and `redeemed_count` < `total_quantity`"
+	}
+	res, err := session.ExecCtx(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrCouponSoldOut
+	}
+	return nil
+}
+
+func (m *customCouponCodeBatchesModel) UpdateStatus(ctx context.Context, id int64, status string) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, status, id)
+	}, m.cacheKeys(data)...)
+	return err
+}
+
+func (m *customCouponCodeBatchesModel) DelCache(ctx context.Context, data *CouponCodeBatches) error {
+	return m.DelCacheCtx(ctx, m.cacheKeys(data)...)
+}
+
+func (m *customCouponCodeBatchesModel) cacheKeys(data *CouponCodeBatches) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheCouponCodeBatchesIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheCouponCodeBatchesSharedCodePrefix, data.SharedCode),
+	}
+}
@@ -0,0 +1,154 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	couponCodeBatchesFieldNames          = builder.RawFieldNames(&CouponCodeBatches{})
+	couponCodeBatchesRows                = strings.Join(couponCodeBatchesFieldNames, ",")
+	couponCodeBatchesRowsExpectAutoSet   = strings.Join(stringx.Remove(couponCodeBatchesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	couponCodeBatchesRowsWithPlaceHolder = strings.Join(stringx.Remove(couponCodeBatchesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheCouponCodeBatchesIdPrefix         = "cache:couponCodeBatches:id:"
+	cacheCouponCodeBatchesSharedCodePrefix = "cache:couponCodeBatches:sharedCode:"
+)
+
+type (
+	couponCodeBatchesModel interface {
+		Insert(ctx context.Context, data *CouponCodeBatches) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*CouponCodeBatches, error)
+		FindOneBySharedCode(ctx context.Context, sharedCode sql.NullString) (*CouponCodeBatches, error)
+		Update(ctx context.Context, data *CouponCodeBatches) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultCouponCodeBatchesModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	CouponCodeBatches struct {
+		Id            int64          `db:"id"`             // 兑换码批次ID
+		CouponId      int64          `db:"coupon_id"`      // 兑换的券模板ID
+		Name          string         `db:"name"`           // 批次名称
+		CodeType      string         `db:"code_type"`      // SHARED：公共码多人可用，UNIQUE：一码一用
+		SharedCode    sql.NullString `db:"shared_code"`    // 公共码，仅 SHARED 使用
+		TotalQuantity int64          `db:"total_quantity"` // SHARED 为可兑换次数上限，UNIQUE 为生成的码数量
+		RedeemedCount int64          `db:"redeemed_count"` // 已兑换次数
+		Status        string         `db:"status"`         // 状态
+		CreatedAt     time.Time      `db:"created_at"`
+		UpdatedAt     time.Time      `db:"updated_at"`
+	}
+)
+
+func newCouponCodeBatchesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultCouponCodeBatchesModel {
+	return &defaultCouponCodeBatchesModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`coupon_code_batches`",
+	}
+}
+
+func (m *defaultCouponCodeBatchesModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	couponCodeBatchesIdKey := fmt.Sprintf("%s%v", cacheCouponCodeBatchesIdPrefix, id)
+	couponCodeBatchesSharedCodeKey := fmt.Sprintf("%s%v", cacheCouponCodeBatchesSharedCodePrefix, data.SharedCode)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, couponCodeBatchesIdKey, couponCodeBatchesSharedCodeKey)
+	return err
+}
+
+func (m *defaultCouponCodeBatchesModel) FindOne(ctx context.Context, id int64) (*CouponCodeBatches, error) {
+	couponCodeBatchesIdKey := fmt.Sprintf("%s%v", cacheCouponCodeBatchesIdPrefix, id)
+	var resp CouponCodeBatches
+	err := m.QueryRowCtx(ctx, &resp, couponCodeBatchesIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCodeBatchesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCodeBatchesModel) FindOneBySharedCode(ctx context.Context, sharedCode sql.NullString) (*CouponCodeBatches, error) {
+	couponCodeBatchesSharedCodeKey := fmt.Sprintf("%s%v", cacheCouponCodeBatchesSharedCodePrefix, sharedCode)
+	var resp CouponCodeBatches
+	err := m.QueryRowIndexCtx(ctx, &resp, couponCodeBatchesSharedCodeKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `shared_code` = ? limit 1", couponCodeBatchesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, sharedCode); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCodeBatchesModel) Insert(ctx context.Context, data *CouponCodeBatches) (sql.Result, error) {
+	couponCodeBatchesIdKey := fmt.Sprintf("%s%v", cacheCouponCodeBatchesIdPrefix, data.Id)
+	couponCodeBatchesSharedCodeKey := fmt.Sprintf("%s%v", cacheCouponCodeBatchesSharedCodePrefix, data.SharedCode)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?)", m.table, couponCodeBatchesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.CouponId, data.Name, data.CodeType, data.SharedCode, data.TotalQuantity, data.RedeemedCount, data.Status)
+	}, couponCodeBatchesIdKey, couponCodeBatchesSharedCodeKey)
+	return ret, err
+}
+
+func (m *defaultCouponCodeBatchesModel) Update(ctx context.Context, newData *CouponCodeBatches) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	couponCodeBatchesIdKey := fmt.Sprintf("%s%v", cacheCouponCodeBatchesIdPrefix, data.Id)
+	couponCodeBatchesSharedCodeKey := fmt.Sprintf("%s%v", cacheCouponCodeBatchesSharedCodePrefix, data.SharedCode)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, couponCodeBatchesRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.CouponId, newData.Name, newData.CodeType, newData.SharedCode, newData.TotalQuantity, newData.RedeemedCount, newData.Status, newData.Id)
+	}, couponCodeBatchesIdKey, couponCodeBatchesSharedCodeKey)
+	return err
+}
+
+func (m *defaultCouponCodeBatchesModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheCouponCodeBatchesIdPrefix, primary)
+}
+
+func (m *defaultCouponCodeBatchesModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCodeBatchesRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultCouponCodeBatchesModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,51 @@
+package coupon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ CouponCodeRedemptionsModel = (*customCouponCodeRedemptionsModel)(nil)
+
+type (
+	// CouponCodeRedemptionsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customCouponCodeRedemptionsModel.
+	CouponCodeRedemptionsModel interface {
+		couponCodeRedemptionsModel
+		// InsertIgnoreWithSession 占用批次内的用户兑换名额，返回 0 表示已兑换过
+		InsertIgnoreWithSession(ctx context.Context, session sqlx.Session, batchId, userId int64, code string) (int64, error)
+		SetInstanceWithSession(ctx context.Context, session sqlx.Session, id, instanceId int64) error
+	}
+
+	customCouponCodeRedemptionsModel struct {
+		*defaultCouponCodeRedemptionsModel
+	}
+)
+
+// NewCouponCodeRedemptionsModel returns a model for the database table.
+func NewCouponCodeRedemptionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) CouponCodeRedemptionsModel {
+	return &customCouponCodeRedemptionsModel{
+		defaultCouponCodeRedemptionsModel: newCouponCodeRedemptionsModel(conn, c, opts...),
+	}
+}
+
+func (m *customCouponCodeRedemptionsModel) InsertIgnoreWithSession(ctx context.Context, session sqlx.Session, batchId, userId int64, code string) (int64, error) {
+	query := fmt.Sprintf("insert ignore into %s (`batch_id`, `user_id`, `code`) values (?, ?, ?)", m.table)
+	res, err := session.ExecCtx(ctx, query, batchId, userId, code)
+	if err != nil {
+		return 0, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (m *customCouponCodeRedemptionsModel) SetInstanceWithSession(ctx context.Context, session sqlx.Session, id, instanceId int64) error {
+	query := fmt.Sprintf("update %s set `instance_id` = ? where `id` = ?", m.table)
+	_, err := session.ExecCtx(ctx, query, instanceId, id)
+	return err
+}
@@ -0,0 +1,150 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	couponCodeRedemptionsFieldNames          = builder.RawFieldNames(&CouponCodeRedemptions{})
+	couponCodeRedemptionsRows                = strings.Join(couponCodeRedemptionsFieldNames, ",")
+	couponCodeRedemptionsRowsExpectAutoSet   = strings.Join(stringx.Remove(couponCodeRedemptionsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	couponCodeRedemptionsRowsWithPlaceHolder = strings.Join(stringx.Remove(couponCodeRedemptionsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheCouponCodeRedemptionsIdPrefix            = "cache:couponCodeRedemptions:id:"
+	cacheCouponCodeRedemptionsBatchIdUserIdPrefix = "cache:couponCodeRedemptions:batchId:userId:"
+)
+
+type (
+	couponCodeRedemptionsModel interface {
+		Insert(ctx context.Context, data *CouponCodeRedemptions) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*CouponCodeRedemptions, error)
+		FindOneByBatchIdUserId(ctx context.Context, batchId int64, userId int64) (*CouponCodeRedemptions, error)
+		Update(ctx context.Context, data *CouponCodeRedemptions) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultCouponCodeRedemptionsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	CouponCodeRedemptions struct {
+		Id         int64     `db:"id"`          // 主键
+		BatchId    int64     `db:"batch_id"`    // 兑换码批次ID
+		UserId     int64     `db:"user_id"`     // 兑换用户ID
+		Code       string    `db:"code"`        // 兑换使用的码
+		InstanceId int64     `db:"instance_id"` // 发放的券实例ID
+		CreatedAt  time.Time `db:"created_at"`
+	}
+)
+
+func newCouponCodeRedemptionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultCouponCodeRedemptionsModel {
+	return &defaultCouponCodeRedemptionsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`coupon_code_redemptions`",
+	}
+}
+
+func (m *defaultCouponCodeRedemptionsModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	couponCodeRedemptionsBatchIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheCouponCodeRedemptionsBatchIdUserIdPrefix, data.BatchId, data.UserId)
+	couponCodeRedemptionsIdKey := fmt.Sprintf("%s%v", cacheCouponCodeRedemptionsIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, couponCodeRedemptionsBatchIdUserIdKey, couponCodeRedemptionsIdKey)
+	return err
+}
+
+func (m *defaultCouponCodeRedemptionsModel) FindOne(ctx context.Context, id int64) (*CouponCodeRedemptions, error) {
+	couponCodeRedemptionsIdKey := fmt.Sprintf("%s%v", cacheCouponCodeRedemptionsIdPrefix, id)
+	var resp CouponCodeRedemptions
+	err := m.QueryRowCtx(ctx, &resp, couponCodeRedemptionsIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCodeRedemptionsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCodeRedemptionsModel) FindOneByBatchIdUserId(ctx context.Context, batchId int64, userId int64) (*CouponCodeRedemptions, error) {
+	couponCodeRedemptionsBatchIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheCouponCodeRedemptionsBatchIdUserIdPrefix, batchId, userId)
+	var resp CouponCodeRedemptions
+	err := m.QueryRowIndexCtx(ctx, &resp, couponCodeRedemptionsBatchIdUserIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `batch_id` = ? and `user_id` = ? limit 1", couponCodeRedemptionsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, batchId, userId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCodeRedemptionsModel) Insert(ctx context.Context, data *CouponCodeRedemptions) (sql.Result, error) {
+	couponCodeRedemptionsBatchIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheCouponCodeRedemptionsBatchIdUserIdPrefix, data.BatchId, data.UserId)
+	couponCodeRedemptionsIdKey := fmt.Sprintf("%s%v", cacheCouponCodeRedemptionsIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", m.table, couponCodeRedemptionsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.BatchId, data.UserId, data.Code, data.InstanceId)
+	}, couponCodeRedemptionsBatchIdUserIdKey, couponCodeRedemptionsIdKey)
+	return ret, err
+}
+
+func (m *defaultCouponCodeRedemptionsModel) Update(ctx context.Context, newData *CouponCodeRedemptions) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	couponCodeRedemptionsBatchIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheCouponCodeRedemptionsBatchIdUserIdPrefix, data.BatchId, data.UserId)
+	couponCodeRedemptionsIdKey := fmt.Sprintf("%s%v", cacheCouponCodeRedemptionsIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, couponCodeRedemptionsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.BatchId, newData.UserId, newData.Code, newData.InstanceId, newData.Id)
+	}, couponCodeRedemptionsBatchIdUserIdKey, couponCodeRedemptionsIdKey)
+	return err
+}
+
+func (m *defaultCouponCodeRedemptionsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheCouponCodeRedemptionsIdPrefix, primary)
+}
+
+func (m *defaultCouponCodeRedemptionsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCodeRedemptionsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultCouponCodeRedemptionsModel) tableName() string {
+	return m.table
+}
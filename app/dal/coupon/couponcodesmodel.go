@@ -0,0 +1,93 @@
+package coupon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ CouponCodesModel = (*customCouponCodesModel)(nil)
+
+type (
+	// CouponCodesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customCouponCodesModel.
+	CouponCodesModel interface {
+		couponCodesModel
+		// InsertIgnoreWithSession 批量写入一次性兑换码，重复的码被忽略，返回实际写入数量
+		InsertIgnoreWithSession(ctx context.Context, session sqlx.Session, batchId int64, codes []string) (int64, error)
+		// FindByCode 不走缓存，兑换时读取最新状态
+		FindByCode(ctx context.Context, code string) (*CouponCodes, error)
+		// RedeemWithSession 将未使用的码标记为已兑换，已被兑换时返回 ErrCouponStatusConflict
+		RedeemWithSession(ctx context.Context, session sqlx.Session, id, userId int64, at time.Time) error
+		ListByBatch(ctx context.Context, batchId, afterId int64, limit int) ([]*CouponCodes, error)
+	}
+
+	customCouponCodesModel struct {
+		*defaultCouponCodesModel
+	}
+)
+
+// NewCouponCodesModel returns a model for the database table.
+func NewCouponCodesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) CouponCodesModel {
+	return &customCouponCodesModel{
+		defaultCouponCodesModel: newCouponCodesModel(conn, c, opts...),
+	}
+}
+
+func (m *customCouponCodesModel) InsertIgnoreWithSession(ctx context.Context, session sqlx.Session, batchId int64, codes []string) (int64, error) {
+	if len(codes) == 0 {
+		return 0, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("(?, ?),", len(codes)), ",")
+	args := make([]any, 0, len(codes)*2)
+	for _, code := range codes {
+		args = append(args, batchId, code)
+	}
+	query := fmt.Sprintf("insert ignore into %s (`batch_id`, `code`) values %s", m.table, placeholders)
+	res, err := session.ExecCtx(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (m *customCouponCodesModel) FindByCode(ctx context.Context, code string) (*CouponCodes, error) {
+	var resp CouponCodes
+	query := fmt.Sprintf("select %s from %s where `code` = ? limit 1", couponCodesRows, m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, code)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *customCouponCodesModel) RedeemWithSession(ctx context.Context, session sqlx.Session, id, userId int64, at time.Time) error {
+	query := fmt.Sprintf("update %s set `status` = ?, `user_id` = ?, `redeemed_at` = ? where `id` = ? and `status` = ?", m.table)
+	res, err := session.ExecCtx(ctx, query, CodeStatusRedeemed, userId, at, id, CodeStatusUnused)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrCouponStatusConflict
+	}
+	return nil
+}
+
+func (m *customCouponCodesModel) ListByBatch(ctx context.Context, batchId, afterId int64, limit int) ([]*CouponCodes, error) {
+	query := fmt.Sprintf("select %s from %s where `batch_id` = ? and `id` > ? order by `id` asc limit ?", couponCodesRows, m.table)
+	var list []*CouponCodes
+	err := m.QueryRowsNoCacheCtx(ctx, &list, query, batchId, afterId, limit)
+	return list, err
+}
@@ -0,0 +1,151 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	couponCodesFieldNames          = builder.RawFieldNames(&CouponCodes{})
+	couponCodesRows                = strings.Join(couponCodesFieldNames, ",")
+	couponCodesRowsExpectAutoSet   = strings.Join(stringx.Remove(couponCodesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	couponCodesRowsWithPlaceHolder = strings.Join(stringx.Remove(couponCodesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheCouponCodesIdPrefix   = "cache:couponCodes:id:"
+	cacheCouponCodesCodePrefix = "cache:couponCodes:code:"
+)
+
+type (
+	couponCodesModel interface {
+		Insert(ctx context.Context, data *CouponCodes) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*CouponCodes, error)
+		FindOneByCode(ctx context.Context, code string) (*CouponCodes, error)
+		Update(ctx context.Context, data *CouponCodes) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultCouponCodesModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	CouponCodes struct {
+		Id         int64        `db:"id"`          // 主键
+		BatchId    int64        `db:"batch_id"`    // 兑换码批次ID
+		Code       string       `db:"code"`        // 一次性兑换码
+		Status     string       `db:"status"`      // 状态
+		UserId     int64        `db:"user_id"`     // 兑换用户ID
+		RedeemedAt sql.NullTime `db:"redeemed_at"` // 兑换时间
+		CreatedAt  time.Time    `db:"created_at"`
+	}
+)
+
+func newCouponCodesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultCouponCodesModel {
+	return &defaultCouponCodesModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`coupon_codes`",
+	}
+}
+
+func (m *defaultCouponCodesModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	couponCodesCodeKey := fmt.Sprintf("%s%v", cacheCouponCodesCodePrefix, data.Code)
+	couponCodesIdKey := fmt.Sprintf("%s%v", cacheCouponCodesIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, couponCodesCodeKey, couponCodesIdKey)
+	return err
+}
+
+func (m *defaultCouponCodesModel) FindOne(ctx context.Context, id int64) (*CouponCodes, error) {
+	couponCodesIdKey := fmt.Sprintf("%s%v", cacheCouponCodesIdPrefix, id)
+	var resp CouponCodes
+	err := m.QueryRowCtx(ctx, &resp, couponCodesIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCodesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCodesModel) FindOneByCode(ctx context.Context, code string) (*CouponCodes, error) {
+	couponCodesCodeKey := fmt.Sprintf("%s%v", cacheCouponCodesCodePrefix, code)
+	var resp CouponCodes
+	err := m.QueryRowIndexCtx(ctx, &resp, couponCodesCodeKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `code` = ? limit 1", couponCodesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, code); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponCodesModel) Insert(ctx context.Context, data *CouponCodes) (sql.Result, error) {
+	couponCodesCodeKey := fmt.Sprintf("%s%v", cacheCouponCodesCodePrefix, data.Code)
+	couponCodesIdKey := fmt.Sprintf("%s%v", cacheCouponCodesIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, couponCodesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.BatchId, data.Code, data.Status, data.UserId, data.RedeemedAt)
+	}, couponCodesCodeKey, couponCodesIdKey)
+	return ret, err
+}
+
+func (m *defaultCouponCodesModel) Update(ctx context.Context, newData *CouponCodes) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	couponCodesCodeKey := fmt.Sprintf("%s%v", cacheCouponCodesCodePrefix, data.Code)
+	couponCodesIdKey := fmt.Sprintf("%s%v", cacheCouponCodesIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, couponCodesRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.BatchId, newData.Code, newData.Status, newData.UserId, newData.RedeemedAt, newData.Id)
+	}, couponCodesCodeKey, couponCodesIdKey)
+	return err
+}
+
+func (m *defaultCouponCodesModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheCouponCodesIdPrefix, primary)
+}
+
+func (m *defaultCouponCodesModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponCodesRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultCouponCodesModel) tableName() string {
+	return m.table
+}
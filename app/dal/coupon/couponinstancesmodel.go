@@ -21,10 +21,18 @@ type (
 		CountByUserCouponWithSession(ctx context.Context, session sqlx.Session, userId, couponId int64) (int64, error)
 		FindDetailForUpdate(ctx context.Context, session sqlx.Session, instanceId, userId int64) (*CouponInstanceDetail, error)
 		FindDetail(ctx context.Context, conn sqlx.SqlConn, instanceId, userId int64) (*CouponInstanceDetail, error)
-		LockWithSession(ctx context.Context, session sqlx.Session, instanceId, userId, orderId int64, lockTime time.Time) error
+		LockWithSession(ctx context.Context, session sqlx.Session, instanceId, userId, orderId, lockedAmount int64, lockTime time.Time) error
 		ReleaseWithSession(ctx context.Context, session sqlx.Session, instanceId, userId, orderId int64) error
 		RedeemWithSession(ctx context.Context, session sqlx.Session, instanceId, userId, orderId int64, usedAt time.Time) error
 		ListUserCoupons(ctx context.Context, conn sqlx.SqlConn, userId int64, status string, offset, limit int) ([]*CouponInstanceDetail, int64, error)
+		ExpireOverdue(ctx context.Context, conn sqlx.SqlConn, now time.Time, limit int) (int64, error)
+		ListLockedBefore(ctx context.Context, conn sqlx.SqlConn, lockedBefore time.Time, afterId int64, limit int) ([]*CouponInstances, error)
+		InsertClaimWithSession(ctx context.Context, session sqlx.Session, couponId, userId int64, claimNo string) (bool, error)
+		CountUsersByCoupon(ctx context.Context, conn sqlx.SqlConn, couponId int64) ([]*UserClaimCount, error)
+		// CountByStatus 统计某券模板在 [from, to) 内发放的实例各状态数量
+		CountByStatus(ctx context.Context, conn sqlx.SqlConn, couponId int64, from, to time.Time) ([]*StatusCount, error)
+		// ListUsedOrderIds 按 id 游标分页查询 [from, to) 内发放且已使用的实例对应的订单ID
+		ListUsedOrderIds(ctx context.Context, conn sqlx.SqlConn, couponId int64, from, to time.Time, afterId int64, limit int) ([]*UsedOrder, error)
 	}
 
 	customCouponInstancesModel struct {
@@ -46,6 +54,7 @@ type CouponInstanceDetail struct {
 	Status          string       `db:"status"`
 	LockedPreorder  int64        `db:"locked_preorder"`
 	LockedAt        sql.NullTime `db:"locked_at"`
+	LockedAmount    int64        `db:"locked_amount"`
 	UsedOrderId     int64        `db:"used_order_id"`
 	UsedAt          sql.NullTime `db:"used_at"`
 	CreatedAt       time.Time    `db:"created_at"`
@@ -58,6 +67,26 @@ type CouponInstanceDetail struct {
 	EndAt           time.Time    `db:"end_at"`
 	Source          string       `db:"source"`
 	Remarks         string       `db:"remarks"`
+	IssuerType      string       `db:"issuer_type"`
+	IssuerId        int64        `db:"issuer_id"`
+}
+
+// StatusCount 券实例按状态计数
+type StatusCount struct {
+	Status string `db:"status"`
+	Count  int64  `db:"cnt"`
+}
+
+// UsedOrder 已使用券实例与核销订单
+type UsedOrder struct {
+	Id          int64 `db:"id"`
+	UsedOrderId int64 `db:"used_order_id"`
+}
+
+// UserClaimCount 用户在某张券下持有的实例数量
+type UserClaimCount struct {
+	UserId int64 `db:"user_id"`
+	Count  int64 `db:"cnt"`
 }
 
 func (m *customCouponInstancesModel) InsertWithSession(ctx context.Context, session sqlx.Session, couponId int64, userId int64) (int64, error) {
@@ -92,6 +121,7 @@ SELECT
     ci.status      AS status,
     ci.locked_preorder,
     ci.locked_at,
+    ci.locked_amount,
     ci.used_order_id,
     ci.used_at,
     ci.created_at,
@@ -103,7 +133,9 @@ SELECT
     c.start_at,
     c.end_at,
     c.source,
-    c.remarks
+    c.remarks,
+    c.issuer_type,
+    c.issuer_id
 FROM %s ci
 JOIN %s c ON ci.coupon_id = c.id
 WHERE ci.id = ? AND ci.user_id = ?
@@ -129,6 +161,7 @@ SELECT
     ci.status      AS status,
     ci.locked_preorder,
     ci.locked_at,
+    ci.locked_amount,
     ci.used_order_id,
     ci.used_at,
     ci.created_at,
@@ -140,7 +173,9 @@ SELECT
     c.start_at,
     c.end_at,
     c.source,
-    c.remarks
+    c.remarks,
+    c.issuer_type,
+    c.issuer_id
 FROM %s ci
 JOIN %s c ON ci.coupon_id = c.id
 WHERE ci.id = ? AND ci.user_id = ?`, m.table, couponsTable)
@@ -155,9 +190,9 @@ WHERE ci.id = ? AND ci.user_id = ?`, m.table, couponsTable)
 	return &detail, nil
 }
 
-func (m *customCouponInstancesModel) LockWithSession(ctx context.Context, session sqlx.Session, instanceId, userId, orderId int64, lockTime time.Time) error {
-	query := fmt.Sprintf("update %s set `status` = ?, `locked_preorder` = ?, `locked_at` = ? where `id` = ? and `user_id` = ? and `status` = ?", m.table)
-	res, err := session.ExecCtx(ctx, query, CouponStatusLocked, orderId, lockTime, instanceId, userId, CouponStatusUnused)
+func (m *customCouponInstancesModel) LockWithSession(ctx context.Context, session sqlx.Session, instanceId, userId, orderId, lockedAmount int64, lockTime time.Time) error {
+	query := fmt.Sprintf("update %s set `status` = ?, `locked_preorder` = ?, `locked_at` = ?, `locked_amount` = ? where `id` = ? and `user_id` = ? and `status` = ?", m.table)
+	res, err := session.ExecCtx(ctx, query, CouponStatusLocked, orderId, lockTime, lockedAmount, instanceId, userId, CouponStatusUnused)
 	if err != nil {
 		return err
 	}
@@ -172,7 +207,7 @@ func (m *customCouponInstancesModel) LockWithSession(ctx context.Context, sessio
 }
 
 func (m *customCouponInstancesModel) ReleaseWithSession(ctx context.Context, session sqlx.Session, instanceId, userId, orderId int64) error {
-	query := fmt.Sprintf("update %s set `status` = ?, `locked_preorder` = 0, `locked_at` = NULL where `id` = ? and `user_id` = ? and `status` = ? and `locked_preorder` = ?", m.table)
+	query := fmt.Sprintf("update %s set `status` = ?, `locked_preorder` = 0, `locked_at` = NULL, `locked_amount` = 0 where `id` = ? and `user_id` = ? and `status` = ? and `locked_preorder` = ?", m.table)
 	res, err := session.ExecCtx(ctx, query, CouponStatusUnused, instanceId, userId, CouponStatusLocked, orderId)
 	if err != nil {
 		return err
@@ -213,6 +248,7 @@ SELECT
     ci.status      AS status,
     ci.locked_preorder,
     ci.locked_at,
+    ci.locked_amount,
     ci.used_order_id,
     ci.used_at,
     ci.created_at,
@@ -224,7 +260,9 @@ SELECT
     c.start_at,
     c.end_at,
     c.source,
-    c.remarks
+    c.remarks,
+    c.issuer_type,
+    c.issuer_id
 FROM %s ci
 JOIN %s c ON ci.coupon_id = c.id
 WHERE ci.user_id = ?`, m.table, couponsTable)
@@ -259,3 +297,64 @@ WHERE ci.user_id = ?`, m.table, couponsTable)
 
 	return list, total, nil
 }
+
+// ExpireOverdue 将模板已过期的未使用券批量置为 EXPIRED，返回本批更新行数
+func (m *customCouponInstancesModel) ExpireOverdue(ctx context.Context, conn sqlx.SqlConn, now time.Time, limit int) (int64, error) {
+	query := fmt.Sprintf("update %s set `status` = ? where `status` = ? and `coupon_id` in (select `id` from `coupons` where `end_at` < ?) limit ?", m.table)
+	res, err := conn.ExecCtx(ctx, query, CouponStatusExpired, CouponStatusUnused, now, limit)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// ListLockedBefore 按 id 游标分页查询锁定时间早于 lockedBefore 的锁定券
+func (m *customCouponInstancesModel) ListLockedBefore(ctx context.Context, conn sqlx.SqlConn, lockedBefore time.Time, afterId int64, limit int) ([]*CouponInstances, error) {
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `locked_at` < ? and `id` > ? order by `id` asc limit ?", couponInstancesRows, m.table)
+	var list []*CouponInstances
+	if err := conn.QueryRowsCtx(ctx, &list, query, CouponStatusLocked, lockedBefore, afterId, limit); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// InsertClaimWithSession 按领取流水号幂等写入券实例，返回是否新插入
+func (m *customCouponInstancesModel) InsertClaimWithSession(ctx context.Context, session sqlx.Session, couponId, userId int64, claimNo string) (bool, error) {
+	query := fmt.Sprintf("insert ignore into %s (`coupon_id`, `user_id`, `status`, `claim_no`) values (?, ?, ?, ?)", m.table)
+	res, err := session.ExecCtx(ctx, query, couponId, userId, CouponStatusUnused, claimNo)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (m *customCouponInstancesModel) CountUsersByCoupon(ctx context.Context, conn sqlx.SqlConn, couponId int64) ([]*UserClaimCount, error) {
+	query := fmt.Sprintf("select `user_id`, count(1) as `cnt` from %s where `coupon_id` = ? group by `user_id`", m.table)
+	var list []*UserClaimCount
+	if err := conn.QueryRowsCtx(ctx, &list, query, couponId); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (m *customCouponInstancesModel) CountByStatus(ctx context.Context, conn sqlx.SqlConn, couponId int64, from, to time.Time) ([]*StatusCount, error) {
+	query := fmt.Sprintf("select `status`, count(1) as `cnt` from %s where `coupon_id` = ? and `created_at` >= ? and `created_at` < ? group by `status`", m.table)
+	var list []*StatusCount
+	if err := conn.QueryRowsCtx(ctx, &list, query, couponId, from, to); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (m *customCouponInstancesModel) ListUsedOrderIds(ctx context.Context, conn sqlx.SqlConn, couponId int64, from, to time.Time, afterId int64, limit int) ([]*UsedOrder, error) {
+	query := fmt.Sprintf("select `id`, `used_order_id` from %s where `coupon_id` = ? and `status` = ? and `created_at` >= ? and `created_at` < ? and `id` > ? order by `id` asc limit ?", m.table)
+	var list []*UsedOrder
+	if err := conn.QueryRowsCtx(ctx, &list, query, couponId, CouponStatusUsed, from, to, afterId, limit); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -24,13 +24,15 @@ var (
 	couponInstancesRowsExpectAutoSet   = strings.Join(stringx.Remove(couponInstancesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
 	couponInstancesRowsWithPlaceHolder = strings.Join(stringx.Remove(couponInstancesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
 
-	cacheCouponInstancesIdPrefix = "cache:couponInstances:id:"
+	cacheCouponInstancesIdPrefix      = "cache:couponInstances:id:"
+	cacheCouponInstancesClaimNoPrefix = "cache:couponInstances:claimNo:"
 )
 
 type (
 	couponInstancesModel interface {
 		Insert(ctx context.Context, data *CouponInstances) (sql.Result, error)
 		FindOne(ctx context.Context, id int64) (*CouponInstances, error)
+		FindOneByClaimNo(ctx context.Context, claimNo sql.NullString) (*CouponInstances, error)
 		Update(ctx context.Context, data *CouponInstances) error
 		Delete(ctx context.Context, id int64) error
 	}
@@ -41,16 +43,18 @@ type (
 	}
 
 	CouponInstances struct {
-		Id             int64        `db:"id"`              // 券实例ID
-		CouponId       int64        `db:"coupon_id"`       // 对应的优惠券id
-		UserId         int64        `db:"user_id"`         // 持券用户ID
-		Status         string       `db:"status"`          // 实例状态
-		LockedPreorder int64        `db:"locked_preorder"` // 锁定的预订单ID
-		LockedAt       sql.NullTime `db:"locked_at"`       // 锁定时间
-		UsedOrderId    int64        `db:"used_order_id"`   // 使用的订单ID
-		UsedAt         sql.NullTime `db:"used_at"`         // 使用时间
-		CreatedAt      time.Time    `db:"created_at"`
-		UpdatedAt      time.Time    `db:"updated_at"`
+		Id             int64          `db:"id"`              // 券实例ID
+		CouponId       int64          `db:"coupon_id"`       // 对应的优惠券id
+		UserId         int64          `db:"user_id"`         // 持券用户ID
+		Status         string         `db:"status"`          // 实例状态
+		LockedPreorder int64          `db:"locked_preorder"` // 锁定的预订单ID
+		LockedAt       sql.NullTime   `db:"locked_at"`       // 锁定时间
+		LockedAmount   int64          `db:"locked_amount"`   // 锁定时参与优惠的商品金额(分)，限定范围的券核销时按此校验门槛
+		UsedOrderId    int64          `db:"used_order_id"`   // 使用的订单ID
+		UsedAt         sql.NullTime   `db:"used_at"`         // 使用时间
+		ClaimNo        sql.NullString `db:"claim_no"`        // 领取流水号，异步领券落库幂等
+		CreatedAt      time.Time      `db:"created_at"`
+		UpdatedAt      time.Time      `db:"updated_at"`
 	}
 )
 
@@ -62,11 +66,17 @@ func newCouponInstancesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache
 }
 
 func (m *defaultCouponInstancesModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	couponInstancesClaimNoKey := fmt.Sprintf("%s%v", cacheCouponInstancesClaimNoPrefix, data.ClaimNo)
 	couponInstancesIdKey := fmt.Sprintf("%s%v", cacheCouponInstancesIdPrefix, id)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
 		return conn.ExecCtx(ctx, query, id)
-	}, couponInstancesIdKey)
+	}, couponInstancesClaimNoKey, couponInstancesIdKey)
 	return err
 }
 
@@ -87,21 +97,48 @@ func (m *defaultCouponInstancesModel) FindOne(ctx context.Context, id int64) (*C
 	}
 }
 
+func (m *defaultCouponInstancesModel) FindOneByClaimNo(ctx context.Context, claimNo sql.NullString) (*CouponInstances, error) {
+	couponInstancesClaimNoKey := fmt.Sprintf("%s%v", cacheCouponInstancesClaimNoPrefix, claimNo)
+	var resp CouponInstances
+	err := m.QueryRowIndexCtx(ctx, &resp, couponInstancesClaimNoKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `claim_no` = ? limit 1", couponInstancesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, claimNo); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
 func (m *defaultCouponInstancesModel) Insert(ctx context.Context, data *CouponInstances) (sql.Result, error) {
+	couponInstancesClaimNoKey := fmt.Sprintf("%s%v", cacheCouponInstancesClaimNoPrefix, data.ClaimNo)
 	couponInstancesIdKey := fmt.Sprintf("%s%v", cacheCouponInstancesIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?)", m.table, couponInstancesRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.CouponId, data.UserId, data.Status, data.LockedPreorder, data.LockedAt, data.UsedOrderId, data.UsedAt)
-	}, couponInstancesIdKey)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, couponInstancesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.CouponId, data.UserId, data.Status, data.LockedPreorder, data.LockedAt, data.LockedAmount, data.UsedOrderId, data.UsedAt, data.ClaimNo)
+	}, couponInstancesClaimNoKey, couponInstancesIdKey)
 	return ret, err
 }
 
-func (m *defaultCouponInstancesModel) Update(ctx context.Context, data *CouponInstances) error {
+func (m *defaultCouponInstancesModel) Update(ctx context.Context, newData *CouponInstances) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	couponInstancesClaimNoKey := fmt.Sprintf("%s%v", cacheCouponInstancesClaimNoPrefix, data.ClaimNo)
 	couponInstancesIdKey := fmt.Sprintf("%s%v", cacheCouponInstancesIdPrefix, data.Id)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, couponInstancesRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.CouponId, data.UserId, data.Status, data.LockedPreorder, data.LockedAt, data.UsedOrderId, data.UsedAt, data.Id)
-	}, couponInstancesIdKey)
+		return conn.ExecCtx(ctx, query, newData.CouponId, newData.UserId, newData.Status, newData.LockedPreorder, newData.LockedAt, newData.LockedAmount, newData.UsedOrderId, newData.UsedAt, newData.ClaimNo, newData.Id)
+	}, couponInstancesClaimNoKey, couponInstancesIdKey)
 	return err
 }
 
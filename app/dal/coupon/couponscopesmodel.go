@@ -0,0 +1,66 @@
+package coupon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ CouponScopesModel = (*customCouponScopesModel)(nil)
+
+type (
+	// CouponScopesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customCouponScopesModel.
+	CouponScopesModel interface {
+		couponScopesModel
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *CouponScopes) error
+		ListByCoupon(ctx context.Context, couponId int64) ([]*CouponScopes, error)
+		ListByCoupons(ctx context.Context, couponIds []int64) ([]*CouponScopes, error)
+	}
+
+	customCouponScopesModel struct {
+		*defaultCouponScopesModel
+	}
+)
+
+// NewCouponScopesModel returns a model for the database table.
+func NewCouponScopesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) CouponScopesModel {
+	return &customCouponScopesModel{
+		defaultCouponScopesModel: newCouponScopesModel(conn, c, opts...),
+	}
+}
+
+func (m *customCouponScopesModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *CouponScopes) error {
+	query := fmt.Sprintf("insert ignore into %s (`coupon_id`, `scope_type`, `effect`, `scope_value`) values (?, ?, ?, ?)", m.table)
+	_, err := session.ExecCtx(ctx, query, data.CouponId, data.ScopeType, data.Effect, data.ScopeValue)
+	return err
+}
+
+func (m *customCouponScopesModel) ListByCoupon(ctx context.Context, couponId int64) ([]*CouponScopes, error) {
+	query := fmt.Sprintf("select %s from %s where `coupon_id` = ? order by `id`", couponScopesRows, m.table)
+	var rows []*CouponScopes
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, couponId); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (m *customCouponScopesModel) ListByCoupons(ctx context.Context, couponIds []int64) ([]*CouponScopes, error) {
+	if len(couponIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(couponIds))
+	for _, id := range couponIds {
+		args = append(args, id)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(couponIds)), ",")
+	query := fmt.Sprintf("select %s from %s where `coupon_id` in (%s) order by `id`", couponScopesRows, m.table, placeholders)
+	var rows []*CouponScopes
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
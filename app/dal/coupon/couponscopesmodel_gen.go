@@ -0,0 +1,150 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	couponScopesFieldNames          = builder.RawFieldNames(&CouponScopes{})
+	couponScopesRows                = strings.Join(couponScopesFieldNames, ",")
+	couponScopesRowsExpectAutoSet   = strings.Join(stringx.Remove(couponScopesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	couponScopesRowsWithPlaceHolder = strings.Join(stringx.Remove(couponScopesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheCouponScopesIdPrefix                                = "cache:couponScopes:id:"
+	cacheCouponScopesCouponIdScopeTypeEffectScopeValuePrefix = "cache:couponScopes:couponId:scopeType:effect:scopeValue:"
+)
+
+type (
+	couponScopesModel interface {
+		Insert(ctx context.Context, data *CouponScopes) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*CouponScopes, error)
+		FindOneByCouponIdScopeTypeEffectScopeValue(ctx context.Context, couponId int64, scopeType string, effect string, scopeValue string) (*CouponScopes, error)
+		Update(ctx context.Context, data *CouponScopes) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultCouponScopesModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	CouponScopes struct {
+		Id         int64     `db:"id"`          // 主键
+		CouponId   int64     `db:"coupon_id"`   // 券模板ID
+		ScopeType  string    `db:"scope_type"`  // 适用维度：商品/类目/商家
+		Effect     string    `db:"effect"`      // 包含/排除
+		ScopeValue string    `db:"scope_value"` // 商品ID、类目名称或商家ID
+		CreatedAt  time.Time `db:"created_at"`
+	}
+)
+
+func newCouponScopesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultCouponScopesModel {
+	return &defaultCouponScopesModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`coupon_scopes`",
+	}
+}
+
+func (m *defaultCouponScopesModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	couponScopesCouponIdScopeTypeEffectScopeValueKey := fmt.Sprintf("%s%v:%v:%v:%v", cacheCouponScopesCouponIdScopeTypeEffectScopeValuePrefix, data.CouponId, data.ScopeType, data.Effect, data.ScopeValue)
+	couponScopesIdKey := fmt.Sprintf("%s%v", cacheCouponScopesIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, couponScopesCouponIdScopeTypeEffectScopeValueKey, couponScopesIdKey)
+	return err
+}
+
+func (m *defaultCouponScopesModel) FindOne(ctx context.Context, id int64) (*CouponScopes, error) {
+	couponScopesIdKey := fmt.Sprintf("%s%v", cacheCouponScopesIdPrefix, id)
+	var resp CouponScopes
+	err := m.QueryRowCtx(ctx, &resp, couponScopesIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponScopesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponScopesModel) FindOneByCouponIdScopeTypeEffectScopeValue(ctx context.Context, couponId int64, scopeType string, effect string, scopeValue string) (*CouponScopes, error) {
+	couponScopesCouponIdScopeTypeEffectScopeValueKey := fmt.Sprintf("%s%v:%v:%v:%v", cacheCouponScopesCouponIdScopeTypeEffectScopeValuePrefix, couponId, scopeType, effect, scopeValue)
+	var resp CouponScopes
+	err := m.QueryRowIndexCtx(ctx, &resp, couponScopesCouponIdScopeTypeEffectScopeValueKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `coupon_id` = ? and `scope_type` = ? and `effect` = ? and `scope_value` = ? limit 1", couponScopesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, couponId, scopeType, effect, scopeValue); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultCouponScopesModel) Insert(ctx context.Context, data *CouponScopes) (sql.Result, error) {
+	couponScopesCouponIdScopeTypeEffectScopeValueKey := fmt.Sprintf("%s%v:%v:%v:%v", cacheCouponScopesCouponIdScopeTypeEffectScopeValuePrefix, data.CouponId, data.ScopeType, data.Effect, data.ScopeValue)
+	couponScopesIdKey := fmt.Sprintf("%s%v", cacheCouponScopesIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", m.table, couponScopesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.CouponId, data.ScopeType, data.Effect, data.ScopeValue)
+	}, couponScopesCouponIdScopeTypeEffectScopeValueKey, couponScopesIdKey)
+	return ret, err
+}
+
+func (m *defaultCouponScopesModel) Update(ctx context.Context, newData *CouponScopes) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	couponScopesCouponIdScopeTypeEffectScopeValueKey := fmt.Sprintf("%s%v:%v:%v:%v", cacheCouponScopesCouponIdScopeTypeEffectScopeValuePrefix, data.CouponId, data.ScopeType, data.Effect, data.ScopeValue)
+	couponScopesIdKey := fmt.Sprintf("%s%v", cacheCouponScopesIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, couponScopesRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.CouponId, newData.ScopeType, newData.Effect, newData.ScopeValue, newData.Id)
+	}, couponScopesCouponIdScopeTypeEffectScopeValueKey, couponScopesIdKey)
+	return err
+}
+
+func (m *defaultCouponScopesModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheCouponScopesIdPrefix, primary)
+}
+
+func (m *defaultCouponScopesModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", couponScopesRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultCouponScopesModel) tableName() string {
+	return m.table
+}
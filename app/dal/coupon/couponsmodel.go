@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -18,6 +20,13 @@ type (
 		couponsModel
 		FindOneForUpdate(ctx context.Context, session sqlx.Session, id int64) (*Coupons, error)
 		IncrementIssuedWithSession(ctx context.Context, session sqlx.Session, id int64) error
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *Coupons) (sql.Result, error)
+		AddIssuedWithSession(ctx context.Context, session sqlx.Session, id, delta int64) error
+		ListIdsEndAfter(ctx context.Context, endAfter time.Time, afterId int64, limit int) ([]int64, error)
+		ReconcileIssued(ctx context.Context, ids []int64) (int64, error)
+		UpdateSettings(ctx context.Context, id, totalQuantity, perUserLimit int64, endAt time.Time, remarks string) (bool, error)
+		Revoke(ctx context.Context, id int64, now time.Time) (bool, error)
+		ListByIssuer(ctx context.Context, issuerType string, issuerId int64, offset, limit int) ([]*Coupons, int64, error)
 	}
 
 	customCouponsModel struct {
@@ -62,3 +71,99 @@ func (m *customCouponsModel) IncrementIssuedWithSession(ctx context.Context, ses
 	}
 	return nil
 }
+
+func (m *customCouponsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *Coupons) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, couponsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.CouponType, data.DiscountAmount, data.DiscountPercent, data.MinSpendAmount, data.TotalQuantity, data.PerUserLimit, data.IssuedQuantity, data.StartAt, data.EndAt, data.Source, data.Remarks, data.IssuerType, data.IssuerId, data.RevokedAt)
+}
+
+// AddIssuedWithSession 异步领券落库时累加已发放数量，额度已在 Redis 中校验
+func (m *customCouponsModel) AddIssuedWithSession(ctx context.Context, session sqlx.Session, id, delta int64) error {
+	query := fmt.Sprintf("update %s set `issued_quantity` = `issued_quantity` + ? where `id` = ?", m.table)
+	_, err := session.ExecCtx(ctx, query, delta, id)
+	return err
+}
+
+// ListIdsEndAfter 按 id 游标分页查询结束时间晚于 endAfter 的券模板
+func (m *customCouponsModel) ListIdsEndAfter(ctx context.Context, endAfter time.Time, afterId int64, limit int) ([]int64, error) {
+	query := fmt.Sprintf("select `id` from %s where `end_at` > ? and `id` > ? order by `id` asc limit ?", m.table)
+	var ids []int64
+	if err := m.QueryRowsNoCacheCtx(ctx, &ids, query, endAfter, afterId, limit); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ReconcileIssued 以券实例数量校正 issued_quantity，返回被校正的模板数
+func (m *customCouponsModel) ReconcileIssued(ctx context.Context, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf("update %s c left join (select `coupon_id`, count(1) as `cnt` from `coupon_instances` where `coupon_id` in (%s) group by `coupon_id`) t on t.`coupon_id` = c.`id` "+
+		"set c.`issued_quantity` = coalesce(t.`cnt`, 0) where c.`id` in (%s) and c.`issued_quantity` <> coalesce(t.`cnt`, 0)", m.table, placeholders, placeholders)
+	res, err := m.ExecNoCacheCtx(ctx, query, append(args, args...)...)
+	if err != nil {
+		return 0, err
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheCouponsIdPrefix, id))
+	}
+	if err := m.DelCacheCtx(ctx, keys...); err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// UpdateSettings 修改未撤销券模板的发放总量、每人限领、结束时间与备注，总量不得低于已发放数量
+func (m *customCouponsModel) UpdateSettings(ctx context.Context, id, totalQuantity, perUserLimit int64, endAt time.Time, remarks string) (bool, error) {
+	key := fmt.Sprintf("%s%v", cacheCouponsIdPrefix, id)
+	res, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `total_quantity` = ?, `per_user_limit` = ?, `end_at` = ?, `remarks` = ? "+
+			"where `id` = ? and `revoked_at` is null and `start_at` < ? and (? = 0 or `issued_quantity` <= ?)", m.table)
+		return conn.ExecCtx(ctx, query, totalQuantity, perUserLimit, endAt, remarks, id, endAt, totalQuantity, totalQuantity)
+	}, key)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	return affected > 0, err
+}
+
+// Revoke 撤销券模板，结束时间提前到 now，已领未用的券随之失效
+func (m *customCouponsModel) Revoke(ctx context.Context, id int64, now time.Time) (bool, error) {
+	key := fmt.Sprintf("%s%v", cacheCouponsIdPrefix, id)
+	res, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `revoked_at` = ?, `end_at` = least(`end_at`, ?) where `id` = ? and `revoked_at` is null", m.table)
+		return conn.ExecCtx(ctx, query, now, now, id)
+	}, key)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	return affected > 0, err
+}
+
+// ListByIssuer 按出资方分页查询券模板，新建的在前
+func (m *customCouponsModel) ListByIssuer(ctx context.Context, issuerType string, issuerId int64, offset, limit int) ([]*Coupons, int64, error) {
+	var total int64
+	countQuery := fmt.Sprintf("select count(1) from %s where `issuer_type` = ? and `issuer_id` = ?", m.table)
+	if err := m.QueryRowNoCacheCtx(ctx, &total, countQuery, issuerType, issuerId); err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*Coupons{}, 0, nil
+	}
+
+	var list []*Coupons
+	query := fmt.Sprintf("select %s from %s where `issuer_type` = ? and `issuer_id` = ? order by `id` desc limit ? offset ?", couponsRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &list, query, issuerType, issuerId, limit, offset); err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
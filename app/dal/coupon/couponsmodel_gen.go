@@ -41,20 +41,23 @@ type (
 	}
 
 	Coupons struct {
-		Id              int64     `db:"id"`               // 券模板ID
-		CouponType      int64     `db:"coupon_type"`      // 券类型 1-现金券 2-折扣券 3-免邮券
-		DiscountAmount  int64     `db:"discount_amount"`  // 抵扣金额(分)，折扣券为上限
-		DiscountPercent int64     `db:"discount_percent"` // 折扣百分比
-		MinSpendAmount  int64     `db:"min_spend_amount"` // 使用门槛(分)
-		TotalQuantity   int64     `db:"total_quantity"`   // 发券总量(0不限)
-		PerUserLimit    int64     `db:"per_user_limit"`   // 每人限领数量(0不限)
-		IssuedQuantity  int64     `db:"issued_quantity"`  // 已发放数量
-		StartAt         time.Time `db:"start_at"`         // 有效期开始时间
-		EndAt           time.Time `db:"end_at"`           // 有效期结束时间
-		Source          string    `db:"source"`           // 来源
-		Remarks         string    `db:"remarks"`          // 备注
-		CreatedAt       time.Time `db:"created_at"`
-		UpdatedAt       time.Time `db:"updated_at"`
+		Id              int64        `db:"id"`               // 券模板ID
+		CouponType      int64        `db:"coupon_type"`      // 券类型 1-现金券 2-折扣券 3-免邮券
+		DiscountAmount  int64        `db:"discount_amount"`  // 抵扣金额(分)，折扣券为上限
+		DiscountPercent int64        `db:"discount_percent"` // 折扣百分比
+		MinSpendAmount  int64        `db:"min_spend_amount"` // 使用门槛(分)
+		TotalQuantity   int64        `db:"total_quantity"`   // 发券总量(0不限)
+		PerUserLimit    int64        `db:"per_user_limit"`   // 每人限领数量(0不限)
+		IssuedQuantity  int64        `db:"issued_quantity"`  // 已发放数量
+		StartAt         time.Time    `db:"start_at"`         // 有效期开始时间
+		EndAt           time.Time    `db:"end_at"`           // 有效期结束时间
+		Source          string       `db:"source"`           // 来源
+		Remarks         string       `db:"remarks"`          // 备注
+		IssuerType      string       `db:"issuer_type"`      // 出资方
+		IssuerId        int64        `db:"issuer_id"`        // 出资商家ID，平台券为0
+		RevokedAt       sql.NullTime `db:"revoked_at"`       // 撤销时间
+		CreatedAt       time.Time    `db:"created_at"`
+		UpdatedAt       time.Time    `db:"updated_at"`
 	}
 )
 
@@ -94,8 +97,8 @@ func (m *defaultCouponsModel) FindOne(ctx context.Context, id int64) (*Coupons,
 func (m *defaultCouponsModel) Insert(ctx context.Context, data *Coupons) (sql.Result, error) {
 	couponsIdKey := fmt.Sprintf("%s%v", cacheCouponsIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, couponsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.CouponType, data.DiscountAmount, data.DiscountPercent, data.MinSpendAmount, data.TotalQuantity, data.PerUserLimit, data.IssuedQuantity, data.StartAt, data.EndAt, data.Source, data.Remarks)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, couponsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.CouponType, data.DiscountAmount, data.DiscountPercent, data.MinSpendAmount, data.TotalQuantity, data.PerUserLimit, data.IssuedQuantity, data.StartAt, data.EndAt, data.Source, data.Remarks, data.IssuerType, data.IssuerId, data.RevokedAt)
 	}, couponsIdKey)
 	return ret, err
 }
@@ -104,7 +107,7 @@ func (m *defaultCouponsModel) Update(ctx context.Context, data *Coupons) error {
 	couponsIdKey := fmt.Sprintf("%s%v", cacheCouponsIdPrefix, data.Id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, couponsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.CouponType, data.DiscountAmount, data.DiscountPercent, data.MinSpendAmount, data.TotalQuantity, data.PerUserLimit, data.IssuedQuantity, data.StartAt, data.EndAt, data.Source, data.Remarks, data.Id)
+		return conn.ExecCtx(ctx, query, data.CouponType, data.DiscountAmount, data.DiscountPercent, data.MinSpendAmount, data.TotalQuantity, data.PerUserLimit, data.IssuedQuantity, data.StartAt, data.EndAt, data.Source, data.Remarks, data.IssuerType, data.IssuerId, data.RevokedAt, data.Id)
 	}, couponsIdKey)
 	return err
 }
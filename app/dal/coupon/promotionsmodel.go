@@ -0,0 +1,86 @@
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PromotionsModel = (*customPromotionsModel)(nil)
+
+type (
+	// PromotionsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPromotionsModel.
+	PromotionsModel interface {
+		promotionsModel
+		// ListActive 查询当前生效中的促销活动，按计算顺序返回
+		ListActive(ctx context.Context, now time.Time) ([]*Promotions, error)
+		// List merchantId 小于 0 表示不过滤商家，0 表示平台活动
+		List(ctx context.Context, merchantId int64, status string, offset, limit int) ([]*Promotions, int64, error)
+		// UpdateStatus 迁移活动状态，返回是否更新
+		UpdateStatus(ctx context.Context, id int64, from, to string) (bool, error)
+	}
+
+	customPromotionsModel struct {
+		*defaultPromotionsModel
+	}
+)
+
+// NewPromotionsModel returns a model for the database table.
+func NewPromotionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PromotionsModel {
+	return &customPromotionsModel{
+		defaultPromotionsModel: newPromotionsModel(conn, c, opts...),
+	}
+}
+
+func (m *customPromotionsModel) ListActive(ctx context.Context, now time.Time) ([]*Promotions, error) {
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `start_at` <= ? and `end_at` >= ? order by `priority` desc, `id` asc", promotionsRows, m.table)
+	var list []*Promotions
+	err := m.QueryRowsNoCacheCtx(ctx, &list, query, PromotionStatusActive, now, now)
+	return list, err
+}
+
+func (m *customPromotionsModel) List(ctx context.Context, merchantId int64, status string, offset, limit int) ([]*Promotions, int64, error) {
+	conds := []string{"1 = 1"}
+	var args []any
+	if merchantId >= 0 {
+		conds = append(conds, "`merchant_id` = ?")
+		args = append(args, merchantId)
+	}
+	if status != "" {
+		conds = append(conds, "`status` = ?")
+		args = append(args, status)
+	}
+	where := strings.Join(conds, " and ")
+
+	var total int64
+	countQuery := fmt.Sprintf("select count(1) from %s where %s", m.table, where)
+	if err := m.QueryRowNoCacheCtx(ctx, &total, countQuery, args...); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf("select %s from %s where %s order by `id` desc limit ? offset ?", promotionsRows, m.table, where)
+	var list []*Promotions
+	if err := m.QueryRowsNoCacheCtx(ctx, &list, query, append(args, limit, offset)...); err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+func (m *customPromotionsModel) UpdateStatus(ctx context.Context, id int64, from, to string) (bool, error) {
+	promotionsIdKey := fmt.Sprintf("%s%v", cachePromotionsIdPrefix, id)
+	res, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, to, id, from)
+	}, promotionsIdKey)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,123 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	promotionsFieldNames          = builder.RawFieldNames(&Promotions{})
+	promotionsRows                = strings.Join(promotionsFieldNames, ",")
+	promotionsRowsExpectAutoSet   = strings.Join(stringx.Remove(promotionsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	promotionsRowsWithPlaceHolder = strings.Join(stringx.Remove(promotionsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cachePromotionsIdPrefix = "cache:promotions:id:"
+)
+
+type (
+	promotionsModel interface {
+		Insert(ctx context.Context, data *Promotions) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*Promotions, error)
+		Update(ctx context.Context, data *Promotions) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultPromotionsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	Promotions struct {
+		Id              int64     `db:"id"`                // 促销活动ID
+		Name            string    `db:"name"`              // 活动名称，结算时展示
+		PromoType       string    `db:"promo_type"`        // 满减/多件折扣/组合价
+		FundedBy        string    `db:"funded_by"`         // 出资方
+		MerchantId      int64     `db:"merchant_id"`       // 出资商家ID，商家出资时仅对该商家商品生效
+		Rules           string    `db:"rules"`             // 优惠规则与适用商品
+		MutexGroup      string    `db:"mutex_group"`       // 互斥组，同组活动只取优惠最大的一个，空表示不互斥
+		StackWithCoupon int64     `db:"stack_with_coupon"` // 是否可与优惠券叠加
+		Priority        int64     `db:"priority"`          // 计算顺序，越大越先计算
+		Status          string    `db:"status"`            // 状态
+		StartAt         time.Time `db:"start_at"`          // 开始时间
+		EndAt           time.Time `db:"end_at"`            // 结束时间
+		CreatedAt       time.Time `db:"created_at"`
+		UpdatedAt       time.Time `db:"updated_at"`
+	}
+)
+
+func newPromotionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultPromotionsModel {
+	return &defaultPromotionsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`promotions`",
+	}
+}
+
+func (m *defaultPromotionsModel) Delete(ctx context.Context, id int64) error {
+	promotionsIdKey := fmt.Sprintf("%s%v", cachePromotionsIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, promotionsIdKey)
+	return err
+}
+
+func (m *defaultPromotionsModel) FindOne(ctx context.Context, id int64) (*Promotions, error) {
+	promotionsIdKey := fmt.Sprintf("%s%v", cachePromotionsIdPrefix, id)
+	var resp Promotions
+	err := m.QueryRowCtx(ctx, &resp, promotionsIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", promotionsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPromotionsModel) Insert(ctx context.Context, data *Promotions) (sql.Result, error) {
+	promotionsIdKey := fmt.Sprintf("%s%v", cachePromotionsIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, promotionsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Name, data.PromoType, data.FundedBy, data.MerchantId, data.Rules, data.MutexGroup, data.StackWithCoupon, data.Priority, data.Status, data.StartAt, data.EndAt)
+	}, promotionsIdKey)
+	return ret, err
+}
+
+func (m *defaultPromotionsModel) Update(ctx context.Context, data *Promotions) error {
+	promotionsIdKey := fmt.Sprintf("%s%v", cachePromotionsIdPrefix, data.Id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, promotionsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.Name, data.PromoType, data.FundedBy, data.MerchantId, data.Rules, data.MutexGroup, data.StackWithCoupon, data.Priority, data.Status, data.StartAt, data.EndAt, data.Id)
+	}, promotionsIdKey)
+	return err
+}
+
+func (m *defaultPromotionsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cachePromotionsIdPrefix, primary)
+}
+
+func (m *defaultPromotionsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", promotionsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultPromotionsModel) tableName() string {
+	return m.table
+}
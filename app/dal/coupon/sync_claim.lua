@@ -0,0 +1,31 @@
+-- 券模板修改或撤销后原地更新预热数据，保留已领数量
+-- KEYS:
+--   1: meta_key
+--   2: users_key
+--   3: pending_key
+-- ARGV:
+--   1: total_quantity (0 不限量)
+--   2: per_user_limit
+--   3: end_at
+--   4: expire_at (unix 秒)
+
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return 0
+end
+
+local total = tonumber(ARGV[1]) or 0
+local stock = -1
+if total > 0 then
+    local claimed = tonumber(redis.call("HGET", KEYS[1], "claimed") or "0")
+    stock = math.max(total - claimed, 0)
+end
+
+redis.call("HSET", KEYS[1],
+    "stock", stock,
+    "per_user_limit", ARGV[2],
+    "end_at", ARGV[3])
+for i = 1, 3 do
+    redis.call("EXPIREAT", KEYS[i], ARGV[4])
+end
+
+return 1
@@ -0,0 +1,29 @@
+-- 撤销一次领取（领取消息投递失败时回滚），按领取流水号幂等
+-- KEYS:
+--   1: meta_key
+--   2: users_key
+--   3: pending_key
+-- ARGV:
+--   1: user_id
+--   2: claim_no
+
+if redis.call("HDEL", KEYS[3], ARGV[2]) == 0 then
+    return 0
+end
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    return 0
+end
+
+local owned = tonumber(redis.call("HGET", KEYS[2], ARGV[1]) or "0")
+if owned <= 0 then
+    return 0
+end
+
+redis.call("HINCRBY", KEYS[2], ARGV[1], -1)
+redis.call("HINCRBY", KEYS[1], "claimed", -1)
+local stock = tonumber(redis.call("HGET", KEYS[1], "stock") or "-1")
+if stock >= 0 then
+    redis.call("HINCRBY", KEYS[1], "stock", 1)
+end
+
+return 1
@@ -11,6 +11,9 @@ var (
 	ErrCouponSoldOut        = errors.New("coupon sold out")
 	ErrCouponStatusConflict = errors.New("coupon status conflict")
 	ErrCouponOwnership      = errors.New("coupon ownership invalid")
+	ErrCouponNotStarted     = errors.New("coupon not started")
+	ErrCouponExpired        = errors.New("coupon expired")
+	ErrCouponClaimLimit     = errors.New("coupon claim limit reached")
 )
 
 const (
@@ -19,3 +22,52 @@ const (
 	CouponStatusUsed    = "USED"
 	CouponStatusExpired = "EXPIRED"
 )
+
+const (
+	CouponIssuerPlatform = "PLATFORM"
+	CouponIssuerMerchant = "MERCHANT"
+)
+
+const (
+	ScopeTypeProduct  = "PRODUCT"
+	ScopeTypeCategory = "CATEGORY"
+	ScopeTypeMerchant = "MERCHANT"
+
+	ScopeEffectInclude = "INCLUDE"
+	ScopeEffectExclude = "EXCLUDE"
+)
+
+const (
+	CampaignTriggerRegister   = "REGISTER"
+	CampaignTriggerFirstOrder = "FIRST_ORDER"
+	CampaignTriggerBirthday   = "BIRTHDAY"
+	CampaignTriggerSegment    = "SEGMENT"
+
+	CampaignStatusScheduled = "SCHEDULED"
+	CampaignStatusRunning   = "RUNNING"
+	CampaignStatusCompleted = "COMPLETED"
+	CampaignStatusCancelled = "CANCELLED"
+)
+
+const (
+	CodeTypeShared = "SHARED"
+	CodeTypeUnique = "UNIQUE"
+
+	CodeBatchStatusActive   = "ACTIVE"
+	CodeBatchStatusDisabled = "DISABLED"
+
+	CodeStatusUnused   = "UNUSED"
+	CodeStatusRedeemed = "REDEEMED"
+)
+
+const (
+	PromotionTypeFullReduction = "FULL_REDUCTION"
+	PromotionTypeMultiBuy      = "MULTI_BUY"
+	PromotionTypeBundle        = "BUNDLE"
+
+	PromotionFunderPlatform = "PLATFORM"
+	PromotionFunderMerchant = "MERCHANT"
+
+	PromotionStatusActive   = "ACTIVE"
+	PromotionStatusDisabled = "DISABLED"
+)
@@ -0,0 +1,33 @@
+-- 预热券模板的领取状态，已存在时不覆盖
+-- 每人已领数量由调用方分批写入暂存 key，这里一次性替换
+-- KEYS:
+--   1: meta_key
+--   2: users_key
+--   3: staging_key (暂存的 user_id -> 已领数量)
+-- ARGV:
+--   1: stock (-1 不限量)
+--   2: per_user_limit
+--   3: start_at
+--   4: end_at
+--   5: claimed
+--   6: expire_at (unix 秒)
+
+if redis.call("EXISTS", KEYS[1]) == 1 then
+    redis.call("DEL", KEYS[3])
+    return 0
+end
+
+redis.call("DEL", KEYS[2])
+if redis.call("EXISTS", KEYS[3]) == 1 then
+    redis.call("RENAME", KEYS[3], KEYS[2])
+    redis.call("EXPIREAT", KEYS[2], ARGV[6])
+end
+redis.call("HSET", KEYS[1],
+    "stock", ARGV[1],
+    "per_user_limit", ARGV[2],
+    "start_at", ARGV[3],
+    "end_at", ARGV[4],
+    "claimed", ARGV[5])
+redis.call("EXPIREAT", KEYS[1], ARGV[6])
+
+return 1
@@ -0,0 +1,26 @@
+-- 向一个桶的当前 epoch 增加令牌额度（借桶 / 拆桶 / 合桶搬运的接收方）
+-- KEYS:
+--   1: epoch_key (inv:{sku}:epoch 或 inv:{sku#bucket}:epoch)
+-- ARGV:
+--   1: amount
+-- 返回：{ "OK", threshold_after } 或 { "NO_EPOCH" } / { "NO_THRESHOLD" }
+
+local epoch_key = KEYS[1]
+local amount = tonumber(ARGV[1] or "0") or 0
+
+local epoch = redis.call("GET", epoch_key)
+if not epoch then
+    return { "NO_EPOCH" }
+end
+
+local prefix = string.sub(epoch_key, 1, #epoch_key - #":epoch")
+local threshold_key = prefix .. ":threshold:" .. tostring(epoch)
+if not redis.call("GET", threshold_key) then
+    return { "NO_THRESHOLD" }
+end
+
+if amount <= 0 then
+    return { "OK", tonumber(redis.call("GET", threshold_key)) }
+end
+
+return { "OK", redis.call("INCRBY", threshold_key, amount) }
@@ -0,0 +1,46 @@
+-- 从一个桶中取走剩余令牌（借桶 / 拆桶 / 合桶时在桶之间搬运额度）
+-- KEYS:
+--   1: epoch_key (inv:{sku}:epoch 或 inv:{sku#bucket}:epoch)
+-- ARGV:
+--   1: want（希望取走的数量，小于 0 表示取走全部剩余）
+--   2: borrow（"1" 表示借桶：至少取 want，且最多取剩余的一半以上，减少反复借桶）
+-- 返回：{ "OK", taken } 或 { "NO_EPOCH" } / { "NO_THRESHOLD" }
+
+local epoch_key = KEYS[1]
+local want = tonumber(ARGV[1] or "0") or 0
+local borrow = tostring(ARGV[2] or "") == "1"
+
+local epoch = redis.call("GET", epoch_key)
+if not epoch then
+    return { "NO_EPOCH" }
+end
+
+local epoch_str = tostring(epoch)
+local prefix = string.sub(epoch_key, 1, #epoch_key - #":epoch")
+local threshold_key = prefix .. ":threshold:" .. epoch_str
+local issued_key = prefix .. ":issued:" .. epoch_str
+
+local threshold = tonumber(redis.call("GET", threshold_key) or "")
+if not threshold then
+    return { "NO_THRESHOLD" }
+end
+
+local issued = tonumber(redis.call("GET", issued_key) or "0")
+local available = threshold - issued
+if available < 0 then available = 0 end
+
+local take = want
+if want < 0 then
+    take = available
+end
+if borrow then
+    local half = math.floor(available / 2)
+    if half > take then take = half end
+end
+if take > available then take = available end
+
+if take > 0 then
+    redis.call("DECRBY", threshold_key, take)
+end
+
+return { "OK", take }
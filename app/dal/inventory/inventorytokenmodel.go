@@ -19,10 +19,28 @@ const (
     // 设置为 0 表示不设置 TTL，由上层超时任务负责清理与回退
     defaultTicketTTL         = 0 * time.Second
 	// Use Redis Cluster hash tags to keep related keys in same slot
-	tokenEpochKeyPattern     = "inv:{%d}:epoch"
-	tokenThresholdKeyPattern = "inv:{%d}:threshold:%d"
-	tokenIssuedKeyPattern    = "inv:{%d}:issued:%d"
+	tokenEpochKeyPattern     = "inv:{%s}:epoch"
+	tokenThresholdKeyPattern = "inv:{%s}:threshold:%d"
+	tokenIssuedKeyPattern    = "inv:{%s}:issued:%d"
 	admissionTicketPattern   = "adm:%s"
+
+	// 热点 SKU 拆成多个子桶，子桶使用不同的 hash tag（sku#bucket）分散到不同 slot；
+	// 0 号桶沿用原有 key，未拆桶的 SKU 不受影响
+	tokenBucketTagPattern   = "%d#%d"
+	tokenBucketCountPattern = "inv:{%d}:buckets"
+	tokenRebalanceLockKey   = "inv:{%d}:rebalance"
+	// 请求计数不带 hash tag，避免计数本身落在热点 slot 上
+	tokenHitsKeyPattern = "inv:hits:%d:%d"
+	bucketedSKUSetKey   = "inv:bucketed"
+
+	maxTokenBuckets       = 64
+	hotBucketWindowSecond = 5
+	rebalanceLockSeconds  = 10
+
+	// 桶数缓存在进程内，避免每次请求都读热点 SKU 所在 slot；拆桶/合并后其他实例最多延迟一个 TTL 感知
+	bucketCountTTL = time.Second
+	// 缓存条目超过该数量时写入前先清理过期条目
+	maxCachedBucketCounts = 10000
 )
 
 //go:embed try_token.lua
@@ -31,12 +49,20 @@ var tryTokenScript string
 //go:embed return_token.lua
 var returnTokenScript string
 
+//go:embed drain_token.lua
+var drainTokenScript string
+
+//go:embed credit_token.lua
+var creditTokenScript string
+
 type (
 	// TokenItem describes the minimal information required to acquire or release tokens for an SKU.
 	TokenItem struct {
 		SKU      int64 `json:"sku"`
 		Quantity int64 `json:"qty"`
 		Epoch    int64 `json:"epoch"`
+		// Bucket is the sub-bucket the token was issued from, 0 for non-hot SKUs.
+		Bucket int `json:"bucket,omitempty"`
 	}
 
     // TokenTicket captures the Redis admission ticket for a preorder (single item).
@@ -51,17 +77,64 @@ type (
         TryGetToken(ctx context.Context, preorderID int64, item TokenItem) error
         CheckToken(ctx context.Context, preorderID int64, consume bool) (*TokenTicket, error)
         ReturnToken(ctx context.Context, preorderID int64, item TokenItem) error
+        // SplitBuckets spreads the remaining budget of a SKU across n sub-buckets.
+        SplitBuckets(ctx context.Context, sku int64, n int) error
+        // MergeBuckets folds all sub-buckets of a SKU back into bucket 0.
+        MergeBuckets(ctx context.Context, sku int64) error
+        // RebalanceBuckets merges bucketed SKUs whose traffic dropped below the cold threshold.
+        RebalanceBuckets(ctx context.Context) error
     }
 
+	// HotBucketConf controls automatic sub-bucketing of hot SKUs.
+	HotBucketConf struct {
+		// Buckets is the number of sub-buckets a hot SKU is split into, <= 1 disables splitting.
+		Buckets int
+		// HotQPS splits a SKU once its token requests per second reach this value.
+		HotQPS int64
+		// ColdQPS merges a split SKU back once its token requests per second drop below this value.
+		ColdQPS int64
+	}
+
+	// TokenModelOption customizes the inventory token model.
+	TokenModelOption func(m *defaultInventoryTokenModel)
+
 	defaultInventoryTokenModel struct {
 		redis     *redis.Redis
 		inventory InventoryModel
 		trySha    string
 		returnSha string
+		drainSha  string
+		creditSha string
+		hot       HotBucketConf
 		mu        sync.Mutex
+
+		cacheMu sync.Mutex
+		buckets map[int64]bucketCountEntry
+		hits    map[int64]*hitCounter
+	}
+
+	bucketCountEntry struct {
+		n        int
+		expireAt time.Time
+	}
+
+	// hitCounter 进程内按秒累计请求数，跨秒时一次性写入 Redis
+	hitCounter struct {
+		second int64
+		count  int64
 	}
 )
 
+// WithHotBucket enables automatic sub-bucketing for hot SKUs.
+func WithHotBucket(c HotBucketConf) TokenModelOption {
+	return func(m *defaultInventoryTokenModel) {
+		if c.Buckets > maxTokenBuckets {
+			c.Buckets = maxTokenBuckets
+		}
+		m.hot = c
+	}
+}
+
 // TokenError wraps status codes returned by lua scripts so callers can branch on error.Code.
 type TokenError struct {
 	code    string
@@ -101,11 +174,17 @@ func newTokenError(code string, details ...string) *TokenError {
 	}
 }
 
-func NewInventoryTokenModel(r *redis.Redis, inventory InventoryModel) InventoryTokenModel {
-	return &defaultInventoryTokenModel{
+func NewInventoryTokenModel(r *redis.Redis, inventory InventoryModel, opts ...TokenModelOption) InventoryTokenModel {
+	m := &defaultInventoryTokenModel{
 		redis:     r,
 		inventory: inventory,
+		buckets:   make(map[int64]bucketCountEntry),
+		hits:      make(map[int64]*hitCounter),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
 func (m *defaultInventoryTokenModel) SyncTokenSnapshot(ctx context.Context, sku int64) error {
@@ -119,8 +198,42 @@ func (m *defaultInventoryTokenModel) TryGetToken(ctx context.Context, preorderID
     if preorderID <= 0 {
         return newTokenError("INVALID_PREORDER", fmt.Sprintf("%d", preorderID))
     }
+    if item.SKU <= 0 {
+        return newTokenError("INVALID_SKU", fmt.Sprintf("%d", item.SKU))
+    }
+
+    buckets := m.bucketCount(ctx, item.SKU)
+    m.recordHit(ctx, item.SKU, buckets)
+
+    // 按预订单号落桶，同一预订单重试时命中同一个桶
+    item.Bucket = int(preorderID % int64(buckets))
+    err := m.tryBucket(ctx, preorderID, item)
+
+    var tokenErr *TokenError
+    if !errors.As(err, &tokenErr) || tokenErr.Code() != "NOT_ENOUGH" {
+        return err
+    }
+    // 缓存的桶数可能已过期（其他实例刚拆桶或合并），以 Redis 为准重新落桶
+    if fresh := m.loadBucketCount(ctx, item.SKU); fresh != buckets {
+        buckets = fresh
+        item.Bucket = int(preorderID % int64(buckets))
+        err = m.tryBucket(ctx, preorderID, item)
+        if !errors.As(err, &tokenErr) || tokenErr.Code() != "NOT_ENOUGH" {
+            return err
+        }
+    }
+    if buckets <= 1 {
+        return err
+    }
+    // 本桶额度不足：从兄弟桶搬运额度后重试一次
+    if m.borrow(ctx, item.SKU, item.Bucket, buckets, item.Quantity, tokenErr) {
+        return m.tryBucket(ctx, preorderID, item)
+    }
+    return err
+}
 
-    if err := m.ensureSnapshotForSKU(ctx, item.SKU); err != nil {
+func (m *defaultInventoryTokenModel) tryBucket(ctx context.Context, preorderID int64, item TokenItem) error {
+    if err := m.ensureBucketSnapshot(ctx, item.SKU, item.Bucket); err != nil {
         return err
     }
 
@@ -153,7 +266,7 @@ func (m *defaultInventoryTokenModel) TryGetToken(ctx context.Context, preorderID
 	// Only pass epoch key; script computes threshold/issued keys atomically
 	keys := make([]string, 0, len(normalized))
 	for _, item := range normalized {
-		keys = append(keys, fmt.Sprintf(tokenEpochKeyPattern, item.SKU))
+		keys = append(keys, epochKey(item.SKU, item.Bucket))
 	}
 
     args := []any{
@@ -233,19 +346,23 @@ func (m *defaultInventoryTokenModel) ReturnToken(ctx context.Context, preorderID
     }
 
 	// Only pass epoch key; script computes threshold/issued keys atomically
-	keys := make([]string, 0, len(normalized))
+	keys := make([]string, 0, len(normalized)+1)
 	for _, item := range normalized {
-		keys = append(keys, fmt.Sprintf(tokenEpochKeyPattern, item.SKU))
+		keys = append(keys, epochKey(item.SKU, item.Bucket))
 	}
 
     if len(normalized) != 1 {
         return newTokenError("INVALID_ITEM_COUNT")
     }
 
+    // 是否折算由脚本按 Redis 中的桶数判断：子桶已合并时归还的令牌从子桶阈值中扣除，再由这里记入主桶
+    keys = append(keys, fmt.Sprintf(tokenBucketCountPattern, ticket.Item.SKU))
+
     args := []any{
         string(payload),
         ticket.PreorderID,
         strconv.FormatInt(ticket.Item.Epoch, 10),
+        ticket.Item.Bucket,
     }
 
     result, err := m.evalScript(ctx, &m.returnSha, returnTokenScript, keys, args...)
@@ -262,6 +379,15 @@ func (m *defaultInventoryTokenModel) ReturnToken(ctx context.Context, preorderID
     if status != "OK" {
         return newTokenError(status, details...)
     }
+    if len(details) >= 5 && details[2] == "FOLDED" {
+        before, _ := strconv.ParseInt(details[3], 10, 64)
+        after, _ := strconv.ParseInt(details[4], 10, 64)
+        if before > after {
+            if err := m.credit(ctx, ticket.Item.SKU, 0, before-after); err != nil {
+                logx.WithContext(ctx).Errorf("fold returned token into bucket 0 failed: sku=%d qty=%d err=%v", ticket.Item.SKU, before-after, err)
+            }
+        }
+    }
     return nil
 }
 
@@ -312,8 +438,9 @@ func (m *defaultInventoryTokenModel) loadScript(ctx context.Context, shaRef *str
 
 func normalizeItems(items []TokenItem) ([]TokenItem, error) {
 	type itemKey struct {
-		sku   int64
-		epoch int64
+		sku    int64
+		epoch  int64
+		bucket int
 	}
 
 	index := make(map[itemKey]int64, len(items))
@@ -331,8 +458,9 @@ func normalizeItems(items []TokenItem) ([]TokenItem, error) {
 		}
 
 		key := itemKey{
-			sku:   item.SKU,
-			epoch: item.Epoch,
+			sku:    item.SKU,
+			epoch:  item.Epoch,
+			bucket: item.Bucket,
 		}
 		if _, ok := index[key]; !ok {
 			order = append(order, key)
@@ -346,6 +474,7 @@ func normalizeItems(items []TokenItem) ([]TokenItem, error) {
 			SKU:      key.sku,
 			Epoch:    key.epoch,
 			Quantity: index[key],
+			Bucket:   key.bucket,
 		})
 	}
 	return normalized, nil
@@ -385,8 +514,13 @@ func (m *defaultInventoryTokenModel) resolveEpochs(ctx context.Context, items []
 		index []int
 	}
 
-	pending := make(map[int64]*skuIdxs)
-	order := make([]int64, 0)
+	type skuBucket struct {
+		sku    int64
+		bucket int
+	}
+
+	pending := make(map[skuBucket]*skuIdxs)
+	order := make([]skuBucket, 0)
 
 	for i, item := range items {
 		if item.SKU <= 0 {
@@ -397,14 +531,14 @@ func (m *defaultInventoryTokenModel) resolveEpochs(ctx context.Context, items []
 		}
 		resolved[i] = item
 		if item.Epoch <= 0 {
-			if pending[item.SKU] == nil {
-				key := fmt.Sprintf(tokenEpochKeyPattern, item.SKU)
-				pending[item.SKU] = &skuIdxs{
-					key: key,
+			sb := skuBucket{sku: item.SKU, bucket: item.Bucket}
+			if pending[sb] == nil {
+				pending[sb] = &skuIdxs{
+					key: epochKey(item.SKU, item.Bucket),
 				}
-				order = append(order, item.SKU)
+				order = append(order, sb)
 			}
-			pending[item.SKU].index = append(pending[item.SKU].index, i)
+			pending[sb].index = append(pending[sb].index, i)
 		}
 	}
 
@@ -413,8 +547,8 @@ func (m *defaultInventoryTokenModel) resolveEpochs(ctx context.Context, items []
 	}
 
 	keys := make([]string, 0, len(order))
-	for _, sku := range order {
-		keys = append(keys, pending[sku].key)
+	for _, sb := range order {
+		keys = append(keys, pending[sb].key)
 	}
 
 	values, err := m.redis.MgetCtx(ctx, keys...)
@@ -422,16 +556,16 @@ func (m *defaultInventoryTokenModel) resolveEpochs(ctx context.Context, items []
 		return nil, err
 	}
 
-	for idx, sku := range order {
+	for idx, sb := range order {
 		val := values[idx]
 		if val == "" {
-			return nil, newTokenError("NO_EPOCH", fmt.Sprintf("%d", sku))
+			return nil, newTokenError("NO_EPOCH", fmt.Sprintf("%d", sb.sku))
 		}
 		epoch, convErr := strconv.ParseInt(val, 10, 64)
 		if convErr != nil {
-			return nil, fmt.Errorf("parse epoch for sku %d: %w", sku, convErr)
+			return nil, fmt.Errorf("parse epoch for sku %d: %w", sb.sku, convErr)
 		}
-		for _, pos := range pending[sku].index {
+		for _, pos := range pending[sb].index {
 			resolved[pos].Epoch = epoch
 		}
 	}
@@ -474,26 +608,29 @@ func (m *defaultInventoryTokenModel) ensureSnapshots(ctx context.Context, items
 }
 
 func (m *defaultInventoryTokenModel) ensureSnapshotForSKU(ctx context.Context, sku int64) error {
+	return m.ensureBucketSnapshot(ctx, sku, 0)
+}
+
+func (m *defaultInventoryTokenModel) ensureBucketSnapshot(ctx context.Context, sku int64, bucket int) error {
 	if sku <= 0 {
 		return newTokenError("INVALID_SKU", fmt.Sprintf("%d", sku))
 	}
 
-	epochKey := fmt.Sprintf(tokenEpochKeyPattern, sku)
-	currentEpochStr, err := m.redis.GetCtx(ctx, epochKey)
+	currentEpochStr, err := m.redis.GetCtx(ctx, epochKey(sku, bucket))
 	if err != nil {
 		return err
 	}
 
 	if currentEpochStr == "" {
-		return m.refreshSnapshotFromDB(ctx, sku, 0)
+		return m.refreshSnapshotFromDB(ctx, sku)
 	}
 
 	currentEpoch, err := strconv.ParseInt(currentEpochStr, 10, 64)
 	if err != nil || currentEpoch <= 0 {
-		return m.refreshSnapshotFromDB(ctx, sku, 0)
+		return m.refreshSnapshotFromDB(ctx, sku)
 	}
 
-	thresholdKey := fmt.Sprintf(tokenThresholdKeyPattern, sku, currentEpoch)
+	thresholdKey := fmt.Sprintf(tokenThresholdKeyPattern, bucketTag(sku, bucket), currentEpoch)
 	exist, err := m.redis.ExistsCtx(ctx, thresholdKey)
 	if err != nil {
 		return err
@@ -503,11 +640,11 @@ func (m *defaultInventoryTokenModel) ensureSnapshotForSKU(ctx context.Context, s
 		return nil
 	}
 
-	return m.refreshSnapshotFromDB(ctx, sku, currentEpoch)
+	return m.refreshSnapshotFromDB(ctx, sku)
 }
 
-// 回源 DB 同步令牌数据
-func (m *defaultInventoryTokenModel) refreshSnapshotFromDB(ctx context.Context, sku int64, oldEpoch int64) error {
+// 回源 DB 同步令牌数据，已拆桶的 SKU 按桶数均分库存，余数记入 0 号桶
+func (m *defaultInventoryTokenModel) refreshSnapshotFromDB(ctx context.Context, sku int64) error {
 	if m.inventory == nil {
 		return newTokenError("SNAPSHOT_UNAVAILABLE")
 	}
@@ -521,10 +658,24 @@ func (m *defaultInventoryTokenModel) refreshSnapshotFromDB(ctx context.Context,
 	}
 
 	threshold := record.Stock
-	return m.applySnapshot(ctx, sku, threshold, oldEpoch)
+	if threshold < 0 {
+		threshold = 0
+	}
+	buckets := m.loadBucketCount(ctx, sku)
+	share := threshold / int64(buckets)
+	for b := buckets - 1; b >= 0; b-- {
+		bucketThreshold := share
+		if b == 0 {
+			bucketThreshold = threshold - share*int64(buckets-1)
+		}
+		if err := m.applySnapshot(ctx, sku, b, bucketThreshold, m.currentEpoch(ctx, sku, b)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (m *defaultInventoryTokenModel) applySnapshot(ctx context.Context, sku int64, threshold int64, oldEpoch int64) error {
+func (m *defaultInventoryTokenModel) applySnapshot(ctx context.Context, sku int64, bucket int, threshold int64, oldEpoch int64) error {
 	if sku <= 0 {
 		return newTokenError("INVALID_SKU", fmt.Sprintf("%d", sku))
 	}
@@ -539,11 +690,12 @@ func (m *defaultInventoryTokenModel) applySnapshot(ctx context.Context, sku int6
         newEpoch = time.Now().UnixMilli()
     }
 
+	tag := bucketTag(sku, bucket)
 	epochStr := strconv.FormatInt(newEpoch, 10)
 	thresholdStr := strconv.FormatInt(threshold, 10)
-	thresholdKey := fmt.Sprintf(tokenThresholdKeyPattern, sku, newEpoch)
-	issuedKey := fmt.Sprintf(tokenIssuedKeyPattern, sku, newEpoch)
-	epochKey := fmt.Sprintf(tokenEpochKeyPattern, sku)
+	thresholdKey := fmt.Sprintf(tokenThresholdKeyPattern, tag, newEpoch)
+	issuedKey := fmt.Sprintf(tokenIssuedKeyPattern, tag, newEpoch)
+	epochKey := fmt.Sprintf(tokenEpochKeyPattern, tag)
 
 	err := m.redis.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
 		if err := pipe.Set(ctx, thresholdKey, thresholdStr, 0).Err(); err != nil {
@@ -556,8 +708,8 @@ func (m *defaultInventoryTokenModel) applySnapshot(ctx context.Context, sku int6
 			return err
 		}
 		if oldEpoch > 0 && oldEpoch != newEpoch {
-			oldThresholdKey := fmt.Sprintf(tokenThresholdKeyPattern, sku, oldEpoch)
-			oldIssuedKey := fmt.Sprintf(tokenIssuedKeyPattern, sku, oldEpoch)
+			oldThresholdKey := fmt.Sprintf(tokenThresholdKeyPattern, tag, oldEpoch)
+			oldIssuedKey := fmt.Sprintf(tokenIssuedKeyPattern, tag, oldEpoch)
 			if err := pipe.Del(ctx, oldThresholdKey, oldIssuedKey).Err(); err != nil {
 				return err
 			}
@@ -570,8 +722,383 @@ func (m *defaultInventoryTokenModel) applySnapshot(ctx context.Context, sku int6
 
 	logx.WithContext(ctx).Infow("inventory snapshot refreshed",
 		logx.Field("sku", sku),
+		logx.Field("bucket", bucket),
 		logx.Field("epoch", newEpoch),
 		logx.Field("threshold", threshold),
 	)
 	return nil
 }
+
+// SplitBuckets 将 SKU 的剩余额度均分到 n 个子桶，余数留在 0 号桶。
+// 子桶先以 0 额度初始化，再切换桶数，最后搬运额度，保证任意时刻不会超发。
+func (m *defaultInventoryTokenModel) SplitBuckets(ctx context.Context, sku int64, n int) error {
+	if sku <= 0 {
+		return newTokenError("INVALID_SKU", fmt.Sprintf("%d", sku))
+	}
+	if n > maxTokenBuckets {
+		n = maxTokenBuckets
+	}
+	if n <= 1 {
+		return nil
+	}
+
+	unlock, ok := m.lockBuckets(ctx, sku)
+	if !ok {
+		return nil
+	}
+	defer unlock()
+
+	if m.loadBucketCount(ctx, sku) > 1 {
+		return nil
+	}
+	if err := m.ensureBucketSnapshot(ctx, sku, 0); err != nil {
+		return err
+	}
+
+	for b := 1; b < n; b++ {
+		if err := m.applySnapshot(ctx, sku, b, 0, m.currentEpoch(ctx, sku, b)); err != nil {
+			return err
+		}
+	}
+
+	remaining, err := m.drain(ctx, sku, 0, -1, false)
+	if err != nil {
+		return err
+	}
+
+	err = m.redis.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		if err := pipe.Set(ctx, fmt.Sprintf(tokenBucketCountPattern, sku), strconv.Itoa(n), 0).Err(); err != nil {
+			return err
+		}
+		return pipe.SAdd(ctx, bucketedSKUSetKey, sku).Err()
+	})
+	if err != nil {
+		if creditErr := m.credit(ctx, sku, 0, remaining); creditErr != nil {
+			logx.WithContext(ctx).Errorf("split buckets rollback failed: sku=%d qty=%d err=%v", sku, remaining, creditErr)
+		}
+		return err
+	}
+
+	m.cacheBucketCount(sku, n)
+
+	share := remaining / int64(n)
+	distributed := int64(0)
+	for b := 1; b < n && share > 0; b++ {
+		if err := m.credit(ctx, sku, b, share); err != nil {
+			logx.WithContext(ctx).Errorf("split buckets credit failed: sku=%d bucket=%d err=%v", sku, b, err)
+			continue
+		}
+		distributed += share
+	}
+	if err := m.credit(ctx, sku, 0, remaining-distributed); err != nil {
+		return err
+	}
+
+	logx.WithContext(ctx).Infow("inventory token buckets split",
+		logx.Field("sku", sku),
+		logx.Field("buckets", n),
+		logx.Field("remaining", remaining),
+	)
+	return nil
+}
+
+// MergeBuckets 先停止向子桶路由，再把子桶剩余额度搬回 0 号桶。
+// 已从子桶发出的令牌归还时由 ReturnToken 折算回 0 号桶。
+func (m *defaultInventoryTokenModel) MergeBuckets(ctx context.Context, sku int64) error {
+	if sku <= 0 {
+		return newTokenError("INVALID_SKU", fmt.Sprintf("%d", sku))
+	}
+
+	unlock, ok := m.lockBuckets(ctx, sku)
+	if !ok {
+		return nil
+	}
+	defer unlock()
+
+	n := m.loadBucketCount(ctx, sku)
+	err := m.redis.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		if err := pipe.Del(ctx, fmt.Sprintf(tokenBucketCountPattern, sku)).Err(); err != nil {
+			return err
+		}
+		return pipe.SRem(ctx, bucketedSKUSetKey, sku).Err()
+	})
+	if err != nil {
+		return err
+	}
+	m.cacheBucketCount(sku, 1)
+
+	var moved int64
+	for b := 1; b < n; b++ {
+		taken, err := m.drain(ctx, sku, b, -1, false)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("merge buckets drain failed: sku=%d bucket=%d err=%v", sku, b, err)
+			continue
+		}
+		if err := m.credit(ctx, sku, 0, taken); err != nil {
+			logx.WithContext(ctx).Errorf("merge buckets credit failed: sku=%d qty=%d err=%v", sku, taken, err)
+			continue
+		}
+		moved += taken
+	}
+
+	logx.WithContext(ctx).Infow("inventory token buckets merged",
+		logx.Field("sku", sku),
+		logx.Field("buckets", n),
+		logx.Field("moved", moved),
+	)
+	return nil
+}
+
+// RebalanceBuckets 扫描已拆桶的 SKU，刷新进程内的桶数缓存，近期请求量低于冷阈值的合并回单桶。
+func (m *defaultInventoryTokenModel) RebalanceBuckets(ctx context.Context) error {
+	members, err := m.redis.SmembersCtx(ctx, bucketedSKUSetKey)
+	if err != nil {
+		return err
+	}
+	m.refreshBucketCounts(ctx, members)
+	if m.hot.ColdQPS <= 0 {
+		return nil
+	}
+
+	now := time.Now().Unix()
+	for _, member := range members {
+		sku, convErr := strconv.ParseInt(member, 10, 64)
+		if convErr != nil || sku <= 0 {
+			_, _ = m.redis.SremCtx(ctx, bucketedSKUSetKey, member)
+			continue
+		}
+		if m.recentQPS(ctx, sku, now) >= m.hot.ColdQPS {
+			continue
+		}
+		if err := m.MergeBuckets(ctx, sku); err != nil {
+			logx.WithContext(ctx).Errorf("merge cold sku buckets failed: sku=%d err=%v", sku, err)
+		}
+	}
+	return nil
+}
+
+// 记录每秒请求量，未拆桶的 SKU 达到热点阈值时自动拆桶。
+// 请求数先在进程内按秒累计，跨秒时用 INCRBY 写入一次，避免每个请求都访问 Redis。
+func (m *defaultInventoryTokenModel) recordHit(ctx context.Context, sku int64, buckets int) {
+	if m.hot.Buckets <= 1 || m.hot.HotQPS <= 0 {
+		return
+	}
+
+	now := time.Now().Unix()
+	m.cacheMu.Lock()
+	c, ok := m.hits[sku]
+	if !ok {
+		c = &hitCounter{second: now}
+		m.hits[sku] = c
+	}
+	var flushSecond, flushCount int64
+	if c.second != now {
+		flushSecond, flushCount = c.second, c.count
+		c.second, c.count = now, 0
+	}
+	c.count++
+	local := c.count
+	m.cacheMu.Unlock()
+
+	if flushCount > 0 {
+		key := fmt.Sprintf(tokenHitsKeyPattern, sku, flushSecond)
+		total, err := m.redis.IncrbyCtx(ctx, key, flushCount)
+		if err == nil {
+			if total == flushCount {
+				_ = m.redis.ExpireCtx(ctx, key, hotBucketWindowSecond*2)
+			}
+			if buckets <= 1 && total >= m.hot.HotQPS && total-flushCount < m.hot.HotQPS {
+				m.splitHot(ctx, sku)
+				return
+			}
+		}
+	}
+	// 单个实例就已达到阈值时不必等到跨秒
+	if buckets <= 1 && local == m.hot.HotQPS {
+		m.splitHot(ctx, sku)
+	}
+}
+
+func (m *defaultInventoryTokenModel) splitHot(ctx context.Context, sku int64) {
+	if err := m.SplitBuckets(ctx, sku, m.hot.Buckets); err != nil {
+		logx.WithContext(ctx).Errorf("split hot sku buckets failed: sku=%d err=%v", sku, err)
+	}
+}
+
+// 统计最近完整窗口内的平均每秒请求量
+func (m *defaultInventoryTokenModel) recentQPS(ctx context.Context, sku int64, now int64) int64 {
+	keys := make([]string, 0, hotBucketWindowSecond)
+	for i := int64(1); i <= hotBucketWindowSecond; i++ {
+		keys = append(keys, fmt.Sprintf(tokenHitsKeyPattern, sku, now-i))
+	}
+
+	values, err := m.redis.MgetCtx(ctx, keys...)
+	if err != nil {
+		return 0
+	}
+
+	var total int64
+	for _, val := range values {
+		n, _ := strconv.ParseInt(val, 10, 64)
+		total += n
+	}
+	return total / hotBucketWindowSecond
+}
+
+// 本桶额度不足时，依次从兄弟桶借额度到本桶
+func (m *defaultInventoryTokenModel) borrow(ctx context.Context, sku int64, home, buckets int, need int64, shortage *TokenError) bool {
+	var available int64
+	if details := shortage.Details(); len(details) > 1 {
+		available, _ = strconv.ParseInt(details[1], 10, 64)
+	}
+
+	lack := need - available
+	for i := 1; i < buckets && lack > 0; i++ {
+		sibling := (home + i) % buckets
+		taken, err := m.drain(ctx, sku, sibling, lack, true)
+		if err != nil || taken <= 0 {
+			continue
+		}
+		if err := m.credit(ctx, sku, home, taken); err != nil {
+			// 搬运失败的额度直接丢弃：只会少卖不会超卖，下次回源快照时修正
+			logx.WithContext(ctx).Errorf("borrow token credit failed: sku=%d bucket=%d qty=%d err=%v", sku, home, taken, err)
+			return false
+		}
+		lack -= taken
+	}
+	return lack <= 0
+}
+
+func (m *defaultInventoryTokenModel) drain(ctx context.Context, sku int64, bucket int, want int64, borrow bool) (int64, error) {
+	borrowArg := "0"
+	if borrow {
+		borrowArg = "1"
+	}
+
+	result, err := m.evalScript(ctx, &m.drainSha, drainTokenScript, []string{epochKey(sku, bucket)}, want, borrowArg)
+	if err != nil {
+		return 0, err
+	}
+
+	status, details, err := decodeLuaResult(result)
+	if err != nil {
+		return 0, err
+	}
+	if status != "OK" || len(details) == 0 {
+		return 0, newTokenError(status, details...)
+	}
+	return strconv.ParseInt(details[0], 10, 64)
+}
+
+func (m *defaultInventoryTokenModel) credit(ctx context.Context, sku int64, bucket int, amount int64) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	result, err := m.evalScript(ctx, &m.creditSha, creditTokenScript, []string{epochKey(sku, bucket)}, amount)
+	if err != nil {
+		return err
+	}
+
+	status, details, err := decodeLuaResult(result)
+	if err != nil {
+		return err
+	}
+	if status != "OK" {
+		return newTokenError(status, details...)
+	}
+	return nil
+}
+
+// bucketCount 返回进程内缓存的桶数，过期后回源 Redis
+func (m *defaultInventoryTokenModel) bucketCount(ctx context.Context, sku int64) int {
+	m.cacheMu.Lock()
+	entry, ok := m.buckets[sku]
+	m.cacheMu.Unlock()
+	if ok && time.Now().Before(entry.expireAt) {
+		return entry.n
+	}
+	return m.loadBucketCount(ctx, sku)
+}
+
+// loadBucketCount 从 Redis 读取桶数并刷新缓存，读取失败时按单桶处理且不缓存
+func (m *defaultInventoryTokenModel) loadBucketCount(ctx context.Context, sku int64) int {
+	val, err := m.redis.GetCtx(ctx, fmt.Sprintf(tokenBucketCountPattern, sku))
+	if err != nil {
+		return 1
+	}
+	n, convErr := strconv.Atoi(val)
+	if convErr != nil || n < 1 {
+		n = 1
+	}
+	m.cacheBucketCount(sku, n)
+	return n
+}
+
+func (m *defaultInventoryTokenModel) cacheBucketCount(sku int64, n int) {
+	now := time.Now()
+	m.cacheMu.Lock()
+	defer m.cacheMu.Unlock()
+	if len(m.buckets) >= maxCachedBucketCounts {
+		for k, entry := range m.buckets {
+			if now.After(entry.expireAt) {
+				delete(m.buckets, k)
+			}
+		}
+	}
+	m.buckets[sku] = bucketCountEntry{n: n, expireAt: now.Add(bucketCountTTL)}
+}
+
+// refreshBucketCounts 由重平衡任务调用：刷新已拆桶 SKU 的桶数，清理过期的缓存与请求计数
+func (m *defaultInventoryTokenModel) refreshBucketCounts(ctx context.Context, members []string) {
+	for _, member := range members {
+		if sku, err := strconv.ParseInt(member, 10, 64); err == nil && sku > 0 {
+			m.loadBucketCount(ctx, sku)
+		}
+	}
+
+	now := time.Now()
+	m.cacheMu.Lock()
+	defer m.cacheMu.Unlock()
+	for sku, entry := range m.buckets {
+		if now.After(entry.expireAt) {
+			delete(m.buckets, sku)
+		}
+	}
+	for sku, c := range m.hits {
+		if now.Unix()-c.second > hotBucketWindowSecond {
+			delete(m.hits, sku)
+		}
+	}
+}
+
+func (m *defaultInventoryTokenModel) currentEpoch(ctx context.Context, sku int64, bucket int) int64 {
+	val, err := m.redis.GetCtx(ctx, epochKey(sku, bucket))
+	if err != nil || val == "" {
+		return 0
+	}
+	epoch, _ := strconv.ParseInt(val, 10, 64)
+	return epoch
+}
+
+func (m *defaultInventoryTokenModel) lockBuckets(ctx context.Context, sku int64) (func(), bool) {
+	key := fmt.Sprintf(tokenRebalanceLockKey, sku)
+	ok, err := m.redis.SetnxExCtx(ctx, key, "1", rebalanceLockSeconds)
+	if err != nil || !ok {
+		return nil, false
+	}
+	return func() {
+		_, _ = m.redis.DelCtx(ctx, key)
+	}, true
+}
+
+func bucketTag(sku int64, bucket int) string {
+	if bucket <= 0 {
+		return strconv.FormatInt(sku, 10)
+	}
+	return fmt.Sprintf(tokenBucketTagPattern, sku, bucket)
+}
+
+func epochKey(sku int64, bucket int) string {
+	return fmt.Sprintf(tokenEpochKeyPattern, bucketTag(sku, bucket))
+}
@@ -1,10 +1,13 @@
 -- 单商品版本：固定处理 1 个商品
 -- KEYS:
---   1: epoch_key (inv:{sku}:epoch)
+--   1: epoch_key (inv:{sku}:epoch，热点子桶为 inv:{sku#bucket}:epoch)
+--   2: bucket_count_key (inv:{sku}:buckets)，不存在视为 1 个桶
 -- ARGV:
 --   1: ticket_json（包含 item，可作为后备解析）
 --   2: preorder_id（用于定位 adm:{preorder_id}）
 --   3: expect_epoch_str（可选，字符串，避免 JSON 数字精度问题）
+--   4: bucket（令牌所属的桶；桶号不小于当前桶数时说明子桶已合并，
+--      归还的令牌同时从本桶阈值扣除，返回 FOLDED 由调用方记入主桶）
 
 local ticket_json = ARGV[1]
 local preorder_id = tostring(ARGV[2] or "")
@@ -50,8 +53,13 @@ if not current_epoch or tostring(current_epoch) ~= expect_epoch then
     return { "OK", 0, 1, "EPOCH_MISMATCH", tostring(current_epoch or ""), expect_epoch }
 end
 
-local threshold_key = "inv:{" .. sku .. "}:threshold:" .. expect_epoch
-local issued_key = "inv:{" .. sku .. "}:issued:" .. expect_epoch
+local prefix = string.sub(epoch_key, 1, #epoch_key - #":epoch")
+local threshold_key = prefix .. ":threshold:" .. expect_epoch
+local issued_key = prefix .. ":issued:" .. expect_epoch
+-- 以 Redis 中的桶数为准判断是否折算，避免进程内缓存的桶数滞后导致令牌归还到已合并的子桶
+local bucket = tonumber(ARGV[4] or "0") or 0
+local bucket_count = tonumber(redis.call("GET", KEYS[2]) or "1") or 1
+local fold = bucket > 0 and bucket >= bucket_count
 
 local threshold_raw = redis.call("GET", threshold_key)
 if not threshold_raw then
@@ -72,9 +80,18 @@ local issued_after = issued - delta
 if issued_after < 0 then issued_after = 0 end
 if issued_after > threshold then issued_after = threshold end
 redis.call("SET", issued_key, issued_after)
+if fold and issued > issued_after then
+    local threshold_after = threshold - (issued - issued_after)
+    if threshold_after < issued_after then threshold_after = issued_after end
+    redis.call("SET", threshold_key, threshold_after)
+end
 
 if ticket_key ~= "" then
     redis.call("DEL", ticket_key)
 end
 
-return { "OK", 1, 0, "ROLLED_BACK", tostring(issued), tostring(issued_after) }
+local result = "ROLLED_BACK"
+if fold then
+    result = "FOLDED"
+end
+return { "OK", 1, 0, result, tostring(issued), tostring(issued_after) }
@@ -1,7 +1,7 @@
 -- Note: Redis Lua provides global `cjson`; do not `require`.
 
 -- KEYS:
---   1: epoch_key (inv:{sku}:epoch)
+--   1: epoch_key (inv:{sku}:epoch，热点子桶为 inv:{sku#bucket}:epoch)
 -- ARGV:
 --   1: preorder_id (string)
 --   2: ticket_ttl_seconds (number)
@@ -65,9 +65,11 @@ if not epoch then
 end
 
 -- Compute keys after verifying epoch to avoid client-side key drift
+-- 桶的 key 前缀取自 epoch_key，保证同一个桶的 key 落在同一 slot
 local epoch_str = tostring(epoch)
-local threshold_key = "inv:{" .. sku .. "}:threshold:" .. epoch_str
-local issued_key = "inv:{" .. sku .. "}:issued:" .. epoch_str
+local prefix = string.sub(epoch_key, 1, #epoch_key - #":epoch")
+local threshold_key = prefix .. ":threshold:" .. epoch_str
+local issued_key = prefix .. ":issued:" .. epoch_str
 
 local threshold_raw = redis.call("GET", threshold_key)
 if not threshold_raw then
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -31,6 +32,8 @@ type (
         // PlaceIfReady/WithSession sets status to PLACED only when current status is READY and not expired.
         PlaceIfReady(ctx context.Context, preorderId int64) (bool, error)
         PlaceIfReadyWithSession(ctx context.Context, session sqlx.Session, preorderId int64) (bool, error)
+        // FindByIds returns preorders by ids, missing ids are skipped.
+        FindByIds(ctx context.Context, preorderIds []int64) ([]*OrderPreorders, error)
     }
 
     customOrderPreordersModel struct {
@@ -56,13 +59,13 @@ func (m *customOrderPreordersModel) FindOne(ctx context.Context, preorderId int6
 }
 
 func (m *customOrderPreordersModel) Insert(ctx context.Context, data *OrderPreorders) (sql.Result, error) {
-    query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, orderPreordersRowsExpectAutoSet)
-    return m.ExecNoCacheCtx(ctx, query, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.Status, data.ExpireAt)
+    query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, orderPreordersRowsExpectAutoSet)
+    return m.ExecNoCacheCtx(ctx, query, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.Currency, data.PriceCurrency, data.FxRate, data.Status, data.ExpireAt)
 }
 
 func (m *customOrderPreordersModel) Update(ctx context.Context, data *OrderPreorders) error {
     query := fmt.Sprintf("update %s set %s where `preorder_id` = ?", m.table, orderPreordersRowsWithPlaceHolder)
-    _, err := m.ExecNoCacheCtx(ctx, query, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.Status, data.ExpireAt, data.PreorderId)
+    _, err := m.ExecNoCacheCtx(ctx, query, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.Currency, data.PriceCurrency, data.FxRate, data.Status, data.ExpireAt, data.PreorderId)
     return err
 }
 
@@ -90,8 +93,8 @@ func (m *customOrderPreordersModel) InsertWithId(ctx context.Context, data *Orde
     orderPreordersPreorderIdKey := fmt.Sprintf("%s%v", cacheOrderPreordersPreorderIdPrefix, data.PreorderId)
     ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
         // explicit insert including preorder_id for snowflake-style IDs
-        query := fmt.Sprintf("insert into %s (`preorder_id`,`user_id`,`coupon_id`,`original_amount`,`final_amount`,`status`,`expire_at`) values (?, ?, ?, ?, ?, ?, ?)", m.table)
-        return conn.ExecCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.Status, data.ExpireAt)
+        query := fmt.Sprintf("insert into %s (`preorder_id`,`user_id`,`coupon_id`,`original_amount`,`final_amount`,`promotion_amount`,`merchant_promotion_amount`,`merchant_coupon_amount`,`promotion_detail`,`currency`,`price_currency`,`fx_rate`,`status`,`expire_at`) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table)
+        return conn.ExecCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.Currency, data.PriceCurrency, data.FxRate, data.Status, data.ExpireAt)
     }, orderPreordersPreorderIdKey)
     return ret, err
 }
@@ -154,3 +157,20 @@ func (m *customOrderPreordersModel) PlaceIfReadyWithSession(ctx context.Context,
     n, _ := res.RowsAffected()
     return n > 0, nil
 }
+
+func (m *customOrderPreordersModel) FindByIds(ctx context.Context, preorderIds []int64) ([]*OrderPreorders, error) {
+    if len(preorderIds) == 0 {
+        return nil, nil
+    }
+    placeholders := strings.TrimSuffix(strings.Repeat("?,", len(preorderIds)), ",")
+    args := make([]any, 0, len(preorderIds))
+    for _, id := range preorderIds {
+        args = append(args, id)
+    }
+    var rows []*OrderPreorders
+    query := fmt.Sprintf("select %s from %s where `preorder_id` in (%s)", orderPreordersRows, m.table, placeholders)
+    if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+        return nil, err
+    }
+    return rows, nil
+}
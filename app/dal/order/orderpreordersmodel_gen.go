@@ -41,15 +41,22 @@ type (
 	}
 
 	OrderPreorders struct {
-		PreorderId     int64     `db:"preorder_id"`     // 预订单ID
-		UserId         int64     `db:"user_id"`         // 用户ID
-		CouponId       int64     `db:"coupon_id"`       // 优惠券ID
-		OriginalAmount int64     `db:"original_amount"` // 原始金额
-		FinalAmount    int64     `db:"final_amount"`    // 最终金额
-		Status         string    `db:"status"`          // 状态：待处理/就绪/已下单/取消
-		ExpireAt       time.Time `db:"expire_at"`       // 预订单过期时间
-		CreatedAt      time.Time `db:"created_at"`
-		UpdatedAt      time.Time `db:"updated_at"`
+		PreorderId              int64          `db:"preorder_id"`               // 预订单ID
+		UserId                  int64          `db:"user_id"`                   // 用户ID
+		CouponId                int64          `db:"coupon_id"`                 // 优惠券ID
+		OriginalAmount          int64          `db:"original_amount"`           // 原始金额
+		FinalAmount             int64          `db:"final_amount"`              // 最终金额
+		PromotionAmount         int64          `db:"promotion_amount"`          // 促销优惠金额(分)，不含优惠券
+		MerchantPromotionAmount int64          `db:"merchant_promotion_amount"` // 其中商家出资的促销优惠(分)
+		MerchantCouponAmount    int64          `db:"merchant_coupon_amount"`    // 商家券抵扣金额(分)，由出资商家承担
+		PromotionDetail         sql.NullString `db:"promotion_detail"`          // 命中的促销活动快照
+		Currency                string         `db:"currency"`                  // 支付币种，金额均以该币种计
+		PriceCurrency           string         `db:"price_currency"`            // 商品标价币种
+		FxRate                  int64          `db:"fx_rate"`                   // 标价币种 → 支付币种汇率快照，放大 1e8 倍
+		Status                  string         `db:"status"`                    // 状态：待处理/就绪/已下单/取消
+		ExpireAt                time.Time      `db:"expire_at"`                 // 预订单过期时间
+		CreatedAt               time.Time      `db:"created_at"`
+		UpdatedAt               time.Time      `db:"updated_at"`
 	}
 )
 
@@ -89,8 +96,8 @@ func (m *defaultOrderPreordersModel) FindOne(ctx context.Context, preorderId int
 func (m *defaultOrderPreordersModel) Insert(ctx context.Context, data *OrderPreorders) (sql.Result, error) {
 	orderPreordersPreorderIdKey := fmt.Sprintf("%s%v", cacheOrderPreordersPreorderIdPrefix, data.PreorderId)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, orderPreordersRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.Status, data.ExpireAt)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, orderPreordersRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.Currency, data.PriceCurrency, data.FxRate, data.Status, data.ExpireAt)
 	}, orderPreordersPreorderIdKey)
 	return ret, err
 }
@@ -99,7 +106,7 @@ func (m *defaultOrderPreordersModel) Update(ctx context.Context, data *OrderPreo
 	orderPreordersPreorderIdKey := fmt.Sprintf("%s%v", cacheOrderPreordersPreorderIdPrefix, data.PreorderId)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `preorder_id` = ?", m.table, orderPreordersRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.Status, data.ExpireAt, data.PreorderId)
+		return conn.ExecCtx(ctx, query, data.UserId, data.CouponId, data.OriginalAmount, data.FinalAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.Currency, data.PriceCurrency, data.FxRate, data.Status, data.ExpireAt, data.PreorderId)
 	}, orderPreordersPreorderIdKey)
 	return err
 }
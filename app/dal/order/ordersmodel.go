@@ -4,6 +4,8 @@ import (
     "context"
     "database/sql"
     "fmt"
+    "strings"
+    "time"
 
     "github.com/zeromicro/go-zero/core/stores/cache"
     "github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -18,10 +20,39 @@ type (
         ordersModel
         // InsertWithSession inserts an order row within given session.
         InsertWithSession(ctx context.Context, session sqlx.Session, data *Orders) (sql.Result, error)
+        // UpdateWithSession updates an order row within given session.
+        UpdateWithSession(ctx context.Context, session sqlx.Session, data *Orders) error
+        // FindOneForUpdateWithSession locks the order row within given session.
+        FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, orderId int64) (*Orders, error)
         // ListByUser returns paginated orders for a user ordered by created_at desc
         ListByUser(ctx context.Context, userId int64, offset, limit int64) ([]*Orders, error)
         // CountByUser returns total orders count for a user
         CountByUser(ctx context.Context, userId int64) (int64, error)
+        // ListCompleted returns COMPLETED orders whose completed_at falls in [from, to), paged by order_id
+        ListCompleted(ctx context.Context, from, to time.Time, afterOrderId, limit int64) ([]*Orders, error)
+        // CountPaidByUser returns the number of paid (not refunded) orders of a user
+        CountPaidByUser(ctx context.Context, userId int64) (int64, error)
+        // StatsByUsers aggregates paid (not refunded) order count and CNY spend per user
+        StatsByUsers(ctx context.Context, userIds []int64) ([]*UserOrderStat, error)
+        // SummarizePaid sums amounts of the given orders that were paid and not refunded
+        SummarizePaid(ctx context.Context, orderIds []int64) (*OrderAmountSummary, error)
+        // SetRefunded raises refunded_amount to the accumulated refund total, never lowering it
+        SetRefunded(ctx context.Context, orderId, refundedTotal int64) error
+    }
+
+    // OrderAmountSummary paid order count, goods total, payable total and promotion discount
+    OrderAmountSummary struct {
+        OrderCount    int64 `db:"order_count"`
+        TotalAmount   int64 `db:"total_amount"`
+        PayableAmount int64 `db:"payable_amount"`
+        PromotionAmount int64 `db:"promotion_amount"`
+    }
+
+    // UserOrderStat paid order count and CNY spend of a user
+    UserOrderStat struct {
+        UserId     int64 `db:"user_id"`
+        OrderCount int64 `db:"order_count"`
+        Spend      int64 `db:"spend"`
     }
 
     customOrdersModel struct {
@@ -37,8 +68,23 @@ func NewOrdersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option)
 }
 
 func (m *customOrdersModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *Orders) (sql.Result, error) {
-    query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, ordersRowsExpectAutoSet)
-    return session.ExecCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.Status, data.TotalAmount, data.PayableAmount, data.PaidAmount, data.PaymentMethod, data.PaymentAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot)
+    query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, ordersRowsExpectAutoSet)
+    return session.ExecCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.OrderType, data.Status, data.TotalAmount, data.PayableAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.PaidAmount, data.RefundedAmount, data.Currency, data.PriceCurrency, data.FxRate, data.PaymentMethod, data.PaymentAt, data.CompletedAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot)
+}
+
+func (m *customOrdersModel) UpdateWithSession(ctx context.Context, session sqlx.Session, data *Orders) error {
+    query := fmt.Sprintf("update %s set %s where `order_id` = ?", m.table, ordersRowsWithPlaceHolder)
+    _, err := session.ExecCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.OrderType, data.Status, data.TotalAmount, data.PayableAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.PaidAmount, data.RefundedAmount, data.Currency, data.PriceCurrency, data.FxRate, data.PaymentMethod, data.PaymentAt, data.CompletedAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot, data.OrderId)
+    return err
+}
+
+func (m *customOrdersModel) FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, orderId int64) (*Orders, error) {
+    var resp Orders
+    query := fmt.Sprintf("select %s from %s where `order_id` = ? limit 1 for update", ordersRows, m.table)
+    if err := session.QueryRowCtx(ctx, &resp, query, orderId); err != nil {
+        return nil, err
+    }
+    return &resp, nil
 }
 
 func (m *customOrdersModel) ListByUser(ctx context.Context, userId int64, offset, limit int64) ([]*Orders, error) {
@@ -54,6 +100,19 @@ func (m *customOrdersModel) ListByUser(ctx context.Context, userId int64, offset
     return res, nil
 }
 
+func (m *customOrdersModel) ListCompleted(ctx context.Context, from, to time.Time, afterOrderId, limit int64) ([]*Orders, error) {
+    var rows []Orders
+    query := fmt.Sprintf("select %s from %s where `status` = 'COMPLETED' and `completed_at` >= ? and `completed_at` < ? and `order_id` > ? order by `order_id` asc limit ?", ordersRows, m.table)
+    if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, from, to, afterOrderId, limit); err != nil {
+        return nil, err
+    }
+    res := make([]*Orders, 0, len(rows))
+    for i := range rows {
+        res = append(res, &rows[i])
+    }
+    return res, nil
+}
+
 func (m *customOrdersModel) CountByUser(ctx context.Context, userId int64) (int64, error) {
     var total int64
     query := fmt.Sprintf("select count(1) from %s where `user_id` = ?", m.table)
@@ -63,10 +122,63 @@ func (m *customOrdersModel) CountByUser(ctx context.Context, userId int64) (int6
     return total, nil
 }
 
+func (m *customOrdersModel) CountPaidByUser(ctx context.Context, userId int64) (int64, error) {
+    var total int64
+    query := fmt.Sprintf("select count(1) from %s where `user_id` = ? and `payment_at` is not null and `status` <> ?", m.table)
+    if err := m.QueryRowNoCacheCtx(ctx, &total, query, userId, "REFUNDED"); err != nil {
+        return 0, err
+    }
+    return total, nil
+}
+
+func (m *customOrdersModel) StatsByUsers(ctx context.Context, userIds []int64) ([]*UserOrderStat, error) {
+    if len(userIds) == 0 {
+        return nil, nil
+    }
+    placeholders := strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ",")
+    args := []any{"CNY"}
+    for _, id := range userIds {
+        args = append(args, id)
+    }
+    args = append(args, "REFUNDED")
+    query := fmt.Sprintf("select `user_id`, count(1) as `order_count`, coalesce(sum(if(`currency` = ?, `payable_amount`, 0)), 0) as `spend` from %s "+
+        "where `user_id` in (%s) and `payment_at` is not null and `status` <> ? group by `user_id`", m.table, placeholders)
+    var rows []*UserOrderStat
+    if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+        return nil, err
+    }
+    return rows, nil
+}
+
+func (m *customOrdersModel) SummarizePaid(ctx context.Context, orderIds []int64) (*OrderAmountSummary, error) {
+    var resp OrderAmountSummary
+    if len(orderIds) == 0 {
+        return &resp, nil
+    }
+    placeholders := strings.TrimSuffix(strings.Repeat("?,", len(orderIds)), ",")
+    args := make([]any, 0, len(orderIds)+1)
+    for _, id := range orderIds {
+        args = append(args, id)
+    }
+    args = append(args, "REFUNDED")
+    query := fmt.Sprintf("select count(1) as `order_count`, coalesce(sum(`total_amount`), 0) as `total_amount`, coalesce(sum(`payable_amount`), 0) as `payable_amount`, coalesce(sum(`promotion_amount`), 0) as `promotion_amount` from %s "+
+        "where `order_id` in (%s) and `payment_at` is not null and `status` <> ?", m.table, placeholders)
+    if err := m.QueryRowNoCacheCtx(ctx, &resp, query, args...); err != nil {
+        return nil, err
+    }
+    return &resp, nil
+}
+
+func (m *customOrdersModel) SetRefunded(ctx context.Context, orderId, refundedTotal int64) error {
+    query := fmt.Sprintf("update %s set `refunded_amount` = greatest(`refunded_amount`, ?) where `order_id` = ?", m.table)
+    _, err := m.ExecNoCacheCtx(ctx, query, refundedTotal, orderId)
+    return err
+}
+
 // No-cache overrides for core CRUD
 func (m *customOrdersModel) Insert(ctx context.Context, data *Orders) (sql.Result, error) {
-    query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, ordersRowsExpectAutoSet)
-    return m.ExecNoCacheCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.Status, data.TotalAmount, data.PayableAmount, data.PaidAmount, data.PaymentMethod, data.PaymentAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot)
+    query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, ordersRowsExpectAutoSet)
+    return m.ExecNoCacheCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.OrderType, data.Status, data.TotalAmount, data.PayableAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.PaidAmount, data.RefundedAmount, data.Currency, data.PriceCurrency, data.FxRate, data.PaymentMethod, data.PaymentAt, data.CompletedAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot)
 }
 
 func (m *customOrdersModel) FindOne(ctx context.Context, orderId int64) (*Orders, error) {
@@ -89,7 +201,7 @@ func (m *customOrdersModel) FindOneByPreorderId(ctx context.Context, preorderId
 
 func (m *customOrdersModel) Update(ctx context.Context, data *Orders) error {
     query := fmt.Sprintf("update %s set %s where `order_id` = ?", m.table, ordersRowsWithPlaceHolder)
-    _, err := m.ExecNoCacheCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.Status, data.TotalAmount, data.PayableAmount, data.PaidAmount, data.PaymentMethod, data.PaymentAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot, data.OrderId)
+    _, err := m.ExecNoCacheCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.OrderType, data.Status, data.TotalAmount, data.PayableAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.PaidAmount, data.RefundedAmount, data.Currency, data.PriceCurrency, data.FxRate, data.PaymentMethod, data.PaymentAt, data.CompletedAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot, data.OrderId)
     return err
 }
 
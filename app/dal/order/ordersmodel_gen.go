@@ -43,21 +43,31 @@ type (
 	}
 
 	Orders struct {
-		OrderId         int64          `db:"order_id"`         // 订单ID
-		PreorderId      int64          `db:"preorder_id"`      // 预订单ID
-		UserId          int64          `db:"user_id"`          // 用户ID
-		CouponId        int64          `db:"coupon_id"`        // 优惠券ID
-		Status          string         `db:"status"`           // 订单状态
-		TotalAmount     int64          `db:"total_amount"`     // 订单商品总金额(分)
-		PayableAmount   int64          `db:"payable_amount"`   // 应付金额(分)
-		PaidAmount      int64          `db:"paid_amount"`      // 实际支付金额(分)
-		PaymentMethod   string         `db:"payment_method"`   // 支付方式
-		PaymentAt       sql.NullTime   `db:"payment_at"`       // 支付时间
-		ExpireTime      int64          `db:"expire_time"`      // 过期时间戳
-		CancelReason    string         `db:"cancel_reason"`    // 取消原因
-		AddressSnapshot sql.NullString `db:"address_snapshot"` // 收货地址快照
-		CreatedAt       time.Time      `db:"created_at"`
-		UpdatedAt       time.Time      `db:"updated_at"`
+		OrderId                 int64          `db:"order_id"`                  // 订单ID
+		PreorderId              int64          `db:"preorder_id"`               // 预订单ID
+		UserId                  int64          `db:"user_id"`                   // 用户ID
+		CouponId                int64          `db:"coupon_id"`                 // 优惠券ID
+		OrderType               string         `db:"order_type"`                // 订单类型：普通/预售定金/预售尾款
+		Status                  string         `db:"status"`                    // 订单状态
+		TotalAmount             int64          `db:"total_amount"`              // 订单商品总金额(分)
+		PayableAmount           int64          `db:"payable_amount"`            // 应付金额(分)
+		PromotionAmount         int64          `db:"promotion_amount"`          // 促销优惠金额(分)，不含优惠券
+		MerchantPromotionAmount int64          `db:"merchant_promotion_amount"` // 其中商家出资的促销优惠(分)
+		MerchantCouponAmount    int64          `db:"merchant_coupon_amount"`    // 商家券抵扣金额(分)，由出资商家承担
+		PromotionDetail         sql.NullString `db:"promotion_detail"`          // 命中的促销活动快照
+		PaidAmount              int64          `db:"paid_amount"`               // 实际支付金额(分)
+		RefundedAmount          int64          `db:"refunded_amount"`           // 累计退款成功金额(分)
+		Currency                string         `db:"currency"`                  // 支付币种，金额均以该币种计
+		PriceCurrency           string         `db:"price_currency"`            // 商品标价币种
+		FxRate                  int64          `db:"fx_rate"`                   // 标价币种 → 支付币种汇率快照，放大 1e8 倍
+		PaymentMethod           string         `db:"payment_method"`            // 支付方式
+		PaymentAt               sql.NullTime   `db:"payment_at"`                // 支付时间
+		CompletedAt             sql.NullTime   `db:"completed_at"`              // 确认收货时间
+		ExpireTime              int64          `db:"expire_time"`               // 过期时间戳
+		CancelReason            string         `db:"cancel_reason"`             // 取消原因
+		AddressSnapshot         sql.NullString `db:"address_snapshot"`          // 收货地址快照
+		CreatedAt               time.Time      `db:"created_at"`
+		UpdatedAt               time.Time      `db:"updated_at"`
 	}
 )
 
@@ -124,8 +134,8 @@ func (m *defaultOrdersModel) Insert(ctx context.Context, data *Orders) (sql.Resu
 	ordersOrderIdKey := fmt.Sprintf("%s%v", cacheOrdersOrderIdPrefix, data.OrderId)
 	ordersPreorderIdKey := fmt.Sprintf("%s%v", cacheOrdersPreorderIdPrefix, data.PreorderId)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, ordersRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.Status, data.TotalAmount, data.PayableAmount, data.PaidAmount, data.PaymentMethod, data.PaymentAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, ordersRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.PreorderId, data.UserId, data.CouponId, data.OrderType, data.Status, data.TotalAmount, data.PayableAmount, data.PromotionAmount, data.MerchantPromotionAmount, data.MerchantCouponAmount, data.PromotionDetail, data.PaidAmount, data.RefundedAmount, data.Currency, data.PriceCurrency, data.FxRate, data.PaymentMethod, data.PaymentAt, data.CompletedAt, data.ExpireTime, data.CancelReason, data.AddressSnapshot)
 	}, ordersOrderIdKey, ordersPreorderIdKey)
 	return ret, err
 }
@@ -140,7 +150,7 @@ func (m *defaultOrdersModel) Update(ctx context.Context, newData *Orders) error
 	ordersPreorderIdKey := fmt.Sprintf("%s%v", cacheOrdersPreorderIdPrefix, data.PreorderId)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `order_id` = ?", m.table, ordersRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.PreorderId, newData.UserId, newData.CouponId, newData.Status, newData.TotalAmount, newData.PayableAmount, newData.PaidAmount, newData.PaymentMethod, newData.PaymentAt, newData.ExpireTime, newData.CancelReason, newData.AddressSnapshot, newData.OrderId)
+		return conn.ExecCtx(ctx, query, newData.PreorderId, newData.UserId, newData.CouponId, newData.OrderType, newData.Status, newData.TotalAmount, newData.PayableAmount, newData.PromotionAmount, newData.MerchantPromotionAmount, newData.MerchantCouponAmount, newData.PromotionDetail, newData.PaidAmount, newData.RefundedAmount, newData.Currency, newData.PriceCurrency, newData.FxRate, newData.PaymentMethod, newData.PaymentAt, newData.CompletedAt, newData.ExpireTime, newData.CancelReason, newData.AddressSnapshot, newData.OrderId)
 	}, ordersOrderIdKey, ordersPreorderIdKey)
 	return err
 }
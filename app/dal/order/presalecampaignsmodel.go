@@ -0,0 +1,96 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PresaleCampaignsModel = (*customPresaleCampaignsModel)(nil)
+
+type (
+	// PresaleCampaignsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPresaleCampaignsModel.
+	PresaleCampaignsModel interface {
+		presaleCampaignsModel
+		// FindOneForUpdateWithSession locks the campaign row within given session.
+		FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, campaignId int64) (*PresaleCampaigns, error)
+		// ReserveWithSession increases reserved_quantity when the campaign is active and has enough quota.
+		// Returns true if the row was updated.
+		ReserveWithSession(ctx context.Context, session sqlx.Session, campaignId, quantity int64) (bool, error)
+		// ReleaseWithSession gives back reserved quantity of a cancelled presale order.
+		ReleaseWithSession(ctx context.Context, session sqlx.Session, campaignId, quantity int64) error
+		// CancelIfActive marks an active campaign owned by the merchant as CANCELLED.
+		CancelIfActive(ctx context.Context, campaignId, merchantId int64) (bool, error)
+	}
+
+	customPresaleCampaignsModel struct {
+		*defaultPresaleCampaignsModel
+	}
+)
+
+// NewPresaleCampaignsModel returns a model for the database table.
+func NewPresaleCampaignsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PresaleCampaignsModel {
+	return &customPresaleCampaignsModel{
+		defaultPresaleCampaignsModel: newPresaleCampaignsModel(conn, c, opts...),
+	}
+}
+
+// reserved_quantity 变化频繁，核心 CRUD 走无缓存路径。
+func (m *customPresaleCampaignsModel) FindOne(ctx context.Context, campaignId int64) (*PresaleCampaigns, error) {
+	var resp PresaleCampaigns
+	query := fmt.Sprintf("select %s from %s where `campaign_id` = ? limit 1", presaleCampaignsRows, m.table)
+	if err := m.QueryRowNoCacheCtx(ctx, &resp, query, campaignId); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (m *customPresaleCampaignsModel) Insert(ctx context.Context, data *PresaleCampaigns) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, presaleCampaignsRowsExpectAutoSet)
+	return m.ExecNoCacheCtx(ctx, query, data.MerchantId, data.ProductId, data.Title, data.PresalePrice, data.DepositAmount, data.DepositDeduction, data.TotalQuantity, data.ReservedQuantity, data.PerUserLimit, data.DepositStartAt, data.DepositEndAt, data.BalanceStartAt, data.BalanceEndAt, data.DepositRefundable, data.Status)
+}
+
+func (m *customPresaleCampaignsModel) Update(ctx context.Context, data *PresaleCampaigns) error {
+	query := fmt.Sprintf("update %s set %s where `campaign_id` = ?", m.table, presaleCampaignsRowsWithPlaceHolder)
+	_, err := m.ExecNoCacheCtx(ctx, query, data.MerchantId, data.ProductId, data.Title, data.PresalePrice, data.DepositAmount, data.DepositDeduction, data.TotalQuantity, data.ReservedQuantity, data.PerUserLimit, data.DepositStartAt, data.DepositEndAt, data.BalanceStartAt, data.BalanceEndAt, data.DepositRefundable, data.Status, data.CampaignId)
+	return err
+}
+
+func (m *customPresaleCampaignsModel) FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, campaignId int64) (*PresaleCampaigns, error) {
+	var resp PresaleCampaigns
+	query := fmt.Sprintf("select %s from %s where `campaign_id` = ? limit 1 for update", presaleCampaignsRows, m.table)
+	if err := session.QueryRowCtx(ctx, &resp, query, campaignId); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (m *customPresaleCampaignsModel) ReserveWithSession(ctx context.Context, session sqlx.Session, campaignId, quantity int64) (bool, error) {
+	query := fmt.Sprintf("update %s set `reserved_quantity` = `reserved_quantity` + ? where `campaign_id` = ? and `status` = ? and (`total_quantity` = 0 or `reserved_quantity` + ? <= `total_quantity`)", m.table)
+	res, err := session.ExecCtx(ctx, query, quantity, campaignId, PresaleCampaignStatusActive, quantity)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+func (m *customPresaleCampaignsModel) ReleaseWithSession(ctx context.Context, session sqlx.Session, campaignId, quantity int64) error {
+	query := fmt.Sprintf("update %s set `reserved_quantity` = if(`reserved_quantity` > ?, `reserved_quantity` - ?, 0) where `campaign_id` = ?", m.table)
+	_, err := session.ExecCtx(ctx, query, quantity, quantity, campaignId)
+	return err
+}
+
+func (m *customPresaleCampaignsModel) CancelIfActive(ctx context.Context, campaignId, merchantId int64) (bool, error) {
+	query := fmt.Sprintf("update %s set `status` = ? where `campaign_id` = ? and `merchant_id` = ? and `status` = ?", m.table)
+	res, err := m.ExecNoCacheCtx(ctx, query, PresaleCampaignStatusCancelled, campaignId, merchantId, PresaleCampaignStatusActive)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
@@ -0,0 +1,127 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package order
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	presaleCampaignsFieldNames          = builder.RawFieldNames(&PresaleCampaigns{})
+	presaleCampaignsRows                = strings.Join(presaleCampaignsFieldNames, ",")
+	presaleCampaignsRowsExpectAutoSet   = strings.Join(stringx.Remove(presaleCampaignsFieldNames, "`campaign_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	presaleCampaignsRowsWithPlaceHolder = strings.Join(stringx.Remove(presaleCampaignsFieldNames, "`campaign_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cachePresaleCampaignsCampaignIdPrefix = "cache:presaleCampaigns:campaignId:"
+)
+
+type (
+	presaleCampaignsModel interface {
+		Insert(ctx context.Context, data *PresaleCampaigns) (sql.Result, error)
+		FindOne(ctx context.Context, campaignId int64) (*PresaleCampaigns, error)
+		Update(ctx context.Context, data *PresaleCampaigns) error
+		Delete(ctx context.Context, campaignId int64) error
+	}
+
+	defaultPresaleCampaignsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	PresaleCampaigns struct {
+		CampaignId        int64     `db:"campaign_id"`        // 预售活动ID
+		MerchantId        int64     `db:"merchant_id"`        // 商家ID
+		ProductId         int64     `db:"product_id"`         // 商品ID
+		Title             string    `db:"title"`              // 活动标题
+		PresalePrice      int64     `db:"presale_price"`      // 预售单价(分)
+		DepositAmount     int64     `db:"deposit_amount"`     // 单件定金(分)
+		DepositDeduction  int64     `db:"deposit_deduction"`  // 单件定金可抵扣金额(分)，大于定金即定金膨胀
+		TotalQuantity     int64     `db:"total_quantity"`     // 预售总量，0 表示不限
+		ReservedQuantity  int64     `db:"reserved_quantity"`  // 已被预订的数量
+		PerUserLimit      int64     `db:"per_user_limit"`     // 每人限购，0 表示不限
+		DepositStartAt    time.Time `db:"deposit_start_at"`   // 定金开始时间
+		DepositEndAt      time.Time `db:"deposit_end_at"`     // 定金截止时间
+		BalanceStartAt    time.Time `db:"balance_start_at"`   // 尾款开始时间
+		BalanceEndAt      time.Time `db:"balance_end_at"`     // 尾款截止时间
+		DepositRefundable int64     `db:"deposit_refundable"` // 用户主动取消时定金是否可退
+		Status            string    `db:"status"`             // 活动状态
+		CreatedAt         time.Time `db:"created_at"`
+		UpdatedAt         time.Time `db:"updated_at"`
+	}
+)
+
+func newPresaleCampaignsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultPresaleCampaignsModel {
+	return &defaultPresaleCampaignsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`presale_campaigns`",
+	}
+}
+
+func (m *defaultPresaleCampaignsModel) Delete(ctx context.Context, campaignId int64) error {
+	presaleCampaignsCampaignIdKey := fmt.Sprintf("%s%v", cachePresaleCampaignsCampaignIdPrefix, campaignId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `campaign_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, campaignId)
+	}, presaleCampaignsCampaignIdKey)
+	return err
+}
+
+func (m *defaultPresaleCampaignsModel) FindOne(ctx context.Context, campaignId int64) (*PresaleCampaigns, error) {
+	presaleCampaignsCampaignIdKey := fmt.Sprintf("%s%v", cachePresaleCampaignsCampaignIdPrefix, campaignId)
+	var resp PresaleCampaigns
+	err := m.QueryRowCtx(ctx, &resp, presaleCampaignsCampaignIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `campaign_id` = ? limit 1", presaleCampaignsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, campaignId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPresaleCampaignsModel) Insert(ctx context.Context, data *PresaleCampaigns) (sql.Result, error) {
+	presaleCampaignsCampaignIdKey := fmt.Sprintf("%s%v", cachePresaleCampaignsCampaignIdPrefix, data.CampaignId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, presaleCampaignsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.MerchantId, data.ProductId, data.Title, data.PresalePrice, data.DepositAmount, data.DepositDeduction, data.TotalQuantity, data.ReservedQuantity, data.PerUserLimit, data.DepositStartAt, data.DepositEndAt, data.BalanceStartAt, data.BalanceEndAt, data.DepositRefundable, data.Status)
+	}, presaleCampaignsCampaignIdKey)
+	return ret, err
+}
+
+func (m *defaultPresaleCampaignsModel) Update(ctx context.Context, data *PresaleCampaigns) error {
+	presaleCampaignsCampaignIdKey := fmt.Sprintf("%s%v", cachePresaleCampaignsCampaignIdPrefix, data.CampaignId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `campaign_id` = ?", m.table, presaleCampaignsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.MerchantId, data.ProductId, data.Title, data.PresalePrice, data.DepositAmount, data.DepositDeduction, data.TotalQuantity, data.ReservedQuantity, data.PerUserLimit, data.DepositStartAt, data.DepositEndAt, data.BalanceStartAt, data.BalanceEndAt, data.DepositRefundable, data.Status, data.CampaignId)
+	}, presaleCampaignsCampaignIdKey)
+	return err
+}
+
+func (m *defaultPresaleCampaignsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cachePresaleCampaignsCampaignIdPrefix, primary)
+}
+
+func (m *defaultPresaleCampaignsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `campaign_id` = ? limit 1", presaleCampaignsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultPresaleCampaignsModel) tableName() string {
+	return m.table
+}
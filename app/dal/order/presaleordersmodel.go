@@ -0,0 +1,124 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PresaleOrdersModel = (*customPresaleOrdersModel)(nil)
+
+type (
+	// PresaleOrdersModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPresaleOrdersModel.
+	PresaleOrdersModel interface {
+		presaleOrdersModel
+		// InsertWithSession inserts a presale order within given session.
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *PresaleOrders) (sql.Result, error)
+		// UpdateWithSession updates a presale order within given session.
+		UpdateWithSession(ctx context.Context, session sqlx.Session, data *PresaleOrders) error
+		// FindOneForUpdateWithSession locks the presale order row within given session.
+		FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, presaleId int64) (*PresaleOrders, error)
+		// FindOneByOrderId returns the presale order owning the given deposit or balance order.
+		FindOneByOrderId(ctx context.Context, orderId int64) (*PresaleOrders, error)
+		// SumActiveQuantityWithSession sums quantity of a user's presale orders that still hold quota.
+		SumActiveQuantityWithSession(ctx context.Context, session sqlx.Session, campaignId, userId int64) (int64, error)
+		// ListByCampaignStatus returns presale orders of a campaign in given statuses.
+		ListByCampaignStatus(ctx context.Context, campaignId int64, statuses []string) ([]*PresaleOrders, error)
+	}
+
+	customPresaleOrdersModel struct {
+		*defaultPresaleOrdersModel
+	}
+)
+
+// NewPresaleOrdersModel returns a model for the database table.
+func NewPresaleOrdersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PresaleOrdersModel {
+	return &customPresaleOrdersModel{
+		defaultPresaleOrdersModel: newPresaleOrdersModel(conn, c, opts...),
+	}
+}
+
+// Override core CRUD to use no-cache paths for consistency.
+func (m *customPresaleOrdersModel) FindOne(ctx context.Context, presaleId int64) (*PresaleOrders, error) {
+	var resp PresaleOrders
+	query := fmt.Sprintf("select %s from %s where `presale_id` = ? limit 1", presaleOrdersRows, m.table)
+	if err := m.QueryRowNoCacheCtx(ctx, &resp, query, presaleId); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (m *customPresaleOrdersModel) Insert(ctx context.Context, data *PresaleOrders) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, presaleOrdersRowsExpectAutoSet)
+	return m.ExecNoCacheCtx(ctx, query, data.CampaignId, data.UserId, data.ProductId, data.Quantity, data.DepositAmount, data.BalanceAmount, data.DepositOrderId, data.BalanceOrderId, data.Status, data.CancelReason)
+}
+
+func (m *customPresaleOrdersModel) Update(ctx context.Context, data *PresaleOrders) error {
+	query := fmt.Sprintf("update %s set %s where `presale_id` = ?", m.table, presaleOrdersRowsWithPlaceHolder)
+	_, err := m.ExecNoCacheCtx(ctx, query, data.CampaignId, data.UserId, data.ProductId, data.Quantity, data.DepositAmount, data.BalanceAmount, data.DepositOrderId, data.BalanceOrderId, data.Status, data.CancelReason, data.PresaleId)
+	return err
+}
+
+func (m *customPresaleOrdersModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *PresaleOrders) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, presaleOrdersRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.CampaignId, data.UserId, data.ProductId, data.Quantity, data.DepositAmount, data.BalanceAmount, data.DepositOrderId, data.BalanceOrderId, data.Status, data.CancelReason)
+}
+
+func (m *customPresaleOrdersModel) UpdateWithSession(ctx context.Context, session sqlx.Session, data *PresaleOrders) error {
+	query := fmt.Sprintf("update %s set %s where `presale_id` = ?", m.table, presaleOrdersRowsWithPlaceHolder)
+	_, err := session.ExecCtx(ctx, query, data.CampaignId, data.UserId, data.ProductId, data.Quantity, data.DepositAmount, data.BalanceAmount, data.DepositOrderId, data.BalanceOrderId, data.Status, data.CancelReason, data.PresaleId)
+	return err
+}
+
+func (m *customPresaleOrdersModel) FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, presaleId int64) (*PresaleOrders, error) {
+	var resp PresaleOrders
+	query := fmt.Sprintf("select %s from %s where `presale_id` = ? limit 1 for update", presaleOrdersRows, m.table)
+	if err := session.QueryRowCtx(ctx, &resp, query, presaleId); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (m *customPresaleOrdersModel) FindOneByOrderId(ctx context.Context, orderId int64) (*PresaleOrders, error) {
+	var resp PresaleOrders
+	query := fmt.Sprintf("select %s from %s where `deposit_order_id` = ? or `balance_order_id` = ? limit 1", presaleOrdersRows, m.table)
+	if err := m.QueryRowNoCacheCtx(ctx, &resp, query, orderId, orderId); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (m *customPresaleOrdersModel) SumActiveQuantityWithSession(ctx context.Context, session sqlx.Session, campaignId, userId int64) (int64, error) {
+	var total int64
+	query := fmt.Sprintf("select coalesce(sum(`quantity`), 0) from %s where `campaign_id` = ? and `user_id` = ? and `status` in (?, ?, ?, ?)", m.table)
+	if err := session.QueryRowCtx(ctx, &total, query, campaignId, userId, PresaleStatusDepositPending, PresaleStatusDepositPaid, PresaleStatusBalancePending, PresaleStatusCompleted); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (m *customPresaleOrdersModel) ListByCampaignStatus(ctx context.Context, campaignId int64, statuses []string) ([]*PresaleOrders, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(statuses)+1)
+	args = append(args, campaignId)
+	for _, st := range statuses {
+		args = append(args, st)
+	}
+	var rows []PresaleOrders
+	query := fmt.Sprintf("select %s from %s where `campaign_id` = ? and `status` in (%s) order by `presale_id` asc", presaleOrdersRows, m.table, strings.TrimSuffix(strings.Repeat("?,", len(statuses)), ","))
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	res := make([]*PresaleOrders, 0, len(rows))
+	for i := range rows {
+		res = append(res, &rows[i])
+	}
+	return res, nil
+}
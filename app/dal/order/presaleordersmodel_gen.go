@@ -0,0 +1,122 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package order
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	presaleOrdersFieldNames          = builder.RawFieldNames(&PresaleOrders{})
+	presaleOrdersRows                = strings.Join(presaleOrdersFieldNames, ",")
+	presaleOrdersRowsExpectAutoSet   = strings.Join(stringx.Remove(presaleOrdersFieldNames, "`presale_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	presaleOrdersRowsWithPlaceHolder = strings.Join(stringx.Remove(presaleOrdersFieldNames, "`presale_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cachePresaleOrdersPresaleIdPrefix = "cache:presaleOrders:presaleId:"
+)
+
+type (
+	presaleOrdersModel interface {
+		Insert(ctx context.Context, data *PresaleOrders) (sql.Result, error)
+		FindOne(ctx context.Context, presaleId int64) (*PresaleOrders, error)
+		Update(ctx context.Context, data *PresaleOrders) error
+		Delete(ctx context.Context, presaleId int64) error
+	}
+
+	defaultPresaleOrdersModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	PresaleOrders struct {
+		PresaleId      int64     `db:"presale_id"`       // 预售单ID
+		CampaignId     int64     `db:"campaign_id"`      // 预售活动ID
+		UserId         int64     `db:"user_id"`          // 用户ID
+		ProductId      int64     `db:"product_id"`       // 商品ID
+		Quantity       int64     `db:"quantity"`         // 购买数量
+		DepositAmount  int64     `db:"deposit_amount"`   // 定金总额(分)
+		BalanceAmount  int64     `db:"balance_amount"`   // 尾款总额(分)，已扣除定金抵扣
+		DepositOrderId int64     `db:"deposit_order_id"` // 定金订单ID
+		BalanceOrderId int64     `db:"balance_order_id"` // 尾款订单ID
+		Status         string    `db:"status"`           // 预售单状态
+		CancelReason   string    `db:"cancel_reason"`    // 取消原因
+		CreatedAt      time.Time `db:"created_at"`
+		UpdatedAt      time.Time `db:"updated_at"`
+	}
+)
+
+func newPresaleOrdersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultPresaleOrdersModel {
+	return &defaultPresaleOrdersModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`presale_orders`",
+	}
+}
+
+func (m *defaultPresaleOrdersModel) Delete(ctx context.Context, presaleId int64) error {
+	presaleOrdersPresaleIdKey := fmt.Sprintf("%s%v", cachePresaleOrdersPresaleIdPrefix, presaleId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `presale_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, presaleId)
+	}, presaleOrdersPresaleIdKey)
+	return err
+}
+
+func (m *defaultPresaleOrdersModel) FindOne(ctx context.Context, presaleId int64) (*PresaleOrders, error) {
+	presaleOrdersPresaleIdKey := fmt.Sprintf("%s%v", cachePresaleOrdersPresaleIdPrefix, presaleId)
+	var resp PresaleOrders
+	err := m.QueryRowCtx(ctx, &resp, presaleOrdersPresaleIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `presale_id` = ? limit 1", presaleOrdersRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, presaleId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPresaleOrdersModel) Insert(ctx context.Context, data *PresaleOrders) (sql.Result, error) {
+	presaleOrdersPresaleIdKey := fmt.Sprintf("%s%v", cachePresaleOrdersPresaleIdPrefix, data.PresaleId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, presaleOrdersRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.CampaignId, data.UserId, data.ProductId, data.Quantity, data.DepositAmount, data.BalanceAmount, data.DepositOrderId, data.BalanceOrderId, data.Status, data.CancelReason)
+	}, presaleOrdersPresaleIdKey)
+	return ret, err
+}
+
+func (m *defaultPresaleOrdersModel) Update(ctx context.Context, data *PresaleOrders) error {
+	presaleOrdersPresaleIdKey := fmt.Sprintf("%s%v", cachePresaleOrdersPresaleIdPrefix, data.PresaleId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `presale_id` = ?", m.table, presaleOrdersRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.CampaignId, data.UserId, data.ProductId, data.Quantity, data.DepositAmount, data.BalanceAmount, data.DepositOrderId, data.BalanceOrderId, data.Status, data.CancelReason, data.PresaleId)
+	}, presaleOrdersPresaleIdKey)
+	return err
+}
+
+func (m *defaultPresaleOrdersModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cachePresaleOrdersPresaleIdPrefix, primary)
+}
+
+func (m *defaultPresaleOrdersModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `presale_id` = ? limit 1", presaleOrdersRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultPresaleOrdersModel) tableName() string {
+	return m.table
+}
@@ -3,3 +3,28 @@ package order
 import "github.com/zeromicro/go-zero/core/stores/sqlx"
 
 var ErrNotFound = sqlx.ErrNotFound
+
+// 订单类型
+const (
+	OrderTypeNormal         = "NORMAL"
+	OrderTypePresaleDeposit = "PRESALE_DEPOSIT"
+	OrderTypePresaleBalance = "PRESALE_BALANCE"
+)
+
+// 预售活动状态
+const (
+	PresaleCampaignStatusActive    = "ACTIVE"
+	PresaleCampaignStatusCancelled = "CANCELLED"
+)
+
+// 预售单状态
+const (
+	PresaleStatusDepositPending   = "DEPOSIT_PENDING"
+	PresaleStatusDepositPaid      = "DEPOSIT_PAID"
+	PresaleStatusBalancePending   = "BALANCE_PENDING"
+	PresaleStatusCompleted        = "COMPLETED"
+	PresaleStatusCancelled        = "CANCELLED"
+	PresaleStatusDepositForfeited = "DEPOSIT_FORFEITED"
+	PresaleStatusDepositRefunding = "DEPOSIT_REFUNDING"
+	PresaleStatusDepositRefunded  = "DEPOSIT_REFUNDED"
+)
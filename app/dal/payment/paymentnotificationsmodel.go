@@ -0,0 +1,42 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PaymentNotificationsModel = (*customPaymentNotificationsModel)(nil)
+
+type (
+	// PaymentNotificationsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPaymentNotificationsModel.
+	PaymentNotificationsModel interface {
+		paymentNotificationsModel
+		// UpdateResult records how a notification was handled.
+		UpdateResult(ctx context.Context, notificationId int64, result string) error
+	}
+
+	customPaymentNotificationsModel struct {
+		*defaultPaymentNotificationsModel
+	}
+)
+
+// NewPaymentNotificationsModel returns a model for the database table.
+func NewPaymentNotificationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PaymentNotificationsModel {
+	return &customPaymentNotificationsModel{
+		defaultPaymentNotificationsModel: newPaymentNotificationsModel(conn, c, opts...),
+	}
+}
+
+func (m *customPaymentNotificationsModel) UpdateResult(ctx context.Context, notificationId int64, result string) error {
+	paymentNotificationsNotificationIdKey := fmt.Sprintf("%s%v", cachePaymentNotificationsNotificationIdPrefix, notificationId)
+	query := fmt.Sprintf("update %s set `result` = ? where `notification_id` = ?", m.tableName())
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, query, result, notificationId)
+	}, paymentNotificationsNotificationIdKey)
+	return err
+}
@@ -0,0 +1,121 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	paymentNotificationsFieldNames          = builder.RawFieldNames(&PaymentNotifications{})
+	paymentNotificationsRows                = strings.Join(paymentNotificationsFieldNames, ",")
+	paymentNotificationsRowsExpectAutoSet   = strings.Join(stringx.Remove(paymentNotificationsFieldNames, "`notification_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	paymentNotificationsRowsWithPlaceHolder = strings.Join(stringx.Remove(paymentNotificationsFieldNames, "`notification_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cachePaymentNotificationsNotificationIdPrefix = "cache:paymentNotifications:notificationId:"
+)
+
+type (
+	paymentNotificationsModel interface {
+		Insert(ctx context.Context, data *PaymentNotifications) (sql.Result, error)
+		FindOne(ctx context.Context, notificationId int64) (*PaymentNotifications, error)
+		Update(ctx context.Context, data *PaymentNotifications) error
+		Delete(ctx context.Context, notificationId int64) error
+	}
+
+	defaultPaymentNotificationsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	PaymentNotifications struct {
+		NotificationId int64          `db:"notification_id"` // 通知记录ID
+		Channel        string         `db:"channel"`         // 支付渠道
+		PaymentNo      string         `db:"payment_no"`      // 支付单号（验签通过后解析）
+		TradeNo        string         `db:"trade_no"`        // 渠道交易号
+		TradeState     string         `db:"trade_state"`     // 渠道交易状态
+		Amount         int64          `db:"amount"`          // 通知金额，单位分
+		Verified       int64          `db:"verified"`        // 验签是否通过
+		Result         string         `db:"result"`          // 处理结果
+		Headers        sql.NullString `db:"headers"`         // 原始请求头
+		RawBody        string         `db:"raw_body"`        // 原始通知报文
+		CreatedAt      time.Time      `db:"created_at"`
+		UpdatedAt      time.Time      `db:"updated_at"`
+	}
+)
+
+func newPaymentNotificationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultPaymentNotificationsModel {
+	return &defaultPaymentNotificationsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`payment_notifications`",
+	}
+}
+
+func (m *defaultPaymentNotificationsModel) Delete(ctx context.Context, notificationId int64) error {
+	paymentNotificationsNotificationIdKey := fmt.Sprintf("%s%v", cachePaymentNotificationsNotificationIdPrefix, notificationId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `notification_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, notificationId)
+	}, paymentNotificationsNotificationIdKey)
+	return err
+}
+
+func (m *defaultPaymentNotificationsModel) FindOne(ctx context.Context, notificationId int64) (*PaymentNotifications, error) {
+	paymentNotificationsNotificationIdKey := fmt.Sprintf("%s%v", cachePaymentNotificationsNotificationIdPrefix, notificationId)
+	var resp PaymentNotifications
+	err := m.QueryRowCtx(ctx, &resp, paymentNotificationsNotificationIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `notification_id` = ? limit 1", paymentNotificationsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, notificationId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPaymentNotificationsModel) Insert(ctx context.Context, data *PaymentNotifications) (sql.Result, error) {
+	paymentNotificationsNotificationIdKey := fmt.Sprintf("%s%v", cachePaymentNotificationsNotificationIdPrefix, data.NotificationId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, paymentNotificationsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Channel, data.PaymentNo, data.TradeNo, data.TradeState, data.Amount, data.Verified, data.Result, data.Headers, data.RawBody)
+	}, paymentNotificationsNotificationIdKey)
+	return ret, err
+}
+
+func (m *defaultPaymentNotificationsModel) Update(ctx context.Context, data *PaymentNotifications) error {
+	paymentNotificationsNotificationIdKey := fmt.Sprintf("%s%v", cachePaymentNotificationsNotificationIdPrefix, data.NotificationId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `notification_id` = ?", m.table, paymentNotificationsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.Channel, data.PaymentNo, data.TradeNo, data.TradeState, data.Amount, data.Verified, data.Result, data.Headers, data.RawBody, data.NotificationId)
+	}, paymentNotificationsNotificationIdKey)
+	return err
+}
+
+func (m *defaultPaymentNotificationsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cachePaymentNotificationsNotificationIdPrefix, primary)
+}
+
+func (m *defaultPaymentNotificationsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `notification_id` = ? limit 1", paymentNotificationsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultPaymentNotificationsModel) tableName() string {
+	return m.table
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -18,6 +19,14 @@ type (
 	PaymentOrdersModel interface {
 		paymentOrdersModel
 		UpdateStatus(ctx context.Context, paymentId int64, fromStatus []string, toStatus string) (bool, error)
+		// UpdateChannelPayload stores the channel prepay payload and moves the status in one statement.
+		UpdateChannelPayload(ctx context.Context, paymentId int64, fromStatus []string, toStatus string, payload string) (bool, error)
+		// FindOneForUpdateWithSession locks the payment row within given session.
+		FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, paymentId int64) (*PaymentOrders, error)
+		// ListByPaymentNos returns payments of the given payment numbers.
+		ListByPaymentNos(ctx context.Context, paymentNos []string) ([]*PaymentOrders, error)
+		// ListByChannelStatusUpdated returns payments of a channel in the status updated within [from, to).
+		ListByChannelStatusUpdated(ctx context.Context, channel, status string, from, to time.Time) ([]*PaymentOrders, error)
 	}
 
 	customPaymentOrdersModel struct {
@@ -70,6 +79,79 @@ func (m *customPaymentOrdersModel) UpdateStatus(ctx context.Context, paymentId i
 	return affected > 0, nil
 }
 
+func (m *customPaymentOrdersModel) UpdateChannelPayload(ctx context.Context, paymentId int64, fromStatus []string, toStatus string, payload string) (bool, error) {
+	if len(fromStatus) == 0 {
+		return false, fmt.Errorf("fromStatus must not be empty")
+	}
+
+	record, err := m.FindOne(ctx, paymentId)
+	if err != nil {
+		return false, err
+	}
+
+	paymentOrdersOrderIdKey := fmt.Sprintf("%s%v", cachePaymentOrdersOrderIdPrefix, record.OrderId)
+	paymentOrdersPaymentIdKey := fmt.Sprintf("%s%v", cachePaymentOrdersPaymentIdPrefix, paymentId)
+	paymentOrdersPaymentNoKey := fmt.Sprintf("%s%v", cachePaymentOrdersPaymentNoPrefix, record.PaymentNo)
+
+	args := make([]any, 0, len(fromStatus)+3)
+	args = append(args, toStatus, payload)
+	for _, s := range fromStatus {
+		args = append(args, s)
+	}
+	args = append(args, paymentId)
+
+	query := fmt.Sprintf("update %s set `status` = ?, `channel_payload` = ?, `updated_at` = current_timestamp where `status` in (%s) and `payment_id` = ?", m.tableName(), placeholders(len(fromStatus)))
+
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, query, args...)
+	}, paymentOrdersOrderIdKey, paymentOrdersPaymentIdKey, paymentOrdersPaymentNoKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (m *customPaymentOrdersModel) FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, paymentId int64) (*PaymentOrders, error) {
+	var resp PaymentOrders
+	query := fmt.Sprintf("select %s from %s where `payment_id` = ? limit 1 for update", paymentOrdersRows, m.table)
+	if err := session.QueryRowCtx(ctx, &resp, query, paymentId); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (m *customPaymentOrdersModel) ListByPaymentNos(ctx context.Context, paymentNos []string) ([]*PaymentOrders, error) {
+	if len(paymentNos) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(paymentNos))
+	for _, no := range paymentNos {
+		args = append(args, no)
+	}
+
+	var rows []*PaymentOrders
+	query := fmt.Sprintf("select %s from %s where `payment_no` in (%s)", paymentOrdersRows, m.table, placeholders(len(paymentNos)))
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (m *customPaymentOrdersModel) ListByChannelStatusUpdated(ctx context.Context, channel, status string, from, to time.Time) ([]*PaymentOrders, error) {
+	var rows []*PaymentOrders
+	query := fmt.Sprintf("select %s from %s where `channel` = ? and `status` = ? and `updated_at` >= ? and `updated_at` < ?", paymentOrdersRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, channel, status, from, to); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 func placeholders(n int) string {
 	if n <= 0 {
 		return ""
@@ -47,11 +47,15 @@ type (
 	PaymentOrders struct {
 		PaymentId      int64          `db:"payment_id"`      // 支付记录ID
 		PaymentNo      string         `db:"payment_no"`      // 支付单号
-		OrderId        int64          `db:"order_id"`        // 关联订单ID
+		OrderId        int64          `db:"order_id"`        // 关联订单ID，充值单为充值单号
+		BizType        string         `db:"biz_type"`        // 业务类型：订单支付/钱包充值
 		UserId         int64          `db:"user_id"`         // 用户ID
 		Amount         int64          `db:"amount"`          // 支付金额，单位分
 		Currency       string         `db:"currency"`        // 币种
-		Channel        string         `db:"channel"`         // 支付渠道
+		PriceCurrency  string         `db:"price_currency"`  // 商品标价币种，取自订单
+		FxRate         int64          `db:"fx_rate"`         // 标价币种 → 支付币种汇率快照，取自订单，放大 1e8 倍
+		Channel        string         `db:"channel"`         // 支付渠道，组合支付时为最后一段（主支付段）的渠道
+		ChannelAmount  int64          `db:"channel_amount"`  // 主支付段金额，单位分；非组合支付与 amount 相同
 		Status         string         `db:"status"`          // 支付状态
 		ChannelPayload sql.NullString `db:"channel_payload"` // 渠道请求载荷
 		TimeoutAt      time.Time      `db:"timeout_at"`      // 支付超时时间
@@ -146,8 +150,8 @@ func (m *defaultPaymentOrdersModel) Insert(ctx context.Context, data *PaymentOrd
 	paymentOrdersPaymentIdKey := fmt.Sprintf("%s%v", cachePaymentOrdersPaymentIdPrefix, data.PaymentId)
 	paymentOrdersPaymentNoKey := fmt.Sprintf("%s%v", cachePaymentOrdersPaymentNoPrefix, data.PaymentNo)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, paymentOrdersRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.PaymentNo, data.OrderId, data.UserId, data.Amount, data.Currency, data.Channel, data.Status, data.ChannelPayload, data.TimeoutAt, data.Extra)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, paymentOrdersRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.PaymentNo, data.OrderId, data.BizType, data.UserId, data.Amount, data.Currency, data.PriceCurrency, data.FxRate, data.Channel, data.ChannelAmount, data.Status, data.ChannelPayload, data.TimeoutAt, data.Extra)
 	}, paymentOrdersOrderIdKey, paymentOrdersPaymentIdKey, paymentOrdersPaymentNoKey)
 	return ret, err
 }
@@ -163,7 +167,7 @@ func (m *defaultPaymentOrdersModel) Update(ctx context.Context, newData *Payment
 	paymentOrdersPaymentNoKey := fmt.Sprintf("%s%v", cachePaymentOrdersPaymentNoPrefix, data.PaymentNo)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `payment_id` = ?", m.table, paymentOrdersRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.PaymentNo, newData.OrderId, newData.UserId, newData.Amount, newData.Currency, newData.Channel, newData.Status, newData.ChannelPayload, newData.TimeoutAt, newData.Extra, newData.PaymentId)
+		return conn.ExecCtx(ctx, query, newData.PaymentNo, newData.OrderId, newData.BizType, newData.UserId, newData.Amount, newData.Currency, newData.PriceCurrency, newData.FxRate, newData.Channel, newData.ChannelAmount, newData.Status, newData.ChannelPayload, newData.TimeoutAt, newData.Extra, newData.PaymentId)
 	}, paymentOrdersOrderIdKey, paymentOrdersPaymentIdKey, paymentOrdersPaymentNoKey)
 	return err
 }
@@ -0,0 +1,41 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PaymentReconcileItemsModel = (*customPaymentReconcileItemsModel)(nil)
+
+type (
+	// PaymentReconcileItemsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPaymentReconcileItemsModel.
+	PaymentReconcileItemsModel interface {
+		paymentReconcileItemsModel
+		// ListByReport returns discrepancy items of a report.
+		ListByReport(ctx context.Context, reportId int64) ([]*PaymentReconcileItems, error)
+	}
+
+	customPaymentReconcileItemsModel struct {
+		*defaultPaymentReconcileItemsModel
+	}
+)
+
+// NewPaymentReconcileItemsModel returns a model for the database table.
+func NewPaymentReconcileItemsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PaymentReconcileItemsModel {
+	return &customPaymentReconcileItemsModel{
+		defaultPaymentReconcileItemsModel: newPaymentReconcileItemsModel(conn, c, opts...),
+	}
+}
+
+func (m *customPaymentReconcileItemsModel) ListByReport(ctx context.Context, reportId int64) ([]*PaymentReconcileItems, error) {
+	var rows []*PaymentReconcileItems
+	query := fmt.Sprintf("select %s from %s where `report_id` = ? order by `item_id`", paymentReconcileItemsRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, reportId); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
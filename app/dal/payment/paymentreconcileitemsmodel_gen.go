@@ -0,0 +1,121 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	paymentReconcileItemsFieldNames          = builder.RawFieldNames(&PaymentReconcileItems{})
+	paymentReconcileItemsRows                = strings.Join(paymentReconcileItemsFieldNames, ",")
+	paymentReconcileItemsRowsExpectAutoSet   = strings.Join(stringx.Remove(paymentReconcileItemsFieldNames, "`item_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	paymentReconcileItemsRowsWithPlaceHolder = strings.Join(stringx.Remove(paymentReconcileItemsFieldNames, "`item_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cachePaymentReconcileItemsItemIdPrefix = "cache:paymentReconcileItems:itemId:"
+)
+
+type (
+	paymentReconcileItemsModel interface {
+		Insert(ctx context.Context, data *PaymentReconcileItems) (sql.Result, error)
+		FindOne(ctx context.Context, itemId int64) (*PaymentReconcileItems, error)
+		Update(ctx context.Context, data *PaymentReconcileItems) error
+		Delete(ctx context.Context, itemId int64) error
+	}
+
+	defaultPaymentReconcileItemsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	PaymentReconcileItems struct {
+		ItemId        int64     `db:"item_id"`        // 差异明细ID
+		ReportId      int64     `db:"report_id"`      // 对账报告ID
+		PaymentNo     string    `db:"payment_no"`     // 支付单号
+		Kind          string    `db:"kind"`           // 差异类型
+		LocalAmount   int64     `db:"local_amount"`   // 本地金额，单位分
+		ChannelAmount int64     `db:"channel_amount"` // 对账单金额，单位分
+		LocalStatus   string    `db:"local_status"`   // 本地支付状态
+		ChannelStatus string    `db:"channel_status"` // 对账单交易状态
+		TradeNo       string    `db:"trade_no"`       // 渠道交易号
+		Fixed         int64     `db:"fixed"`          // 是否已自动修复
+		Note          string    `db:"note"`           // 备注
+		CreatedAt     time.Time `db:"created_at"`
+	}
+)
+
+func newPaymentReconcileItemsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultPaymentReconcileItemsModel {
+	return &defaultPaymentReconcileItemsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`payment_reconcile_items`",
+	}
+}
+
+func (m *defaultPaymentReconcileItemsModel) Delete(ctx context.Context, itemId int64) error {
+	paymentReconcileItemsItemIdKey := fmt.Sprintf("%s%v", cachePaymentReconcileItemsItemIdPrefix, itemId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `item_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, itemId)
+	}, paymentReconcileItemsItemIdKey)
+	return err
+}
+
+func (m *defaultPaymentReconcileItemsModel) FindOne(ctx context.Context, itemId int64) (*PaymentReconcileItems, error) {
+	paymentReconcileItemsItemIdKey := fmt.Sprintf("%s%v", cachePaymentReconcileItemsItemIdPrefix, itemId)
+	var resp PaymentReconcileItems
+	err := m.QueryRowCtx(ctx, &resp, paymentReconcileItemsItemIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `item_id` = ? limit 1", paymentReconcileItemsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, itemId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPaymentReconcileItemsModel) Insert(ctx context.Context, data *PaymentReconcileItems) (sql.Result, error) {
+	paymentReconcileItemsItemIdKey := fmt.Sprintf("%s%v", cachePaymentReconcileItemsItemIdPrefix, data.ItemId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, paymentReconcileItemsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ReportId, data.PaymentNo, data.Kind, data.LocalAmount, data.ChannelAmount, data.LocalStatus, data.ChannelStatus, data.TradeNo, data.Fixed, data.Note)
+	}, paymentReconcileItemsItemIdKey)
+	return ret, err
+}
+
+func (m *defaultPaymentReconcileItemsModel) Update(ctx context.Context, data *PaymentReconcileItems) error {
+	paymentReconcileItemsItemIdKey := fmt.Sprintf("%s%v", cachePaymentReconcileItemsItemIdPrefix, data.ItemId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `item_id` = ?", m.table, paymentReconcileItemsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.ReportId, data.PaymentNo, data.Kind, data.LocalAmount, data.ChannelAmount, data.LocalStatus, data.ChannelStatus, data.TradeNo, data.Fixed, data.Note, data.ItemId)
+	}, paymentReconcileItemsItemIdKey)
+	return err
+}
+
+func (m *defaultPaymentReconcileItemsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cachePaymentReconcileItemsItemIdPrefix, primary)
+}
+
+func (m *defaultPaymentReconcileItemsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `item_id` = ? limit 1", paymentReconcileItemsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultPaymentReconcileItemsModel) tableName() string {
+	return m.table
+}
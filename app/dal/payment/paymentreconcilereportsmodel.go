@@ -0,0 +1,42 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PaymentReconcileReportsModel = (*customPaymentReconcileReportsModel)(nil)
+
+type (
+	// PaymentReconcileReportsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPaymentReconcileReportsModel.
+	PaymentReconcileReportsModel interface {
+		paymentReconcileReportsModel
+		// FindLatest returns the latest report of a channel and bill date.
+		FindLatest(ctx context.Context, channel string, billDate time.Time) (*PaymentReconcileReports, error)
+	}
+
+	customPaymentReconcileReportsModel struct {
+		*defaultPaymentReconcileReportsModel
+	}
+)
+
+// NewPaymentReconcileReportsModel returns a model for the database table.
+func NewPaymentReconcileReportsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PaymentReconcileReportsModel {
+	return &customPaymentReconcileReportsModel{
+		defaultPaymentReconcileReportsModel: newPaymentReconcileReportsModel(conn, c, opts...),
+	}
+}
+
+func (m *customPaymentReconcileReportsModel) FindLatest(ctx context.Context, channel string, billDate time.Time) (*PaymentReconcileReports, error) {
+	var resp PaymentReconcileReports
+	query := fmt.Sprintf("select %s from %s where `channel` = ? and `bill_date` = ? order by `report_id` desc limit 1", paymentReconcileReportsRows, m.table)
+	if err := m.QueryRowNoCacheCtx(ctx, &resp, query, channel, billDate.Format(time.DateOnly)); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
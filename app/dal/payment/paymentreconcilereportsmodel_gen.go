@@ -0,0 +1,125 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	paymentReconcileReportsFieldNames          = builder.RawFieldNames(&PaymentReconcileReports{})
+	paymentReconcileReportsRows                = strings.Join(paymentReconcileReportsFieldNames, ",")
+	paymentReconcileReportsRowsExpectAutoSet   = strings.Join(stringx.Remove(paymentReconcileReportsFieldNames, "`report_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	paymentReconcileReportsRowsWithPlaceHolder = strings.Join(stringx.Remove(paymentReconcileReportsFieldNames, "`report_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cachePaymentReconcileReportsReportIdPrefix = "cache:paymentReconcileReports:reportId:"
+)
+
+type (
+	paymentReconcileReportsModel interface {
+		Insert(ctx context.Context, data *PaymentReconcileReports) (sql.Result, error)
+		FindOne(ctx context.Context, reportId int64) (*PaymentReconcileReports, error)
+		Update(ctx context.Context, data *PaymentReconcileReports) error
+		Delete(ctx context.Context, reportId int64) error
+	}
+
+	defaultPaymentReconcileReportsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	PaymentReconcileReports struct {
+		ReportId       int64     `db:"report_id"`       // 对账报告ID
+		Channel        string    `db:"channel"`         // 支付渠道
+		BillDate       time.Time `db:"bill_date"`       // 账单日期
+		FileName       string    `db:"file_name"`       // 对账单文件名
+		Status         string    `db:"status"`          // 对账状态
+		AutoFix        int64     `db:"auto_fix"`        // 是否自动修复处理中的支付单
+		TotalRows      int64     `db:"total_rows"`      // 对账单行数
+		Matched        int64     `db:"matched"`         // 一致笔数
+		Missing        int64     `db:"missing"`         // 本地成功但对账单缺失
+		Extra          int64     `db:"extra"`           // 对账单存在但本地缺失
+		AmountMismatch int64     `db:"amount_mismatch"` // 金额不一致
+		StatusMismatch int64     `db:"status_mismatch"` // 状态不一致
+		Fixed          int64     `db:"fixed"`           // 自动修复笔数
+		Error          string    `db:"error"`           // 失败原因
+		CreatedAt      time.Time `db:"created_at"`
+		UpdatedAt      time.Time `db:"updated_at"`
+	}
+)
+
+func newPaymentReconcileReportsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultPaymentReconcileReportsModel {
+	return &defaultPaymentReconcileReportsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`payment_reconcile_reports`",
+	}
+}
+
+func (m *defaultPaymentReconcileReportsModel) Delete(ctx context.Context, reportId int64) error {
+	paymentReconcileReportsReportIdKey := fmt.Sprintf("%s%v", cachePaymentReconcileReportsReportIdPrefix, reportId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `report_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, reportId)
+	}, paymentReconcileReportsReportIdKey)
+	return err
+}
+
+func (m *defaultPaymentReconcileReportsModel) FindOne(ctx context.Context, reportId int64) (*PaymentReconcileReports, error) {
+	paymentReconcileReportsReportIdKey := fmt.Sprintf("%s%v", cachePaymentReconcileReportsReportIdPrefix, reportId)
+	var resp PaymentReconcileReports
+	err := m.QueryRowCtx(ctx, &resp, paymentReconcileReportsReportIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `report_id` = ? limit 1", paymentReconcileReportsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, reportId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPaymentReconcileReportsModel) Insert(ctx context.Context, data *PaymentReconcileReports) (sql.Result, error) {
+	paymentReconcileReportsReportIdKey := fmt.Sprintf("%s%v", cachePaymentReconcileReportsReportIdPrefix, data.ReportId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, paymentReconcileReportsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Channel, data.BillDate, data.FileName, data.Status, data.AutoFix, data.TotalRows, data.Matched, data.Missing, data.Extra, data.AmountMismatch, data.StatusMismatch, data.Fixed, data.Error)
+	}, paymentReconcileReportsReportIdKey)
+	return ret, err
+}
+
+func (m *defaultPaymentReconcileReportsModel) Update(ctx context.Context, data *PaymentReconcileReports) error {
+	paymentReconcileReportsReportIdKey := fmt.Sprintf("%s%v", cachePaymentReconcileReportsReportIdPrefix, data.ReportId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `report_id` = ?", m.table, paymentReconcileReportsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.Channel, data.BillDate, data.FileName, data.Status, data.AutoFix, data.TotalRows, data.Matched, data.Missing, data.Extra, data.AmountMismatch, data.StatusMismatch, data.Fixed, data.Error, data.ReportId)
+	}, paymentReconcileReportsReportIdKey)
+	return err
+}
+
+func (m *defaultPaymentReconcileReportsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cachePaymentReconcileReportsReportIdPrefix, primary)
+}
+
+func (m *defaultPaymentReconcileReportsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `report_id` = ? limit 1", paymentReconcileReportsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultPaymentReconcileReportsModel) tableName() string {
+	return m.table
+}
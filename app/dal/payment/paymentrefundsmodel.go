@@ -0,0 +1,170 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PaymentRefundsModel = (*customPaymentRefundsModel)(nil)
+
+type (
+	// PaymentRefundsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPaymentRefundsModel.
+	PaymentRefundsModel interface {
+		paymentRefundsModel
+		// InsertWithSession inserts a refund row within given session.
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *PaymentRefunds) (sql.Result, error)
+		// SumAmountWithSession sums refund amount of a payment in given statuses within given session.
+		SumAmountWithSession(ctx context.Context, session sqlx.Session, paymentId int64, statuses []string) (int64, error)
+		// SumAmountBySplitWithSession sums refund amount of a payment in given statuses grouped by split_id within given session.
+		SumAmountBySplitWithSession(ctx context.Context, session sqlx.Session, paymentId int64, statuses []string) (map[int64]int64, error)
+		// ListByRequest returns refunds created by one request, matched on parent_request_no (rows written before the column existed match on request_no).
+		ListByRequest(ctx context.Context, paymentId int64, requestNo string) ([]*PaymentRefunds, error)
+		// SumAmount sums refund amount of a payment in given statuses.
+		SumAmount(ctx context.Context, paymentId int64, statuses []string) (int64, error)
+		// UpdateProgress records one channel attempt and moves the status; finished_at is set on terminal status.
+		UpdateProgress(ctx context.Context, refundId int64, fromStatus []string, toStatus, channelRefundNo, lastError string) (bool, error)
+	}
+
+	customPaymentRefundsModel struct {
+		*defaultPaymentRefundsModel
+	}
+)
+
+// NewPaymentRefundsModel returns a model for the database table.
+func NewPaymentRefundsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PaymentRefundsModel {
+	return &customPaymentRefundsModel{
+		defaultPaymentRefundsModel: newPaymentRefundsModel(conn, c, opts...),
+	}
+}
+
+func (m *customPaymentRefundsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *PaymentRefunds) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, paymentRefundsRowsExpectAutoSet)
+	ret, err := session.ExecCtx(ctx, query, data.RefundNo, data.RequestNo, data.ParentRequestNo, data.PaymentId, data.PaymentNo, data.OrderId, data.SplitId, data.UserId, data.Amount, data.Currency, data.FxRate, data.PriceAmount, data.Channel, data.Status, data.Reason, data.ChannelRefundNo, data.Attempts, data.LastError, data.FinishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// 幂等查询可能缓存了未找到的占位，插入后清理
+	paymentRefundsPaymentIdRequestNoKey := fmt.Sprintf("%s%v:%v", cachePaymentRefundsPaymentIdRequestNoPrefix, data.PaymentId, data.RequestNo)
+	paymentRefundsRefundNoKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundNoPrefix, data.RefundNo)
+	_ = m.DelCacheCtx(ctx, paymentRefundsPaymentIdRequestNoKey, paymentRefundsRefundNoKey)
+	return ret, nil
+}
+
+func (m *customPaymentRefundsModel) SumAmountWithSession(ctx context.Context, session sqlx.Session, paymentId int64, statuses []string) (int64, error) {
+	if len(statuses) == 0 {
+		return 0, fmt.Errorf("statuses must not be empty")
+	}
+	args := make([]any, 0, len(statuses)+1)
+	args = append(args, paymentId)
+	for _, s := range statuses {
+		args = append(args, s)
+	}
+
+	var total int64
+	query := fmt.Sprintf("select coalesce(sum(`amount`), 0) from %s where `payment_id` = ? and `status` in (%s)", m.table, placeholders(len(statuses)))
+	if err := session.QueryRowCtx(ctx, &total, query, args...); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (m *customPaymentRefundsModel) SumAmountBySplitWithSession(ctx context.Context, session sqlx.Session, paymentId int64, statuses []string) (map[int64]int64, error) {
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("statuses must not be empty")
+	}
+	args := make([]any, 0, len(statuses)+1)
+	args = append(args, paymentId)
+	for _, s := range statuses {
+		args = append(args, s)
+	}
+
+	var rows []struct {
+		SplitId int64 `db:"split_id"`
+		Total   int64 `db:"total"`
+	}
+	query := fmt.Sprintf("select `split_id`, coalesce(sum(`amount`), 0) as total from %s where `payment_id` = ? and `status` in (%s) group by `split_id`", m.table, placeholders(len(statuses)))
+	if err := session.QueryRowsCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	totals := make(map[int64]int64, len(rows))
+	for _, r := range rows {
+		totals[r.SplitId] = r.Total
+	}
+	return totals, nil
+}
+
+func (m *customPaymentRefundsModel) ListByRequest(ctx context.Context, paymentId int64, requestNo string) ([]*PaymentRefunds, error) {
+	var resp []*PaymentRefunds
+	query := fmt.Sprintf("select %s from %s where `payment_id` = ? and (`parent_request_no` = ? or (`parent_request_no` = '' and `request_no` = ?)) order by `refund_id`", paymentRefundsRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, paymentId, requestNo, requestNo); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *customPaymentRefundsModel) SumAmount(ctx context.Context, paymentId int64, statuses []string) (int64, error) {
+	if len(statuses) == 0 {
+		return 0, fmt.Errorf("statuses must not be empty")
+	}
+	args := make([]any, 0, len(statuses)+1)
+	args = append(args, paymentId)
+	for _, s := range statuses {
+		args = append(args, s)
+	}
+
+	var total int64
+	query := fmt.Sprintf("select coalesce(sum(`amount`), 0) from %s where `payment_id` = ? and `status` in (%s)", m.table, placeholders(len(statuses)))
+	if err := m.QueryRowNoCacheCtx(ctx, &total, query, args...); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (m *customPaymentRefundsModel) UpdateProgress(ctx context.Context, refundId int64, fromStatus []string, toStatus, channelRefundNo, lastError string) (bool, error) {
+	if len(fromStatus) == 0 {
+		return false, fmt.Errorf("fromStatus must not be empty")
+	}
+
+	record, err := m.FindOne(ctx, refundId)
+	if err != nil {
+		return false, err
+	}
+
+	paymentRefundsPaymentIdRequestNoKey := fmt.Sprintf("%s%v:%v", cachePaymentRefundsPaymentIdRequestNoPrefix, record.PaymentId, record.RequestNo)
+	paymentRefundsRefundIdKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundIdPrefix, refundId)
+	paymentRefundsRefundNoKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundNoPrefix, record.RefundNo)
+
+	if len(lastError) > 512 {
+		lastError = lastError[:512]
+	}
+	finished := toStatus == RefundStatusSuccess || toStatus == RefundStatusFailed
+
+	args := make([]any, 0, len(fromStatus)+6)
+	args = append(args, toStatus, channelRefundNo, channelRefundNo, lastError, finished)
+	for _, s := range fromStatus {
+		args = append(args, s)
+	}
+	args = append(args, refundId)
+
+	query := fmt.Sprintf("update %s set `status` = ?, `channel_refund_no` = if(? = '', `channel_refund_no`, ?), `last_error` = ?, `attempts` = `attempts` + 1, `finished_at` = if(?, current_timestamp, `finished_at`), `updated_at` = current_timestamp where `status` in (%s) and `refund_id` = ?", m.table, placeholders(len(fromStatus)))
+
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, query, args...)
+	}, paymentRefundsPaymentIdRequestNoKey, paymentRefundsRefundIdKey, paymentRefundsRefundNoKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
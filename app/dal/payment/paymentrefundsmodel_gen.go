@@ -0,0 +1,191 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	paymentRefundsFieldNames          = builder.RawFieldNames(&PaymentRefunds{})
+	paymentRefundsRows                = strings.Join(paymentRefundsFieldNames, ",")
+	paymentRefundsRowsExpectAutoSet   = strings.Join(stringx.Remove(paymentRefundsFieldNames, "`refund_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	paymentRefundsRowsWithPlaceHolder = strings.Join(stringx.Remove(paymentRefundsFieldNames, "`refund_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cachePaymentRefundsRefundIdPrefix           = "cache:paymentRefunds:refundId:"
+	cachePaymentRefundsPaymentIdRequestNoPrefix = "cache:paymentRefunds:paymentId:requestNo:"
+	cachePaymentRefundsRefundNoPrefix           = "cache:paymentRefunds:refundNo:"
+)
+
+type (
+	paymentRefundsModel interface {
+		Insert(ctx context.Context, data *PaymentRefunds) (sql.Result, error)
+		FindOne(ctx context.Context, refundId int64) (*PaymentRefunds, error)
+		FindOneByPaymentIdRequestNo(ctx context.Context, paymentId int64, requestNo string) (*PaymentRefunds, error)
+		FindOneByRefundNo(ctx context.Context, refundNo string) (*PaymentRefunds, error)
+		Update(ctx context.Context, data *PaymentRefunds) error
+		Delete(ctx context.Context, refundId int64) error
+	}
+
+	defaultPaymentRefundsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	PaymentRefunds struct {
+		RefundId        int64        `db:"refund_id"`         // 退款记录ID
+		RefundNo        string       `db:"refund_no"`         // 退款单号，作为渠道侧退款请求号
+		RequestNo       string       `db:"request_no"`        // 业务请求号，同一支付单内幂等
+		ParentRequestNo string       `db:"parent_request_no"` // 所属退款申请的请求号，组合支付拆出的分段退款单与首张相同
+		PaymentId       int64        `db:"payment_id"`        // 支付记录ID
+		PaymentNo       string       `db:"payment_no"`        // 支付单号
+		OrderId         int64        `db:"order_id"`          // 关联订单ID
+		SplitId         int64        `db:"split_id"`          // 退款对应的支付分段ID，非组合支付为 0
+		UserId          int64        `db:"user_id"`           // 用户ID
+		Amount          int64        `db:"amount"`            // 退款金额，单位分
+		Currency        string       `db:"currency"`          // 币种
+		FxRate          int64        `db:"fx_rate"`           // 支付单的汇率快照，退款沿用原汇率
+		PriceAmount     int64        `db:"price_amount"`      // 按原汇率折回标价币种的退款金额
+		Channel         string       `db:"channel"`           // 支付渠道
+		Status          string       `db:"status"`            // 退款状态
+		Reason          string       `db:"reason"`            // 退款原因
+		ChannelRefundNo string       `db:"channel_refund_no"` // 渠道退款单号
+		Attempts        int64        `db:"attempts"`          // 渠道请求次数
+		LastError       string       `db:"last_error"`        // 最近一次失败原因
+		FinishedAt      sql.NullTime `db:"finished_at"`       // 完成时间
+		CreatedAt       time.Time    `db:"created_at"`
+		UpdatedAt       time.Time    `db:"updated_at"`
+	}
+)
+
+func newPaymentRefundsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultPaymentRefundsModel {
+	return &defaultPaymentRefundsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`payment_refunds`",
+	}
+}
+
+func (m *defaultPaymentRefundsModel) Delete(ctx context.Context, refundId int64) error {
+	data, err := m.FindOne(ctx, refundId)
+	if err != nil {
+		return err
+	}
+
+	paymentRefundsPaymentIdRequestNoKey := fmt.Sprintf("%s%v:%v", cachePaymentRefundsPaymentIdRequestNoPrefix, data.PaymentId, data.RequestNo)
+	paymentRefundsRefundIdKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundIdPrefix, refundId)
+	paymentRefundsRefundNoKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundNoPrefix, data.RefundNo)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `refund_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, refundId)
+	}, paymentRefundsPaymentIdRequestNoKey, paymentRefundsRefundIdKey, paymentRefundsRefundNoKey)
+	return err
+}
+
+func (m *defaultPaymentRefundsModel) FindOne(ctx context.Context, refundId int64) (*PaymentRefunds, error) {
+	paymentRefundsRefundIdKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundIdPrefix, refundId)
+	var resp PaymentRefunds
+	err := m.QueryRowCtx(ctx, &resp, paymentRefundsRefundIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `refund_id` = ? limit 1", paymentRefundsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, refundId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPaymentRefundsModel) FindOneByPaymentIdRequestNo(ctx context.Context, paymentId int64, requestNo string) (*PaymentRefunds, error) {
+	paymentRefundsPaymentIdRequestNoKey := fmt.Sprintf("%s%v:%v", cachePaymentRefundsPaymentIdRequestNoPrefix, paymentId, requestNo)
+	var resp PaymentRefunds
+	err := m.QueryRowIndexCtx(ctx, &resp, paymentRefundsPaymentIdRequestNoKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `payment_id` = ? and `request_no` = ? limit 1", paymentRefundsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, paymentId, requestNo); err != nil {
+			return nil, err
+		}
+		return resp.RefundId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPaymentRefundsModel) FindOneByRefundNo(ctx context.Context, refundNo string) (*PaymentRefunds, error) {
+	paymentRefundsRefundNoKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundNoPrefix, refundNo)
+	var resp PaymentRefunds
+	err := m.QueryRowIndexCtx(ctx, &resp, paymentRefundsRefundNoKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `refund_no` = ? limit 1", paymentRefundsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, refundNo); err != nil {
+			return nil, err
+		}
+		return resp.RefundId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPaymentRefundsModel) Insert(ctx context.Context, data *PaymentRefunds) (sql.Result, error) {
+	paymentRefundsPaymentIdRequestNoKey := fmt.Sprintf("%s%v:%v", cachePaymentRefundsPaymentIdRequestNoPrefix, data.PaymentId, data.RequestNo)
+	paymentRefundsRefundIdKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundIdPrefix, data.RefundId)
+	paymentRefundsRefundNoKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundNoPrefix, data.RefundNo)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, paymentRefundsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.RefundNo, data.RequestNo, data.ParentRequestNo, data.PaymentId, data.PaymentNo, data.OrderId, data.SplitId, data.UserId, data.Amount, data.Currency, data.FxRate, data.PriceAmount, data.Channel, data.Status, data.Reason, data.ChannelRefundNo, data.Attempts, data.LastError, data.FinishedAt)
+	}, paymentRefundsPaymentIdRequestNoKey, paymentRefundsRefundIdKey, paymentRefundsRefundNoKey)
+	return ret, err
+}
+
+func (m *defaultPaymentRefundsModel) Update(ctx context.Context, newData *PaymentRefunds) error {
+	data, err := m.FindOne(ctx, newData.RefundId)
+	if err != nil {
+		return err
+	}
+
+	paymentRefundsPaymentIdRequestNoKey := fmt.Sprintf("%s%v:%v", cachePaymentRefundsPaymentIdRequestNoPrefix, data.PaymentId, data.RequestNo)
+	paymentRefundsRefundIdKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundIdPrefix, data.RefundId)
+	paymentRefundsRefundNoKey := fmt.Sprintf("%s%v", cachePaymentRefundsRefundNoPrefix, data.RefundNo)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `refund_id` = ?", m.table, paymentRefundsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.RefundNo, newData.RequestNo, newData.ParentRequestNo, newData.PaymentId, newData.PaymentNo, newData.OrderId, newData.SplitId, newData.UserId, newData.Amount, newData.Currency, newData.FxRate, newData.PriceAmount, newData.Channel, newData.Status, newData.Reason, newData.ChannelRefundNo, newData.Attempts, newData.LastError, newData.FinishedAt, newData.RefundId)
+	}, paymentRefundsPaymentIdRequestNoKey, paymentRefundsRefundIdKey, paymentRefundsRefundNoKey)
+	return err
+}
+
+func (m *defaultPaymentRefundsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cachePaymentRefundsRefundIdPrefix, primary)
+}
+
+func (m *defaultPaymentRefundsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `refund_id` = ? limit 1", paymentRefundsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultPaymentRefundsModel) tableName() string {
+	return m.table
+}
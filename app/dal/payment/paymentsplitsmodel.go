@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PaymentSplitsModel = (*customPaymentSplitsModel)(nil)
+
+type (
+	// PaymentSplitsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPaymentSplitsModel.
+	PaymentSplitsModel interface {
+		paymentSplitsModel
+		// ListByPayment returns splits of a payment ordered by leg_no without cache.
+		ListByPayment(ctx context.Context, paymentId int64) ([]*PaymentSplits, error)
+		// UpdateStatus moves a split from one of fromStatus to toStatus, tradeNo is kept when empty.
+		UpdateStatus(ctx context.Context, split *PaymentSplits, fromStatus []string, toStatus, tradeNo string) (bool, error)
+		// DeleteUnsettled removes PENDING and ROLLED_BACK splits of a payment before re-planning.
+		DeleteUnsettled(ctx context.Context, paymentId int64) error
+	}
+
+	customPaymentSplitsModel struct {
+		*defaultPaymentSplitsModel
+	}
+)
+
+// NewPaymentSplitsModel returns a model for the database table.
+func NewPaymentSplitsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PaymentSplitsModel {
+	return &customPaymentSplitsModel{
+		defaultPaymentSplitsModel: newPaymentSplitsModel(conn, c, opts...),
+	}
+}
+
+func (m *customPaymentSplitsModel) ListByPayment(ctx context.Context, paymentId int64) ([]*PaymentSplits, error) {
+	var resp []*PaymentSplits
+	query := fmt.Sprintf("select %s from %s where `payment_id` = ? order by `leg_no`", paymentSplitsRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, paymentId); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *customPaymentSplitsModel) UpdateStatus(ctx context.Context, split *PaymentSplits, fromStatus []string, toStatus, tradeNo string) (bool, error) {
+	if len(fromStatus) == 0 {
+		return false, fmt.Errorf("fromStatus must not be empty")
+	}
+	paymentSplitsSplitIdKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitIdPrefix, split.SplitId)
+	paymentSplitsSplitNoKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitNoPrefix, split.SplitNo)
+
+	args := make([]any, 0, len(fromStatus)+4)
+	args = append(args, toStatus, tradeNo, tradeNo)
+	for _, s := range fromStatus {
+		args = append(args, s)
+	}
+	args = append(args, split.SplitId)
+
+	query := fmt.Sprintf("update %s set `status` = ?, `trade_no` = if(? = '', `trade_no`, ?), `updated_at` = current_timestamp where `status` in (%s) and `split_id` = ?", m.table, placeholders(len(fromStatus)))
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, query, args...)
+	}, paymentSplitsSplitIdKey, paymentSplitsSplitNoKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (m *customPaymentSplitsModel) DeleteUnsettled(ctx context.Context, paymentId int64) error {
+	splits, err := m.ListByPayment(ctx, paymentId)
+	if err != nil {
+		return err
+	}
+	for _, s := range splits {
+		if s.Status != SplitStatusPending && s.Status != SplitStatusRolledBack {
+			continue
+		}
+		if err := m.Delete(ctx, s.SplitId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
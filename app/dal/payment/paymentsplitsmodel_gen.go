@@ -0,0 +1,155 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	paymentSplitsFieldNames          = builder.RawFieldNames(&PaymentSplits{})
+	paymentSplitsRows                = strings.Join(paymentSplitsFieldNames, ",")
+	paymentSplitsRowsExpectAutoSet   = strings.Join(stringx.Remove(paymentSplitsFieldNames, "`split_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	paymentSplitsRowsWithPlaceHolder = strings.Join(stringx.Remove(paymentSplitsFieldNames, "`split_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cachePaymentSplitsSplitIdPrefix = "cache:paymentSplits:splitId:"
+	cachePaymentSplitsSplitNoPrefix = "cache:paymentSplits:splitNo:"
+)
+
+type (
+	paymentSplitsModel interface {
+		Insert(ctx context.Context, data *PaymentSplits) (sql.Result, error)
+		FindOne(ctx context.Context, splitId int64) (*PaymentSplits, error)
+		FindOneBySplitNo(ctx context.Context, splitNo string) (*PaymentSplits, error)
+		Update(ctx context.Context, data *PaymentSplits) error
+		Delete(ctx context.Context, splitId int64) error
+	}
+
+	defaultPaymentSplitsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	PaymentSplits struct {
+		SplitId   int64     `db:"split_id"`   // 分段ID
+		SplitNo   string    `db:"split_no"`   // 分段单号，作为渠道侧支付单号
+		PaymentId int64     `db:"payment_id"` // 支付记录ID
+		LegNo     int64     `db:"leg_no"`     // 分段顺序，退款按逆序退回
+		Channel   string    `db:"channel"`    // 支付渠道
+		Amount    int64     `db:"amount"`     // 分段金额，单位分
+		Points    int64     `db:"points"`     // 积分分段抵扣的积分数
+		Status    string    `db:"status"`     // 分段状态
+		TradeNo   string    `db:"trade_no"`   // 渠道交易号/记账凭证号
+		CreatedAt time.Time `db:"created_at"`
+		UpdatedAt time.Time `db:"updated_at"`
+	}
+)
+
+func newPaymentSplitsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultPaymentSplitsModel {
+	return &defaultPaymentSplitsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`payment_splits`",
+	}
+}
+
+func (m *defaultPaymentSplitsModel) Delete(ctx context.Context, splitId int64) error {
+	data, err := m.FindOne(ctx, splitId)
+	if err != nil {
+		return err
+	}
+
+	paymentSplitsSplitIdKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitIdPrefix, splitId)
+	paymentSplitsSplitNoKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitNoPrefix, data.SplitNo)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `split_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, splitId)
+	}, paymentSplitsSplitIdKey, paymentSplitsSplitNoKey)
+	return err
+}
+
+func (m *defaultPaymentSplitsModel) FindOne(ctx context.Context, splitId int64) (*PaymentSplits, error) {
+	paymentSplitsSplitIdKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitIdPrefix, splitId)
+	var resp PaymentSplits
+	err := m.QueryRowCtx(ctx, &resp, paymentSplitsSplitIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `split_id` = ? limit 1", paymentSplitsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, splitId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPaymentSplitsModel) FindOneBySplitNo(ctx context.Context, splitNo string) (*PaymentSplits, error) {
+	paymentSplitsSplitNoKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitNoPrefix, splitNo)
+	var resp PaymentSplits
+	err := m.QueryRowIndexCtx(ctx, &resp, paymentSplitsSplitNoKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `split_no` = ? limit 1", paymentSplitsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, splitNo); err != nil {
+			return nil, err
+		}
+		return resp.SplitId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultPaymentSplitsModel) Insert(ctx context.Context, data *PaymentSplits) (sql.Result, error) {
+	paymentSplitsSplitIdKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitIdPrefix, data.SplitId)
+	paymentSplitsSplitNoKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitNoPrefix, data.SplitNo)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?)", m.table, paymentSplitsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.SplitNo, data.PaymentId, data.LegNo, data.Channel, data.Amount, data.Points, data.Status, data.TradeNo)
+	}, paymentSplitsSplitIdKey, paymentSplitsSplitNoKey)
+	return ret, err
+}
+
+func (m *defaultPaymentSplitsModel) Update(ctx context.Context, newData *PaymentSplits) error {
+	data, err := m.FindOne(ctx, newData.SplitId)
+	if err != nil {
+		return err
+	}
+
+	paymentSplitsSplitIdKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitIdPrefix, data.SplitId)
+	paymentSplitsSplitNoKey := fmt.Sprintf("%s%v", cachePaymentSplitsSplitNoPrefix, data.SplitNo)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `split_id` = ?", m.table, paymentSplitsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.SplitNo, newData.PaymentId, newData.LegNo, newData.Channel, newData.Amount, newData.Points, newData.Status, newData.TradeNo, newData.SplitId)
+	}, paymentSplitsSplitIdKey, paymentSplitsSplitNoKey)
+	return err
+}
+
+func (m *defaultPaymentSplitsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cachePaymentSplitsSplitIdPrefix, primary)
+}
+
+func (m *defaultPaymentSplitsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `split_id` = ? limit 1", paymentSplitsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultPaymentSplitsModel) tableName() string {
+	return m.table
+}
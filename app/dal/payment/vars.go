@@ -3,3 +3,73 @@ package payment
 import "github.com/zeromicro/go-zero/core/stores/sqlx"
 
 var ErrNotFound = sqlx.ErrNotFound
+
+// 支付通知处理结果
+const (
+	NotifyResultReceived     = "RECEIVED"
+	NotifyResultInvalidSign  = "INVALID_SIGN"
+	NotifyResultUnknownTrade = "UNKNOWN_TRADE"
+	NotifyResultMismatch     = "MISMATCH"
+	NotifyResultIgnored      = "IGNORED"
+	NotifyResultConfirmed    = "CONFIRMED"
+	NotifyResultClosed       = "CLOSED"
+	NotifyResultFailed       = "FAILED"
+)
+
+// 退款状态
+const (
+	RefundStatusPending    = "PENDING"
+	RefundStatusProcessing = "PROCESSING"
+	RefundStatusSuccess    = "SUCCESS"
+	RefundStatusFailed     = "FAILED"
+)
+
+// 对账报告状态与差异类型
+const (
+	ReconcileStatusRunning = "RUNNING"
+	ReconcileStatusDone    = "DONE"
+	ReconcileStatusFailed  = "FAILED"
+
+	ReconcileKindMissing        = "MISSING"
+	ReconcileKindExtra          = "EXTRA"
+	ReconcileKindAmountMismatch = "AMOUNT_MISMATCH"
+	ReconcileKindStatusMismatch = "STATUS_MISMATCH"
+)
+
+// 支付单业务类型
+const (
+	PaymentBizOrder = "ORDER"
+	PaymentBizTopup = "TOPUP"
+)
+
+// 组合支付分段状态
+const (
+	SplitStatusPending    = "PENDING"
+	SplitStatusHeld       = "HELD"
+	SplitStatusCommitted  = "COMMITTED"
+	SplitStatusRolledBack = "ROLLED_BACK"
+)
+
+// 钱包账户科目、余额方向与记账业务类型
+const (
+	WalletSubjectUserBalance     = "USER_BALANCE"
+	WalletSubjectChannelClearing = "CHANNEL_CLEARING"
+	WalletSubjectOrderSettlement = "ORDER_SETTLEMENT"
+	WalletSubjectUserPoints      = "USER_POINTS"
+	WalletSubjectPointsIssued    = "POINTS_ISSUED"
+	WalletSubjectPointsRedeemed  = "POINTS_REDEEMED"
+
+	// WalletCurrencyPoint 积分账户的币种，金额单位为积分
+	WalletCurrencyPoint = "POINT"
+
+	WalletSideDebit  = "DEBIT"
+	WalletSideCredit = "CREDIT"
+
+	WalletAccountActive = "ACTIVE"
+	WalletAccountFrozen = "FROZEN"
+
+	WalletBizTopup   = "TOPUP"
+	WalletBizPayment = "PAYMENT"
+	WalletBizRefund  = "REFUND"
+	WalletBizGrant   = "GRANT"
+)
@@ -0,0 +1,104 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WalletAccountsModel = (*customWalletAccountsModel)(nil)
+
+type (
+	// WalletAccountsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWalletAccountsModel.
+	WalletAccountsModel interface {
+		walletAccountsModel
+		// EnsureAccount creates the account if absent, existing accounts are left untouched.
+		EnsureAccount(ctx context.Context, userId int64, subject, currency, normalSide string) error
+		// FindOneForUpdateWithSession locks the account row within given session.
+		FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, userId int64, subject, currency string) (*WalletAccounts, error)
+		// UpdateBalanceWithSession writes the balance of a locked account within given session.
+		UpdateBalanceWithSession(ctx context.Context, session sqlx.Session, data *WalletAccounts) error
+		// ListByUser returns all accounts of a user without cache.
+		ListByUser(ctx context.Context, userId int64) ([]*WalletAccounts, error)
+		// SyncPlatformBalances copies the snapshot balance of the date onto platform accounts, whose balances are not maintained on posting.
+		SyncPlatformBalances(ctx context.Context, date time.Time) error
+	}
+
+	customWalletAccountsModel struct {
+		*defaultWalletAccountsModel
+	}
+)
+
+// NewWalletAccountsModel returns a model for the database table.
+func NewWalletAccountsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) WalletAccountsModel {
+	return &customWalletAccountsModel{
+		defaultWalletAccountsModel: newWalletAccountsModel(conn, c, opts...),
+	}
+}
+
+func (m *customWalletAccountsModel) EnsureAccount(ctx context.Context, userId int64, subject, currency, normalSide string) error {
+	query := fmt.Sprintf("insert ignore into %s (`user_id`, `subject`, `currency`, `normal_side`, `balance`, `status`) values (?, ?, ?, ?, 0, ?)", m.table)
+	if _, err := m.ExecNoCacheCtx(ctx, query, userId, subject, currency, normalSide, WalletAccountActive); err != nil {
+		return err
+	}
+	// 查询可能缓存了未找到的占位，建户后清理
+	walletAccountsUserIdSubjectCurrencyKey := fmt.Sprintf("%s%v:%v:%v", cacheWalletAccountsUserIdSubjectCurrencyPrefix, userId, subject, currency)
+	_ = m.DelCacheCtx(ctx, walletAccountsUserIdSubjectCurrencyKey)
+	return nil
+}
+
+func (m *customWalletAccountsModel) FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, userId int64, subject, currency string) (*WalletAccounts, error) {
+	var resp WalletAccounts
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `subject` = ? and `currency` = ? limit 1 for update", walletAccountsRows, m.table)
+	if err := session.QueryRowCtx(ctx, &resp, query, userId, subject, currency); err != nil {
+		if err == sqlx.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (m *customWalletAccountsModel) UpdateBalanceWithSession(ctx context.Context, session sqlx.Session, data *WalletAccounts) error {
+	query := fmt.Sprintf("update %s set `balance` = ?, `updated_at` = current_timestamp where `account_id` = ?", m.table)
+	if _, err := session.ExecCtx(ctx, query, data.Balance, data.AccountId); err != nil {
+		return err
+	}
+	walletAccountsAccountIdKey := fmt.Sprintf("%s%v", cacheWalletAccountsAccountIdPrefix, data.AccountId)
+	walletAccountsUserIdSubjectCurrencyKey := fmt.Sprintf("%s%v:%v:%v", cacheWalletAccountsUserIdSubjectCurrencyPrefix, data.UserId, data.Subject, data.Currency)
+	_ = m.DelCacheCtx(ctx, walletAccountsAccountIdKey, walletAccountsUserIdSubjectCurrencyKey)
+	return nil
+}
+
+func (m *customWalletAccountsModel) ListByUser(ctx context.Context, userId int64) ([]*WalletAccounts, error) {
+	var resp []*WalletAccounts
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? order by `account_id`", walletAccountsRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *customWalletAccountsModel) SyncPlatformBalances(ctx context.Context, date time.Time) error {
+	accounts, err := m.ListByUser(ctx, 0)
+	if err != nil || len(accounts) == 0 {
+		return err
+	}
+	query := fmt.Sprintf("update %s a join `wallet_balance_snapshots` s on s.`account_id` = a.`account_id` and s.`snapshot_date` = ? "+
+		"set a.`balance` = s.`balance` where a.`user_id` = 0", m.table)
+	if _, err := m.ExecNoCacheCtx(ctx, query, date.Format(time.DateOnly)); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(accounts)*2)
+	for _, acc := range accounts {
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheWalletAccountsAccountIdPrefix, acc.AccountId),
+			fmt.Sprintf("%s%v:%v:%v", cacheWalletAccountsUserIdSubjectCurrencyPrefix, acc.UserId, acc.Subject, acc.Currency),
+		)
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
@@ -0,0 +1,153 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	walletAccountsFieldNames          = builder.RawFieldNames(&WalletAccounts{})
+	walletAccountsRows                = strings.Join(walletAccountsFieldNames, ",")
+	walletAccountsRowsExpectAutoSet   = strings.Join(stringx.Remove(walletAccountsFieldNames, "`account_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	walletAccountsRowsWithPlaceHolder = strings.Join(stringx.Remove(walletAccountsFieldNames, "`account_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheWalletAccountsAccountIdPrefix             = "cache:walletAccounts:accountId:"
+	cacheWalletAccountsUserIdSubjectCurrencyPrefix = "cache:walletAccounts:userId:subject:currency:"
+)
+
+type (
+	walletAccountsModel interface {
+		Insert(ctx context.Context, data *WalletAccounts) (sql.Result, error)
+		FindOne(ctx context.Context, accountId int64) (*WalletAccounts, error)
+		FindOneByUserIdSubjectCurrency(ctx context.Context, userId int64, subject string, currency string) (*WalletAccounts, error)
+		Update(ctx context.Context, data *WalletAccounts) error
+		Delete(ctx context.Context, accountId int64) error
+	}
+
+	defaultWalletAccountsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	WalletAccounts struct {
+		AccountId  int64     `db:"account_id"`  // 账户ID
+		UserId     int64     `db:"user_id"`     // 用户ID，平台科目为 0
+		Subject    string    `db:"subject"`     // 科目：USER_BALANCE/CHANNEL_CLEARING/ORDER_SETTLEMENT/USER_POINTS/POINTS_ISSUED/POINTS_REDEEMED
+		Currency   string    `db:"currency"`    // 币种，积分账户为 POINT
+		NormalSide string    `db:"normal_side"` // 余额方向
+		Balance    int64     `db:"balance"`     // 余额，单位分
+		Status     string    `db:"status"`      // 账户状态
+		CreatedAt  time.Time `db:"created_at"`
+		UpdatedAt  time.Time `db:"updated_at"`
+	}
+)
+
+func newWalletAccountsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultWalletAccountsModel {
+	return &defaultWalletAccountsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`wallet_accounts`",
+	}
+}
+
+func (m *defaultWalletAccountsModel) Delete(ctx context.Context, accountId int64) error {
+	data, err := m.FindOne(ctx, accountId)
+	if err != nil {
+		return err
+	}
+
+	walletAccountsAccountIdKey := fmt.Sprintf("%s%v", cacheWalletAccountsAccountIdPrefix, accountId)
+	walletAccountsUserIdSubjectCurrencyKey := fmt.Sprintf("%s%v:%v:%v", cacheWalletAccountsUserIdSubjectCurrencyPrefix, data.UserId, data.Subject, data.Currency)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `account_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, accountId)
+	}, walletAccountsAccountIdKey, walletAccountsUserIdSubjectCurrencyKey)
+	return err
+}
+
+func (m *defaultWalletAccountsModel) FindOne(ctx context.Context, accountId int64) (*WalletAccounts, error) {
+	walletAccountsAccountIdKey := fmt.Sprintf("%s%v", cacheWalletAccountsAccountIdPrefix, accountId)
+	var resp WalletAccounts
+	err := m.QueryRowCtx(ctx, &resp, walletAccountsAccountIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `account_id` = ? limit 1", walletAccountsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, accountId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultWalletAccountsModel) FindOneByUserIdSubjectCurrency(ctx context.Context, userId int64, subject string, currency string) (*WalletAccounts, error) {
+	walletAccountsUserIdSubjectCurrencyKey := fmt.Sprintf("%s%v:%v:%v", cacheWalletAccountsUserIdSubjectCurrencyPrefix, userId, subject, currency)
+	var resp WalletAccounts
+	err := m.QueryRowIndexCtx(ctx, &resp, walletAccountsUserIdSubjectCurrencyKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `user_id` = ? and `subject` = ? and `currency` = ? limit 1", walletAccountsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, userId, subject, currency); err != nil {
+			return nil, err
+		}
+		return resp.AccountId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultWalletAccountsModel) Insert(ctx context.Context, data *WalletAccounts) (sql.Result, error) {
+	walletAccountsAccountIdKey := fmt.Sprintf("%s%v", cacheWalletAccountsAccountIdPrefix, data.AccountId)
+	walletAccountsUserIdSubjectCurrencyKey := fmt.Sprintf("%s%v:%v:%v", cacheWalletAccountsUserIdSubjectCurrencyPrefix, data.UserId, data.Subject, data.Currency)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, walletAccountsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.UserId, data.Subject, data.Currency, data.NormalSide, data.Balance, data.Status)
+	}, walletAccountsAccountIdKey, walletAccountsUserIdSubjectCurrencyKey)
+	return ret, err
+}
+
+func (m *defaultWalletAccountsModel) Update(ctx context.Context, newData *WalletAccounts) error {
+	data, err := m.FindOne(ctx, newData.AccountId)
+	if err != nil {
+		return err
+	}
+
+	walletAccountsAccountIdKey := fmt.Sprintf("%s%v", cacheWalletAccountsAccountIdPrefix, data.AccountId)
+	walletAccountsUserIdSubjectCurrencyKey := fmt.Sprintf("%s%v:%v:%v", cacheWalletAccountsUserIdSubjectCurrencyPrefix, data.UserId, data.Subject, data.Currency)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `account_id` = ?", m.table, walletAccountsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.UserId, newData.Subject, newData.Currency, newData.NormalSide, newData.Balance, newData.Status, newData.AccountId)
+	}, walletAccountsAccountIdKey, walletAccountsUserIdSubjectCurrencyKey)
+	return err
+}
+
+func (m *defaultWalletAccountsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheWalletAccountsAccountIdPrefix, primary)
+}
+
+func (m *defaultWalletAccountsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `account_id` = ? limit 1", walletAccountsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultWalletAccountsModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,88 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WalletBalanceSnapshotsModel = (*customWalletBalanceSnapshotsModel)(nil)
+
+type (
+	// WalletBalanceSnapshotsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWalletBalanceSnapshotsModel.
+	WalletBalanceSnapshotsModel interface {
+		walletBalanceSnapshotsModel
+		// SnapshotDay records the end-of-day balance and turnover of every account for the day, idempotent per account and date.
+		SnapshotDay(ctx context.Context, day time.Time) (int64, error)
+		// SumTurnover sums debit and credit turnover of all accounts on the snapshot date.
+		SumTurnover(ctx context.Context, day time.Time) (debit int64, credit int64, err error)
+	}
+
+	customWalletBalanceSnapshotsModel struct {
+		*defaultWalletBalanceSnapshotsModel
+	}
+)
+
+// NewWalletBalanceSnapshotsModel returns a model for the database table.
+func NewWalletBalanceSnapshotsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) WalletBalanceSnapshotsModel {
+	return &customWalletBalanceSnapshotsModel{
+		defaultWalletBalanceSnapshotsModel: newWalletBalanceSnapshotsModel(conn, c, opts...),
+	}
+}
+
+func (m *customWalletBalanceSnapshotsModel) SnapshotDay(ctx context.Context, day time.Time) (int64, error) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+	date := start.Format(time.DateOnly)
+	turnover := "coalesce((select sum(l.`amount`) from `wallet_ledger_lines` l where l.`account_id` = a.`account_id` and l.`direction` = 'DEBIT' and l.`created_at` >= ? and l.`created_at` < ?), 0), " +
+		"coalesce((select sum(l.`amount`) from `wallet_ledger_lines` l where l.`account_id` = a.`account_id` and l.`direction` = 'CREDIT' and l.`created_at` >= ? and l.`created_at` < ?), 0) "
+
+	// 用户账户：日终余额取当日结束前最后一条分录的记账后余额，避免快照任务执行时的新分录影响结果
+	userQuery := fmt.Sprintf("insert ignore into %s (`account_id`, `snapshot_date`, `balance`, `debit_total`, `credit_total`) "+
+		"select a.`account_id`, ?, "+
+		"coalesce((select l.`balance_after` from `wallet_ledger_lines` l where l.`account_id` = a.`account_id` and l.`created_at` < ? order by l.`line_id` desc limit 1), 0), "+
+		turnover+
+		"from `wallet_accounts` a where a.`user_id` > 0 and a.`created_at` < ?", m.table)
+	userRes, err := m.ExecNoCacheCtx(ctx, userQuery, date, end, start, end, start, end, end)
+	if err != nil {
+		return 0, err
+	}
+
+	// 平台科目分录不带记账后余额：前一日快照余额加当日净发生额，无前一日快照时汇总历史分录
+	signed := "sum(if(l.`direction` = a.`normal_side`, l.`amount`, -l.`amount`))"
+	platformQuery := fmt.Sprintf("insert ignore into %s (`account_id`, `snapshot_date`, `balance`, `debit_total`, `credit_total`) "+
+		"select a.`account_id`, ?, "+
+		"coalesce((select s.`balance` from %s s where s.`account_id` = a.`account_id` and s.`snapshot_date` = ?), "+
+		"(select coalesce("+signed+", 0) from `wallet_ledger_lines` l where l.`account_id` = a.`account_id` and l.`created_at` < ?)) + "+
+		"coalesce((select "+signed+" from `wallet_ledger_lines` l where l.`account_id` = a.`account_id` and l.`created_at` >= ? and l.`created_at` < ?), 0), "+
+		turnover+
+		"from `wallet_accounts` a where a.`user_id` = 0 and a.`created_at` < ?", m.table, m.table)
+	prev := start.AddDate(0, 0, -1).Format(time.DateOnly)
+	platformRes, err := m.ExecNoCacheCtx(ctx, platformQuery, date, prev, start, start, end, start, end, start, end, end)
+	if err != nil {
+		return 0, err
+	}
+
+	userRows, err := userRes.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	platformRows, err := platformRes.RowsAffected()
+	return userRows + platformRows, err
+}
+
+func (m *customWalletBalanceSnapshotsModel) SumTurnover(ctx context.Context, day time.Time) (int64, int64, error) {
+	var resp struct {
+		Debit  int64 `db:"debit"`
+		Credit int64 `db:"credit"`
+	}
+	query := fmt.Sprintf("select coalesce(sum(`debit_total`), 0) as debit, coalesce(sum(`credit_total`), 0) as credit from %s where `snapshot_date` = ?", m.table)
+	if err := m.QueryRowNoCacheCtx(ctx, &resp, query, day.Format(time.DateOnly)); err != nil {
+		return 0, 0, err
+	}
+	return resp.Debit, resp.Credit, nil
+}
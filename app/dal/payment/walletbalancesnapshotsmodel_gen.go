@@ -0,0 +1,151 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	walletBalanceSnapshotsFieldNames          = builder.RawFieldNames(&WalletBalanceSnapshots{})
+	walletBalanceSnapshotsRows                = strings.Join(walletBalanceSnapshotsFieldNames, ",")
+	walletBalanceSnapshotsRowsExpectAutoSet   = strings.Join(stringx.Remove(walletBalanceSnapshotsFieldNames, "`snapshot_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	walletBalanceSnapshotsRowsWithPlaceHolder = strings.Join(stringx.Remove(walletBalanceSnapshotsFieldNames, "`snapshot_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheWalletBalanceSnapshotsSnapshotIdPrefix            = "cache:walletBalanceSnapshots:snapshotId:"
+	cacheWalletBalanceSnapshotsAccountIdSnapshotDatePrefix = "cache:walletBalanceSnapshots:accountId:snapshotDate:"
+)
+
+type (
+	walletBalanceSnapshotsModel interface {
+		Insert(ctx context.Context, data *WalletBalanceSnapshots) (sql.Result, error)
+		FindOne(ctx context.Context, snapshotId int64) (*WalletBalanceSnapshots, error)
+		FindOneByAccountIdSnapshotDate(ctx context.Context, accountId int64, snapshotDate time.Time) (*WalletBalanceSnapshots, error)
+		Update(ctx context.Context, data *WalletBalanceSnapshots) error
+		Delete(ctx context.Context, snapshotId int64) error
+	}
+
+	defaultWalletBalanceSnapshotsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	WalletBalanceSnapshots struct {
+		SnapshotId   int64     `db:"snapshot_id"`   // 快照ID
+		AccountId    int64     `db:"account_id"`    // 账户ID
+		SnapshotDate time.Time `db:"snapshot_date"` // 快照日期
+		Balance      int64     `db:"balance"`       // 日终余额，单位分
+		DebitTotal   int64     `db:"debit_total"`   // 当日借方发生额
+		CreditTotal  int64     `db:"credit_total"`  // 当日贷方发生额
+		CreatedAt    time.Time `db:"created_at"`
+	}
+)
+
+func newWalletBalanceSnapshotsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultWalletBalanceSnapshotsModel {
+	return &defaultWalletBalanceSnapshotsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`wallet_balance_snapshots`",
+	}
+}
+
+func (m *defaultWalletBalanceSnapshotsModel) Delete(ctx context.Context, snapshotId int64) error {
+	data, err := m.FindOne(ctx, snapshotId)
+	if err != nil {
+		return err
+	}
+
+	walletBalanceSnapshotsAccountIdSnapshotDateKey := fmt.Sprintf("%s%v:%v", cacheWalletBalanceSnapshotsAccountIdSnapshotDatePrefix, data.AccountId, data.SnapshotDate)
+	walletBalanceSnapshotsSnapshotIdKey := fmt.Sprintf("%s%v", cacheWalletBalanceSnapshotsSnapshotIdPrefix, snapshotId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `snapshot_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, snapshotId)
+	}, walletBalanceSnapshotsAccountIdSnapshotDateKey, walletBalanceSnapshotsSnapshotIdKey)
+	return err
+}
+
+func (m *defaultWalletBalanceSnapshotsModel) FindOne(ctx context.Context, snapshotId int64) (*WalletBalanceSnapshots, error) {
+	walletBalanceSnapshotsSnapshotIdKey := fmt.Sprintf("%s%v", cacheWalletBalanceSnapshotsSnapshotIdPrefix, snapshotId)
+	var resp WalletBalanceSnapshots
+	err := m.QueryRowCtx(ctx, &resp, walletBalanceSnapshotsSnapshotIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `snapshot_id` = ? limit 1", walletBalanceSnapshotsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, snapshotId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultWalletBalanceSnapshotsModel) FindOneByAccountIdSnapshotDate(ctx context.Context, accountId int64, snapshotDate time.Time) (*WalletBalanceSnapshots, error) {
+	walletBalanceSnapshotsAccountIdSnapshotDateKey := fmt.Sprintf("%s%v:%v", cacheWalletBalanceSnapshotsAccountIdSnapshotDatePrefix, accountId, snapshotDate)
+	var resp WalletBalanceSnapshots
+	err := m.QueryRowIndexCtx(ctx, &resp, walletBalanceSnapshotsAccountIdSnapshotDateKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `account_id` = ? and `snapshot_date` = ? limit 1", walletBalanceSnapshotsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, accountId, snapshotDate); err != nil {
+			return nil, err
+		}
+		return resp.SnapshotId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultWalletBalanceSnapshotsModel) Insert(ctx context.Context, data *WalletBalanceSnapshots) (sql.Result, error) {
+	walletBalanceSnapshotsAccountIdSnapshotDateKey := fmt.Sprintf("%s%v:%v", cacheWalletBalanceSnapshotsAccountIdSnapshotDatePrefix, data.AccountId, data.SnapshotDate)
+	walletBalanceSnapshotsSnapshotIdKey := fmt.Sprintf("%s%v", cacheWalletBalanceSnapshotsSnapshotIdPrefix, data.SnapshotId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, walletBalanceSnapshotsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.AccountId, data.SnapshotDate, data.Balance, data.DebitTotal, data.CreditTotal)
+	}, walletBalanceSnapshotsAccountIdSnapshotDateKey, walletBalanceSnapshotsSnapshotIdKey)
+	return ret, err
+}
+
+func (m *defaultWalletBalanceSnapshotsModel) Update(ctx context.Context, newData *WalletBalanceSnapshots) error {
+	data, err := m.FindOne(ctx, newData.SnapshotId)
+	if err != nil {
+		return err
+	}
+
+	walletBalanceSnapshotsAccountIdSnapshotDateKey := fmt.Sprintf("%s%v:%v", cacheWalletBalanceSnapshotsAccountIdSnapshotDatePrefix, data.AccountId, data.SnapshotDate)
+	walletBalanceSnapshotsSnapshotIdKey := fmt.Sprintf("%s%v", cacheWalletBalanceSnapshotsSnapshotIdPrefix, data.SnapshotId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `snapshot_id` = ?", m.table, walletBalanceSnapshotsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.AccountId, newData.SnapshotDate, newData.Balance, newData.DebitTotal, newData.CreditTotal, newData.SnapshotId)
+	}, walletBalanceSnapshotsAccountIdSnapshotDateKey, walletBalanceSnapshotsSnapshotIdKey)
+	return err
+}
+
+func (m *defaultWalletBalanceSnapshotsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheWalletBalanceSnapshotsSnapshotIdPrefix, primary)
+}
+
+func (m *defaultWalletBalanceSnapshotsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `snapshot_id` = ? limit 1", walletBalanceSnapshotsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultWalletBalanceSnapshotsModel) tableName() string {
+	return m.table
+}
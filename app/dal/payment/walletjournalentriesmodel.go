@@ -0,0 +1,66 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WalletJournalEntriesModel = (*customWalletJournalEntriesModel)(nil)
+
+type (
+	// WalletJournalEntriesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWalletJournalEntriesModel.
+	WalletJournalEntriesModel interface {
+		walletJournalEntriesModel
+		// InsertWithSession inserts a journal entry within given session.
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *WalletJournalEntries) (sql.Result, error)
+		// ListByIds returns entries by ids.
+		ListByIds(ctx context.Context, entryIds []int64) ([]*WalletJournalEntries, error)
+	}
+
+	customWalletJournalEntriesModel struct {
+		*defaultWalletJournalEntriesModel
+	}
+)
+
+// NewWalletJournalEntriesModel returns a model for the database table.
+func NewWalletJournalEntriesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) WalletJournalEntriesModel {
+	return &customWalletJournalEntriesModel{
+		defaultWalletJournalEntriesModel: newWalletJournalEntriesModel(conn, c, opts...),
+	}
+}
+
+func (m *customWalletJournalEntriesModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *WalletJournalEntries) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?)", m.table, walletJournalEntriesRowsExpectAutoSet)
+	ret, err := session.ExecCtx(ctx, query, data.EntryNo, data.BizType, data.BizNo, data.UserId, data.Amount, data.Currency, data.Remark)
+	if err != nil {
+		return nil, err
+	}
+
+	// 幂等查询可能缓存了未找到的占位，插入后清理
+	walletJournalEntriesBizTypeBizNoKey := fmt.Sprintf("%s%v:%v", cacheWalletJournalEntriesBizTypeBizNoPrefix, data.BizType, data.BizNo)
+	walletJournalEntriesEntryNoKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryNoPrefix, data.EntryNo)
+	_ = m.DelCacheCtx(ctx, walletJournalEntriesBizTypeBizNoKey, walletJournalEntriesEntryNoKey)
+	return ret, nil
+}
+
+func (m *customWalletJournalEntriesModel) ListByIds(ctx context.Context, entryIds []int64) ([]*WalletJournalEntries, error) {
+	if len(entryIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(entryIds))
+	for _, id := range entryIds {
+		args = append(args, id)
+	}
+
+	var resp []*WalletJournalEntries
+	query := fmt.Sprintf("select %s from %s where `entry_id` in (%s)", walletJournalEntriesRows, m.table, placeholders(len(entryIds)))
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,178 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	walletJournalEntriesFieldNames          = builder.RawFieldNames(&WalletJournalEntries{})
+	walletJournalEntriesRows                = strings.Join(walletJournalEntriesFieldNames, ",")
+	walletJournalEntriesRowsExpectAutoSet   = strings.Join(stringx.Remove(walletJournalEntriesFieldNames, "`entry_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	walletJournalEntriesRowsWithPlaceHolder = strings.Join(stringx.Remove(walletJournalEntriesFieldNames, "`entry_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheWalletJournalEntriesEntryIdPrefix      = "cache:walletJournalEntries:entryId:"
+	cacheWalletJournalEntriesBizTypeBizNoPrefix = "cache:walletJournalEntries:bizType:bizNo:"
+	cacheWalletJournalEntriesEntryNoPrefix      = "cache:walletJournalEntries:entryNo:"
+)
+
+type (
+	walletJournalEntriesModel interface {
+		Insert(ctx context.Context, data *WalletJournalEntries) (sql.Result, error)
+		FindOne(ctx context.Context, entryId int64) (*WalletJournalEntries, error)
+		FindOneByBizTypeBizNo(ctx context.Context, bizType string, bizNo string) (*WalletJournalEntries, error)
+		FindOneByEntryNo(ctx context.Context, entryNo string) (*WalletJournalEntries, error)
+		Update(ctx context.Context, data *WalletJournalEntries) error
+		Delete(ctx context.Context, entryId int64) error
+	}
+
+	defaultWalletJournalEntriesModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	WalletJournalEntries struct {
+		EntryId   int64     `db:"entry_id"` // 凭证ID
+		EntryNo   string    `db:"entry_no"` // 凭证号
+		BizType   string    `db:"biz_type"` // 业务类型
+		BizNo     string    `db:"biz_no"`   // 业务单号：支付单号/退款单号
+		UserId    int64     `db:"user_id"`  // 用户ID
+		Amount    int64     `db:"amount"`   // 金额，单位分
+		Currency  string    `db:"currency"` // 币种
+		Remark    string    `db:"remark"`   // 摘要
+		CreatedAt time.Time `db:"created_at"`
+	}
+)
+
+func newWalletJournalEntriesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultWalletJournalEntriesModel {
+	return &defaultWalletJournalEntriesModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`wallet_journal_entries`",
+	}
+}
+
+func (m *defaultWalletJournalEntriesModel) Delete(ctx context.Context, entryId int64) error {
+	data, err := m.FindOne(ctx, entryId)
+	if err != nil {
+		return err
+	}
+
+	walletJournalEntriesBizTypeBizNoKey := fmt.Sprintf("%s%v:%v", cacheWalletJournalEntriesBizTypeBizNoPrefix, data.BizType, data.BizNo)
+	walletJournalEntriesEntryIdKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryIdPrefix, entryId)
+	walletJournalEntriesEntryNoKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryNoPrefix, data.EntryNo)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `entry_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, entryId)
+	}, walletJournalEntriesBizTypeBizNoKey, walletJournalEntriesEntryIdKey, walletJournalEntriesEntryNoKey)
+	return err
+}
+
+func (m *defaultWalletJournalEntriesModel) FindOne(ctx context.Context, entryId int64) (*WalletJournalEntries, error) {
+	walletJournalEntriesEntryIdKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryIdPrefix, entryId)
+	var resp WalletJournalEntries
+	err := m.QueryRowCtx(ctx, &resp, walletJournalEntriesEntryIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `entry_id` = ? limit 1", walletJournalEntriesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, entryId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultWalletJournalEntriesModel) FindOneByBizTypeBizNo(ctx context.Context, bizType string, bizNo string) (*WalletJournalEntries, error) {
+	walletJournalEntriesBizTypeBizNoKey := fmt.Sprintf("%s%v:%v", cacheWalletJournalEntriesBizTypeBizNoPrefix, bizType, bizNo)
+	var resp WalletJournalEntries
+	err := m.QueryRowIndexCtx(ctx, &resp, walletJournalEntriesBizTypeBizNoKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `biz_type` = ? and `biz_no` = ? limit 1", walletJournalEntriesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, bizType, bizNo); err != nil {
+			return nil, err
+		}
+		return resp.EntryId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultWalletJournalEntriesModel) FindOneByEntryNo(ctx context.Context, entryNo string) (*WalletJournalEntries, error) {
+	walletJournalEntriesEntryNoKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryNoPrefix, entryNo)
+	var resp WalletJournalEntries
+	err := m.QueryRowIndexCtx(ctx, &resp, walletJournalEntriesEntryNoKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `entry_no` = ? limit 1", walletJournalEntriesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, entryNo); err != nil {
+			return nil, err
+		}
+		return resp.EntryId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultWalletJournalEntriesModel) Insert(ctx context.Context, data *WalletJournalEntries) (sql.Result, error) {
+	walletJournalEntriesBizTypeBizNoKey := fmt.Sprintf("%s%v:%v", cacheWalletJournalEntriesBizTypeBizNoPrefix, data.BizType, data.BizNo)
+	walletJournalEntriesEntryIdKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryIdPrefix, data.EntryId)
+	walletJournalEntriesEntryNoKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryNoPrefix, data.EntryNo)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?)", m.table, walletJournalEntriesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.EntryNo, data.BizType, data.BizNo, data.UserId, data.Amount, data.Currency, data.Remark)
+	}, walletJournalEntriesBizTypeBizNoKey, walletJournalEntriesEntryIdKey, walletJournalEntriesEntryNoKey)
+	return ret, err
+}
+
+func (m *defaultWalletJournalEntriesModel) Update(ctx context.Context, newData *WalletJournalEntries) error {
+	data, err := m.FindOne(ctx, newData.EntryId)
+	if err != nil {
+		return err
+	}
+
+	walletJournalEntriesBizTypeBizNoKey := fmt.Sprintf("%s%v:%v", cacheWalletJournalEntriesBizTypeBizNoPrefix, data.BizType, data.BizNo)
+	walletJournalEntriesEntryIdKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryIdPrefix, data.EntryId)
+	walletJournalEntriesEntryNoKey := fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryNoPrefix, data.EntryNo)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `entry_id` = ?", m.table, walletJournalEntriesRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.EntryNo, newData.BizType, newData.BizNo, newData.UserId, newData.Amount, newData.Currency, newData.Remark, newData.EntryId)
+	}, walletJournalEntriesBizTypeBizNoKey, walletJournalEntriesEntryIdKey, walletJournalEntriesEntryNoKey)
+	return err
+}
+
+func (m *defaultWalletJournalEntriesModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheWalletJournalEntriesEntryIdPrefix, primary)
+}
+
+func (m *defaultWalletJournalEntriesModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `entry_id` = ? limit 1", walletJournalEntriesRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultWalletJournalEntriesModel) tableName() string {
+	return m.table
+}
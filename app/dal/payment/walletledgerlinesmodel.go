@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WalletLedgerLinesModel = (*customWalletLedgerLinesModel)(nil)
+
+type (
+	// WalletLedgerLinesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWalletLedgerLinesModel.
+	WalletLedgerLinesModel interface {
+		walletLedgerLinesModel
+		// InsertWithSession inserts a ledger line within given session.
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *WalletLedgerLines) (sql.Result, error)
+		// ListByAccount returns paginated lines of an account ordered by line_id desc.
+		ListByAccount(ctx context.Context, accountId int64, offset, limit int64) ([]*WalletLedgerLines, error)
+		// CountByAccount returns total lines of an account.
+		CountByAccount(ctx context.Context, accountId int64) (int64, error)
+	}
+
+	customWalletLedgerLinesModel struct {
+		*defaultWalletLedgerLinesModel
+	}
+)
+
+// NewWalletLedgerLinesModel returns a model for the database table.
+func NewWalletLedgerLinesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) WalletLedgerLinesModel {
+	return &customWalletLedgerLinesModel{
+		defaultWalletLedgerLinesModel: newWalletLedgerLinesModel(conn, c, opts...),
+	}
+}
+
+func (m *customWalletLedgerLinesModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *WalletLedgerLines) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, walletLedgerLinesRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.EntryId, data.AccountId, data.Direction, data.Amount, data.BalanceAfter)
+}
+
+func (m *customWalletLedgerLinesModel) ListByAccount(ctx context.Context, accountId int64, offset, limit int64) ([]*WalletLedgerLines, error) {
+	var resp []*WalletLedgerLines
+	query := fmt.Sprintf("select %s from %s where `account_id` = ? order by `line_id` desc limit ?, ?", walletLedgerLinesRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, accountId, offset, limit); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *customWalletLedgerLinesModel) CountByAccount(ctx context.Context, accountId int64) (int64, error) {
+	var total int64
+	query := fmt.Sprintf("select count(*) from %s where `account_id` = ?", m.table)
+	if err := m.QueryRowNoCacheCtx(ctx, &total, query, accountId); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
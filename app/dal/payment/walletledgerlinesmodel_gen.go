@@ -0,0 +1,116 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	walletLedgerLinesFieldNames          = builder.RawFieldNames(&WalletLedgerLines{})
+	walletLedgerLinesRows                = strings.Join(walletLedgerLinesFieldNames, ",")
+	walletLedgerLinesRowsExpectAutoSet   = strings.Join(stringx.Remove(walletLedgerLinesFieldNames, "`line_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	walletLedgerLinesRowsWithPlaceHolder = strings.Join(stringx.Remove(walletLedgerLinesFieldNames, "`line_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheWalletLedgerLinesLineIdPrefix = "cache:walletLedgerLines:lineId:"
+)
+
+type (
+	walletLedgerLinesModel interface {
+		Insert(ctx context.Context, data *WalletLedgerLines) (sql.Result, error)
+		FindOne(ctx context.Context, lineId int64) (*WalletLedgerLines, error)
+		Update(ctx context.Context, data *WalletLedgerLines) error
+		Delete(ctx context.Context, lineId int64) error
+	}
+
+	defaultWalletLedgerLinesModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	WalletLedgerLines struct {
+		LineId       int64     `db:"line_id"`       // 分录ID
+		EntryId      int64     `db:"entry_id"`      // 凭证ID
+		AccountId    int64     `db:"account_id"`    // 账户ID
+		Direction    string    `db:"direction"`     // 借贷方向
+		Amount       int64     `db:"amount"`        // 金额，单位分
+		BalanceAfter int64     `db:"balance_after"` // 记账后账户余额
+		CreatedAt    time.Time `db:"created_at"`
+	}
+)
+
+func newWalletLedgerLinesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultWalletLedgerLinesModel {
+	return &defaultWalletLedgerLinesModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`wallet_ledger_lines`",
+	}
+}
+
+func (m *defaultWalletLedgerLinesModel) Delete(ctx context.Context, lineId int64) error {
+	walletLedgerLinesLineIdKey := fmt.Sprintf("%s%v", cacheWalletLedgerLinesLineIdPrefix, lineId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `line_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, lineId)
+	}, walletLedgerLinesLineIdKey)
+	return err
+}
+
+func (m *defaultWalletLedgerLinesModel) FindOne(ctx context.Context, lineId int64) (*WalletLedgerLines, error) {
+	walletLedgerLinesLineIdKey := fmt.Sprintf("%s%v", cacheWalletLedgerLinesLineIdPrefix, lineId)
+	var resp WalletLedgerLines
+	err := m.QueryRowCtx(ctx, &resp, walletLedgerLinesLineIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `line_id` = ? limit 1", walletLedgerLinesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, lineId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultWalletLedgerLinesModel) Insert(ctx context.Context, data *WalletLedgerLines) (sql.Result, error) {
+	walletLedgerLinesLineIdKey := fmt.Sprintf("%s%v", cacheWalletLedgerLinesLineIdPrefix, data.LineId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, walletLedgerLinesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.EntryId, data.AccountId, data.Direction, data.Amount, data.BalanceAfter)
+	}, walletLedgerLinesLineIdKey)
+	return ret, err
+}
+
+func (m *defaultWalletLedgerLinesModel) Update(ctx context.Context, data *WalletLedgerLines) error {
+	walletLedgerLinesLineIdKey := fmt.Sprintf("%s%v", cacheWalletLedgerLinesLineIdPrefix, data.LineId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `line_id` = ?", m.table, walletLedgerLinesRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.EntryId, data.AccountId, data.Direction, data.Amount, data.BalanceAfter, data.LineId)
+	}, walletLedgerLinesLineIdKey)
+	return err
+}
+
+func (m *defaultWalletLedgerLinesModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheWalletLedgerLinesLineIdPrefix, primary)
+}
+
+func (m *defaultWalletLedgerLinesModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `line_id` = ? limit 1", walletLedgerLinesRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultWalletLedgerLinesModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,53 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ProductFxRatesModel = (*customProductFxRatesModel)(nil)
+
+type (
+	// ProductFxRatesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customProductFxRatesModel.
+	ProductFxRatesModel interface {
+		productFxRatesModel
+		Upsert(ctx context.Context, currency string, rate int64) error
+		ListAll(ctx context.Context) ([]*ProductFxRates, error)
+	}
+
+	customProductFxRatesModel struct {
+		*defaultProductFxRatesModel
+	}
+)
+
+// NewProductFxRatesModel returns a model for the database table.
+func NewProductFxRatesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ProductFxRatesModel {
+	return &customProductFxRatesModel{
+		defaultProductFxRatesModel: newProductFxRatesModel(conn, c, opts...),
+	}
+}
+
+// Upsert 新增或更新币种汇率
+func (m *customProductFxRatesModel) Upsert(ctx context.Context, currency string, rate int64) error {
+	key := fmt.Sprintf("%s%v", cacheProductFxRatesCurrencyPrefix, currency)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("insert into %s (`currency`, `rate`) values (?, ?) on duplicate key update `rate` = values(`rate`)", m.table)
+		return conn.ExecCtx(ctx, query, currency, rate)
+	}, key)
+	return err
+}
+
+// ListAll 查询全部汇率，按币种排序
+func (m *customProductFxRatesModel) ListAll(ctx context.Context) ([]*ProductFxRates, error) {
+	query := fmt.Sprintf("select %s from %s order by `currency`", productFxRatesRows, m.table)
+	var rows []*ProductFxRates
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
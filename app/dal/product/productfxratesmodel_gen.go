@@ -0,0 +1,113 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package product
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	productFxRatesFieldNames          = builder.RawFieldNames(&ProductFxRates{})
+	productFxRatesRows                = strings.Join(productFxRatesFieldNames, ",")
+	productFxRatesRowsExpectAutoSet   = strings.Join(stringx.Remove(productFxRatesFieldNames, "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	productFxRatesRowsWithPlaceHolder = strings.Join(stringx.Remove(productFxRatesFieldNames, "`currency`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheProductFxRatesCurrencyPrefix = "cache:productFxRates:currency:"
+)
+
+type (
+	productFxRatesModel interface {
+		Insert(ctx context.Context, data *ProductFxRates) (sql.Result, error)
+		FindOne(ctx context.Context, currency string) (*ProductFxRates, error)
+		Update(ctx context.Context, data *ProductFxRates) error
+		Delete(ctx context.Context, currency string) error
+	}
+
+	defaultProductFxRatesModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ProductFxRates struct {
+		Currency  string    `db:"currency"`   // 币种，基准币 CNY 不入表
+		Rate      int64     `db:"rate"`       // 1 单位该币种折合 CNY 的数量，放大 1e8 倍
+		CreatedAt time.Time `db:"created_at"` // 创建时间
+		UpdatedAt time.Time `db:"updated_at"` // 更新时间
+	}
+)
+
+func newProductFxRatesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultProductFxRatesModel {
+	return &defaultProductFxRatesModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`product_fx_rates`",
+	}
+}
+
+func (m *defaultProductFxRatesModel) Delete(ctx context.Context, currency string) error {
+	productFxRatesCurrencyKey := fmt.Sprintf("%s%v", cacheProductFxRatesCurrencyPrefix, currency)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `currency` = ?", m.table)
+		return conn.ExecCtx(ctx, query, currency)
+	}, productFxRatesCurrencyKey)
+	return err
+}
+
+func (m *defaultProductFxRatesModel) FindOne(ctx context.Context, currency string) (*ProductFxRates, error) {
+	productFxRatesCurrencyKey := fmt.Sprintf("%s%v", cacheProductFxRatesCurrencyPrefix, currency)
+	var resp ProductFxRates
+	err := m.QueryRowCtx(ctx, &resp, productFxRatesCurrencyKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `currency` = ? limit 1", productFxRatesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, currency)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultProductFxRatesModel) Insert(ctx context.Context, data *ProductFxRates) (sql.Result, error) {
+	productFxRatesCurrencyKey := fmt.Sprintf("%s%v", cacheProductFxRatesCurrencyPrefix, data.Currency)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?)", m.table, productFxRatesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Currency, data.Rate)
+	}, productFxRatesCurrencyKey)
+	return ret, err
+}
+
+func (m *defaultProductFxRatesModel) Update(ctx context.Context, data *ProductFxRates) error {
+	productFxRatesCurrencyKey := fmt.Sprintf("%s%v", cacheProductFxRatesCurrencyPrefix, data.Currency)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `currency` = ?", m.table, productFxRatesRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.Rate, data.Currency)
+	}, productFxRatesCurrencyKey)
+	return err
+}
+
+func (m *defaultProductFxRatesModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheProductFxRatesCurrencyPrefix, primary)
+}
+
+func (m *defaultProductFxRatesModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `currency` = ? limit 1", productFxRatesRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultProductFxRatesModel) tableName() string {
+	return m.table
+}
@@ -47,6 +47,7 @@ type (
 		Description string    `db:"description"` // 商品描述
 		Picture     string    `db:"picture"`     // 商品主图地址
 		Price       int64     `db:"price"`       // 商品售价，单位分
+		Currency    string    `db:"currency"`    // 标价币种，商家以该币种定价与结算
 		CreatedAt   time.Time `db:"created_at"`  // 创建时间
 		UpdatedAt   time.Time `db:"updated_at"`  // 更新时间
 	}
@@ -88,8 +89,8 @@ func (m *defaultProductsModel) FindOne(ctx context.Context, id int64) (*Products
 func (m *defaultProductsModel) Insert(ctx context.Context, data *Products) (sql.Result, error) {
 	productsIdKey := fmt.Sprintf("%s%v", cacheProductsIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, productsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.MerchantId, data.Name, data.Description, data.Picture, data.Price)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, productsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.MerchantId, data.Name, data.Description, data.Picture, data.Price, data.Currency)
 	}, productsIdKey)
 	return ret, err
 }
@@ -98,7 +99,7 @@ func (m *defaultProductsModel) Update(ctx context.Context, data *Products) error
 	productsIdKey := fmt.Sprintf("%s%v", cacheProductsIdPrefix, data.Id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, productsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.MerchantId, data.Name, data.Description, data.Picture, data.Price, data.Id)
+		return conn.ExecCtx(ctx, query, data.MerchantId, data.Name, data.Description, data.Picture, data.Price, data.Currency, data.Id)
 	}, productsIdKey)
 	return err
 }
@@ -0,0 +1,41 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ SettlementCommissionRatesModel = (*customSettlementCommissionRatesModel)(nil)
+
+type (
+	// SettlementCommissionRatesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customSettlementCommissionRatesModel.
+	SettlementCommissionRatesModel interface {
+		settlementCommissionRatesModel
+		// ListAll returns all configured category rates.
+		ListAll(ctx context.Context) ([]*SettlementCommissionRates, error)
+	}
+
+	customSettlementCommissionRatesModel struct {
+		*defaultSettlementCommissionRatesModel
+	}
+)
+
+// NewSettlementCommissionRatesModel returns a model for the database table.
+func NewSettlementCommissionRatesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) SettlementCommissionRatesModel {
+	return &customSettlementCommissionRatesModel{
+		defaultSettlementCommissionRatesModel: newSettlementCommissionRatesModel(conn, c, opts...),
+	}
+}
+
+func (m *customSettlementCommissionRatesModel) ListAll(ctx context.Context) ([]*SettlementCommissionRates, error) {
+	var resp []*SettlementCommissionRates
+	query := fmt.Sprintf("select %s from %s order by `category`", settlementCommissionRatesRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
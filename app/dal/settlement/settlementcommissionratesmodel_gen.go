@@ -0,0 +1,149 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package settlement
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	settlementCommissionRatesFieldNames          = builder.RawFieldNames(&SettlementCommissionRates{})
+	settlementCommissionRatesRows                = strings.Join(settlementCommissionRatesFieldNames, ",")
+	settlementCommissionRatesRowsExpectAutoSet   = strings.Join(stringx.Remove(settlementCommissionRatesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	settlementCommissionRatesRowsWithPlaceHolder = strings.Join(stringx.Remove(settlementCommissionRatesFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheSettlementCommissionRatesIdPrefix       = "cache:settlementCommissionRates:id:"
+	cacheSettlementCommissionRatesCategoryPrefix = "cache:settlementCommissionRates:category:"
+)
+
+type (
+	settlementCommissionRatesModel interface {
+		Insert(ctx context.Context, data *SettlementCommissionRates) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*SettlementCommissionRates, error)
+		FindOneByCategory(ctx context.Context, category string) (*SettlementCommissionRates, error)
+		Update(ctx context.Context, data *SettlementCommissionRates) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultSettlementCommissionRatesModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	SettlementCommissionRates struct {
+		Id        int64     `db:"id"`
+		Category  string    `db:"category"` // 类目名称
+		RateBp    int64     `db:"rate_bp"`  // 佣金费率，万分比
+		CreatedAt time.Time `db:"created_at"`
+		UpdatedAt time.Time `db:"updated_at"`
+	}
+)
+
+func newSettlementCommissionRatesModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultSettlementCommissionRatesModel {
+	return &defaultSettlementCommissionRatesModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`settlement_commission_rates`",
+	}
+}
+
+func (m *defaultSettlementCommissionRatesModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	settlementCommissionRatesCategoryKey := fmt.Sprintf("%s%v", cacheSettlementCommissionRatesCategoryPrefix, data.Category)
+	settlementCommissionRatesIdKey := fmt.Sprintf("%s%v", cacheSettlementCommissionRatesIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, settlementCommissionRatesCategoryKey, settlementCommissionRatesIdKey)
+	return err
+}
+
+func (m *defaultSettlementCommissionRatesModel) FindOne(ctx context.Context, id int64) (*SettlementCommissionRates, error) {
+	settlementCommissionRatesIdKey := fmt.Sprintf("%s%v", cacheSettlementCommissionRatesIdPrefix, id)
+	var resp SettlementCommissionRates
+	err := m.QueryRowCtx(ctx, &resp, settlementCommissionRatesIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", settlementCommissionRatesRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultSettlementCommissionRatesModel) FindOneByCategory(ctx context.Context, category string) (*SettlementCommissionRates, error) {
+	settlementCommissionRatesCategoryKey := fmt.Sprintf("%s%v", cacheSettlementCommissionRatesCategoryPrefix, category)
+	var resp SettlementCommissionRates
+	err := m.QueryRowIndexCtx(ctx, &resp, settlementCommissionRatesCategoryKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `category` = ? limit 1", settlementCommissionRatesRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, category); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultSettlementCommissionRatesModel) Insert(ctx context.Context, data *SettlementCommissionRates) (sql.Result, error) {
+	settlementCommissionRatesCategoryKey := fmt.Sprintf("%s%v", cacheSettlementCommissionRatesCategoryPrefix, data.Category)
+	settlementCommissionRatesIdKey := fmt.Sprintf("%s%v", cacheSettlementCommissionRatesIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?)", m.table, settlementCommissionRatesRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Category, data.RateBp)
+	}, settlementCommissionRatesCategoryKey, settlementCommissionRatesIdKey)
+	return ret, err
+}
+
+func (m *defaultSettlementCommissionRatesModel) Update(ctx context.Context, newData *SettlementCommissionRates) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	settlementCommissionRatesCategoryKey := fmt.Sprintf("%s%v", cacheSettlementCommissionRatesCategoryPrefix, data.Category)
+	settlementCommissionRatesIdKey := fmt.Sprintf("%s%v", cacheSettlementCommissionRatesIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, settlementCommissionRatesRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.Category, newData.RateBp, newData.Id)
+	}, settlementCommissionRatesCategoryKey, settlementCommissionRatesIdKey)
+	return err
+}
+
+func (m *defaultSettlementCommissionRatesModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheSettlementCommissionRatesIdPrefix, primary)
+}
+
+func (m *defaultSettlementCommissionRatesModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", settlementCommissionRatesRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultSettlementCommissionRatesModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,60 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ SettlementItemsModel = (*customSettlementItemsModel)(nil)
+
+type (
+	// SettlementItemsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customSettlementItemsModel.
+	SettlementItemsModel interface {
+		settlementItemsModel
+		// InsertIgnoreWithSession inserts an item within given session, false when the order line was already settled.
+		InsertIgnoreWithSession(ctx context.Context, session sqlx.Session, data *SettlementItems) (bool, error)
+		// ListByStatement returns items of a statement ordered by order_id.
+		ListByStatement(ctx context.Context, statementId int64) ([]*SettlementItems, error)
+	}
+
+	customSettlementItemsModel struct {
+		*defaultSettlementItemsModel
+	}
+)
+
+// NewSettlementItemsModel returns a model for the database table.
+func NewSettlementItemsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) SettlementItemsModel {
+	return &customSettlementItemsModel{
+		defaultSettlementItemsModel: newSettlementItemsModel(conn, c, opts...),
+	}
+}
+
+func (m *customSettlementItemsModel) InsertIgnoreWithSession(ctx context.Context, session sqlx.Session, data *SettlementItems) (bool, error) {
+	query := fmt.Sprintf("insert ignore into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, settlementItemsRowsExpectAutoSet)
+	ret, err := session.ExecCtx(ctx, query, data.StatementId, data.MerchantId, data.OrderId, data.ProductId, data.Category, data.Quantity, data.Amount, data.RateBp, data.Commission, data.MerchantDiscount, data.CompletedAt)
+	if err != nil {
+		return false, err
+	}
+	affected, err := ret.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected > 0 {
+		settlementItemsOrderIdProductIdKey := fmt.Sprintf("%s%v:%v", cacheSettlementItemsOrderIdProductIdPrefix, data.OrderId, data.ProductId)
+		_ = m.DelCacheCtx(ctx, settlementItemsOrderIdProductIdKey)
+	}
+	return affected > 0, nil
+}
+
+func (m *customSettlementItemsModel) ListByStatement(ctx context.Context, statementId int64) ([]*SettlementItems, error) {
+	var resp []*SettlementItems
+	query := fmt.Sprintf("select %s from %s where `statement_id` = ? order by `order_id`, `product_id`", settlementItemsRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, statementId); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
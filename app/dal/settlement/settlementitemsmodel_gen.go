@@ -0,0 +1,157 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package settlement
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	settlementItemsFieldNames          = builder.RawFieldNames(&SettlementItems{})
+	settlementItemsRows                = strings.Join(settlementItemsFieldNames, ",")
+	settlementItemsRowsExpectAutoSet   = strings.Join(stringx.Remove(settlementItemsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	settlementItemsRowsWithPlaceHolder = strings.Join(stringx.Remove(settlementItemsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheSettlementItemsIdPrefix               = "cache:settlementItems:id:"
+	cacheSettlementItemsOrderIdProductIdPrefix = "cache:settlementItems:orderId:productId:"
+)
+
+type (
+	settlementItemsModel interface {
+		Insert(ctx context.Context, data *SettlementItems) (sql.Result, error)
+		FindOne(ctx context.Context, id int64) (*SettlementItems, error)
+		FindOneByOrderIdProductId(ctx context.Context, orderId int64, productId int64) (*SettlementItems, error)
+		Update(ctx context.Context, data *SettlementItems) error
+		Delete(ctx context.Context, id int64) error
+	}
+
+	defaultSettlementItemsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	SettlementItems struct {
+		Id               int64     `db:"id"`
+		StatementId      int64     `db:"statement_id"`      // 结算单ID
+		MerchantId       int64     `db:"merchant_id"`       // 商家ID
+		OrderId          int64     `db:"order_id"`          // 订单ID
+		ProductId        int64     `db:"product_id"`        // 商品ID
+		Category         string    `db:"category"`          // 计佣类目，未配置费率时为空
+		Quantity         int64     `db:"quantity"`          // 数量
+		Amount           int64     `db:"amount"`            // 行金额，单位分
+		RateBp           int64     `db:"rate_bp"`           // 佣金费率，万分比
+		Commission       int64     `db:"commission"`        // 佣金，单位分
+		MerchantDiscount int64     `db:"merchant_discount"` // 商家承担的优惠，单位分
+		CompletedAt      time.Time `db:"completed_at"`      // 确认收货时间
+		CreatedAt        time.Time `db:"created_at"`
+	}
+)
+
+func newSettlementItemsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultSettlementItemsModel {
+	return &defaultSettlementItemsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`settlement_items`",
+	}
+}
+
+func (m *defaultSettlementItemsModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	settlementItemsIdKey := fmt.Sprintf("%s%v", cacheSettlementItemsIdPrefix, id)
+	settlementItemsOrderIdProductIdKey := fmt.Sprintf("%s%v:%v", cacheSettlementItemsOrderIdProductIdPrefix, data.OrderId, data.ProductId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, settlementItemsIdKey, settlementItemsOrderIdProductIdKey)
+	return err
+}
+
+func (m *defaultSettlementItemsModel) FindOne(ctx context.Context, id int64) (*SettlementItems, error) {
+	settlementItemsIdKey := fmt.Sprintf("%s%v", cacheSettlementItemsIdPrefix, id)
+	var resp SettlementItems
+	err := m.QueryRowCtx(ctx, &resp, settlementItemsIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", settlementItemsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultSettlementItemsModel) FindOneByOrderIdProductId(ctx context.Context, orderId int64, productId int64) (*SettlementItems, error) {
+	settlementItemsOrderIdProductIdKey := fmt.Sprintf("%s%v:%v", cacheSettlementItemsOrderIdProductIdPrefix, orderId, productId)
+	var resp SettlementItems
+	err := m.QueryRowIndexCtx(ctx, &resp, settlementItemsOrderIdProductIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `order_id` = ? and `product_id` = ? limit 1", settlementItemsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, orderId, productId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultSettlementItemsModel) Insert(ctx context.Context, data *SettlementItems) (sql.Result, error) {
+	settlementItemsIdKey := fmt.Sprintf("%s%v", cacheSettlementItemsIdPrefix, data.Id)
+	settlementItemsOrderIdProductIdKey := fmt.Sprintf("%s%v:%v", cacheSettlementItemsOrderIdProductIdPrefix, data.OrderId, data.ProductId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, settlementItemsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.StatementId, data.MerchantId, data.OrderId, data.ProductId, data.Category, data.Quantity, data.Amount, data.RateBp, data.Commission, data.MerchantDiscount, data.CompletedAt)
+	}, settlementItemsIdKey, settlementItemsOrderIdProductIdKey)
+	return ret, err
+}
+
+func (m *defaultSettlementItemsModel) Update(ctx context.Context, newData *SettlementItems) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	settlementItemsIdKey := fmt.Sprintf("%s%v", cacheSettlementItemsIdPrefix, data.Id)
+	settlementItemsOrderIdProductIdKey := fmt.Sprintf("%s%v:%v", cacheSettlementItemsOrderIdProductIdPrefix, data.OrderId, data.ProductId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, settlementItemsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.StatementId, newData.MerchantId, newData.OrderId, newData.ProductId, newData.Category, newData.Quantity, newData.Amount, newData.RateBp, newData.Commission, newData.MerchantDiscount, newData.CompletedAt, newData.Id)
+	}, settlementItemsIdKey, settlementItemsOrderIdProductIdKey)
+	return err
+}
+
+func (m *defaultSettlementItemsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheSettlementItemsIdPrefix, primary)
+}
+
+func (m *defaultSettlementItemsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", settlementItemsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultSettlementItemsModel) tableName() string {
+	return m.table
+}
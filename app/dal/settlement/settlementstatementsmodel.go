@@ -0,0 +1,149 @@
+package settlement
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ SettlementStatementsModel = (*customSettlementStatementsModel)(nil)
+
+type (
+	// SettlementStatementsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customSettlementStatementsModel.
+	SettlementStatementsModel interface {
+		settlementStatementsModel
+		// InsertWithSession inserts a statement row within given session.
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *SettlementStatements) (sql.Result, error)
+		// UpdateTotalsWithSession writes counters and amounts of a statement within given session.
+		UpdateTotalsWithSession(ctx context.Context, session sqlx.Session, data *SettlementStatements) error
+		// UpdatePayout moves a statement from one of fromStatus to data.Status with payout fields.
+		UpdatePayout(ctx context.Context, data *SettlementStatements, fromStatus []string) (bool, error)
+		// List returns statements ordered by period desc, merchantId 0 and empty status mean all.
+		List(ctx context.Context, merchantId int64, status string, offset, limit int64) ([]*SettlementStatements, error)
+		// Count counts statements with the same filters as List.
+		Count(ctx context.Context, merchantId int64, status string) (int64, error)
+	}
+
+	customSettlementStatementsModel struct {
+		*defaultSettlementStatementsModel
+	}
+)
+
+// NewSettlementStatementsModel returns a model for the database table.
+func NewSettlementStatementsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) SettlementStatementsModel {
+	return &customSettlementStatementsModel{
+		defaultSettlementStatementsModel: newSettlementStatementsModel(conn, c, opts...),
+	}
+}
+
+func (m *customSettlementStatementsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *SettlementStatements) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, settlementStatementsRowsExpectAutoSet)
+	ret, err := session.ExecCtx(ctx, query, data.StatementNo, data.MerchantId, data.PeriodStart, data.PeriodEnd, data.OrderCount, data.ItemCount, data.GrossAmount, data.CommissionAmount, data.DiscountAmount, data.NetAmount, data.Status, data.PayoutNo, data.FailReason, data.PaidAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// 重复生成前的查询可能缓存了未找到的占位，插入后清理
+	settlementStatementsMerchantIdPeriodStartPeriodEndKey := fmt.Sprintf("%s%v:%v:%v", cacheSettlementStatementsMerchantIdPeriodStartPeriodEndPrefix, data.MerchantId, data.PeriodStart, data.PeriodEnd)
+	settlementStatementsStatementNoKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementNoPrefix, data.StatementNo)
+	_ = m.DelCacheCtx(ctx, settlementStatementsMerchantIdPeriodStartPeriodEndKey, settlementStatementsStatementNoKey)
+	return ret, nil
+}
+
+func (m *customSettlementStatementsModel) UpdateTotalsWithSession(ctx context.Context, session sqlx.Session, data *SettlementStatements) error {
+	query := fmt.Sprintf("update %s set `order_count` = ?, `item_count` = ?, `gross_amount` = ?, `commission_amount` = ?, `discount_amount` = ?, `net_amount` = ?, `updated_at` = current_timestamp where `statement_id` = ?", m.table)
+	if _, err := session.ExecCtx(ctx, query, data.OrderCount, data.ItemCount, data.GrossAmount, data.CommissionAmount, data.DiscountAmount, data.NetAmount, data.StatementId); err != nil {
+		return err
+	}
+	_ = m.DelCacheCtx(ctx, m.cacheKeys(data)...)
+	return nil
+}
+
+func (m *customSettlementStatementsModel) UpdatePayout(ctx context.Context, data *SettlementStatements, fromStatus []string) (bool, error) {
+	if len(fromStatus) == 0 {
+		return false, fmt.Errorf("fromStatus must not be empty")
+	}
+	args := make([]any, 0, len(fromStatus)+5)
+	args = append(args, data.Status, data.PayoutNo, data.FailReason, data.PaidAt, data.StatementId)
+	for _, s := range fromStatus {
+		args = append(args, s)
+	}
+
+	query := fmt.Sprintf("update %s set `status` = ?, `payout_no` = ?, `fail_reason` = ?, `paid_at` = ?, `updated_at` = current_timestamp where `statement_id` = ? and `status` in (%s)", m.table, placeholders(len(fromStatus)))
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, query, args...)
+	}, m.cacheKeys(data)...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (m *customSettlementStatementsModel) List(ctx context.Context, merchantId int64, status string, offset, limit int64) ([]*SettlementStatements, error) {
+	where, args := statementFilter(merchantId, status)
+	args = append(args, limit, offset)
+
+	var resp []*SettlementStatements
+	query := fmt.Sprintf("select %s from %s where %s order by `period_start` desc, `statement_id` desc limit ? offset ?", settlementStatementsRows, m.table, where)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *customSettlementStatementsModel) Count(ctx context.Context, merchantId int64, status string) (int64, error) {
+	where, args := statementFilter(merchantId, status)
+
+	var total int64
+	query := fmt.Sprintf("select count(1) from %s where %s", m.table, where)
+	if err := m.QueryRowNoCacheCtx(ctx, &total, query, args...); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (m *customSettlementStatementsModel) cacheKeys(data *SettlementStatements) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheSettlementStatementsStatementIdPrefix, data.StatementId),
+		fmt.Sprintf("%s%v:%v:%v", cacheSettlementStatementsMerchantIdPeriodStartPeriodEndPrefix, data.MerchantId, data.PeriodStart, data.PeriodEnd),
+		fmt.Sprintf("%s%v", cacheSettlementStatementsStatementNoPrefix, data.StatementNo),
+	}
+}
+
+func statementFilter(merchantId int64, status string) (string, []any) {
+	conds := []string{"1 = 1"}
+	var args []any
+	if merchantId > 0 {
+		conds = append(conds, "`merchant_id` = ?")
+		args = append(args, merchantId)
+	}
+	if status != "" {
+		conds = append(conds, "`status` = ?")
+		args = append(args, status)
+	}
+	return strings.Join(conds, " and "), args
+}
+
+func placeholders(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteByte('?')
+	}
+	return builder.String()
+}
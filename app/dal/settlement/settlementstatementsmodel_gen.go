@@ -0,0 +1,186 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package settlement
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	settlementStatementsFieldNames          = builder.RawFieldNames(&SettlementStatements{})
+	settlementStatementsRows                = strings.Join(settlementStatementsFieldNames, ",")
+	settlementStatementsRowsExpectAutoSet   = strings.Join(stringx.Remove(settlementStatementsFieldNames, "`statement_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	settlementStatementsRowsWithPlaceHolder = strings.Join(stringx.Remove(settlementStatementsFieldNames, "`statement_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheSettlementStatementsStatementIdPrefix                    = "cache:settlementStatements:statementId:"
+	cacheSettlementStatementsMerchantIdPeriodStartPeriodEndPrefix = "cache:settlementStatements:merchantId:periodStart:periodEnd:"
+	cacheSettlementStatementsStatementNoPrefix                    = "cache:settlementStatements:statementNo:"
+)
+
+type (
+	settlementStatementsModel interface {
+		Insert(ctx context.Context, data *SettlementStatements) (sql.Result, error)
+		FindOne(ctx context.Context, statementId int64) (*SettlementStatements, error)
+		FindOneByMerchantIdPeriodStartPeriodEnd(ctx context.Context, merchantId int64, periodStart time.Time, periodEnd time.Time) (*SettlementStatements, error)
+		FindOneByStatementNo(ctx context.Context, statementNo string) (*SettlementStatements, error)
+		Update(ctx context.Context, data *SettlementStatements) error
+		Delete(ctx context.Context, statementId int64) error
+	}
+
+	defaultSettlementStatementsModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	SettlementStatements struct {
+		StatementId      int64        `db:"statement_id"`      // 结算单ID
+		StatementNo      string       `db:"statement_no"`      // 结算单号
+		MerchantId       int64        `db:"merchant_id"`       // 商家ID
+		PeriodStart      time.Time    `db:"period_start"`      // 结算周期开始（含）
+		PeriodEnd        time.Time    `db:"period_end"`        // 结算周期结束（不含）
+		OrderCount       int64        `db:"order_count"`       // 订单数
+		ItemCount        int64        `db:"item_count"`        // 明细行数
+		GrossAmount      int64        `db:"gross_amount"`      // 商品金额，单位分
+		CommissionAmount int64        `db:"commission_amount"` // 平台佣金，单位分
+		DiscountAmount   int64        `db:"discount_amount"`   // 商家承担的优惠（商家促销与商家券），单位分
+		NetAmount        int64        `db:"net_amount"`        // 应付商家，单位分
+		Status           string       `db:"status"`            // 打款状态
+		PayoutNo         string       `db:"payout_no"`         // 打款流水号
+		FailReason       string       `db:"fail_reason"`       // 打款失败原因
+		PaidAt           sql.NullTime `db:"paid_at"`           // 打款完成时间
+		CreatedAt        time.Time    `db:"created_at"`
+		UpdatedAt        time.Time    `db:"updated_at"`
+	}
+)
+
+func newSettlementStatementsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultSettlementStatementsModel {
+	return &defaultSettlementStatementsModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`settlement_statements`",
+	}
+}
+
+func (m *defaultSettlementStatementsModel) Delete(ctx context.Context, statementId int64) error {
+	data, err := m.FindOne(ctx, statementId)
+	if err != nil {
+		return err
+	}
+
+	settlementStatementsMerchantIdPeriodStartPeriodEndKey := fmt.Sprintf("%s%v:%v:%v", cacheSettlementStatementsMerchantIdPeriodStartPeriodEndPrefix, data.MerchantId, data.PeriodStart, data.PeriodEnd)
+	settlementStatementsStatementIdKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementIdPrefix, statementId)
+	settlementStatementsStatementNoKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementNoPrefix, data.StatementNo)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `statement_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, statementId)
+	}, settlementStatementsMerchantIdPeriodStartPeriodEndKey, settlementStatementsStatementIdKey, settlementStatementsStatementNoKey)
+	return err
+}
+
+func (m *defaultSettlementStatementsModel) FindOne(ctx context.Context, statementId int64) (*SettlementStatements, error) {
+	settlementStatementsStatementIdKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementIdPrefix, statementId)
+	var resp SettlementStatements
+	err := m.QueryRowCtx(ctx, &resp, settlementStatementsStatementIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `statement_id` = ? limit 1", settlementStatementsRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, statementId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultSettlementStatementsModel) FindOneByMerchantIdPeriodStartPeriodEnd(ctx context.Context, merchantId int64, periodStart time.Time, periodEnd time.Time) (*SettlementStatements, error) {
+	settlementStatementsMerchantIdPeriodStartPeriodEndKey := fmt.Sprintf("%s%v:%v:%v", cacheSettlementStatementsMerchantIdPeriodStartPeriodEndPrefix, merchantId, periodStart, periodEnd)
+	var resp SettlementStatements
+	err := m.QueryRowIndexCtx(ctx, &resp, settlementStatementsMerchantIdPeriodStartPeriodEndKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `merchant_id` = ? and `period_start` = ? and `period_end` = ? limit 1", settlementStatementsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, merchantId, periodStart, periodEnd); err != nil {
+			return nil, err
+		}
+		return resp.StatementId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultSettlementStatementsModel) FindOneByStatementNo(ctx context.Context, statementNo string) (*SettlementStatements, error) {
+	settlementStatementsStatementNoKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementNoPrefix, statementNo)
+	var resp SettlementStatements
+	err := m.QueryRowIndexCtx(ctx, &resp, settlementStatementsStatementNoKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `statement_no` = ? limit 1", settlementStatementsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, statementNo); err != nil {
+			return nil, err
+		}
+		return resp.StatementId, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultSettlementStatementsModel) Insert(ctx context.Context, data *SettlementStatements) (sql.Result, error) {
+	settlementStatementsMerchantIdPeriodStartPeriodEndKey := fmt.Sprintf("%s%v:%v:%v", cacheSettlementStatementsMerchantIdPeriodStartPeriodEndPrefix, data.MerchantId, data.PeriodStart, data.PeriodEnd)
+	settlementStatementsStatementIdKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementIdPrefix, data.StatementId)
+	settlementStatementsStatementNoKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementNoPrefix, data.StatementNo)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, settlementStatementsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.StatementNo, data.MerchantId, data.PeriodStart, data.PeriodEnd, data.OrderCount, data.ItemCount, data.GrossAmount, data.CommissionAmount, data.DiscountAmount, data.NetAmount, data.Status, data.PayoutNo, data.FailReason, data.PaidAt)
+	}, settlementStatementsMerchantIdPeriodStartPeriodEndKey, settlementStatementsStatementIdKey, settlementStatementsStatementNoKey)
+	return ret, err
+}
+
+func (m *defaultSettlementStatementsModel) Update(ctx context.Context, newData *SettlementStatements) error {
+	data, err := m.FindOne(ctx, newData.StatementId)
+	if err != nil {
+		return err
+	}
+
+	settlementStatementsMerchantIdPeriodStartPeriodEndKey := fmt.Sprintf("%s%v:%v:%v", cacheSettlementStatementsMerchantIdPeriodStartPeriodEndPrefix, data.MerchantId, data.PeriodStart, data.PeriodEnd)
+	settlementStatementsStatementIdKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementIdPrefix, data.StatementId)
+	settlementStatementsStatementNoKey := fmt.Sprintf("%s%v", cacheSettlementStatementsStatementNoPrefix, data.StatementNo)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `statement_id` = ?", m.table, settlementStatementsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.StatementNo, newData.MerchantId, newData.PeriodStart, newData.PeriodEnd, newData.OrderCount, newData.ItemCount, newData.GrossAmount, newData.CommissionAmount, newData.DiscountAmount, newData.NetAmount, newData.Status, newData.PayoutNo, newData.FailReason, newData.PaidAt, newData.StatementId)
+	}, settlementStatementsMerchantIdPeriodStartPeriodEndKey, settlementStatementsStatementIdKey, settlementStatementsStatementNoKey)
+	return err
+}
+
+func (m *defaultSettlementStatementsModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheSettlementStatementsStatementIdPrefix, primary)
+}
+
+func (m *defaultSettlementStatementsModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `statement_id` = ? limit 1", settlementStatementsRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultSettlementStatementsModel) tableName() string {
+	return m.table
+}
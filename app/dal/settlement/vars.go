@@ -0,0 +1,13 @@
+package settlement
+
+import "github.com/zeromicro/go-zero/core/stores/sqlx"
+
+var ErrNotFound = sqlx.ErrNotFound
+
+// 结算单打款状态
+const (
+	StatementStatusPending = "PENDING"
+	StatementStatusPaying  = "PAYING"
+	StatementStatusPaid    = "PAID"
+	StatementStatusFailed  = "FAILED"
+)
@@ -2,7 +2,10 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -16,6 +19,19 @@ type (
 	UsersModel interface {
 		usersModel
 		FindAllUsername(ctx context.Context) ([]string, error) 
+		// TouchLastActive 记录最近登录时间
+		TouchLastActive(ctx context.Context, id uint64, at time.Time) error
+		// ScanIds 按 id 游标分页圈选用户
+		ScanIds(ctx context.Context, filter ScanFilter) ([]uint64, error)
+	}
+
+	// ScanFilter 用户圈选条件，零值表示不限
+	ScanFilter struct {
+		AfterId       uint64
+		Limit         int
+		BirthdayMonth int
+		BirthdayDay   int
+		ActiveAfter   time.Time
 	}
 
 	customUsersModel struct {
@@ -35,4 +51,32 @@ func (m *customUsersModel) FindAllUsername(ctx context.Context) ([]string, error
 	var name []string
 	err := m.CachedConn.QueryRowNoCacheCtx(ctx, &name, query)
 	return name, err
-}
\ No newline at end of file
+}
+
+func (m *customUsersModel) TouchLastActive(ctx context.Context, id uint64, at time.Time) error {
+	usersIdKey := fmt.Sprintf("%s%v", cacheUsersIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `last_active_at` = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, at, id)
+	}, usersIdKey)
+	return err
+}
+
+func (m *customUsersModel) ScanIds(ctx context.Context, filter ScanFilter) ([]uint64, error) {
+	conds := []string{"`id` > ?"}
+	args := []any{filter.AfterId}
+	if filter.BirthdayMonth > 0 && filter.BirthdayDay > 0 {
+		conds = append(conds, "month(`birthday`) = ? and day(`birthday`) = ?")
+		args = append(args, filter.BirthdayMonth, filter.BirthdayDay)
+	}
+	if !filter.ActiveAfter.IsZero() {
+		conds = append(conds, "`last_active_at` >= ?")
+		args = append(args, filter.ActiveAfter)
+	}
+	args = append(args, filter.Limit)
+
+	query := fmt.Sprintf("select `id` from %s where %s order by `id` asc limit ?", m.table, strings.Join(conds, " and "))
+	var ids []uint64
+	err := m.QueryRowsNoCacheCtx(ctx, &ids, query, args...)
+	return ids, err
+}
@@ -43,11 +43,13 @@ type (
 	}
 
 	Users struct {
-		Id        uint64    `db:"id"`         // 唯一主键，雪花执行
-		Username  string    `db:"username"`   // 唯一的用户名
-		Password  string    `db:"password"`   // 密码这块，肯定是加密过的密码
-		CreatedAt time.Time `db:"created_at"` // 创建时间
-		UpdatedAt time.Time `db:"updated_at"` // 最近更新时间
+		Id           uint64       `db:"id"`             // 唯一主键，雪花执行
+		Username     string       `db:"username"`       // 唯一的用户名
+		Password     string       `db:"password"`       // 密码这块，肯定是加密过的密码
+		Birthday     sql.NullTime `db:"birthday"`       // 生日，生日券用
+		LastActiveAt sql.NullTime `db:"last_active_at"` // 最近登录时间，人群圈选用
+		CreatedAt    time.Time    `db:"created_at"`     // 创建时间
+		UpdatedAt    time.Time    `db:"updated_at"`     // 最近更新时间
 	}
 )
 
@@ -114,8 +116,8 @@ func (m *defaultUsersModel) Insert(ctx context.Context, data *Users) (sql.Result
 	usersIdKey := fmt.Sprintf("%s%v", cacheUsersIdPrefix, data.Id)
 	usersUsernameKey := fmt.Sprintf("%s%v", cacheUsersUsernamePrefix, data.Username)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, usersRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.Id, data.Username, data.Password)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, usersRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Id, data.Username, data.Password, data.Birthday, data.LastActiveAt)
 	}, usersIdKey, usersUsernameKey)
 	return ret, err
 }
@@ -130,7 +132,7 @@ func (m *defaultUsersModel) Update(ctx context.Context, newData *Users) error {
 	usersUsernameKey := fmt.Sprintf("%s%v", cacheUsersUsernamePrefix, data.Username)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, usersRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Username, newData.Password, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.Username, newData.Password, newData.Birthday, newData.LastActiveAt, newData.Id)
 	}, usersIdKey, usersUsernameKey)
 	return err
 }
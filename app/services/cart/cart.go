@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"NatsumeAI/app/services/cart/cart"
+	"NatsumeAI/app/services/cart/internal/bootstrap"
 	"NatsumeAI/app/services/cart/internal/config"
 	"NatsumeAI/app/services/cart/internal/server"
 	"NatsumeAI/app/services/cart/internal/svc"
@@ -35,6 +36,9 @@ func main() {
 		}
 	})
 
+	stopFlush := bootstrap.StartFlush(ctx)
+	defer stopFlush()
+
 	if err := consul.RegisterService(c.ListenOn, c.Consul); err != nil {
 		logx.Errorw("register service error", logx.Field("err", err))
 		panic(err)
@@ -24,6 +24,8 @@ const (
 type GetCartItemListReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`        // user_id 为 0 时按游客令牌访问游客购物车
+	SelectedOnly  bool                   `protobuf:"varint,3,opt,name=selected_only,json=selectedOnly,proto3" json:"selected_only,omitempty"` // 只返回已勾选的商品，供结算使用
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,12 +67,27 @@ func (x *GetCartItemListReq) GetUserId() int64 {
 	return 0
 }
 
+func (x *GetCartItemListReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *GetCartItemListReq) GetSelectedOnly() bool {
+	if x != nil {
+		return x.SelectedOnly
+	}
+	return false
+}
+
 type GetCartItemListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
 	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
 	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	Data          []*CartInfoResp        `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	SavedItems    []*CartInfoResp        `protobuf:"bytes,5,rep,name=saved_items,json=savedItems,proto3" json:"saved_items,omitempty"` // 稍后再买，不计入 total
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,12 +150,21 @@ func (x *GetCartItemListResp) GetData() []*CartInfoResp {
 	return nil
 }
 
+func (x *GetCartItemListResp) GetSavedItems() []*CartInfoResp {
+	if x != nil {
+		return x.SavedItems
+	}
+	return nil
+}
+
 type CartInfoResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Selected      bool                   `protobuf:"varint,5,opt,name=selected,proto3" json:"selected,omitempty"`
+	SavedForLater bool                   `protobuf:"varint,6,opt,name=saved_for_later,json=savedForLater,proto3" json:"saved_for_later,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -201,10 +227,25 @@ func (x *CartInfoResp) GetQuantity() int64 {
 	return 0
 }
 
+func (x *CartInfoResp) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *CartInfoResp) GetSavedForLater() bool {
+	if x != nil {
+		return x.SavedForLater
+	}
+	return false
+}
+
 type DelCartItemReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,3,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -253,11 +294,19 @@ func (x *DelCartItemReq) GetProductId() int64 {
 	return 0
 }
 
+func (x *DelCartItemReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
 type CreateCartItemReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -313,11 +362,19 @@ func (x *CreateCartItemReq) GetCount() int64 {
 	return 0
 }
 
+func (x *CreateCartItemReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
 type UpdateCartItemReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -373,10 +430,18 @@ func (x *UpdateCartItemReq) GetCount() int64 {
 	return 0
 }
 
+func (x *UpdateCartItemReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
 type CartItemResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
 	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // 操作后的商品数量，删除时为 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -425,51 +490,1070 @@ func (x *CartItemResp) GetStatusMsg() string {
 	return ""
 }
 
-var File_cart_proto protoreflect.FileDescriptor
+func (x *CartItemResp) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
 
-const file_cart_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"cart.proto\x12\x04cart\"-\n" +
-	"\x12GetCartItemListReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x93\x01\n" +
-	"\x13GetCartItemListResp\x12\x1f\n" +
-	"\vstatus_code\x18\x01 \x01(\x05R\n" +
-	"statusCode\x12\x1d\n" +
-	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x14\n" +
-	"\x05total\x18\x03 \x01(\x03R\x05total\x12&\n" +
-	"\x04data\x18\x04 \x03(\v2\x12.cart.CartInfoRespR\x04data\"r\n" +
-	"\fCartInfoResp\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"H\n" +
-	"\x0eDelCartItemReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\"a\n" +
-	"\x11CreateCartItemReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x03R\x05count\"a\n" +
-	"\x11UpdateCartItemReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x03R\x05count\"N\n" +
-	"\fCartItemResp\x12\x1f\n" +
+// 批量操作商品，product_ids 为空时 SelectCartItems 处理全部商品，其余操作不处理
+type SelectCartItemsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	ProductIds    []int64                `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Selected      bool                   `protobuf:"varint,4,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCartItemsReq) Reset() {
+	*x = SelectCartItemsReq{}
+	mi := &file_cart_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCartItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCartItemsReq) ProtoMessage() {}
+
+func (x *SelectCartItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCartItemsReq.ProtoReflect.Descriptor instead.
+func (*SelectCartItemsReq) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SelectCartItemsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SelectCartItemsReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *SelectCartItemsReq) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *SelectCartItemsReq) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+type SaveForLaterReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	ProductIds    []int64                `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Saved         bool                   `protobuf:"varint,4,opt,name=saved,proto3" json:"saved,omitempty"` // true 移入稍后再买，false 移回购物车
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveForLaterReq) Reset() {
+	*x = SaveForLaterReq{}
+	mi := &file_cart_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveForLaterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveForLaterReq) ProtoMessage() {}
+
+func (x *SaveForLaterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveForLaterReq.ProtoReflect.Descriptor instead.
+func (*SaveForLaterReq) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SaveForLaterReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SaveForLaterReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *SaveForLaterReq) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *SaveForLaterReq) GetSaved() bool {
+	if x != nil {
+		return x.Saved
+	}
+	return false
+}
+
+type BatchDeleteCartItemsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	ProductIds    []int64                `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteCartItemsReq) Reset() {
+	*x = BatchDeleteCartItemsReq{}
+	mi := &file_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteCartItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteCartItemsReq) ProtoMessage() {}
+
+func (x *BatchDeleteCartItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteCartItemsReq.ProtoReflect.Descriptor instead.
+func (*BatchDeleteCartItemsReq) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchDeleteCartItemsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BatchDeleteCartItemsReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *BatchDeleteCartItemsReq) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type CartBatchResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Affected      int64                  `protobuf:"varint,3,opt,name=affected,proto3" json:"affected,omitempty"` // 实际处理的商品行数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartBatchResp) Reset() {
+	*x = CartBatchResp{}
+	mi := &file_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartBatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartBatchResp) ProtoMessage() {}
+
+func (x *CartBatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartBatchResp.ProtoReflect.Descriptor instead.
+func (*CartBatchResp) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CartBatchResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CartBatchResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CartBatchResp) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+type GetCartViewReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"` // 游客购物车不推荐优惠券
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartViewReq) Reset() {
+	*x = GetCartViewReq{}
+	mi := &file_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartViewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartViewReq) ProtoMessage() {}
+
+func (x *GetCartViewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartViewReq.ProtoReflect.Descriptor instead.
+func (*GetCartViewReq) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCartViewReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCartViewReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// 购物车商品行，商品信息为实时数据，金额单位为分(CNY)
+type CartLine struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId         int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity          int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Picture           string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"`
+	Price             int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`                             // 当前单价
+	AddedPrice        int64                  `protobuf:"varint,7,opt,name=added_price,json=addedPrice,proto3" json:"added_price,omitempty"` // 加入购物车时的单价，0 表示未记录
+	Stock             int64                  `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`
+	MerchantId        int64                  `protobuf:"varint,9,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Amount            int64                  `protobuf:"varint,10,opt,name=amount,proto3" json:"amount,omitempty"`                                 // 行金额 = price * quantity，不可购买时为 0
+	PriceChanged      bool                   `protobuf:"varint,11,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"` // 当前单价与加入时不同
+	Unavailable       bool                   `protobuf:"varint,12,opt,name=unavailable,proto3" json:"unavailable,omitempty"`                       // 商品已下架或库存不足
+	UnavailableReason string                 `protobuf:"bytes,13,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
+	Selected          bool                   `protobuf:"varint,14,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CartLine) Reset() {
+	*x = CartLine{}
+	mi := &file_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartLine) ProtoMessage() {}
+
+func (x *CartLine) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartLine.ProtoReflect.Descriptor instead.
+func (*CartLine) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CartLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CartLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CartLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartLine) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *CartLine) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CartLine) GetAddedPrice() int64 {
+	if x != nil {
+		return x.AddedPrice
+	}
+	return 0
+}
+
+func (x *CartLine) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CartLine) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CartLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CartLine) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *CartLine) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
+func (x *CartLine) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
+func (x *CartLine) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+type CartMerchantGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Lines         []*CartLine            `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotal      int64                  `protobuf:"varint,3,opt,name=subtotal,proto3" json:"subtotal,omitempty"` // 本店已勾选且可购买的商品金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartMerchantGroup) Reset() {
+	*x = CartMerchantGroup{}
+	mi := &file_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartMerchantGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartMerchantGroup) ProtoMessage() {}
+
+func (x *CartMerchantGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartMerchantGroup.ProtoReflect.Descriptor instead.
+func (*CartMerchantGroup) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CartMerchantGroup) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CartMerchantGroup) GetLines() []*CartLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CartMerchantGroup) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+type CartPromotion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId    int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,4,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CartPromotion) Reset() {
+	*x = CartPromotion{}
+	mi := &file_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartPromotion) ProtoMessage() {}
+
+func (x *CartPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartPromotion.ProtoReflect.Descriptor instead.
+func (*CartPromotion) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CartPromotion) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *CartPromotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartPromotion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CartPromotion) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+// 最优优惠：比较“仅促销”与“促销 + 最优券”两种组合，取优惠更大的
+type CartDiscount struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DiscountAmount  int64                  `protobuf:"varint,1,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	PromotionAmount int64                  `protobuf:"varint,2,opt,name=promotion_amount,json=promotionAmount,proto3" json:"promotion_amount,omitempty"`
+	CouponId        int64                  `protobuf:"varint,3,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"` // 推荐使用的券实例，0 表示不用券
+	CouponAmount    int64                  `protobuf:"varint,4,opt,name=coupon_amount,json=couponAmount,proto3" json:"coupon_amount,omitempty"`
+	Promotions      []*CartPromotion       `protobuf:"bytes,5,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CartDiscount) Reset() {
+	*x = CartDiscount{}
+	mi := &file_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartDiscount) ProtoMessage() {}
+
+func (x *CartDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartDiscount.ProtoReflect.Descriptor instead.
+func (*CartDiscount) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CartDiscount) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *CartDiscount) GetPromotionAmount() int64 {
+	if x != nil {
+		return x.PromotionAmount
+	}
+	return 0
+}
+
+func (x *CartDiscount) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CartDiscount) GetCouponAmount() int64 {
+	if x != nil {
+		return x.CouponAmount
+	}
+	return 0
+}
+
+func (x *CartDiscount) GetPromotions() []*CartPromotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type GetCartViewResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Groups        []*CartMerchantGroup   `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Subtotal      int64                  `protobuf:"varint,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`                                // 已勾选且可购买的商品金额合计
+	TotalQuantity int64                  `protobuf:"varint,5,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"` // 已勾选且可购买的商品件数合计
+	BestDiscount  *CartDiscount          `protobuf:"bytes,6,opt,name=best_discount,json=bestDiscount,proto3" json:"best_discount,omitempty"`     // 按已勾选商品计算
+	PayableAmount int64                  `protobuf:"varint,7,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"` // subtotal - best_discount.discount_amount
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	SavedLines    []*CartLine            `protobuf:"bytes,9,rep,name=saved_lines,json=savedLines,proto3" json:"saved_lines,omitempty"`      // 稍后再买，不计入金额
+	AllSelected   bool                   `protobuf:"varint,10,opt,name=all_selected,json=allSelected,proto3" json:"all_selected,omitempty"` // 购物车中的商品是否全部勾选
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartViewResp) Reset() {
+	*x = GetCartViewResp{}
+	mi := &file_cart_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartViewResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartViewResp) ProtoMessage() {}
+
+func (x *GetCartViewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartViewResp.ProtoReflect.Descriptor instead.
+func (*GetCartViewResp) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCartViewResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetCartViewResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetCartViewResp) GetGroups() []*CartMerchantGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetCartViewResp) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *GetCartViewResp) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *GetCartViewResp) GetBestDiscount() *CartDiscount {
+	if x != nil {
+		return x.BestDiscount
+	}
+	return nil
+}
+
+func (x *GetCartViewResp) GetPayableAmount() int64 {
+	if x != nil {
+		return x.PayableAmount
+	}
+	return 0
+}
+
+func (x *GetCartViewResp) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetCartViewResp) GetSavedLines() []*CartLine {
+	if x != nil {
+		return x.SavedLines
+	}
+	return nil
+}
+
+func (x *GetCartViewResp) GetAllSelected() bool {
+	if x != nil {
+		return x.AllSelected
+	}
+	return false
+}
+
+// 登录后把游客购物车合并到用户购物车，合并后游客购物车删除
+type MergeGuestCartReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGuestCartReq) Reset() {
+	*x = MergeGuestCartReq{}
+	mi := &file_cart_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestCartReq) ProtoMessage() {}
+
+func (x *MergeGuestCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestCartReq.ProtoReflect.Descriptor instead.
+func (*MergeGuestCartReq) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MergeGuestCartReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MergeGuestCartReq) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+type MergeGuestCartResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Merged        int64                  `protobuf:"varint,3,opt,name=merged,proto3" json:"merged,omitempty"`   // 写入用户购物车的商品行数
+	Dropped       int64                  `protobuf:"varint,4,opt,name=dropped,proto3" json:"dropped,omitempty"` // 已下架、售罄或已达上限而未合并的商品行数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGuestCartResp) Reset() {
+	*x = MergeGuestCartResp{}
+	mi := &file_cart_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestCartResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestCartResp) ProtoMessage() {}
+
+func (x *MergeGuestCartResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestCartResp.ProtoReflect.Descriptor instead.
+func (*MergeGuestCartResp) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MergeGuestCartResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *MergeGuestCartResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *MergeGuestCartResp) GetMerged() int64 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+func (x *MergeGuestCartResp) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+var File_cart_proto protoreflect.FileDescriptor
+
+const file_cart_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"cart.proto\x12\x04cart\"s\n" +
+	"\x12GetCartItemListReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\x12#\n" +
+	"\rselected_only\x18\x03 \x01(\bR\fselectedOnly\"\xc8\x01\n" +
+	"\x13GetCartItemListResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12&\n" +
+	"\x04data\x18\x04 \x03(\v2\x12.cart.CartInfoRespR\x04data\x123\n" +
+	"\vsaved_items\x18\x05 \x03(\v2\x12.cart.CartInfoRespR\n" +
+	"savedItems\"\xb6\x01\n" +
+	"\fCartInfoResp\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1a\n" +
+	"\bselected\x18\x05 \x01(\bR\bselected\x12&\n" +
+	"\x0fsaved_for_later\x18\x06 \x01(\bR\rsavedForLater\"i\n" +
+	"\x0eDelCartItemReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\vguest_token\x18\x03 \x01(\tR\n" +
+	"guestToken\"\x82\x01\n" +
+	"\x11CreateCartItemReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestToken\"\x82\x01\n" +
+	"\x11UpdateCartItemReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestToken\"j\n" +
+	"\fCartItemResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\"\x8b\x01\n" +
+	"\x12SelectCartItemsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\x03R\n" +
+	"productIds\x12\x1a\n" +
+	"\bselected\x18\x04 \x01(\bR\bselected\"\x82\x01\n" +
+	"\x0fSaveForLaterReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\x03R\n" +
+	"productIds\x12\x14\n" +
+	"\x05saved\x18\x04 \x01(\bR\x05saved\"t\n" +
+	"\x17BatchDeleteCartItemsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\x03R\n" +
+	"productIds\"k\n" +
+	"\rCartBatchResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1a\n" +
+	"\baffected\x18\x03 \x01(\x03R\baffected\"J\n" +
+	"\x0eGetCartViewReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\"\x9b\x03\n" +
+	"\bCartLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\apicture\x18\x05 \x01(\tR\apicture\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12\x1f\n" +
+	"\vadded_price\x18\a \x01(\x03R\n" +
+	"addedPrice\x12\x14\n" +
+	"\x05stock\x18\b \x01(\x03R\x05stock\x12\x1f\n" +
+	"\vmerchant_id\x18\t \x01(\x03R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06amount\x18\n" +
+	" \x01(\x03R\x06amount\x12#\n" +
+	"\rprice_changed\x18\v \x01(\bR\fpriceChanged\x12 \n" +
+	"\vunavailable\x18\f \x01(\bR\vunavailable\x12-\n" +
+	"\x12unavailable_reason\x18\r \x01(\tR\x11unavailableReason\x12\x1a\n" +
+	"\bselected\x18\x0e \x01(\bR\bselected\"v\n" +
+	"\x11CartMerchantGroup\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12$\n" +
+	"\x05lines\x18\x02 \x03(\v2\x0e.cart.CartLineR\x05lines\x12\x1a\n" +
+	"\bsubtotal\x18\x03 \x01(\x03R\bsubtotal\"\x91\x01\n" +
+	"\rCartPromotion\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\x0fdiscount_amount\x18\x04 \x01(\x03R\x0ediscountAmount\"\xd9\x01\n" +
+	"\fCartDiscount\x12'\n" +
+	"\x0fdiscount_amount\x18\x01 \x01(\x03R\x0ediscountAmount\x12)\n" +
+	"\x10promotion_amount\x18\x02 \x01(\x03R\x0fpromotionAmount\x12\x1b\n" +
+	"\tcoupon_id\x18\x03 \x01(\x03R\bcouponId\x12#\n" +
+	"\rcoupon_amount\x18\x04 \x01(\x03R\fcouponAmount\x123\n" +
+	"\n" +
+	"promotions\x18\x05 \x03(\v2\x13.cart.CartPromotionR\n" +
+	"promotions\"\x95\x03\n" +
+	"\x0fGetCartViewResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12/\n" +
+	"\x06groups\x18\x03 \x03(\v2\x17.cart.CartMerchantGroupR\x06groups\x12\x1a\n" +
+	"\bsubtotal\x18\x04 \x01(\x03R\bsubtotal\x12%\n" +
+	"\x0etotal_quantity\x18\x05 \x01(\x03R\rtotalQuantity\x127\n" +
+	"\rbest_discount\x18\x06 \x01(\v2\x12.cart.CartDiscountR\fbestDiscount\x12%\n" +
+	"\x0epayable_amount\x18\a \x01(\x03R\rpayableAmount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12/\n" +
+	"\vsaved_lines\x18\t \x03(\v2\x0e.cart.CartLineR\n" +
+	"savedLines\x12!\n" +
+	"\fall_selected\x18\n" +
+	" \x01(\bR\vallSelected\"M\n" +
+	"\x11MergeGuestCartReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\"\x86\x01\n" +
+	"\x12MergeGuestCartResp\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12\x1d\n" +
 	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg2\x8c\x02\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x16\n" +
+	"\x06merged\x18\x03 \x01(\x03R\x06merged\x12\x18\n" +
+	"\adropped\x18\x04 \x01(\x03R\adropped2\xd7\x04\n" +
 	"\vCartService\x12F\n" +
 	"\x0fGetCartItemList\x12\x18.cart.GetCartItemListReq\x1a\x19.cart.GetCartItemListResp\x12:\n" +
+	"\vGetCartView\x12\x14.cart.GetCartViewReq\x1a\x15.cart.GetCartViewResp\x12:\n" +
 	"\vAddCartItem\x12\x17.cart.CreateCartItemReq\x1a\x12.cart.CartItemResp\x12=\n" +
 	"\x0eUpdateCartItem\x12\x17.cart.UpdateCartItemReq\x1a\x12.cart.CartItemResp\x12:\n" +
-	"\x0eDeleteCartItem\x12\x14.cart.DelCartItemReq\x1a\x12.cart.CartItemRespB\bZ\x06./cartb\x06proto3"
+	"\x0eDeleteCartItem\x12\x14.cart.DelCartItemReq\x1a\x12.cart.CartItemResp\x12C\n" +
+	"\x0eMergeGuestCart\x12\x17.cart.MergeGuestCartReq\x1a\x18.cart.MergeGuestCartResp\x12@\n" +
+	"\x0fSelectCartItems\x12\x18.cart.SelectCartItemsReq\x1a\x13.cart.CartBatchResp\x12:\n" +
+	"\fSaveForLater\x12\x15.cart.SaveForLaterReq\x1a\x13.cart.CartBatchResp\x12J\n" +
+	"\x14BatchDeleteCartItems\x12\x1d.cart.BatchDeleteCartItemsReq\x1a\x13.cart.CartBatchRespB\bZ\x06./cartb\x06proto3"
 
 var (
 	file_cart_proto_rawDescOnce sync.Once
@@ -483,31 +1567,59 @@ func file_cart_proto_rawDescGZIP() []byte {
 	return file_cart_proto_rawDescData
 }
 
-var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_cart_proto_goTypes = []any{
-	(*GetCartItemListReq)(nil),  // 0: cart.GetCartItemListReq
-	(*GetCartItemListResp)(nil), // 1: cart.GetCartItemListResp
-	(*CartInfoResp)(nil),        // 2: cart.CartInfoResp
-	(*DelCartItemReq)(nil),      // 3: cart.DelCartItemReq
-	(*CreateCartItemReq)(nil),   // 4: cart.CreateCartItemReq
-	(*UpdateCartItemReq)(nil),   // 5: cart.UpdateCartItemReq
-	(*CartItemResp)(nil),        // 6: cart.CartItemResp
+	(*GetCartItemListReq)(nil),      // 0: cart.GetCartItemListReq
+	(*GetCartItemListResp)(nil),     // 1: cart.GetCartItemListResp
+	(*CartInfoResp)(nil),            // 2: cart.CartInfoResp
+	(*DelCartItemReq)(nil),          // 3: cart.DelCartItemReq
+	(*CreateCartItemReq)(nil),       // 4: cart.CreateCartItemReq
+	(*UpdateCartItemReq)(nil),       // 5: cart.UpdateCartItemReq
+	(*CartItemResp)(nil),            // 6: cart.CartItemResp
+	(*SelectCartItemsReq)(nil),      // 7: cart.SelectCartItemsReq
+	(*SaveForLaterReq)(nil),         // 8: cart.SaveForLaterReq
+	(*BatchDeleteCartItemsReq)(nil), // 9: cart.BatchDeleteCartItemsReq
+	(*CartBatchResp)(nil),           // 10: cart.CartBatchResp
+	(*GetCartViewReq)(nil),          // 11: cart.GetCartViewReq
+	(*CartLine)(nil),                // 12: cart.CartLine
+	(*CartMerchantGroup)(nil),       // 13: cart.CartMerchantGroup
+	(*CartPromotion)(nil),           // 14: cart.CartPromotion
+	(*CartDiscount)(nil),            // 15: cart.CartDiscount
+	(*GetCartViewResp)(nil),         // 16: cart.GetCartViewResp
+	(*MergeGuestCartReq)(nil),       // 17: cart.MergeGuestCartReq
+	(*MergeGuestCartResp)(nil),      // 18: cart.MergeGuestCartResp
 }
 var file_cart_proto_depIdxs = []int32{
-	2, // 0: cart.GetCartItemListResp.data:type_name -> cart.CartInfoResp
-	0, // 1: cart.CartService.GetCartItemList:input_type -> cart.GetCartItemListReq
-	4, // 2: cart.CartService.AddCartItem:input_type -> cart.CreateCartItemReq
-	5, // 3: cart.CartService.UpdateCartItem:input_type -> cart.UpdateCartItemReq
-	3, // 4: cart.CartService.DeleteCartItem:input_type -> cart.DelCartItemReq
-	1, // 5: cart.CartService.GetCartItemList:output_type -> cart.GetCartItemListResp
-	6, // 6: cart.CartService.AddCartItem:output_type -> cart.CartItemResp
-	6, // 7: cart.CartService.UpdateCartItem:output_type -> cart.CartItemResp
-	6, // 8: cart.CartService.DeleteCartItem:output_type -> cart.CartItemResp
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: cart.GetCartItemListResp.data:type_name -> cart.CartInfoResp
+	2,  // 1: cart.GetCartItemListResp.saved_items:type_name -> cart.CartInfoResp
+	12, // 2: cart.CartMerchantGroup.lines:type_name -> cart.CartLine
+	14, // 3: cart.CartDiscount.promotions:type_name -> cart.CartPromotion
+	13, // 4: cart.GetCartViewResp.groups:type_name -> cart.CartMerchantGroup
+	15, // 5: cart.GetCartViewResp.best_discount:type_name -> cart.CartDiscount
+	12, // 6: cart.GetCartViewResp.saved_lines:type_name -> cart.CartLine
+	0,  // 7: cart.CartService.GetCartItemList:input_type -> cart.GetCartItemListReq
+	11, // 8: cart.CartService.GetCartView:input_type -> cart.GetCartViewReq
+	4,  // 9: cart.CartService.AddCartItem:input_type -> cart.CreateCartItemReq
+	5,  // 10: cart.CartService.UpdateCartItem:input_type -> cart.UpdateCartItemReq
+	3,  // 11: cart.CartService.DeleteCartItem:input_type -> cart.DelCartItemReq
+	17, // 12: cart.CartService.MergeGuestCart:input_type -> cart.MergeGuestCartReq
+	7,  // 13: cart.CartService.SelectCartItems:input_type -> cart.SelectCartItemsReq
+	8,  // 14: cart.CartService.SaveForLater:input_type -> cart.SaveForLaterReq
+	9,  // 15: cart.CartService.BatchDeleteCartItems:input_type -> cart.BatchDeleteCartItemsReq
+	1,  // 16: cart.CartService.GetCartItemList:output_type -> cart.GetCartItemListResp
+	16, // 17: cart.CartService.GetCartView:output_type -> cart.GetCartViewResp
+	6,  // 18: cart.CartService.AddCartItem:output_type -> cart.CartItemResp
+	6,  // 19: cart.CartService.UpdateCartItem:output_type -> cart.CartItemResp
+	6,  // 20: cart.CartService.DeleteCartItem:output_type -> cart.CartItemResp
+	18, // 21: cart.CartService.MergeGuestCart:output_type -> cart.MergeGuestCartResp
+	10, // 22: cart.CartService.SelectCartItems:output_type -> cart.CartBatchResp
+	10, // 23: cart.CartService.SaveForLater:output_type -> cart.CartBatchResp
+	10, // 24: cart.CartService.BatchDeleteCartItems:output_type -> cart.CartBatchResp
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cart_proto_init() }
@@ -521,7 +1633,7 @@ func file_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_proto_rawDesc), len(file_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CartService_GetCartItemList_FullMethodName = "/cart.CartService/GetCartItemList"
-	CartService_AddCartItem_FullMethodName     = "/cart.CartService/AddCartItem"
-	CartService_UpdateCartItem_FullMethodName  = "/cart.CartService/UpdateCartItem"
-	CartService_DeleteCartItem_FullMethodName  = "/cart.CartService/DeleteCartItem"
+	CartService_GetCartItemList_FullMethodName      = "/cart.CartService/GetCartItemList"
+	CartService_GetCartView_FullMethodName          = "/cart.CartService/GetCartView"
+	CartService_AddCartItem_FullMethodName          = "/cart.CartService/AddCartItem"
+	CartService_UpdateCartItem_FullMethodName       = "/cart.CartService/UpdateCartItem"
+	CartService_DeleteCartItem_FullMethodName       = "/cart.CartService/DeleteCartItem"
+	CartService_MergeGuestCart_FullMethodName       = "/cart.CartService/MergeGuestCart"
+	CartService_SelectCartItems_FullMethodName      = "/cart.CartService/SelectCartItems"
+	CartService_SaveForLater_FullMethodName         = "/cart.CartService/SaveForLater"
+	CartService_BatchDeleteCartItems_FullMethodName = "/cart.CartService/BatchDeleteCartItems"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -31,12 +36,22 @@ const (
 type CartServiceClient interface {
 	// 用户购物车信息
 	GetCartItemList(ctx context.Context, in *GetCartItemListReq, opts ...grpc.CallOption) (*GetCartItemListResp, error)
-	// 添加到购物车
+	// 购物车详情：实时商品信息按商家分组，附带小计与最优优惠
+	GetCartView(ctx context.Context, in *GetCartViewReq, opts ...grpc.CallOption) (*GetCartViewResp, error)
+	// 添加到购物车，已存在时累加数量
 	AddCartItem(ctx context.Context, in *CreateCartItemReq, opts ...grpc.CallOption) (*CartItemResp, error)
 	// 改变商品数量
 	UpdateCartItem(ctx context.Context, in *UpdateCartItemReq, opts ...grpc.CallOption) (*CartItemResp, error)
 	// 删了
 	DeleteCartItem(ctx context.Context, in *DelCartItemReq, opts ...grpc.CallOption) (*CartItemResp, error)
+	// 登录时合并游客购物车
+	MergeGuestCart(ctx context.Context, in *MergeGuestCartReq, opts ...grpc.CallOption) (*MergeGuestCartResp, error)
+	// 勾选/取消勾选，不传商品时全选/全不选
+	SelectCartItems(ctx context.Context, in *SelectCartItemsReq, opts ...grpc.CallOption) (*CartBatchResp, error)
+	// 移入/移出稍后再买
+	SaveForLater(ctx context.Context, in *SaveForLaterReq, opts ...grpc.CallOption) (*CartBatchResp, error)
+	// 批量删除
+	BatchDeleteCartItems(ctx context.Context, in *BatchDeleteCartItemsReq, opts ...grpc.CallOption) (*CartBatchResp, error)
 }
 
 type cartServiceClient struct {
@@ -57,6 +72,16 @@ func (c *cartServiceClient) GetCartItemList(ctx context.Context, in *GetCartItem
 	return out, nil
 }
 
+func (c *cartServiceClient) GetCartView(ctx context.Context, in *GetCartViewReq, opts ...grpc.CallOption) (*GetCartViewResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartViewResp)
+	err := c.cc.Invoke(ctx, CartService_GetCartView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartServiceClient) AddCartItem(ctx context.Context, in *CreateCartItemReq, opts ...grpc.CallOption) (*CartItemResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CartItemResp)
@@ -87,18 +112,68 @@ func (c *cartServiceClient) DeleteCartItem(ctx context.Context, in *DelCartItemR
 	return out, nil
 }
 
+func (c *cartServiceClient) MergeGuestCart(ctx context.Context, in *MergeGuestCartReq, opts ...grpc.CallOption) (*MergeGuestCartResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeGuestCartResp)
+	err := c.cc.Invoke(ctx, CartService_MergeGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) SelectCartItems(ctx context.Context, in *SelectCartItemsReq, opts ...grpc.CallOption) (*CartBatchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartBatchResp)
+	err := c.cc.Invoke(ctx, CartService_SelectCartItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) SaveForLater(ctx context.Context, in *SaveForLaterReq, opts ...grpc.CallOption) (*CartBatchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartBatchResp)
+	err := c.cc.Invoke(ctx, CartService_SaveForLater_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) BatchDeleteCartItems(ctx context.Context, in *BatchDeleteCartItemsReq, opts ...grpc.CallOption) (*CartBatchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartBatchResp)
+	err := c.cc.Invoke(ctx, CartService_BatchDeleteCartItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
 type CartServiceServer interface {
 	// 用户购物车信息
 	GetCartItemList(context.Context, *GetCartItemListReq) (*GetCartItemListResp, error)
-	// 添加到购物车
+	// 购物车详情：实时商品信息按商家分组，附带小计与最优优惠
+	GetCartView(context.Context, *GetCartViewReq) (*GetCartViewResp, error)
+	// 添加到购物车，已存在时累加数量
 	AddCartItem(context.Context, *CreateCartItemReq) (*CartItemResp, error)
 	// 改变商品数量
 	UpdateCartItem(context.Context, *UpdateCartItemReq) (*CartItemResp, error)
 	// 删了
 	DeleteCartItem(context.Context, *DelCartItemReq) (*CartItemResp, error)
+	// 登录时合并游客购物车
+	MergeGuestCart(context.Context, *MergeGuestCartReq) (*MergeGuestCartResp, error)
+	// 勾选/取消勾选，不传商品时全选/全不选
+	SelectCartItems(context.Context, *SelectCartItemsReq) (*CartBatchResp, error)
+	// 移入/移出稍后再买
+	SaveForLater(context.Context, *SaveForLaterReq) (*CartBatchResp, error)
+	// 批量删除
+	BatchDeleteCartItems(context.Context, *BatchDeleteCartItemsReq) (*CartBatchResp, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
 
@@ -112,6 +187,9 @@ type UnimplementedCartServiceServer struct{}
 func (UnimplementedCartServiceServer) GetCartItemList(context.Context, *GetCartItemListReq) (*GetCartItemListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCartItemList not implemented")
 }
+func (UnimplementedCartServiceServer) GetCartView(context.Context, *GetCartViewReq) (*GetCartViewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCartView not implemented")
+}
 func (UnimplementedCartServiceServer) AddCartItem(context.Context, *CreateCartItemReq) (*CartItemResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCartItem not implemented")
 }
@@ -121,6 +199,18 @@ func (UnimplementedCartServiceServer) UpdateCartItem(context.Context, *UpdateCar
 func (UnimplementedCartServiceServer) DeleteCartItem(context.Context, *DelCartItemReq) (*CartItemResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCartItem not implemented")
 }
+func (UnimplementedCartServiceServer) MergeGuestCart(context.Context, *MergeGuestCartReq) (*MergeGuestCartResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuestCart not implemented")
+}
+func (UnimplementedCartServiceServer) SelectCartItems(context.Context, *SelectCartItemsReq) (*CartBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectCartItems not implemented")
+}
+func (UnimplementedCartServiceServer) SaveForLater(context.Context, *SaveForLaterReq) (*CartBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveForLater not implemented")
+}
+func (UnimplementedCartServiceServer) BatchDeleteCartItems(context.Context, *BatchDeleteCartItemsReq) (*CartBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteCartItems not implemented")
+}
 func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
 func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
 
@@ -160,6 +250,24 @@ func _CartService_GetCartItemList_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_GetCartView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartViewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetCartView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetCartView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetCartView(ctx, req.(*GetCartViewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CartService_AddCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCartItemReq)
 	if err := dec(in); err != nil {
@@ -214,6 +322,78 @@ func _CartService_DeleteCartItem_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_MergeGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MergeGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MergeGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MergeGuestCart(ctx, req.(*MergeGuestCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_SelectCartItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectCartItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).SelectCartItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_SelectCartItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).SelectCartItems(ctx, req.(*SelectCartItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_SaveForLater_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveForLaterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).SaveForLater(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_SaveForLater_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).SaveForLater(ctx, req.(*SaveForLaterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_BatchDeleteCartItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteCartItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).BatchDeleteCartItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_BatchDeleteCartItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).BatchDeleteCartItems(ctx, req.(*BatchDeleteCartItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +405,10 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCartItemList",
 			Handler:    _CartService_GetCartItemList_Handler,
 		},
+		{
+			MethodName: "GetCartView",
+			Handler:    _CartService_GetCartView_Handler,
+		},
 		{
 			MethodName: "AddCartItem",
 			Handler:    _CartService_AddCartItem_Handler,
@@ -237,6 +421,22 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCartItem",
 			Handler:    _CartService_DeleteCartItem_Handler,
 		},
+		{
+			MethodName: "MergeGuestCart",
+			Handler:    _CartService_MergeGuestCart_Handler,
+		},
+		{
+			MethodName: "SelectCartItems",
+			Handler:    _CartService_SelectCartItems_Handler,
+		},
+		{
+			MethodName: "SaveForLater",
+			Handler:    _CartService_SaveForLater_Handler,
+		},
+		{
+			MethodName: "BatchDeleteCartItems",
+			Handler:    _CartService_BatchDeleteCartItems_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cart.proto",
@@ -14,23 +14,45 @@ import (
 )
 
 type (
-	CartInfoResp        = cart.CartInfoResp
-	CartItemResp        = cart.CartItemResp
-	CreateCartItemReq   = cart.CreateCartItemReq
-	DelCartItemReq      = cart.DelCartItemReq
-	GetCartItemListReq  = cart.GetCartItemListReq
-	GetCartItemListResp = cart.GetCartItemListResp
-	UpdateCartItemReq   = cart.UpdateCartItemReq
+	BatchDeleteCartItemsReq = cart.BatchDeleteCartItemsReq
+	CartBatchResp           = cart.CartBatchResp
+	CartDiscount            = cart.CartDiscount
+	CartInfoResp            = cart.CartInfoResp
+	CartItemResp            = cart.CartItemResp
+	CartLine                = cart.CartLine
+	CartMerchantGroup       = cart.CartMerchantGroup
+	CartPromotion           = cart.CartPromotion
+	CreateCartItemReq       = cart.CreateCartItemReq
+	DelCartItemReq          = cart.DelCartItemReq
+	GetCartItemListReq      = cart.GetCartItemListReq
+	GetCartItemListResp     = cart.GetCartItemListResp
+	GetCartViewReq          = cart.GetCartViewReq
+	GetCartViewResp         = cart.GetCartViewResp
+	MergeGuestCartReq       = cart.MergeGuestCartReq
+	MergeGuestCartResp      = cart.MergeGuestCartResp
+	SaveForLaterReq         = cart.SaveForLaterReq
+	SelectCartItemsReq      = cart.SelectCartItemsReq
+	UpdateCartItemReq       = cart.UpdateCartItemReq
 
 	CartService interface {
 		// 用户购物车信息
 		GetCartItemList(ctx context.Context, in *GetCartItemListReq, opts ...grpc.CallOption) (*GetCartItemListResp, error)
-		// 添加到购物车
+		// 购物车详情：实时商品信息按商家分组，附带小计与最优优惠
+		GetCartView(ctx context.Context, in *GetCartViewReq, opts ...grpc.CallOption) (*GetCartViewResp, error)
+		// 添加到购物车，已存在时累加数量
 		AddCartItem(ctx context.Context, in *CreateCartItemReq, opts ...grpc.CallOption) (*CartItemResp, error)
 		// 改变商品数量
 		UpdateCartItem(ctx context.Context, in *UpdateCartItemReq, opts ...grpc.CallOption) (*CartItemResp, error)
 		// 删了
 		DeleteCartItem(ctx context.Context, in *DelCartItemReq, opts ...grpc.CallOption) (*CartItemResp, error)
+		// 登录时合并游客购物车
+		MergeGuestCart(ctx context.Context, in *MergeGuestCartReq, opts ...grpc.CallOption) (*MergeGuestCartResp, error)
+		// 勾选/取消勾选，不传商品时全选/全不选
+		SelectCartItems(ctx context.Context, in *SelectCartItemsReq, opts ...grpc.CallOption) (*CartBatchResp, error)
+		// 移入/移出稍后再买
+		SaveForLater(ctx context.Context, in *SaveForLaterReq, opts ...grpc.CallOption) (*CartBatchResp, error)
+		// 批量删除
+		BatchDeleteCartItems(ctx context.Context, in *BatchDeleteCartItemsReq, opts ...grpc.CallOption) (*CartBatchResp, error)
 	}
 
 	defaultCartService struct {
@@ -50,7 +72,13 @@ func (m *defaultCartService) GetCartItemList(ctx context.Context, in *GetCartIte
 	return client.GetCartItemList(ctx, in, opts...)
 }
 
-// 添加到购物车
+// 购物车详情：实时商品信息按商家分组，附带小计与最优优惠
+func (m *defaultCartService) GetCartView(ctx context.Context, in *GetCartViewReq, opts ...grpc.CallOption) (*GetCartViewResp, error) {
+	client := cart.NewCartServiceClient(m.cli.Conn())
+	return client.GetCartView(ctx, in, opts...)
+}
+
+// 添加到购物车，已存在时累加数量
 func (m *defaultCartService) AddCartItem(ctx context.Context, in *CreateCartItemReq, opts ...grpc.CallOption) (*CartItemResp, error) {
 	client := cart.NewCartServiceClient(m.cli.Conn())
 	return client.AddCartItem(ctx, in, opts...)
@@ -67,3 +95,27 @@ func (m *defaultCartService) DeleteCartItem(ctx context.Context, in *DelCartItem
 	client := cart.NewCartServiceClient(m.cli.Conn())
 	return client.DeleteCartItem(ctx, in, opts...)
 }
+
+// 登录时合并游客购物车
+func (m *defaultCartService) MergeGuestCart(ctx context.Context, in *MergeGuestCartReq, opts ...grpc.CallOption) (*MergeGuestCartResp, error) {
+	client := cart.NewCartServiceClient(m.cli.Conn())
+	return client.MergeGuestCart(ctx, in, opts...)
+}
+
+// 勾选/取消勾选，不传商品时全选/全不选
+func (m *defaultCartService) SelectCartItems(ctx context.Context, in *SelectCartItemsReq, opts ...grpc.CallOption) (*CartBatchResp, error) {
+	client := cart.NewCartServiceClient(m.cli.Conn())
+	return client.SelectCartItems(ctx, in, opts...)
+}
+
+// 移入/移出稍后再买
+func (m *defaultCartService) SaveForLater(ctx context.Context, in *SaveForLaterReq, opts ...grpc.CallOption) (*CartBatchResp, error) {
+	client := cart.NewCartServiceClient(m.cli.Conn())
+	return client.SaveForLater(ctx, in, opts...)
+}
+
+// 批量删除
+func (m *defaultCartService) BatchDeleteCartItems(ctx context.Context, in *BatchDeleteCartItemsReq, opts ...grpc.CallOption) (*CartBatchResp, error) {
+	client := cart.NewCartServiceClient(m.cli.Conn())
+	return client.BatchDeleteCartItems(ctx, in, opts...)
+}
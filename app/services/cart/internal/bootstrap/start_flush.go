@@ -0,0 +1,67 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/services/cart/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 每轮最多落库的批数，积压时留到下一轮，避免长时间占用数据库
+const flushMaxBatches = 50
+
+// StartFlush 定期把 Redis 购物车的变更批量写回 MySQL，停止时再落库一轮。
+func StartFlush(sc *svc.ServiceContext) func() {
+	conf := sc.Config.Store
+	interval := time.Duration(conf.FlushIntervalMillis) * time.Millisecond
+	if interval <= 0 {
+		interval = time.Second
+	}
+	batch := conf.FlushBatchSize
+	if batch <= 0 {
+		batch = 200
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				flush(ctx, sc, batch)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+		finalCtx, finalCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer finalCancel()
+		flush(finalCtx, sc, batch)
+	}
+}
+
+func flush(ctx context.Context, sc *svc.ServiceContext, batch int) {
+	var total int
+	for i := 0; i < flushMaxBatches; i++ {
+		n, err := sc.CartStore.Flush(ctx, batch)
+		total += n
+		if err != nil {
+			logx.WithContext(ctx).Errorf("cart flush failed: flushed=%d err=%v", total, err)
+			return
+		}
+		if n < batch {
+			break
+		}
+	}
+	if total > 0 {
+		logx.WithContext(ctx).Infof("cart flush done: users=%d", total)
+	}
+}
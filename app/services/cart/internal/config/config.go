@@ -12,6 +12,11 @@ import (
 type Config struct {
 	zrpc.RpcServerConf
 
+	// ProductRpc 查询商品实时价格、库存与商家
+	ProductRpc zrpc.RpcClientConf
+	// CouponRpc 计算购物车最优优惠，未配置时不返回优惠
+	CouponRpc zrpc.RpcClientConf `json:",optional"`
+
 	Consul consul.Conf
 
 	RedisConf redis.RedisConf
@@ -19,4 +24,26 @@ type Config struct {
 	CacheConf cache.CacheConf
 
 	LogConf logx.LogConf
+
+	Store StoreConf `json:",optional"`
+
+	Guest GuestConf `json:",optional"`
+}
+
+// StoreConf 购物车读写走 Redis，每 FlushIntervalMillis 毫秒把变更的用户购物车按 FlushBatchSize 分批写回 MySQL；
+// Redis 中的购物车 TTLHours 小时未访问后过期，再次访问时从 MySQL 重建。
+// 每个购物车（含游客购物车）最多 MaxLines 个商品行，单个商品数量不超过 Guest.MaxQuantityPerItem。
+type StoreConf struct {
+	TTLHours            int   `json:",default=168"`
+	FlushIntervalMillis int   `json:",default=1000"`
+	FlushBatchSize      int   `json:",default=200"`
+	MaxLines            int64 `json:",default=200"`
+}
+
+// GuestConf 游客购物车只存 Redis，TTLHours 小时未访问后过期。登录合并时同一商品按 MergeRule 处理：
+// SUM 累加数量，MAX 取两者较大值；合并后数量不超过库存与 MaxQuantityPerItem（单个商品的上限，加购时同样生效）。
+type GuestConf struct {
+	TTLHours           int    `json:",default=72"`
+	MergeRule          string `json:",default=SUM,options=SUM|MAX"`
+	MaxQuantityPerItem int64  `json:",default=99"`
 }
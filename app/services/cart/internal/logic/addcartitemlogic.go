@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
+	"NatsumeAI/app/common/snowflake"
 	cartmodel "NatsumeAI/app/dal/cart"
 	cartpb "NatsumeAI/app/services/cart/cart"
 	"NatsumeAI/app/services/cart/internal/svc"
+	productpb "NatsumeAI/app/services/product/product"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -25,40 +28,59 @@ func NewAddCartItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddCa
 	}
 }
 
-// 添加到购物车
+// 添加到购物车，已存在时累加数量
 func (l *AddCartItemLogic) AddCartItem(in *cartpb.CreateCartItemReq) (*cartpb.CartItemResp, error) {
 	resp := &cartpb.CartItemResp{
 		StatusCode: errno.InternalError,
 		StatusMsg:  "internal error",
 	}
 
-	if in == nil || in.UserId <= 0 || in.ProductId <= 0 {
+	owner, ok := cartOwner(in.GetUserId(), in.GetGuestToken())
+	if !ok || in.ProductId <= 0 || in.Count <= 0 {
 		resp.StatusCode = errno.InvalidParam
 		resp.StatusMsg = "invalid request payload"
 		return resp, nil
 	}
 
-	_, err := l.svcCtx.CartModel.FindOneByUserProduct(l.ctx, in.UserId, in.ProductId)
+	// 记录加入时的单价，购物车详情据此提示降价/涨价；已在购物车中时保留首次加入的单价
+	pr, err := l.svcCtx.ProductRpc.GetProduct(l.ctx, &productpb.GetProductReq{
+		ProductId: in.ProductId,
+		Currency:  fx.BaseCurrency,
+	})
+	if err != nil {
+		return resp, err
+	}
+	if pr.StatusCode != errno.StatusOK || pr.Product == nil {
+		resp.StatusCode = errno.ProductNotFound
+		resp.StatusMsg = "product not found"
+		return resp, nil
+	}
+
+	_, quantity, err := l.svcCtx.CartStore.Upsert(l.ctx, owner, &cartmodel.Cart{
+		Id:         snowflake.Next(),
+		UserId:     in.UserId,
+		ProductId:  in.ProductId,
+		Quantity:   in.Count,
+		AddedPrice: pr.Product.DisplayPrice,
+		Selected:   1,
+	})
 	switch err {
 	case nil:
-		l.Logger.Errorf("item exists")
-		resp.StatusCode = errno.ItemExistInCart
-		resp.StatusMsg = "item exist in cart"
+	case cartmodel.ErrCartFull:
+		resp.StatusCode = errno.CartFull
+		resp.StatusMsg = "cart is full"
+		return resp, nil
+	case cartmodel.ErrQuantityLimit:
+		resp.Quantity = quantity
+		resp.StatusCode = errno.CartQuantityLimit
+		resp.StatusMsg = "cart item quantity exceeds limit"
 		return resp, nil
-	case cartmodel.ErrNotFound:
-		_, err = l.svcCtx.CartModel.Insert(l.ctx, &cartmodel.Cart{
-			UserId:    in.UserId,
-			ProductId: in.ProductId,
-			Quantity:  in.Count,
-		})
-		if err != nil {
-			l.Logger.Errorf("add cart item insert failed: %v", err)
-			return resp, err
-		}
 	default:
+		l.Logger.Errorf("add cart item failed: %v", err)
 		return resp, err
 	}
 
+	resp.Quantity = quantity
 	resp.StatusCode = errno.StatusOK
 	resp.StatusMsg = "ok"
 	return resp, nil
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/services/cart/cart"
+	"NatsumeAI/app/services/cart/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteCartItemsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchDeleteCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteCartItemsLogic {
+	return &BatchDeleteCartItemsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量删除
+func (l *BatchDeleteCartItemsLogic) BatchDeleteCartItems(in *cart.BatchDeleteCartItemsReq) (*cart.CartBatchResp, error) {
+	resp := &cart.CartBatchResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  "internal error",
+	}
+
+	owner, ok := cartOwner(in.GetUserId(), in.GetGuestToken())
+	if !ok || !validProductIds(in.ProductIds, false) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid request payload"
+		return resp, nil
+	}
+
+	n, err := l.svcCtx.CartStore.Remove(l.ctx, owner, in.ProductIds...)
+	if err != nil {
+		l.Logger.Errorf("batch delete cart items failed: %v", err)
+		return resp, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Affected = n
+	return resp, nil
+}
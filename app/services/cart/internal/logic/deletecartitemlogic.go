@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"NatsumeAI/app/common/consts/errno"
-	cartmodel "NatsumeAI/app/dal/cart"
 	cartpb "NatsumeAI/app/services/cart/cart"
 	"NatsumeAI/app/services/cart/internal/svc"
 
@@ -32,26 +31,23 @@ func (l *DeleteCartItemLogic) DeleteCartItem(in *cartpb.DelCartItemReq) (*cartpb
 		StatusMsg:  "internal error",
 	}
 
-	if in == nil || in.UserId <= 0 || in.ProductId <= 0 {
+	owner, ok := cartOwner(in.GetUserId(), in.GetGuestToken())
+	if !ok || in.ProductId <= 0 {
 		resp.StatusCode = errno.InvalidParam
 		resp.StatusMsg = "invalid request payload"
 		return resp, nil
 	}
 
-	item, err := l.svcCtx.CartModel.FindOneByUserProduct(l.ctx, in.UserId, in.ProductId)
+	n, err := l.svcCtx.CartStore.Remove(l.ctx, owner, in.ProductId)
 	if err != nil {
-		if err == cartmodel.ErrNotFound {
-			resp.StatusCode = errno.CartItemNotFound
-			resp.StatusMsg = "cart item not found"
-			return resp, nil
-		}
-		return resp, err
-	}
-
-	if err := l.svcCtx.CartModel.Delete(l.ctx, item.Id); err != nil {
 		l.Logger.Errorf("delete cart item failed: %v", err)
 		return resp, err
 	}
+	if n == 0 {
+		resp.StatusCode = errno.CartItemNotFound
+		resp.StatusMsg = "cart item not found"
+		return resp, nil
+	}
 
 	resp.StatusCode = errno.StatusOK
 	resp.StatusMsg = "ok"
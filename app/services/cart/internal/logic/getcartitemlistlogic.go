@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"NatsumeAI/app/common/consts/errno"
-	cartmodel "NatsumeAI/app/dal/cart"
 	cartpb "NatsumeAI/app/services/cart/cart"
 	"NatsumeAI/app/services/cart/internal/svc"
 
@@ -32,37 +31,47 @@ func (l *GetCartItemListLogic) GetCartItemList(in *cartpb.GetCartItemListReq) (*
 		StatusMsg:  "internal error",
 	}
 
-	if in == nil || in.UserId <= 0 {
+	owner, ok := cartOwner(in.GetUserId(), in.GetGuestToken())
+	if !ok {
 		resp.StatusCode = errno.InvalidParam
 		resp.StatusMsg = "invalid request payload"
 		return resp, nil
 	}
 
-	items, err := l.svcCtx.CartModel.ListByUserId(l.ctx, in.UserId)
+	items, err := l.svcCtx.CartStore.List(l.ctx, owner)
 	if err != nil {
-		if err != cartmodel.ErrNotFound {
-			return resp, err
-		}
-		items = nil
+		return resp, err
 	}
 
 	respItems := make([]*cartpb.CartInfoResp, 0, len(items))
+	var saved []*cartpb.CartInfoResp
 	for _, item := range items {
 		if item == nil {
 			continue
 		}
-		respItems = append(respItems, &cartpb.CartInfoResp{
-			Id:        item.Id,
-			UserId:    item.UserId,
-			ProductId: item.ProductId,
-			Quantity:  item.Quantity,
-		})
+		info := &cartpb.CartInfoResp{
+			Id:            item.Id,
+			UserId:        item.UserId,
+			ProductId:     item.ProductId,
+			Quantity:      item.Quantity,
+			Selected:      item.Selected != 0,
+			SavedForLater: item.SavedForLater != 0,
+		}
+		switch {
+		case info.SavedForLater:
+			if !in.SelectedOnly {
+				saved = append(saved, info)
+			}
+		case info.Selected || !in.SelectedOnly:
+			respItems = append(respItems, info)
+		}
 	}
 
 	resp.StatusCode = errno.StatusOK
 	resp.StatusMsg = "ok"
 	resp.Total = int64(len(respItems))
 	resp.Data = respItems
+	resp.SavedItems = saved
 
 	return resp, nil
 }
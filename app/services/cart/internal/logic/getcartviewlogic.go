@@ -0,0 +1,251 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
+	cartmodel "NatsumeAI/app/dal/cart"
+	"NatsumeAI/app/services/cart/cart"
+	"NatsumeAI/app/services/cart/internal/svc"
+	couponpb "NatsumeAI/app/services/coupon/coupon"
+	productpb "NatsumeAI/app/services/product/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mr"
+)
+
+type GetCartViewLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCartViewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCartViewLogic {
+	return &GetCartViewLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 购物车详情：实时商品信息按商家分组，附带小计与最优优惠
+func (l *GetCartViewLogic) GetCartView(in *cart.GetCartViewReq) (*cart.GetCartViewResp, error) {
+	resp := &cart.GetCartViewResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  "internal error",
+	}
+
+	owner, ok := cartOwner(in.GetUserId(), in.GetGuestToken())
+	if !ok {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid request payload"
+		return resp, nil
+	}
+
+	items, err := l.svcCtx.CartStore.List(l.ctx, owner)
+	if err != nil {
+		return resp, err
+	}
+	built, err := l.buildLines(items)
+	if err != nil {
+		return resp, err
+	}
+
+	// 按商家分组，组的顺序与组内商品顺序保持购物车顺序；已下架或查询失败的商品归入 merchant_id 为 0 的组。
+	// 金额与优惠只按已勾选且可购买的商品计算，稍后再买的商品单独返回
+	groups := make(map[int64]*cart.CartMerchantGroup)
+	var order []int64
+	var lines []*couponpb.OrderLine
+	var active, selected int
+	for i, item := range items {
+		if item == nil {
+			continue
+		}
+		line, categories := built[i].line, built[i].categories
+		if item.SavedForLater != 0 {
+			resp.SavedLines = append(resp.SavedLines, line)
+			continue
+		}
+		active++
+		if line.Selected {
+			selected++
+		}
+		g, ok := groups[line.MerchantId]
+		if !ok {
+			g = &cart.CartMerchantGroup{MerchantId: line.MerchantId}
+			groups[line.MerchantId] = g
+			order = append(order, line.MerchantId)
+		}
+		g.Lines = append(g.Lines, line)
+		if line.Unavailable || !line.Selected {
+			continue
+		}
+		g.Subtotal += line.Amount
+		resp.Subtotal += line.Amount
+		resp.TotalQuantity += line.Quantity
+		lines = append(lines, &couponpb.OrderLine{
+			ProductId:  line.ProductId,
+			MerchantId: line.MerchantId,
+			Categories: categories,
+			Amount:     line.Amount,
+			Quantity:   line.Quantity,
+		})
+	}
+	for _, id := range order {
+		resp.Groups = append(resp.Groups, groups[id])
+	}
+
+	resp.AllSelected = active > 0 && selected == active
+	resp.BestDiscount = l.bestDiscount(in.UserId, lines)
+	resp.PayableAmount = resp.Subtotal - resp.BestDiscount.DiscountAmount
+	resp.Currency = fx.BaseCurrency
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
+
+type builtLine struct {
+	idx        int
+	line       *cart.CartLine
+	categories []string
+}
+
+// buildLines 并发查询各商品的实时信息，结果按购物车下标返回
+func (l *GetCartViewLogic) buildLines(items []*cartmodel.Cart) ([]builtLine, error) {
+	return mr.MapReduce(func(source chan<- int) {
+		for i, item := range items {
+			if item != nil {
+				source <- i
+			}
+		}
+	}, func(i int, writer mr.Writer[builtLine], cancel func(error)) {
+		line, categories := l.buildLine(items[i])
+		writer.Write(builtLine{idx: i, line: line, categories: categories})
+	}, func(pipe <-chan builtLine, writer mr.Writer[[]builtLine], cancel func(error)) {
+		built := make([]builtLine, len(items))
+		for b := range pipe {
+			built[b.idx] = b
+		}
+		writer.Write(built)
+	}, mr.WithContext(l.ctx))
+}
+
+// buildLine 查询商品实时信息，价格按 CNY 展示；商品不存在、库存不足或查询失败时标记为不可购买，不影响其他商品
+func (l *GetCartViewLogic) buildLine(item *cartmodel.Cart) (*cart.CartLine, []string) {
+	line := &cart.CartLine{
+		Id:         item.Id,
+		ProductId:  item.ProductId,
+		Quantity:   item.Quantity,
+		AddedPrice: item.AddedPrice,
+		Selected:   item.Selected != 0,
+	}
+	pr, err := l.svcCtx.ProductRpc.GetProduct(l.ctx, &productpb.GetProductReq{
+		ProductId: item.ProductId,
+		Currency:  fx.BaseCurrency,
+	})
+	if err != nil || pr.StatusCode != errno.StatusOK && pr.StatusCode != errno.ProductNotFound {
+		if err == nil {
+			err = fmt.Errorf("status %d: %s", pr.StatusCode, pr.StatusMsg)
+		}
+		l.Errorf("get cart product failed: product=%d err=%v", item.ProductId, err)
+		line.Unavailable = true
+		line.UnavailableReason = "商品信息暂时无法获取"
+		return line, nil
+	}
+	if pr.StatusCode == errno.ProductNotFound || pr.Product == nil {
+		line.Unavailable = true
+		line.UnavailableReason = "商品已下架"
+		return line, nil
+	}
+
+	p := pr.Product
+	line.Name = p.Name
+	line.Picture = p.Picture
+	line.Price = p.DisplayPrice
+	line.Stock = p.Stock
+	line.MerchantId = p.MerchantId
+	line.PriceChanged = item.AddedPrice > 0 && item.AddedPrice != p.DisplayPrice
+	switch {
+	case p.Stock <= 0:
+		line.Unavailable = true
+		line.UnavailableReason = "已售罄"
+	case p.Stock < item.Quantity:
+		line.Unavailable = true
+		line.UnavailableReason = fmt.Sprintf("库存不足，仅剩%d件", p.Stock)
+	default:
+		line.Amount = p.DisplayPrice * item.Quantity
+	}
+	return line, p.Categories
+}
+
+// bestDiscount 比较“仅促销”与“促销 + 最优券”两种组合，取优惠更大的；券服务不可用时不返回优惠，游客只计算促销
+func (l *GetCartViewLogic) bestDiscount(userId int64, lines []*couponpb.OrderLine) *cart.CartDiscount {
+	best := &cart.CartDiscount{}
+	if l.svcCtx.CouponRpc == nil || len(lines) == 0 {
+		return best
+	}
+
+	if pr, err := l.svcCtx.CouponRpc.EvaluatePromotions(l.ctx, &couponpb.EvaluatePromotionsReq{
+		UserId: userId,
+		Lines:  lines,
+	}); err != nil || pr.StatusCode != errno.StatusOK {
+		l.Errorf("evaluate cart promotions failed: user=%d err=%v", userId, err)
+	} else {
+		best = promotionDiscount(pr)
+	}
+	// 游客没有券，只计算促销
+	if userId <= 0 {
+		return best
+	}
+
+	pr, err := l.svcCtx.CouponRpc.EvaluatePromotions(l.ctx, &couponpb.EvaluatePromotionsReq{
+		UserId:     userId,
+		Lines:      lines,
+		WithCoupon: true,
+	})
+	if err != nil || pr.StatusCode != errno.StatusOK {
+		l.Errorf("evaluate cart promotions with coupon failed: user=%d err=%v", userId, err)
+		return best
+	}
+	// 与下单一致：券按扣除促销后的商品行计算
+	rec, err := l.svcCtx.CouponRpc.RecommendCoupons(l.ctx, &couponpb.RecommendCouponsReq{
+		UserId: userId,
+		Lines:  pr.Lines,
+	})
+	if err != nil || rec.StatusCode != errno.StatusOK {
+		l.Errorf("recommend cart coupons failed: user=%d err=%v", userId, err)
+		return best
+	}
+	for _, r := range rec.Recommendations {
+		if !r.Usable || r.Coupon == nil || r.Coupon.CouponId != rec.BestCouponId {
+			continue
+		}
+		withCoupon := promotionDiscount(pr)
+		withCoupon.CouponId = r.Coupon.CouponId
+		withCoupon.CouponAmount = r.DiscountAmount
+		withCoupon.DiscountAmount += r.DiscountAmount
+		if withCoupon.DiscountAmount > best.DiscountAmount {
+			best = withCoupon
+		}
+		break
+	}
+	return best
+}
+
+func promotionDiscount(pr *couponpb.EvaluatePromotionsResp) *cart.CartDiscount {
+	d := &cart.CartDiscount{
+		DiscountAmount:  pr.DiscountAmount,
+		PromotionAmount: pr.DiscountAmount,
+	}
+	for _, a := range pr.Applied {
+		d.Promotions = append(d.Promotions, &cart.CartPromotion{
+			PromotionId:    a.PromotionId,
+			Name:           a.Name,
+			Description:    a.Description,
+			DiscountAmount: a.DiscountAmount,
+		})
+	}
+	return d
+}
@@ -0,0 +1,160 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
+	"NatsumeAI/app/common/snowflake"
+	cartmodel "NatsumeAI/app/dal/cart"
+	"NatsumeAI/app/services/cart/cart"
+	"NatsumeAI/app/services/cart/internal/svc"
+	productpb "NatsumeAI/app/services/product/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const mergeRuleMax = "MAX"
+
+type MergeGuestCartLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMergeGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MergeGuestCartLogic {
+	return &MergeGuestCartLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 登录时合并游客购物车
+func (l *MergeGuestCartLogic) MergeGuestCart(in *cart.MergeGuestCartReq) (*cart.MergeGuestCartResp, error) {
+	resp := &cart.MergeGuestCartResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  "internal error",
+	}
+
+	if in == nil || in.UserId <= 0 || !validGuestToken(in.GuestToken) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid request payload"
+		return resp, nil
+	}
+
+	// 先取出并删除游客购物车，重复登录或并发请求只会合并一次
+	guestItems, err := l.svcCtx.CartStore.TakeGuest(l.ctx, in.GuestToken)
+	if err != nil {
+		return resp, err
+	}
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	if len(guestItems) == 0 {
+		return resp, nil
+	}
+
+	owner := cartmodel.UserCart(in.UserId)
+	userItems, err := l.svcCtx.CartStore.List(l.ctx, owner)
+	if err != nil {
+		l.restore(in.GuestToken, guestItems)
+		resp.StatusCode = errno.InternalError
+		resp.StatusMsg = "internal error"
+		return resp, err
+	}
+	existing := make(map[int64]*cartmodel.Cart, len(userItems))
+	for _, item := range userItems {
+		existing[item.ProductId] = item
+	}
+
+	lines := int64(len(existing))
+	for i, g := range guestItems {
+		mine := existing[g.ProductId]
+		// 用户购物车商品行已满时不再合入新商品
+		if mine == nil && l.svcCtx.Config.Store.MaxLines > 0 && lines >= l.svcCtx.Config.Store.MaxLines {
+			resp.Dropped++
+			continue
+		}
+		item, err := l.merge(mine, g)
+		if err != nil {
+			l.Logger.Errorf("merge guest cart failed: user=%d product=%d err=%v", in.UserId, g.ProductId, err)
+			l.restore(in.GuestToken, guestItems[i:])
+			resp.StatusCode = errno.InternalError
+			resp.StatusMsg = "internal error"
+			return resp, err
+		}
+		if item == nil {
+			resp.Dropped++
+			continue
+		}
+		item.UserId = in.UserId
+		if err := l.svcCtx.CartStore.Set(l.ctx, owner, item); err != nil {
+			l.Logger.Errorf("merge guest cart failed: user=%d product=%d err=%v", in.UserId, g.ProductId, err)
+			l.restore(in.GuestToken, guestItems[i:])
+			resp.StatusCode = errno.InternalError
+			resp.StatusMsg = "internal error"
+			return resp, err
+		}
+		if mine == nil {
+			lines++
+		}
+		resp.Merged++
+	}
+
+	return resp, nil
+}
+
+// merge 按配置的规则计算合并后的商品行，数量不超过库存与单商品上限，但不少于用户购物车中已有的数量；
+// 商品已下架或合并后数量没有增加时返回 nil
+func (l *MergeGuestCartLogic) merge(mine, guest *cartmodel.Cart) (*cartmodel.Cart, error) {
+	pr, err := l.svcCtx.ProductRpc.GetProduct(l.ctx, &productpb.GetProductReq{
+		ProductId: guest.ProductId,
+		Currency:  fx.BaseCurrency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pr.StatusCode != errno.StatusOK || pr.Product == nil {
+		return nil, nil
+	}
+
+	var have int64
+	if mine != nil {
+		have = mine.Quantity
+	}
+	target := have + guest.Quantity
+	if l.svcCtx.Config.Guest.MergeRule == mergeRuleMax {
+		target = max(have, guest.Quantity)
+	}
+	if limit := l.svcCtx.Config.Guest.MaxQuantityPerItem; limit > 0 {
+		target = min(target, limit)
+	}
+	target = max(min(target, pr.Product.Stock), have)
+	if target <= have {
+		return nil, nil
+	}
+
+	if mine != nil {
+		merged := *mine
+		merged.Quantity = target
+		return &merged, nil
+	}
+	return &cartmodel.Cart{
+		Id:            snowflake.Next(),
+		ProductId:     guest.ProductId,
+		Quantity:      target,
+		AddedPrice:    guest.AddedPrice,
+		Selected:      guest.Selected,
+		SavedForLater: guest.SavedForLater,
+	}, nil
+}
+
+// restore 合并失败时把未处理的商品放回游客购物车，下次登录再合并
+func (l *MergeGuestCartLogic) restore(token string, items []*cartmodel.Cart) {
+	owner := cartmodel.GuestCart(token)
+	for _, item := range items {
+		if err := l.svcCtx.CartStore.Set(l.ctx, owner, item); err != nil {
+			l.Logger.Errorf("restore guest cart failed: product=%d err=%v", item.ProductId, err)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"regexp"
+
+	cartmodel "NatsumeAI/app/dal/cart"
+)
+
+// 批量操作一次最多处理的商品数
+const maxBatchProducts = 200
+
+// 游客令牌由客户端生成（如 UUID），限制字符集以免拼入 Redis key 时越界
+var guestTokenPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{16,64}$`)
+
+func validGuestToken(token string) bool {
+	return guestTokenPattern.MatchString(token)
+}
+
+// cartOwner 已登录时使用用户购物车，否则使用游客令牌对应的购物车
+func cartOwner(userId int64, guestToken string) (cartmodel.Owner, bool) {
+	if userId > 0 {
+		return cartmodel.UserCart(userId), true
+	}
+	if validGuestToken(guestToken) {
+		return cartmodel.GuestCart(guestToken), true
+	}
+	return cartmodel.Owner{}, false
+}
+
+// validProductIds 批量操作的商品ID，allowEmpty 为 false 时不能为空
+func validProductIds(ids []int64, allowEmpty bool) bool {
+	if len(ids) > maxBatchProducts || (len(ids) == 0 && !allowEmpty) {
+		return false
+	}
+	for _, id := range ids {
+		if id <= 0 {
+			return false
+		}
+	}
+	return true
+}
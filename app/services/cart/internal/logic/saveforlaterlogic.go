@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/services/cart/cart"
+	"NatsumeAI/app/services/cart/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SaveForLaterLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSaveForLaterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveForLaterLogic {
+	return &SaveForLaterLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 移入/移出稍后再买
+func (l *SaveForLaterLogic) SaveForLater(in *cart.SaveForLaterReq) (*cart.CartBatchResp, error) {
+	resp := &cart.CartBatchResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  "internal error",
+	}
+
+	owner, ok := cartOwner(in.GetUserId(), in.GetGuestToken())
+	if !ok || !validProductIds(in.ProductIds, false) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid request payload"
+		return resp, nil
+	}
+
+	n, err := l.svcCtx.CartStore.SaveForLater(l.ctx, owner, in.ProductIds, in.Saved)
+	if err != nil {
+		l.Logger.Errorf("save cart items for later failed: %v", err)
+		return resp, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Affected = n
+	return resp, nil
+}
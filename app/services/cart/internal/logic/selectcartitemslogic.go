@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/services/cart/cart"
+	"NatsumeAI/app/services/cart/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SelectCartItemsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSelectCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectCartItemsLogic {
+	return &SelectCartItemsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 勾选/取消勾选，不传商品时全选/全不选
+func (l *SelectCartItemsLogic) SelectCartItems(in *cart.SelectCartItemsReq) (*cart.CartBatchResp, error) {
+	resp := &cart.CartBatchResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  "internal error",
+	}
+
+	owner, ok := cartOwner(in.GetUserId(), in.GetGuestToken())
+	if !ok || !validProductIds(in.ProductIds, true) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid request payload"
+		return resp, nil
+	}
+
+	n, err := l.svcCtx.CartStore.Select(l.ctx, owner, in.ProductIds, in.Selected)
+	if err != nil {
+		l.Logger.Errorf("select cart items failed: %v", err)
+		return resp, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Affected = n
+	return resp, nil
+}
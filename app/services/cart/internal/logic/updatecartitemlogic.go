@@ -32,38 +32,32 @@ func (l *UpdateCartItemLogic) UpdateCartItem(in *cartpb.UpdateCartItemReq) (*car
 		StatusMsg:  "internal error",
 	}
 
-	if in == nil || in.UserId <= 0 || in.ProductId <= 0 {
+	owner, ok := cartOwner(in.GetUserId(), in.GetGuestToken())
+	if !ok || in.ProductId <= 0 {
 		resp.StatusCode = errno.InvalidParam
 		resp.StatusMsg = "invalid request payload"
 		return resp, nil
 	}
 
-	item, err := l.svcCtx.CartModel.FindOneByUserProduct(l.ctx, in.UserId, in.ProductId)
+	// 数量减到 0 及以下时移出购物车
+	quantity, err := l.svcCtx.CartStore.Incr(l.ctx, owner, in.ProductId, in.Count)
 	if err != nil {
 		if err == cartmodel.ErrNotFound {
 			resp.StatusCode = errno.CartItemNotFound
 			resp.StatusMsg = "cart item not found"
 			return resp, nil
 		}
-		return resp, err
-	}
-
-	if item.Quantity + in.Count <= 0 {
-		if err := l.svcCtx.CartModel.Delete(l.ctx, item.Id); err != nil {
-			l.Logger.Errorf("update cart item delete failed: %v", err)
-			return resp, err
+		if err == cartmodel.ErrQuantityLimit {
+			resp.Quantity = quantity
+			resp.StatusCode = errno.CartQuantityLimit
+			resp.StatusMsg = "cart item quantity exceeds limit"
+			return resp, nil
 		}
-		resp.StatusCode = errno.StatusOK
-		resp.StatusMsg = "ok"
-		return resp, nil
-	}
-
-	item.Quantity += in.Count
-	if err := l.svcCtx.CartModel.Update(l.ctx, item); err != nil {
 		l.Logger.Errorf("update cart item failed: %v", err)
 		return resp, err
 	}
 
+	resp.Quantity = quantity
 	resp.StatusCode = errno.StatusOK
 	resp.StatusMsg = "ok"
 	return resp, nil
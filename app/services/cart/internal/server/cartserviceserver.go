@@ -29,7 +29,13 @@ func (s *CartServiceServer) GetCartItemList(ctx context.Context, in *cart.GetCar
 	return l.GetCartItemList(in)
 }
 
-// 添加到购物车
+// 购物车详情：实时商品信息按商家分组，附带小计与最优优惠
+func (s *CartServiceServer) GetCartView(ctx context.Context, in *cart.GetCartViewReq) (*cart.GetCartViewResp, error) {
+	l := logic.NewGetCartViewLogic(ctx, s.svcCtx)
+	return l.GetCartView(in)
+}
+
+// 添加到购物车，已存在时累加数量
 func (s *CartServiceServer) AddCartItem(ctx context.Context, in *cart.CreateCartItemReq) (*cart.CartItemResp, error) {
 	l := logic.NewAddCartItemLogic(ctx, s.svcCtx)
 	return l.AddCartItem(in)
@@ -46,3 +52,27 @@ func (s *CartServiceServer) DeleteCartItem(ctx context.Context, in *cart.DelCart
 	l := logic.NewDeleteCartItemLogic(ctx, s.svcCtx)
 	return l.DeleteCartItem(in)
 }
+
+// 登录时合并游客购物车
+func (s *CartServiceServer) MergeGuestCart(ctx context.Context, in *cart.MergeGuestCartReq) (*cart.MergeGuestCartResp, error) {
+	l := logic.NewMergeGuestCartLogic(ctx, s.svcCtx)
+	return l.MergeGuestCart(in)
+}
+
+// 勾选/取消勾选，不传商品时全选/全不选
+func (s *CartServiceServer) SelectCartItems(ctx context.Context, in *cart.SelectCartItemsReq) (*cart.CartBatchResp, error) {
+	l := logic.NewSelectCartItemsLogic(ctx, s.svcCtx)
+	return l.SelectCartItems(in)
+}
+
+// 移入/移出稍后再买
+func (s *CartServiceServer) SaveForLater(ctx context.Context, in *cart.SaveForLaterReq) (*cart.CartBatchResp, error) {
+	l := logic.NewSaveForLaterLogic(ctx, s.svcCtx)
+	return l.SaveForLater(in)
+}
+
+// 批量删除
+func (s *CartServiceServer) BatchDeleteCartItems(ctx context.Context, in *cart.BatchDeleteCartItemsReq) (*cart.CartBatchResp, error) {
+	l := logic.NewBatchDeleteCartItemsLogic(ctx, s.svcCtx)
+	return l.BatchDeleteCartItems(in)
+}
@@ -1,18 +1,29 @@
 package svc
 
 import (
+	"time"
+
 	"NatsumeAI/app/dal/cart"
 	"NatsumeAI/app/services/cart/internal/config"
+	couponsvc "NatsumeAI/app/services/coupon/couponservice"
+	productsvc "NatsumeAI/app/services/product/productservice"
 
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
 	Config config.Config
 	CartModel cart.CartModel
+	// CartStore 购物车读写入口，异步落库到 CartModel
+	CartStore cart.CartStoreModel
 
 	Redis *redis.Redis
+
+	ProductRpc productsvc.ProductService
+	// CouponRpc 未配置时为 nil
+	CouponRpc couponsvc.CouponService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -20,9 +31,20 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	if err != nil {
 		panic("fail to init redis")
 	}
+	var couponCli couponsvc.CouponService
+	if c.CouponRpc.Target != "" {
+		couponCli = couponsvc.NewCouponService(zrpc.MustNewClient(c.CouponRpc))
+	}
+	cartModel := cart.NewCartModel(sqlx.MustNewConn(c.MysqlConf), c.CacheConf)
 	return &ServiceContext{
 		Config: c,
-		CartModel:  cart.NewCartModel(sqlx.MustNewConn(c.MysqlConf), c.CacheConf),
+		CartModel:  cartModel,
+		CartStore:  cart.NewCartStoreModel(redisClient, cartModel, time.Duration(c.Store.TTLHours)*time.Hour, time.Duration(c.Guest.TTLHours)*time.Hour, cart.StoreLimits{
+			MaxLines:    c.Store.MaxLines,
+			MaxQuantity: c.Guest.MaxQuantityPerItem,
+		}),
 		Redis: redisClient,
+		ProductRpc: productsvc.NewProductService(zrpc.MustNewClient(c.ProductRpc)),
+		CouponRpc:  couponCli,
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/bootstrap"
 	"NatsumeAI/app/services/coupon/internal/config"
 	"NatsumeAI/app/services/coupon/internal/server"
 	"NatsumeAI/app/services/coupon/internal/svc"
@@ -35,6 +36,15 @@ func main() {
 		}
 	})
 
+	stopSweep := bootstrap.StartSweep(ctx)
+	defer stopSweep()
+	stopKafka := bootstrap.StartKafka(ctx)
+	defer stopKafka()
+	stopReconcile := bootstrap.StartReconcile(ctx)
+	defer stopReconcile()
+	stopCampaign := bootstrap.StartCampaign(ctx)
+	defer stopCampaign()
+
 	if err := consul.RegisterService(c.ListenOn, c.Consul); err != nil {
 		logx.Errorw("register service error", logx.Field("err", err))
 		panic(err)
@@ -125,6 +125,398 @@ func (CouponType) EnumDescriptor() ([]byte, []int) {
 	return file_coupon_proto_rawDescGZIP(), []int{1}
 }
 
+// 券的出资方，优惠成本由出资方承担
+type CouponIssuer int32
+
+const (
+	CouponIssuer_COUPON_ISSUER_UNKNOWN  CouponIssuer = 0
+	CouponIssuer_COUPON_ISSUER_PLATFORM CouponIssuer = 1
+	CouponIssuer_COUPON_ISSUER_MERCHANT CouponIssuer = 2
+)
+
+// Enum value maps for CouponIssuer.
+var (
+	CouponIssuer_name = map[int32]string{
+		0: "COUPON_ISSUER_UNKNOWN",
+		1: "COUPON_ISSUER_PLATFORM",
+		2: "COUPON_ISSUER_MERCHANT",
+	}
+	CouponIssuer_value = map[string]int32{
+		"COUPON_ISSUER_UNKNOWN":  0,
+		"COUPON_ISSUER_PLATFORM": 1,
+		"COUPON_ISSUER_MERCHANT": 2,
+	}
+)
+
+func (x CouponIssuer) Enum() *CouponIssuer {
+	p := new(CouponIssuer)
+	*p = x
+	return p
+}
+
+func (x CouponIssuer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CouponIssuer) Descriptor() protoreflect.EnumDescriptor {
+	return file_coupon_proto_enumTypes[2].Descriptor()
+}
+
+func (CouponIssuer) Type() protoreflect.EnumType {
+	return &file_coupon_proto_enumTypes[2]
+}
+
+func (x CouponIssuer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CouponIssuer.Descriptor instead.
+func (CouponIssuer) EnumDescriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{2}
+}
+
+// 自动发券活动触发方式
+type CampaignTrigger int32
+
+const (
+	CampaignTrigger_CAMPAIGN_TRIGGER_UNKNOWN     CampaignTrigger = 0
+	CampaignTrigger_CAMPAIGN_TRIGGER_REGISTER    CampaignTrigger = 1 // 注册成功
+	CampaignTrigger_CAMPAIGN_TRIGGER_FIRST_ORDER CampaignTrigger = 2 // 首单支付成功
+	CampaignTrigger_CAMPAIGN_TRIGGER_BIRTHDAY    CampaignTrigger = 3 // 生日当天，由调度任务执行
+	CampaignTrigger_CAMPAIGN_TRIGGER_SEGMENT     CampaignTrigger = 4 // 按人群一次性发放，由调度任务执行
+)
+
+// Enum value maps for CampaignTrigger.
+var (
+	CampaignTrigger_name = map[int32]string{
+		0: "CAMPAIGN_TRIGGER_UNKNOWN",
+		1: "CAMPAIGN_TRIGGER_REGISTER",
+		2: "CAMPAIGN_TRIGGER_FIRST_ORDER",
+		3: "CAMPAIGN_TRIGGER_BIRTHDAY",
+		4: "CAMPAIGN_TRIGGER_SEGMENT",
+	}
+	CampaignTrigger_value = map[string]int32{
+		"CAMPAIGN_TRIGGER_UNKNOWN":     0,
+		"CAMPAIGN_TRIGGER_REGISTER":    1,
+		"CAMPAIGN_TRIGGER_FIRST_ORDER": 2,
+		"CAMPAIGN_TRIGGER_BIRTHDAY":    3,
+		"CAMPAIGN_TRIGGER_SEGMENT":     4,
+	}
+)
+
+func (x CampaignTrigger) Enum() *CampaignTrigger {
+	p := new(CampaignTrigger)
+	*p = x
+	return p
+}
+
+func (x CampaignTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_coupon_proto_enumTypes[3].Descriptor()
+}
+
+func (CampaignTrigger) Type() protoreflect.EnumType {
+	return &file_coupon_proto_enumTypes[3]
+}
+
+func (x CampaignTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignTrigger.Descriptor instead.
+func (CampaignTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{3}
+}
+
+type CampaignStatus int32
+
+const (
+	CampaignStatus_CAMPAIGN_STATUS_UNKNOWN   CampaignStatus = 0
+	CampaignStatus_CAMPAIGN_STATUS_SCHEDULED CampaignStatus = 1
+	CampaignStatus_CAMPAIGN_STATUS_RUNNING   CampaignStatus = 2
+	CampaignStatus_CAMPAIGN_STATUS_COMPLETED CampaignStatus = 3
+	CampaignStatus_CAMPAIGN_STATUS_CANCELLED CampaignStatus = 4
+)
+
+// Enum value maps for CampaignStatus.
+var (
+	CampaignStatus_name = map[int32]string{
+		0: "CAMPAIGN_STATUS_UNKNOWN",
+		1: "CAMPAIGN_STATUS_SCHEDULED",
+		2: "CAMPAIGN_STATUS_RUNNING",
+		3: "CAMPAIGN_STATUS_COMPLETED",
+		4: "CAMPAIGN_STATUS_CANCELLED",
+	}
+	CampaignStatus_value = map[string]int32{
+		"CAMPAIGN_STATUS_UNKNOWN":   0,
+		"CAMPAIGN_STATUS_SCHEDULED": 1,
+		"CAMPAIGN_STATUS_RUNNING":   2,
+		"CAMPAIGN_STATUS_COMPLETED": 3,
+		"CAMPAIGN_STATUS_CANCELLED": 4,
+	}
+)
+
+func (x CampaignStatus) Enum() *CampaignStatus {
+	p := new(CampaignStatus)
+	*p = x
+	return p
+}
+
+func (x CampaignStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_coupon_proto_enumTypes[4].Descriptor()
+}
+
+func (CampaignStatus) Type() protoreflect.EnumType {
+	return &file_coupon_proto_enumTypes[4]
+}
+
+func (x CampaignStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignStatus.Descriptor instead.
+func (CampaignStatus) EnumDescriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{4}
+}
+
+// 兑换码类型
+type CodeType int32
+
+const (
+	CodeType_CODE_TYPE_UNKNOWN CodeType = 0
+	CodeType_CODE_TYPE_SHARED  CodeType = 1 // 公共码，多人可用，限总兑换次数
+	CodeType_CODE_TYPE_UNIQUE  CodeType = 2 // 一码一用，批量生成后导出
+)
+
+// Enum value maps for CodeType.
+var (
+	CodeType_name = map[int32]string{
+		0: "CODE_TYPE_UNKNOWN",
+		1: "CODE_TYPE_SHARED",
+		2: "CODE_TYPE_UNIQUE",
+	}
+	CodeType_value = map[string]int32{
+		"CODE_TYPE_UNKNOWN": 0,
+		"CODE_TYPE_SHARED":  1,
+		"CODE_TYPE_UNIQUE":  2,
+	}
+)
+
+func (x CodeType) Enum() *CodeType {
+	p := new(CodeType)
+	*p = x
+	return p
+}
+
+func (x CodeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CodeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_coupon_proto_enumTypes[5].Descriptor()
+}
+
+func (CodeType) Type() protoreflect.EnumType {
+	return &file_coupon_proto_enumTypes[5]
+}
+
+func (x CodeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CodeType.Descriptor instead.
+func (CodeType) EnumDescriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{5}
+}
+
+// 促销活动类型
+type PromotionType int32
+
+const (
+	PromotionType_PROMOTION_TYPE_UNKNOWN        PromotionType = 0
+	PromotionType_PROMOTION_TYPE_FULL_REDUCTION PromotionType = 1 // 满减，按阶梯取满足的最高档
+	PromotionType_PROMOTION_TYPE_MULTI_BUY      PromotionType = 2 // 多件折扣，满 N 件打折
+	PromotionType_PROMOTION_TYPE_BUNDLE         PromotionType = 3 // 组合价，组合内商品各 1 件为一组
+)
+
+// Enum value maps for PromotionType.
+var (
+	PromotionType_name = map[int32]string{
+		0: "PROMOTION_TYPE_UNKNOWN",
+		1: "PROMOTION_TYPE_FULL_REDUCTION",
+		2: "PROMOTION_TYPE_MULTI_BUY",
+		3: "PROMOTION_TYPE_BUNDLE",
+	}
+	PromotionType_value = map[string]int32{
+		"PROMOTION_TYPE_UNKNOWN":        0,
+		"PROMOTION_TYPE_FULL_REDUCTION": 1,
+		"PROMOTION_TYPE_MULTI_BUY":      2,
+		"PROMOTION_TYPE_BUNDLE":         3,
+	}
+)
+
+func (x PromotionType) Enum() *PromotionType {
+	p := new(PromotionType)
+	*p = x
+	return p
+}
+
+func (x PromotionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromotionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_coupon_proto_enumTypes[6].Descriptor()
+}
+
+func (PromotionType) Type() protoreflect.EnumType {
+	return &file_coupon_proto_enumTypes[6]
+}
+
+func (x PromotionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromotionType.Descriptor instead.
+func (PromotionType) EnumDescriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{6}
+}
+
+// 促销出资方，决定优惠成本由谁承担
+type PromotionFunder int32
+
+const (
+	PromotionFunder_PROMOTION_FUNDER_UNKNOWN  PromotionFunder = 0
+	PromotionFunder_PROMOTION_FUNDER_PLATFORM PromotionFunder = 1
+	PromotionFunder_PROMOTION_FUNDER_MERCHANT PromotionFunder = 2
+)
+
+// Enum value maps for PromotionFunder.
+var (
+	PromotionFunder_name = map[int32]string{
+		0: "PROMOTION_FUNDER_UNKNOWN",
+		1: "PROMOTION_FUNDER_PLATFORM",
+		2: "PROMOTION_FUNDER_MERCHANT",
+	}
+	PromotionFunder_value = map[string]int32{
+		"PROMOTION_FUNDER_UNKNOWN":  0,
+		"PROMOTION_FUNDER_PLATFORM": 1,
+		"PROMOTION_FUNDER_MERCHANT": 2,
+	}
+)
+
+func (x PromotionFunder) Enum() *PromotionFunder {
+	p := new(PromotionFunder)
+	*p = x
+	return p
+}
+
+func (x PromotionFunder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromotionFunder) Descriptor() protoreflect.EnumDescriptor {
+	return file_coupon_proto_enumTypes[7].Descriptor()
+}
+
+func (PromotionFunder) Type() protoreflect.EnumType {
+	return &file_coupon_proto_enumTypes[7]
+}
+
+func (x PromotionFunder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromotionFunder.Descriptor instead.
+func (PromotionFunder) EnumDescriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{7}
+}
+
+// 适用范围：商品、类目任一包含规则命中即可用（未配置包含规则时不限），
+// 商家限定与排除规则同时生效
+type CouponScope struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IncludeProductIds []int64                `protobuf:"varint,1,rep,packed,name=include_product_ids,json=includeProductIds,proto3" json:"include_product_ids,omitempty"`
+	ExcludeProductIds []int64                `protobuf:"varint,2,rep,packed,name=exclude_product_ids,json=excludeProductIds,proto3" json:"exclude_product_ids,omitempty"`
+	IncludeCategories []string               `protobuf:"bytes,3,rep,name=include_categories,json=includeCategories,proto3" json:"include_categories,omitempty"`
+	ExcludeCategories []string               `protobuf:"bytes,4,rep,name=exclude_categories,json=excludeCategories,proto3" json:"exclude_categories,omitempty"`
+	MerchantId        int64                  `protobuf:"varint,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"` // 仅限该商家商品，0 表示不限
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CouponScope) Reset() {
+	*x = CouponScope{}
+	mi := &file_coupon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponScope) ProtoMessage() {}
+
+func (x *CouponScope) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponScope.ProtoReflect.Descriptor instead.
+func (*CouponScope) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CouponScope) GetIncludeProductIds() []int64 {
+	if x != nil {
+		return x.IncludeProductIds
+	}
+	return nil
+}
+
+func (x *CouponScope) GetExcludeProductIds() []int64 {
+	if x != nil {
+		return x.ExcludeProductIds
+	}
+	return nil
+}
+
+func (x *CouponScope) GetIncludeCategories() []string {
+	if x != nil {
+		return x.IncludeCategories
+	}
+	return nil
+}
+
+func (x *CouponScope) GetExcludeCategories() []string {
+	if x != nil {
+		return x.ExcludeCategories
+	}
+	return nil
+}
+
+func (x *CouponScope) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
 type CouponInfo struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	CouponId        int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
@@ -137,15 +529,18 @@ type CouponInfo struct {
 	LockedPreorder  int64                  `protobuf:"varint,8,opt,name=locked_preorder,json=lockedPreorder,proto3" json:"locked_preorder,omitempty"`
 	StartAt         int64                  `protobuf:"varint,9,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
 	EndAt           int64                  `protobuf:"varint,10,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
-	Source          string                 `protobuf:"bytes,11,opt,name=source,proto3" json:"source,omitempty"`   // 来源
-	Remarks         string                 `protobuf:"bytes,12,opt,name=remarks,proto3" json:"remarks,omitempty"` // 备注
+	Source          string                 `protobuf:"bytes,11,opt,name=source,proto3" json:"source,omitempty"`                                                     // 来源
+	Remarks         string                 `protobuf:"bytes,12,opt,name=remarks,proto3" json:"remarks,omitempty"`                                                   // 备注
+	Scope           *CouponScope           `protobuf:"bytes,13,opt,name=scope,proto3" json:"scope,omitempty"`                                                       // 适用范围，全场券为空
+	IssuerType      CouponIssuer           `protobuf:"varint,14,opt,name=issuer_type,json=issuerType,proto3,enum=coupon.CouponIssuer" json:"issuer_type,omitempty"` // 出资方
+	IssuerId        int64                  `protobuf:"varint,15,opt,name=issuer_id,json=issuerId,proto3" json:"issuer_id,omitempty"`                                // 出资商家ID，平台券为0
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CouponInfo) Reset() {
 	*x = CouponInfo{}
-	mi := &file_coupon_proto_msgTypes[0]
+	mi := &file_coupon_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -157,7 +552,7 @@ func (x *CouponInfo) String() string {
 func (*CouponInfo) ProtoMessage() {}
 
 func (x *CouponInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[0]
+	mi := &file_coupon_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,7 +565,7 @@ func (x *CouponInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CouponInfo.ProtoReflect.Descriptor instead.
 func (*CouponInfo) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{0}
+	return file_coupon_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CouponInfo) GetCouponId() int64 {
@@ -257,6 +652,27 @@ func (x *CouponInfo) GetRemarks() string {
 	return ""
 }
 
+func (x *CouponInfo) GetScope() *CouponScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *CouponInfo) GetIssuerType() CouponIssuer {
+	if x != nil {
+		return x.IssuerType
+	}
+	return CouponIssuer_COUPON_ISSUER_UNKNOWN
+}
+
+func (x *CouponInfo) GetIssuerId() int64 {
+	if x != nil {
+		return x.IssuerId
+	}
+	return 0
+}
+
 type ClaimCouponReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -267,7 +683,7 @@ type ClaimCouponReq struct {
 
 func (x *ClaimCouponReq) Reset() {
 	*x = ClaimCouponReq{}
-	mi := &file_coupon_proto_msgTypes[1]
+	mi := &file_coupon_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +695,7 @@ func (x *ClaimCouponReq) String() string {
 func (*ClaimCouponReq) ProtoMessage() {}
 
 func (x *ClaimCouponReq) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[1]
+	mi := &file_coupon_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +708,7 @@ func (x *ClaimCouponReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimCouponReq.ProtoReflect.Descriptor instead.
 func (*ClaimCouponReq) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{1}
+	return file_coupon_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ClaimCouponReq) GetUserId() int64 {
@@ -319,7 +735,7 @@ type ClaimCouponResp struct {
 
 func (x *ClaimCouponResp) Reset() {
 	*x = ClaimCouponResp{}
-	mi := &file_coupon_proto_msgTypes[2]
+	mi := &file_coupon_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -331,7 +747,7 @@ func (x *ClaimCouponResp) String() string {
 func (*ClaimCouponResp) ProtoMessage() {}
 
 func (x *ClaimCouponResp) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[2]
+	mi := &file_coupon_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +760,7 @@ func (x *ClaimCouponResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimCouponResp.ProtoReflect.Descriptor instead.
 func (*ClaimCouponResp) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{2}
+	return file_coupon_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ClaimCouponResp) GetStatusCode() int64 {
@@ -373,7 +789,7 @@ type ListUserCouponsReq struct {
 
 func (x *ListUserCouponsReq) Reset() {
 	*x = ListUserCouponsReq{}
-	mi := &file_coupon_proto_msgTypes[3]
+	mi := &file_coupon_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -385,7 +801,7 @@ func (x *ListUserCouponsReq) String() string {
 func (*ListUserCouponsReq) ProtoMessage() {}
 
 func (x *ListUserCouponsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[3]
+	mi := &file_coupon_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +814,7 @@ func (x *ListUserCouponsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserCouponsReq.ProtoReflect.Descriptor instead.
 func (*ListUserCouponsReq) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{3}
+	return file_coupon_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListUserCouponsReq) GetUserId() int64 {
@@ -441,7 +857,7 @@ type ListUserCouponsResp struct {
 
 func (x *ListUserCouponsResp) Reset() {
 	*x = ListUserCouponsResp{}
-	mi := &file_coupon_proto_msgTypes[4]
+	mi := &file_coupon_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +869,7 @@ func (x *ListUserCouponsResp) String() string {
 func (*ListUserCouponsResp) ProtoMessage() {}
 
 func (x *ListUserCouponsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[4]
+	mi := &file_coupon_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +882,7 @@ func (x *ListUserCouponsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserCouponsResp.ProtoReflect.Descriptor instead.
 func (*ListUserCouponsResp) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{4}
+	return file_coupon_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListUserCouponsResp) GetStatusCode() int32 {
@@ -497,18 +913,96 @@ func (x *ListUserCouponsResp) GetTotal() int64 {
 	return 0
 }
 
-type ValidateCouponReq struct {
+// 订单商品行，用于按适用范围计算优惠
+type OrderLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
-	OrderAmount   int64                  `protobuf:"varint,3,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Categories    []string               `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`     // 行金额(分)
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"` // 件数，多件折扣、组合价使用，不传按 1 件计
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateCouponReq) Reset() {
-	*x = ValidateCouponReq{}
-	mi := &file_coupon_proto_msgTypes[5]
+func (x *OrderLine) Reset() {
+	*x = OrderLine{}
+	mi := &file_coupon_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderLine) ProtoMessage() {}
+
+func (x *OrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderLine.ProtoReflect.Descriptor instead.
+func (*OrderLine) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OrderLine) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *OrderLine) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *OrderLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OrderLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ValidateCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	OrderAmount   int64                  `protobuf:"varint,3,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	Lines         []*OrderLine           `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"` // 不传时整单视为一行，限定范围的券不可用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCouponReq) Reset() {
+	*x = ValidateCouponReq{}
+	mi := &file_coupon_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +1014,7 @@ func (x *ValidateCouponReq) String() string {
 func (*ValidateCouponReq) ProtoMessage() {}
 
 func (x *ValidateCouponReq) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[5]
+	mi := &file_coupon_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +1027,7 @@ func (x *ValidateCouponReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateCouponReq.ProtoReflect.Descriptor instead.
 func (*ValidateCouponReq) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{5}
+	return file_coupon_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ValidateCouponReq) GetUserId() int64 {
@@ -557,6 +1051,13 @@ func (x *ValidateCouponReq) GetOrderAmount() int64 {
 	return 0
 }
 
+func (x *ValidateCouponReq) GetLines() []*OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
 type ValidateCouponResp struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode     int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -564,13 +1065,16 @@ type ValidateCouponResp struct {
 	Valid          bool                   `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
 	DiscountAmount int64                  `protobuf:"varint,4,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
 	CouponType     CouponType             `protobuf:"varint,5,opt,name=coupon_type,json=couponType,proto3,enum=coupon.CouponType" json:"coupon_type,omitempty"`
+	EligibleAmount int64                  `protobuf:"varint,6,opt,name=eligible_amount,json=eligibleAmount,proto3" json:"eligible_amount,omitempty"`              // 参与优惠的商品金额(分)
+	IssuerType     CouponIssuer           `protobuf:"varint,7,opt,name=issuer_type,json=issuerType,proto3,enum=coupon.CouponIssuer" json:"issuer_type,omitempty"` // 出资方，结算时商家券的优惠由商家承担
+	IssuerId       int64                  `protobuf:"varint,8,opt,name=issuer_id,json=issuerId,proto3" json:"issuer_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ValidateCouponResp) Reset() {
 	*x = ValidateCouponResp{}
-	mi := &file_coupon_proto_msgTypes[6]
+	mi := &file_coupon_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -582,7 +1086,7 @@ func (x *ValidateCouponResp) String() string {
 func (*ValidateCouponResp) ProtoMessage() {}
 
 func (x *ValidateCouponResp) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[6]
+	mi := &file_coupon_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +1099,7 @@ func (x *ValidateCouponResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateCouponResp.ProtoReflect.Descriptor instead.
 func (*ValidateCouponResp) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{6}
+	return file_coupon_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ValidateCouponResp) GetStatusCode() int32 {
@@ -633,30 +1137,51 @@ func (x *ValidateCouponResp) GetCouponType() CouponType {
 	return CouponType_COUPON_TYPE_UNKNOWN
 }
 
-type LockCouponReq struct {
+func (x *ValidateCouponResp) GetEligibleAmount() int64 {
+	if x != nil {
+		return x.EligibleAmount
+	}
+	return 0
+}
+
+func (x *ValidateCouponResp) GetIssuerType() CouponIssuer {
+	if x != nil {
+		return x.IssuerType
+	}
+	return CouponIssuer_COUPON_ISSUER_UNKNOWN
+}
+
+func (x *ValidateCouponResp) GetIssuerId() int64 {
+	if x != nil {
+		return x.IssuerId
+	}
+	return 0
+}
+
+type RecommendCouponsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
-	OrderId       int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderAmount   int64                  `protobuf:"varint,2,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"` // 不传时按商品行金额汇总
+	Lines         []*OrderLine           `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LockCouponReq) Reset() {
-	*x = LockCouponReq{}
-	mi := &file_coupon_proto_msgTypes[7]
+func (x *RecommendCouponsReq) Reset() {
+	*x = RecommendCouponsReq{}
+	mi := &file_coupon_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LockCouponReq) String() string {
+func (x *RecommendCouponsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockCouponReq) ProtoMessage() {}
+func (*RecommendCouponsReq) ProtoMessage() {}
 
-func (x *LockCouponReq) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[7]
+func (x *RecommendCouponsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,55 +1192,59 @@ func (x *LockCouponReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockCouponReq.ProtoReflect.Descriptor instead.
-func (*LockCouponReq) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RecommendCouponsReq.ProtoReflect.Descriptor instead.
+func (*RecommendCouponsReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LockCouponReq) GetUserId() int64 {
+func (x *RecommendCouponsReq) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *LockCouponReq) GetCouponId() int64 {
+func (x *RecommendCouponsReq) GetOrderAmount() int64 {
 	if x != nil {
-		return x.CouponId
+		return x.OrderAmount
 	}
 	return 0
 }
 
-func (x *LockCouponReq) GetOrderId() int64 {
+func (x *RecommendCouponsReq) GetLines() []*OrderLine {
 	if x != nil {
-		return x.OrderId
+		return x.Lines
 	}
-	return 0
+	return nil
 }
 
-type LockCouponResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 单张券的推荐结果
+type CouponRecommendation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Coupon         *CouponInfo            `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Usable         bool                   `protobuf:"varint,2,opt,name=usable,proto3" json:"usable,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,3,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 可抵扣金额(分)
+	EligibleAmount int64                  `protobuf:"varint,4,opt,name=eligible_amount,json=eligibleAmount,proto3" json:"eligible_amount,omitempty"` // 参与优惠的商品金额(分)
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                        // 不可用原因
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *LockCouponResp) Reset() {
-	*x = LockCouponResp{}
-	mi := &file_coupon_proto_msgTypes[8]
+func (x *CouponRecommendation) Reset() {
+	*x = CouponRecommendation{}
+	mi := &file_coupon_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LockCouponResp) String() string {
+func (x *CouponRecommendation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockCouponResp) ProtoMessage() {}
+func (*CouponRecommendation) ProtoMessage() {}
 
-func (x *LockCouponResp) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[8]
+func (x *CouponRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,49 +1255,71 @@ func (x *LockCouponResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockCouponResp.ProtoReflect.Descriptor instead.
-func (*LockCouponResp) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CouponRecommendation.ProtoReflect.Descriptor instead.
+func (*CouponRecommendation) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *LockCouponResp) GetStatusCode() int32 {
+func (x *CouponRecommendation) GetCoupon() *CouponInfo {
 	if x != nil {
-		return x.StatusCode
+		return x.Coupon
+	}
+	return nil
+}
+
+func (x *CouponRecommendation) GetUsable() bool {
+	if x != nil {
+		return x.Usable
+	}
+	return false
+}
+
+func (x *CouponRecommendation) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
 	}
 	return 0
 }
 
-func (x *LockCouponResp) GetStatusMsg() string {
+func (x *CouponRecommendation) GetEligibleAmount() int64 {
 	if x != nil {
-		return x.StatusMsg
+		return x.EligibleAmount
+	}
+	return 0
+}
+
+func (x *CouponRecommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-type ReleaseCouponReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
-	OrderId       int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RecommendCouponsResp struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	StatusCode      int32                   `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg       string                  `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Recommendations []*CouponRecommendation `protobuf:"bytes,3,rep,name=recommendations,proto3" json:"recommendations,omitempty"`                  // 可用券按优惠金额降序在前，不可用券在后
+	BestCouponId    int64                   `protobuf:"varint,4,opt,name=best_coupon_id,json=bestCouponId,proto3" json:"best_coupon_id,omitempty"` // 优惠最大的券，0 表示无可用券
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ReleaseCouponReq) Reset() {
-	*x = ReleaseCouponReq{}
-	mi := &file_coupon_proto_msgTypes[9]
+func (x *RecommendCouponsResp) Reset() {
+	*x = RecommendCouponsResp{}
+	mi := &file_coupon_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseCouponReq) String() string {
+func (x *RecommendCouponsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseCouponReq) ProtoMessage() {}
+func (*RecommendCouponsResp) ProtoMessage() {}
 
-func (x *ReleaseCouponReq) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[9]
+func (x *RecommendCouponsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,55 +1330,64 @@ func (x *ReleaseCouponReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseCouponReq.ProtoReflect.Descriptor instead.
-func (*ReleaseCouponReq) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RecommendCouponsResp.ProtoReflect.Descriptor instead.
+func (*RecommendCouponsResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ReleaseCouponReq) GetUserId() int64 {
+func (x *RecommendCouponsResp) GetStatusCode() int32 {
 	if x != nil {
-		return x.UserId
+		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *ReleaseCouponReq) GetCouponId() int64 {
+func (x *RecommendCouponsResp) GetStatusMsg() string {
 	if x != nil {
-		return x.CouponId
+		return x.StatusMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *ReleaseCouponReq) GetOrderId() int64 {
+func (x *RecommendCouponsResp) GetRecommendations() []*CouponRecommendation {
 	if x != nil {
-		return x.OrderId
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *RecommendCouponsResp) GetBestCouponId() int64 {
+	if x != nil {
+		return x.BestCouponId
 	}
 	return 0
 }
 
-type ReleaseCouponResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type LockCouponReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CouponId       int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	OrderId        int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	EligibleAmount int64                  `protobuf:"varint,4,opt,name=eligible_amount,json=eligibleAmount,proto3" json:"eligible_amount,omitempty"` // 下单校验时参与优惠的商品金额(分)，限定范围的券核销时按此校验门槛
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ReleaseCouponResp) Reset() {
-	*x = ReleaseCouponResp{}
-	mi := &file_coupon_proto_msgTypes[10]
+func (x *LockCouponReq) Reset() {
+	*x = LockCouponReq{}
+	mi := &file_coupon_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseCouponResp) String() string {
+func (x *LockCouponReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseCouponResp) ProtoMessage() {}
+func (*LockCouponReq) ProtoMessage() {}
 
-func (x *ReleaseCouponResp) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[10]
+func (x *LockCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,38 +1398,216 @@ func (x *ReleaseCouponResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseCouponResp.ProtoReflect.Descriptor instead.
-func (*ReleaseCouponResp) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use LockCouponReq.ProtoReflect.Descriptor instead.
+func (*LockCouponReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ReleaseCouponResp) GetStatusCode() int32 {
+func (x *LockCouponReq) GetUserId() int64 {
 	if x != nil {
-		return x.StatusCode
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ReleaseCouponResp) GetStatusMsg() string {
+func (x *LockCouponReq) GetCouponId() int64 {
 	if x != nil {
-		return x.StatusMsg
+		return x.CouponId
 	}
-	return ""
+	return 0
 }
 
-type RedeemCouponReq struct {
+func (x *LockCouponReq) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *LockCouponReq) GetEligibleAmount() int64 {
+	if x != nil {
+		return x.EligibleAmount
+	}
+	return 0
+}
+
+type LockCouponResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
-	OrderId       int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	OrderAmount   int64                  `protobuf:"varint,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RedeemCouponReq) Reset() {
-	*x = RedeemCouponReq{}
-	mi := &file_coupon_proto_msgTypes[11]
+func (x *LockCouponResp) Reset() {
+	*x = LockCouponResp{}
+	mi := &file_coupon_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockCouponResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockCouponResp) ProtoMessage() {}
+
+func (x *LockCouponResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockCouponResp.ProtoReflect.Descriptor instead.
+func (*LockCouponResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LockCouponResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *LockCouponResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+type ReleaseCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCouponReq) Reset() {
+	*x = ReleaseCouponReq{}
+	mi := &file_coupon_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCouponReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCouponReq) ProtoMessage() {}
+
+func (x *ReleaseCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCouponReq.ProtoReflect.Descriptor instead.
+func (*ReleaseCouponReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReleaseCouponReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReleaseCouponReq) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *ReleaseCouponReq) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ReleaseCouponResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCouponResp) Reset() {
+	*x = ReleaseCouponResp{}
+	mi := &file_coupon_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCouponResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCouponResp) ProtoMessage() {}
+
+func (x *ReleaseCouponResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCouponResp.ProtoReflect.Descriptor instead.
+func (*ReleaseCouponResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReleaseCouponResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ReleaseCouponResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+type RedeemCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderAmount   int64                  `protobuf:"varint,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCouponReq) Reset() {
+	*x = RedeemCouponReq{}
+	mi := &file_coupon_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -881,7 +1619,7 @@ func (x *RedeemCouponReq) String() string {
 func (*RedeemCouponReq) ProtoMessage() {}
 
 func (x *RedeemCouponReq) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[11]
+	mi := &file_coupon_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1632,7 @@ func (x *RedeemCouponReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RedeemCouponReq.ProtoReflect.Descriptor instead.
 func (*RedeemCouponReq) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{11}
+	return file_coupon_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RedeemCouponReq) GetUserId() int64 {
@@ -935,7 +1673,7 @@ type RedeemCouponResp struct {
 
 func (x *RedeemCouponResp) Reset() {
 	*x = RedeemCouponResp{}
-	mi := &file_coupon_proto_msgTypes[12]
+	mi := &file_coupon_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -947,7 +1685,7 @@ func (x *RedeemCouponResp) String() string {
 func (*RedeemCouponResp) ProtoMessage() {}
 
 func (x *RedeemCouponResp) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[12]
+	mi := &file_coupon_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1698,7 @@ func (x *RedeemCouponResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RedeemCouponResp.ProtoReflect.Descriptor instead.
 func (*RedeemCouponResp) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{12}
+	return file_coupon_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RedeemCouponResp) GetStatusCode() int32 {
@@ -989,13 +1727,16 @@ type PublishCouponReq struct {
 	EndAt           int64                  `protobuf:"varint,8,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
 	Source          string                 `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
 	Remarks         string                 `protobuf:"bytes,10,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	Scope           *CouponScope           `protobuf:"bytes,11,opt,name=scope,proto3" json:"scope,omitempty"`
+	IssuerType      CouponIssuer           `protobuf:"varint,12,opt,name=issuer_type,json=issuerType,proto3,enum=coupon.CouponIssuer" json:"issuer_type,omitempty"` // 不传按平台券处理
+	IssuerId        int64                  `protobuf:"varint,13,opt,name=issuer_id,json=issuerId,proto3" json:"issuer_id,omitempty"`                                // 商家券为发券商家ID，适用范围强制限定为该商家商品
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PublishCouponReq) Reset() {
 	*x = PublishCouponReq{}
-	mi := &file_coupon_proto_msgTypes[13]
+	mi := &file_coupon_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1007,7 +1748,7 @@ func (x *PublishCouponReq) String() string {
 func (*PublishCouponReq) ProtoMessage() {}
 
 func (x *PublishCouponReq) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[13]
+	mi := &file_coupon_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1761,7 @@ func (x *PublishCouponReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishCouponReq.ProtoReflect.Descriptor instead.
 func (*PublishCouponReq) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{13}
+	return file_coupon_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PublishCouponReq) GetCouponType() CouponType {
@@ -1093,6 +1834,27 @@ func (x *PublishCouponReq) GetRemarks() string {
 	return ""
 }
 
+func (x *PublishCouponReq) GetScope() *CouponScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *PublishCouponReq) GetIssuerType() CouponIssuer {
+	if x != nil {
+		return x.IssuerType
+	}
+	return CouponIssuer_COUPON_ISSUER_UNKNOWN
+}
+
+func (x *PublishCouponReq) GetIssuerId() int64 {
+	if x != nil {
+		return x.IssuerId
+	}
+	return 0
+}
+
 type PublishCouponResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -1104,7 +1866,7 @@ type PublishCouponResp struct {
 
 func (x *PublishCouponResp) Reset() {
 	*x = PublishCouponResp{}
-	mi := &file_coupon_proto_msgTypes[14]
+	mi := &file_coupon_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1116,7 +1878,7 @@ func (x *PublishCouponResp) String() string {
 func (*PublishCouponResp) ProtoMessage() {}
 
 func (x *PublishCouponResp) ProtoReflect() protoreflect.Message {
-	mi := &file_coupon_proto_msgTypes[14]
+	mi := &file_coupon_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1891,7 @@ func (x *PublishCouponResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishCouponResp.ProtoReflect.Descriptor instead.
 func (*PublishCouponResp) Descriptor() ([]byte, []int) {
-	return file_coupon_proto_rawDescGZIP(), []int{14}
+	return file_coupon_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PublishCouponResp) GetStatusCode() int32 {
@@ -1153,71 +1915,3478 @@ func (x *PublishCouponResp) GetCampaignId() int64 {
 	return 0
 }
 
-var File_coupon_proto protoreflect.FileDescriptor
+// 券模板，供出资方管理
+type CouponTemplate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CouponId        int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	CouponType      CouponType             `protobuf:"varint,2,opt,name=coupon_type,json=couponType,proto3,enum=coupon.CouponType" json:"coupon_type,omitempty"`
+	DiscountAmount  int64                  `protobuf:"varint,3,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	DiscountPercent int32                  `protobuf:"varint,4,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	MinSpendAmount  int64                  `protobuf:"varint,5,opt,name=min_spend_amount,json=minSpendAmount,proto3" json:"min_spend_amount,omitempty"`
+	TotalIssue      int64                  `protobuf:"varint,6,opt,name=total_issue,json=totalIssue,proto3" json:"total_issue,omitempty"`
+	PerUserLimit    int64                  `protobuf:"varint,7,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	IssuedQuantity  int64                  `protobuf:"varint,8,opt,name=issued_quantity,json=issuedQuantity,proto3" json:"issued_quantity,omitempty"`
+	StartAt         int64                  `protobuf:"varint,9,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt           int64                  `protobuf:"varint,10,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Source          string                 `protobuf:"bytes,11,opt,name=source,proto3" json:"source,omitempty"`
+	Remarks         string                 `protobuf:"bytes,12,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	Scope           *CouponScope           `protobuf:"bytes,13,opt,name=scope,proto3" json:"scope,omitempty"`
+	IssuerType      CouponIssuer           `protobuf:"varint,14,opt,name=issuer_type,json=issuerType,proto3,enum=coupon.CouponIssuer" json:"issuer_type,omitempty"`
+	IssuerId        int64                  `protobuf:"varint,15,opt,name=issuer_id,json=issuerId,proto3" json:"issuer_id,omitempty"`
+	RevokedAt       int64                  `protobuf:"varint,16,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"` // 0 表示未撤销
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
 
-const file_coupon_proto_rawDesc = "" +
-	"\n" +
-	"\fcoupon.proto\x12\x06coupon\"\xb0\x03\n" +
-	"\n" +
-	"CouponInfo\x12\x1b\n" +
-	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x123\n" +
-	"\vcoupon_type\x18\x02 \x01(\x0e2\x12.coupon.CouponTypeR\n" +
-	"couponType\x12,\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x14.coupon.CouponStatusR\x06status\x12'\n" +
-	"\x0fdiscount_amount\x18\x04 \x01(\x03R\x0ediscountAmount\x12)\n" +
-	"\x10discount_percent\x18\x05 \x01(\x05R\x0fdiscountPercent\x12(\n" +
-	"\x10min_spend_amount\x18\x06 \x01(\x03R\x0eminSpendAmount\x12\x17\n" +
-	"\auser_id\x18\a \x01(\x03R\x06userId\x12'\n" +
-	"\x0flocked_preorder\x18\b \x01(\x03R\x0elockedPreorder\x12\x19\n" +
-	"\bstart_at\x18\t \x01(\x03R\astartAt\x12\x15\n" +
-	"\x06end_at\x18\n" +
-	" \x01(\x03R\x05endAt\x12\x16\n" +
-	"\x06source\x18\v \x01(\tR\x06source\x12\x18\n" +
-	"\aremarks\x18\f \x01(\tR\aremarks\"F\n" +
-	"\x0eClaimCouponReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\"Q\n" +
-	"\x0fClaimCouponResp\x12\x1f\n" +
-	"\vstatus_code\x18\x01 \x01(\x03R\n" +
-	"statusCode\x12\x1d\n" +
-	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"\x8c\x01\n" +
-	"\x12ListUserCouponsReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12,\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x14.coupon.CouponStatusR\x06status\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x99\x01\n" +
-	"\x13ListUserCouponsResp\x12\x1f\n" +
-	"\vstatus_code\x18\x01 \x01(\x05R\n" +
-	"statusCode\x12\x1d\n" +
-	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12,\n" +
-	"\acoupons\x18\x03 \x03(\v2\x12.coupon.CouponInfoR\acoupons\x12\x14\n" +
-	"\x05total\x18\x04 \x01(\x03R\x05total\"l\n" +
-	"\x11ValidateCouponReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12!\n" +
-	"\forder_amount\x18\x03 \x01(\x03R\vorderAmount\"\xc8\x01\n" +
-	"\x12ValidateCouponResp\x12\x1f\n" +
-	"\vstatus_code\x18\x01 \x01(\x05R\n" +
-	"statusCode\x12\x1d\n" +
-	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x14\n" +
-	"\x05valid\x18\x03 \x01(\bR\x05valid\x12'\n" +
-	"\x0fdiscount_amount\x18\x04 \x01(\x03R\x0ediscountAmount\x123\n" +
-	"\vcoupon_type\x18\x05 \x01(\x0e2\x12.coupon.CouponTypeR\n" +
-	"couponType\"`\n" +
-	"\rLockCouponReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12\x19\n" +
-	"\border_id\x18\x03 \x01(\x03R\aorderId\"P\n" +
-	"\x0eLockCouponResp\x12\x1f\n" +
-	"\vstatus_code\x18\x01 \x01(\x05R\n" +
-	"statusCode\x12\x1d\n" +
-	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"c\n" +
-	"\x10ReleaseCouponReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+func (x *CouponTemplate) Reset() {
+	*x = CouponTemplate{}
+	mi := &file_coupon_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplate) ProtoMessage() {}
+
+func (x *CouponTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplate.ProtoReflect.Descriptor instead.
+func (*CouponTemplate) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CouponTemplate) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetCouponType() CouponType {
+	if x != nil {
+		return x.CouponType
+	}
+	return CouponType_COUPON_TYPE_UNKNOWN
+}
+
+func (x *CouponTemplate) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetMinSpendAmount() int64 {
+	if x != nil {
+		return x.MinSpendAmount
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetTotalIssue() int64 {
+	if x != nil {
+		return x.TotalIssue
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetPerUserLimit() int64 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetIssuedQuantity() int64 {
+	if x != nil {
+		return x.IssuedQuantity
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetScope() *CouponScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *CouponTemplate) GetIssuerType() CouponIssuer {
+	if x != nil {
+		return x.IssuerType
+	}
+	return CouponIssuer_COUPON_ISSUER_UNKNOWN
+}
+
+func (x *CouponTemplate) GetIssuerId() int64 {
+	if x != nil {
+		return x.IssuerId
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+// 修改券模板，仅出资方可操作；issuer_type/issuer_id 为操作方身份
+type UpdateCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponId      int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	IssuerType    CouponIssuer           `protobuf:"varint,2,opt,name=issuer_type,json=issuerType,proto3,enum=coupon.CouponIssuer" json:"issuer_type,omitempty"`
+	IssuerId      int64                  `protobuf:"varint,3,opt,name=issuer_id,json=issuerId,proto3" json:"issuer_id,omitempty"`
+	TotalIssue    int64                  `protobuf:"varint,4,opt,name=total_issue,json=totalIssue,proto3" json:"total_issue,omitempty"` // 0 不限，不得低于已发放数量
+	PerUserLimit  int64                  `protobuf:"varint,5,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	EndAt         int64                  `protobuf:"varint,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Remarks       string                 `protobuf:"bytes,7,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCouponReq) Reset() {
+	*x = UpdateCouponReq{}
+	mi := &file_coupon_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCouponReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCouponReq) ProtoMessage() {}
+
+func (x *UpdateCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCouponReq.ProtoReflect.Descriptor instead.
+func (*UpdateCouponReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateCouponReq) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *UpdateCouponReq) GetIssuerType() CouponIssuer {
+	if x != nil {
+		return x.IssuerType
+	}
+	return CouponIssuer_COUPON_ISSUER_UNKNOWN
+}
+
+func (x *UpdateCouponReq) GetIssuerId() int64 {
+	if x != nil {
+		return x.IssuerId
+	}
+	return 0
+}
+
+func (x *UpdateCouponReq) GetTotalIssue() int64 {
+	if x != nil {
+		return x.TotalIssue
+	}
+	return 0
+}
+
+func (x *UpdateCouponReq) GetPerUserLimit() int64 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *UpdateCouponReq) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *UpdateCouponReq) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+type UpdateCouponResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCouponResp) Reset() {
+	*x = UpdateCouponResp{}
+	mi := &file_coupon_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCouponResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCouponResp) ProtoMessage() {}
+
+func (x *UpdateCouponResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCouponResp.ProtoReflect.Descriptor instead.
+func (*UpdateCouponResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCouponResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *UpdateCouponResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+// 撤销券模板，已领未用的券随之失效
+type RevokeCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponId      int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	IssuerType    CouponIssuer           `protobuf:"varint,2,opt,name=issuer_type,json=issuerType,proto3,enum=coupon.CouponIssuer" json:"issuer_type,omitempty"`
+	IssuerId      int64                  `protobuf:"varint,3,opt,name=issuer_id,json=issuerId,proto3" json:"issuer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCouponReq) Reset() {
+	*x = RevokeCouponReq{}
+	mi := &file_coupon_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCouponReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCouponReq) ProtoMessage() {}
+
+func (x *RevokeCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCouponReq.ProtoReflect.Descriptor instead.
+func (*RevokeCouponReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RevokeCouponReq) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *RevokeCouponReq) GetIssuerType() CouponIssuer {
+	if x != nil {
+		return x.IssuerType
+	}
+	return CouponIssuer_COUPON_ISSUER_UNKNOWN
+}
+
+func (x *RevokeCouponReq) GetIssuerId() int64 {
+	if x != nil {
+		return x.IssuerId
+	}
+	return 0
+}
+
+type RevokeCouponResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCouponResp) Reset() {
+	*x = RevokeCouponResp{}
+	mi := &file_coupon_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCouponResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCouponResp) ProtoMessage() {}
+
+func (x *RevokeCouponResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCouponResp.ProtoReflect.Descriptor instead.
+func (*RevokeCouponResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeCouponResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *RevokeCouponResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+type ListCouponTemplatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssuerType    CouponIssuer           `protobuf:"varint,1,opt,name=issuer_type,json=issuerType,proto3,enum=coupon.CouponIssuer" json:"issuer_type,omitempty"`
+	IssuerId      int64                  `protobuf:"varint,2,opt,name=issuer_id,json=issuerId,proto3" json:"issuer_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponTemplatesReq) Reset() {
+	*x = ListCouponTemplatesReq{}
+	mi := &file_coupon_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponTemplatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponTemplatesReq) ProtoMessage() {}
+
+func (x *ListCouponTemplatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponTemplatesReq.ProtoReflect.Descriptor instead.
+func (*ListCouponTemplatesReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListCouponTemplatesReq) GetIssuerType() CouponIssuer {
+	if x != nil {
+		return x.IssuerType
+	}
+	return CouponIssuer_COUPON_ISSUER_UNKNOWN
+}
+
+func (x *ListCouponTemplatesReq) GetIssuerId() int64 {
+	if x != nil {
+		return x.IssuerId
+	}
+	return 0
+}
+
+func (x *ListCouponTemplatesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponTemplatesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCouponTemplatesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Templates     []*CouponTemplate      `protobuf:"bytes,3,rep,name=templates,proto3" json:"templates,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponTemplatesResp) Reset() {
+	*x = ListCouponTemplatesResp{}
+	mi := &file_coupon_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponTemplatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponTemplatesResp) ProtoMessage() {}
+
+func (x *ListCouponTemplatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponTemplatesResp.ProtoReflect.Descriptor instead.
+func (*ListCouponTemplatesResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCouponTemplatesResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListCouponTemplatesResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListCouponTemplatesResp) GetTemplates() []*CouponTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListCouponTemplatesResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 人群圈选条件，未设置（0）的条件不参与过滤
+type CampaignSegment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MinOrderCount    int64                  `protobuf:"varint,1,opt,name=min_order_count,json=minOrderCount,proto3" json:"min_order_count,omitempty"`          // 已支付订单数下限
+	MaxOrderCount    int64                  `protobuf:"varint,2,opt,name=max_order_count,json=maxOrderCount,proto3" json:"max_order_count,omitempty"`          // 已支付订单数上限，-1 表示 0 单（未下过单）
+	MinSpend         int64                  `protobuf:"varint,3,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`                           // 累计消费下限(分)
+	ActiveWithinDays int32                  `protobuf:"varint,4,opt,name=active_within_days,json=activeWithinDays,proto3" json:"active_within_days,omitempty"` // 最近 N 天内登录过
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CampaignSegment) Reset() {
+	*x = CampaignSegment{}
+	mi := &file_coupon_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignSegment) ProtoMessage() {}
+
+func (x *CampaignSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignSegment.ProtoReflect.Descriptor instead.
+func (*CampaignSegment) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CampaignSegment) GetMinOrderCount() int64 {
+	if x != nil {
+		return x.MinOrderCount
+	}
+	return 0
+}
+
+func (x *CampaignSegment) GetMaxOrderCount() int64 {
+	if x != nil {
+		return x.MaxOrderCount
+	}
+	return 0
+}
+
+func (x *CampaignSegment) GetMinSpend() int64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *CampaignSegment) GetActiveWithinDays() int32 {
+	if x != nil {
+		return x.ActiveWithinDays
+	}
+	return 0
+}
+
+type CampaignInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    int64                  `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CouponId      int64                  `protobuf:"varint,3,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	Trigger       CampaignTrigger        `protobuf:"varint,4,opt,name=trigger,proto3,enum=coupon.CampaignTrigger" json:"trigger,omitempty"`
+	Segment       *CampaignSegment       `protobuf:"bytes,5,opt,name=segment,proto3" json:"segment,omitempty"`
+	Status        CampaignStatus         `protobuf:"varint,6,opt,name=status,proto3,enum=coupon.CampaignStatus" json:"status,omitempty"`
+	StartAt       int64                  `protobuf:"varint,7,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         int64                  `protobuf:"varint,8,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	ScannedCount  int64                  `protobuf:"varint,9,opt,name=scanned_count,json=scannedCount,proto3" json:"scanned_count,omitempty"`
+	IssuedCount   int64                  `protobuf:"varint,10,opt,name=issued_count,json=issuedCount,proto3" json:"issued_count,omitempty"`
+	SkippedCount  int64                  `protobuf:"varint,11,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"` // 不满足条件或已发过
+	FailedCount   int64                  `protobuf:"varint,12,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	LastError     string                 `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	FinishedAt    int64                  `protobuf:"varint,14,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignInfo) Reset() {
+	*x = CampaignInfo{}
+	mi := &file_coupon_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignInfo) ProtoMessage() {}
+
+func (x *CampaignInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignInfo.ProtoReflect.Descriptor instead.
+func (*CampaignInfo) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CampaignInfo) GetCampaignId() int64 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CampaignInfo) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetTrigger() CampaignTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return CampaignTrigger_CAMPAIGN_TRIGGER_UNKNOWN
+}
+
+func (x *CampaignInfo) GetSegment() *CampaignSegment {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+func (x *CampaignInfo) GetStatus() CampaignStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CampaignStatus_CAMPAIGN_STATUS_UNKNOWN
+}
+
+func (x *CampaignInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetScannedCount() int64 {
+	if x != nil {
+		return x.ScannedCount
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetIssuedCount() int64 {
+	if x != nil {
+		return x.IssuedCount
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *CampaignInfo) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	Trigger       CampaignTrigger        `protobuf:"varint,3,opt,name=trigger,proto3,enum=coupon.CampaignTrigger" json:"trigger,omitempty"`
+	Segment       *CampaignSegment       `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`                 // 仅 SEGMENT 使用
+	StartAt       int64                  `protobuf:"varint,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // SEGMENT 为计划执行时间
+	EndAt         int64                  `protobuf:"varint,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignReq) Reset() {
+	*x = CreateCampaignReq{}
+	mi := &file_coupon_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignReq) ProtoMessage() {}
+
+func (x *CreateCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignReq.ProtoReflect.Descriptor instead.
+func (*CreateCampaignReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateCampaignReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCampaignReq) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CreateCampaignReq) GetTrigger() CampaignTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return CampaignTrigger_CAMPAIGN_TRIGGER_UNKNOWN
+}
+
+func (x *CreateCampaignReq) GetSegment() *CampaignSegment {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+func (x *CreateCampaignReq) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *CreateCampaignReq) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+type CreateCampaignResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	CampaignId    int64                  `protobuf:"varint,3,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignResp) Reset() {
+	*x = CreateCampaignResp{}
+	mi := &file_coupon_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignResp) ProtoMessage() {}
+
+func (x *CreateCampaignResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignResp.ProtoReflect.Descriptor instead.
+func (*CreateCampaignResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateCampaignResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CreateCampaignResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CreateCampaignResp) GetCampaignId() int64 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+type CancelCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    int64                  `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCampaignReq) Reset() {
+	*x = CancelCampaignReq{}
+	mi := &file_coupon_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCampaignReq) ProtoMessage() {}
+
+func (x *CancelCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCampaignReq.ProtoReflect.Descriptor instead.
+func (*CancelCampaignReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CancelCampaignReq) GetCampaignId() int64 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+type CancelCampaignResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCampaignResp) Reset() {
+	*x = CancelCampaignResp{}
+	mi := &file_coupon_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCampaignResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCampaignResp) ProtoMessage() {}
+
+func (x *CancelCampaignResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCampaignResp.ProtoReflect.Descriptor instead.
+func (*CancelCampaignResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelCampaignResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CancelCampaignResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+type GetCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    int64                  `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignReq) Reset() {
+	*x = GetCampaignReq{}
+	mi := &file_coupon_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignReq) ProtoMessage() {}
+
+func (x *GetCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignReq.ProtoReflect.Descriptor instead.
+func (*GetCampaignReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetCampaignReq) GetCampaignId() int64 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+type GetCampaignResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Campaign      *CampaignInfo          `protobuf:"bytes,3,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignResp) Reset() {
+	*x = GetCampaignResp{}
+	mi := &file_coupon_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignResp) ProtoMessage() {}
+
+func (x *GetCampaignResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignResp.ProtoReflect.Descriptor instead.
+func (*GetCampaignResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCampaignResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetCampaignResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetCampaignResp) GetCampaign() *CampaignInfo {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type ListCampaignsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trigger       CampaignTrigger        `protobuf:"varint,1,opt,name=trigger,proto3,enum=coupon.CampaignTrigger" json:"trigger,omitempty"` // 不传不过滤
+	Status        CampaignStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=coupon.CampaignStatus" json:"status,omitempty"`    // 不传不过滤
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsReq) Reset() {
+	*x = ListCampaignsReq{}
+	mi := &file_coupon_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsReq) ProtoMessage() {}
+
+func (x *ListCampaignsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsReq.ProtoReflect.Descriptor instead.
+func (*ListCampaignsReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListCampaignsReq) GetTrigger() CampaignTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return CampaignTrigger_CAMPAIGN_TRIGGER_UNKNOWN
+}
+
+func (x *ListCampaignsReq) GetStatus() CampaignStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CampaignStatus_CAMPAIGN_STATUS_UNKNOWN
+}
+
+func (x *ListCampaignsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCampaignsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCampaignsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Campaigns     []*CampaignInfo        `protobuf:"bytes,3,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsResp) Reset() {
+	*x = ListCampaignsResp{}
+	mi := &file_coupon_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsResp) ProtoMessage() {}
+
+func (x *ListCampaignsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsResp.ProtoReflect.Descriptor instead.
+func (*ListCampaignsResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListCampaignsResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListCampaignsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListCampaignsResp) GetCampaigns() []*CampaignInfo {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+func (x *ListCampaignsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 用户事件触发发券，仅支持 REGISTER、FIRST_ORDER，同一活动对同一用户只发一次
+type TriggerCampaignsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trigger       CampaignTrigger        `protobuf:"varint,1,opt,name=trigger,proto3,enum=coupon.CampaignTrigger" json:"trigger,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerCampaignsReq) Reset() {
+	*x = TriggerCampaignsReq{}
+	mi := &file_coupon_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerCampaignsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerCampaignsReq) ProtoMessage() {}
+
+func (x *TriggerCampaignsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerCampaignsReq.ProtoReflect.Descriptor instead.
+func (*TriggerCampaignsReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TriggerCampaignsReq) GetTrigger() CampaignTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return CampaignTrigger_CAMPAIGN_TRIGGER_UNKNOWN
+}
+
+func (x *TriggerCampaignsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type TriggerCampaignsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Issued        int32                  `protobuf:"varint,3,opt,name=issued,proto3" json:"issued,omitempty"` // 本次发放的券数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerCampaignsResp) Reset() {
+	*x = TriggerCampaignsResp{}
+	mi := &file_coupon_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerCampaignsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerCampaignsResp) ProtoMessage() {}
+
+func (x *TriggerCampaignsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerCampaignsResp.ProtoReflect.Descriptor instead.
+func (*TriggerCampaignsResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TriggerCampaignsResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *TriggerCampaignsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *TriggerCampaignsResp) GetIssued() int32 {
+	if x != nil {
+		return x.Issued
+	}
+	return 0
+}
+
+type CodeBatchInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       int64                  `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CodeType      CodeType               `protobuf:"varint,4,opt,name=code_type,json=codeType,proto3,enum=coupon.CodeType" json:"code_type,omitempty"`
+	SharedCode    string                 `protobuf:"bytes,5,opt,name=shared_code,json=sharedCode,proto3" json:"shared_code,omitempty"`           // 仅公共码
+	TotalQuantity int64                  `protobuf:"varint,6,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"` // 公共码为兑换次数上限，一次性码为生成数量
+	RedeemedCount int64                  `protobuf:"varint,7,opt,name=redeemed_count,json=redeemedCount,proto3" json:"redeemed_count,omitempty"`
+	Disabled      bool                   `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CodeBatchInfo) Reset() {
+	*x = CodeBatchInfo{}
+	mi := &file_coupon_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeBatchInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeBatchInfo) ProtoMessage() {}
+
+func (x *CodeBatchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeBatchInfo.ProtoReflect.Descriptor instead.
+func (*CodeBatchInfo) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CodeBatchInfo) GetBatchId() int64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *CodeBatchInfo) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CodeBatchInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CodeBatchInfo) GetCodeType() CodeType {
+	if x != nil {
+		return x.CodeType
+	}
+	return CodeType_CODE_TYPE_UNKNOWN
+}
+
+func (x *CodeBatchInfo) GetSharedCode() string {
+	if x != nil {
+		return x.SharedCode
+	}
+	return ""
+}
+
+func (x *CodeBatchInfo) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *CodeBatchInfo) GetRedeemedCount() int64 {
+	if x != nil {
+		return x.RedeemedCount
+	}
+	return 0
+}
+
+func (x *CodeBatchInfo) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *CodeBatchInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateCodeBatchReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponId      int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CodeType      CodeType               `protobuf:"varint,3,opt,name=code_type,json=codeType,proto3,enum=coupon.CodeType" json:"code_type,omitempty"`
+	SharedCode    string                 `protobuf:"bytes,4,opt,name=shared_code,json=sharedCode,proto3" json:"shared_code,omitempty"` // 可选，公共码自定义内容（4-32 位字母数字），不传则随机生成
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                      // 公共码为兑换次数上限，一次性码为生成数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCodeBatchReq) Reset() {
+	*x = CreateCodeBatchReq{}
+	mi := &file_coupon_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCodeBatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCodeBatchReq) ProtoMessage() {}
+
+func (x *CreateCodeBatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCodeBatchReq.ProtoReflect.Descriptor instead.
+func (*CreateCodeBatchReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateCodeBatchReq) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CreateCodeBatchReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCodeBatchReq) GetCodeType() CodeType {
+	if x != nil {
+		return x.CodeType
+	}
+	return CodeType_CODE_TYPE_UNKNOWN
+}
+
+func (x *CreateCodeBatchReq) GetSharedCode() string {
+	if x != nil {
+		return x.SharedCode
+	}
+	return ""
+}
+
+func (x *CreateCodeBatchReq) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateCodeBatchResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Batch         *CodeBatchInfo         `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCodeBatchResp) Reset() {
+	*x = CreateCodeBatchResp{}
+	mi := &file_coupon_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCodeBatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCodeBatchResp) ProtoMessage() {}
+
+func (x *CreateCodeBatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCodeBatchResp.ProtoReflect.Descriptor instead.
+func (*CreateCodeBatchResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateCodeBatchResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CreateCodeBatchResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CreateCodeBatchResp) GetBatch() *CodeBatchInfo {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type GetCodeBatchReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       int64                  `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCodeBatchReq) Reset() {
+	*x = GetCodeBatchReq{}
+	mi := &file_coupon_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCodeBatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCodeBatchReq) ProtoMessage() {}
+
+func (x *GetCodeBatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCodeBatchReq.ProtoReflect.Descriptor instead.
+func (*GetCodeBatchReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetCodeBatchReq) GetBatchId() int64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+type GetCodeBatchResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Batch         *CodeBatchInfo         `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCodeBatchResp) Reset() {
+	*x = GetCodeBatchResp{}
+	mi := &file_coupon_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCodeBatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCodeBatchResp) ProtoMessage() {}
+
+func (x *GetCodeBatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCodeBatchResp.ProtoReflect.Descriptor instead.
+func (*GetCodeBatchResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCodeBatchResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetCodeBatchResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetCodeBatchResp) GetBatch() *CodeBatchInfo {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+// 停用批次后该批次的码均不可再兑换
+type DisableCodeBatchReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       int64                  `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableCodeBatchReq) Reset() {
+	*x = DisableCodeBatchReq{}
+	mi := &file_coupon_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableCodeBatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableCodeBatchReq) ProtoMessage() {}
+
+func (x *DisableCodeBatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableCodeBatchReq.ProtoReflect.Descriptor instead.
+func (*DisableCodeBatchReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DisableCodeBatchReq) GetBatchId() int64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+type DisableCodeBatchResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableCodeBatchResp) Reset() {
+	*x = DisableCodeBatchResp{}
+	mi := &file_coupon_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableCodeBatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableCodeBatchResp) ProtoMessage() {}
+
+func (x *DisableCodeBatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableCodeBatchResp.ProtoReflect.Descriptor instead.
+func (*DisableCodeBatchResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DisableCodeBatchResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *DisableCodeBatchResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+// 导出一次性码，CSV 列：code,status,user_id,redeemed_at
+type ExportCodeBatchReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       int64                  `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCodeBatchReq) Reset() {
+	*x = ExportCodeBatchReq{}
+	mi := &file_coupon_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCodeBatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCodeBatchReq) ProtoMessage() {}
+
+func (x *ExportCodeBatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCodeBatchReq.ProtoReflect.Descriptor instead.
+func (*ExportCodeBatchReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportCodeBatchReq) GetBatchId() int64 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+type ExportCodeBatchResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCodeBatchResp) Reset() {
+	*x = ExportCodeBatchResp{}
+	mi := &file_coupon_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCodeBatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCodeBatchResp) ProtoMessage() {}
+
+func (x *ExportCodeBatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCodeBatchResp.ProtoReflect.Descriptor instead.
+func (*ExportCodeBatchResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportCodeBatchResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ExportCodeBatchResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ExportCodeBatchResp) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportCodeBatchResp) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// 用户输入兑换码领券，连续输错达到上限后暂时禁止兑换
+type RedeemCodeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // 不区分大小写，忽略空格与连字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCodeReq) Reset() {
+	*x = RedeemCodeReq{}
+	mi := &file_coupon_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCodeReq) ProtoMessage() {}
+
+func (x *RedeemCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCodeReq.ProtoReflect.Descriptor instead.
+func (*RedeemCodeReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RedeemCodeReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RedeemCodeReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RedeemCodeResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Coupon        *CouponInfo            `protobuf:"bytes,3,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCodeResp) Reset() {
+	*x = RedeemCodeResp{}
+	mi := &file_coupon_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCodeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCodeResp) ProtoMessage() {}
+
+func (x *RedeemCodeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCodeResp.ProtoReflect.Descriptor instead.
+func (*RedeemCodeResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RedeemCodeResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *RedeemCodeResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *RedeemCodeResp) GetCoupon() *CouponInfo {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// 券效果统计，时间范围按券实例发放时间过滤，不传表示不限
+type GetCouponStatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponIds     []int64                `protobuf:"varint,1,rep,packed,name=coupon_ids,json=couponIds,proto3" json:"coupon_ids,omitempty"` // 券模板ID，最多 50 个
+	StartAt       int64                  `protobuf:"varint,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         int64                  `protobuf:"varint,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	IssuerType    CouponIssuer           `protobuf:"varint,4,opt,name=issuer_type,json=issuerType,proto3,enum=coupon.CouponIssuer" json:"issuer_type,omitempty"` // 调用方身份，只能查询自己出资的券
+	IssuerId      int64                  `protobuf:"varint,5,opt,name=issuer_id,json=issuerId,proto3" json:"issuer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouponStatsReq) Reset() {
+	*x = GetCouponStatsReq{}
+	mi := &file_coupon_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponStatsReq) ProtoMessage() {}
+
+func (x *GetCouponStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponStatsReq.ProtoReflect.Descriptor instead.
+func (*GetCouponStatsReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetCouponStatsReq) GetCouponIds() []int64 {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+func (x *GetCouponStatsReq) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReq) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReq) GetIssuerType() CouponIssuer {
+	if x != nil {
+		return x.IssuerType
+	}
+	return CouponIssuer_COUPON_ISSUER_UNKNOWN
+}
+
+func (x *GetCouponStatsReq) GetIssuerId() int64 {
+	if x != nil {
+		return x.IssuerId
+	}
+	return 0
+}
+
+type CouponStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CouponId       int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	TotalQuantity  int64                  `protobuf:"varint,2,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`    // 发行总量，0 表示不限
+	IssuedQuantity int64                  `protobuf:"varint,3,opt,name=issued_quantity,json=issuedQuantity,proto3" json:"issued_quantity,omitempty"` // 累计发放量（不受时间范围限制）
+	Claimed        int64                  `protobuf:"varint,4,opt,name=claimed,proto3" json:"claimed,omitempty"`                                     // 时间范围内发放的券实例数
+	Unused         int64                  `protobuf:"varint,5,opt,name=unused,proto3" json:"unused,omitempty"`
+	Locked         int64                  `protobuf:"varint,6,opt,name=locked,proto3" json:"locked,omitempty"`
+	Used           int64                  `protobuf:"varint,7,opt,name=used,proto3" json:"used,omitempty"`
+	Expired        int64                  `protobuf:"varint,8,opt,name=expired,proto3" json:"expired,omitempty"`                                      // 含模板已结束但未置为过期的未使用券
+	RedemptionRate float64                `protobuf:"fixed64,9,opt,name=redemption_rate,json=redemptionRate,proto3" json:"redemption_rate,omitempty"` // used / claimed
+	DiscountAmount int64                  `protobuf:"varint,10,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 已支付订单的优惠金额(分)
+	Gmv            int64                  `protobuf:"varint,11,opt,name=gmv,proto3" json:"gmv,omitempty"`                                             // 已支付订单的应付金额(分)
+	OrderCount     int64                  `protobuf:"varint,12,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CouponStats) Reset() {
+	*x = CouponStats{}
+	mi := &file_coupon_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponStats) ProtoMessage() {}
+
+func (x *CouponStats) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponStats.ProtoReflect.Descriptor instead.
+func (*CouponStats) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CouponStats) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CouponStats) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *CouponStats) GetIssuedQuantity() int64 {
+	if x != nil {
+		return x.IssuedQuantity
+	}
+	return 0
+}
+
+func (x *CouponStats) GetClaimed() int64 {
+	if x != nil {
+		return x.Claimed
+	}
+	return 0
+}
+
+func (x *CouponStats) GetUnused() int64 {
+	if x != nil {
+		return x.Unused
+	}
+	return 0
+}
+
+func (x *CouponStats) GetLocked() int64 {
+	if x != nil {
+		return x.Locked
+	}
+	return 0
+}
+
+func (x *CouponStats) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *CouponStats) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *CouponStats) GetRedemptionRate() float64 {
+	if x != nil {
+		return x.RedemptionRate
+	}
+	return 0
+}
+
+func (x *CouponStats) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *CouponStats) GetGmv() int64 {
+	if x != nil {
+		return x.Gmv
+	}
+	return 0
+}
+
+func (x *CouponStats) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+type GetCouponStatsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Stats         []*CouponStats         `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouponStatsResp) Reset() {
+	*x = GetCouponStatsResp{}
+	mi := &file_coupon_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponStatsResp) ProtoMessage() {}
+
+func (x *GetCouponStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponStatsResp.ProtoReflect.Descriptor instead.
+func (*GetCouponStatsResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetCouponStatsResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetCouponStatsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetCouponStatsResp) GetStats() []*CouponStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 满 threshold 减 discount(分)
+type PromotionTier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threshold     int64                  `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Discount      int64                  `protobuf:"varint,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionTier) Reset() {
+	*x = PromotionTier{}
+	mi := &file_coupon_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionTier) ProtoMessage() {}
+
+func (x *PromotionTier) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionTier.ProtoReflect.Descriptor instead.
+func (*PromotionTier) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PromotionTier) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *PromotionTier) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+// 满 min_quantity 件优惠 off_percent%
+type MultiBuyStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinQuantity   int64                  `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	OffPercent    int32                  `protobuf:"varint,2,opt,name=off_percent,json=offPercent,proto3" json:"off_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiBuyStep) Reset() {
+	*x = MultiBuyStep{}
+	mi := &file_coupon_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiBuyStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiBuyStep) ProtoMessage() {}
+
+func (x *MultiBuyStep) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiBuyStep.ProtoReflect.Descriptor instead.
+func (*MultiBuyStep) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MultiBuyStep) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *MultiBuyStep) GetOffPercent() int32 {
+	if x != nil {
+		return x.OffPercent
+	}
+	return 0
+}
+
+// 优惠规则，product_ids、categories 均为空表示不限商品（组合价必须指定 product_ids）
+type PromotionRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tiers         []*PromotionTier       `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`                                 // 满减
+	Steps         []*MultiBuyStep        `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`                                 // 多件折扣
+	BundlePrice   int64                  `protobuf:"varint,3,opt,name=bundle_price,json=bundlePrice,proto3" json:"bundle_price,omitempty"` // 组合价(分)，每组价格
+	ProductIds    []int64                `protobuf:"varint,4,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionRule) Reset() {
+	*x = PromotionRule{}
+	mi := &file_coupon_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionRule) ProtoMessage() {}
+
+func (x *PromotionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionRule.ProtoReflect.Descriptor instead.
+func (*PromotionRule) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PromotionRule) GetTiers() []*PromotionTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *PromotionRule) GetSteps() []*MultiBuyStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PromotionRule) GetBundlePrice() int64 {
+	if x != nil {
+		return x.BundlePrice
+	}
+	return 0
+}
+
+func (x *PromotionRule) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *PromotionRule) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type PromotionInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId     int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PromoType       PromotionType          `protobuf:"varint,3,opt,name=promo_type,json=promoType,proto3,enum=coupon.PromotionType" json:"promo_type,omitempty"`
+	FundedBy        PromotionFunder        `protobuf:"varint,4,opt,name=funded_by,json=fundedBy,proto3,enum=coupon.PromotionFunder" json:"funded_by,omitempty"`
+	MerchantId      int64                  `protobuf:"varint,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Rule            *PromotionRule         `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`
+	MutexGroup      string                 `protobuf:"bytes,7,opt,name=mutex_group,json=mutexGroup,proto3" json:"mutex_group,omitempty"`
+	StackWithCoupon bool                   `protobuf:"varint,8,opt,name=stack_with_coupon,json=stackWithCoupon,proto3" json:"stack_with_coupon,omitempty"`
+	Priority        int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	Disabled        bool                   `protobuf:"varint,10,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	StartAt         int64                  `protobuf:"varint,11,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt           int64                  `protobuf:"varint,12,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PromotionInfo) Reset() {
+	*x = PromotionInfo{}
+	mi := &file_coupon_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionInfo) ProtoMessage() {}
+
+func (x *PromotionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionInfo.ProtoReflect.Descriptor instead.
+func (*PromotionInfo) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PromotionInfo) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromotionInfo) GetPromoType() PromotionType {
+	if x != nil {
+		return x.PromoType
+	}
+	return PromotionType_PROMOTION_TYPE_UNKNOWN
+}
+
+func (x *PromotionInfo) GetFundedBy() PromotionFunder {
+	if x != nil {
+		return x.FundedBy
+	}
+	return PromotionFunder_PROMOTION_FUNDER_UNKNOWN
+}
+
+func (x *PromotionInfo) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetRule() *PromotionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *PromotionInfo) GetMutexGroup() string {
+	if x != nil {
+		return x.MutexGroup
+	}
+	return ""
+}
+
+func (x *PromotionInfo) GetStackWithCoupon() bool {
+	if x != nil {
+		return x.StackWithCoupon
+	}
+	return false
+}
+
+func (x *PromotionInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *PromotionInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreatePromotionReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PromoType       PromotionType          `protobuf:"varint,2,opt,name=promo_type,json=promoType,proto3,enum=coupon.PromotionType" json:"promo_type,omitempty"`
+	FundedBy        PromotionFunder        `protobuf:"varint,3,opt,name=funded_by,json=fundedBy,proto3,enum=coupon.PromotionFunder" json:"funded_by,omitempty"`
+	MerchantId      int64                  `protobuf:"varint,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"` // 商家出资必填
+	Rule            *PromotionRule         `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	MutexGroup      string                 `protobuf:"bytes,6,opt,name=mutex_group,json=mutexGroup,proto3" json:"mutex_group,omitempty"`                   // 同组活动互斥，只取优惠最大的一个
+	StackWithCoupon bool                   `protobuf:"varint,7,opt,name=stack_with_coupon,json=stackWithCoupon,proto3" json:"stack_with_coupon,omitempty"` // 为 false 时用户使用优惠券则不参与
+	Priority        int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`                                        // 越大越先计算，后计算的活动基于已优惠后的金额
+	StartAt         int64                  `protobuf:"varint,9,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt           int64                  `protobuf:"varint,10,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePromotionReq) Reset() {
+	*x = CreatePromotionReq{}
+	mi := &file_coupon_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionReq) ProtoMessage() {}
+
+func (x *CreatePromotionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionReq.ProtoReflect.Descriptor instead.
+func (*CreatePromotionReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreatePromotionReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePromotionReq) GetPromoType() PromotionType {
+	if x != nil {
+		return x.PromoType
+	}
+	return PromotionType_PROMOTION_TYPE_UNKNOWN
+}
+
+func (x *CreatePromotionReq) GetFundedBy() PromotionFunder {
+	if x != nil {
+		return x.FundedBy
+	}
+	return PromotionFunder_PROMOTION_FUNDER_UNKNOWN
+}
+
+func (x *CreatePromotionReq) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreatePromotionReq) GetRule() *PromotionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *CreatePromotionReq) GetMutexGroup() string {
+	if x != nil {
+		return x.MutexGroup
+	}
+	return ""
+}
+
+func (x *CreatePromotionReq) GetStackWithCoupon() bool {
+	if x != nil {
+		return x.StackWithCoupon
+	}
+	return false
+}
+
+func (x *CreatePromotionReq) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreatePromotionReq) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *CreatePromotionReq) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+type CreatePromotionResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Promotion     *PromotionInfo         `protobuf:"bytes,3,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResp) Reset() {
+	*x = CreatePromotionResp{}
+	mi := &file_coupon_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResp) ProtoMessage() {}
+
+func (x *CreatePromotionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResp.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreatePromotionResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CreatePromotionResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CreatePromotionResp) GetPromotion() *PromotionInfo {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type DisablePromotionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisablePromotionReq) Reset() {
+	*x = DisablePromotionReq{}
+	mi := &file_coupon_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisablePromotionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisablePromotionReq) ProtoMessage() {}
+
+func (x *DisablePromotionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisablePromotionReq.ProtoReflect.Descriptor instead.
+func (*DisablePromotionReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DisablePromotionReq) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+type DisablePromotionResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisablePromotionResp) Reset() {
+	*x = DisablePromotionResp{}
+	mi := &file_coupon_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisablePromotionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisablePromotionResp) ProtoMessage() {}
+
+func (x *DisablePromotionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisablePromotionResp.ProtoReflect.Descriptor instead.
+func (*DisablePromotionResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DisablePromotionResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *DisablePromotionResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+type ListPromotionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"` // -1 表示不过滤，0 表示平台活动
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsReq) Reset() {
+	*x = ListPromotionsReq{}
+	mi := &file_coupon_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsReq) ProtoMessage() {}
+
+func (x *ListPromotionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsReq.ProtoReflect.Descriptor instead.
+func (*ListPromotionsReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListPromotionsReq) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ListPromotionsReq) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListPromotionsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPromotionsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPromotionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Promotions    []*PromotionInfo       `protobuf:"bytes,3,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResp) Reset() {
+	*x = ListPromotionsResp{}
+	mi := &file_coupon_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResp) ProtoMessage() {}
+
+func (x *ListPromotionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResp.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListPromotionsResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListPromotionsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListPromotionsResp) GetPromotions() []*PromotionInfo {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+func (x *ListPromotionsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 命中的促销活动及优惠说明
+type AppliedPromotion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId    int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PromoType      PromotionType          `protobuf:"varint,3,opt,name=promo_type,json=promoType,proto3,enum=coupon.PromotionType" json:"promo_type,omitempty"`
+	FundedBy       PromotionFunder        `protobuf:"varint,4,opt,name=funded_by,json=fundedBy,proto3,enum=coupon.PromotionFunder" json:"funded_by,omitempty"`
+	MerchantId     int64                  `protobuf:"varint,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Description    string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"` // 如"满200减30"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AppliedPromotion) Reset() {
+	*x = AppliedPromotion{}
+	mi := &file_coupon_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedPromotion) ProtoMessage() {}
+
+func (x *AppliedPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedPromotion.ProtoReflect.Descriptor instead.
+func (*AppliedPromotion) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AppliedPromotion) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *AppliedPromotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppliedPromotion) GetPromoType() PromotionType {
+	if x != nil {
+		return x.PromoType
+	}
+	return PromotionType_PROMOTION_TYPE_UNKNOWN
+}
+
+func (x *AppliedPromotion) GetFundedBy() PromotionFunder {
+	if x != nil {
+		return x.FundedBy
+	}
+	return PromotionFunder_PROMOTION_FUNDER_UNKNOWN
+}
+
+func (x *AppliedPromotion) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *AppliedPromotion) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *AppliedPromotion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 未命中的促销活动及原因
+type SkippedPromotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedPromotion) Reset() {
+	*x = SkippedPromotion{}
+	mi := &file_coupon_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedPromotion) ProtoMessage() {}
+
+func (x *SkippedPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedPromotion.ProtoReflect.Descriptor instead.
+func (*SkippedPromotion) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SkippedPromotion) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *SkippedPromotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SkippedPromotion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 结算时计算促销优惠，with_coupon 表示本单使用优惠券，不可叠加的活动将跳过
+type EvaluatePromotionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Lines         []*OrderLine           `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	WithCoupon    bool                   `protobuf:"varint,3,opt,name=with_coupon,json=withCoupon,proto3" json:"with_coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluatePromotionsReq) Reset() {
+	*x = EvaluatePromotionsReq{}
+	mi := &file_coupon_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluatePromotionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePromotionsReq) ProtoMessage() {}
+
+func (x *EvaluatePromotionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePromotionsReq.ProtoReflect.Descriptor instead.
+func (*EvaluatePromotionsReq) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *EvaluatePromotionsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EvaluatePromotionsReq) GetLines() []*OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *EvaluatePromotionsReq) GetWithCoupon() bool {
+	if x != nil {
+		return x.WithCoupon
+	}
+	return false
+}
+
+type EvaluatePromotionsResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode     int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg      string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,3,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 促销优惠合计(分)
+	PlatformAmount int64                  `protobuf:"varint,4,opt,name=platform_amount,json=platformAmount,proto3" json:"platform_amount,omitempty"` // 其中平台出资
+	MerchantAmount int64                  `protobuf:"varint,5,opt,name=merchant_amount,json=merchantAmount,proto3" json:"merchant_amount,omitempty"` // 其中商家出资
+	Applied        []*AppliedPromotion    `protobuf:"bytes,6,rep,name=applied,proto3" json:"applied,omitempty"`
+	Skipped        []*SkippedPromotion    `protobuf:"bytes,7,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	Lines          []*OrderLine           `protobuf:"bytes,8,rep,name=lines,proto3" json:"lines,omitempty"` // 扣除促销优惠后的商品行，用于继续校验优惠券
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EvaluatePromotionsResp) Reset() {
+	*x = EvaluatePromotionsResp{}
+	mi := &file_coupon_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluatePromotionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePromotionsResp) ProtoMessage() {}
+
+func (x *EvaluatePromotionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePromotionsResp.ProtoReflect.Descriptor instead.
+func (*EvaluatePromotionsResp) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *EvaluatePromotionsResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *EvaluatePromotionsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *EvaluatePromotionsResp) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *EvaluatePromotionsResp) GetPlatformAmount() int64 {
+	if x != nil {
+		return x.PlatformAmount
+	}
+	return 0
+}
+
+func (x *EvaluatePromotionsResp) GetMerchantAmount() int64 {
+	if x != nil {
+		return x.MerchantAmount
+	}
+	return 0
+}
+
+func (x *EvaluatePromotionsResp) GetApplied() []*AppliedPromotion {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+func (x *EvaluatePromotionsResp) GetSkipped() []*SkippedPromotion {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *EvaluatePromotionsResp) GetLines() []*OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_coupon_proto protoreflect.FileDescriptor
+
+const file_coupon_proto_rawDesc = "" +
+	"\n" +
+	"\fcoupon.proto\x12\x06coupon\"\xec\x01\n" +
+	"\vCouponScope\x12.\n" +
+	"\x13include_product_ids\x18\x01 \x03(\x03R\x11includeProductIds\x12.\n" +
+	"\x13exclude_product_ids\x18\x02 \x03(\x03R\x11excludeProductIds\x12-\n" +
+	"\x12include_categories\x18\x03 \x03(\tR\x11includeCategories\x12-\n" +
+	"\x12exclude_categories\x18\x04 \x03(\tR\x11excludeCategories\x12\x1f\n" +
+	"\vmerchant_id\x18\x05 \x01(\x03R\n" +
+	"merchantId\"\xaf\x04\n" +
+	"\n" +
+	"CouponInfo\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x123\n" +
+	"\vcoupon_type\x18\x02 \x01(\x0e2\x12.coupon.CouponTypeR\n" +
+	"couponType\x12,\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x14.coupon.CouponStatusR\x06status\x12'\n" +
+	"\x0fdiscount_amount\x18\x04 \x01(\x03R\x0ediscountAmount\x12)\n" +
+	"\x10discount_percent\x18\x05 \x01(\x05R\x0fdiscountPercent\x12(\n" +
+	"\x10min_spend_amount\x18\x06 \x01(\x03R\x0eminSpendAmount\x12\x17\n" +
+	"\auser_id\x18\a \x01(\x03R\x06userId\x12'\n" +
+	"\x0flocked_preorder\x18\b \x01(\x03R\x0elockedPreorder\x12\x19\n" +
+	"\bstart_at\x18\t \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\n" +
+	" \x01(\x03R\x05endAt\x12\x16\n" +
+	"\x06source\x18\v \x01(\tR\x06source\x12\x18\n" +
+	"\aremarks\x18\f \x01(\tR\aremarks\x12)\n" +
+	"\x05scope\x18\r \x01(\v2\x13.coupon.CouponScopeR\x05scope\x125\n" +
+	"\vissuer_type\x18\x0e \x01(\x0e2\x14.coupon.CouponIssuerR\n" +
+	"issuerType\x12\x1b\n" +
+	"\tissuer_id\x18\x0f \x01(\x03R\bissuerId\"F\n" +
+	"\x0eClaimCouponReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\"Q\n" +
+	"\x0fClaimCouponResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"\x8c\x01\n" +
+	"\x12ListUserCouponsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12,\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x14.coupon.CouponStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x99\x01\n" +
+	"\x13ListUserCouponsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12,\n" +
+	"\acoupons\x18\x03 \x03(\v2\x12.coupon.CouponInfoR\acoupons\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"\x9f\x01\n" +
+	"\tOrderLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\tR\n" +
+	"categories\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\"\x95\x01\n" +
+	"\x11ValidateCouponReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12!\n" +
+	"\forder_amount\x18\x03 \x01(\x03R\vorderAmount\x12'\n" +
+	"\x05lines\x18\x04 \x03(\v2\x11.coupon.OrderLineR\x05lines\"\xc5\x02\n" +
+	"\x12ValidateCouponResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12'\n" +
+	"\x0fdiscount_amount\x18\x04 \x01(\x03R\x0ediscountAmount\x123\n" +
+	"\vcoupon_type\x18\x05 \x01(\x0e2\x12.coupon.CouponTypeR\n" +
+	"couponType\x12'\n" +
+	"\x0feligible_amount\x18\x06 \x01(\x03R\x0eeligibleAmount\x125\n" +
+	"\vissuer_type\x18\a \x01(\x0e2\x14.coupon.CouponIssuerR\n" +
+	"issuerType\x12\x1b\n" +
+	"\tissuer_id\x18\b \x01(\x03R\bissuerId\"z\n" +
+	"\x13RecommendCouponsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\forder_amount\x18\x02 \x01(\x03R\vorderAmount\x12'\n" +
+	"\x05lines\x18\x03 \x03(\v2\x11.coupon.OrderLineR\x05lines\"\xc4\x01\n" +
+	"\x14CouponRecommendation\x12*\n" +
+	"\x06coupon\x18\x01 \x01(\v2\x12.coupon.CouponInfoR\x06coupon\x12\x16\n" +
+	"\x06usable\x18\x02 \x01(\bR\x06usable\x12'\n" +
+	"\x0fdiscount_amount\x18\x03 \x01(\x03R\x0ediscountAmount\x12'\n" +
+	"\x0feligible_amount\x18\x04 \x01(\x03R\x0eeligibleAmount\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xc4\x01\n" +
+	"\x14RecommendCouponsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12F\n" +
+	"\x0frecommendations\x18\x03 \x03(\v2\x1c.coupon.CouponRecommendationR\x0frecommendations\x12$\n" +
+	"\x0ebest_coupon_id\x18\x04 \x01(\x03R\fbestCouponId\"\x89\x01\n" +
+	"\rLockCouponReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x03R\aorderId\x12'\n" +
+	"\x0feligible_amount\x18\x04 \x01(\x03R\x0eeligibleAmount\"P\n" +
+	"\x0eLockCouponResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"c\n" +
+	"\x10ReleaseCouponReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12\x19\n" +
 	"\border_id\x18\x03 \x01(\x03R\aorderId\"S\n" +
 	"\x11ReleaseCouponResp\x12\x1f\n" +
@@ -1234,7 +5403,7 @@ const file_coupon_proto_rawDesc = "" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12\x1d\n" +
 	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"\xf0\x02\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"\xef\x03\n" +
 	"\x10PublishCouponReq\x123\n" +
 	"\vcoupon_type\x18\x01 \x01(\x0e2\x12.coupon.CouponTypeR\n" +
 	"couponType\x12'\n" +
@@ -1248,14 +5417,352 @@ const file_coupon_proto_rawDesc = "" +
 	"\x06end_at\x18\b \x01(\x03R\x05endAt\x12\x16\n" +
 	"\x06source\x18\t \x01(\tR\x06source\x12\x18\n" +
 	"\aremarks\x18\n" +
-	" \x01(\tR\aremarks\"t\n" +
+	" \x01(\tR\aremarks\x12)\n" +
+	"\x05scope\x18\v \x01(\v2\x13.coupon.CouponScopeR\x05scope\x125\n" +
+	"\vissuer_type\x18\f \x01(\x0e2\x14.coupon.CouponIssuerR\n" +
+	"issuerType\x12\x1b\n" +
+	"\tissuer_id\x18\r \x01(\x03R\bissuerId\"t\n" +
 	"\x11PublishCouponResp\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12\x1d\n" +
 	"\n" +
 	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1f\n" +
 	"\vcampaign_id\x18\x03 \x01(\x03R\n" +
-	"campaignId*\x90\x01\n" +
+	"campaignId\"\xd2\x04\n" +
+	"\x0eCouponTemplate\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x123\n" +
+	"\vcoupon_type\x18\x02 \x01(\x0e2\x12.coupon.CouponTypeR\n" +
+	"couponType\x12'\n" +
+	"\x0fdiscount_amount\x18\x03 \x01(\x03R\x0ediscountAmount\x12)\n" +
+	"\x10discount_percent\x18\x04 \x01(\x05R\x0fdiscountPercent\x12(\n" +
+	"\x10min_spend_amount\x18\x05 \x01(\x03R\x0eminSpendAmount\x12\x1f\n" +
+	"\vtotal_issue\x18\x06 \x01(\x03R\n" +
+	"totalIssue\x12$\n" +
+	"\x0eper_user_limit\x18\a \x01(\x03R\fperUserLimit\x12'\n" +
+	"\x0fissued_quantity\x18\b \x01(\x03R\x0eissuedQuantity\x12\x19\n" +
+	"\bstart_at\x18\t \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\n" +
+	" \x01(\x03R\x05endAt\x12\x16\n" +
+	"\x06source\x18\v \x01(\tR\x06source\x12\x18\n" +
+	"\aremarks\x18\f \x01(\tR\aremarks\x12)\n" +
+	"\x05scope\x18\r \x01(\v2\x13.coupon.CouponScopeR\x05scope\x125\n" +
+	"\vissuer_type\x18\x0e \x01(\x0e2\x14.coupon.CouponIssuerR\n" +
+	"issuerType\x12\x1b\n" +
+	"\tissuer_id\x18\x0f \x01(\x03R\bissuerId\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\x10 \x01(\x03R\trevokedAt\"\xfa\x01\n" +
+	"\x0fUpdateCouponReq\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x125\n" +
+	"\vissuer_type\x18\x02 \x01(\x0e2\x14.coupon.CouponIssuerR\n" +
+	"issuerType\x12\x1b\n" +
+	"\tissuer_id\x18\x03 \x01(\x03R\bissuerId\x12\x1f\n" +
+	"\vtotal_issue\x18\x04 \x01(\x03R\n" +
+	"totalIssue\x12$\n" +
+	"\x0eper_user_limit\x18\x05 \x01(\x03R\fperUserLimit\x12\x15\n" +
+	"\x06end_at\x18\x06 \x01(\x03R\x05endAt\x12\x18\n" +
+	"\aremarks\x18\a \x01(\tR\aremarks\"R\n" +
+	"\x10UpdateCouponResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"\x82\x01\n" +
+	"\x0fRevokeCouponReq\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x125\n" +
+	"\vissuer_type\x18\x02 \x01(\x0e2\x14.coupon.CouponIssuerR\n" +
+	"issuerType\x12\x1b\n" +
+	"\tissuer_id\x18\x03 \x01(\x03R\bissuerId\"R\n" +
+	"\x10RevokeCouponResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"\x9d\x01\n" +
+	"\x16ListCouponTemplatesReq\x125\n" +
+	"\vissuer_type\x18\x01 \x01(\x0e2\x14.coupon.CouponIssuerR\n" +
+	"issuerType\x12\x1b\n" +
+	"\tissuer_id\x18\x02 \x01(\x03R\bissuerId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xa5\x01\n" +
+	"\x17ListCouponTemplatesResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x124\n" +
+	"\ttemplates\x18\x03 \x03(\v2\x16.coupon.CouponTemplateR\ttemplates\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"\xac\x01\n" +
+	"\x0fCampaignSegment\x12&\n" +
+	"\x0fmin_order_count\x18\x01 \x01(\x03R\rminOrderCount\x12&\n" +
+	"\x0fmax_order_count\x18\x02 \x01(\x03R\rmaxOrderCount\x12\x1b\n" +
+	"\tmin_spend\x18\x03 \x01(\x03R\bminSpend\x12,\n" +
+	"\x12active_within_days\x18\x04 \x01(\x05R\x10activeWithinDays\"\x97\x04\n" +
+	"\fCampaignInfo\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\x03R\n" +
+	"campaignId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tcoupon_id\x18\x03 \x01(\x03R\bcouponId\x121\n" +
+	"\atrigger\x18\x04 \x01(\x0e2\x17.coupon.CampaignTriggerR\atrigger\x121\n" +
+	"\asegment\x18\x05 \x01(\v2\x17.coupon.CampaignSegmentR\asegment\x12.\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.coupon.CampaignStatusR\x06status\x12\x19\n" +
+	"\bstart_at\x18\a \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\b \x01(\x03R\x05endAt\x12#\n" +
+	"\rscanned_count\x18\t \x01(\x03R\fscannedCount\x12!\n" +
+	"\fissued_count\x18\n" +
+	" \x01(\x03R\vissuedCount\x12#\n" +
+	"\rskipped_count\x18\v \x01(\x03R\fskippedCount\x12!\n" +
+	"\ffailed_count\x18\f \x01(\x03R\vfailedCount\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\r \x01(\tR\tlastError\x12\x1f\n" +
+	"\vfinished_at\x18\x0e \x01(\x03R\n" +
+	"finishedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\x03R\tcreatedAt\"\xdc\x01\n" +
+	"\x11CreateCampaignReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x121\n" +
+	"\atrigger\x18\x03 \x01(\x0e2\x17.coupon.CampaignTriggerR\atrigger\x121\n" +
+	"\asegment\x18\x04 \x01(\v2\x17.coupon.CampaignSegmentR\asegment\x12\x19\n" +
+	"\bstart_at\x18\x05 \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x06 \x01(\x03R\x05endAt\"u\n" +
+	"\x12CreateCampaignResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1f\n" +
+	"\vcampaign_id\x18\x03 \x01(\x03R\n" +
+	"campaignId\"4\n" +
+	"\x11CancelCampaignReq\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\x03R\n" +
+	"campaignId\"T\n" +
+	"\x12CancelCampaignResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"1\n" +
+	"\x0eGetCampaignReq\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\x03R\n" +
+	"campaignId\"\x83\x01\n" +
+	"\x0fGetCampaignResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x120\n" +
+	"\bcampaign\x18\x03 \x01(\v2\x14.coupon.CampaignInfoR\bcampaign\"\xa6\x01\n" +
+	"\x10ListCampaignsReq\x121\n" +
+	"\atrigger\x18\x01 \x01(\x0e2\x17.coupon.CampaignTriggerR\atrigger\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.coupon.CampaignStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9d\x01\n" +
+	"\x11ListCampaignsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x122\n" +
+	"\tcampaigns\x18\x03 \x03(\v2\x14.coupon.CampaignInfoR\tcampaigns\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"a\n" +
+	"\x13TriggerCampaignsReq\x121\n" +
+	"\atrigger\x18\x01 \x01(\x0e2\x17.coupon.CampaignTriggerR\atrigger\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"n\n" +
+	"\x14TriggerCampaignsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x16\n" +
+	"\x06issued\x18\x03 \x01(\x05R\x06issued\"\xb4\x02\n" +
+	"\rCodeBatchInfo\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\x03R\abatchId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12-\n" +
+	"\tcode_type\x18\x04 \x01(\x0e2\x10.coupon.CodeTypeR\bcodeType\x12\x1f\n" +
+	"\vshared_code\x18\x05 \x01(\tR\n" +
+	"sharedCode\x12%\n" +
+	"\x0etotal_quantity\x18\x06 \x01(\x03R\rtotalQuantity\x12%\n" +
+	"\x0eredeemed_count\x18\a \x01(\x03R\rredeemedCount\x12\x1a\n" +
+	"\bdisabled\x18\b \x01(\bR\bdisabled\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\"\xb1\x01\n" +
+	"\x12CreateCodeBatchReq\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\tcode_type\x18\x03 \x01(\x0e2\x10.coupon.CodeTypeR\bcodeType\x12\x1f\n" +
+	"\vshared_code\x18\x04 \x01(\tR\n" +
+	"sharedCode\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\"\x82\x01\n" +
+	"\x13CreateCodeBatchResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12+\n" +
+	"\x05batch\x18\x03 \x01(\v2\x15.coupon.CodeBatchInfoR\x05batch\",\n" +
+	"\x0fGetCodeBatchReq\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\x03R\abatchId\"\x7f\n" +
+	"\x10GetCodeBatchResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12+\n" +
+	"\x05batch\x18\x03 \x01(\v2\x15.coupon.CodeBatchInfoR\x05batch\"0\n" +
+	"\x13DisableCodeBatchReq\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\x03R\abatchId\"V\n" +
+	"\x14DisableCodeBatchResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"/\n" +
+	"\x12ExportCodeBatchReq\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\x03R\abatchId\"\x8c\x01\n" +
+	"\x13ExportCodeBatchResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\"<\n" +
+	"\rRedeemCodeReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"|\n" +
+	"\x0eRedeemCodeResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12*\n" +
+	"\x06coupon\x18\x03 \x01(\v2\x12.coupon.CouponInfoR\x06coupon\"\xb8\x01\n" +
+	"\x11GetCouponStatsReq\x12\x1d\n" +
+	"\n" +
+	"coupon_ids\x18\x01 \x03(\x03R\tcouponIds\x12\x19\n" +
+	"\bstart_at\x18\x02 \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x03 \x01(\x03R\x05endAt\x125\n" +
+	"\vissuer_type\x18\x04 \x01(\x0e2\x14.coupon.CouponIssuerR\n" +
+	"issuerType\x12\x1b\n" +
+	"\tissuer_id\x18\x05 \x01(\x03R\bissuerId\"\xf7\x02\n" +
+	"\vCouponStats\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x12%\n" +
+	"\x0etotal_quantity\x18\x02 \x01(\x03R\rtotalQuantity\x12'\n" +
+	"\x0fissued_quantity\x18\x03 \x01(\x03R\x0eissuedQuantity\x12\x18\n" +
+	"\aclaimed\x18\x04 \x01(\x03R\aclaimed\x12\x16\n" +
+	"\x06unused\x18\x05 \x01(\x03R\x06unused\x12\x16\n" +
+	"\x06locked\x18\x06 \x01(\x03R\x06locked\x12\x12\n" +
+	"\x04used\x18\a \x01(\x03R\x04used\x12\x18\n" +
+	"\aexpired\x18\b \x01(\x03R\aexpired\x12'\n" +
+	"\x0fredemption_rate\x18\t \x01(\x01R\x0eredemptionRate\x12'\n" +
+	"\x0fdiscount_amount\x18\n" +
+	" \x01(\x03R\x0ediscountAmount\x12\x10\n" +
+	"\x03gmv\x18\v \x01(\x03R\x03gmv\x12\x1f\n" +
+	"\vorder_count\x18\f \x01(\x03R\n" +
+	"orderCount\"\x7f\n" +
+	"\x12GetCouponStatsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12)\n" +
+	"\x05stats\x18\x03 \x03(\v2\x13.coupon.CouponStatsR\x05stats\"I\n" +
+	"\rPromotionTier\x12\x1c\n" +
+	"\tthreshold\x18\x01 \x01(\x03R\tthreshold\x12\x1a\n" +
+	"\bdiscount\x18\x02 \x01(\x03R\bdiscount\"R\n" +
+	"\fMultiBuyStep\x12!\n" +
+	"\fmin_quantity\x18\x01 \x01(\x03R\vminQuantity\x12\x1f\n" +
+	"\voff_percent\x18\x02 \x01(\x05R\n" +
+	"offPercent\"\xcc\x01\n" +
+	"\rPromotionRule\x12+\n" +
+	"\x05tiers\x18\x01 \x03(\v2\x15.coupon.PromotionTierR\x05tiers\x12*\n" +
+	"\x05steps\x18\x02 \x03(\v2\x14.coupon.MultiBuyStepR\x05steps\x12!\n" +
+	"\fbundle_price\x18\x03 \x01(\x03R\vbundlePrice\x12\x1f\n" +
+	"\vproduct_ids\x18\x04 \x03(\x03R\n" +
+	"productIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\tR\n" +
+	"categories\"\xd4\x03\n" +
+	"\rPromotionInfo\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
+	"\n" +
+	"promo_type\x18\x03 \x01(\x0e2\x15.coupon.PromotionTypeR\tpromoType\x124\n" +
+	"\tfunded_by\x18\x04 \x01(\x0e2\x17.coupon.PromotionFunderR\bfundedBy\x12\x1f\n" +
+	"\vmerchant_id\x18\x05 \x01(\x03R\n" +
+	"merchantId\x12)\n" +
+	"\x04rule\x18\x06 \x01(\v2\x15.coupon.PromotionRuleR\x04rule\x12\x1f\n" +
+	"\vmutex_group\x18\a \x01(\tR\n" +
+	"mutexGroup\x12*\n" +
+	"\x11stack_with_coupon\x18\b \x01(\bR\x0fstackWithCoupon\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\x05R\bpriority\x12\x1a\n" +
+	"\bdisabled\x18\n" +
+	" \x01(\bR\bdisabled\x12\x19\n" +
+	"\bstart_at\x18\v \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\f \x01(\x03R\x05endAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\"\xfb\x02\n" +
+	"\x12CreatePromotionReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\n" +
+	"promo_type\x18\x02 \x01(\x0e2\x15.coupon.PromotionTypeR\tpromoType\x124\n" +
+	"\tfunded_by\x18\x03 \x01(\x0e2\x17.coupon.PromotionFunderR\bfundedBy\x12\x1f\n" +
+	"\vmerchant_id\x18\x04 \x01(\x03R\n" +
+	"merchantId\x12)\n" +
+	"\x04rule\x18\x05 \x01(\v2\x15.coupon.PromotionRuleR\x04rule\x12\x1f\n" +
+	"\vmutex_group\x18\x06 \x01(\tR\n" +
+	"mutexGroup\x12*\n" +
+	"\x11stack_with_coupon\x18\a \x01(\bR\x0fstackWithCoupon\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12\x19\n" +
+	"\bstart_at\x18\t \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\n" +
+	" \x01(\x03R\x05endAt\"\x8a\x01\n" +
+	"\x13CreatePromotionResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x123\n" +
+	"\tpromotion\x18\x03 \x01(\v2\x15.coupon.PromotionInfoR\tpromotion\"8\n" +
+	"\x13DisablePromotionReq\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\"V\n" +
+	"\x14DisablePromotionResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"\x86\x01\n" +
+	"\x11ListPromotionsReq\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xa1\x01\n" +
+	"\x12ListPromotionsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x125\n" +
+	"\n" +
+	"promotions\x18\x03 \x03(\v2\x15.coupon.PromotionInfoR\n" +
+	"promotions\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"\xa1\x02\n" +
+	"\x10AppliedPromotion\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
+	"\n" +
+	"promo_type\x18\x03 \x01(\x0e2\x15.coupon.PromotionTypeR\tpromoType\x124\n" +
+	"\tfunded_by\x18\x04 \x01(\x0e2\x17.coupon.PromotionFunderR\bfundedBy\x12\x1f\n" +
+	"\vmerchant_id\x18\x05 \x01(\x03R\n" +
+	"merchantId\x12'\n" +
+	"\x0fdiscount_amount\x18\x06 \x01(\x03R\x0ediscountAmount\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\"a\n" +
+	"\x10SkippedPromotion\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"z\n" +
+	"\x15EvaluatePromotionsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x05lines\x18\x02 \x03(\v2\x11.coupon.OrderLineR\x05lines\x12\x1f\n" +
+	"\vwith_coupon\x18\x03 \x01(\bR\n" +
+	"withCoupon\"\xe4\x02\n" +
+	"\x16EvaluatePromotionsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12'\n" +
+	"\x0fdiscount_amount\x18\x03 \x01(\x03R\x0ediscountAmount\x12'\n" +
+	"\x0fplatform_amount\x18\x04 \x01(\x03R\x0eplatformAmount\x12'\n" +
+	"\x0fmerchant_amount\x18\x05 \x01(\x03R\x0emerchantAmount\x122\n" +
+	"\aapplied\x18\x06 \x03(\v2\x18.coupon.AppliedPromotionR\aapplied\x122\n" +
+	"\askipped\x18\a \x03(\v2\x18.coupon.SkippedPromotionR\askipped\x12'\n" +
+	"\x05lines\x18\b \x03(\v2\x11.coupon.OrderLineR\x05lines*\x90\x01\n" +
 	"\fCouponStatus\x12\x19\n" +
 	"\x15COUPON_STATUS_UNKNOWN\x10\x00\x12\x18\n" +
 	"\x14COUPON_STATUS_UNUSED\x10\x01\x12\x18\n" +
@@ -1266,16 +5773,65 @@ const file_coupon_proto_rawDesc = "" +
 	"CouponType\x12\x17\n" +
 	"\x13COUPON_TYPE_UNKNOWN\x10\x00\x12\x14\n" +
 	"\x10COUPON_TYPE_CASH\x10\x01\x12\x17\n" +
-	"\x13COUPON_TYPE_PERCENT\x10\x022\xf0\x03\n" +
+	"\x13COUPON_TYPE_PERCENT\x10\x02*a\n" +
+	"\fCouponIssuer\x12\x19\n" +
+	"\x15COUPON_ISSUER_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16COUPON_ISSUER_PLATFORM\x10\x01\x12\x1a\n" +
+	"\x16COUPON_ISSUER_MERCHANT\x10\x02*\xad\x01\n" +
+	"\x0fCampaignTrigger\x12\x1c\n" +
+	"\x18CAMPAIGN_TRIGGER_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19CAMPAIGN_TRIGGER_REGISTER\x10\x01\x12 \n" +
+	"\x1cCAMPAIGN_TRIGGER_FIRST_ORDER\x10\x02\x12\x1d\n" +
+	"\x19CAMPAIGN_TRIGGER_BIRTHDAY\x10\x03\x12\x1c\n" +
+	"\x18CAMPAIGN_TRIGGER_SEGMENT\x10\x04*\xa7\x01\n" +
+	"\x0eCampaignStatus\x12\x1b\n" +
+	"\x17CAMPAIGN_STATUS_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19CAMPAIGN_STATUS_SCHEDULED\x10\x01\x12\x1b\n" +
+	"\x17CAMPAIGN_STATUS_RUNNING\x10\x02\x12\x1d\n" +
+	"\x19CAMPAIGN_STATUS_COMPLETED\x10\x03\x12\x1d\n" +
+	"\x19CAMPAIGN_STATUS_CANCELLED\x10\x04*M\n" +
+	"\bCodeType\x12\x15\n" +
+	"\x11CODE_TYPE_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10CODE_TYPE_SHARED\x10\x01\x12\x14\n" +
+	"\x10CODE_TYPE_UNIQUE\x10\x02*\x87\x01\n" +
+	"\rPromotionType\x12\x1a\n" +
+	"\x16PROMOTION_TYPE_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dPROMOTION_TYPE_FULL_REDUCTION\x10\x01\x12\x1c\n" +
+	"\x18PROMOTION_TYPE_MULTI_BUY\x10\x02\x12\x19\n" +
+	"\x15PROMOTION_TYPE_BUNDLE\x10\x03*m\n" +
+	"\x0fPromotionFunder\x12\x1c\n" +
+	"\x18PROMOTION_FUNDER_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19PROMOTION_FUNDER_PLATFORM\x10\x01\x12\x1d\n" +
+	"\x19PROMOTION_FUNDER_MERCHANT\x10\x022\xed\x0e\n" +
 	"\rCouponService\x12>\n" +
 	"\vClaimCoupon\x12\x16.coupon.ClaimCouponReq\x1a\x17.coupon.ClaimCouponResp\x12J\n" +
 	"\x0fListUserCoupons\x12\x1a.coupon.ListUserCouponsReq\x1a\x1b.coupon.ListUserCouponsResp\x12G\n" +
-	"\x0eValidateCoupon\x12\x19.coupon.ValidateCouponReq\x1a\x1a.coupon.ValidateCouponResp\x12;\n" +
+	"\x0eValidateCoupon\x12\x19.coupon.ValidateCouponReq\x1a\x1a.coupon.ValidateCouponResp\x12M\n" +
+	"\x10RecommendCoupons\x12\x1b.coupon.RecommendCouponsReq\x1a\x1c.coupon.RecommendCouponsResp\x12;\n" +
 	"\n" +
 	"LockCoupon\x12\x15.coupon.LockCouponReq\x1a\x16.coupon.LockCouponResp\x12D\n" +
 	"\rReleaseCoupon\x12\x18.coupon.ReleaseCouponReq\x1a\x19.coupon.ReleaseCouponResp\x12A\n" +
 	"\fRedeemCoupon\x12\x17.coupon.RedeemCouponReq\x1a\x18.coupon.RedeemCouponResp\x12D\n" +
-	"\rPublishCoupon\x12\x18.coupon.PublishCouponReq\x1a\x19.coupon.PublishCouponRespB\n" +
+	"\rPublishCoupon\x12\x18.coupon.PublishCouponReq\x1a\x19.coupon.PublishCouponResp\x12A\n" +
+	"\fUpdateCoupon\x12\x17.coupon.UpdateCouponReq\x1a\x18.coupon.UpdateCouponResp\x12A\n" +
+	"\fRevokeCoupon\x12\x17.coupon.RevokeCouponReq\x1a\x18.coupon.RevokeCouponResp\x12V\n" +
+	"\x13ListCouponTemplates\x12\x1e.coupon.ListCouponTemplatesReq\x1a\x1f.coupon.ListCouponTemplatesResp\x12G\n" +
+	"\x0eCreateCampaign\x12\x19.coupon.CreateCampaignReq\x1a\x1a.coupon.CreateCampaignResp\x12G\n" +
+	"\x0eCancelCampaign\x12\x19.coupon.CancelCampaignReq\x1a\x1a.coupon.CancelCampaignResp\x12>\n" +
+	"\vGetCampaign\x12\x16.coupon.GetCampaignReq\x1a\x17.coupon.GetCampaignResp\x12D\n" +
+	"\rListCampaigns\x12\x18.coupon.ListCampaignsReq\x1a\x19.coupon.ListCampaignsResp\x12M\n" +
+	"\x10TriggerCampaigns\x12\x1b.coupon.TriggerCampaignsReq\x1a\x1c.coupon.TriggerCampaignsResp\x12J\n" +
+	"\x0fCreateCodeBatch\x12\x1a.coupon.CreateCodeBatchReq\x1a\x1b.coupon.CreateCodeBatchResp\x12A\n" +
+	"\fGetCodeBatch\x12\x17.coupon.GetCodeBatchReq\x1a\x18.coupon.GetCodeBatchResp\x12M\n" +
+	"\x10DisableCodeBatch\x12\x1b.coupon.DisableCodeBatchReq\x1a\x1c.coupon.DisableCodeBatchResp\x12J\n" +
+	"\x0fExportCodeBatch\x12\x1a.coupon.ExportCodeBatchReq\x1a\x1b.coupon.ExportCodeBatchResp\x12;\n" +
+	"\n" +
+	"RedeemCode\x12\x15.coupon.RedeemCodeReq\x1a\x16.coupon.RedeemCodeResp\x12G\n" +
+	"\x0eGetCouponStats\x12\x19.coupon.GetCouponStatsReq\x1a\x1a.coupon.GetCouponStatsResp\x12J\n" +
+	"\x0fCreatePromotion\x12\x1a.coupon.CreatePromotionReq\x1a\x1b.coupon.CreatePromotionResp\x12M\n" +
+	"\x10DisablePromotion\x12\x1b.coupon.DisablePromotionReq\x1a\x1c.coupon.DisablePromotionResp\x12G\n" +
+	"\x0eListPromotions\x12\x19.coupon.ListPromotionsReq\x1a\x1a.coupon.ListPromotionsResp\x12S\n" +
+	"\x12EvaluatePromotions\x12\x1d.coupon.EvaluatePromotionsReq\x1a\x1e.coupon.EvaluatePromotionsRespB\n" +
 	"Z\b./couponb\x06proto3"
 
 var (
@@ -1290,53 +5846,198 @@ func file_coupon_proto_rawDescGZIP() []byte {
 	return file_coupon_proto_rawDescData
 }
 
-var file_coupon_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_coupon_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_coupon_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_coupon_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_coupon_proto_goTypes = []any{
-	(CouponStatus)(0),           // 0: coupon.CouponStatus
-	(CouponType)(0),             // 1: coupon.CouponType
-	(*CouponInfo)(nil),          // 2: coupon.CouponInfo
-	(*ClaimCouponReq)(nil),      // 3: coupon.ClaimCouponReq
-	(*ClaimCouponResp)(nil),     // 4: coupon.ClaimCouponResp
-	(*ListUserCouponsReq)(nil),  // 5: coupon.ListUserCouponsReq
-	(*ListUserCouponsResp)(nil), // 6: coupon.ListUserCouponsResp
-	(*ValidateCouponReq)(nil),   // 7: coupon.ValidateCouponReq
-	(*ValidateCouponResp)(nil),  // 8: coupon.ValidateCouponResp
-	(*LockCouponReq)(nil),       // 9: coupon.LockCouponReq
-	(*LockCouponResp)(nil),      // 10: coupon.LockCouponResp
-	(*ReleaseCouponReq)(nil),    // 11: coupon.ReleaseCouponReq
-	(*ReleaseCouponResp)(nil),   // 12: coupon.ReleaseCouponResp
-	(*RedeemCouponReq)(nil),     // 13: coupon.RedeemCouponReq
-	(*RedeemCouponResp)(nil),    // 14: coupon.RedeemCouponResp
-	(*PublishCouponReq)(nil),    // 15: coupon.PublishCouponReq
-	(*PublishCouponResp)(nil),   // 16: coupon.PublishCouponResp
+	(CouponStatus)(0),               // 0: coupon.CouponStatus
+	(CouponType)(0),                 // 1: coupon.CouponType
+	(CouponIssuer)(0),               // 2: coupon.CouponIssuer
+	(CampaignTrigger)(0),            // 3: coupon.CampaignTrigger
+	(CampaignStatus)(0),             // 4: coupon.CampaignStatus
+	(CodeType)(0),                   // 5: coupon.CodeType
+	(PromotionType)(0),              // 6: coupon.PromotionType
+	(PromotionFunder)(0),            // 7: coupon.PromotionFunder
+	(*CouponScope)(nil),             // 8: coupon.CouponScope
+	(*CouponInfo)(nil),              // 9: coupon.CouponInfo
+	(*ClaimCouponReq)(nil),          // 10: coupon.ClaimCouponReq
+	(*ClaimCouponResp)(nil),         // 11: coupon.ClaimCouponResp
+	(*ListUserCouponsReq)(nil),      // 12: coupon.ListUserCouponsReq
+	(*ListUserCouponsResp)(nil),     // 13: coupon.ListUserCouponsResp
+	(*OrderLine)(nil),               // 14: coupon.OrderLine
+	(*ValidateCouponReq)(nil),       // 15: coupon.ValidateCouponReq
+	(*ValidateCouponResp)(nil),      // 16: coupon.ValidateCouponResp
+	(*RecommendCouponsReq)(nil),     // 17: coupon.RecommendCouponsReq
+	(*CouponRecommendation)(nil),    // 18: coupon.CouponRecommendation
+	(*RecommendCouponsResp)(nil),    // 19: coupon.RecommendCouponsResp
+	(*LockCouponReq)(nil),           // 20: coupon.LockCouponReq
+	(*LockCouponResp)(nil),          // 21: coupon.LockCouponResp
+	(*ReleaseCouponReq)(nil),        // 22: coupon.ReleaseCouponReq
+	(*ReleaseCouponResp)(nil),       // 23: coupon.ReleaseCouponResp
+	(*RedeemCouponReq)(nil),         // 24: coupon.RedeemCouponReq
+	(*RedeemCouponResp)(nil),        // 25: coupon.RedeemCouponResp
+	(*PublishCouponReq)(nil),        // 26: coupon.PublishCouponReq
+	(*PublishCouponResp)(nil),       // 27: coupon.PublishCouponResp
+	(*CouponTemplate)(nil),          // 28: coupon.CouponTemplate
+	(*UpdateCouponReq)(nil),         // 29: coupon.UpdateCouponReq
+	(*UpdateCouponResp)(nil),        // 30: coupon.UpdateCouponResp
+	(*RevokeCouponReq)(nil),         // 31: coupon.RevokeCouponReq
+	(*RevokeCouponResp)(nil),        // 32: coupon.RevokeCouponResp
+	(*ListCouponTemplatesReq)(nil),  // 33: coupon.ListCouponTemplatesReq
+	(*ListCouponTemplatesResp)(nil), // 34: coupon.ListCouponTemplatesResp
+	(*CampaignSegment)(nil),         // 35: coupon.CampaignSegment
+	(*CampaignInfo)(nil),            // 36: coupon.CampaignInfo
+	(*CreateCampaignReq)(nil),       // 37: coupon.CreateCampaignReq
+	(*CreateCampaignResp)(nil),      // 38: coupon.CreateCampaignResp
+	(*CancelCampaignReq)(nil),       // 39: coupon.CancelCampaignReq
+	(*CancelCampaignResp)(nil),      // 40: coupon.CancelCampaignResp
+	(*GetCampaignReq)(nil),          // 41: coupon.GetCampaignReq
+	(*GetCampaignResp)(nil),         // 42: coupon.GetCampaignResp
+	(*ListCampaignsReq)(nil),        // 43: coupon.ListCampaignsReq
+	(*ListCampaignsResp)(nil),       // 44: coupon.ListCampaignsResp
+	(*TriggerCampaignsReq)(nil),     // 45: coupon.TriggerCampaignsReq
+	(*TriggerCampaignsResp)(nil),    // 46: coupon.TriggerCampaignsResp
+	(*CodeBatchInfo)(nil),           // 47: coupon.CodeBatchInfo
+	(*CreateCodeBatchReq)(nil),      // 48: coupon.CreateCodeBatchReq
+	(*CreateCodeBatchResp)(nil),     // 49: coupon.CreateCodeBatchResp
+	(*GetCodeBatchReq)(nil),         // 50: coupon.GetCodeBatchReq
+	(*GetCodeBatchResp)(nil),        // 51: coupon.GetCodeBatchResp
+	(*DisableCodeBatchReq)(nil),     // 52: coupon.DisableCodeBatchReq
+	(*DisableCodeBatchResp)(nil),    // 53: coupon.DisableCodeBatchResp
+	(*ExportCodeBatchReq)(nil),      // 54: coupon.ExportCodeBatchReq
+	(*ExportCodeBatchResp)(nil),     // 55: coupon.ExportCodeBatchResp
+	(*RedeemCodeReq)(nil),           // 56: coupon.RedeemCodeReq
+	(*RedeemCodeResp)(nil),          // 57: coupon.RedeemCodeResp
+	(*GetCouponStatsReq)(nil),       // 58: coupon.GetCouponStatsReq
+	(*CouponStats)(nil),             // 59: coupon.CouponStats
+	(*GetCouponStatsResp)(nil),      // 60: coupon.GetCouponStatsResp
+	(*PromotionTier)(nil),           // 61: coupon.PromotionTier
+	(*MultiBuyStep)(nil),            // 62: coupon.MultiBuyStep
+	(*PromotionRule)(nil),           // 63: coupon.PromotionRule
+	(*PromotionInfo)(nil),           // 64: coupon.PromotionInfo
+	(*CreatePromotionReq)(nil),      // 65: coupon.CreatePromotionReq
+	(*CreatePromotionResp)(nil),     // 66: coupon.CreatePromotionResp
+	(*DisablePromotionReq)(nil),     // 67: coupon.DisablePromotionReq
+	(*DisablePromotionResp)(nil),    // 68: coupon.DisablePromotionResp
+	(*ListPromotionsReq)(nil),       // 69: coupon.ListPromotionsReq
+	(*ListPromotionsResp)(nil),      // 70: coupon.ListPromotionsResp
+	(*AppliedPromotion)(nil),        // 71: coupon.AppliedPromotion
+	(*SkippedPromotion)(nil),        // 72: coupon.SkippedPromotion
+	(*EvaluatePromotionsReq)(nil),   // 73: coupon.EvaluatePromotionsReq
+	(*EvaluatePromotionsResp)(nil),  // 74: coupon.EvaluatePromotionsResp
 }
 var file_coupon_proto_depIdxs = []int32{
 	1,  // 0: coupon.CouponInfo.coupon_type:type_name -> coupon.CouponType
 	0,  // 1: coupon.CouponInfo.status:type_name -> coupon.CouponStatus
-	0,  // 2: coupon.ListUserCouponsReq.status:type_name -> coupon.CouponStatus
-	2,  // 3: coupon.ListUserCouponsResp.coupons:type_name -> coupon.CouponInfo
-	1,  // 4: coupon.ValidateCouponResp.coupon_type:type_name -> coupon.CouponType
-	1,  // 5: coupon.PublishCouponReq.coupon_type:type_name -> coupon.CouponType
-	3,  // 6: coupon.CouponService.ClaimCoupon:input_type -> coupon.ClaimCouponReq
-	5,  // 7: coupon.CouponService.ListUserCoupons:input_type -> coupon.ListUserCouponsReq
-	7,  // 8: coupon.CouponService.ValidateCoupon:input_type -> coupon.ValidateCouponReq
-	9,  // 9: coupon.CouponService.LockCoupon:input_type -> coupon.LockCouponReq
-	11, // 10: coupon.CouponService.ReleaseCoupon:input_type -> coupon.ReleaseCouponReq
-	13, // 11: coupon.CouponService.RedeemCoupon:input_type -> coupon.RedeemCouponReq
-	15, // 12: coupon.CouponService.PublishCoupon:input_type -> coupon.PublishCouponReq
-	4,  // 13: coupon.CouponService.ClaimCoupon:output_type -> coupon.ClaimCouponResp
-	6,  // 14: coupon.CouponService.ListUserCoupons:output_type -> coupon.ListUserCouponsResp
-	8,  // 15: coupon.CouponService.ValidateCoupon:output_type -> coupon.ValidateCouponResp
-	10, // 16: coupon.CouponService.LockCoupon:output_type -> coupon.LockCouponResp
-	12, // 17: coupon.CouponService.ReleaseCoupon:output_type -> coupon.ReleaseCouponResp
-	14, // 18: coupon.CouponService.RedeemCoupon:output_type -> coupon.RedeemCouponResp
-	16, // 19: coupon.CouponService.PublishCoupon:output_type -> coupon.PublishCouponResp
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	8,  // 2: coupon.CouponInfo.scope:type_name -> coupon.CouponScope
+	2,  // 3: coupon.CouponInfo.issuer_type:type_name -> coupon.CouponIssuer
+	0,  // 4: coupon.ListUserCouponsReq.status:type_name -> coupon.CouponStatus
+	9,  // 5: coupon.ListUserCouponsResp.coupons:type_name -> coupon.CouponInfo
+	14, // 6: coupon.ValidateCouponReq.lines:type_name -> coupon.OrderLine
+	1,  // 7: coupon.ValidateCouponResp.coupon_type:type_name -> coupon.CouponType
+	2,  // 8: coupon.ValidateCouponResp.issuer_type:type_name -> coupon.CouponIssuer
+	14, // 9: coupon.RecommendCouponsReq.lines:type_name -> coupon.OrderLine
+	9,  // 10: coupon.CouponRecommendation.coupon:type_name -> coupon.CouponInfo
+	18, // 11: coupon.RecommendCouponsResp.recommendations:type_name -> coupon.CouponRecommendation
+	1,  // 12: coupon.PublishCouponReq.coupon_type:type_name -> coupon.CouponType
+	8,  // 13: coupon.PublishCouponReq.scope:type_name -> coupon.CouponScope
+	2,  // 14: coupon.PublishCouponReq.issuer_type:type_name -> coupon.CouponIssuer
+	1,  // 15: coupon.CouponTemplate.coupon_type:type_name -> coupon.CouponType
+	8,  // 16: coupon.CouponTemplate.scope:type_name -> coupon.CouponScope
+	2,  // 17: coupon.CouponTemplate.issuer_type:type_name -> coupon.CouponIssuer
+	2,  // 18: coupon.UpdateCouponReq.issuer_type:type_name -> coupon.CouponIssuer
+	2,  // 19: coupon.RevokeCouponReq.issuer_type:type_name -> coupon.CouponIssuer
+	2,  // 20: coupon.ListCouponTemplatesReq.issuer_type:type_name -> coupon.CouponIssuer
+	28, // 21: coupon.ListCouponTemplatesResp.templates:type_name -> coupon.CouponTemplate
+	3,  // 22: coupon.CampaignInfo.trigger:type_name -> coupon.CampaignTrigger
+	35, // 23: coupon.CampaignInfo.segment:type_name -> coupon.CampaignSegment
+	4,  // 24: coupon.CampaignInfo.status:type_name -> coupon.CampaignStatus
+	3,  // 25: coupon.CreateCampaignReq.trigger:type_name -> coupon.CampaignTrigger
+	35, // 26: coupon.CreateCampaignReq.segment:type_name -> coupon.CampaignSegment
+	36, // 27: coupon.GetCampaignResp.campaign:type_name -> coupon.CampaignInfo
+	3,  // 28: coupon.ListCampaignsReq.trigger:type_name -> coupon.CampaignTrigger
+	4,  // 29: coupon.ListCampaignsReq.status:type_name -> coupon.CampaignStatus
+	36, // 30: coupon.ListCampaignsResp.campaigns:type_name -> coupon.CampaignInfo
+	3,  // 31: coupon.TriggerCampaignsReq.trigger:type_name -> coupon.CampaignTrigger
+	5,  // 32: coupon.CodeBatchInfo.code_type:type_name -> coupon.CodeType
+	5,  // 33: coupon.CreateCodeBatchReq.code_type:type_name -> coupon.CodeType
+	47, // 34: coupon.CreateCodeBatchResp.batch:type_name -> coupon.CodeBatchInfo
+	47, // 35: coupon.GetCodeBatchResp.batch:type_name -> coupon.CodeBatchInfo
+	9,  // 36: coupon.RedeemCodeResp.coupon:type_name -> coupon.CouponInfo
+	2,  // 37: coupon.GetCouponStatsReq.issuer_type:type_name -> coupon.CouponIssuer
+	59, // 38: coupon.GetCouponStatsResp.stats:type_name -> coupon.CouponStats
+	61, // 39: coupon.PromotionRule.tiers:type_name -> coupon.PromotionTier
+	62, // 40: coupon.PromotionRule.steps:type_name -> coupon.MultiBuyStep
+	6,  // 41: coupon.PromotionInfo.promo_type:type_name -> coupon.PromotionType
+	7,  // 42: coupon.PromotionInfo.funded_by:type_name -> coupon.PromotionFunder
+	63, // 43: coupon.PromotionInfo.rule:type_name -> coupon.PromotionRule
+	6,  // 44: coupon.CreatePromotionReq.promo_type:type_name -> coupon.PromotionType
+	7,  // 45: coupon.CreatePromotionReq.funded_by:type_name -> coupon.PromotionFunder
+	63, // 46: coupon.CreatePromotionReq.rule:type_name -> coupon.PromotionRule
+	64, // 47: coupon.CreatePromotionResp.promotion:type_name -> coupon.PromotionInfo
+	64, // 48: coupon.ListPromotionsResp.promotions:type_name -> coupon.PromotionInfo
+	6,  // 49: coupon.AppliedPromotion.promo_type:type_name -> coupon.PromotionType
+	7,  // 50: coupon.AppliedPromotion.funded_by:type_name -> coupon.PromotionFunder
+	14, // 51: coupon.EvaluatePromotionsReq.lines:type_name -> coupon.OrderLine
+	71, // 52: coupon.EvaluatePromotionsResp.applied:type_name -> coupon.AppliedPromotion
+	72, // 53: coupon.EvaluatePromotionsResp.skipped:type_name -> coupon.SkippedPromotion
+	14, // 54: coupon.EvaluatePromotionsResp.lines:type_name -> coupon.OrderLine
+	10, // 55: coupon.CouponService.ClaimCoupon:input_type -> coupon.ClaimCouponReq
+	12, // 56: coupon.CouponService.ListUserCoupons:input_type -> coupon.ListUserCouponsReq
+	15, // 57: coupon.CouponService.ValidateCoupon:input_type -> coupon.ValidateCouponReq
+	17, // 58: coupon.CouponService.RecommendCoupons:input_type -> coupon.RecommendCouponsReq
+	20, // 59: coupon.CouponService.LockCoupon:input_type -> coupon.LockCouponReq
+	22, // 60: coupon.CouponService.ReleaseCoupon:input_type -> coupon.ReleaseCouponReq
+	24, // 61: coupon.CouponService.RedeemCoupon:input_type -> coupon.RedeemCouponReq
+	26, // 62: coupon.CouponService.PublishCoupon:input_type -> coupon.PublishCouponReq
+	29, // 63: coupon.CouponService.UpdateCoupon:input_type -> coupon.UpdateCouponReq
+	31, // 64: coupon.CouponService.RevokeCoupon:input_type -> coupon.RevokeCouponReq
+	33, // 65: coupon.CouponService.ListCouponTemplates:input_type -> coupon.ListCouponTemplatesReq
+	37, // 66: coupon.CouponService.CreateCampaign:input_type -> coupon.CreateCampaignReq
+	39, // 67: coupon.CouponService.CancelCampaign:input_type -> coupon.CancelCampaignReq
+	41, // 68: coupon.CouponService.GetCampaign:input_type -> coupon.GetCampaignReq
+	43, // 69: coupon.CouponService.ListCampaigns:input_type -> coupon.ListCampaignsReq
+	45, // 70: coupon.CouponService.TriggerCampaigns:input_type -> coupon.TriggerCampaignsReq
+	48, // 71: coupon.CouponService.CreateCodeBatch:input_type -> coupon.CreateCodeBatchReq
+	50, // 72: coupon.CouponService.GetCodeBatch:input_type -> coupon.GetCodeBatchReq
+	52, // 73: coupon.CouponService.DisableCodeBatch:input_type -> coupon.DisableCodeBatchReq
+	54, // 74: coupon.CouponService.ExportCodeBatch:input_type -> coupon.ExportCodeBatchReq
+	56, // 75: coupon.CouponService.RedeemCode:input_type -> coupon.RedeemCodeReq
+	58, // 76: coupon.CouponService.GetCouponStats:input_type -> coupon.GetCouponStatsReq
+	65, // 77: coupon.CouponService.CreatePromotion:input_type -> coupon.CreatePromotionReq
+	67, // 78: coupon.CouponService.DisablePromotion:input_type -> coupon.DisablePromotionReq
+	69, // 79: coupon.CouponService.ListPromotions:input_type -> coupon.ListPromotionsReq
+	73, // 80: coupon.CouponService.EvaluatePromotions:input_type -> coupon.EvaluatePromotionsReq
+	11, // 81: coupon.CouponService.ClaimCoupon:output_type -> coupon.ClaimCouponResp
+	13, // 82: coupon.CouponService.ListUserCoupons:output_type -> coupon.ListUserCouponsResp
+	16, // 83: coupon.CouponService.ValidateCoupon:output_type -> coupon.ValidateCouponResp
+	19, // 84: coupon.CouponService.RecommendCoupons:output_type -> coupon.RecommendCouponsResp
+	21, // 85: coupon.CouponService.LockCoupon:output_type -> coupon.LockCouponResp
+	23, // 86: coupon.CouponService.ReleaseCoupon:output_type -> coupon.ReleaseCouponResp
+	25, // 87: coupon.CouponService.RedeemCoupon:output_type -> coupon.RedeemCouponResp
+	27, // 88: coupon.CouponService.PublishCoupon:output_type -> coupon.PublishCouponResp
+	30, // 89: coupon.CouponService.UpdateCoupon:output_type -> coupon.UpdateCouponResp
+	32, // 90: coupon.CouponService.RevokeCoupon:output_type -> coupon.RevokeCouponResp
+	34, // 91: coupon.CouponService.ListCouponTemplates:output_type -> coupon.ListCouponTemplatesResp
+	38, // 92: coupon.CouponService.CreateCampaign:output_type -> coupon.CreateCampaignResp
+	40, // 93: coupon.CouponService.CancelCampaign:output_type -> coupon.CancelCampaignResp
+	42, // 94: coupon.CouponService.GetCampaign:output_type -> coupon.GetCampaignResp
+	44, // 95: coupon.CouponService.ListCampaigns:output_type -> coupon.ListCampaignsResp
+	46, // 96: coupon.CouponService.TriggerCampaigns:output_type -> coupon.TriggerCampaignsResp
+	49, // 97: coupon.CouponService.CreateCodeBatch:output_type -> coupon.CreateCodeBatchResp
+	51, // 98: coupon.CouponService.GetCodeBatch:output_type -> coupon.GetCodeBatchResp
+	53, // 99: coupon.CouponService.DisableCodeBatch:output_type -> coupon.DisableCodeBatchResp
+	55, // 100: coupon.CouponService.ExportCodeBatch:output_type -> coupon.ExportCodeBatchResp
+	57, // 101: coupon.CouponService.RedeemCode:output_type -> coupon.RedeemCodeResp
+	60, // 102: coupon.CouponService.GetCouponStats:output_type -> coupon.GetCouponStatsResp
+	66, // 103: coupon.CouponService.CreatePromotion:output_type -> coupon.CreatePromotionResp
+	68, // 104: coupon.CouponService.DisablePromotion:output_type -> coupon.DisablePromotionResp
+	70, // 105: coupon.CouponService.ListPromotions:output_type -> coupon.ListPromotionsResp
+	74, // 106: coupon.CouponService.EvaluatePromotions:output_type -> coupon.EvaluatePromotionsResp
+	81, // [81:107] is the sub-list for method output_type
+	55, // [55:81] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_coupon_proto_init() }
@@ -1349,8 +6050,8 @@ func file_coupon_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_coupon_proto_rawDesc), len(file_coupon_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   15,
+			NumEnums:      8,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
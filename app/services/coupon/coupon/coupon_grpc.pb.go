@@ -19,13 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CouponService_ClaimCoupon_FullMethodName     = "/coupon.CouponService/ClaimCoupon"
-	CouponService_ListUserCoupons_FullMethodName = "/coupon.CouponService/ListUserCoupons"
-	CouponService_ValidateCoupon_FullMethodName  = "/coupon.CouponService/ValidateCoupon"
-	CouponService_LockCoupon_FullMethodName      = "/coupon.CouponService/LockCoupon"
-	CouponService_ReleaseCoupon_FullMethodName   = "/coupon.CouponService/ReleaseCoupon"
-	CouponService_RedeemCoupon_FullMethodName    = "/coupon.CouponService/RedeemCoupon"
-	CouponService_PublishCoupon_FullMethodName   = "/coupon.CouponService/PublishCoupon"
+	CouponService_ClaimCoupon_FullMethodName         = "/coupon.CouponService/ClaimCoupon"
+	CouponService_ListUserCoupons_FullMethodName     = "/coupon.CouponService/ListUserCoupons"
+	CouponService_ValidateCoupon_FullMethodName      = "/coupon.CouponService/ValidateCoupon"
+	CouponService_RecommendCoupons_FullMethodName    = "/coupon.CouponService/RecommendCoupons"
+	CouponService_LockCoupon_FullMethodName          = "/coupon.CouponService/LockCoupon"
+	CouponService_ReleaseCoupon_FullMethodName       = "/coupon.CouponService/ReleaseCoupon"
+	CouponService_RedeemCoupon_FullMethodName        = "/coupon.CouponService/RedeemCoupon"
+	CouponService_PublishCoupon_FullMethodName       = "/coupon.CouponService/PublishCoupon"
+	CouponService_UpdateCoupon_FullMethodName        = "/coupon.CouponService/UpdateCoupon"
+	CouponService_RevokeCoupon_FullMethodName        = "/coupon.CouponService/RevokeCoupon"
+	CouponService_ListCouponTemplates_FullMethodName = "/coupon.CouponService/ListCouponTemplates"
+	CouponService_CreateCampaign_FullMethodName      = "/coupon.CouponService/CreateCampaign"
+	CouponService_CancelCampaign_FullMethodName      = "/coupon.CouponService/CancelCampaign"
+	CouponService_GetCampaign_FullMethodName         = "/coupon.CouponService/GetCampaign"
+	CouponService_ListCampaigns_FullMethodName       = "/coupon.CouponService/ListCampaigns"
+	CouponService_TriggerCampaigns_FullMethodName    = "/coupon.CouponService/TriggerCampaigns"
+	CouponService_CreateCodeBatch_FullMethodName     = "/coupon.CouponService/CreateCodeBatch"
+	CouponService_GetCodeBatch_FullMethodName        = "/coupon.CouponService/GetCodeBatch"
+	CouponService_DisableCodeBatch_FullMethodName    = "/coupon.CouponService/DisableCodeBatch"
+	CouponService_ExportCodeBatch_FullMethodName     = "/coupon.CouponService/ExportCodeBatch"
+	CouponService_RedeemCode_FullMethodName          = "/coupon.CouponService/RedeemCode"
+	CouponService_GetCouponStats_FullMethodName      = "/coupon.CouponService/GetCouponStats"
+	CouponService_CreatePromotion_FullMethodName     = "/coupon.CouponService/CreatePromotion"
+	CouponService_DisablePromotion_FullMethodName    = "/coupon.CouponService/DisablePromotion"
+	CouponService_ListPromotions_FullMethodName      = "/coupon.CouponService/ListPromotions"
+	CouponService_EvaluatePromotions_FullMethodName  = "/coupon.CouponService/EvaluatePromotions"
 )
 
 // CouponServiceClient is the client API for CouponService service.
@@ -38,6 +57,8 @@ type CouponServiceClient interface {
 	ListUserCoupons(ctx context.Context, in *ListUserCouponsReq, opts ...grpc.CallOption) (*ListUserCouponsResp, error)
 	// 校验优惠券可用性
 	ValidateCoupon(ctx context.Context, in *ValidateCouponReq, opts ...grpc.CallOption) (*ValidateCouponResp, error)
+	// 推荐最优优惠券
+	RecommendCoupons(ctx context.Context, in *RecommendCouponsReq, opts ...grpc.CallOption) (*RecommendCouponsResp, error)
 	// 锁定优惠券
 	LockCoupon(ctx context.Context, in *LockCouponReq, opts ...grpc.CallOption) (*LockCouponResp, error)
 	// 释放优惠券
@@ -46,6 +67,31 @@ type CouponServiceClient interface {
 	RedeemCoupon(ctx context.Context, in *RedeemCouponReq, opts ...grpc.CallOption) (*RedeemCouponResp, error)
 	// 发布/批量发券
 	PublishCoupon(ctx context.Context, in *PublishCouponReq, opts ...grpc.CallOption) (*PublishCouponResp, error)
+	// 出资方管理券模板
+	UpdateCoupon(ctx context.Context, in *UpdateCouponReq, opts ...grpc.CallOption) (*UpdateCouponResp, error)
+	RevokeCoupon(ctx context.Context, in *RevokeCouponReq, opts ...grpc.CallOption) (*RevokeCouponResp, error)
+	ListCouponTemplates(ctx context.Context, in *ListCouponTemplatesReq, opts ...grpc.CallOption) (*ListCouponTemplatesResp, error)
+	// 自动发券活动
+	CreateCampaign(ctx context.Context, in *CreateCampaignReq, opts ...grpc.CallOption) (*CreateCampaignResp, error)
+	CancelCampaign(ctx context.Context, in *CancelCampaignReq, opts ...grpc.CallOption) (*CancelCampaignResp, error)
+	GetCampaign(ctx context.Context, in *GetCampaignReq, opts ...grpc.CallOption) (*GetCampaignResp, error)
+	ListCampaigns(ctx context.Context, in *ListCampaignsReq, opts ...grpc.CallOption) (*ListCampaignsResp, error)
+	// 注册、首单等用户事件触发发券
+	TriggerCampaigns(ctx context.Context, in *TriggerCampaignsReq, opts ...grpc.CallOption) (*TriggerCampaignsResp, error)
+	// 兑换码
+	CreateCodeBatch(ctx context.Context, in *CreateCodeBatchReq, opts ...grpc.CallOption) (*CreateCodeBatchResp, error)
+	GetCodeBatch(ctx context.Context, in *GetCodeBatchReq, opts ...grpc.CallOption) (*GetCodeBatchResp, error)
+	DisableCodeBatch(ctx context.Context, in *DisableCodeBatchReq, opts ...grpc.CallOption) (*DisableCodeBatchResp, error)
+	ExportCodeBatch(ctx context.Context, in *ExportCodeBatchReq, opts ...grpc.CallOption) (*ExportCodeBatchResp, error)
+	RedeemCode(ctx context.Context, in *RedeemCodeReq, opts ...grpc.CallOption) (*RedeemCodeResp, error)
+	// 券效果统计
+	GetCouponStats(ctx context.Context, in *GetCouponStatsReq, opts ...grpc.CallOption) (*GetCouponStatsResp, error)
+	// 促销活动
+	CreatePromotion(ctx context.Context, in *CreatePromotionReq, opts ...grpc.CallOption) (*CreatePromotionResp, error)
+	DisablePromotion(ctx context.Context, in *DisablePromotionReq, opts ...grpc.CallOption) (*DisablePromotionResp, error)
+	ListPromotions(ctx context.Context, in *ListPromotionsReq, opts ...grpc.CallOption) (*ListPromotionsResp, error)
+	// 结算时计算可用促销及与优惠券的叠加
+	EvaluatePromotions(ctx context.Context, in *EvaluatePromotionsReq, opts ...grpc.CallOption) (*EvaluatePromotionsResp, error)
 }
 
 type couponServiceClient struct {
@@ -86,6 +132,16 @@ func (c *couponServiceClient) ValidateCoupon(ctx context.Context, in *ValidateCo
 	return out, nil
 }
 
+func (c *couponServiceClient) RecommendCoupons(ctx context.Context, in *RecommendCouponsReq, opts ...grpc.CallOption) (*RecommendCouponsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendCouponsResp)
+	err := c.cc.Invoke(ctx, CouponService_RecommendCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *couponServiceClient) LockCoupon(ctx context.Context, in *LockCouponReq, opts ...grpc.CallOption) (*LockCouponResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LockCouponResp)
@@ -126,6 +182,186 @@ func (c *couponServiceClient) PublishCoupon(ctx context.Context, in *PublishCoup
 	return out, nil
 }
 
+func (c *couponServiceClient) UpdateCoupon(ctx context.Context, in *UpdateCouponReq, opts ...grpc.CallOption) (*UpdateCouponResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCouponResp)
+	err := c.cc.Invoke(ctx, CouponService_UpdateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) RevokeCoupon(ctx context.Context, in *RevokeCouponReq, opts ...grpc.CallOption) (*RevokeCouponResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeCouponResp)
+	err := c.cc.Invoke(ctx, CouponService_RevokeCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) ListCouponTemplates(ctx context.Context, in *ListCouponTemplatesReq, opts ...grpc.CallOption) (*ListCouponTemplatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponTemplatesResp)
+	err := c.cc.Invoke(ctx, CouponService_ListCouponTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignReq, opts ...grpc.CallOption) (*CreateCampaignResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampaignResp)
+	err := c.cc.Invoke(ctx, CouponService_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) CancelCampaign(ctx context.Context, in *CancelCampaignReq, opts ...grpc.CallOption) (*CancelCampaignResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCampaignResp)
+	err := c.cc.Invoke(ctx, CouponService_CancelCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) GetCampaign(ctx context.Context, in *GetCampaignReq, opts ...grpc.CallOption) (*GetCampaignResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResp)
+	err := c.cc.Invoke(ctx, CouponService_GetCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) ListCampaigns(ctx context.Context, in *ListCampaignsReq, opts ...grpc.CallOption) (*ListCampaignsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignsResp)
+	err := c.cc.Invoke(ctx, CouponService_ListCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) TriggerCampaigns(ctx context.Context, in *TriggerCampaignsReq, opts ...grpc.CallOption) (*TriggerCampaignsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerCampaignsResp)
+	err := c.cc.Invoke(ctx, CouponService_TriggerCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) CreateCodeBatch(ctx context.Context, in *CreateCodeBatchReq, opts ...grpc.CallOption) (*CreateCodeBatchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCodeBatchResp)
+	err := c.cc.Invoke(ctx, CouponService_CreateCodeBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) GetCodeBatch(ctx context.Context, in *GetCodeBatchReq, opts ...grpc.CallOption) (*GetCodeBatchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCodeBatchResp)
+	err := c.cc.Invoke(ctx, CouponService_GetCodeBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) DisableCodeBatch(ctx context.Context, in *DisableCodeBatchReq, opts ...grpc.CallOption) (*DisableCodeBatchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableCodeBatchResp)
+	err := c.cc.Invoke(ctx, CouponService_DisableCodeBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) ExportCodeBatch(ctx context.Context, in *ExportCodeBatchReq, opts ...grpc.CallOption) (*ExportCodeBatchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCodeBatchResp)
+	err := c.cc.Invoke(ctx, CouponService_ExportCodeBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) RedeemCode(ctx context.Context, in *RedeemCodeReq, opts ...grpc.CallOption) (*RedeemCodeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemCodeResp)
+	err := c.cc.Invoke(ctx, CouponService_RedeemCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) GetCouponStats(ctx context.Context, in *GetCouponStatsReq, opts ...grpc.CallOption) (*GetCouponStatsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCouponStatsResp)
+	err := c.cc.Invoke(ctx, CouponService_GetCouponStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) CreatePromotion(ctx context.Context, in *CreatePromotionReq, opts ...grpc.CallOption) (*CreatePromotionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromotionResp)
+	err := c.cc.Invoke(ctx, CouponService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) DisablePromotion(ctx context.Context, in *DisablePromotionReq, opts ...grpc.CallOption) (*DisablePromotionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisablePromotionResp)
+	err := c.cc.Invoke(ctx, CouponService_DisablePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) ListPromotions(ctx context.Context, in *ListPromotionsReq, opts ...grpc.CallOption) (*ListPromotionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResp)
+	err := c.cc.Invoke(ctx, CouponService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) EvaluatePromotions(ctx context.Context, in *EvaluatePromotionsReq, opts ...grpc.CallOption) (*EvaluatePromotionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluatePromotionsResp)
+	err := c.cc.Invoke(ctx, CouponService_EvaluatePromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CouponServiceServer is the server API for CouponService service.
 // All implementations must embed UnimplementedCouponServiceServer
 // for forward compatibility.
@@ -136,6 +372,8 @@ type CouponServiceServer interface {
 	ListUserCoupons(context.Context, *ListUserCouponsReq) (*ListUserCouponsResp, error)
 	// 校验优惠券可用性
 	ValidateCoupon(context.Context, *ValidateCouponReq) (*ValidateCouponResp, error)
+	// 推荐最优优惠券
+	RecommendCoupons(context.Context, *RecommendCouponsReq) (*RecommendCouponsResp, error)
 	// 锁定优惠券
 	LockCoupon(context.Context, *LockCouponReq) (*LockCouponResp, error)
 	// 释放优惠券
@@ -144,6 +382,31 @@ type CouponServiceServer interface {
 	RedeemCoupon(context.Context, *RedeemCouponReq) (*RedeemCouponResp, error)
 	// 发布/批量发券
 	PublishCoupon(context.Context, *PublishCouponReq) (*PublishCouponResp, error)
+	// 出资方管理券模板
+	UpdateCoupon(context.Context, *UpdateCouponReq) (*UpdateCouponResp, error)
+	RevokeCoupon(context.Context, *RevokeCouponReq) (*RevokeCouponResp, error)
+	ListCouponTemplates(context.Context, *ListCouponTemplatesReq) (*ListCouponTemplatesResp, error)
+	// 自动发券活动
+	CreateCampaign(context.Context, *CreateCampaignReq) (*CreateCampaignResp, error)
+	CancelCampaign(context.Context, *CancelCampaignReq) (*CancelCampaignResp, error)
+	GetCampaign(context.Context, *GetCampaignReq) (*GetCampaignResp, error)
+	ListCampaigns(context.Context, *ListCampaignsReq) (*ListCampaignsResp, error)
+	// 注册、首单等用户事件触发发券
+	TriggerCampaigns(context.Context, *TriggerCampaignsReq) (*TriggerCampaignsResp, error)
+	// 兑换码
+	CreateCodeBatch(context.Context, *CreateCodeBatchReq) (*CreateCodeBatchResp, error)
+	GetCodeBatch(context.Context, *GetCodeBatchReq) (*GetCodeBatchResp, error)
+	DisableCodeBatch(context.Context, *DisableCodeBatchReq) (*DisableCodeBatchResp, error)
+	ExportCodeBatch(context.Context, *ExportCodeBatchReq) (*ExportCodeBatchResp, error)
+	RedeemCode(context.Context, *RedeemCodeReq) (*RedeemCodeResp, error)
+	// 券效果统计
+	GetCouponStats(context.Context, *GetCouponStatsReq) (*GetCouponStatsResp, error)
+	// 促销活动
+	CreatePromotion(context.Context, *CreatePromotionReq) (*CreatePromotionResp, error)
+	DisablePromotion(context.Context, *DisablePromotionReq) (*DisablePromotionResp, error)
+	ListPromotions(context.Context, *ListPromotionsReq) (*ListPromotionsResp, error)
+	// 结算时计算可用促销及与优惠券的叠加
+	EvaluatePromotions(context.Context, *EvaluatePromotionsReq) (*EvaluatePromotionsResp, error)
 	mustEmbedUnimplementedCouponServiceServer()
 }
 
@@ -163,6 +426,9 @@ func (UnimplementedCouponServiceServer) ListUserCoupons(context.Context, *ListUs
 func (UnimplementedCouponServiceServer) ValidateCoupon(context.Context, *ValidateCouponReq) (*ValidateCouponResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateCoupon not implemented")
 }
+func (UnimplementedCouponServiceServer) RecommendCoupons(context.Context, *RecommendCouponsReq) (*RecommendCouponsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendCoupons not implemented")
+}
 func (UnimplementedCouponServiceServer) LockCoupon(context.Context, *LockCouponReq) (*LockCouponResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockCoupon not implemented")
 }
@@ -175,6 +441,60 @@ func (UnimplementedCouponServiceServer) RedeemCoupon(context.Context, *RedeemCou
 func (UnimplementedCouponServiceServer) PublishCoupon(context.Context, *PublishCouponReq) (*PublishCouponResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishCoupon not implemented")
 }
+func (UnimplementedCouponServiceServer) UpdateCoupon(context.Context, *UpdateCouponReq) (*UpdateCouponResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCoupon not implemented")
+}
+func (UnimplementedCouponServiceServer) RevokeCoupon(context.Context, *RevokeCouponReq) (*RevokeCouponResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCoupon not implemented")
+}
+func (UnimplementedCouponServiceServer) ListCouponTemplates(context.Context, *ListCouponTemplatesReq) (*ListCouponTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCouponTemplates not implemented")
+}
+func (UnimplementedCouponServiceServer) CreateCampaign(context.Context, *CreateCampaignReq) (*CreateCampaignResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedCouponServiceServer) CancelCampaign(context.Context, *CancelCampaignReq) (*CancelCampaignResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCampaign not implemented")
+}
+func (UnimplementedCouponServiceServer) GetCampaign(context.Context, *GetCampaignReq) (*GetCampaignResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaign not implemented")
+}
+func (UnimplementedCouponServiceServer) ListCampaigns(context.Context, *ListCampaignsReq) (*ListCampaignsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaigns not implemented")
+}
+func (UnimplementedCouponServiceServer) TriggerCampaigns(context.Context, *TriggerCampaignsReq) (*TriggerCampaignsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerCampaigns not implemented")
+}
+func (UnimplementedCouponServiceServer) CreateCodeBatch(context.Context, *CreateCodeBatchReq) (*CreateCodeBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCodeBatch not implemented")
+}
+func (UnimplementedCouponServiceServer) GetCodeBatch(context.Context, *GetCodeBatchReq) (*GetCodeBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCodeBatch not implemented")
+}
+func (UnimplementedCouponServiceServer) DisableCodeBatch(context.Context, *DisableCodeBatchReq) (*DisableCodeBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableCodeBatch not implemented")
+}
+func (UnimplementedCouponServiceServer) ExportCodeBatch(context.Context, *ExportCodeBatchReq) (*ExportCodeBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCodeBatch not implemented")
+}
+func (UnimplementedCouponServiceServer) RedeemCode(context.Context, *RedeemCodeReq) (*RedeemCodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCode not implemented")
+}
+func (UnimplementedCouponServiceServer) GetCouponStats(context.Context, *GetCouponStatsReq) (*GetCouponStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCouponStats not implemented")
+}
+func (UnimplementedCouponServiceServer) CreatePromotion(context.Context, *CreatePromotionReq) (*CreatePromotionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedCouponServiceServer) DisablePromotion(context.Context, *DisablePromotionReq) (*DisablePromotionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePromotion not implemented")
+}
+func (UnimplementedCouponServiceServer) ListPromotions(context.Context, *ListPromotionsReq) (*ListPromotionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedCouponServiceServer) EvaluatePromotions(context.Context, *EvaluatePromotionsReq) (*EvaluatePromotionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluatePromotions not implemented")
+}
 func (UnimplementedCouponServiceServer) mustEmbedUnimplementedCouponServiceServer() {}
 func (UnimplementedCouponServiceServer) testEmbeddedByValue()                       {}
 
@@ -250,6 +570,24 @@ func _CouponService_ValidateCoupon_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CouponService_RecommendCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendCouponsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).RecommendCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_RecommendCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).RecommendCoupons(ctx, req.(*RecommendCouponsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CouponService_LockCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LockCouponReq)
 	if err := dec(in); err != nil {
@@ -322,6 +660,330 @@ func _CouponService_PublishCoupon_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CouponService_UpdateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCouponReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).UpdateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_UpdateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).UpdateCoupon(ctx, req.(*UpdateCouponReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_RevokeCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCouponReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).RevokeCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_RevokeCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).RevokeCoupon(ctx, req.(*RevokeCouponReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_ListCouponTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).ListCouponTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_ListCouponTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).ListCouponTemplates(ctx, req.(*ListCouponTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).CreateCampaign(ctx, req.(*CreateCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_CancelCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).CancelCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_CancelCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).CancelCampaign(ctx, req.(*CancelCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_GetCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).GetCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_GetCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).GetCampaign(ctx, req.(*GetCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_ListCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).ListCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_ListCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).ListCampaigns(ctx, req.(*ListCampaignsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_TriggerCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerCampaignsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).TriggerCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_TriggerCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).TriggerCampaigns(ctx, req.(*TriggerCampaignsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_CreateCodeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCodeBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).CreateCodeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_CreateCodeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).CreateCodeBatch(ctx, req.(*CreateCodeBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_GetCodeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodeBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).GetCodeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_GetCodeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).GetCodeBatch(ctx, req.(*GetCodeBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_DisableCodeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableCodeBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).DisableCodeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_DisableCodeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).DisableCodeBatch(ctx, req.(*DisableCodeBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_ExportCodeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCodeBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).ExportCodeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_ExportCodeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).ExportCodeBatch(ctx, req.(*ExportCodeBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_RedeemCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).RedeemCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_RedeemCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).RedeemCode(ctx, req.(*RedeemCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_GetCouponStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).GetCouponStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_GetCouponStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).GetCouponStats(ctx, req.(*GetCouponStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).CreatePromotion(ctx, req.(*CreatePromotionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_DisablePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePromotionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).DisablePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_DisablePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).DisablePromotion(ctx, req.(*DisablePromotionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).ListPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_ListPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).ListPromotions(ctx, req.(*ListPromotionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_EvaluatePromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluatePromotionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).EvaluatePromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_EvaluatePromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).EvaluatePromotions(ctx, req.(*EvaluatePromotionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CouponService_ServiceDesc is the grpc.ServiceDesc for CouponService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +1003,10 @@ var CouponService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateCoupon",
 			Handler:    _CouponService_ValidateCoupon_Handler,
 		},
+		{
+			MethodName: "RecommendCoupons",
+			Handler:    _CouponService_RecommendCoupons_Handler,
+		},
 		{
 			MethodName: "LockCoupon",
 			Handler:    _CouponService_LockCoupon_Handler,
@@ -357,6 +1023,78 @@ var CouponService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishCoupon",
 			Handler:    _CouponService_PublishCoupon_Handler,
 		},
+		{
+			MethodName: "UpdateCoupon",
+			Handler:    _CouponService_UpdateCoupon_Handler,
+		},
+		{
+			MethodName: "RevokeCoupon",
+			Handler:    _CouponService_RevokeCoupon_Handler,
+		},
+		{
+			MethodName: "ListCouponTemplates",
+			Handler:    _CouponService_ListCouponTemplates_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _CouponService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "CancelCampaign",
+			Handler:    _CouponService_CancelCampaign_Handler,
+		},
+		{
+			MethodName: "GetCampaign",
+			Handler:    _CouponService_GetCampaign_Handler,
+		},
+		{
+			MethodName: "ListCampaigns",
+			Handler:    _CouponService_ListCampaigns_Handler,
+		},
+		{
+			MethodName: "TriggerCampaigns",
+			Handler:    _CouponService_TriggerCampaigns_Handler,
+		},
+		{
+			MethodName: "CreateCodeBatch",
+			Handler:    _CouponService_CreateCodeBatch_Handler,
+		},
+		{
+			MethodName: "GetCodeBatch",
+			Handler:    _CouponService_GetCodeBatch_Handler,
+		},
+		{
+			MethodName: "DisableCodeBatch",
+			Handler:    _CouponService_DisableCodeBatch_Handler,
+		},
+		{
+			MethodName: "ExportCodeBatch",
+			Handler:    _CouponService_ExportCodeBatch_Handler,
+		},
+		{
+			MethodName: "RedeemCode",
+			Handler:    _CouponService_RedeemCode_Handler,
+		},
+		{
+			MethodName: "GetCouponStats",
+			Handler:    _CouponService_GetCouponStats_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _CouponService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "DisablePromotion",
+			Handler:    _CouponService_DisablePromotion_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _CouponService_ListPromotions_Handler,
+		},
+		{
+			MethodName: "EvaluatePromotions",
+			Handler:    _CouponService_EvaluatePromotions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coupon.proto",
@@ -14,21 +14,73 @@ import (
 )
 
 type (
-	ClaimCouponReq      = coupon.ClaimCouponReq
-	ClaimCouponResp     = coupon.ClaimCouponResp
-	CouponInfo          = coupon.CouponInfo
-	ListUserCouponsReq  = coupon.ListUserCouponsReq
-	ListUserCouponsResp = coupon.ListUserCouponsResp
-	LockCouponReq       = coupon.LockCouponReq
-	LockCouponResp      = coupon.LockCouponResp
-	PublishCouponReq    = coupon.PublishCouponReq
-	PublishCouponResp   = coupon.PublishCouponResp
-	RedeemCouponReq     = coupon.RedeemCouponReq
-	RedeemCouponResp    = coupon.RedeemCouponResp
-	ReleaseCouponReq    = coupon.ReleaseCouponReq
-	ReleaseCouponResp   = coupon.ReleaseCouponResp
-	ValidateCouponReq   = coupon.ValidateCouponReq
-	ValidateCouponResp  = coupon.ValidateCouponResp
+	AppliedPromotion        = coupon.AppliedPromotion
+	CampaignInfo            = coupon.CampaignInfo
+	CampaignSegment         = coupon.CampaignSegment
+	CancelCampaignReq       = coupon.CancelCampaignReq
+	CancelCampaignResp      = coupon.CancelCampaignResp
+	ClaimCouponReq          = coupon.ClaimCouponReq
+	ClaimCouponResp         = coupon.ClaimCouponResp
+	CodeBatchInfo           = coupon.CodeBatchInfo
+	CouponInfo              = coupon.CouponInfo
+	CouponRecommendation    = coupon.CouponRecommendation
+	CouponScope             = coupon.CouponScope
+	CouponStats             = coupon.CouponStats
+	CouponTemplate          = coupon.CouponTemplate
+	CreateCampaignReq       = coupon.CreateCampaignReq
+	CreateCampaignResp      = coupon.CreateCampaignResp
+	CreateCodeBatchReq      = coupon.CreateCodeBatchReq
+	CreateCodeBatchResp     = coupon.CreateCodeBatchResp
+	CreatePromotionReq      = coupon.CreatePromotionReq
+	CreatePromotionResp     = coupon.CreatePromotionResp
+	DisableCodeBatchReq     = coupon.DisableCodeBatchReq
+	DisableCodeBatchResp    = coupon.DisableCodeBatchResp
+	DisablePromotionReq     = coupon.DisablePromotionReq
+	DisablePromotionResp    = coupon.DisablePromotionResp
+	EvaluatePromotionsReq   = coupon.EvaluatePromotionsReq
+	EvaluatePromotionsResp  = coupon.EvaluatePromotionsResp
+	ExportCodeBatchReq      = coupon.ExportCodeBatchReq
+	ExportCodeBatchResp     = coupon.ExportCodeBatchResp
+	GetCampaignReq          = coupon.GetCampaignReq
+	GetCampaignResp         = coupon.GetCampaignResp
+	GetCodeBatchReq         = coupon.GetCodeBatchReq
+	GetCodeBatchResp        = coupon.GetCodeBatchResp
+	GetCouponStatsReq       = coupon.GetCouponStatsReq
+	GetCouponStatsResp      = coupon.GetCouponStatsResp
+	ListCampaignsReq        = coupon.ListCampaignsReq
+	ListCampaignsResp       = coupon.ListCampaignsResp
+	ListCouponTemplatesReq  = coupon.ListCouponTemplatesReq
+	ListCouponTemplatesResp = coupon.ListCouponTemplatesResp
+	ListPromotionsReq       = coupon.ListPromotionsReq
+	ListPromotionsResp      = coupon.ListPromotionsResp
+	ListUserCouponsReq      = coupon.ListUserCouponsReq
+	ListUserCouponsResp     = coupon.ListUserCouponsResp
+	LockCouponReq           = coupon.LockCouponReq
+	LockCouponResp          = coupon.LockCouponResp
+	MultiBuyStep            = coupon.MultiBuyStep
+	OrderLine               = coupon.OrderLine
+	PromotionInfo           = coupon.PromotionInfo
+	PromotionRule           = coupon.PromotionRule
+	PromotionTier           = coupon.PromotionTier
+	PublishCouponReq        = coupon.PublishCouponReq
+	PublishCouponResp       = coupon.PublishCouponResp
+	RecommendCouponsReq     = coupon.RecommendCouponsReq
+	RecommendCouponsResp    = coupon.RecommendCouponsResp
+	RedeemCodeReq           = coupon.RedeemCodeReq
+	RedeemCodeResp          = coupon.RedeemCodeResp
+	RedeemCouponReq         = coupon.RedeemCouponReq
+	RedeemCouponResp        = coupon.RedeemCouponResp
+	ReleaseCouponReq        = coupon.ReleaseCouponReq
+	ReleaseCouponResp       = coupon.ReleaseCouponResp
+	RevokeCouponReq         = coupon.RevokeCouponReq
+	RevokeCouponResp        = coupon.RevokeCouponResp
+	SkippedPromotion        = coupon.SkippedPromotion
+	TriggerCampaignsReq     = coupon.TriggerCampaignsReq
+	TriggerCampaignsResp    = coupon.TriggerCampaignsResp
+	UpdateCouponReq         = coupon.UpdateCouponReq
+	UpdateCouponResp        = coupon.UpdateCouponResp
+	ValidateCouponReq       = coupon.ValidateCouponReq
+	ValidateCouponResp      = coupon.ValidateCouponResp
 
 	CouponService interface {
 		// 领取优惠券
@@ -37,6 +89,8 @@ type (
 		ListUserCoupons(ctx context.Context, in *ListUserCouponsReq, opts ...grpc.CallOption) (*ListUserCouponsResp, error)
 		// 校验优惠券可用性
 		ValidateCoupon(ctx context.Context, in *ValidateCouponReq, opts ...grpc.CallOption) (*ValidateCouponResp, error)
+		// 推荐最优优惠券
+		RecommendCoupons(ctx context.Context, in *RecommendCouponsReq, opts ...grpc.CallOption) (*RecommendCouponsResp, error)
 		// 锁定优惠券
 		LockCoupon(ctx context.Context, in *LockCouponReq, opts ...grpc.CallOption) (*LockCouponResp, error)
 		// 释放优惠券
@@ -45,6 +99,31 @@ type (
 		RedeemCoupon(ctx context.Context, in *RedeemCouponReq, opts ...grpc.CallOption) (*RedeemCouponResp, error)
 		// 发布/批量发券
 		PublishCoupon(ctx context.Context, in *PublishCouponReq, opts ...grpc.CallOption) (*PublishCouponResp, error)
+		// 出资方管理券模板
+		UpdateCoupon(ctx context.Context, in *UpdateCouponReq, opts ...grpc.CallOption) (*UpdateCouponResp, error)
+		RevokeCoupon(ctx context.Context, in *RevokeCouponReq, opts ...grpc.CallOption) (*RevokeCouponResp, error)
+		ListCouponTemplates(ctx context.Context, in *ListCouponTemplatesReq, opts ...grpc.CallOption) (*ListCouponTemplatesResp, error)
+		// 自动发券活动
+		CreateCampaign(ctx context.Context, in *CreateCampaignReq, opts ...grpc.CallOption) (*CreateCampaignResp, error)
+		CancelCampaign(ctx context.Context, in *CancelCampaignReq, opts ...grpc.CallOption) (*CancelCampaignResp, error)
+		GetCampaign(ctx context.Context, in *GetCampaignReq, opts ...grpc.CallOption) (*GetCampaignResp, error)
+		ListCampaigns(ctx context.Context, in *ListCampaignsReq, opts ...grpc.CallOption) (*ListCampaignsResp, error)
+		// 注册、首单等用户事件触发发券
+		TriggerCampaigns(ctx context.Context, in *TriggerCampaignsReq, opts ...grpc.CallOption) (*TriggerCampaignsResp, error)
+		// 兑换码
+		CreateCodeBatch(ctx context.Context, in *CreateCodeBatchReq, opts ...grpc.CallOption) (*CreateCodeBatchResp, error)
+		GetCodeBatch(ctx context.Context, in *GetCodeBatchReq, opts ...grpc.CallOption) (*GetCodeBatchResp, error)
+		DisableCodeBatch(ctx context.Context, in *DisableCodeBatchReq, opts ...grpc.CallOption) (*DisableCodeBatchResp, error)
+		ExportCodeBatch(ctx context.Context, in *ExportCodeBatchReq, opts ...grpc.CallOption) (*ExportCodeBatchResp, error)
+		RedeemCode(ctx context.Context, in *RedeemCodeReq, opts ...grpc.CallOption) (*RedeemCodeResp, error)
+		// 券效果统计
+		GetCouponStats(ctx context.Context, in *GetCouponStatsReq, opts ...grpc.CallOption) (*GetCouponStatsResp, error)
+		// 促销活动
+		CreatePromotion(ctx context.Context, in *CreatePromotionReq, opts ...grpc.CallOption) (*CreatePromotionResp, error)
+		DisablePromotion(ctx context.Context, in *DisablePromotionReq, opts ...grpc.CallOption) (*DisablePromotionResp, error)
+		ListPromotions(ctx context.Context, in *ListPromotionsReq, opts ...grpc.CallOption) (*ListPromotionsResp, error)
+		// 结算时计算可用促销及与优惠券的叠加
+		EvaluatePromotions(ctx context.Context, in *EvaluatePromotionsReq, opts ...grpc.CallOption) (*EvaluatePromotionsResp, error)
 	}
 
 	defaultCouponService struct {
@@ -76,6 +155,12 @@ func (m *defaultCouponService) ValidateCoupon(ctx context.Context, in *ValidateC
 	return client.ValidateCoupon(ctx, in, opts...)
 }
 
+// 推荐最优优惠券
+func (m *defaultCouponService) RecommendCoupons(ctx context.Context, in *RecommendCouponsReq, opts ...grpc.CallOption) (*RecommendCouponsResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.RecommendCoupons(ctx, in, opts...)
+}
+
 // 锁定优惠券
 func (m *defaultCouponService) LockCoupon(ctx context.Context, in *LockCouponReq, opts ...grpc.CallOption) (*LockCouponResp, error) {
 	client := coupon.NewCouponServiceClient(m.cli.Conn())
@@ -99,3 +184,100 @@ func (m *defaultCouponService) PublishCoupon(ctx context.Context, in *PublishCou
 	client := coupon.NewCouponServiceClient(m.cli.Conn())
 	return client.PublishCoupon(ctx, in, opts...)
 }
+
+// 出资方管理券模板
+func (m *defaultCouponService) UpdateCoupon(ctx context.Context, in *UpdateCouponReq, opts ...grpc.CallOption) (*UpdateCouponResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.UpdateCoupon(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) RevokeCoupon(ctx context.Context, in *RevokeCouponReq, opts ...grpc.CallOption) (*RevokeCouponResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.RevokeCoupon(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) ListCouponTemplates(ctx context.Context, in *ListCouponTemplatesReq, opts ...grpc.CallOption) (*ListCouponTemplatesResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.ListCouponTemplates(ctx, in, opts...)
+}
+
+// 自动发券活动
+func (m *defaultCouponService) CreateCampaign(ctx context.Context, in *CreateCampaignReq, opts ...grpc.CallOption) (*CreateCampaignResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.CreateCampaign(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) CancelCampaign(ctx context.Context, in *CancelCampaignReq, opts ...grpc.CallOption) (*CancelCampaignResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.CancelCampaign(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) GetCampaign(ctx context.Context, in *GetCampaignReq, opts ...grpc.CallOption) (*GetCampaignResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.GetCampaign(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) ListCampaigns(ctx context.Context, in *ListCampaignsReq, opts ...grpc.CallOption) (*ListCampaignsResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.ListCampaigns(ctx, in, opts...)
+}
+
+// 注册、首单等用户事件触发发券
+func (m *defaultCouponService) TriggerCampaigns(ctx context.Context, in *TriggerCampaignsReq, opts ...grpc.CallOption) (*TriggerCampaignsResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.TriggerCampaigns(ctx, in, opts...)
+}
+
+// 兑换码
+func (m *defaultCouponService) CreateCodeBatch(ctx context.Context, in *CreateCodeBatchReq, opts ...grpc.CallOption) (*CreateCodeBatchResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.CreateCodeBatch(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) GetCodeBatch(ctx context.Context, in *GetCodeBatchReq, opts ...grpc.CallOption) (*GetCodeBatchResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.GetCodeBatch(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) DisableCodeBatch(ctx context.Context, in *DisableCodeBatchReq, opts ...grpc.CallOption) (*DisableCodeBatchResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.DisableCodeBatch(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) ExportCodeBatch(ctx context.Context, in *ExportCodeBatchReq, opts ...grpc.CallOption) (*ExportCodeBatchResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.ExportCodeBatch(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) RedeemCode(ctx context.Context, in *RedeemCodeReq, opts ...grpc.CallOption) (*RedeemCodeResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.RedeemCode(ctx, in, opts...)
+}
+
+// 券效果统计
+func (m *defaultCouponService) GetCouponStats(ctx context.Context, in *GetCouponStatsReq, opts ...grpc.CallOption) (*GetCouponStatsResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.GetCouponStats(ctx, in, opts...)
+}
+
+// 促销活动
+func (m *defaultCouponService) CreatePromotion(ctx context.Context, in *CreatePromotionReq, opts ...grpc.CallOption) (*CreatePromotionResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.CreatePromotion(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) DisablePromotion(ctx context.Context, in *DisablePromotionReq, opts ...grpc.CallOption) (*DisablePromotionResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.DisablePromotion(ctx, in, opts...)
+}
+
+func (m *defaultCouponService) ListPromotions(ctx context.Context, in *ListPromotionsReq, opts ...grpc.CallOption) (*ListPromotionsResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.ListPromotions(ctx, in, opts...)
+}
+
+// 结算时计算可用促销及与优惠券的叠加
+func (m *defaultCouponService) EvaluatePromotions(ctx context.Context, in *EvaluatePromotionsReq, opts ...grpc.CallOption) (*EvaluatePromotionsResp, error) {
+	client := coupon.NewCouponServiceClient(m.cli.Conn())
+	return client.EvaluatePromotions(ctx, in, opts...)
+}
@@ -0,0 +1,40 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/services/coupon/internal/campaign"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// StartCampaign 定时推进自动发券活动，SEGMENT/BIRTHDAY 活动由 Redis 锁保证同一时刻只有一个实例执行。
+func StartCampaign(sc *svc.ServiceContext) func() {
+	conf := sc.Config.Campaign
+	if !conf.Enabled {
+		return func() {}
+	}
+	interval := time.Duration(conf.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := campaign.Run(ctx, sc, time.Now()); err != nil {
+					logx.WithContext(ctx).Errorf("coupon campaign run failed: %v", err)
+				}
+			}
+		}
+	}()
+	return cancel
+}
@@ -0,0 +1,19 @@
+package bootstrap
+
+import (
+	"context"
+
+	"NatsumeAI/app/services/coupon/internal/mq"
+	"NatsumeAI/app/services/coupon/internal/svc"
+)
+
+// StartKafka 启动领券事件消费者，返回停止函数
+func StartKafka(sc *svc.ServiceContext) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		if err := mq.StartClaimConsumer(ctx, sc); err != nil {
+			panic(err)
+		}
+	}()
+	return cancel
+}
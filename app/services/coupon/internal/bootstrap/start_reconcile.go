@@ -0,0 +1,72 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	reconcileBatch = 200
+	// 结束不久的券仍可能有在途的领券消息
+	reconcileEndedWithin = 24 * time.Hour
+)
+
+// StartReconcile 定期以券实例数量校正 issued_quantity，异步落库失败或重复累加时保持一致。
+func StartReconcile(sc *svc.ServiceContext) func() {
+	conf := sc.Config.Reconcile
+	if !conf.Enabled {
+		return func() {}
+	}
+	interval := time.Duration(conf.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fixed, err := reconcileIssued(ctx, sc, time.Now())
+				if err != nil {
+					logx.WithContext(ctx).Errorf("coupon reconcile failed: fixed=%d err=%v", fixed, err)
+					continue
+				}
+				if fixed > 0 {
+					logx.WithContext(ctx).Infof("coupon reconcile done: fixed=%d", fixed)
+				}
+			}
+		}
+	}()
+	return cancel
+}
+
+func reconcileIssued(ctx context.Context, sc *svc.ServiceContext, now time.Time) (int64, error) {
+	var fixed, afterId int64
+	for {
+		ids, err := sc.CouponsModel.ListIdsEndAfter(ctx, now.Add(-reconcileEndedWithin), afterId, reconcileBatch)
+		if err != nil {
+			return fixed, err
+		}
+		if len(ids) == 0 {
+			return fixed, nil
+		}
+		n, err := sc.CouponsModel.ReconcileIssued(ctx, ids)
+		if err != nil {
+			return fixed, err
+		}
+		fixed += n
+		if len(ids) < reconcileBatch {
+			return fixed, nil
+		}
+		afterId = ids[len(ids)-1]
+	}
+}
@@ -0,0 +1,45 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/services/coupon/internal/svc"
+	"NatsumeAI/app/services/coupon/internal/sweep"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// StartSweep 定时清理过期券与失效的锁券，多实例同时执行时依赖条件更新保证幂等。
+func StartSweep(sc *svc.ServiceContext) func() {
+	conf := sc.Config.Sweep
+	if !conf.Enabled {
+		return func() {}
+	}
+	interval := time.Duration(conf.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				res, err := sweep.Run(ctx, sc, time.Now())
+				if err != nil {
+					logx.WithContext(ctx).Errorf("coupon sweep failed: expired=%d released=%d err=%v", res.Expired, res.Released, err)
+					continue
+				}
+				if res.Expired > 0 || res.Released > 0 {
+					logx.WithContext(ctx).Infof("coupon sweep done: expired=%d released=%d", res.Expired, res.Released)
+				}
+			}
+		}
+	}()
+	return cancel
+}
@@ -0,0 +1,382 @@
+package campaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/snowflake"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+	"NatsumeAI/app/services/order/orderservice"
+	"NatsumeAI/app/services/user/userservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	// 订单服务单次查询用户订单统计的上限
+	maxStatsBatch = 1000
+	// 活动执行锁，防止多实例同时处理同一活动
+	lockKeyPrefix  = "coupon:campaign:lock:"
+	lockExpireSecs = 300
+)
+
+// Segment 人群圈选条件，以 JSON 存于 coupon_campaigns.segment
+type Segment struct {
+	MinOrderCount    int64 `json:"min_order_count,omitempty"`
+	MaxOrderCount    int64 `json:"max_order_count,omitempty"` // -1 表示未下过单
+	MinSpend         int64 `json:"min_spend,omitempty"`
+	ActiveWithinDays int   `json:"active_within_days,omitempty"`
+}
+
+// needOrderStats 是否需要查询订单统计
+func (s Segment) needOrderStats() bool {
+	return s.MinOrderCount > 0 || s.MaxOrderCount != 0 || s.MinSpend > 0
+}
+
+func (s Segment) match(orderCount, spend int64) bool {
+	if s.MinOrderCount > 0 && orderCount < s.MinOrderCount {
+		return false
+	}
+	if s.MaxOrderCount < 0 && orderCount > 0 {
+		return false
+	}
+	if s.MaxOrderCount > 0 && orderCount > s.MaxOrderCount {
+		return false
+	}
+	if s.MinSpend > 0 && spend < s.MinSpend {
+		return false
+	}
+	return true
+}
+
+// ParseSegment 解析活动上保存的人群条件，未配置时返回零值
+func ParseSegment(c *couponmodel.CouponCampaigns) (Segment, error) {
+	var seg Segment
+	if !c.Segment.Valid || c.Segment.String == "" {
+		return seg, nil
+	}
+	err := json.Unmarshal([]byte(c.Segment.String), &seg)
+	return seg, err
+}
+
+// Issue 向用户发放活动券，同一活动同一 bizKey 下每个用户只发一次。
+// 返回 false 表示此前已发过；券模板已发完、已结束时分别返回 ErrCouponSoldOut、ErrCouponExpired。
+// 活动发券不受券模板 per_user_limit 限制。
+// 开启异步领券时库存以 Redis 为准，先在 Redis 中占用一张再同步落库，失败或重复发放时归还。
+func Issue(ctx context.Context, sc *svc.ServiceContext, c *couponmodel.CouponCampaigns, userId int64, bizKey string, now time.Time) (bool, error) {
+	tpl, err := sc.CouponsModel.FindOne(ctx, c.CouponId)
+	if err != nil {
+		return false, err
+	}
+	if now.After(tpl.EndAt) {
+		return false, couponmodel.ErrCouponExpired
+	}
+
+	reserved := sc.KafkaWriter != nil
+	claimNo := strconv.FormatInt(snowflake.Next(), 10)
+	if reserved {
+		if err := sc.CouponClaimModel.Reserve(ctx, c.CouponId, userId, claimNo, now); err != nil {
+			return false, err
+		}
+	}
+
+	issued := false
+	err = sc.MysqlConn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		issueId, err := sc.CouponCampaignIssuesModel.InsertIgnoreWithSession(ctx, session, c.Id, userId, bizKey)
+		if err != nil {
+			return err
+		}
+		if issueId == 0 {
+			return nil
+		}
+
+		instanceId, err := sc.CouponInstancesModel.InsertWithSession(ctx, session, c.CouponId, userId)
+		if err != nil {
+			return err
+		}
+		if reserved {
+			err = sc.CouponsModel.AddIssuedWithSession(ctx, session, c.CouponId, 1)
+		} else {
+			err = sc.CouponsModel.IncrementIssuedWithSession(ctx, session, c.CouponId)
+		}
+		if err != nil {
+			return err
+		}
+		if err := sc.CouponCampaignIssuesModel.SetInstanceWithSession(ctx, session, issueId, instanceId); err != nil {
+			return err
+		}
+		issued = true
+		return nil
+	})
+
+	if reserved {
+		settleReservation(ctx, sc, c.CouponId, userId, claimNo, err == nil && issued)
+	}
+	return issued, err
+}
+
+// settleReservation 落库成功后移除在途记录，否则归还 Redis 中占用的库存
+func settleReservation(ctx context.Context, sc *svc.ServiceContext, couponId, userId int64, claimNo string, persisted bool) {
+	if persisted {
+		if err := sc.CouponClaimModel.Confirm(ctx, couponId, claimNo); err != nil {
+			logx.WithContext(ctx).Errorf("confirm coupon reservation failed: claim=%s coupon=%d err=%v", claimNo, couponId, err)
+		}
+		return
+	}
+	if err := sc.CouponClaimModel.Unclaim(ctx, couponId, userId, claimNo); err != nil {
+		logx.WithContext(ctx).Errorf("release coupon reservation failed: claim=%s coupon=%d user=%d err=%v", claimNo, couponId, userId, err)
+	}
+}
+
+// Run 推进到期活动：结束超过 end_at 的活动，执行 SEGMENT 与 BIRTHDAY 活动，其余事件类活动置为 RUNNING。
+func Run(ctx context.Context, sc *svc.ServiceContext, now time.Time) error {
+	list, err := sc.CouponCampaignsModel.ListDue(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range list {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if now.After(c.EndAt) {
+			finish(ctx, sc, c, "")
+			continue
+		}
+		if c.Status == couponmodel.CampaignStatusScheduled {
+			if _, err := sc.CouponCampaignsModel.UpdateStatus(ctx, c.Id, []string{couponmodel.CampaignStatusScheduled}, couponmodel.CampaignStatusRunning, ""); err != nil {
+				logx.WithContext(ctx).Errorf("start campaign failed: campaign=%d err=%v", c.Id, err)
+				continue
+			}
+		}
+
+		switch c.TriggerType {
+		case couponmodel.CampaignTriggerSegment:
+			err = withLock(ctx, sc, c.Id, func() error { return runSegment(ctx, sc, c, now) })
+		case couponmodel.CampaignTriggerBirthday:
+			if now.Hour() < sc.Config.Campaign.BirthdayHour ||
+				(c.LastRunDate.Valid && c.LastRunDate.Time.Format(time.DateOnly) == now.Format(time.DateOnly)) {
+				continue
+			}
+			err = withLock(ctx, sc, c.Id, func() error { return runBirthday(ctx, sc, c, now) })
+		default:
+			continue
+		}
+		if err != nil {
+			logx.WithContext(ctx).Errorf("run campaign failed: campaign=%d trigger=%s err=%v", c.Id, c.TriggerType, err)
+		}
+	}
+	return nil
+}
+
+// runSegment 从游标处按批扫描用户，单轮最多处理 MaxBatches 批，扫描完毕后结束活动
+func runSegment(ctx context.Context, sc *svc.ServiceContext, c *couponmodel.CouponCampaigns, now time.Time) error {
+	seg, err := ParseSegment(c)
+	if err != nil {
+		finish(ctx, sc, c, "invalid segment")
+		return err
+	}
+
+	conf := sc.Config.Campaign
+	batch := conf.BatchSize
+	if batch <= 0 || batch > maxStatsBatch {
+		batch = maxStatsBatch
+	}
+	var activeAfter int64
+	if seg.ActiveWithinDays > 0 {
+		activeAfter = now.AddDate(0, 0, -seg.ActiveWithinDays).Unix()
+	}
+
+	cursor := c.CursorUserId
+	for i := 0; conf.MaxBatches <= 0 || i < conf.MaxBatches; i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		scan, err := sc.UserRpc.ScanUsers(ctx, &userservice.ScanUsersRequest{
+			AfterId:     cursor,
+			Limit:       int64(batch),
+			ActiveAfter: activeAfter,
+		})
+		if err != nil {
+			return err
+		}
+		if scan.StatusCode != errno.StatusOK {
+			return fmt.Errorf("scan users failed: %s", scan.StatusMsg)
+		}
+		if len(scan.UserIds) == 0 {
+			finish(ctx, sc, c, "")
+			return nil
+		}
+
+		targets := scan.UserIds
+		if seg.needOrderStats() {
+			targets, err = filterByOrders(ctx, sc, seg, scan.UserIds)
+			if err != nil {
+				return err
+			}
+		}
+
+		p := issueBatch(ctx, sc, c, targets, "", now)
+		p.Scanned = int64(len(scan.UserIds))
+		p.Skipped += int64(len(scan.UserIds) - len(targets))
+		p.Cursor = scan.UserIds[len(scan.UserIds)-1]
+		if err := sc.CouponCampaignsModel.AddProgress(ctx, c.Id, p); err != nil {
+			return err
+		}
+		cursor = p.Cursor
+
+		if stop, reason := stopReason(p); stop {
+			finish(ctx, sc, c, reason)
+			return nil
+		}
+		if len(scan.UserIds) < batch {
+			finish(ctx, sc, c, "")
+			return nil
+		}
+	}
+	return nil
+}
+
+// runBirthday 向当天生日的用户发券，非闰年 2 月 28 日一并发放 2 月 29 日生日的用户
+func runBirthday(ctx context.Context, sc *svc.ServiceContext, c *couponmodel.CouponCampaigns, now time.Time) error {
+	days := [][2]int{{int(now.Month()), now.Day()}}
+	if now.Month() == time.February && now.Day() == 28 && !isLeap(now.Year()) {
+		days = append(days, [2]int{2, 29})
+	}
+
+	bizKey := fmt.Sprintf("%d", now.Year())
+	batch := sc.Config.Campaign.BatchSize
+	if batch <= 0 || batch > maxStatsBatch {
+		batch = maxStatsBatch
+	}
+
+	for _, d := range days {
+		var cursor int64
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			scan, err := sc.UserRpc.ScanUsers(ctx, &userservice.ScanUsersRequest{
+				AfterId:       cursor,
+				Limit:         int64(batch),
+				BirthdayMonth: int32(d[0]),
+				BirthdayDay:   int32(d[1]),
+			})
+			if err != nil {
+				return err
+			}
+			if scan.StatusCode != errno.StatusOK {
+				return fmt.Errorf("scan users failed: %s", scan.StatusMsg)
+			}
+			if len(scan.UserIds) == 0 {
+				break
+			}
+
+			p := issueBatch(ctx, sc, c, scan.UserIds, bizKey, now)
+			p.Scanned = int64(len(scan.UserIds))
+			if err := sc.CouponCampaignsModel.AddProgress(ctx, c.Id, p); err != nil {
+				return err
+			}
+			if stop, reason := stopReason(p); stop {
+				finish(ctx, sc, c, reason)
+				return nil
+			}
+			cursor = scan.UserIds[len(scan.UserIds)-1]
+			if len(scan.UserIds) < batch {
+				break
+			}
+		}
+	}
+	return sc.CouponCampaignsModel.MarkRunDate(ctx, c.Id, now)
+}
+
+// filterByOrders 按订单数与消费金额过滤用户，无订单的用户视为 0 单 0 消费
+func filterByOrders(ctx context.Context, sc *svc.ServiceContext, seg Segment, userIds []int64) ([]int64, error) {
+	stats, err := sc.OrderRpc.GetUserOrderStats(ctx, &orderservice.GetUserOrderStatsReq{UserIds: userIds})
+	if err != nil {
+		return nil, err
+	}
+	if stats.StatusCode != 0 {
+		return nil, fmt.Errorf("get user order stats failed: %s", stats.StatusMsg)
+	}
+
+	byUser := make(map[int64]*orderservice.UserOrderStats, len(stats.Stats))
+	for _, st := range stats.Stats {
+		byUser[st.UserId] = st
+	}
+	targets := make([]int64, 0, len(userIds))
+	for _, uid := range userIds {
+		var count, spend int64
+		if st, ok := byUser[uid]; ok {
+			count, spend = st.OrderCount, st.Spend
+		}
+		if seg.match(count, spend) {
+			targets = append(targets, uid)
+		}
+	}
+	return targets, nil
+}
+
+// issueBatch 逐个发券，券已发完或已结束时提前返回，由调用方结束活动
+func issueBatch(ctx context.Context, sc *svc.ServiceContext, c *couponmodel.CouponCampaigns, userIds []int64, bizKey string, now time.Time) couponmodel.CampaignProgress {
+	var p couponmodel.CampaignProgress
+	for _, uid := range userIds {
+		issued, err := Issue(ctx, sc, c, uid, bizKey, now)
+		switch {
+		case err == nil && issued:
+			p.Issued++
+		case err == nil:
+			p.Skipped++
+		case errors.Is(err, couponmodel.ErrCouponSoldOut), errors.Is(err, couponmodel.ErrCouponExpired):
+			p.LastError = err.Error()
+			return p
+		default:
+			p.Failed++
+			p.LastError = err.Error()
+			logx.WithContext(ctx).Errorf("issue campaign coupon failed: campaign=%d user=%d err=%v", c.Id, uid, err)
+		}
+	}
+	return p
+}
+
+func stopReason(p couponmodel.CampaignProgress) (bool, string) {
+	if p.LastError == couponmodel.ErrCouponSoldOut.Error() || p.LastError == couponmodel.ErrCouponExpired.Error() {
+		return true, p.LastError
+	}
+	return false, ""
+}
+
+func finish(ctx context.Context, sc *svc.ServiceContext, c *couponmodel.CouponCampaigns, reason string) {
+	from := []string{couponmodel.CampaignStatusScheduled, couponmodel.CampaignStatusRunning}
+	if _, err := sc.CouponCampaignsModel.UpdateStatus(ctx, c.Id, from, couponmodel.CampaignStatusCompleted, reason); err != nil {
+		logx.WithContext(ctx).Errorf("complete campaign failed: campaign=%d err=%v", c.Id, err)
+	}
+}
+
+// withLock 持有活动锁时执行 fn，锁被其他实例持有时跳过本轮
+func withLock(ctx context.Context, sc *svc.ServiceContext, id int64, fn func() error) error {
+	lock := redis.NewRedisLock(sc.Redis, fmt.Sprintf("%s%d", lockKeyPrefix, id))
+	lock.SetExpire(lockExpireSecs)
+	ok, err := lock.AcquireCtx(ctx)
+	if err != nil || !ok {
+		return err
+	}
+	defer func() {
+		if _, err := lock.ReleaseCtx(context.Background()); err != nil {
+			logx.WithContext(ctx).Errorf("release campaign lock failed: campaign=%d err=%v", id, err)
+		}
+	}()
+	return fn()
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
@@ -17,6 +17,57 @@ type Config struct {
 	RedisConf redis.RedisConf
 	MysqlConf sqlx.SqlConf
 	CacheConf cache.CacheConf
-	
+
+	OrderRpc zrpc.RpcClientConf
+	UserRpc  zrpc.RpcClientConf
+
 	LogConf logx.LogConf
+
+	Sweep SweepConf `json:",optional"`
+
+	// KafkaConf 配置后领券改为 Redis 判定 + 异步落库，未配置时走 MySQL 事务
+	KafkaConf KafkaConf `json:",optional"`
+
+	Reconcile ReconcileConf `json:",optional"`
+
+	Campaign CampaignConf `json:",optional"`
+
+	CodeRedeem CodeRedeemConf `json:",optional"`
+}
+
+type KafkaConf struct {
+	Broker     []string `json:",optional"`
+	Group      string   `json:",optional"`
+	ClaimTopic string   `json:",optional"`
+}
+
+// ReconcileConf 每 IntervalSeconds 秒以券实例数量校正未结束券模板的 issued_quantity。
+type ReconcileConf struct {
+	Enabled         bool `json:",optional"`
+	IntervalSeconds int  `json:",default=300"`
+}
+
+// SweepConf 券清理：每 IntervalSeconds 秒将过期的未使用券置为 EXPIRED，
+// 并释放锁定超过 LockGraceMinutes 分钟且预订单已取消/过期/不存在的券。
+type SweepConf struct {
+	Enabled          bool `json:",optional"`
+	IntervalSeconds  int  `json:",default=60"`
+	BatchSize        int  `json:",default=500"`
+	LockGraceMinutes int  `json:",default=30"`
+}
+
+// CampaignConf 自动发券活动调度：每 IntervalSeconds 秒推进活动状态，
+// SEGMENT 活动每轮按 BatchSize 分批扫描用户，BIRTHDAY 活动每天 BirthdayHour 点后执行一次。
+type CampaignConf struct {
+	Enabled         bool `json:",optional"`
+	IntervalSeconds int  `json:",default=60"`
+	BatchSize       int  `json:",default=200"`
+	MaxBatches      int  `json:",default=20"`
+	BirthdayHour    int  `json:",default=9"`
+}
+
+// CodeRedeemConf 兑换码防爆破：WindowSeconds 秒内输错 MaxFailures 次后，直到窗口结束前拒绝该用户兑换。
+type CodeRedeemConf struct {
+	MaxFailures   int `json:",default=5"`
+	WindowSeconds int `json:",default=900"`
 }
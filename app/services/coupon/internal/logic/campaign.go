@@ -0,0 +1,95 @@
+package logic
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/campaign"
+)
+
+var (
+	triggerEnumToDB = map[coupon.CampaignTrigger]string{
+		coupon.CampaignTrigger_CAMPAIGN_TRIGGER_REGISTER:    couponmodel.CampaignTriggerRegister,
+		coupon.CampaignTrigger_CAMPAIGN_TRIGGER_FIRST_ORDER: couponmodel.CampaignTriggerFirstOrder,
+		coupon.CampaignTrigger_CAMPAIGN_TRIGGER_BIRTHDAY:    couponmodel.CampaignTriggerBirthday,
+		coupon.CampaignTrigger_CAMPAIGN_TRIGGER_SEGMENT:     couponmodel.CampaignTriggerSegment,
+	}
+
+	campaignStatusEnumToDB = map[coupon.CampaignStatus]string{
+		coupon.CampaignStatus_CAMPAIGN_STATUS_SCHEDULED: couponmodel.CampaignStatusScheduled,
+		coupon.CampaignStatus_CAMPAIGN_STATUS_RUNNING:   couponmodel.CampaignStatusRunning,
+		coupon.CampaignStatus_CAMPAIGN_STATUS_COMPLETED: couponmodel.CampaignStatusCompleted,
+		coupon.CampaignStatus_CAMPAIGN_STATUS_CANCELLED: couponmodel.CampaignStatusCancelled,
+	}
+)
+
+func triggerToEnum(t string) coupon.CampaignTrigger {
+	for enum, val := range triggerEnumToDB {
+		if val == t {
+			return enum
+		}
+	}
+	return coupon.CampaignTrigger_CAMPAIGN_TRIGGER_UNKNOWN
+}
+
+func campaignStatusToEnum(st string) coupon.CampaignStatus {
+	for enum, val := range campaignStatusEnumToDB {
+		if val == st {
+			return enum
+		}
+	}
+	return coupon.CampaignStatus_CAMPAIGN_STATUS_UNKNOWN
+}
+
+// segmentToDB 人群条件序列化，未设置任何条件时存 NULL（全量用户）
+func segmentToDB(seg *coupon.CampaignSegment) (sql.NullString, error) {
+	if seg == nil {
+		return sql.NullString{}, nil
+	}
+	val := campaign.Segment{
+		MinOrderCount:    seg.MinOrderCount,
+		MaxOrderCount:    seg.MaxOrderCount,
+		MinSpend:         seg.MinSpend,
+		ActiveWithinDays: int(seg.ActiveWithinDays),
+	}
+	if val == (campaign.Segment{}) {
+		return sql.NullString{}, nil
+	}
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(raw), Valid: true}, nil
+}
+
+func toCampaignInfo(c *couponmodel.CouponCampaigns) *coupon.CampaignInfo {
+	info := &coupon.CampaignInfo{
+		CampaignId:   c.Id,
+		Name:         c.Name,
+		CouponId:     c.CouponId,
+		Trigger:      triggerToEnum(c.TriggerType),
+		Status:       campaignStatusToEnum(c.Status),
+		StartAt:      c.StartAt.Unix(),
+		EndAt:        c.EndAt.Unix(),
+		ScannedCount: c.ScannedCount,
+		IssuedCount:  c.IssuedCount,
+		SkippedCount: c.SkippedCount,
+		FailedCount:  c.FailedCount,
+		LastError:    c.LastError,
+		CreatedAt:    c.CreatedAt.Unix(),
+	}
+	if c.FinishedAt.Valid {
+		info.FinishedAt = c.FinishedAt.Time.Unix()
+	}
+	if seg, err := campaign.ParseSegment(c); err == nil && seg != (campaign.Segment{}) {
+		info.Segment = &coupon.CampaignSegment{
+			MinOrderCount:    seg.MinOrderCount,
+			MaxOrderCount:    seg.MaxOrderCount,
+			MinSpend:         seg.MinSpend,
+			ActiveWithinDays: int32(seg.ActiveWithinDays),
+		}
+	}
+	return info
+}
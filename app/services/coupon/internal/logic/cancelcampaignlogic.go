@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelCampaignLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelCampaignLogic {
+	return &CancelCampaignLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 取消未结束的活动，已发放的券不回收
+func (l *CancelCampaignLogic) CancelCampaign(in *coupon.CancelCampaignReq) (*coupon.CancelCampaignResp, error) {
+	resp := &coupon.CancelCampaignResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.CampaignId <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	if _, err := l.svcCtx.CouponCampaignsModel.FindOne(l.ctx, in.CampaignId); err != nil {
+		if err == couponmodel.ErrNotFound {
+			resp.StatusCode = errno.CampaignNotFound
+			resp.StatusMsg = errCodeToMsg(errno.CampaignNotFound, "")
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	from := []string{couponmodel.CampaignStatusScheduled, couponmodel.CampaignStatusRunning}
+	ok, err := l.svcCtx.CouponCampaignsModel.UpdateStatus(l.ctx, in.CampaignId, from, couponmodel.CampaignStatusCancelled, "")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		resp.StatusCode = errno.CampaignStatusInvalid
+		resp.StatusMsg = errCodeToMsg(errno.CampaignStatusInvalid, "")
+		return resp, nil
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
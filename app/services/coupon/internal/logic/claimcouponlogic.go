@@ -3,11 +3,14 @@ package logic
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/snowflake"
 	couponmodel "NatsumeAI/app/dal/coupon"
 	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/mq"
 	"NatsumeAI/app/services/coupon/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -41,7 +44,67 @@ func (l *ClaimCouponLogic) ClaimCoupon(in *coupon.ClaimCouponReq) (*coupon.Claim
 		return resp, nil
 	}
 
-	err := l.svcCtx.MysqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+	var err error
+	if l.svcCtx.KafkaWriter != nil {
+		err = l.claimAsync(in)
+	} else {
+		err = l.claimTx(in)
+	}
+
+	if err != nil {
+		if be, ok := err.(*bizError); ok {
+			resp.StatusCode = int64(be.code)
+			resp.StatusMsg = errCodeToMsg(be.code, be.msg)
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	resp.StatusCode = int64(errno.StatusOK)
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
+
+// claimAsync 在 Redis 中完成库存与限领判定，券实例经 Kafka 异步落库
+func (l *ClaimCouponLogic) claimAsync(in *coupon.ClaimCouponReq) error {
+	now := time.Now()
+	claimNo := strconv.FormatInt(snowflake.Next(), 10)
+	err := l.svcCtx.CouponClaimModel.Claim(l.ctx, in.CouponId, in.UserId, claimNo, now)
+	switch {
+	case err == nil:
+	case errors.Is(err, couponmodel.ErrNotFound):
+		return newBizError(errno.CouponNotFound, "coupon not found")
+	case errors.Is(err, couponmodel.ErrCouponNotStarted):
+		return newBizError(errno.CouponExpired, "coupon not started")
+	case errors.Is(err, couponmodel.ErrCouponExpired):
+		return newBizError(errno.CouponExpired, "coupon expired")
+	case errors.Is(err, couponmodel.ErrCouponClaimLimit):
+		return newBizError(errno.CouponAlreadyClaimed, "user reach coupon limit")
+	case errors.Is(err, couponmodel.ErrCouponSoldOut):
+		return newBizError(errno.CouponSoldOut, "coupon sold out")
+	default:
+		return err
+	}
+
+	evt := mq.ClaimEvent{
+		ClaimNo:   claimNo,
+		CouponId:  in.CouponId,
+		UserId:    in.UserId,
+		ClaimedAt: now.Unix(),
+	}
+	if err := mq.PublishClaimEvent(l.ctx, l.svcCtx, evt); err != nil {
+		// 投递失败，归还 Redis 中的额度
+		if uerr := l.svcCtx.CouponClaimModel.Unclaim(l.ctx, in.CouponId, in.UserId, claimNo); uerr != nil {
+			l.Errorf("unclaim coupon failed: coupon=%d user=%d err=%v", in.CouponId, in.UserId, uerr)
+		}
+		return err
+	}
+	return nil
+}
+
+// claimTx 未配置 Kafka 时锁券模板行，在 MySQL 事务内完成领取
+func (l *ClaimCouponLogic) claimTx(in *coupon.ClaimCouponReq) error {
+	return l.svcCtx.MysqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
 		tpl, err := l.svcCtx.CouponsModel.FindOneForUpdate(ctx, session, in.CouponId)
 		if err != nil {
 			if errors.Is(err, couponmodel.ErrNotFound) {
@@ -81,17 +144,4 @@ func (l *ClaimCouponLogic) ClaimCoupon(in *coupon.ClaimCouponReq) (*coupon.Claim
 
 		return nil
 	})
-
-	if err != nil {
-		if be, ok := err.(*bizError); ok {
-			resp.StatusCode = int64(be.code)
-			resp.StatusMsg = errCodeToMsg(be.code, be.msg)
-			return resp, nil
-		}
-		return nil, err
-	}
-
-	resp.StatusCode = int64(errno.StatusOK)
-	resp.StatusMsg = "ok"
-	return resp, nil
 }
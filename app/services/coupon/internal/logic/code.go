@@ -0,0 +1,104 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+)
+
+const (
+	// 去掉易混淆的 0/O、1/I
+	codeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	codeLength   = 12
+
+	codeFailKeyPrefix = "coupon:code:fail:"
+)
+
+var sharedCodePattern = regexp.MustCompile(`^[A-Z0-9]{4,32}$`)
+
+var codeTypeEnumToDB = map[coupon.CodeType]string{
+	coupon.CodeType_CODE_TYPE_SHARED: couponmodel.CodeTypeShared,
+	coupon.CodeType_CODE_TYPE_UNIQUE: couponmodel.CodeTypeUnique,
+}
+
+// normalizeCode 兑换码统一大写，去掉空格与连字符
+func normalizeCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer(" ", "", "-", "", "\t", "").Replace(code)
+}
+
+// randomCode 生成随机兑换码
+func randomCode() (string, error) {
+	buf := make([]byte, codeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = codeAlphabet[int(b)%len(codeAlphabet)]
+	}
+	return string(buf), nil
+}
+
+func toCodeBatchInfo(b *couponmodel.CouponCodeBatches) *coupon.CodeBatchInfo {
+	info := &coupon.CodeBatchInfo{
+		BatchId:       b.Id,
+		CouponId:      b.CouponId,
+		Name:          b.Name,
+		TotalQuantity: b.TotalQuantity,
+		RedeemedCount: b.RedeemedCount,
+		Disabled:      b.Status == couponmodel.CodeBatchStatusDisabled,
+		CreatedAt:     b.CreatedAt.Unix(),
+	}
+	for enum, val := range codeTypeEnumToDB {
+		if val == b.CodeType {
+			info.CodeType = enum
+		}
+	}
+	if b.SharedCode.Valid {
+		info.SharedCode = b.SharedCode.String
+	}
+	return info
+}
+
+// codeFailures 兑换码输错次数统计，窗口从第一次输错开始计算
+type codeFailures struct {
+	svcCtx *svc.ServiceContext
+	key    string
+}
+
+func newCodeFailures(svcCtx *svc.ServiceContext, userId int64) *codeFailures {
+	return &codeFailures{
+		svcCtx: svcCtx,
+		key:    fmt.Sprintf("%s%d", codeFailKeyPrefix, userId),
+	}
+}
+
+func (f *codeFailures) blocked(ctx context.Context) (bool, error) {
+	val, err := f.svcCtx.Redis.GetCtx(ctx, f.key)
+	if err != nil || val == "" {
+		return false, err
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return false, nil
+	}
+	return n >= f.svcCtx.Config.CodeRedeem.MaxFailures, nil
+}
+
+func (f *codeFailures) record(ctx context.Context) error {
+	n, err := f.svcCtx.Redis.IncrCtx(ctx, f.key)
+	if err != nil {
+		return err
+	}
+	if n == 1 {
+		return f.svcCtx.Redis.ExpireCtx(ctx, f.key, f.svcCtx.Config.CodeRedeem.WindowSeconds)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCampaignLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCampaignLogic {
+	return &CreateCampaignLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建自动发券活动
+func (l *CreateCampaignLogic) CreateCampaign(in *coupon.CreateCampaignReq) (*coupon.CreateCampaignResp, error) {
+	resp := &coupon.CreateCampaignResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.Name == "" || len(in.Name) > 128 || in.CouponId <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+	trigger, ok := triggerEnumToDB[in.Trigger]
+	if !ok {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid campaign trigger"
+		return resp, nil
+	}
+
+	startAt := time.Unix(in.StartAt, 0).UTC()
+	endAt := time.Unix(in.EndAt, 0).UTC()
+	if in.StartAt <= 0 || !endAt.After(startAt) || endAt.Before(time.Now()) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid time range"
+		return resp, nil
+	}
+
+	seg := in.Segment
+	if trigger != couponmodel.CampaignTriggerSegment {
+		seg = nil
+	}
+	if seg != nil && (seg.MinOrderCount < 0 || seg.MaxOrderCount < -1 || seg.MinSpend < 0 || seg.ActiveWithinDays < 0 ||
+		(seg.MaxOrderCount > 0 && seg.MaxOrderCount < seg.MinOrderCount) || (seg.MaxOrderCount < 0 && seg.MinOrderCount > 0)) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid campaign segment"
+		return resp, nil
+	}
+	segment, err := segmentToDB(seg)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl, err := l.svcCtx.CouponsModel.FindOne(l.ctx, in.CouponId)
+	if err != nil {
+		if err == couponmodel.ErrNotFound {
+			resp.StatusCode = errno.CouponNotFound
+			resp.StatusMsg = errCodeToMsg(errno.CouponNotFound, "")
+			return resp, nil
+		}
+		return nil, err
+	}
+	if tpl.EndAt.Before(startAt) {
+		resp.StatusCode = errno.CouponExpired
+		resp.StatusMsg = "coupon ends before campaign starts"
+		return resp, nil
+	}
+
+	result, err := l.svcCtx.CouponCampaignsModel.Insert(l.ctx, &couponmodel.CouponCampaigns{
+		Name:        in.Name,
+		CouponId:    in.CouponId,
+		TriggerType: trigger,
+		Segment:     segment,
+		Status:      couponmodel.CampaignStatusScheduled,
+		StartAt:     startAt,
+		EndAt:       endAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.CampaignId = id
+	return resp, nil
+}
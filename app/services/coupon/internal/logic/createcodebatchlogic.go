@@ -0,0 +1,172 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type CreateCodeBatchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateCodeBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCodeBatchLogic {
+	return &CreateCodeBatchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+const (
+	maxSharedCodeQuantity = 10000000
+	// 单批一次性码上限，导出的 CSV 需在一次 RPC 响应内返回
+	maxUniqueCodeQuantity = 50000
+	codeInsertChunk       = 500
+)
+
+// 创建兑换码批次：公共码写入批次本身，一次性码批量生成
+func (l *CreateCodeBatchLogic) CreateCodeBatch(in *coupon.CreateCodeBatchReq) (*coupon.CreateCodeBatchResp, error) {
+	resp := &coupon.CreateCodeBatchResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.CouponId <= 0 || in.Name == "" || len(in.Name) > 128 || in.Quantity <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+	codeType, ok := codeTypeEnumToDB[in.CodeType]
+	if !ok {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid code type"
+		return resp, nil
+	}
+
+	var sharedCode sql.NullString
+	switch codeType {
+	case couponmodel.CodeTypeShared:
+		if in.Quantity > maxSharedCodeQuantity {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "quantity too large"
+			return resp, nil
+		}
+		code := normalizeCode(in.SharedCode)
+		if code == "" {
+			var err error
+			if code, err = randomCode(); err != nil {
+				return nil, err
+			}
+		} else if !sharedCodePattern.MatchString(code) {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "invalid shared code"
+			return resp, nil
+		}
+		// 公共码不能与已有一次性码重复
+		if _, err := l.svcCtx.CouponCodesModel.FindByCode(l.ctx, code); err == nil {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "shared code already exists"
+			return resp, nil
+		} else if !errors.Is(err, couponmodel.ErrNotFound) {
+			return nil, err
+		}
+		sharedCode = sql.NullString{String: code, Valid: true}
+	case couponmodel.CodeTypeUnique:
+		if in.Quantity > maxUniqueCodeQuantity {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "quantity too large"
+			return resp, nil
+		}
+	}
+
+	if _, err := l.svcCtx.CouponsModel.FindOne(l.ctx, in.CouponId); err != nil {
+		if errors.Is(err, couponmodel.ErrNotFound) {
+			resp.StatusCode = errno.CouponNotFound
+			resp.StatusMsg = errCodeToMsg(errno.CouponNotFound, "")
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	var batchId int64
+	err := l.svcCtx.MysqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		id, err := l.svcCtx.CouponCodeBatchesModel.InsertWithSession(ctx, session, &couponmodel.CouponCodeBatches{
+			CouponId:      in.CouponId,
+			Name:          in.Name,
+			CodeType:      codeType,
+			SharedCode:    sharedCode,
+			TotalQuantity: in.Quantity,
+			Status:        couponmodel.CodeBatchStatusActive,
+		})
+		if err != nil {
+			return err
+		}
+		batchId = id
+		if codeType == couponmodel.CodeTypeUnique {
+			return l.generateCodes(ctx, session, id, in.Quantity)
+		}
+		return nil
+	})
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "duplicate") {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "shared code already exists"
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	batch, err := l.svcCtx.CouponCodeBatchesModel.FindOne(l.ctx, batchId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Batch = toCodeBatchInfo(batch)
+	return resp, nil
+}
+
+// generateCodes 分批生成一次性码，与已有码冲突的部分重新生成
+func (l *CreateCodeBatchLogic) generateCodes(ctx context.Context, session sqlx.Session, batchId, quantity int64) error {
+	var inserted int64
+	for retries := 0; inserted < quantity; {
+		n := quantity - inserted
+		if n > codeInsertChunk {
+			n = codeInsertChunk
+		}
+		codes := make([]string, 0, n)
+		for i := int64(0); i < n; i++ {
+			code, err := randomCode()
+			if err != nil {
+				return err
+			}
+			codes = append(codes, code)
+		}
+
+		affected, err := l.svcCtx.CouponCodesModel.InsertIgnoreWithSession(ctx, session, batchId, codes)
+		if err != nil {
+			return err
+		}
+		if affected < n {
+			retries++
+			if retries > 10 {
+				return errors.New("generate unique coupon codes failed")
+			}
+		}
+		inserted += affected
+	}
+	return nil
+}
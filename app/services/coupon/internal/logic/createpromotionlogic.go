@@ -0,0 +1,109 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePromotionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreatePromotionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePromotionLogic {
+	return &CreatePromotionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建促销活动
+func (l *CreatePromotionLogic) CreatePromotion(in *coupon.CreatePromotionReq) (*coupon.CreatePromotionResp, error) {
+	resp := &coupon.CreatePromotionResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.Name == "" || len(in.Name) > 128 || in.MerchantId < 0 || len(in.MutexGroup) > 32 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+	promoType, ok := promoTypeEnumToDB[in.PromoType]
+	if !ok {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid promotion type"
+		return resp, nil
+	}
+	fundedBy, ok := promoFunderEnumToDB[in.FundedBy]
+	if !ok {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid promotion funder"
+		return resp, nil
+	}
+	// 商家出资的活动只能作用于该商家的商品
+	if fundedBy == couponmodel.PromotionFunderMerchant && in.MerchantId == 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "merchant id required for merchant funded promotion"
+		return resp, nil
+	}
+
+	startAt := time.Unix(in.StartAt, 0).UTC()
+	endAt := time.Unix(in.EndAt, 0).UTC()
+	if in.StartAt <= 0 || !endAt.After(startAt) || endAt.Before(time.Now()) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid time range"
+		return resp, nil
+	}
+
+	rule := ruleFromProto(in.Rule)
+	if err := rule.Validate(promoType); err != nil {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid promotion rule: " + err.Error()
+		return resp, nil
+	}
+	rules, err := ruleToDB(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &couponmodel.Promotions{
+		Name:       in.Name,
+		PromoType:  promoType,
+		FundedBy:   fundedBy,
+		MerchantId: in.MerchantId,
+		Rules:      rules,
+		MutexGroup: strings.TrimSpace(in.MutexGroup),
+		Priority:   int64(in.Priority),
+		Status:     couponmodel.PromotionStatusActive,
+		StartAt:    startAt,
+		EndAt:      endAt,
+	}
+	if in.StackWithCoupon {
+		data.StackWithCoupon = 1
+	}
+	result, err := l.svcCtx.PromotionsModel.Insert(l.ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if data.Id, err = result.LastInsertId(); err != nil {
+		return nil, err
+	}
+	data.CreatedAt = time.Now()
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Promotion = toPromotionInfo(data)
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisableCodeBatchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDisableCodeBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisableCodeBatchLogic {
+	return &DisableCodeBatchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 停用兑换码批次，已兑换的券不受影响
+func (l *DisableCodeBatchLogic) DisableCodeBatch(in *coupon.DisableCodeBatchReq) (*coupon.DisableCodeBatchResp, error) {
+	resp := &coupon.DisableCodeBatchResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.BatchId <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	if err := l.svcCtx.CouponCodeBatchesModel.UpdateStatus(l.ctx, in.BatchId, couponmodel.CodeBatchStatusDisabled); err != nil {
+		if errors.Is(err, couponmodel.ErrNotFound) {
+			resp.StatusCode = errno.CouponCodeInvalid
+			resp.StatusMsg = "code batch not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisablePromotionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDisablePromotionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisablePromotionLogic {
+	return &DisablePromotionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 停用促销活动，已下单的订单不受影响
+func (l *DisablePromotionLogic) DisablePromotion(in *coupon.DisablePromotionReq) (*coupon.DisablePromotionResp, error) {
+	resp := &coupon.DisablePromotionResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.PromotionId <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	if _, err := l.svcCtx.PromotionsModel.FindOne(l.ctx, in.PromotionId); err != nil {
+		if err == couponmodel.ErrNotFound {
+			resp.StatusCode = errno.PromotionNotFound
+			resp.StatusMsg = errCodeToMsg(errno.PromotionNotFound, "")
+			return resp, nil
+		}
+		return nil, err
+	}
+	// 重复停用视为成功
+	if _, err := l.svcCtx.PromotionsModel.UpdateStatus(l.ctx, in.PromotionId, couponmodel.PromotionStatusActive, couponmodel.PromotionStatusDisabled); err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/services/coupon/internal/promotion"
+
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EvaluatePromotionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewEvaluatePromotionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EvaluatePromotionsLogic {
+	return &EvaluatePromotionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 结算时计算可用促销及与优惠券的叠加
+func (l *EvaluatePromotionsLogic) EvaluatePromotions(in *coupon.EvaluatePromotionsReq) (*coupon.EvaluatePromotionsResp, error) {
+	resp := &coupon.EvaluatePromotionsResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || len(in.Lines) == 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+	for _, line := range in.Lines {
+		if line == nil || line.Amount < 0 || line.Quantity < 0 {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "invalid order line"
+			return resp, nil
+		}
+	}
+
+	promos, err := l.svcCtx.PromotionsModel.ListActive(l.ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	res := promotion.Evaluate(promos, in.Lines, in.WithCoupon)
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.DiscountAmount = res.Discount
+	resp.PlatformAmount = res.Platform
+	resp.MerchantAmount = res.Merchant
+	resp.Applied = res.Applied
+	resp.Skipped = res.Skipped
+	resp.Lines = res.Lines
+	return resp, nil
+}
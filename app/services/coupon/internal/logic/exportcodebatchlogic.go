@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportCodeBatchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportCodeBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportCodeBatchLogic {
+	return &ExportCodeBatchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+const exportPageSize = 1000
+
+// 导出一次性码为 CSV，包含兑换状态
+func (l *ExportCodeBatchLogic) ExportCodeBatch(in *coupon.ExportCodeBatchReq) (*coupon.ExportCodeBatchResp, error) {
+	resp := &coupon.ExportCodeBatchResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.BatchId <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	batch, err := l.svcCtx.CouponCodeBatchesModel.FindOne(l.ctx, in.BatchId)
+	if err != nil {
+		if errors.Is(err, couponmodel.ErrNotFound) {
+			resp.StatusCode = errno.CouponCodeInvalid
+			resp.StatusMsg = "code batch not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	if batch.CodeType != couponmodel.CodeTypeUnique {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "only unique code batches can be exported"
+		return resp, nil
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"code", "status", "user_id", "redeemed_at"}); err != nil {
+		return nil, err
+	}
+	var afterId int64
+	for {
+		rows, err := l.svcCtx.CouponCodesModel.ListByBatch(l.ctx, batch.Id, afterId, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			var userId, redeemedAt string
+			if row.UserId > 0 {
+				userId = strconv.FormatInt(row.UserId, 10)
+			}
+			if row.RedeemedAt.Valid {
+				redeemedAt = row.RedeemedAt.Time.Format(time.DateTime)
+			}
+			if err := w.Write([]string{row.Code, row.Status, userId, redeemedAt}); err != nil {
+				return nil, err
+			}
+		}
+		if len(rows) < exportPageSize {
+			break
+		}
+		afterId = rows[len(rows)-1].Id
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.FileName = fmt.Sprintf("coupon_codes_%d.csv", batch.Id)
+	resp.Content = buf.Bytes()
+	return resp, nil
+}
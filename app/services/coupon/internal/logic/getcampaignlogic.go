@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCampaignLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCampaignLogic {
+	return &GetCampaignLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询活动及执行进度
+func (l *GetCampaignLogic) GetCampaign(in *coupon.GetCampaignReq) (*coupon.GetCampaignResp, error) {
+	resp := &coupon.GetCampaignResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.CampaignId <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	c, err := l.svcCtx.CouponCampaignsModel.FindOne(l.ctx, in.CampaignId)
+	if err != nil {
+		if err == couponmodel.ErrNotFound {
+			resp.StatusCode = errno.CampaignNotFound
+			resp.StatusMsg = errCodeToMsg(errno.CampaignNotFound, "")
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Campaign = toCampaignInfo(c)
+	return resp, nil
+}
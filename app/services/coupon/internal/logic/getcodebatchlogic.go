@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCodeBatchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCodeBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCodeBatchLogic {
+	return &GetCodeBatchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询兑换码批次及兑换进度
+func (l *GetCodeBatchLogic) GetCodeBatch(in *coupon.GetCodeBatchReq) (*coupon.GetCodeBatchResp, error) {
+	resp := &coupon.GetCodeBatchResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.BatchId <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	batch, err := l.svcCtx.CouponCodeBatchesModel.FindOne(l.ctx, in.BatchId)
+	if err != nil {
+		if errors.Is(err, couponmodel.ErrNotFound) {
+			resp.StatusCode = errno.CouponCodeInvalid
+			resp.StatusMsg = "code batch not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Batch = toCodeBatchInfo(batch)
+	return resp, nil
+}
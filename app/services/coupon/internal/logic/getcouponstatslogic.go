@@ -0,0 +1,155 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+	"NatsumeAI/app/services/order/orderservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCouponStatsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCouponStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCouponStatsLogic {
+	return &GetCouponStatsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+const (
+	maxStatsCoupons = 50
+	// 订单服务单次汇总的订单数上限
+	statsOrderBatch = 1000
+)
+
+// 券效果统计：券实例状态分布来自 coupon_instances，优惠金额与成交额由订单服务按核销订单汇总
+func (l *GetCouponStatsLogic) GetCouponStats(in *coupon.GetCouponStatsReq) (*coupon.GetCouponStatsResp, error) {
+	resp := &coupon.GetCouponStatsResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || len(in.CouponIds) == 0 || len(in.CouponIds) > maxStatsCoupons ||
+		in.StartAt < 0 || in.EndAt < 0 || (in.EndAt > 0 && in.EndAt <= in.StartAt) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	from := time.Unix(in.StartAt, 0)
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if in.EndAt > 0 {
+		to = time.Unix(in.EndAt, 0)
+	}
+
+	// 只能统计调用方自己出资的券，任一券不属于调用方即整体拒绝
+	stats := make([]*coupon.CouponStats, 0, len(in.CouponIds))
+	for _, id := range in.CouponIds {
+		tpl, err := findOwnedCoupon(l.ctx, l.svcCtx, id, in.IssuerType, in.IssuerId)
+		if err != nil {
+			if be, ok := err.(*bizError); ok {
+				resp.StatusCode = be.code
+				resp.StatusMsg = errCodeToMsg(be.code, be.msg)
+				return resp, nil
+			}
+			return nil, err
+		}
+
+		st, err := l.couponStats(tpl, from, to)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, st)
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Stats = stats
+	return resp, nil
+}
+
+func (l *GetCouponStatsLogic) couponStats(tpl *couponmodel.Coupons, from, to time.Time) (*coupon.CouponStats, error) {
+	st := &coupon.CouponStats{
+		CouponId:       tpl.Id,
+		TotalQuantity:  tpl.TotalQuantity,
+		IssuedQuantity: tpl.IssuedQuantity,
+	}
+
+	counts, err := l.svcCtx.CouponInstancesModel.CountByStatus(l.ctx, l.svcCtx.MysqlConn, tpl.Id, from, to)
+	if err != nil {
+		return nil, err
+	}
+	ended := time.Now().After(tpl.EndAt)
+	for _, c := range counts {
+		st.Claimed += c.Count
+		switch c.Status {
+		case couponmodel.CouponStatusUnused:
+			// 模板已结束、尚未被清理任务置为过期的券按过期统计
+			if ended {
+				st.Expired += c.Count
+			} else {
+				st.Unused += c.Count
+			}
+		case couponmodel.CouponStatusLocked:
+			st.Locked += c.Count
+		case couponmodel.CouponStatusUsed:
+			st.Used += c.Count
+		case couponmodel.CouponStatusExpired:
+			st.Expired += c.Count
+		}
+	}
+	if st.Claimed > 0 {
+		st.RedemptionRate = float64(st.Used) / float64(st.Claimed)
+	}
+	if st.Used == 0 {
+		return st, nil
+	}
+
+	var afterId int64
+	for {
+		rows, err := l.svcCtx.CouponInstancesModel.ListUsedOrderIds(l.ctx, l.svcCtx.MysqlConn, tpl.Id, from, to, afterId, statsOrderBatch)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		afterId = rows[len(rows)-1].Id
+
+		orderIds := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			if row.UsedOrderId > 0 {
+				orderIds = append(orderIds, row.UsedOrderId)
+			}
+		}
+		if len(orderIds) > 0 {
+			sum, err := l.svcCtx.OrderRpc.SummarizeOrders(l.ctx, &orderservice.SummarizeOrdersReq{OrderIds: orderIds})
+			if err != nil {
+				return nil, err
+			}
+			if sum.StatusCode != 0 {
+				return nil, fmt.Errorf("summarize orders failed: %s", sum.StatusMsg)
+			}
+			st.OrderCount += sum.OrderCount
+			st.Gmv += sum.PayableAmount
+			// 优惠金额只算券的部分，扣除同单促销优惠
+			st.DiscountAmount += sum.TotalAmount - sum.PayableAmount - sum.PromotionAmount
+		}
+		if len(rows) < statsOrderBatch {
+			break
+		}
+	}
+	return st, nil
+}
@@ -68,6 +68,32 @@ func couponTypeToProto(t int64) coupon.CouponType {
 	}
 }
 
+// toCouponInfo 将券实例详情转换为接口结构，过期的未使用券标记为已过期
+func toCouponInfo(row *couponmodel.CouponInstanceDetail, scope *couponScope) *coupon.CouponInfo {
+	status := statusToEnum(row.Status, row.EndAt)
+	// 过期了，标记一下
+	if status != coupon.CouponStatus_COUPON_STATUS_EXPIRED && time.Now().After(row.EndAt) {
+		status = coupon.CouponStatus_COUPON_STATUS_EXPIRED
+	}
+	return &coupon.CouponInfo{
+		CouponId:        row.InstanceId,
+		CouponType:      couponTypeToProto(row.CouponType),
+		Status:          status,
+		DiscountAmount:  row.DiscountAmount,
+		DiscountPercent: int32(row.DiscountPercent),
+		MinSpendAmount:  row.MinSpendAmount,
+		UserId:          row.UserId,
+		LockedPreorder:  row.LockedPreorder,
+		StartAt:         row.StartAt.Unix(),
+		EndAt:           row.EndAt.Unix(),
+		Source:          row.Source,
+		Remarks:         row.Remarks,
+		Scope:           scope.toProto(),
+		IssuerType:      issuerToProto(row.IssuerType),
+		IssuerId:        row.IssuerId,
+	}
+}
+
 // 计算可以折扣的金额
 func calcDiscountAmount(t coupon.CouponType, orderAmount, discountAmount, discountPercent, maxDiscount int64) int64 {
 	switch t {
@@ -127,6 +153,20 @@ func errCodeToMsg(code int32, fallback string) string {
 		return "coupon status invalid"
 	case errno.CouponOwnershipInvalid:
 		return "coupon not owned by user"
+	case errno.CampaignNotFound:
+		return "campaign not found"
+	case errno.CampaignStatusInvalid:
+		return "campaign status invalid"
+	case errno.CouponCodeInvalid:
+		return "invalid coupon code"
+	case errno.CouponCodeRedeemed:
+		return "coupon code already redeemed"
+	case errno.TooManyAttempts:
+		return "too many failed attempts, try again later"
+	case errno.PromotionNotFound:
+		return "promotion not found"
+	case errno.NoPermission:
+		return "no permission to manage this coupon"
 	}
 	if fallback != "" {
 		return fallback
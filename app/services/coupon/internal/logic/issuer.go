@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// issuerFromProto 校验出资方身份，未指定时按平台处理；平台券出资方ID为0，商家券必须带商家ID
+func issuerFromProto(t coupon.CouponIssuer, issuerId int64) (string, bool) {
+	switch t {
+	case coupon.CouponIssuer_COUPON_ISSUER_UNKNOWN, coupon.CouponIssuer_COUPON_ISSUER_PLATFORM:
+		return couponmodel.CouponIssuerPlatform, issuerId == 0
+	case coupon.CouponIssuer_COUPON_ISSUER_MERCHANT:
+		return couponmodel.CouponIssuerMerchant, issuerId > 0
+	default:
+		return "", false
+	}
+}
+
+func issuerToProto(t string) coupon.CouponIssuer {
+	switch t {
+	case couponmodel.CouponIssuerPlatform:
+		return coupon.CouponIssuer_COUPON_ISSUER_PLATFORM
+	case couponmodel.CouponIssuerMerchant:
+		return coupon.CouponIssuer_COUPON_ISSUER_MERCHANT
+	default:
+		return coupon.CouponIssuer_COUPON_ISSUER_UNKNOWN
+	}
+}
+
+// findOwnedCoupon 查询券模板并校验操作方是否为出资方
+func findOwnedCoupon(ctx context.Context, svcCtx *svc.ServiceContext, couponId int64, t coupon.CouponIssuer, issuerId int64) (*couponmodel.Coupons, error) {
+	issuerType, ok := issuerFromProto(t, issuerId)
+	if couponId <= 0 || !ok {
+		return nil, newBizError(errno.InvalidParam, errCodeToMsg(errno.InvalidParam, ""))
+	}
+	tpl, err := svcCtx.CouponsModel.FindOne(ctx, couponId)
+	if err != nil {
+		if err == couponmodel.ErrNotFound {
+			return nil, newBizError(errno.CouponNotFound, errCodeToMsg(errno.CouponNotFound, ""))
+		}
+		return nil, err
+	}
+	if tpl.IssuerType != issuerType || tpl.IssuerId != issuerId {
+		return nil, newBizError(errno.NoPermission, errCodeToMsg(errno.NoPermission, ""))
+	}
+	return tpl, nil
+}
+
+// syncClaimState 券模板修改或撤销后更新 Redis 领券预热数据；原地更新失败时删除预热元数据，
+// 下次领券从 MySQL 重新预热，两者都失败时返回错误由调用方重试，避免继续按旧库存与有效期发券
+func syncClaimState(ctx context.Context, svcCtx *svc.ServiceContext, couponId int64) error {
+	err := svcCtx.CouponClaimModel.Sync(ctx, couponId)
+	if err == nil {
+		return nil
+	}
+	logx.WithContext(ctx).Errorf("sync coupon %d claim state failed, invalidate warm data: %v", couponId, err)
+	return svcCtx.CouponClaimModel.Invalidate(ctx, couponId)
+}
+
+func toCouponTemplate(tpl *couponmodel.Coupons, scope *couponScope) *coupon.CouponTemplate {
+	out := &coupon.CouponTemplate{
+		CouponId:        tpl.Id,
+		CouponType:      couponTypeToProto(tpl.CouponType),
+		DiscountAmount:  tpl.DiscountAmount,
+		DiscountPercent: int32(tpl.DiscountPercent),
+		MinSpendAmount:  tpl.MinSpendAmount,
+		TotalIssue:      tpl.TotalQuantity,
+		PerUserLimit:    tpl.PerUserLimit,
+		IssuedQuantity:  tpl.IssuedQuantity,
+		StartAt:         tpl.StartAt.Unix(),
+		EndAt:           tpl.EndAt.Unix(),
+		Source:          tpl.Source,
+		Remarks:         tpl.Remarks,
+		Scope:           scope.toProto(),
+		IssuerType:      issuerToProto(tpl.IssuerType),
+		IssuerId:        tpl.IssuerId,
+	}
+	if tpl.RevokedAt.Valid {
+		out.RevokedAt = tpl.RevokedAt.Time.Unix()
+	}
+	return out
+}
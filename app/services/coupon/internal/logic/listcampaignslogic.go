@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListCampaignsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListCampaignsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCampaignsLogic {
+	return &ListCampaignsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 分页查询活动
+func (l *ListCampaignsLogic) ListCampaigns(in *coupon.ListCampaignsReq) (*coupon.ListCampaignsResp, error) {
+	resp := &coupon.ListCampaignsResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	var trigger, status string
+	if in.Trigger != coupon.CampaignTrigger_CAMPAIGN_TRIGGER_UNKNOWN {
+		val, ok := triggerEnumToDB[in.Trigger]
+		if !ok {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "invalid campaign trigger"
+			return resp, nil
+		}
+		trigger = val
+	}
+	if in.Status != coupon.CampaignStatus_CAMPAIGN_STATUS_UNKNOWN {
+		val, ok := campaignStatusEnumToDB[in.Status]
+		if !ok {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "invalid campaign status"
+			return resp, nil
+		}
+		status = val
+	}
+
+	limit, offset := normalizePagination(in.Page, in.PageSize)
+	rows, total, err := l.svcCtx.CouponCampaignsModel.List(l.ctx, trigger, status, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*coupon.CampaignInfo, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, toCampaignInfo(row))
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Campaigns = list
+	resp.Total = total
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListCouponTemplatesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListCouponTemplatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCouponTemplatesLogic {
+	return &ListCouponTemplatesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 分页查询出资方发布的券模板
+func (l *ListCouponTemplatesLogic) ListCouponTemplates(in *coupon.ListCouponTemplatesReq) (*coupon.ListCouponTemplatesResp, error) {
+	resp := &coupon.ListCouponTemplatesResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+	issuerType, ok := issuerFromProto(in.IssuerType, in.IssuerId)
+	if !ok {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	limit, offset := normalizePagination(in.Page, in.PageSize)
+	rows, total, err := l.svcCtx.CouponsModel.ListByIssuer(l.ctx, issuerType, in.IssuerId, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.Id)
+	}
+	scopes, err := loadScopes(l.ctx, l.svcCtx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*coupon.CouponTemplate, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, toCouponTemplate(row, scopes[row.Id]))
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Templates = list
+	resp.Total = total
+	return resp, nil
+}
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPromotionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListPromotionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPromotionsLogic {
+	return &ListPromotionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 分页查询促销活动，active_only 时只返回未停用的活动
+func (l *ListPromotionsLogic) ListPromotions(in *coupon.ListPromotionsReq) (*coupon.ListPromotionsResp, error) {
+	resp := &coupon.ListPromotionsResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.MerchantId < -1 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	status := ""
+	if in.ActiveOnly {
+		status = couponmodel.PromotionStatusActive
+	}
+	limit, offset := normalizePagination(in.Page, in.PageSize)
+	rows, total, err := l.svcCtx.PromotionsModel.List(l.ctx, in.MerchantId, status, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*coupon.PromotionInfo, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, toPromotionInfo(row))
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Promotions = list
+	resp.Total = total
+	return resp, nil
+}
@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"time"
 
 	"NatsumeAI/app/common/consts/errno"
 	"NatsumeAI/app/services/coupon/coupon"
@@ -56,30 +55,18 @@ func (l *ListUserCouponsLogic) ListUserCoupons(in *coupon.ListUserCouponsReq) (*
 		return nil, err
 	}
 
-	now := time.Now()
-	result := make([]*coupon.CouponInfo, 0, len(rows))
+	couponIds := make([]int64, 0, len(rows))
 	for _, row := range rows {
-		status := statusToEnum(row.Status, row.EndAt)
-		// 过期了，标记一下
-		if status != coupon.CouponStatus_COUPON_STATUS_EXPIRED && now.After(row.EndAt) {
-			status = coupon.CouponStatus_COUPON_STATUS_EXPIRED
-		}
+		couponIds = append(couponIds, row.CouponId)
+	}
+	scopes, err := loadScopes(l.ctx, l.svcCtx, couponIds)
+	if err != nil {
+		return nil, err
+	}
 
-		info := &coupon.CouponInfo{
-			CouponId:        row.InstanceId,
-			CouponType:      couponTypeToProto(row.CouponType),
-			Status:          status,
-			DiscountAmount:  row.DiscountAmount,
-			DiscountPercent: int32(row.DiscountPercent),
-			MinSpendAmount:  row.MinSpendAmount,
-			UserId:          row.UserId,
-			LockedPreorder:  row.LockedPreorder,
-			StartAt:         row.StartAt.Unix(),
-			EndAt:           row.EndAt.Unix(),
-			Source:          row.Source,
-			Remarks:         row.Remarks,
-		}
-		result = append(result, info)
+	result := make([]*coupon.CouponInfo, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toCouponInfo(row, scopes[row.CouponId]))
 	}
 
 	resp.StatusCode = errno.StatusOK
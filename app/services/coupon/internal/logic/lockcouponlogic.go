@@ -63,7 +63,7 @@ func (l *LockCouponLogic) LockCoupon(in *coupon.LockCouponReq) (*coupon.LockCoup
 			return newBizError(errno.CouponStatusInvalid, "coupon not unused")
 		}
 
-		if err := l.svcCtx.CouponInstancesModel.LockWithSession(ctx, session, detail.InstanceId, detail.UserId, in.OrderId, now); err != nil {
+		if err := l.svcCtx.CouponInstancesModel.LockWithSession(ctx, session, detail.InstanceId, detail.UserId, in.OrderId, in.EligibleAmount, now); err != nil {
 			if errors.Is(err, couponmodel.ErrCouponStatusConflict) {
 				return newBizError(errno.CouponStatusInvalid, "coupon status invalid")
 			}
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"encoding/json"
+
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/promotion"
+)
+
+var (
+	promoTypeEnumToDB = map[coupon.PromotionType]string{
+		coupon.PromotionType_PROMOTION_TYPE_FULL_REDUCTION: couponmodel.PromotionTypeFullReduction,
+		coupon.PromotionType_PROMOTION_TYPE_MULTI_BUY:      couponmodel.PromotionTypeMultiBuy,
+		coupon.PromotionType_PROMOTION_TYPE_BUNDLE:         couponmodel.PromotionTypeBundle,
+	}
+
+	promoFunderEnumToDB = map[coupon.PromotionFunder]string{
+		coupon.PromotionFunder_PROMOTION_FUNDER_PLATFORM: couponmodel.PromotionFunderPlatform,
+		coupon.PromotionFunder_PROMOTION_FUNDER_MERCHANT: couponmodel.PromotionFunderMerchant,
+	}
+)
+
+func promoFunderToEnum(f string) coupon.PromotionFunder {
+	for enum, val := range promoFunderEnumToDB {
+		if val == f {
+			return enum
+		}
+	}
+	return coupon.PromotionFunder_PROMOTION_FUNDER_UNKNOWN
+}
+
+func ruleFromProto(in *coupon.PromotionRule) promotion.Rule {
+	var r promotion.Rule
+	if in == nil {
+		return r
+	}
+	for _, t := range in.Tiers {
+		r.Tiers = append(r.Tiers, promotion.Tier{Threshold: t.Threshold, Discount: t.Discount})
+	}
+	for _, s := range in.Steps {
+		r.Steps = append(r.Steps, promotion.Step{MinQuantity: s.MinQuantity, OffPercent: int64(s.OffPercent)})
+	}
+	r.BundlePrice = in.BundlePrice
+	r.ProductIds = in.ProductIds
+	r.Categories = in.Categories
+	return r
+}
+
+func ruleToProto(r promotion.Rule) *coupon.PromotionRule {
+	out := &coupon.PromotionRule{
+		BundlePrice: r.BundlePrice,
+		ProductIds:  r.ProductIds,
+		Categories:  r.Categories,
+	}
+	for _, t := range r.Tiers {
+		out.Tiers = append(out.Tiers, &coupon.PromotionTier{Threshold: t.Threshold, Discount: t.Discount})
+	}
+	for _, s := range r.Steps {
+		out.Steps = append(out.Steps, &coupon.MultiBuyStep{MinQuantity: s.MinQuantity, OffPercent: int32(s.OffPercent)})
+	}
+	return out
+}
+
+func toPromotionInfo(p *couponmodel.Promotions) *coupon.PromotionInfo {
+	info := &coupon.PromotionInfo{
+		PromotionId:     p.Id,
+		Name:            p.Name,
+		PromoType:       promotion.TypeToProto(p.PromoType),
+		FundedBy:        promoFunderToEnum(p.FundedBy),
+		MerchantId:      p.MerchantId,
+		MutexGroup:      p.MutexGroup,
+		StackWithCoupon: p.StackWithCoupon != 0,
+		Priority:        int32(p.Priority),
+		Disabled:        p.Status == couponmodel.PromotionStatusDisabled,
+		StartAt:         p.StartAt.Unix(),
+		EndAt:           p.EndAt.Unix(),
+		CreatedAt:       p.CreatedAt.Unix(),
+	}
+	if rule, err := promotion.ParseRule(p); err == nil {
+		info.Rule = ruleToProto(rule)
+	}
+	return info
+}
+
+func ruleToDB(r promotion.Rule) (string, error) {
+	raw, err := json.Marshal(r)
+	return string(raw), err
+}
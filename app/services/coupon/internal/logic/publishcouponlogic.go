@@ -10,6 +10,7 @@ import (
 	"NatsumeAI/app/services/coupon/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 type PublishCouponLogic struct {
@@ -45,6 +46,26 @@ func (l *PublishCouponLogic) PublishCoupon(in *coupon.PublishCouponReq) (*coupon
 		return resp, nil
 	}
 
+	issuerType, ok := issuerFromProto(in.IssuerType, in.IssuerId)
+	if !ok {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid coupon issuer"
+		return resp, nil
+	}
+	scope := in.Scope
+	if issuerType == couponmodel.CouponIssuerMerchant {
+		// 商家券只能用于本店商品
+		if scope == nil {
+			scope = &coupon.CouponScope{}
+		}
+		if scope.MerchantId != 0 && scope.MerchantId != in.IssuerId {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "merchant coupon must be scoped to issuer"
+			return resp, nil
+		}
+		scope.MerchantId = in.IssuerId
+	}
+
 	startAt := time.Unix(in.StartAt, 0).UTC()
 	endAt := time.Unix(in.EndAt, 0).UTC()
 	if !endAt.After(startAt) {
@@ -65,14 +86,35 @@ func (l *PublishCouponLogic) PublishCoupon(in *coupon.PublishCouponReq) (*coupon
 		EndAt:           endAt,
 		Source:          in.Source,
 		Remarks:         in.Remarks,
+		IssuerType:      issuerType,
+		IssuerId:        in.IssuerId,
 	}
 
-	result, err := l.svcCtx.CouponsModel.Insert(l.ctx, data)
-	if err != nil {
-		return nil, err
+	if _, ok := scopeRows(0, scope); !ok {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid coupon scope"
+		return resp, nil
 	}
 
-	campaignID, err := result.LastInsertId()
+	// 券模板与适用范围同一事务写入
+	var campaignID int64
+	err := l.svcCtx.MysqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		result, err := l.svcCtx.CouponsModel.InsertWithSession(ctx, session, data)
+		if err != nil {
+			return err
+		}
+		campaignID, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		rows, _ := scopeRows(campaignID, scope)
+		for _, row := range rows {
+			if err := l.svcCtx.CouponScopesModel.InsertWithSession(ctx, session, row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	recommendPageSize = 100
+	// 单个用户参与推荐的券数量上限，避免异常账户拖慢结算页
+	recommendMaxCoupons = 500
+)
+
+type RecommendCouponsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRecommendCouponsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecommendCouponsLogic {
+	return &RecommendCouponsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 推荐最优优惠券
+func (l *RecommendCouponsLogic) RecommendCoupons(in *coupon.RecommendCouponsReq) (*coupon.RecommendCouponsResp, error) {
+	resp := &coupon.RecommendCouponsResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.UserId <= 0 || in.OrderAmount < 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	orderAmount := in.OrderAmount
+	if orderAmount == 0 {
+		for _, line := range in.Lines {
+			if line != nil && line.Amount > 0 {
+				orderAmount += line.Amount
+			}
+		}
+	}
+	if orderAmount <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	rows, err := l.listUnused(in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	couponIds := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		couponIds = append(couponIds, row.CouponId)
+	}
+	scopes, err := loadScopes(l.ctx, l.svcCtx, couponIds)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := make([]*coupon.CouponRecommendation, 0, len(rows))
+	for _, row := range rows {
+		scope := scopes[row.CouponId]
+		item := &coupon.CouponRecommendation{Coupon: toCouponInfo(row, scope)}
+		result = append(result, item)
+
+		switch {
+		case now.Before(row.StartAt):
+			item.Reason = "coupon not started"
+			continue
+		case now.After(row.EndAt):
+			item.Reason = "coupon expired"
+			continue
+		}
+
+		eligible := scope.eligibleAmount(orderAmount, in.Lines)
+		item.EligibleAmount = eligible
+		if eligible <= 0 {
+			item.Reason = "no eligible items for coupon"
+			continue
+		}
+		if eligible < row.MinSpendAmount {
+			item.Reason = "order amount not eligible"
+			continue
+		}
+
+		discount := calcDiscountAmount(couponTypeToProto(row.CouponType), eligible, row.DiscountAmount, row.DiscountPercent, row.DiscountAmount)
+		if discount <= 0 {
+			item.Reason = "no discount available"
+			continue
+		}
+		item.Usable = true
+		item.DiscountAmount = discount
+	}
+
+	// 可用券在前，按优惠金额降序；金额相同时优先推荐先到期的券
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Usable != b.Usable {
+			return a.Usable
+		}
+		if a.DiscountAmount != b.DiscountAmount {
+			return a.DiscountAmount > b.DiscountAmount
+		}
+		return a.Coupon.EndAt < b.Coupon.EndAt
+	})
+
+	if len(result) > 0 && result[0].Usable {
+		resp.BestCouponId = result[0].Coupon.CouponId
+	}
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Recommendations = result
+	return resp, nil
+}
+
+// listUnused 分页读取用户全部未使用的券
+func (l *RecommendCouponsLogic) listUnused(userId int64) ([]*couponmodel.CouponInstanceDetail, error) {
+	var rows []*couponmodel.CouponInstanceDetail
+	for offset := 0; offset < recommendMaxCoupons; offset += recommendPageSize {
+		page, _, err := l.svcCtx.CouponInstancesModel.ListUserCoupons(l.ctx, l.svcCtx.MysqlConn, userId, couponmodel.CouponStatusUnused, offset, recommendPageSize)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, page...)
+		if len(page) < recommendPageSize {
+			break
+		}
+	}
+	return rows, nil
+}
@@ -0,0 +1,237 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/snowflake"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type RedeemCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRedeemCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedeemCodeLogic {
+	return &RedeemCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 兑换码领券：每个批次每人限兑一次，不受券模板每人限领约束
+func (l *RedeemCodeLogic) RedeemCode(in *coupon.RedeemCodeReq) (*coupon.RedeemCodeResp, error) {
+	resp := &coupon.RedeemCodeResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	code := ""
+	if in != nil {
+		code = normalizeCode(in.Code)
+	}
+	if in == nil || in.UserId <= 0 || code == "" || len(code) > 32 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	failures := newCodeFailures(l.svcCtx, in.UserId)
+	if blocked, err := failures.blocked(l.ctx); err != nil {
+		return nil, err
+	} else if blocked {
+		resp.StatusCode = errno.TooManyAttempts
+		resp.StatusMsg = errCodeToMsg(errno.TooManyAttempts, "")
+		return resp, nil
+	}
+
+	batch, codeRow, err := l.lookup(code)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		// 只统计不存在的码，防止穷举
+		if err := failures.record(l.ctx); err != nil {
+			l.Errorf("record code failure failed: user=%d err=%v", in.UserId, err)
+		}
+		resp.StatusCode = errno.CouponCodeInvalid
+		resp.StatusMsg = errCodeToMsg(errno.CouponCodeInvalid, "")
+		return resp, nil
+	}
+
+	instanceId, err := l.redeem(batch, codeRow, in.UserId, code)
+	if err != nil {
+		if be, ok := err.(*bizError); ok {
+			resp.StatusCode = be.code
+			resp.StatusMsg = errCodeToMsg(be.code, be.msg)
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	detail, err := l.svcCtx.CouponInstancesModel.FindDetail(l.ctx, l.svcCtx.MysqlConn, instanceId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := loadScopes(l.ctx, l.svcCtx, []int64{detail.CouponId})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Coupon = toCouponInfo(detail, scopes[detail.CouponId])
+	return resp, nil
+}
+
+// lookup 依次按一次性码、公共码查找，均不存在时返回 nil
+func (l *RedeemCodeLogic) lookup(code string) (*couponmodel.CouponCodeBatches, *couponmodel.CouponCodes, error) {
+	codeRow, err := l.svcCtx.CouponCodesModel.FindByCode(l.ctx, code)
+	switch {
+	case err == nil:
+		batch, err := l.svcCtx.CouponCodeBatchesModel.FindOne(l.ctx, codeRow.BatchId)
+		if err != nil {
+			if errors.Is(err, couponmodel.ErrNotFound) {
+				return nil, nil, nil
+			}
+			return nil, nil, err
+		}
+		return batch, codeRow, nil
+	case !errors.Is(err, couponmodel.ErrNotFound):
+		return nil, nil, err
+	}
+
+	batch, err := l.svcCtx.CouponCodeBatchesModel.FindBySharedCode(l.ctx, code)
+	if err != nil {
+		if errors.Is(err, couponmodel.ErrNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	return batch, nil, nil
+}
+
+// redeem 在一个事务内核销兑换码、占用用户名额并发放券实例。
+// 开启异步领券时库存以 Redis 为准，先在 Redis 中占用一张，事务失败时归还。
+func (l *RedeemCodeLogic) redeem(batch *couponmodel.CouponCodeBatches, codeRow *couponmodel.CouponCodes, userId int64, code string) (int64, error) {
+	if batch.Status != couponmodel.CodeBatchStatusActive {
+		return 0, newBizError(errno.CouponCodeInvalid, "")
+	}
+	tpl, err := l.svcCtx.CouponsModel.FindOne(l.ctx, batch.CouponId)
+	if err != nil {
+		if errors.Is(err, couponmodel.ErrNotFound) {
+			return 0, newBizError(errno.CouponNotFound, "")
+		}
+		return 0, err
+	}
+	now := time.Now()
+	if now.Before(tpl.StartAt) {
+		return 0, newBizError(errno.CouponExpired, "coupon not started")
+	}
+	if now.After(tpl.EndAt) {
+		return 0, newBizError(errno.CouponExpired, "")
+	}
+
+	reserved := l.svcCtx.KafkaWriter != nil
+	claimNo := strconv.FormatInt(snowflake.Next(), 10)
+	if reserved {
+		err := l.svcCtx.CouponClaimModel.Reserve(l.ctx, batch.CouponId, userId, claimNo, now)
+		switch {
+		case err == nil:
+		case errors.Is(err, couponmodel.ErrNotFound):
+			return 0, newBizError(errno.CouponNotFound, "")
+		case errors.Is(err, couponmodel.ErrCouponNotStarted):
+			return 0, newBizError(errno.CouponExpired, "coupon not started")
+		case errors.Is(err, couponmodel.ErrCouponExpired):
+			return 0, newBizError(errno.CouponExpired, "")
+		case errors.Is(err, couponmodel.ErrCouponSoldOut):
+			return 0, newBizError(errno.CouponSoldOut, "")
+		default:
+			return 0, err
+		}
+	}
+
+	var instanceId int64
+	err = l.svcCtx.MysqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		redemptionId, err := l.svcCtx.CouponCodeRedemptionsModel.InsertIgnoreWithSession(ctx, session, batch.Id, userId, code)
+		if err != nil {
+			return err
+		}
+		if redemptionId == 0 {
+			return newBizError(errno.CouponAlreadyClaimed, "code batch already redeemed by user")
+		}
+
+		if codeRow != nil {
+			if err := l.svcCtx.CouponCodesModel.RedeemWithSession(ctx, session, codeRow.Id, userId, now); err != nil {
+				if errors.Is(err, couponmodel.ErrCouponStatusConflict) {
+					return newBizError(errno.CouponCodeRedeemed, "")
+				}
+				return err
+			}
+		}
+		// 公共码受兑换次数上限约束，一次性码仅累计
+		if err := l.svcCtx.CouponCodeBatchesModel.IncrRedeemedWithSession(ctx, session, batch.Id, codeRow == nil); err != nil {
+			if errors.Is(err, couponmodel.ErrCouponSoldOut) {
+				return newBizError(errno.CouponSoldOut, "code usage limit reached")
+			}
+			return err
+		}
+
+		id, err := l.svcCtx.CouponInstancesModel.InsertWithSession(ctx, session, batch.CouponId, userId)
+		if err != nil {
+			return err
+		}
+		if reserved {
+			// 额度已在 Redis 中占用
+			err = l.svcCtx.CouponsModel.AddIssuedWithSession(ctx, session, batch.CouponId, 1)
+		} else {
+			err = l.svcCtx.CouponsModel.IncrementIssuedWithSession(ctx, session, batch.CouponId)
+		}
+		if err != nil {
+			if errors.Is(err, couponmodel.ErrCouponSoldOut) {
+				return newBizError(errno.CouponSoldOut, "")
+			}
+			return err
+		}
+		if err := l.svcCtx.CouponCodeRedemptionsModel.SetInstanceWithSession(ctx, session, redemptionId, id); err != nil {
+			return err
+		}
+		instanceId = id
+		return nil
+	})
+	if reserved {
+		l.settleReservation(batch.CouponId, userId, claimNo, err == nil)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if err := l.svcCtx.CouponCodeBatchesModel.DelCache(l.ctx, batch); err != nil {
+		l.Errorf("delete code batch cache failed: batch=%d err=%v", batch.Id, err)
+	}
+	return instanceId, nil
+}
+
+// settleReservation 落库成功后移除在途记录，否则归还 Redis 中占用的库存
+func (l *RedeemCodeLogic) settleReservation(couponId, userId int64, claimNo string, persisted bool) {
+	if persisted {
+		if err := l.svcCtx.CouponClaimModel.Confirm(l.ctx, couponId, claimNo); err != nil {
+			l.Errorf("confirm coupon reservation failed: claim=%s coupon=%d err=%v", claimNo, couponId, err)
+		}
+		return
+	}
+	if err := l.svcCtx.CouponClaimModel.Unclaim(l.ctx, couponId, userId, claimNo); err != nil {
+		l.Errorf("release coupon reservation failed: claim=%s coupon=%d user=%d err=%v", claimNo, couponId, userId, err)
+	}
+}
@@ -63,12 +63,21 @@ func (l *RedeemCouponLogic) RedeemCoupon(in *coupon.RedeemCouponReq) (*coupon.Re
 			return newBizError(errno.CouponExpired, "coupon expired")
 		}
 
-		if in.OrderAmount < detail.MinSpendAmount {
+		// 限定范围的券按锁定时记录的商品行金额校验门槛与优惠，不能用整单金额
+		scopes, err := loadScopes(ctx, l.svcCtx, []int64{detail.CouponId})
+		if err != nil {
+			return err
+		}
+		amount := in.OrderAmount
+		if scopes[detail.CouponId].restricted() {
+			amount = detail.LockedAmount
+		}
+		if amount <= 0 || amount < detail.MinSpendAmount {
 			return newBizError(errno.InvalidParam, "order amount not eligible")
 		}
 
 		cType := couponTypeToProto(detail.CouponType)
-		discount := calcDiscountAmount(cType, in.OrderAmount, detail.DiscountAmount, detail.DiscountPercent, detail.DiscountAmount)
+		discount := calcDiscountAmount(cType, amount, detail.DiscountAmount, detail.DiscountPercent, detail.DiscountAmount)
 		if discount <= 0 {
 			return newBizError(errno.CouponStatusInvalid, "coupon has no discount")
 		}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeCouponLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeCouponLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeCouponLogic {
+	return &RevokeCouponLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 撤销券模板，仅出资方可操作；撤销后不可再领取，已领未用的券随之失效
+func (l *RevokeCouponLogic) RevokeCoupon(in *coupon.RevokeCouponReq) (*coupon.RevokeCouponResp, error) {
+	resp := &coupon.RevokeCouponResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	tpl, err := findOwnedCoupon(l.ctx, l.svcCtx, in.CouponId, in.IssuerType, in.IssuerId)
+	if err != nil {
+		if be, ok := err.(*bizError); ok {
+			resp.StatusCode = be.code
+			resp.StatusMsg = errCodeToMsg(be.code, be.msg)
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	// 重复撤销视为成功，并重新同步领券预热数据，供同步失败后重试
+	if !tpl.RevokedAt.Valid {
+		if _, err := l.svcCtx.CouponsModel.Revoke(l.ctx, tpl.Id, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+	}
+	if err := syncClaimState(l.ctx, l.svcCtx, tpl.Id); err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
@@ -0,0 +1,201 @@
+package logic
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+)
+
+const maxScopeValueLength = 64
+
+// couponScope 券模板的适用范围
+type couponScope struct {
+	includeProducts   map[int64]struct{}
+	excludeProducts   map[int64]struct{}
+	includeCategories map[string]struct{}
+	excludeCategories map[string]struct{}
+	merchantId        int64
+}
+
+func newCouponScope(rows []*couponmodel.CouponScopes) *couponScope {
+	s := &couponScope{
+		includeProducts:   map[int64]struct{}{},
+		excludeProducts:   map[int64]struct{}{},
+		includeCategories: map[string]struct{}{},
+		excludeCategories: map[string]struct{}{},
+	}
+	for _, row := range rows {
+		include := row.Effect == couponmodel.ScopeEffectInclude
+		switch row.ScopeType {
+		case couponmodel.ScopeTypeProduct:
+			id, err := strconv.ParseInt(row.ScopeValue, 10, 64)
+			if err != nil {
+				continue
+			}
+			if include {
+				s.includeProducts[id] = struct{}{}
+			} else {
+				s.excludeProducts[id] = struct{}{}
+			}
+		case couponmodel.ScopeTypeCategory:
+			if include {
+				s.includeCategories[row.ScopeValue] = struct{}{}
+			} else {
+				s.excludeCategories[row.ScopeValue] = struct{}{}
+			}
+		case couponmodel.ScopeTypeMerchant:
+			if id, err := strconv.ParseInt(row.ScopeValue, 10, 64); err == nil && include {
+				s.merchantId = id
+			}
+		}
+	}
+	return s
+}
+
+// loadScopes 批量加载券模板的适用范围，key 为券模板ID
+func loadScopes(ctx context.Context, svcCtx *svc.ServiceContext, couponIds []int64) (map[int64]*couponScope, error) {
+	rows, err := svcCtx.CouponScopesModel.ListByCoupons(ctx, couponIds)
+	if err != nil {
+		return nil, err
+	}
+	grouped := make(map[int64][]*couponmodel.CouponScopes, len(couponIds))
+	for _, row := range rows {
+		grouped[row.CouponId] = append(grouped[row.CouponId], row)
+	}
+	scopes := make(map[int64]*couponScope, len(couponIds))
+	for _, id := range couponIds {
+		scopes[id] = newCouponScope(grouped[id])
+	}
+	return scopes, nil
+}
+
+// restricted 是否配置了任何适用范围
+func (s *couponScope) restricted() bool {
+	return len(s.includeProducts) > 0 || len(s.excludeProducts) > 0 ||
+		len(s.includeCategories) > 0 || len(s.excludeCategories) > 0 || s.merchantId > 0
+}
+
+// eligible 判断商品行是否在适用范围内：排除优先，商家限定必须满足，商品/类目包含规则命中其一即可
+func (s *couponScope) eligible(line *coupon.OrderLine) bool {
+	if _, ok := s.excludeProducts[line.ProductId]; ok {
+		return false
+	}
+	for _, c := range line.Categories {
+		if _, ok := s.excludeCategories[c]; ok {
+			return false
+		}
+	}
+	if s.merchantId > 0 && line.MerchantId != s.merchantId {
+		return false
+	}
+	if len(s.includeProducts) == 0 && len(s.includeCategories) == 0 {
+		return true
+	}
+	if _, ok := s.includeProducts[line.ProductId]; ok {
+		return true
+	}
+	for _, c := range line.Categories {
+		if _, ok := s.includeCategories[c]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// eligibleAmount 计算参与优惠的金额；未传商品行时整单视为一行，此时限定范围的券不可用
+func (s *couponScope) eligibleAmount(orderAmount int64, lines []*coupon.OrderLine) int64 {
+	if len(lines) == 0 {
+		if s.restricted() {
+			return 0
+		}
+		return orderAmount
+	}
+	var amount int64
+	for _, line := range lines {
+		if line == nil || line.Amount <= 0 {
+			continue
+		}
+		if s.eligible(line) {
+			amount += line.Amount
+		}
+	}
+	return amount
+}
+
+func (s *couponScope) toProto() *coupon.CouponScope {
+	if s == nil || !s.restricted() {
+		return nil
+	}
+	out := &coupon.CouponScope{MerchantId: s.merchantId}
+	for id := range s.includeProducts {
+		out.IncludeProductIds = append(out.IncludeProductIds, id)
+	}
+	for id := range s.excludeProducts {
+		out.ExcludeProductIds = append(out.ExcludeProductIds, id)
+	}
+	for c := range s.includeCategories {
+		out.IncludeCategories = append(out.IncludeCategories, c)
+	}
+	for c := range s.excludeCategories {
+		out.ExcludeCategories = append(out.ExcludeCategories, c)
+	}
+	slices.Sort(out.IncludeProductIds)
+	slices.Sort(out.ExcludeProductIds)
+	slices.Sort(out.IncludeCategories)
+	slices.Sort(out.ExcludeCategories)
+	return out
+}
+
+// scopeRows 将发券请求中的适用范围转换为规则行，返回 false 表示参数不合法
+func scopeRows(couponId int64, in *coupon.CouponScope) ([]*couponmodel.CouponScopes, bool) {
+	if in == nil {
+		return nil, true
+	}
+	var rows []*couponmodel.CouponScopes
+	add := func(scopeType, effect, value string) {
+		rows = append(rows, &couponmodel.CouponScopes{
+			CouponId:   couponId,
+			ScopeType:  scopeType,
+			Effect:     effect,
+			ScopeValue: value,
+		})
+	}
+	for _, id := range in.IncludeProductIds {
+		if id <= 0 {
+			return nil, false
+		}
+		add(couponmodel.ScopeTypeProduct, couponmodel.ScopeEffectInclude, strconv.FormatInt(id, 10))
+	}
+	for _, id := range in.ExcludeProductIds {
+		if id <= 0 {
+			return nil, false
+		}
+		add(couponmodel.ScopeTypeProduct, couponmodel.ScopeEffectExclude, strconv.FormatInt(id, 10))
+	}
+	for _, raw := range in.IncludeCategories {
+		c := strings.TrimSpace(raw)
+		if c == "" || len(c) > maxScopeValueLength {
+			return nil, false
+		}
+		add(couponmodel.ScopeTypeCategory, couponmodel.ScopeEffectInclude, c)
+	}
+	for _, raw := range in.ExcludeCategories {
+		c := strings.TrimSpace(raw)
+		if c == "" || len(c) > maxScopeValueLength {
+			return nil, false
+		}
+		add(couponmodel.ScopeTypeCategory, couponmodel.ScopeEffectExclude, c)
+	}
+	if in.MerchantId < 0 {
+		return nil, false
+	}
+	if in.MerchantId > 0 {
+		add(couponmodel.ScopeTypeMerchant, couponmodel.ScopeEffectInclude, strconv.FormatInt(in.MerchantId, 10))
+	}
+	return rows, true
+}
@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/campaign"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TriggerCampaignsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTriggerCampaignsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TriggerCampaignsLogic {
+	return &TriggerCampaignsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 用户事件触发发券：向用户发放所有生效中的同类活动券，重复触发不会重复发放
+func (l *TriggerCampaignsLogic) TriggerCampaigns(in *coupon.TriggerCampaignsReq) (*coupon.TriggerCampaignsResp, error) {
+	resp := &coupon.TriggerCampaignsResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.UserId <= 0 ||
+		(in.Trigger != coupon.CampaignTrigger_CAMPAIGN_TRIGGER_REGISTER && in.Trigger != coupon.CampaignTrigger_CAMPAIGN_TRIGGER_FIRST_ORDER) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	now := time.Now()
+	list, err := l.svcCtx.CouponCampaignsModel.ListActive(l.ctx, triggerEnumToDB[in.Trigger], now)
+	if err != nil {
+		return nil, err
+	}
+
+	var issued int32
+	for _, c := range list {
+		ok, err := campaign.Issue(l.ctx, l.svcCtx, c, in.UserId, "", now)
+		if err != nil {
+			// 券已发完或已结束的活动跳过，由调度任务结束
+			if errors.Is(err, couponmodel.ErrCouponSoldOut) || errors.Is(err, couponmodel.ErrCouponExpired) {
+				l.Infof("campaign coupon unavailable: campaign=%d user=%d err=%v", c.Id, in.UserId, err)
+				continue
+			}
+			return nil, err
+		}
+		if ok {
+			issued++
+			if err := l.svcCtx.CouponCampaignsModel.AddProgress(l.ctx, c.Id, couponmodel.CampaignProgress{Scanned: 1, Issued: 1}); err != nil {
+				l.Errorf("update campaign progress failed: campaign=%d err=%v", c.Id, err)
+			}
+		}
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Issued = issued
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+
+	"NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateCouponLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateCouponLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateCouponLogic {
+	return &UpdateCouponLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改券模板的发放总量、每人限领、结束时间与备注，仅出资方可操作
+func (l *UpdateCouponLogic) UpdateCoupon(in *coupon.UpdateCouponReq) (*coupon.UpdateCouponResp, error) {
+	resp := &coupon.UpdateCouponResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  errCodeToMsg(errno.InternalError, ""),
+	}
+
+	if in == nil || in.TotalIssue < 0 || in.PerUserLimit < 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = errCodeToMsg(errno.InvalidParam, "")
+		return resp, nil
+	}
+
+	tpl, err := findOwnedCoupon(l.ctx, l.svcCtx, in.CouponId, in.IssuerType, in.IssuerId)
+	if err != nil {
+		if be, ok := err.(*bizError); ok {
+			resp.StatusCode = be.code
+			resp.StatusMsg = errCodeToMsg(be.code, be.msg)
+			return resp, nil
+		}
+		return nil, err
+	}
+	if tpl.RevokedAt.Valid {
+		resp.StatusCode = errno.CouponStatusInvalid
+		resp.StatusMsg = "coupon revoked"
+		return resp, nil
+	}
+
+	endAt := time.Unix(in.EndAt, 0).UTC()
+	if !endAt.After(tpl.StartAt) {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid time range"
+		return resp, nil
+	}
+	if in.TotalIssue > 0 && in.TotalIssue < tpl.IssuedQuantity {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "total issue below issued quantity"
+		return resp, nil
+	}
+
+	// 条件更新兜底并发撤销与领券
+	ok, err := l.svcCtx.CouponsModel.UpdateSettings(l.ctx, tpl.Id, in.TotalIssue, in.PerUserLimit, endAt, in.Remarks)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		resp.StatusCode = errno.CouponStatusInvalid
+		resp.StatusMsg = errCodeToMsg(errno.CouponStatusInvalid, "")
+		return resp, nil
+	}
+	// 领券库存与有效期预热在 Redis，原地更新以保留已领与在途数量
+	if err := syncClaimState(l.ctx, l.svcCtx, tpl.Id); err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
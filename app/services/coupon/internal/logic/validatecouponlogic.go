@@ -65,7 +65,21 @@ func (l *ValidateCouponLogic) ValidateCoupon(in *coupon.ValidateCouponReq) (*cou
 		return resp, nil
 	}
 
-	if in.OrderAmount < row.MinSpendAmount {
+	// 只对适用范围内的商品行计算门槛与优惠
+	scopes, err := loadScopes(l.ctx, l.svcCtx, []int64{row.CouponId})
+	if err != nil {
+		return nil, err
+	}
+	eligible := scopes[row.CouponId].eligibleAmount(in.OrderAmount, in.Lines)
+	resp.EligibleAmount = eligible
+	if eligible <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "no eligible items for coupon"
+		resp.Valid = false
+		return resp, nil
+	}
+
+	if eligible < row.MinSpendAmount {
 		resp.StatusCode = errno.InvalidParam
 		resp.StatusMsg = "order amount not eligible"
 		resp.Valid = false
@@ -73,12 +87,14 @@ func (l *ValidateCouponLogic) ValidateCoupon(in *coupon.ValidateCouponReq) (*cou
 	}
 
 	cType := couponTypeToProto(row.CouponType)
-	discount := calcDiscountAmount(cType, in.OrderAmount, row.DiscountAmount, row.DiscountPercent, row.DiscountAmount)
+	discount := calcDiscountAmount(cType, eligible, row.DiscountAmount, row.DiscountPercent, row.DiscountAmount)
 
 	resp.StatusCode = errno.StatusOK
 	resp.StatusMsg = "ok"
 	resp.Valid = discount > 0
 	resp.DiscountAmount = discount
 	resp.CouponType = cType
+	resp.IssuerType = issuerToProto(row.IssuerType)
+	resp.IssuerId = row.IssuerId
 	return resp, nil
 }
@@ -0,0 +1,112 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"NatsumeAI/app/services/coupon/internal/svc"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	claimRetryBaseDelay = 200 * time.Millisecond
+	claimRetryMaxDelay  = 10 * time.Second
+)
+
+// ClaimEvent Redis 中领取成功后投递，由消费者写入券实例
+type ClaimEvent struct {
+	ClaimNo   string `json:"claim_no"`
+	CouponId  int64  `json:"coupon_id"`
+	UserId    int64  `json:"user_id"`
+	ClaimedAt int64  `json:"claimed_at"`
+}
+
+// PublishClaimEvent 投递领券事件，按券模板分区
+func PublishClaimEvent(ctx context.Context, sc *svc.ServiceContext, evt ClaimEvent) error {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	return sc.KafkaWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatInt(evt.CouponId, 10)),
+		Value: body,
+	})
+}
+
+// StartClaimConsumer 消费领券事件并落库
+func StartClaimConsumer(ctx context.Context, sc *svc.ServiceContext) error {
+	if len(sc.Config.KafkaConf.Broker) == 0 || sc.Config.KafkaConf.ClaimTopic == "" || sc.Config.KafkaConf.Group == "" {
+		return nil
+	}
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     sc.Config.KafkaConf.Broker,
+		GroupID:     sc.Config.KafkaConf.Group,
+		Topic:       sc.Config.KafkaConf.ClaimTopic,
+		MinBytes:    1,
+		MaxBytes:    10 << 20,
+		MaxWait:     100 * time.Millisecond,
+		StartOffset: kafka.FirstOffset,
+	})
+	defer r.Close()
+
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+		var evt ClaimEvent
+		if err := json.Unmarshal(m.Value, &evt); err != nil {
+			// 格式错误的消息无法重放，直接提交
+			logx.WithContext(ctx).Errorf("invalid coupon claim event: offset=%d err=%v", m.Offset, err)
+			_ = r.CommitMessages(ctx, m)
+			continue
+		}
+		if err := persistClaim(ctx, sc, evt); err != nil {
+			// 只有退出时才会失败，不提交位点，重启后重新投递
+			return nil
+		}
+		// 在途记录清理失败只会让重新预热时多计一次，不影响提交
+		if err := sc.CouponClaimModel.Confirm(ctx, evt.CouponId, evt.ClaimNo); err != nil {
+			logx.WithContext(ctx).Errorf("confirm coupon claim failed: claim=%s coupon=%d err=%v", evt.ClaimNo, evt.CouponId, err)
+		}
+		_ = r.CommitMessages(ctx, m)
+	}
+}
+
+// persistClaim 写入券实例并累加 issued_quantity，按领取流水号幂等。
+// Redis 中的库存与限领已经扣减，用户也已收到领取成功，因此失败时退避重试直到成功，
+// 仅在 ctx 结束时返回错误。
+func persistClaim(ctx context.Context, sc *svc.ServiceContext, evt ClaimEvent) error {
+	delay := claimRetryBaseDelay
+	for {
+		err := handleClaim(ctx, sc, evt)
+		if err == nil {
+			return nil
+		}
+		logx.WithContext(ctx).Errorf("persist coupon claim failed, retry in %s: claim=%s coupon=%d user=%d err=%v", delay, evt.ClaimNo, evt.CouponId, evt.UserId, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, claimRetryMaxDelay)
+	}
+}
+
+func handleClaim(ctx context.Context, sc *svc.ServiceContext, evt ClaimEvent) error {
+	return sc.MysqlConn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		inserted, err := sc.CouponInstancesModel.InsertClaimWithSession(ctx, session, evt.CouponId, evt.UserId, evt.ClaimNo)
+		if err != nil || !inserted {
+			return err
+		}
+		return sc.CouponsModel.AddIssuedWithSession(ctx, session, evt.CouponId, 1)
+	})
+}
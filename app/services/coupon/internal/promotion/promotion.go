@@ -0,0 +1,368 @@
+package promotion
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/coupon"
+)
+
+// Tier 满 Threshold 减 Discount(分)
+type Tier struct {
+	Threshold int64 `json:"threshold"`
+	Discount  int64 `json:"discount"`
+}
+
+// Step 满 MinQuantity 件优惠 OffPercent%
+type Step struct {
+	MinQuantity int64 `json:"min_quantity"`
+	OffPercent  int64 `json:"off_percent"`
+}
+
+// Rule 优惠规则，以 JSON 存于 promotions.rules
+type Rule struct {
+	Tiers       []Tier   `json:"tiers,omitempty"`
+	Steps       []Step   `json:"steps,omitempty"`
+	BundlePrice int64    `json:"bundle_price,omitempty"`
+	ProductIds  []int64  `json:"product_ids,omitempty"`
+	Categories  []string `json:"categories,omitempty"`
+}
+
+// ParseRule 解析活动上保存的优惠规则
+func ParseRule(p *couponmodel.Promotions) (Rule, error) {
+	var r Rule
+	err := json.Unmarshal([]byte(p.Rules), &r)
+	return r, err
+}
+
+// Validate 校验规则是否符合活动类型，通过后阶梯按门槛升序排列
+func (r *Rule) Validate(promoType string) error {
+	switch promoType {
+	case couponmodel.PromotionTypeFullReduction:
+		if len(r.Tiers) == 0 {
+			return errors.New("tiers required")
+		}
+		for _, t := range r.Tiers {
+			if t.Threshold <= 0 || t.Discount <= 0 || t.Discount > t.Threshold {
+				return errors.New("invalid tier")
+			}
+		}
+		slices.SortFunc(r.Tiers, func(a, b Tier) int { return cmp.Compare(a.Threshold, b.Threshold) })
+	case couponmodel.PromotionTypeMultiBuy:
+		if len(r.Steps) == 0 {
+			return errors.New("steps required")
+		}
+		for _, s := range r.Steps {
+			if s.MinQuantity <= 0 || s.OffPercent <= 0 || s.OffPercent >= 100 {
+				return errors.New("invalid step")
+			}
+		}
+		slices.SortFunc(r.Steps, func(a, b Step) int { return cmp.Compare(a.MinQuantity, b.MinQuantity) })
+	case couponmodel.PromotionTypeBundle:
+		// 组合价只看组合内商品，不支持按类目圈选
+		if len(r.ProductIds) < 2 || len(r.Categories) > 0 || r.BundlePrice <= 0 {
+			return errors.New("bundle requires at least 2 products and a price")
+		}
+	default:
+		return errors.New("unknown promotion type")
+	}
+	for _, id := range r.ProductIds {
+		if id <= 0 {
+			return errors.New("invalid product id")
+		}
+	}
+	return nil
+}
+
+// Result 促销计算结果，Lines 为扣除促销优惠后的商品行
+type Result struct {
+	Discount int64
+	Platform int64
+	Merchant int64
+	Applied  []*coupon.AppliedPromotion
+	Skipped  []*coupon.SkippedPromotion
+	Lines    []*coupon.OrderLine
+}
+
+// outcome 单个活动在当前商品行上的计算结果，alloc 为分摊到各行的优惠金额
+type outcome struct {
+	promo       *couponmodel.Promotions
+	discount    int64
+	alloc       map[int]int64
+	description string
+	reason      string
+}
+
+// Evaluate 依次计算促销活动，promos 需按计算顺序排列（priority 降序）。
+// 后计算的活动基于已扣除前序优惠的行金额；同一互斥组只取优惠最大的活动，优惠相同时取先计算的；
+// withCoupon 为 true 时跳过不可与优惠券叠加的活动。
+func Evaluate(promos []*couponmodel.Promotions, lines []*coupon.OrderLine, withCoupon bool) *Result {
+	res := &Result{Lines: make([]*coupon.OrderLine, 0, len(lines))}
+	for _, line := range lines {
+		if line == nil {
+			continue
+		}
+		res.Lines = append(res.Lines, &coupon.OrderLine{
+			ProductId:  line.ProductId,
+			MerchantId: line.MerchantId,
+			Categories: line.Categories,
+			Amount:     line.Amount,
+			Quantity:   line.Quantity,
+		})
+	}
+
+	usable := func(p *couponmodel.Promotions) bool {
+		return !withCoupon || p.StackWithCoupon != 0
+	}
+	decided := make(map[string]bool)
+	for i, p := range promos {
+		if !usable(p) {
+			res.skip(p, "不可与优惠券同时使用")
+			continue
+		}
+		if p.MutexGroup == "" {
+			res.take(compute(p, res.Lines))
+			continue
+		}
+		if decided[p.MutexGroup] {
+			continue
+		}
+		decided[p.MutexGroup] = true
+
+		var candidates []*outcome
+		var best *outcome
+		for _, m := range promos[i:] {
+			if m.MutexGroup != p.MutexGroup || !usable(m) {
+				continue
+			}
+			o := compute(m, res.Lines)
+			candidates = append(candidates, o)
+			if o.discount > 0 && (best == nil || o.discount > best.discount) {
+				best = o
+			}
+		}
+		for _, o := range candidates {
+			if o == best {
+				continue
+			}
+			if o.discount > 0 {
+				res.skip(o.promo, fmt.Sprintf("与「%s」互斥，已选择优惠更大的活动", best.promo.Name))
+			} else {
+				res.skip(o.promo, o.reason)
+			}
+		}
+		if best != nil {
+			res.take(best)
+		}
+	}
+	return res
+}
+
+func (r *Result) skip(p *couponmodel.Promotions, reason string) {
+	r.Skipped = append(r.Skipped, &coupon.SkippedPromotion{
+		PromotionId: p.Id,
+		Name:        p.Name,
+		Reason:      reason,
+	})
+}
+
+// take 命中则扣减行金额并记录，未命中记录原因
+func (r *Result) take(o *outcome) {
+	if o.discount <= 0 {
+		r.skip(o.promo, o.reason)
+		return
+	}
+	// 以实际分摊到各行的金额为准
+	var discount int64
+	for idx, amount := range o.alloc {
+		r.Lines[idx].Amount -= amount
+		discount += amount
+	}
+	r.Discount += discount
+	funder := coupon.PromotionFunder_PROMOTION_FUNDER_PLATFORM
+	if o.promo.FundedBy == couponmodel.PromotionFunderMerchant {
+		funder = coupon.PromotionFunder_PROMOTION_FUNDER_MERCHANT
+		r.Merchant += discount
+	} else {
+		r.Platform += discount
+	}
+	r.Applied = append(r.Applied, &coupon.AppliedPromotion{
+		PromotionId:    o.promo.Id,
+		Name:           o.promo.Name,
+		PromoType:      TypeToProto(o.promo.PromoType),
+		FundedBy:       funder,
+		MerchantId:     o.promo.MerchantId,
+		DiscountAmount: discount,
+		Description:    o.description,
+	})
+}
+
+// TypeToProto 活动类型转换为接口枚举
+func TypeToProto(t string) coupon.PromotionType {
+	switch t {
+	case couponmodel.PromotionTypeFullReduction:
+		return coupon.PromotionType_PROMOTION_TYPE_FULL_REDUCTION
+	case couponmodel.PromotionTypeMultiBuy:
+		return coupon.PromotionType_PROMOTION_TYPE_MULTI_BUY
+	case couponmodel.PromotionTypeBundle:
+		return coupon.PromotionType_PROMOTION_TYPE_BUNDLE
+	default:
+		return coupon.PromotionType_PROMOTION_TYPE_UNKNOWN
+	}
+}
+
+func compute(p *couponmodel.Promotions, lines []*coupon.OrderLine) *outcome {
+	o := &outcome{promo: p}
+	rule, err := ParseRule(p)
+	if err != nil {
+		o.reason = "活动规则配置错误"
+		return o
+	}
+
+	var idxs []int
+	var total, quantity int64
+	for i, line := range lines {
+		if line.Amount <= 0 || !rule.match(p, line) {
+			continue
+		}
+		idxs = append(idxs, i)
+		total += line.Amount
+		quantity += lineQuantity(line)
+	}
+	if len(idxs) == 0 {
+		o.reason = "订单中没有参与活动的商品"
+		return o
+	}
+
+	switch p.PromoType {
+	case couponmodel.PromotionTypeFullReduction:
+		var hit *Tier
+		for i := range rule.Tiers {
+			if total >= rule.Tiers[i].Threshold {
+				hit = &rule.Tiers[i]
+			}
+		}
+		if hit == nil {
+			first := rule.Tiers[0]
+			o.reason = fmt.Sprintf("还差%s元可享满%s减%s", yuan(first.Threshold-total), yuan(first.Threshold), yuan(first.Discount))
+			return o
+		}
+		o.discount = min(hit.Discount, total)
+		o.description = fmt.Sprintf("满%s减%s", yuan(hit.Threshold), yuan(hit.Discount))
+	case couponmodel.PromotionTypeMultiBuy:
+		var hit *Step
+		for i := range rule.Steps {
+			if quantity >= rule.Steps[i].MinQuantity {
+				hit = &rule.Steps[i]
+			}
+		}
+		if hit == nil {
+			first := rule.Steps[0]
+			o.reason = fmt.Sprintf("还差%d件可享满%d件%s", first.MinQuantity-quantity, first.MinQuantity, zhe(first.OffPercent))
+			return o
+		}
+		o.discount = total * hit.OffPercent / 100
+		o.description = fmt.Sprintf("满%d件%s", hit.MinQuantity, zhe(hit.OffPercent))
+	case couponmodel.PromotionTypeBundle:
+		return bundle(o, rule, lines, idxs)
+	default:
+		o.reason = "不支持的活动类型"
+		return o
+	}
+	o.alloc = allocate(lines, idxs, o.discount)
+	return o
+}
+
+// bundle 组合内商品各 1 件为一组，按行均价计算每组原价，组数取各商品件数的最小值
+func bundle(o *outcome, rule Rule, lines []*coupon.OrderLine, idxs []int) *outcome {
+	amounts := make(map[int64]int64, len(rule.ProductIds))
+	quantities := make(map[int64]int64, len(rule.ProductIds))
+	for _, i := range idxs {
+		amounts[lines[i].ProductId] += lines[i].Amount
+		quantities[lines[i].ProductId] += lineQuantity(lines[i])
+	}
+	var sets, setPrice int64 = -1, 0
+	for _, id := range rule.ProductIds {
+		q := quantities[id]
+		if q == 0 {
+			o.reason = "未凑齐组合商品"
+			return o
+		}
+		if sets < 0 || q < sets {
+			sets = q
+		}
+		setPrice += amounts[id] / q
+	}
+	if setPrice <= rule.BundlePrice {
+		o.reason = "组合价不低于商品原价"
+		return o
+	}
+	o.discount = sets * (setPrice - rule.BundlePrice)
+	o.description = fmt.Sprintf("%d件组合价%s元", len(rule.ProductIds), yuan(rule.BundlePrice))
+	o.alloc = allocate(lines, idxs, o.discount)
+	return o
+}
+
+// match 商品行是否参与活动：指定商家的活动只对该商家商品生效，未配置商品与类目时不限商品
+func (r Rule) match(p *couponmodel.Promotions, line *coupon.OrderLine) bool {
+	if p.MerchantId > 0 && line.MerchantId != p.MerchantId {
+		return false
+	}
+	if len(r.ProductIds) == 0 && len(r.Categories) == 0 {
+		return true
+	}
+	if slices.Contains(r.ProductIds, line.ProductId) {
+		return true
+	}
+	for _, c := range line.Categories {
+		if slices.Contains(r.Categories, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// allocate 按行金额比例分摊优惠，尾差计入最后一行
+func allocate(lines []*coupon.OrderLine, idxs []int, discount int64) map[int]int64 {
+	var total int64
+	for _, i := range idxs {
+		total += lines[i].Amount
+	}
+	alloc := make(map[int]int64, len(idxs))
+	remain := discount
+	for n, i := range idxs {
+		share := discount * lines[i].Amount / total
+		if n == len(idxs)-1 {
+			share = remain
+		}
+		share = min(share, lines[i].Amount)
+		alloc[i] = share
+		remain -= share
+	}
+	return alloc
+}
+
+func lineQuantity(line *coupon.OrderLine) int64 {
+	if line.Quantity <= 0 {
+		return 1
+	}
+	return line.Quantity
+}
+
+// yuan 分转换为元展示，整元时不带小数
+func yuan(cents int64) string {
+	if cents%100 == 0 {
+		return strconv.FormatInt(cents/100, 10)
+	}
+	return fmt.Sprintf("%.2f", float64(cents)/100)
+}
+
+// zhe 优惠百分比转换为折扣展示，如 20 -> 8折
+func zhe(offPercent int64) string {
+	return strconv.FormatFloat(float64(100-offPercent)/10, 'f', -1, 64) + "折"
+}
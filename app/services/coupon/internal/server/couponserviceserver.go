@@ -41,6 +41,12 @@ func (s *CouponServiceServer) ValidateCoupon(ctx context.Context, in *coupon.Val
 	return l.ValidateCoupon(in)
 }
 
+// 推荐最优优惠券
+func (s *CouponServiceServer) RecommendCoupons(ctx context.Context, in *coupon.RecommendCouponsReq) (*coupon.RecommendCouponsResp, error) {
+	l := logic.NewRecommendCouponsLogic(ctx, s.svcCtx)
+	return l.RecommendCoupons(in)
+}
+
 // 锁定优惠券
 func (s *CouponServiceServer) LockCoupon(ctx context.Context, in *coupon.LockCouponReq) (*coupon.LockCouponResp, error) {
 	l := logic.NewLockCouponLogic(ctx, s.svcCtx)
@@ -64,3 +70,100 @@ func (s *CouponServiceServer) PublishCoupon(ctx context.Context, in *coupon.Publ
 	l := logic.NewPublishCouponLogic(ctx, s.svcCtx)
 	return l.PublishCoupon(in)
 }
+
+// 出资方管理券模板
+func (s *CouponServiceServer) UpdateCoupon(ctx context.Context, in *coupon.UpdateCouponReq) (*coupon.UpdateCouponResp, error) {
+	l := logic.NewUpdateCouponLogic(ctx, s.svcCtx)
+	return l.UpdateCoupon(in)
+}
+
+func (s *CouponServiceServer) RevokeCoupon(ctx context.Context, in *coupon.RevokeCouponReq) (*coupon.RevokeCouponResp, error) {
+	l := logic.NewRevokeCouponLogic(ctx, s.svcCtx)
+	return l.RevokeCoupon(in)
+}
+
+func (s *CouponServiceServer) ListCouponTemplates(ctx context.Context, in *coupon.ListCouponTemplatesReq) (*coupon.ListCouponTemplatesResp, error) {
+	l := logic.NewListCouponTemplatesLogic(ctx, s.svcCtx)
+	return l.ListCouponTemplates(in)
+}
+
+// 自动发券活动
+func (s *CouponServiceServer) CreateCampaign(ctx context.Context, in *coupon.CreateCampaignReq) (*coupon.CreateCampaignResp, error) {
+	l := logic.NewCreateCampaignLogic(ctx, s.svcCtx)
+	return l.CreateCampaign(in)
+}
+
+func (s *CouponServiceServer) CancelCampaign(ctx context.Context, in *coupon.CancelCampaignReq) (*coupon.CancelCampaignResp, error) {
+	l := logic.NewCancelCampaignLogic(ctx, s.svcCtx)
+	return l.CancelCampaign(in)
+}
+
+func (s *CouponServiceServer) GetCampaign(ctx context.Context, in *coupon.GetCampaignReq) (*coupon.GetCampaignResp, error) {
+	l := logic.NewGetCampaignLogic(ctx, s.svcCtx)
+	return l.GetCampaign(in)
+}
+
+func (s *CouponServiceServer) ListCampaigns(ctx context.Context, in *coupon.ListCampaignsReq) (*coupon.ListCampaignsResp, error) {
+	l := logic.NewListCampaignsLogic(ctx, s.svcCtx)
+	return l.ListCampaigns(in)
+}
+
+// 注册、首单等用户事件触发发券
+func (s *CouponServiceServer) TriggerCampaigns(ctx context.Context, in *coupon.TriggerCampaignsReq) (*coupon.TriggerCampaignsResp, error) {
+	l := logic.NewTriggerCampaignsLogic(ctx, s.svcCtx)
+	return l.TriggerCampaigns(in)
+}
+
+// 兑换码
+func (s *CouponServiceServer) CreateCodeBatch(ctx context.Context, in *coupon.CreateCodeBatchReq) (*coupon.CreateCodeBatchResp, error) {
+	l := logic.NewCreateCodeBatchLogic(ctx, s.svcCtx)
+	return l.CreateCodeBatch(in)
+}
+
+func (s *CouponServiceServer) GetCodeBatch(ctx context.Context, in *coupon.GetCodeBatchReq) (*coupon.GetCodeBatchResp, error) {
+	l := logic.NewGetCodeBatchLogic(ctx, s.svcCtx)
+	return l.GetCodeBatch(in)
+}
+
+func (s *CouponServiceServer) DisableCodeBatch(ctx context.Context, in *coupon.DisableCodeBatchReq) (*coupon.DisableCodeBatchResp, error) {
+	l := logic.NewDisableCodeBatchLogic(ctx, s.svcCtx)
+	return l.DisableCodeBatch(in)
+}
+
+func (s *CouponServiceServer) ExportCodeBatch(ctx context.Context, in *coupon.ExportCodeBatchReq) (*coupon.ExportCodeBatchResp, error) {
+	l := logic.NewExportCodeBatchLogic(ctx, s.svcCtx)
+	return l.ExportCodeBatch(in)
+}
+
+func (s *CouponServiceServer) RedeemCode(ctx context.Context, in *coupon.RedeemCodeReq) (*coupon.RedeemCodeResp, error) {
+	l := logic.NewRedeemCodeLogic(ctx, s.svcCtx)
+	return l.RedeemCode(in)
+}
+
+// 券效果统计
+func (s *CouponServiceServer) GetCouponStats(ctx context.Context, in *coupon.GetCouponStatsReq) (*coupon.GetCouponStatsResp, error) {
+	l := logic.NewGetCouponStatsLogic(ctx, s.svcCtx)
+	return l.GetCouponStats(in)
+}
+
+// 促销活动
+func (s *CouponServiceServer) CreatePromotion(ctx context.Context, in *coupon.CreatePromotionReq) (*coupon.CreatePromotionResp, error) {
+	l := logic.NewCreatePromotionLogic(ctx, s.svcCtx)
+	return l.CreatePromotion(in)
+}
+
+func (s *CouponServiceServer) DisablePromotion(ctx context.Context, in *coupon.DisablePromotionReq) (*coupon.DisablePromotionResp, error) {
+	l := logic.NewDisablePromotionLogic(ctx, s.svcCtx)
+	return l.DisablePromotion(in)
+}
+
+func (s *CouponServiceServer) ListPromotions(ctx context.Context, in *coupon.ListPromotionsReq) (*coupon.ListPromotionsResp, error) {
+	l := logic.NewListPromotionsLogic(ctx, s.svcCtx)
+	return l.ListPromotions(in)
+}
+
+// 结算时计算可用促销及与优惠券的叠加
+func (s *CouponServiceServer) EvaluatePromotions(ctx context.Context, in *coupon.EvaluatePromotionsReq) (*coupon.EvaluatePromotionsResp, error) {
+	l := logic.NewEvaluatePromotionsLogic(ctx, s.svcCtx)
+	return l.EvaluatePromotions(in)
+}
@@ -1,11 +1,18 @@
 package svc
 
 import (
+	"time"
+
 	couponmodel "NatsumeAI/app/dal/coupon"
 	"NatsumeAI/app/services/coupon/internal/config"
+	"NatsumeAI/app/services/order/orderservice"
+	"NatsumeAI/app/services/user/userservice"
 
+	"github.com/segmentio/kafka-go"
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
@@ -13,17 +20,67 @@ type ServiceContext struct {
 	MysqlConn            sqlx.SqlConn
 	CouponsModel         couponmodel.CouponsModel
 	CouponInstancesModel couponmodel.CouponInstancesModel
+	CouponScopesModel    couponmodel.CouponScopesModel
+	CouponClaimModel     couponmodel.CouponClaimModel
+
+	CouponCampaignsModel      couponmodel.CouponCampaignsModel
+	CouponCampaignIssuesModel couponmodel.CouponCampaignIssuesModel
+
+	CouponCodeBatchesModel     couponmodel.CouponCodeBatchesModel
+	CouponCodesModel           couponmodel.CouponCodesModel
+	CouponCodeRedemptionsModel couponmodel.CouponCodeRedemptionsModel
+
+	PromotionsModel couponmodel.PromotionsModel
+
+	Redis       *redis.Redis
+	KafkaWriter *kafka.Writer
+
+	OrderRpc orderservice.OrderService
+	UserRpc  userservice.UserService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	logx.MustSetup(c.LogConf)
 
 	conn := sqlx.MustNewConn(c.MysqlConf)
+	rds := redis.MustNewRedis(c.RedisConf)
+	coupons := couponmodel.NewCouponsModel(conn, c.CacheConf)
+	instances := couponmodel.NewCouponInstancesModel(conn, c.CacheConf)
+
+	// 领券消息按券模板分区
+	var kw *kafka.Writer
+	if len(c.KafkaConf.Broker) > 0 && c.KafkaConf.ClaimTopic != "" {
+		kw = &kafka.Writer{
+			Addr:                   kafka.TCP(c.KafkaConf.Broker...),
+			Topic:                  c.KafkaConf.ClaimTopic,
+			RequiredAcks:           kafka.RequireOne,
+			Balancer:               &kafka.Hash{},
+			AllowAutoTopicCreation: true,
+			BatchTimeout:           5 * time.Millisecond,
+		}
+	}
 
 	return &ServiceContext{
 		Config:               c,
 		MysqlConn:            conn,
-		CouponsModel:         couponmodel.NewCouponsModel(conn, c.CacheConf),
-		CouponInstancesModel: couponmodel.NewCouponInstancesModel(conn, c.CacheConf),
+		CouponsModel:         coupons,
+		CouponInstancesModel: instances,
+		CouponScopesModel:    couponmodel.NewCouponScopesModel(conn, c.CacheConf),
+		CouponClaimModel:     couponmodel.NewCouponClaimModel(rds, conn, coupons, instances),
+
+		CouponCampaignsModel:      couponmodel.NewCouponCampaignsModel(conn, c.CacheConf),
+		CouponCampaignIssuesModel: couponmodel.NewCouponCampaignIssuesModel(conn, c.CacheConf),
+
+		CouponCodeBatchesModel:     couponmodel.NewCouponCodeBatchesModel(conn, c.CacheConf),
+		CouponCodesModel:           couponmodel.NewCouponCodesModel(conn, c.CacheConf),
+		CouponCodeRedemptionsModel: couponmodel.NewCouponCodeRedemptionsModel(conn, c.CacheConf),
+
+		PromotionsModel: couponmodel.NewPromotionsModel(conn, c.CacheConf),
+
+		Redis:       rds,
+		KafkaWriter: kw,
+
+		OrderRpc: orderservice.NewOrderService(zrpc.MustNewClient(c.OrderRpc)),
+		UserRpc:  userservice.NewUserService(zrpc.MustNewClient(c.UserRpc)),
 	}
 }
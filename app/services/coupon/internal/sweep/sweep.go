@@ -0,0 +1,125 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	couponmodel "NatsumeAI/app/dal/coupon"
+	"NatsumeAI/app/services/coupon/internal/svc"
+	"NatsumeAI/app/services/order/orderservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 订单服务单次查询预订单状态的上限
+const maxPreorderBatch = 500
+
+// Result 一轮清理的结果
+type Result struct {
+	Expired  int64
+	Released int64
+}
+
+// Run 执行一轮清理：先将过期的未使用券置为 EXPIRED，再释放预订单已失效的锁定券。
+func Run(ctx context.Context, sc *svc.ServiceContext, now time.Time) (Result, error) {
+	var res Result
+	batch := sc.Config.Sweep.BatchSize
+	if batch <= 0 || batch > maxPreorderBatch {
+		batch = maxPreorderBatch
+	}
+
+	for {
+		n, err := sc.CouponInstancesModel.ExpireOverdue(ctx, sc.MysqlConn, now, batch)
+		if err != nil {
+			return res, err
+		}
+		res.Expired += n
+		if n < int64(batch) {
+			break
+		}
+	}
+
+	lockedBefore := now.Add(-time.Duration(sc.Config.Sweep.LockGraceMinutes) * time.Minute)
+	var afterId int64
+	for {
+		rows, err := sc.CouponInstancesModel.ListLockedBefore(ctx, sc.MysqlConn, lockedBefore, afterId, batch)
+		if err != nil {
+			return res, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		afterId = rows[len(rows)-1].Id
+
+		released, err := releaseStale(ctx, sc, rows, now)
+		res.Released += released
+		if err != nil {
+			return res, err
+		}
+		if len(rows) < batch {
+			break
+		}
+	}
+	return res, nil
+}
+
+// releaseStale 释放预订单已取消、已过期未下单或已被删除的锁定券；已下单的预订单由订单取消流程释放。
+func releaseStale(ctx context.Context, sc *svc.ServiceContext, rows []*couponmodel.CouponInstances, now time.Time) (int64, error) {
+	ids := make([]int64, 0, len(rows))
+	seen := make(map[int64]struct{}, len(rows))
+	for _, row := range rows {
+		if _, ok := seen[row.LockedPreorder]; ok || row.LockedPreorder <= 0 {
+			continue
+		}
+		seen[row.LockedPreorder] = struct{}{}
+		ids = append(ids, row.LockedPreorder)
+	}
+
+	states := make(map[int64]*orderservice.PreorderState, len(ids))
+	if len(ids) > 0 {
+		resp, err := sc.OrderRpc.GetPreorderStates(ctx, &orderservice.GetPreorderStatesReq{PreorderIds: ids})
+		if err != nil {
+			return 0, err
+		}
+		if resp.StatusCode != 0 {
+			return 0, fmt.Errorf("get preorder states: code=%d msg=%s", resp.StatusCode, resp.StatusMsg)
+		}
+		for _, st := range resp.States {
+			states[st.PreorderId] = st
+		}
+	}
+
+	var released int64
+	for _, row := range rows {
+		if !preorderDead(states[row.LockedPreorder], now) {
+			continue
+		}
+		err := sc.CouponInstancesModel.ReleaseWithSession(ctx, sc.MysqlConn, row.Id, row.UserId, row.LockedPreorder)
+		if err != nil {
+			// 期间已被核销或释放
+			if errors.Is(err, couponmodel.ErrCouponStatusConflict) {
+				continue
+			}
+			return released, err
+		}
+		released++
+		logx.WithContext(ctx).Infof("coupon sweep: released instance=%d user=%d preorder=%d", row.Id, row.UserId, row.LockedPreorder)
+	}
+	return released, nil
+}
+
+func preorderDead(st *orderservice.PreorderState, now time.Time) bool {
+	if st == nil {
+		return true
+	}
+	switch st.Status {
+	case "CANCELLED":
+		return true
+	case "PENDING", "READY":
+		return now.Unix() > st.ExpireAt
+	default:
+		return false
+	}
+}
@@ -130,6 +130,9 @@ func buildProductIndexDefinition(dims, shards, replicas int) ([]byte, error) {
 				"price": map[string]any{
 					"type": "double",
 				},
+				"currency": map[string]any{
+					"type": "keyword",
+				},
 				"categories": map[string]any{
 					"type": "keyword",
 				},
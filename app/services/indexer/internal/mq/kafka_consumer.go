@@ -155,6 +155,7 @@ func upsertProductDocument(ctx context.Context, sc *svc.ServiceContext, indexNam
 		"description": row.Description,
 		"picture":     row.Picture,
 		"price":       row.Price,
+		"currency":    row.Currency,
 	}
 
 	if createdAt := normalizeProductTimestamp(row.CreatedAt); createdAt != "" {
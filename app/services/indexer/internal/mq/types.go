@@ -30,6 +30,7 @@ type ProductRow struct {
 	Description string `json:"description"`
 	Picture     string `json:"picture"`
 	Price       int64  `json:"price,string"`
+	Currency    string `json:"currency"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
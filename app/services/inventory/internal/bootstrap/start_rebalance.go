@@ -0,0 +1,39 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/services/inventory/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// StartRebalance 周期性刷新桶数缓存，并合并流量回落的热点 SKU 子桶。
+func StartRebalance(sc *svc.ServiceContext) func() {
+	conf := sc.Config.TokenBucket
+	if conf.Buckets <= 1 {
+		return func() {}
+	}
+	interval := time.Duration(conf.RebalanceSeconds) * time.Second
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := sc.InventoryTokenModel.RebalanceBuckets(ctx); err != nil {
+					logx.WithContext(ctx).Errorf("rebalance token buckets failed: %v", err)
+				}
+			}
+		}
+	}()
+	return cancel
+}
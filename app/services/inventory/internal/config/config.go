@@ -19,4 +19,14 @@ type Config struct {
 	CacheConf cache.CacheConf
 
 	LogConf logx.LogConf
+
+	TokenBucket TokenBucketConf `json:",optional"`
+}
+
+// TokenBucketConf 热点 SKU 令牌拆桶配置，Buckets <= 1 时不自动拆桶。
+type TokenBucketConf struct {
+	Buckets          int   `json:",default=1"`
+	HotQPS           int64 `json:",optional"`
+	ColdQPS          int64 `json:",optional"`
+	RebalanceSeconds int   `json:",default=30"`
 }
@@ -76,6 +76,7 @@ func (l *ReturnTokenLogic) ReturnToken(in *inventory.ReturnTokenReq) (*inventory
             SKU:      item.ProductId,
             Quantity: item.Quantity,
             Epoch:    ticket.Item.Epoch,
+            Bucket:   ticket.Item.Bucket,
         }
         if err := l.svcCtx.InventoryTokenModel.ReturnToken(l.ctx, in.PreorderId, tokenItem); err != nil {
             var tokenErr *inventorymodel.TokenError
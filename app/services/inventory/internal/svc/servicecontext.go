@@ -33,6 +33,10 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		Config:              c,
 		InventoryModel:      inventoryModel,
 		InventoryAuditModel: inventoryAuditModel,
-		InventoryTokenModel: inventory.NewInventoryTokenModel(redisClient, inventoryModel),
+		InventoryTokenModel: inventory.NewInventoryTokenModel(redisClient, inventoryModel, inventory.WithHotBucket(inventory.HotBucketConf{
+			Buckets: c.TokenBucket.Buckets,
+			HotQPS:  c.TokenBucket.HotQPS,
+			ColdQPS: c.TokenBucket.ColdQPS,
+		})),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 
+	"NatsumeAI/app/services/inventory/internal/bootstrap"
 	"NatsumeAI/app/services/inventory/internal/config"
 	"NatsumeAI/app/services/inventory/internal/server"
 	"NatsumeAI/app/services/inventory/internal/svc"
@@ -34,7 +35,10 @@ func main() {
 			reflection.Register(grpcServer)
 		}
 	})
-	
+
+	stopRebalance := bootstrap.StartRebalance(ctx)
+	defer stopRebalance()
+
 	if err := consul.RegisterService(c.ListenOn, c.Consul); err != nil {
 		logx.Errorw("register service error", logx.Field("err", err))
 		panic(err)
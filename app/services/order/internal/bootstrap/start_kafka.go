@@ -37,6 +37,11 @@ func StartKafka(sc *svc.ServiceContext) func() {
         } 
 
     }()
+    go func() {
+        if err := mq.StartRefundConsumer(ctx, sc); err != nil {
+            panic(err)
+        }
+    }()
 
     return func() {
         cancel()
@@ -15,6 +15,8 @@ type Config struct {
     InventoryRpc zrpc.RpcClientConf
     CouponRpc    zrpc.RpcClientConf
     ProductRpc   zrpc.RpcClientConf
+    // PaymentRpc 用于发起预售定金退款，未配置时只记录待退款状态
+    PaymentRpc   zrpc.RpcClientConf `json:",optional"`
 
     Consul consul.Conf
 
@@ -55,6 +57,10 @@ type KafkaConf struct {
     Group        string
     PreOrderTopic string
     OrderTopic    string
+    // 支付服务投递的退款结果事件
+    RefundTopic   string `json:",optional"`
+    // 无法处理的退款事件转存的死信 topic
+    RefundDeadTopic string `json:",default=payment-refund-dead"`
 }
 
 
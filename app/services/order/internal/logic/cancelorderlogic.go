@@ -3,10 +3,13 @@ package logic
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"NatsumeAI/app/common/consts/errno"
 	couponsvcpb "NatsumeAI/app/services/coupon/coupon"
 	invpb "NatsumeAI/app/services/inventory/inventory"
+	"NatsumeAI/app/services/order/internal/payclient"
+	"NatsumeAI/app/services/order/internal/presale"
 	"NatsumeAI/app/services/order/internal/svc"
 	"NatsumeAI/app/services/order/order"
 
@@ -57,6 +60,28 @@ func (l *CancelOrderLogic) CancelOrder(in *order.CancelOrderReq) (*order.CancelO
             return resp, nil
         }
 
+        // 支付中的订单先关闭支付单，渠道侧已支付则不允许取消
+        if err := payclient.ClosePayment(l.ctx, l.svcCtx, ord, in.GetReason()); err != nil {
+            if errors.Is(err, payclient.ErrPaid) {
+                resp.StatusCode = 409
+                resp.StatusMsg = "order already paid or completed"
+                return resp, nil
+            }
+            // 关单失败不阻塞取消，关单后到账的款项由支付服务自动退回
+            l.Logger.Errorf("cancel close payment failed: order=%d err=%v", ord.OrderId, err)
+        }
+
+        // 预售定金/尾款订单没有预订单资源，走预售状态机
+        if presale.IsPresaleOrder(ord) {
+            if err := presale.CancelChildOrder(l.ctx, l.svcCtx, ord, in.GetReason()); err != nil {
+                return nil, err
+            }
+            resp.StatusCode = 0
+            resp.StatusMsg = "ok"
+            resp.Status = order.OrderStatus_ORDER_STATUS_CANCELLED
+            return resp, nil
+        }
+
         // 回滚预占库存、释放券、归还令牌
         l.rollbackPreorderResources(preorderId, in.GetUserId(), ord.CouponId)
 
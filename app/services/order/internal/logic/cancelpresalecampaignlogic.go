@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/presale"
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelPresaleCampaignLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelPresaleCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelPresaleCampaignLogic {
+	return &CancelPresaleCampaignLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 取消预售活动（商家），已付定金全部退还
+func (l *CancelPresaleCampaignLogic) CancelPresaleCampaign(in *order.CancelPresaleCampaignReq) (*order.CancelPresaleCampaignResp, error) {
+	resp := &order.CancelPresaleCampaignResp{}
+	if in == nil || in.MerchantId <= 0 || in.CampaignId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	c, err := l.svcCtx.PresaleCampaigns.FindOne(l.ctx, in.CampaignId)
+	if err != nil {
+		if err == orderdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "campaign not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	if c.MerchantId != in.MerchantId {
+		resp.StatusCode = 403
+		resp.StatusMsg = "forbidden"
+		return resp, nil
+	}
+	if _, err := l.svcCtx.PresaleCampaigns.CancelIfActive(l.ctx, in.CampaignId, in.MerchantId); err != nil {
+		return nil, err
+	}
+
+	reason := in.Reason
+	if reason == "" {
+		reason = "presale campaign cancelled"
+	}
+	// 商家原因取消：未付定金直接取消，已付定金全部退还
+	rows, err := l.svcCtx.PresaleOrders.ListByCampaignStatus(l.ctx, in.CampaignId, []string{
+		orderdal.PresaleStatusDepositPending,
+		orderdal.PresaleStatusDepositPaid,
+		orderdal.PresaleStatusBalancePending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, po := range rows {
+		_, err := presale.Close(l.ctx, l.svcCtx, po.PresaleId, reason, func(po *orderdal.PresaleOrders, c *orderdal.PresaleCampaigns) (string, bool) {
+			return presale.CloseStatus(po, c, true)
+		})
+		if err != nil && err != presale.ErrNotClosable {
+			l.Logger.Errorf("cancel presale campaign: close presale failed: campaign=%d presale=%d err=%v", in.CampaignId, po.PresaleId, err)
+		}
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
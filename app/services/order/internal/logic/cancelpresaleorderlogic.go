@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/presale"
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelPresaleOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelPresaleOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelPresaleOrderLogic {
+	return &CancelPresaleOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 取消预售单，按规则没收或退还定金
+func (l *CancelPresaleOrderLogic) CancelPresaleOrder(in *order.CancelPresaleOrderReq) (*order.CancelPresaleOrderResp, error) {
+	resp := &order.CancelPresaleOrderResp{}
+	if in == nil || in.UserId <= 0 || in.PresaleId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	po, err := l.svcCtx.PresaleOrders.FindOne(l.ctx, in.PresaleId)
+	if err != nil {
+		if err == orderdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "presale order not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	if po.UserId != in.UserId {
+		resp.StatusCode = 403
+		resp.StatusMsg = "forbidden"
+		return resp, nil
+	}
+
+	reason := in.Reason
+	if reason == "" {
+		reason = "cancelled by user"
+	}
+	// 用户主动取消：定金未付直接取消；定金已付时按活动配置退还或没收
+	closed, err := presale.Close(l.ctx, l.svcCtx, po.PresaleId, reason, func(po *orderdal.PresaleOrders, c *orderdal.PresaleCampaigns) (string, bool) {
+		return presale.CloseStatus(po, c, c.DepositRefundable == 1)
+	})
+	if err != nil {
+		if err == presale.ErrNotClosable {
+			resp.StatusCode = 409
+			resp.StatusMsg = "presale order not cancellable"
+			resp.Status = toPresaleStatus(po.Status)
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Status = toPresaleStatus(closed.Status)
+	return resp, nil
+}
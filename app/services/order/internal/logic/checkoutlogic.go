@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
 	"NatsumeAI/app/common/snowflake"
 	orderdal "NatsumeAI/app/dal/order"
 	couponsvcpb "NatsumeAI/app/services/coupon/coupon"
@@ -44,6 +45,14 @@ func (l *CheckoutLogic) Checkout(in *order.CheckoutReq) (*order.CheckoutResp, er
 		return resp, nil
 	}
 
+	// 优惠券面额按 CNY 配置，外币订单暂不支持用券
+	currency := fx.Normalize(in.Currency)
+	if in.CouponId > 0 && currency != fx.BaseCurrency {
+		resp.StatusCode = 400
+		resp.StatusMsg = "coupon only available for " + fx.BaseCurrency
+		return resp, nil
+	}
+
 	if ok := l.svcCtx.CheckoutLimiter.Allow(); !ok {
 		resp.StatusCode = 403
 		resp.StatusMsg = "too many request"
@@ -91,12 +100,26 @@ func (l *CheckoutLogic) Checkout(in *order.CheckoutReq) (*order.CheckoutResp, er
 
 	var priceCents int64
 	var snap *mq.CheckoutSnapshot
+	var merchantId int64
+	var categories []string
+	// 按下单时的汇率把标价换算为支付币种，并在预订单上记录汇率快照
+	priceCurrency := currency
+	fxRate := fx.RateScale
 	if l.svcCtx.Product != nil {
 		if pr, err := l.svcCtx.Product.GetProduct(l.ctx, &prodpb.GetProductReq{
 			ProductId: in.Item.ProductId,
 			UserId:    in.UserId,
-		}); err == nil && pr != nil && pr.Product != nil {
-			priceCents = pr.Product.Price
+			Currency:  currency,
+		}); err == nil && pr != nil && pr.StatusCode == errno.CurrencyUnsupported {
+			resp.StatusCode = 400
+			resp.StatusMsg = "currency not supported"
+			return resp, nil
+		} else if err == nil && pr != nil && pr.Product != nil {
+			priceCents = pr.Product.DisplayPrice
+			priceCurrency = fx.Normalize(pr.Product.Currency)
+			fxRate = pr.Product.FxRate
+			merchantId = pr.Product.MerchantId
+			categories = pr.Product.Categories
 			snap = &mq.CheckoutSnapshot{
 				Title:      pr.Product.Name,
 				CoverImage: pr.Product.Picture,
@@ -113,13 +136,44 @@ func (l *CheckoutLogic) Checkout(in *order.CheckoutReq) (*order.CheckoutResp, er
 	// 计算金额
 	totalAmount := priceCents * in.Item.Quantity
 	finalAmount := totalAmount
+	lines := []*couponsvcpb.OrderLine{{
+		ProductId:  in.Item.ProductId,
+		MerchantId: merchantId,
+		Categories: categories,
+		Amount:     totalAmount,
+		Quantity:   in.Item.Quantity,
+	}}
+
+	// 先计算促销，优惠券按扣除促销后的商品行校验；促销按 CNY 配置，外币订单不参与。
+	// 促销计算失败时按原价继续下单，不阻塞结算
+	var promotionAmount, merchantPromotionAmount int64
+	var promotions []promotionSnapshot
+	if currency == fx.BaseCurrency && l.svcCtx.Coupon != nil && totalAmount > 0 {
+		pr, err := l.svcCtx.Coupon.EvaluatePromotions(l.ctx, &couponsvcpb.EvaluatePromotionsReq{
+			UserId:     in.UserId,
+			Lines:      lines,
+			WithCoupon: in.CouponId > 0,
+		})
+		if err != nil || pr == nil || pr.StatusCode != errno.StatusOK {
+			l.Logger.Errorf("evaluate promotions failed, checkout without promotions: user=%d product=%d err=%v", in.UserId, in.Item.ProductId, err)
+		} else if pr.DiscountAmount > 0 {
+			promotionAmount = pr.DiscountAmount
+			merchantPromotionAmount = pr.MerchantAmount
+			promotions = toPromotionSnapshots(pr.Applied)
+			lines = pr.Lines
+			finalAmount = max(totalAmount-promotionAmount, 0)
+		}
+	}
 
 	couponId := int64(0)
-	if in.CouponId > 0 && l.svcCtx.Coupon != nil && totalAmount > 0 {
+	var couponAmount, merchantCouponAmount, couponEligibleAmount int64
+	if in.CouponId > 0 && l.svcCtx.Coupon != nil && finalAmount > 0 {
 		v, err := l.svcCtx.Coupon.ValidateCoupon(l.ctx, &couponsvcpb.ValidateCouponReq{
 			UserId:      in.UserId,
 			CouponId:    in.CouponId,
-			OrderAmount: totalAmount,
+			OrderAmount: finalAmount,
+			// 按商品行校验券的适用范围
+			Lines: lines,
 		})
 		if err != nil || v == nil || !v.Valid {
 			// 归还令牌
@@ -128,23 +182,29 @@ func (l *CheckoutLogic) Checkout(in *order.CheckoutReq) (*order.CheckoutResp, er
 			return resp, nil
 		}
 		if v.DiscountAmount > 0 {
-			finalAmount = totalAmount - v.DiscountAmount
-			if finalAmount < 0 {
-				finalAmount = 0
-			}
+			couponAmount = min(v.DiscountAmount, finalAmount)
+			finalAmount -= couponAmount
+		}
+		// 商家券的优惠由出资商家承担，结算时从商家应结金额中扣除
+		if v.IssuerType == couponsvcpb.CouponIssuer_COUPON_ISSUER_MERCHANT {
+			merchantCouponAmount = couponAmount
 		}
 		couponId = in.CouponId
+		couponEligibleAmount = v.EligibleAmount
 	}
+	detail := promotionDetail(promotions)
 
 	// 第四步：写入预订单 + 可靠发布消息（优先使用 DTM）
 	evt := mq.CheckoutEvent{
 		PreorderId: preorderID,
 		UserId:     in.UserId,
 		CouponId:   couponId,
-		ProductId:  in.Item.ProductId,
-		Quantity:   in.Item.Quantity,
-		PriceCents: priceCents,
-		Snapshot:   snap,
+		// 锁券时记录参与优惠的金额，核销时据此校验限定范围的券
+		CouponEligibleAmount: couponEligibleAmount,
+		ProductId:            in.Item.ProductId,
+		Quantity:             in.Item.Quantity,
+		PriceCents:           priceCents,
+		Snapshot:             snap,
 	}
 	if l.svcCtx.Config.DtmConf.Server != "" && l.svcCtx.Config.DtmConf.BusiURL != "" {
 		gid := "checkout-" + strconv.FormatInt(preorderID, 10)
@@ -154,8 +214,8 @@ func (l *CheckoutLogic) Checkout(in *order.CheckoutReq) (*order.CheckoutResp, er
 		qp := l.svcCtx.Config.DtmConf.BusiURL + "/dtm/checkout/query?preorder_id=" + strconv.FormatInt(preorderID, 10)
 		if err := msg.DoAndSubmitDB(qp, l.svcCtx.RawDB, func(tx *sql.Tx) error {
 			// 使用原生 SQL 写入预订单，确保与消息提交原子
-			query := "insert into `order_preorders` (`preorder_id`,`user_id`,`coupon_id`,`original_amount`,`final_amount`,`status`,`expire_at`) values (?,?,?,?,?,?,?)"
-			_, err := tx.ExecContext(l.ctx, query, preorderID, in.UserId, couponId, totalAmount, finalAmount, "PENDING", expireAt)
+			query := "insert into `order_preorders` (`preorder_id`,`user_id`,`coupon_id`,`original_amount`,`final_amount`,`promotion_amount`,`merchant_promotion_amount`,`merchant_coupon_amount`,`promotion_detail`,`currency`,`price_currency`,`fx_rate`,`status`,`expire_at`) values (?,?,?,?,?,?,?,?,?,?,?,?,?,?)"
+			_, err := tx.ExecContext(l.ctx, query, preorderID, in.UserId, couponId, totalAmount, finalAmount, promotionAmount, merchantPromotionAmount, merchantCouponAmount, detail, currency, priceCurrency, fxRate, "PENDING", expireAt)
 			return err
 		}); err != nil {
 			resp.StatusCode = 500
@@ -167,13 +227,20 @@ func (l *CheckoutLogic) Checkout(in *order.CheckoutReq) (*order.CheckoutResp, er
 	} else {
 		// 回退方案：直接插入 + 直接发 Kafka
 		po := &orderdal.OrderPreorders{
-			PreorderId:     preorderID,
-			UserId:         in.UserId,
-			CouponId:       couponId,
-			OriginalAmount: totalAmount,
-			FinalAmount:    finalAmount,
-			Status:         "PENDING",
-			ExpireAt:       expireAt,
+			PreorderId:              preorderID,
+			UserId:                  in.UserId,
+			CouponId:                couponId,
+			OriginalAmount:          totalAmount,
+			FinalAmount:             finalAmount,
+			PromotionAmount:         promotionAmount,
+			MerchantPromotionAmount: merchantPromotionAmount,
+			MerchantCouponAmount:    merchantCouponAmount,
+			PromotionDetail:         detail,
+			Currency:                currency,
+			PriceCurrency:           priceCurrency,
+			FxRate:                  fxRate,
+			Status:                  "PENDING",
+			ExpireAt:                expireAt,
 		}
 		if _, err := l.svcCtx.Preorder.InsertWithId(l.ctx, po); err != nil {
 			resp.StatusCode = 500
@@ -193,5 +260,10 @@ func (l *CheckoutLogic) Checkout(in *order.CheckoutReq) (*order.CheckoutResp, er
 	resp.StatusMsg = "queued"
 	resp.PreorderId = preorderID
 	resp.ExpiredAt = expireAt.Unix()
+	resp.OriginalAmount = totalAmount
+	resp.PromotionAmount = promotionAmount
+	resp.CouponAmount = couponAmount
+	resp.FinalAmount = finalAmount
+	resp.Promotions = toOrderPromotions(promotions)
 	return resp, nil
 }
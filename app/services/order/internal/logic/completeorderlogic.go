@@ -0,0 +1,112 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type CompleteOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCompleteOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CompleteOrderLogic {
+	return &CompleteOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 确认收货：PAID -> COMPLETED。预售在尾款订单上确认，定金订单随之完成。
+func (l *CompleteOrderLogic) CompleteOrder(in *order.CompleteOrderReq) (*order.CompleteOrderResp, error) {
+	resp := &order.CompleteOrderResp{}
+	if in == nil || in.OrderId <= 0 || in.UserId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	ord, err := l.svcCtx.Orders.FindOne(l.ctx, in.OrderId)
+	if err != nil {
+		if err == orderdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "order not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	if ord.UserId != in.UserId {
+		resp.StatusCode = 403
+		resp.StatusMsg = "forbidden"
+		return resp, nil
+	}
+	resp.Status = toProtoStatus(ord.Status)
+	switch ord.Status {
+	case "COMPLETED":
+		resp.StatusCode = 0
+		resp.StatusMsg = "ok"
+		return resp, nil
+	case "PAID":
+		// proceed
+	default:
+		resp.StatusCode = 409
+		resp.StatusMsg = "order not paid"
+		return resp, nil
+	}
+
+	orderIds := []int64{ord.OrderId}
+	switch ord.OrderType {
+	case orderdal.OrderTypePresaleDeposit:
+		resp.StatusCode = 409
+		resp.StatusMsg = "presale order completes with its balance order"
+		return resp, nil
+	case orderdal.OrderTypePresaleBalance:
+		po, err := l.svcCtx.PresaleOrders.FindOneByOrderId(l.ctx, ord.OrderId)
+		if err != nil {
+			return nil, err
+		}
+		if po.Status != orderdal.PresaleStatusCompleted {
+			resp.StatusCode = 409
+			resp.StatusMsg = "presale order not completed"
+			return resp, nil
+		}
+		orderIds = append(orderIds, po.DepositOrderId)
+	}
+
+	now := time.Now()
+	err = l.svcCtx.DB.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		for _, id := range orderIds {
+			locked, err := l.svcCtx.Orders.FindOneForUpdateWithSession(ctx, session, id)
+			if err != nil {
+				return err
+			}
+			if locked.Status != "PAID" {
+				continue
+			}
+			locked.Status = "COMPLETED"
+			locked.CompletedAt = sql.NullTime{Time: now, Valid: true}
+			if err := l.svcCtx.Orders.UpdateWithSession(ctx, session, locked); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Status = order.OrderStatus_ORDER_STATUS_COMPLETED
+	return resp, nil
+}
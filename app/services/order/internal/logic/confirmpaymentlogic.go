@@ -7,6 +7,8 @@ import (
 
 	couponsvcpb "NatsumeAI/app/services/coupon/coupon"
 	invpb "NatsumeAI/app/services/inventory/inventory"
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/presale"
 	"NatsumeAI/app/services/order/internal/svc"
 	"NatsumeAI/app/services/order/order"
 
@@ -67,6 +69,25 @@ func (l *ConfirmPaymentLogic) ConfirmPayment(in *order.ConfirmPaymentReq) (*orde
 		return resp, nil
 	}
 
+	// 预售定金/尾款订单不占用现货库存，仅推进预售单状态
+	if presale.IsPresaleOrder(ord) {
+		po, err := presale.MarkChildPaid(l.ctx, l.svcCtx, ord, in.PaymentMethod)
+		if err != nil {
+			return nil, err
+		}
+		if ord.OrderType == orderdal.OrderTypePresaleDeposit && po.Status == orderdal.PresaleStatusDepositPaid {
+			if c, err := l.svcCtx.PresaleCampaigns.FindOne(l.ctx, po.CampaignId); err == nil {
+				if err := presale.ScheduleBalanceTimeout(l.svcCtx, po.PresaleId, c.BalanceEndAt); err != nil {
+					l.Logger.Errorf("schedule presale balance timeout failed: presale=%d err=%v", po.PresaleId, err)
+				}
+			}
+		}
+		resp.StatusCode = 0
+		resp.StatusMsg = "ok"
+		resp.Status = toProtoStatus(ord.Status)
+		return resp, nil
+	}
+
 	// 确认库存（从冻结转已售）
 	_, err = l.svcCtx.Inventory.DecreaseInventory(l.ctx, &invpb.DecreaseInventoryReq{
 		OrderId: ord.OrderId,
@@ -99,8 +120,35 @@ func (l *ConfirmPaymentLogic) ConfirmPayment(in *order.ConfirmPaymentReq) (*orde
 		return nil, err
 	}
 
+	l.triggerFirstOrder(ord.UserId)
+
 	resp.StatusCode = 0
 	resp.StatusMsg = "ok"
 	resp.Status = toProtoStatus(ord.Status)
 	return resp, nil
 }
+
+// triggerFirstOrder 用户首单支付成功后触发首单券活动，失败不影响支付结果
+func (l *ConfirmPaymentLogic) triggerFirstOrder(userId int64) {
+	if l.svcCtx.Coupon == nil {
+		return
+	}
+	paid, err := l.svcCtx.Orders.CountPaidByUser(l.ctx, userId)
+	if err != nil || paid != 1 {
+		if err != nil {
+			l.Logger.Errorf("count paid orders failed: user=%d err=%v", userId, err)
+		}
+		return
+	}
+	res, err := l.svcCtx.Coupon.TriggerCampaigns(l.ctx, &couponsvcpb.TriggerCampaignsReq{
+		Trigger: couponsvcpb.CampaignTrigger_CAMPAIGN_TRIGGER_FIRST_ORDER,
+		UserId:  userId,
+	})
+	if err != nil {
+		l.Logger.Errorf("trigger first order campaigns failed: user=%d err=%v", userId, err)
+		return
+	}
+	if res.Issued > 0 {
+		l.Logger.Infof("first order campaign coupons issued: user=%d count=%d", userId, res.Issued)
+	}
+}
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+	prodpb "NatsumeAI/app/services/product/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePresaleCampaignLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreatePresaleCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePresaleCampaignLogic {
+	return &CreatePresaleCampaignLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 预售：定金 + 尾款两阶段下单
+// 创建预售活动（商家）
+func (l *CreatePresaleCampaignLogic) CreatePresaleCampaign(in *order.CreatePresaleCampaignReq) (*order.CreatePresaleCampaignResp, error) {
+	resp := &order.CreatePresaleCampaignResp{}
+	if in == nil || in.MerchantId <= 0 || in.ProductId <= 0 || in.PresalePrice <= 0 || in.DepositAmount <= 0 || in.TotalQuantity < 0 || in.PerUserLimit < 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	// 定金可抵扣金额不低于定金（允许膨胀），且必须留有尾款
+	deduction := in.DepositDeduction
+	if deduction == 0 {
+		deduction = in.DepositAmount
+	}
+	if deduction < in.DepositAmount || deduction >= in.PresalePrice {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid deposit deduction"
+		return resp, nil
+	}
+	// 时间窗口：定金开始 < 定金截止 <= 尾款开始 < 尾款截止
+	if !(in.DepositStartAt < in.DepositEndAt && in.DepositEndAt <= in.BalanceStartAt && in.BalanceStartAt < in.BalanceEndAt) {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid presale window"
+		return resp, nil
+	}
+	if in.BalanceEndAt <= time.Now().Unix() {
+		resp.StatusCode = 400
+		resp.StatusMsg = "presale window already ended"
+		return resp, nil
+	}
+
+	// 校验商品归属
+	if l.svcCtx.Product != nil {
+		pr, err := l.svcCtx.Product.GetProduct(l.ctx, &prodpb.GetProductReq{ProductId: in.ProductId})
+		if err != nil || pr == nil || pr.Product == nil {
+			resp.StatusCode = 404
+			resp.StatusMsg = "product not found"
+			return resp, nil
+		}
+		if pr.Product.MerchantId != in.MerchantId {
+			resp.StatusCode = 403
+			resp.StatusMsg = "forbidden"
+			return resp, nil
+		}
+	}
+
+	refundable := int64(0)
+	if in.DepositRefundable {
+		refundable = 1
+	}
+	data := &orderdal.PresaleCampaigns{
+		MerchantId:        in.MerchantId,
+		ProductId:         in.ProductId,
+		Title:             in.Title,
+		PresalePrice:      in.PresalePrice,
+		DepositAmount:     in.DepositAmount,
+		DepositDeduction:  deduction,
+		TotalQuantity:     in.TotalQuantity,
+		ReservedQuantity:  0,
+		PerUserLimit:      in.PerUserLimit,
+		DepositStartAt:    time.Unix(in.DepositStartAt, 0),
+		DepositEndAt:      time.Unix(in.DepositEndAt, 0),
+		BalanceStartAt:    time.Unix(in.BalanceStartAt, 0),
+		BalanceEndAt:      time.Unix(in.BalanceEndAt, 0),
+		DepositRefundable: refundable,
+		Status:            orderdal.PresaleCampaignStatusActive,
+	}
+	res, err := l.svcCtx.PresaleCampaigns.Insert(l.ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	data.CampaignId, _ = res.LastInsertId()
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Campaign = toPresaleCampaign(data)
+	return resp, nil
+}
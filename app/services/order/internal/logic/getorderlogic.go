@@ -54,9 +54,18 @@ func (l *GetOrderLogic) GetOrder(in *order.GetOrderReq) (*order.GetOrderResp, er
         PaidAt:         0,
         CancelledAt:    0,
         PaymentMethod:  ord.PaymentMethod,
+        OrderType:      ord.OrderType,
+        Currency:       ord.Currency,
+        PriceCurrency:  ord.PriceCurrency,
+        FxRate:         ord.FxRate,
+        PromotionAmount: ord.PromotionAmount,
+        MerchantPromotionAmount: ord.MerchantPromotionAmount,
+        MerchantCouponAmount:    ord.MerchantCouponAmount,
+        Promotions:     parsePromotionDetail(ord.PromotionDetail),
         AddressSnapshot: func() string { if ord.AddressSnapshot.Valid { return ord.AddressSnapshot.String }; return "" }(),
     }
     if ord.PaymentAt.Valid { info.PaidAt = ord.PaymentAt.Time.Unix() }
+    if ord.CompletedAt.Valid { info.CompletedAt = ord.CompletedAt.Time.Unix() }
     // 加载商品快照信息
     items, _ := l.listOrderItems(ord.OrderId)
     info.Items = items
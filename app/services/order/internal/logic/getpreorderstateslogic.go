@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxPreorderStatesBatch = 500
+
+type GetPreorderStatesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPreorderStatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPreorderStatesLogic {
+	return &GetPreorderStatesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量查询预订单状态（券服务清理锁券用）
+func (l *GetPreorderStatesLogic) GetPreorderStates(in *order.GetPreorderStatesReq) (*order.GetPreorderStatesResp, error) {
+	resp := &order.GetPreorderStatesResp{}
+	if in == nil || len(in.PreorderIds) == 0 || len(in.PreorderIds) > maxPreorderStatesBatch {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	rows, err := l.svcCtx.Preorder.FindByIds(l.ctx, in.PreorderIds)
+	if err != nil {
+		return nil, err
+	}
+	states := make([]*order.PreorderState, 0, len(rows))
+	for _, po := range rows {
+		states = append(states, &order.PreorderState{
+			PreorderId: po.PreorderId,
+			Status:     po.Status,
+			ExpireAt:   po.ExpireAt.Unix(),
+		})
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.States = states
+	return resp, nil
+}
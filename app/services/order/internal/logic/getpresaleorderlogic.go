@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPresaleOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPresaleOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPresaleOrderLogic {
+	return &GetPresaleOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询预售单
+func (l *GetPresaleOrderLogic) GetPresaleOrder(in *order.GetPresaleOrderReq) (*order.GetPresaleOrderResp, error) {
+	resp := &order.GetPresaleOrderResp{}
+	if in == nil || in.UserId <= 0 || in.PresaleId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	po, err := l.svcCtx.PresaleOrders.FindOne(l.ctx, in.PresaleId)
+	if err != nil {
+		if err == orderdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "presale order not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	if po.UserId != in.UserId {
+		resp.StatusCode = 403
+		resp.StatusMsg = "forbidden"
+		return resp, nil
+	}
+	c, err := l.svcCtx.PresaleCampaigns.FindOne(l.ctx, po.CampaignId)
+	if err != nil && err != orderdal.ErrNotFound {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Presale = toPresaleOrderInfo(po, c)
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxUserOrderStatsBatch = 1000
+
+type GetUserOrderStatsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserOrderStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserOrderStatsLogic {
+	return &GetUserOrderStatsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 按用户汇总已支付订单（券活动人群圈选）
+func (l *GetUserOrderStatsLogic) GetUserOrderStats(in *order.GetUserOrderStatsReq) (*order.GetUserOrderStatsResp, error) {
+	resp := &order.GetUserOrderStatsResp{}
+	if in == nil || len(in.UserIds) == 0 || len(in.UserIds) > maxUserOrderStatsBatch {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	rows, err := l.svcCtx.Orders.StatsByUsers(l.ctx, in.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]*order.UserOrderStats, 0, len(rows))
+	for _, r := range rows {
+		stats = append(stats, &order.UserOrderStats{
+			UserId:     r.UserId,
+			OrderCount: r.OrderCount,
+			Spend:      r.Spend,
+		})
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Stats = stats
+	return resp, nil
+}
@@ -0,0 +1,152 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/common/fx"
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxCompletedOrdersLimit = 500
+
+type ListCompletedOrdersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListCompletedOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCompletedOrdersLogic {
+	return &ListCompletedOrdersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 按确认收货时间拉取已完成订单（结算）。全额退款的订单状态为 REFUNDED，不会返回。
+// 行金额为该行分摊到的实付金额加商家出资优惠（平台出资的促销与券不计入），部分退款按未退比例扣减；
+// 商家出资的促销与商家券优惠按同样方式分摊到各行，结算时由商家承担。
+func (l *ListCompletedOrdersLogic) ListCompletedOrders(in *order.ListCompletedOrdersReq) (*order.ListCompletedOrdersResp, error) {
+	resp := &order.ListCompletedOrdersResp{}
+	if in == nil || in.CompletedFrom <= 0 || in.CompletedTo <= in.CompletedFrom {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	limit := in.Limit
+	if limit <= 0 || limit > maxCompletedOrdersLimit {
+		limit = maxCompletedOrdersLimit
+	}
+
+	rows, err := l.svcCtx.Orders.ListCompleted(l.ctx, time.Unix(in.CompletedFrom, 0), time.Unix(in.CompletedTo, 0), in.AfterOrderId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*order.OrderInfo, 0, len(rows))
+	for _, r := range rows {
+		info := &order.OrderInfo{
+			OrderId:       r.OrderId,
+			PreorderId:    r.PreorderId,
+			UserId:        r.UserId,
+			Status:        toProtoStatus(r.Status),
+			TotalAmount:   r.TotalAmount,
+			PayAmount:     r.PaidAmount,
+			CreatedAt:     r.CreatedAt.Unix(),
+			PaymentMethod: r.PaymentMethod,
+			OrderType:     r.OrderType,
+			Currency:      r.Currency,
+			PriceCurrency: r.PriceCurrency,
+			FxRate:        r.FxRate,
+
+			PromotionAmount:         r.PromotionAmount,
+			MerchantPromotionAmount: r.MerchantPromotionAmount,
+			MerchantCouponAmount:    r.MerchantCouponAmount,
+			Promotions:              parsePromotionDetail(r.PromotionDetail),
+		}
+		if r.PaymentAt.Valid {
+			info.PaidAt = r.PaymentAt.Time.Unix()
+		}
+		if r.CompletedAt.Valid {
+			info.CompletedAt = r.CompletedAt.Time.Unix()
+		}
+		items, err := l.settleItems(r)
+		if err != nil {
+			return nil, err
+		}
+		info.Items = items
+		orders = append(orders, info)
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Orders = orders
+	return resp, nil
+}
+
+func (l *ListCompletedOrdersLogic) settleItems(ord *orderdal.Orders) ([]*order.OrderItem, error) {
+	rows, err := l.svcCtx.OrdItm.ListByOrder(l.ctx, ord.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []*order.OrderItem{}, nil
+	}
+
+	// 商家应结金额：实付 + 商家出资优惠，即原价扣除平台出资的优惠
+	merchantDiscount := ord.MerchantPromotionAmount + ord.MerchantCouponAmount
+	gross := ord.PayableAmount + merchantDiscount
+	if ord.RefundedAmount > 0 && ord.PayableAmount > 0 {
+		kept := max(ord.PayableAmount-ord.RefundedAmount, 0)
+		gross = gross * kept / ord.PayableAmount
+		merchantDiscount = merchantDiscount * kept / ord.PayableAmount
+	}
+
+	weights := make([]int64, len(rows))
+	for i, r := range rows {
+		weights[i] = int64(r.PriceCents * r.Quantity)
+	}
+	amounts := allocate(gross, weights)
+	discounts := allocate(merchantDiscount, weights)
+
+	items := make([]*order.OrderItem, 0, len(rows))
+	for i, r := range rows {
+		items = append(items, &order.OrderItem{
+			ProductId:  int64(r.ProductId),
+			Quantity:   int64(r.Quantity),
+			PriceCents: int64(r.PriceCents),
+			// 结算按商家标价币种，用下单时的汇率快照折回
+			Amount:           fx.Revert(amounts[i], ord.FxRate),
+			MerchantDiscount: fx.Revert(discounts[i], ord.FxRate),
+		})
+	}
+	return items, nil
+}
+
+// allocate 按权重分摊金额，尾差计入最后一行；权重合计为 0 时平均分摊
+func allocate(amount int64, weights []int64) []int64 {
+	var total int64
+	for _, w := range weights {
+		total += w
+	}
+	out := make([]int64, len(weights))
+	remain := amount
+	for i, w := range weights {
+		if i == len(weights)-1 {
+			out[i] = remain
+			break
+		}
+		if total > 0 {
+			out[i] = amount * w / total
+		} else {
+			out[i] = amount / int64(len(weights))
+		}
+		remain -= out[i]
+	}
+	return out
+}
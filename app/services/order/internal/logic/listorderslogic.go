@@ -55,8 +55,19 @@ func (l *ListOrdersLogic) ListOrders(in *order.ListOrdersReq) (*order.ListOrders
             PayAmount:      r.PaidAmount,
             CreatedAt:      r.CreatedAt.Unix(),
             PaymentMethod:  r.PaymentMethod,
+            OrderType:      r.OrderType,
+            Currency:       r.Currency,
+            PriceCurrency:  r.PriceCurrency,
+            FxRate:         r.FxRate,
+            PromotionAmount: r.PromotionAmount,
+            MerchantPromotionAmount: r.MerchantPromotionAmount,
+            MerchantCouponAmount:    r.MerchantCouponAmount,
+            Promotions:     parsePromotionDetail(r.PromotionDetail),
             AddressSnapshot: func() string { if r.AddressSnapshot.Valid { return r.AddressSnapshot.String }; return "" }(),
         }
+        if r.CompletedAt.Valid {
+            info.CompletedAt = r.CompletedAt.Time.Unix()
+        }
         if its, err := l.listOrderItems(r.OrderId); err == nil {
             info.Items = its
         }
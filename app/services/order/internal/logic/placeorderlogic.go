@@ -80,10 +80,18 @@ func (l *PlaceOrderLogic) PlaceOrder(in *order.PlaceOrderReq) (*order.PlaceOrder
             PreorderId:    in.PreorderId,
             UserId:        in.UserId,
             CouponId:      po.CouponId,
+            OrderType:     orderdal.OrderTypeNormal,
             Status:        "PENDING_PAYMENT",
             TotalAmount:   po.OriginalAmount,
             PayableAmount: po.FinalAmount,
+            PromotionAmount:         po.PromotionAmount,
+            MerchantPromotionAmount: po.MerchantPromotionAmount,
+            MerchantCouponAmount:    po.MerchantCouponAmount,
+            PromotionDetail:         po.PromotionDetail,
             PaidAmount:    0,
+            Currency:      po.Currency,
+            PriceCurrency: po.PriceCurrency,
+            FxRate:        po.FxRate,
             PaymentMethod: "",
             PaymentAt:     sql.NullTime{},
             ExpireTime:    po.ExpireAt.Unix(),
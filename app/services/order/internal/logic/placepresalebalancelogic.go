@@ -0,0 +1,180 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"NatsumeAI/app/common/fx"
+	"NatsumeAI/app/common/snowflake"
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/mq"
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/hibiken/asynq"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type PlacePresaleBalanceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPlacePresaleBalanceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PlacePresaleBalanceLogic {
+	return &PlacePresaleBalanceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+var (
+	errPresaleNotDepositPaid = errors.New("presale deposit not paid")
+	errPresaleDepositNoItems = errors.New("presale deposit order has no items")
+)
+
+// 预售尾款下单（生成尾款订单）
+func (l *PlacePresaleBalanceLogic) PlacePresaleBalance(in *order.PlacePresaleBalanceReq) (*order.PlacePresaleBalanceResp, error) {
+	resp := &order.PlacePresaleBalanceResp{}
+	if in == nil || in.UserId <= 0 || in.PresaleId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	po, err := l.svcCtx.PresaleOrders.FindOne(l.ctx, in.PresaleId)
+	if err != nil {
+		if err == orderdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "presale order not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	if po.UserId != in.UserId {
+		resp.StatusCode = 403
+		resp.StatusMsg = "forbidden"
+		return resp, nil
+	}
+	c, err := l.svcCtx.PresaleCampaigns.FindOne(l.ctx, po.CampaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 幂等：已生成尾款订单直接返回，过期时间以尾款订单上记录的为准
+	if po.Status == orderdal.PresaleStatusBalancePending && po.BalanceOrderId > 0 {
+		bo, err := l.svcCtx.Orders.FindOne(l.ctx, po.BalanceOrderId)
+		if err != nil {
+			return nil, err
+		}
+		resp.StatusCode = 0
+		resp.StatusMsg = "ok"
+		resp.BalanceOrderId = po.BalanceOrderId
+		resp.BalanceAmount = po.BalanceAmount
+		resp.ExpiredAt = bo.ExpireTime
+		return resp, nil
+	}
+	if po.Status != orderdal.PresaleStatusDepositPaid {
+		resp.StatusCode = 409
+		resp.StatusMsg = "presale deposit not paid"
+		return resp, nil
+	}
+	now := time.Now()
+	if c.Status != orderdal.PresaleCampaignStatusActive || now.Before(c.BalanceStartAt) || !now.Before(c.BalanceEndAt) {
+		resp.StatusCode = 409
+		resp.StatusMsg = "presale balance window closed"
+		return resp, nil
+	}
+
+	expireAt := now.Add(l.svcCtx.PreorderTTL)
+	if expireAt.After(c.BalanceEndAt) {
+		expireAt = c.BalanceEndAt
+	}
+
+	var orderID int64
+	err = l.svcCtx.DB.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		locked, err := l.svcCtx.PresaleOrders.FindOneForUpdateWithSession(ctx, session, po.PresaleId)
+		if err != nil {
+			return err
+		}
+		if locked.Status != orderdal.PresaleStatusDepositPaid {
+			return errPresaleNotDepositPaid
+		}
+
+		res, err := l.svcCtx.Orders.InsertWithSession(ctx, session, &orderdal.Orders{
+			PreorderId:    snowflake.Next(),
+			UserId:        in.UserId,
+			OrderType:     orderdal.OrderTypePresaleBalance,
+			Status:        "PENDING_PAYMENT",
+			TotalAmount:   locked.BalanceAmount,
+			PayableAmount: locked.BalanceAmount,
+			Currency:      fx.BaseCurrency,
+			PriceCurrency: fx.BaseCurrency,
+			FxRate:        fx.RateScale,
+			ExpireTime:    expireAt.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+		orderID, _ = res.LastInsertId()
+
+		// 尾款订单沿用定金订单的商品快照，定金订单缺少明细时整体回滚，不生成没有明细的尾款订单
+		rows, err := l.svcCtx.OrdItm.ListByOrder(ctx, locked.DepositOrderId)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return errPresaleDepositNoItems
+		}
+		if _, err := l.svcCtx.OrdItm.InsertWithSession(ctx, session, &orderdal.OrderItems{
+			OrderId:    uint64(orderID),
+			ProductId:  uint64(locked.ProductId),
+			Quantity:   uint64(locked.Quantity),
+			PriceCents: uint64(c.PresalePrice - c.DepositDeduction),
+			Snapshot:   rows[0].Snapshot,
+		}); err != nil {
+			return err
+		}
+
+		locked.Status = orderdal.PresaleStatusBalancePending
+		locked.BalanceOrderId = orderID
+		return l.svcCtx.PresaleOrders.UpdateWithSession(ctx, session, locked)
+	})
+	if err != nil {
+		if err == errPresaleNotDepositPaid {
+			resp.StatusCode = 409
+			resp.StatusMsg = "presale deposit not paid"
+			return resp, nil
+		}
+		if err == errPresaleDepositNoItems {
+			l.Logger.Errorf("place presale balance failed: presale=%d deposit_order=%d has no items", po.PresaleId, po.DepositOrderId)
+			resp.StatusCode = 500
+			resp.StatusMsg = "presale deposit order has no items"
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	// 尾款订单未支付超时取消，预售单回到 DEPOSIT_PAID
+	if l.svcCtx.AsynqClient != nil {
+		payload, _ := json.Marshal(mq.CancelOrderTaskPayload{
+			OrderId: orderID,
+			UserId:  in.UserId,
+		})
+		task := asynq.NewTask(mq.TaskCancelOrder, payload)
+		if _, err := l.svcCtx.AsynqClient.Enqueue(task, asynq.ProcessAt(expireAt), asynq.Queue("default")); err != nil {
+			l.Logger.Errorf("enqueue presale balance order timeout failed: presale=%d order=%d err=%v", po.PresaleId, orderID, err)
+		}
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.BalanceOrderId = orderID
+	resp.BalanceAmount = po.BalanceAmount
+	resp.ExpiredAt = expireAt.Unix()
+	return resp, nil
+}
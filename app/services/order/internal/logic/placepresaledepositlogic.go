@@ -0,0 +1,187 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"NatsumeAI/app/common/fx"
+	"NatsumeAI/app/common/snowflake"
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/mq"
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+	prodpb "NatsumeAI/app/services/product/product"
+
+	"github.com/hibiken/asynq"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type PlacePresaleDepositLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPlacePresaleDepositLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PlacePresaleDepositLogic {
+	return &PlacePresaleDepositLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+var (
+	errPresaleSoldOut     = errors.New("presale sold out")
+	errPresaleLimitExceed = errors.New("presale per user limit exceeded")
+)
+
+// 预售下单（生成定金订单）
+func (l *PlacePresaleDepositLogic) PlacePresaleDeposit(in *order.PlacePresaleDepositReq) (*order.PlacePresaleDepositResp, error) {
+	resp := &order.PlacePresaleDepositResp{}
+	if in == nil || in.UserId <= 0 || in.CampaignId <= 0 || in.Quantity <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	c, err := l.svcCtx.PresaleCampaigns.FindOne(l.ctx, in.CampaignId)
+	if err != nil {
+		if err == orderdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "campaign not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if c.Status != orderdal.PresaleCampaignStatusActive || now.Before(c.DepositStartAt) || !now.Before(c.DepositEndAt) {
+		resp.StatusCode = 409
+		resp.StatusMsg = "presale deposit window closed"
+		return resp, nil
+	}
+
+	// 定金订单的支付期限不超过定金截止时间
+	expireAt := now.Add(l.svcCtx.PreorderTTL)
+	if expireAt.After(c.DepositEndAt) {
+		expireAt = c.DepositEndAt
+	}
+	depositAmount := c.DepositAmount * in.Quantity
+	balanceAmount := (c.PresalePrice - c.DepositDeduction) * in.Quantity
+
+	var snapshot sql.NullString
+	if l.svcCtx.Product != nil {
+		if pr, err := l.svcCtx.Product.GetProduct(l.ctx, &prodpb.GetProductReq{
+			ProductId: c.ProductId,
+			UserId:    in.UserId,
+		}); err == nil && pr != nil && pr.Product != nil {
+			b, _ := json.Marshal(mq.CheckoutSnapshot{
+				Title:      pr.Product.Name,
+				CoverImage: pr.Product.Picture,
+				Attributes: pr.Product.Description,
+			})
+			snapshot = sql.NullString{String: string(b), Valid: true}
+		}
+	}
+
+	var presaleID, orderID int64
+	err = l.svcCtx.DB.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		// 限购：统计该用户仍占用名额的预售单
+		if c.PerUserLimit > 0 {
+			held, err := l.svcCtx.PresaleOrders.SumActiveQuantityWithSession(ctx, session, c.CampaignId, in.UserId)
+			if err != nil {
+				return err
+			}
+			if held+in.Quantity > c.PerUserLimit {
+				return errPresaleLimitExceed
+			}
+		}
+		// 预占预售名额
+		ok, err := l.svcCtx.PresaleCampaigns.ReserveWithSession(ctx, session, c.CampaignId, in.Quantity)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errPresaleSoldOut
+		}
+
+		// 预售订单没有预订单，使用雪花 ID 占位以满足 preorder_id 唯一约束
+		res, err := l.svcCtx.Orders.InsertWithSession(ctx, session, &orderdal.Orders{
+			PreorderId:    snowflake.Next(),
+			UserId:        in.UserId,
+			OrderType:     orderdal.OrderTypePresaleDeposit,
+			Status:        "PENDING_PAYMENT",
+			TotalAmount:   depositAmount,
+			PayableAmount: depositAmount,
+			Currency:      fx.BaseCurrency,
+			PriceCurrency: fx.BaseCurrency,
+			FxRate:        fx.RateScale,
+			ExpireTime:    expireAt.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+		orderID, _ = res.LastInsertId()
+		if _, err := l.svcCtx.OrdItm.InsertWithSession(ctx, session, &orderdal.OrderItems{
+			OrderId:    uint64(orderID),
+			ProductId:  uint64(c.ProductId),
+			Quantity:   uint64(in.Quantity),
+			PriceCents: uint64(c.DepositAmount),
+			Snapshot:   snapshot,
+		}); err != nil {
+			return err
+		}
+
+		res, err = l.svcCtx.PresaleOrders.InsertWithSession(ctx, session, &orderdal.PresaleOrders{
+			CampaignId:     c.CampaignId,
+			UserId:         in.UserId,
+			ProductId:      c.ProductId,
+			Quantity:       in.Quantity,
+			DepositAmount:  depositAmount,
+			BalanceAmount:  balanceAmount,
+			DepositOrderId: orderID,
+			Status:         orderdal.PresaleStatusDepositPending,
+		})
+		if err != nil {
+			return err
+		}
+		presaleID, _ = res.LastInsertId()
+		return nil
+	})
+	switch err {
+	case nil:
+	case errPresaleSoldOut:
+		resp.StatusCode = 409
+		resp.StatusMsg = "presale sold out"
+		return resp, nil
+	case errPresaleLimitExceed:
+		resp.StatusCode = 409
+		resp.StatusMsg = "presale per user limit exceeded"
+		return resp, nil
+	default:
+		return nil, err
+	}
+
+	// 定金未支付超时取消，复用普通订单的超时取消任务
+	if l.svcCtx.AsynqClient != nil {
+		payload, _ := json.Marshal(mq.CancelOrderTaskPayload{
+			OrderId: orderID,
+			UserId:  in.UserId,
+		})
+		task := asynq.NewTask(mq.TaskCancelOrder, payload)
+		if _, err := l.svcCtx.AsynqClient.Enqueue(task, asynq.ProcessAt(expireAt), asynq.Queue("default")); err != nil {
+			l.Logger.Errorf("enqueue presale deposit timeout failed: presale=%d order=%d err=%v", presaleID, orderID, err)
+		}
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.PresaleId = presaleID
+	resp.DepositOrderId = orderID
+	resp.DepositAmount = depositAmount
+	resp.ExpiredAt = expireAt.Unix()
+	return resp, nil
+}
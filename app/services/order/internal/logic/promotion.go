@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	couponsvcpb "NatsumeAI/app/services/coupon/coupon"
+	"NatsumeAI/app/services/order/order"
+)
+
+// promotionSnapshot 预订单/订单上记录的命中促销活动，活动后续修改或停用不影响已下单的说明
+type promotionSnapshot struct {
+	PromotionId    int64  `json:"promotion_id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	DiscountAmount int64  `json:"discount_amount"`
+	FundedBy       string `json:"funded_by"`
+	MerchantId     int64  `json:"merchant_id"`
+}
+
+func toPromotionSnapshots(applied []*couponsvcpb.AppliedPromotion) []promotionSnapshot {
+	list := make([]promotionSnapshot, 0, len(applied))
+	for _, a := range applied {
+		fundedBy := "PLATFORM"
+		if a.FundedBy == couponsvcpb.PromotionFunder_PROMOTION_FUNDER_MERCHANT {
+			fundedBy = "MERCHANT"
+		}
+		list = append(list, promotionSnapshot{
+			PromotionId:    a.PromotionId,
+			Name:           a.Name,
+			Description:    a.Description,
+			DiscountAmount: a.DiscountAmount,
+			FundedBy:       fundedBy,
+			MerchantId:     a.MerchantId,
+		})
+	}
+	return list
+}
+
+// promotionDetail 序列化促销快照，未命中时存 NULL
+func promotionDetail(list []promotionSnapshot) sql.NullString {
+	if len(list) == 0 {
+		return sql.NullString{}
+	}
+	raw, err := json.Marshal(list)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(raw), Valid: true}
+}
+
+func toOrderPromotions(list []promotionSnapshot) []*order.OrderPromotion {
+	out := make([]*order.OrderPromotion, 0, len(list))
+	for _, p := range list {
+		out = append(out, &order.OrderPromotion{
+			PromotionId:    p.PromotionId,
+			Name:           p.Name,
+			Description:    p.Description,
+			DiscountAmount: p.DiscountAmount,
+			FundedBy:       p.FundedBy,
+			MerchantId:     p.MerchantId,
+		})
+	}
+	return out
+}
+
+// parsePromotionDetail 解析订单上的促销快照，格式错误时忽略
+func parsePromotionDetail(detail sql.NullString) []*order.OrderPromotion {
+	if !detail.Valid || detail.String == "" {
+		return nil
+	}
+	var list []promotionSnapshot
+	if err := json.Unmarshal([]byte(detail.String), &list); err != nil {
+		return nil
+	}
+	return toOrderPromotions(list)
+}
@@ -1,6 +1,7 @@
 package logic
 
 import (
+	orderdal "NatsumeAI/app/dal/order"
 	"NatsumeAI/app/services/order/order"
 	"strings"
 )
@@ -18,7 +19,73 @@ func toProtoStatus(s string) order.OrderStatus {
 		return order.OrderStatus_ORDER_STATUS_CANCELLED
 	case "COMPLETED", "DONE", "FINISHED":
 		return order.OrderStatus_ORDER_STATUS_COMPLETED
+	case "REFUNDED":
+		return order.OrderStatus_ORDER_STATUS_REFUNDED
 	default:
 		return order.OrderStatus_ORDER_STATUS_UNKNOWN
 	}
 }
+
+// toPresaleStatus maps internal presale status string to protobuf enum.
+func toPresaleStatus(s string) order.PresaleStatus {
+	switch strings.ToUpper(s) {
+	case orderdal.PresaleStatusDepositPending:
+		return order.PresaleStatus_PRESALE_STATUS_DEPOSIT_PENDING
+	case orderdal.PresaleStatusDepositPaid:
+		return order.PresaleStatus_PRESALE_STATUS_DEPOSIT_PAID
+	case orderdal.PresaleStatusBalancePending:
+		return order.PresaleStatus_PRESALE_STATUS_BALANCE_PENDING
+	case orderdal.PresaleStatusCompleted:
+		return order.PresaleStatus_PRESALE_STATUS_COMPLETED
+	case orderdal.PresaleStatusCancelled:
+		return order.PresaleStatus_PRESALE_STATUS_CANCELLED
+	case orderdal.PresaleStatusDepositForfeited:
+		return order.PresaleStatus_PRESALE_STATUS_DEPOSIT_FORFEITED
+	case orderdal.PresaleStatusDepositRefunding:
+		return order.PresaleStatus_PRESALE_STATUS_DEPOSIT_REFUNDING
+	case orderdal.PresaleStatusDepositRefunded:
+		return order.PresaleStatus_PRESALE_STATUS_DEPOSIT_REFUNDED
+	default:
+		return order.PresaleStatus_PRESALE_STATUS_UNKNOWN
+	}
+}
+
+func toPresaleCampaign(c *orderdal.PresaleCampaigns) *order.PresaleCampaign {
+	if c == nil {
+		return nil
+	}
+	return &order.PresaleCampaign{
+		CampaignId:        c.CampaignId,
+		MerchantId:        c.MerchantId,
+		ProductId:         c.ProductId,
+		Title:             c.Title,
+		PresalePrice:      c.PresalePrice,
+		DepositAmount:     c.DepositAmount,
+		DepositDeduction:  c.DepositDeduction,
+		TotalQuantity:     c.TotalQuantity,
+		ReservedQuantity:  c.ReservedQuantity,
+		PerUserLimit:      c.PerUserLimit,
+		DepositStartAt:    c.DepositStartAt.Unix(),
+		DepositEndAt:      c.DepositEndAt.Unix(),
+		BalanceStartAt:    c.BalanceStartAt.Unix(),
+		BalanceEndAt:      c.BalanceEndAt.Unix(),
+		DepositRefundable: c.DepositRefundable == 1,
+		Status:            c.Status,
+	}
+}
+
+func toPresaleOrderInfo(po *orderdal.PresaleOrders, c *orderdal.PresaleCampaigns) *order.PresaleOrderInfo {
+	return &order.PresaleOrderInfo{
+		PresaleId:      po.PresaleId,
+		UserId:         po.UserId,
+		Quantity:       po.Quantity,
+		DepositAmount:  po.DepositAmount,
+		BalanceAmount:  po.BalanceAmount,
+		DepositOrderId: po.DepositOrderId,
+		BalanceOrderId: po.BalanceOrderId,
+		Status:         toPresaleStatus(po.Status),
+		CancelReason:   po.CancelReason,
+		CreatedAt:      po.CreatedAt.Unix(),
+		Campaign:       toPresaleCampaign(c),
+	}
+}
@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/services/order/internal/svc"
+	"NatsumeAI/app/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxSummarizeOrdersBatch = 1000
+
+type SummarizeOrdersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSummarizeOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SummarizeOrdersLogic {
+	return &SummarizeOrdersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 汇总已支付订单金额（券效果统计）
+func (l *SummarizeOrdersLogic) SummarizeOrders(in *order.SummarizeOrdersReq) (*order.SummarizeOrdersResp, error) {
+	resp := &order.SummarizeOrdersResp{}
+	if in == nil || len(in.OrderIds) == 0 || len(in.OrderIds) > maxSummarizeOrdersBatch {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	sum, err := l.svcCtx.Orders.SummarizePaid(l.ctx, in.OrderIds)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.OrderCount = sum.OrderCount
+	resp.TotalAmount = sum.TotalAmount
+	resp.PayableAmount = sum.PayableAmount
+	resp.PromotionAmount = sum.PromotionAmount
+	return resp, nil
+}
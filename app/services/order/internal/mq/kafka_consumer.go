@@ -11,6 +11,7 @@ import (
 	orderdal "NatsumeAI/app/dal/order"
 	couponsvcpb "NatsumeAI/app/services/coupon/coupon"
 	invpb "NatsumeAI/app/services/inventory/inventory"
+	"NatsumeAI/app/services/order/internal/presale"
 	"NatsumeAI/app/services/order/internal/svc"
 	prodpb "NatsumeAI/app/services/product/product"
 
@@ -127,7 +128,7 @@ func handleCheckout(c context.Context, s *svc.ServiceContext, e CheckoutEvent) e
 
         // Step1: LockCoupon -> ReleaseCoupon
         if e.CouponId > 0 && s.Coupon != nil {
-            lockReq := &couponsvcpb.LockCouponReq{UserId: e.UserId, CouponId: e.CouponId, OrderId: preorderID}
+            lockReq := &couponsvcpb.LockCouponReq{UserId: e.UserId, CouponId: e.CouponId, OrderId: preorderID, EligibleAmount: e.CouponEligibleAmount}
             saga.Add(couponTarget+couponsvcpb.CouponService_LockCoupon_FullMethodName, couponTarget+couponsvcpb.CouponService_ReleaseCoupon_FullMethodName, lockReq)
         }
 
@@ -148,7 +149,7 @@ func handleCheckout(c context.Context, s *svc.ServiceContext, e CheckoutEvent) e
     } else {
         // 无 DTM 配置，走原有直连逻辑（略）
         if e.CouponId > 0 && s.Coupon != nil {
-            if lr, err := s.Coupon.LockCoupon(c, &couponsvcpb.LockCouponReq{UserId: e.UserId, CouponId: e.CouponId, OrderId: preorderID}); err != nil || lr == nil || lr.StatusCode != errno.StatusOK {
+            if lr, err := s.Coupon.LockCoupon(c, &couponsvcpb.LockCouponReq{UserId: e.UserId, CouponId: e.CouponId, OrderId: preorderID, EligibleAmount: e.CouponEligibleAmount}); err != nil || lr == nil || lr.StatusCode != errno.StatusOK {
                 if err != nil {
                     logx.WithContext(c).Errorf("coupon lock rpc failed: preorder=%d coupon=%d user=%d err=%v", preorderID, e.CouponId, e.UserId, err)
                 } else {
@@ -236,6 +237,7 @@ func NewAsynqMux(sc *svc.ServiceContext) *asynq.ServeMux {
     // Register handlers
     mux.HandleFunc(TaskCancelOrder, CancelOrderHandler(sc))
     mux.HandleFunc(TaskCancelPreorder, CancelPreorderHandler(sc))
+    mux.HandleFunc(presale.TaskBalanceTimeout, presale.BalanceTimeoutHandler(sc))
     return mux
 }
 
@@ -310,6 +312,13 @@ func handleCancelOrderTask(ctx context.Context, sc *svc.ServiceContext, p Cancel
     if ord.Status != "PENDING_PAYMENT" {
         return nil
     }
+    // 预售定金/尾款订单没有预订单资源，走预售状态机
+    if presale.IsPresaleOrder(ord) {
+        if err := presale.CancelChildOrder(ctx, sc, ord, "unpaid timeout"); err != nil {
+            logx.WithContext(ctx).Errorf("order cancel: presale child order failed: order=%d err=%v", ord.OrderId, err)
+        }
+        return nil
+    }
     // get item from preorder items
     var itemProductId int64
     var itemQty int64
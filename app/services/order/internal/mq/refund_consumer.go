@@ -0,0 +1,175 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/presale"
+	"NatsumeAI/app/services/order/internal/svc"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	refundRetryBaseDelay = 200 * time.Millisecond
+	refundRetryMaxDelay  = 10 * time.Second
+	// 临时错误的最大处理次数，超过后转入死信 topic
+	refundMaxAttempts = 10
+)
+
+// errRefundPermanent 重试也无法处理的事件（订单不存在等），直接转入死信
+var errRefundPermanent = errors.New("refund event cannot be applied")
+
+// StartRefundConsumer consumes refund result events from the payment service.
+// Offsets are committed only after the event is applied or moved to the dead-letter topic.
+func StartRefundConsumer(ctx context.Context, sc *svc.ServiceContext) error {
+	if len(sc.Config.KafkaConf.Broker) == 0 || sc.Config.KafkaConf.RefundTopic == "" || sc.Config.KafkaConf.Group == "" {
+		return nil
+	}
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     sc.Config.KafkaConf.Broker,
+		GroupID:     sc.Config.KafkaConf.Group,
+		Topic:       sc.Config.KafkaConf.RefundTopic,
+		MinBytes:    1,
+		MaxBytes:    10 << 20,
+		MaxWait:     500 * time.Millisecond,
+		StartOffset: kafka.FirstOffset,
+	})
+	defer r.Close()
+	dead := &kafka.Writer{
+		Addr:                   kafka.TCP(sc.Config.KafkaConf.Broker...),
+		Topic:                  sc.Config.KafkaConf.RefundDeadTopic,
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+	}
+	defer dead.Close()
+
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+		var evt RefundEvent
+		if err := json.Unmarshal(m.Value, &evt); err != nil {
+			err = fmt.Errorf("%w: invalid payload: %v", errRefundPermanent, err)
+		} else {
+			err = applyRefundEvent(ctx, sc, evt)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				// 退出时不提交位点，重启后重新投递
+				return nil
+			}
+			if err := deadLetterRefund(ctx, dead, m, err); err != nil {
+				return nil
+			}
+		}
+		_ = r.CommitMessages(ctx, m)
+	}
+}
+
+// applyRefundEvent 临时错误退避重试，最多 refundMaxAttempts 次；永久错误立即返回
+func applyRefundEvent(ctx context.Context, sc *svc.ServiceContext, evt RefundEvent) error {
+	delay := refundRetryBaseDelay
+	for attempt := 1; ; attempt++ {
+		err := handleRefundEvent(ctx, sc, evt)
+		if err == nil || errors.Is(err, errRefundPermanent) || attempt >= refundMaxAttempts {
+			return err
+		}
+		logx.WithContext(ctx).Errorf("handle refund event failed, retry in %s: refund=%s order=%d attempt=%d err=%v", delay, evt.RefundNo, evt.OrderId, attempt, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, refundRetryMaxDelay)
+	}
+}
+
+// deadLetterRefund 将无法处理的事件原样转存到死信 topic，并在 header 中记录来源与失败原因；
+// 写入失败时持续重试，仅在 ctx 结束时返回错误
+func deadLetterRefund(ctx context.Context, w *kafka.Writer, m kafka.Message, cause error) error {
+	logx.WithContext(ctx).Errorf("refund event moved to dead letter: partition=%d offset=%d err=%v", m.Partition, m.Offset, cause)
+	msg := kafka.Message{
+		Key:   m.Key,
+		Value: m.Value,
+		Headers: []kafka.Header{
+			{Key: "source_topic", Value: []byte(m.Topic)},
+			{Key: "source_partition", Value: []byte(strconv.Itoa(m.Partition))},
+			{Key: "source_offset", Value: []byte(strconv.FormatInt(m.Offset, 10))},
+			{Key: "error", Value: []byte(cause.Error())},
+		},
+	}
+	delay := refundRetryBaseDelay
+	for {
+		err := w.WriteMessages(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		logx.WithContext(ctx).Errorf("write refund dead letter failed, retry in %s: offset=%d err=%v", delay, m.Offset, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, refundRetryMaxDelay)
+	}
+}
+
+// 退款成功时记录订单累计退款金额；累计退款达到实付金额时：定金订单推进预售单为 DEPOSIT_REFUNDED，订单置为 REFUNDED。
+// 事件可能重复投递，状态迁移均带前置状态判断。
+func handleRefundEvent(ctx context.Context, sc *svc.ServiceContext, evt RefundEvent) error {
+	if evt.Status != "SUCCESS" {
+		logx.WithContext(ctx).Errorf("refund failed: refund=%s order=%d amount=%d", evt.RefundNo, evt.OrderId, evt.Amount)
+		return nil
+	}
+
+	if evt.OrderId <= 0 || evt.RefundedTotal < 0 {
+		return fmt.Errorf("%w: invalid refund=%s order=%d", errRefundPermanent, evt.RefundNo, evt.OrderId)
+	}
+	ord, err := sc.Orders.FindOne(ctx, evt.OrderId)
+	if err != nil {
+		if err == orderdal.ErrNotFound {
+			return fmt.Errorf("%w: order %d not found", errRefundPermanent, evt.OrderId)
+		}
+		return err
+	}
+
+	// 记录累计退款金额，部分退款的订单结算时按未退部分计算
+	if err := sc.Orders.SetRefunded(ctx, ord.OrderId, evt.RefundedTotal); err != nil {
+		return err
+	}
+
+	// 组合支付按分段退款，全部分段退款成功后才算退完
+	if evt.PaidAmount <= 0 || evt.RefundedTotal < evt.PaidAmount {
+		return nil
+	}
+
+	if ord.OrderType == orderdal.OrderTypePresaleDeposit {
+		if err := presale.MarkDepositRefunded(ctx, sc, ord.OrderId); err != nil && err != orderdal.ErrNotFound {
+			return err
+		}
+	}
+
+	return sc.DB.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		locked, err := sc.Orders.FindOneForUpdateWithSession(ctx, session, ord.OrderId)
+		if err != nil {
+			return err
+		}
+		if locked.Status != "PAID" && locked.Status != "COMPLETED" {
+			return nil
+		}
+		locked.Status = "REFUNDED"
+		return sc.Orders.UpdateWithSession(ctx, session, locked)
+	})
+}
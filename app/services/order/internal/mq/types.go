@@ -5,6 +5,9 @@ type CheckoutEvent struct {
     PreorderId int64  `json:"preorder_id"`
     UserId     int64  `json:"user_id"`
     CouponId   int64  `json:"coupon_id"`
+    // CouponEligibleAmount is the line amount the coupon applied to at checkout,
+    // recorded on the coupon lock so redemption can re-check scoped coupons.
+    CouponEligibleAmount int64 `json:"coupon_eligible_amount,omitempty"`
     ProductId  int64  `json:"product_id"`
     Quantity   int64  `json:"quantity"`
     // PriceCents is the unit price at checkout time (in cents).
@@ -38,3 +41,19 @@ type CancelOrderTaskPayload struct {
     PreorderId int64 `json:"preorder_id"`
     UserId     int64 `json:"user_id"`
 }
+
+// RefundEvent mirrors the refund result event published by the payment service.
+type RefundEvent struct {
+    RefundId      int64  `json:"refund_id"`
+    RefundNo      string `json:"refund_no"`
+    RequestNo     string `json:"request_no"`
+    PaymentId     int64  `json:"payment_id"`
+    OrderId       int64  `json:"order_id"`
+    UserId        int64  `json:"user_id"`
+    Amount        int64  `json:"amount"`
+    Status        string `json:"status"`
+    // RefundedTotal is the accumulated successful refund amount of the payment.
+    RefundedTotal int64  `json:"refunded_total"`
+    PaidAmount    int64  `json:"paid_amount"`
+    FinishedAt    int64  `json:"finished_at"`
+}
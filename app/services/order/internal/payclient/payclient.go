@@ -0,0 +1,57 @@
+package payclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/svc"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+)
+
+// ErrPaid 关闭支付单时渠道侧已支付成功，订单不应再取消。
+var ErrPaid = errors.New("order payment already paid")
+
+// ClosePayment 取消订单前关闭支付中的支付单，未配置支付服务或订单未发起支付时跳过。
+func ClosePayment(ctx context.Context, sc *svc.ServiceContext, ord *orderdal.Orders, reason string) error {
+	if sc.Payment == nil || ord.Status != "PAYING" {
+		return nil
+	}
+	resp, err := sc.Payment.ClosePayment(ctx, &paymentpb.ClosePaymentReq{
+		OrderId: ord.OrderId,
+		UserId:  ord.UserId,
+		Reason:  reason,
+	})
+	if err != nil {
+		return err
+	}
+	switch resp.GetStatusCode() {
+	case 0:
+		return nil
+	case 409:
+		return ErrPaid
+	default:
+		return fmt.Errorf("close payment rejected: code=%d msg=%s", resp.GetStatusCode(), resp.GetStatusMsg())
+	}
+}
+
+// Refund 申请退款，requestNo 在同一支付单内幂等。未配置支付服务时返回 false。
+func Refund(ctx context.Context, sc *svc.ServiceContext, orderId, amount int64, reason, requestNo string) (bool, error) {
+	if sc.Payment == nil {
+		return false, nil
+	}
+	resp, err := sc.Payment.CreateRefund(ctx, &paymentpb.CreateRefundReq{
+		OrderId:   orderId,
+		Amount:    amount,
+		Reason:    reason,
+		RequestNo: requestNo,
+	})
+	if err != nil {
+		return false, err
+	}
+	if resp.GetStatusCode() != 0 {
+		return false, fmt.Errorf("create refund rejected: code=%d msg=%s", resp.GetStatusCode(), resp.GetStatusMsg())
+	}
+	return true, nil
+}
@@ -0,0 +1,255 @@
+package presale
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/payclient"
+	"NatsumeAI/app/services/order/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// ErrNotClosable 预售单已处于终态（或尾款已付），不能再取消。
+var ErrNotClosable = errors.New("presale order not closable")
+
+// IsPresaleOrder reports whether the order is a presale deposit or balance order.
+func IsPresaleOrder(ord *orderdal.Orders) bool {
+	return ord != nil && (ord.OrderType == orderdal.OrderTypePresaleDeposit || ord.OrderType == orderdal.OrderTypePresaleBalance)
+}
+
+// CloseStatus 根据当前状态决定预售单关闭后的终态：
+//   - 定金未付：直接取消；
+//   - 定金已付：商家取消活动或活动允许退定金时退还定金，否则没收定金；
+//   - 尾款已付或已是终态：不可关闭。
+func CloseStatus(po *orderdal.PresaleOrders, c *orderdal.PresaleCampaigns, refundDeposit bool) (string, bool) {
+	switch po.Status {
+	case orderdal.PresaleStatusDepositPending:
+		return orderdal.PresaleStatusCancelled, true
+	case orderdal.PresaleStatusDepositPaid, orderdal.PresaleStatusBalancePending:
+		if refundDeposit || c.Status == orderdal.PresaleCampaignStatusCancelled {
+			return orderdal.PresaleStatusDepositRefunding, true
+		}
+		return orderdal.PresaleStatusDepositForfeited, true
+	default:
+		return "", false
+	}
+}
+
+// Close 关闭预售单：取消未支付的定金/尾款订单，释放预售名额，并按 CloseStatus 落终态。
+// decide 返回 false 时不做任何修改并返回 ErrNotClosable。
+func Close(ctx context.Context, sc *svc.ServiceContext, presaleId int64, reason string, decide func(po *orderdal.PresaleOrders, c *orderdal.PresaleCampaigns) (string, bool)) (*orderdal.PresaleOrders, error) {
+	var (
+		closed *orderdal.PresaleOrders
+		paying []*orderdal.Orders
+	)
+	err := sc.DB.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		po, err := sc.PresaleOrders.FindOneForUpdateWithSession(ctx, session, presaleId)
+		if err != nil {
+			return err
+		}
+		c, err := sc.PresaleCampaigns.FindOne(ctx, po.CampaignId)
+		if err != nil {
+			return err
+		}
+		status, ok := decide(po, c)
+		if !ok {
+			return ErrNotClosable
+		}
+
+		for _, oid := range []int64{po.DepositOrderId, po.BalanceOrderId} {
+			if oid <= 0 {
+				continue
+			}
+			ord, err := sc.Orders.FindOneForUpdateWithSession(ctx, session, oid)
+			if err != nil {
+				return err
+			}
+			if ord.Status != "PENDING_PAYMENT" && ord.Status != "PAYING" {
+				continue
+			}
+			if ord.Status == "PAYING" {
+				snapshot := *ord
+				paying = append(paying, &snapshot)
+			}
+			ord.Status = "CANCELLED"
+			ord.CancelReason = reason
+			if err := sc.Orders.UpdateWithSession(ctx, session, ord); err != nil {
+				return err
+			}
+		}
+
+		po.Status = status
+		po.CancelReason = reason
+		if err := sc.PresaleOrders.UpdateWithSession(ctx, session, po); err != nil {
+			return err
+		}
+		if err := sc.PresaleCampaigns.ReleaseWithSession(ctx, session, po.CampaignId, po.Quantity); err != nil {
+			return err
+		}
+		closed = po
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 关闭被取消订单的支付单，关单后到账的款项由支付服务自动退回
+	for _, ord := range paying {
+		if err := payclient.ClosePayment(ctx, sc, ord, reason); err != nil {
+			logx.WithContext(ctx).Errorf("presale close payment failed: presale=%d order=%d err=%v", closed.PresaleId, ord.OrderId, err)
+		}
+	}
+	if closed.Status == orderdal.PresaleStatusDepositRefunding {
+		if err := RequestDepositRefund(ctx, sc, closed); err != nil {
+			// 退款申请按预售单号幂等，可重新发起
+			logx.WithContext(ctx).Errorf("presale deposit refund request failed: presale=%d deposit_order=%d err=%v", closed.PresaleId, closed.DepositOrderId, err)
+		}
+	}
+	return closed, nil
+}
+
+// RequestDepositRefund 向支付服务申请退还定金，退款成功后由退款事件推进为 DEPOSIT_REFUNDED。
+func RequestDepositRefund(ctx context.Context, sc *svc.ServiceContext, po *orderdal.PresaleOrders) error {
+	requested, err := payclient.Refund(ctx, sc, po.DepositOrderId, po.DepositAmount, "presale deposit refund", "presale-deposit-"+strconv.FormatInt(po.PresaleId, 10))
+	if err != nil {
+		return err
+	}
+	if !requested {
+		logx.WithContext(ctx).Infof("presale deposit refunding without payment rpc: presale=%d deposit_order=%d amount=%d", po.PresaleId, po.DepositOrderId, po.DepositAmount)
+	}
+	return nil
+}
+
+// MarkDepositRefunded 定金退款成功：DEPOSIT_REFUNDING -> DEPOSIT_REFUNDED，重复事件忽略。
+func MarkDepositRefunded(ctx context.Context, sc *svc.ServiceContext, depositOrderId int64) error {
+	po, err := sc.PresaleOrders.FindOneByOrderId(ctx, depositOrderId)
+	if err != nil {
+		return err
+	}
+	if po.DepositOrderId != depositOrderId {
+		return nil
+	}
+	return sc.DB.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		p, err := sc.PresaleOrders.FindOneForUpdateWithSession(ctx, session, po.PresaleId)
+		if err != nil {
+			return err
+		}
+		if p.Status != orderdal.PresaleStatusDepositRefunding {
+			return nil
+		}
+		p.Status = orderdal.PresaleStatusDepositRefunded
+		return sc.PresaleOrders.UpdateWithSession(ctx, session, p)
+	})
+}
+
+// CancelChildOrder 取消一笔未支付的定金/尾款订单（用户取消或支付超时）。
+//   - 定金订单取消：预售单整体取消并释放名额；
+//   - 尾款订单取消：预售单回到 DEPOSIT_PAID，尾款期内可以重新发起尾款订单。
+func CancelChildOrder(ctx context.Context, sc *svc.ServiceContext, ord *orderdal.Orders, reason string) error {
+	po, err := sc.PresaleOrders.FindOneByOrderId(ctx, ord.OrderId)
+	if err != nil {
+		return err
+	}
+	if ord.OrderType == orderdal.OrderTypePresaleDeposit {
+		_, err := Close(ctx, sc, po.PresaleId, reason, func(po *orderdal.PresaleOrders, _ *orderdal.PresaleCampaigns) (string, bool) {
+			return orderdal.PresaleStatusCancelled, po.Status == orderdal.PresaleStatusDepositPending
+		})
+		if err == ErrNotClosable {
+			// 预售单已被其他流程关闭，只需确保订单本身被取消
+			return cancelOrderOnly(ctx, sc, ord.OrderId, reason)
+		}
+		return err
+	}
+
+	return sc.DB.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		locked, err := sc.Orders.FindOneForUpdateWithSession(ctx, session, ord.OrderId)
+		if err != nil {
+			return err
+		}
+		if locked.Status != "PENDING_PAYMENT" && locked.Status != "PAYING" {
+			return nil
+		}
+		locked.Status = "CANCELLED"
+		locked.CancelReason = reason
+		if err := sc.Orders.UpdateWithSession(ctx, session, locked); err != nil {
+			return err
+		}
+		p, err := sc.PresaleOrders.FindOneForUpdateWithSession(ctx, session, po.PresaleId)
+		if err != nil {
+			return err
+		}
+		if p.Status != orderdal.PresaleStatusBalancePending || p.BalanceOrderId != ord.OrderId {
+			return nil
+		}
+		p.Status = orderdal.PresaleStatusDepositPaid
+		p.BalanceOrderId = 0
+		return sc.PresaleOrders.UpdateWithSession(ctx, session, p)
+	})
+}
+
+// MarkChildPaid 定金/尾款订单支付成功：订单置为 PAID 并推进预售单状态。
+func MarkChildPaid(ctx context.Context, sc *svc.ServiceContext, ord *orderdal.Orders, paymentMethod string) (*orderdal.PresaleOrders, error) {
+	po, err := sc.PresaleOrders.FindOneByOrderId(ctx, ord.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	err = sc.DB.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		locked, err := sc.Orders.FindOneForUpdateWithSession(ctx, session, ord.OrderId)
+		if err != nil {
+			return err
+		}
+		if locked.Status != "PAYING" {
+			return nil
+		}
+		locked.Status = "PAID"
+		locked.PaymentMethod = paymentMethod
+		locked.PaidAmount = locked.PayableAmount
+		locked.PaymentAt = sql.NullTime{Time: time.Now(), Valid: true}
+		if err := sc.Orders.UpdateWithSession(ctx, session, locked); err != nil {
+			return err
+		}
+		*ord = *locked
+
+		p, err := sc.PresaleOrders.FindOneForUpdateWithSession(ctx, session, po.PresaleId)
+		if err != nil {
+			return err
+		}
+		switch {
+		case ord.OrderType == orderdal.OrderTypePresaleDeposit && p.Status == orderdal.PresaleStatusDepositPending:
+			p.Status = orderdal.PresaleStatusDepositPaid
+		case ord.OrderType == orderdal.OrderTypePresaleBalance && p.Status == orderdal.PresaleStatusBalancePending && p.BalanceOrderId == ord.OrderId:
+			p.Status = orderdal.PresaleStatusCompleted
+		default:
+			return nil
+		}
+		if err := sc.PresaleOrders.UpdateWithSession(ctx, session, p); err != nil {
+			return err
+		}
+		po = p
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return po, nil
+}
+
+func cancelOrderOnly(ctx context.Context, sc *svc.ServiceContext, orderId int64, reason string) error {
+	return sc.DB.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		ord, err := sc.Orders.FindOneForUpdateWithSession(ctx, session, orderId)
+		if err != nil {
+			return err
+		}
+		if ord.Status != "PENDING_PAYMENT" && ord.Status != "PAYING" {
+			return nil
+		}
+		ord.Status = "CANCELLED"
+		ord.CancelReason = reason
+		return sc.Orders.UpdateWithSession(ctx, session, ord)
+	})
+}
@@ -0,0 +1,74 @@
+package presale
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	orderdal "NatsumeAI/app/dal/order"
+	"NatsumeAI/app/services/order/internal/svc"
+
+	"github.com/hibiken/asynq"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// TaskBalanceTimeout 尾款截止任务：截止时仍未付尾款的预售单没收定金。
+const TaskBalanceTimeout = "order:presale_balance_timeout"
+
+// BalanceTimeoutPayload represents payload for the balance deadline task.
+type BalanceTimeoutPayload struct {
+	PresaleId int64 `json:"presale_id"`
+}
+
+// ScheduleBalanceTimeout 在尾款截止时间投递尾款超时任务。
+func ScheduleBalanceTimeout(sc *svc.ServiceContext, presaleId int64, deadline time.Time) error {
+	if sc.AsynqClient == nil {
+		return nil
+	}
+	payload, _ := json.Marshal(BalanceTimeoutPayload{PresaleId: presaleId})
+	task := asynq.NewTask(TaskBalanceTimeout, payload)
+	_, err := sc.AsynqClient.Enqueue(task, asynq.ProcessAt(deadline), asynq.Queue("default"))
+	return err
+}
+
+// BalanceTimeoutHandler returns an asynq handler that forfeits deposits whose balance was not paid in time.
+func BalanceTimeoutHandler(sc *svc.ServiceContext) asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		var p BalanceTimeoutPayload
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return err
+		}
+		return handleBalanceTimeout(ctx, sc, p)
+	}
+}
+
+func handleBalanceTimeout(ctx context.Context, sc *svc.ServiceContext, p BalanceTimeoutPayload) error {
+	po, err := sc.PresaleOrders.FindOne(ctx, p.PresaleId)
+	if err != nil {
+		return nil
+	}
+	if po.Status != orderdal.PresaleStatusDepositPaid && po.Status != orderdal.PresaleStatusBalancePending {
+		return nil
+	}
+	// 尾款支付进行中，交由支付结果决定，稍后重试
+	if po.BalanceOrderId > 0 {
+		if ord, err := sc.Orders.FindOne(ctx, po.BalanceOrderId); err == nil && ord.Status == "PAYING" {
+			return fmt.Errorf("presale %d balance payment in progress", po.PresaleId)
+		}
+	}
+
+	_, err = Close(ctx, sc, po.PresaleId, "balance payment timeout", func(po *orderdal.PresaleOrders, c *orderdal.PresaleCampaigns) (string, bool) {
+		if time.Now().Before(c.BalanceEndAt) {
+			return "", false
+		}
+		return CloseStatus(po, c, false)
+	})
+	if err == ErrNotClosable {
+		return nil
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("presale balance timeout failed: presale=%d err=%v", po.PresaleId, err)
+	}
+	return err
+}
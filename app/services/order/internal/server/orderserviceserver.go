@@ -64,3 +64,69 @@ func (s *OrderServiceServer) ListOrders(ctx context.Context, in *order.ListOrder
 	l := logic.NewListOrdersLogic(ctx, s.svcCtx)
 	return l.ListOrders(in)
 }
+
+// 确认收货
+func (s *OrderServiceServer) CompleteOrder(ctx context.Context, in *order.CompleteOrderReq) (*order.CompleteOrderResp, error) {
+	l := logic.NewCompleteOrderLogic(ctx, s.svcCtx)
+	return l.CompleteOrder(in)
+}
+
+// 按确认收货时间拉取已完成订单（结算）
+func (s *OrderServiceServer) ListCompletedOrders(ctx context.Context, in *order.ListCompletedOrdersReq) (*order.ListCompletedOrdersResp, error) {
+	l := logic.NewListCompletedOrdersLogic(ctx, s.svcCtx)
+	return l.ListCompletedOrders(in)
+}
+
+// 批量查询预订单状态（券服务清理锁券用）
+func (s *OrderServiceServer) GetPreorderStates(ctx context.Context, in *order.GetPreorderStatesReq) (*order.GetPreorderStatesResp, error) {
+	l := logic.NewGetPreorderStatesLogic(ctx, s.svcCtx)
+	return l.GetPreorderStates(in)
+}
+
+// 按用户汇总已支付订单（券活动人群圈选）
+func (s *OrderServiceServer) GetUserOrderStats(ctx context.Context, in *order.GetUserOrderStatsReq) (*order.GetUserOrderStatsResp, error) {
+	l := logic.NewGetUserOrderStatsLogic(ctx, s.svcCtx)
+	return l.GetUserOrderStats(in)
+}
+
+// 汇总已支付订单金额（券效果统计）
+func (s *OrderServiceServer) SummarizeOrders(ctx context.Context, in *order.SummarizeOrdersReq) (*order.SummarizeOrdersResp, error) {
+	l := logic.NewSummarizeOrdersLogic(ctx, s.svcCtx)
+	return l.SummarizeOrders(in)
+}
+
+// 创建预售活动（商家）
+func (s *OrderServiceServer) CreatePresaleCampaign(ctx context.Context, in *order.CreatePresaleCampaignReq) (*order.CreatePresaleCampaignResp, error) {
+	l := logic.NewCreatePresaleCampaignLogic(ctx, s.svcCtx)
+	return l.CreatePresaleCampaign(in)
+}
+
+// 取消预售活动（商家），已付定金全部退还
+func (s *OrderServiceServer) CancelPresaleCampaign(ctx context.Context, in *order.CancelPresaleCampaignReq) (*order.CancelPresaleCampaignResp, error) {
+	l := logic.NewCancelPresaleCampaignLogic(ctx, s.svcCtx)
+	return l.CancelPresaleCampaign(in)
+}
+
+// 预售下单（生成定金订单）
+func (s *OrderServiceServer) PlacePresaleDeposit(ctx context.Context, in *order.PlacePresaleDepositReq) (*order.PlacePresaleDepositResp, error) {
+	l := logic.NewPlacePresaleDepositLogic(ctx, s.svcCtx)
+	return l.PlacePresaleDeposit(in)
+}
+
+// 预售尾款下单（生成尾款订单）
+func (s *OrderServiceServer) PlacePresaleBalance(ctx context.Context, in *order.PlacePresaleBalanceReq) (*order.PlacePresaleBalanceResp, error) {
+	l := logic.NewPlacePresaleBalanceLogic(ctx, s.svcCtx)
+	return l.PlacePresaleBalance(in)
+}
+
+// 取消预售单，按规则没收或退还定金
+func (s *OrderServiceServer) CancelPresaleOrder(ctx context.Context, in *order.CancelPresaleOrderReq) (*order.CancelPresaleOrderResp, error) {
+	l := logic.NewCancelPresaleOrderLogic(ctx, s.svcCtx)
+	return l.CancelPresaleOrder(in)
+}
+
+// 查询预售单
+func (s *OrderServiceServer) GetPresaleOrder(ctx context.Context, in *order.GetPresaleOrderReq) (*order.GetPresaleOrderResp, error) {
+	l := logic.NewGetPresaleOrderLogic(ctx, s.svcCtx)
+	return l.GetPresaleOrder(in)
+}
@@ -8,6 +8,7 @@ import (
 	couponsvc "NatsumeAI/app/services/coupon/couponservice"
 	invsvc "NatsumeAI/app/services/inventory/inventoryservice"
 	"NatsumeAI/app/services/order/internal/config"
+	paysvc "NatsumeAI/app/services/payment/paymentservice"
 	prodsvc "NatsumeAI/app/services/product/productservice"
 
 	"github.com/hibiken/asynq"
@@ -28,9 +29,13 @@ type ServiceContext struct {
 	Orders   orderdal.OrdersModel
 	OrdItm   orderdal.OrderItemsModel
 
+	PresaleCampaigns orderdal.PresaleCampaignsModel
+	PresaleOrders    orderdal.PresaleOrdersModel
+
 	Inventory invsvc.InventoryService
 	Coupon    couponsvc.CouponService
 	Product   prodsvc.ProductService
+	Payment   paysvc.PaymentService
 
 	AsynqClient *asynq.Client
 
@@ -54,6 +59,10 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	if c.ProductRpc.Target != "" {
 		prodCli = prodsvc.NewProductService(zrpc.MustNewClient(c.ProductRpc))
 	}
+	var payCli paysvc.PaymentService
+	if c.PaymentRpc.Target != "" {
+		payCli = paysvc.NewPaymentService(zrpc.MustNewClient(c.PaymentRpc))
+	}
 	asynqClient := asynq.NewClient(asynq.RedisClientOpt{Addr: c.AsynqConf.Addr})
 
 	// Reusable Kafka writer to reduce per-send overhead and latency
@@ -76,20 +85,23 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	}
 
 	sc := &ServiceContext{
-		Config:          c,
-		DB:              db,
-		RawDB:           raw,
-		Preorder:        orderdal.NewOrderPreordersModel(db, c.CacheConf),
-		PreItm:          orderdal.NewOrderPreorderItemsModel(db, c.CacheConf),
-		Orders:          orderdal.NewOrdersModel(db, c.CacheConf),
-		OrdItm:          orderdal.NewOrderItemsModel(db, c.CacheConf),
-		Inventory:       invCli,
-		Coupon:          coupCli,
-		Product:         prodCli,
-		AsynqClient:     asynqClient,
-		CheckoutLimiter: limit.NewTokenLimiter(10, 50, c.RedisConf.NewRedis(), "order:preoder"),
-		KafkaWriter:     kw,
-		PreorderTTL:     ttl,
+		Config:           c,
+		DB:               db,
+		RawDB:            raw,
+		Preorder:         orderdal.NewOrderPreordersModel(db, c.CacheConf),
+		PreItm:           orderdal.NewOrderPreorderItemsModel(db, c.CacheConf),
+		Orders:           orderdal.NewOrdersModel(db, c.CacheConf),
+		OrdItm:           orderdal.NewOrderItemsModel(db, c.CacheConf),
+		PresaleCampaigns: orderdal.NewPresaleCampaignsModel(db, c.CacheConf),
+		PresaleOrders:    orderdal.NewPresaleOrdersModel(db, c.CacheConf),
+		Inventory:        invCli,
+		Coupon:           coupCli,
+		Product:          prodCli,
+		Payment:          payCli,
+		AsynqClient:      asynqClient,
+		CheckoutLimiter:  limit.NewTokenLimiter(10, 50, c.RedisConf.NewRedis(), "order:preoder"),
+		KafkaWriter:      kw,
+		PreorderTTL:      ttl,
 	}
 
 	return sc
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.3
-// 	protoc        v5.29.2
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.19.4
 // source: order.proto
 
 package order
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -20,16 +21,17 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// 订单状态
+// OrderStatus enumerates lifecycle states for an order.
 type OrderStatus int32
 
 const (
 	OrderStatus_ORDER_STATUS_UNKNOWN   OrderStatus = 0
-	OrderStatus_ORDER_STATUS_PENDING   OrderStatus = 1 // 预订单已生成，待支付
-	OrderStatus_ORDER_STATUS_CONFIRMED OrderStatus = 2 // 支付成功，待发货
-	OrderStatus_ORDER_STATUS_CANCELLED OrderStatus = 3 // 用户/系统取消或超时
-	OrderStatus_ORDER_STATUS_COMPLETED OrderStatus = 4 // 已履约完成
-	OrderStatus_ORDER_STATUS_PAYING    OrderStatus = 5 // 支付流程已启动，等待网关回调
+	OrderStatus_ORDER_STATUS_PENDING   OrderStatus = 1 // Preorder placed, waiting for payment.
+	OrderStatus_ORDER_STATUS_CONFIRMED OrderStatus = 2 // Payment succeeded.
+	OrderStatus_ORDER_STATUS_CANCELLED OrderStatus = 3 // Cancelled by user or system.
+	OrderStatus_ORDER_STATUS_COMPLETED OrderStatus = 4 // Fulfilled.
+	OrderStatus_ORDER_STATUS_PAYING    OrderStatus = 5 // Payment in-progress.
+	OrderStatus_ORDER_STATUS_REFUNDED  OrderStatus = 6 // Paid amount fully refunded.
 )
 
 // Enum value maps for OrderStatus.
@@ -41,6 +43,7 @@ var (
 		3: "ORDER_STATUS_CANCELLED",
 		4: "ORDER_STATUS_COMPLETED",
 		5: "ORDER_STATUS_PAYING",
+		6: "ORDER_STATUS_REFUNDED",
 	}
 	OrderStatus_value = map[string]int32{
 		"ORDER_STATUS_UNKNOWN":   0,
@@ -49,6 +52,7 @@ var (
 		"ORDER_STATUS_CANCELLED": 3,
 		"ORDER_STATUS_COMPLETED": 4,
 		"ORDER_STATUS_PAYING":    5,
+		"ORDER_STATUS_REFUNDED":  6,
 	}
 )
 
@@ -79,6 +83,74 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{0}
 }
 
+// PresaleStatus enumerates lifecycle states for a presale (deposit + balance) order.
+type PresaleStatus int32
+
+const (
+	PresaleStatus_PRESALE_STATUS_UNKNOWN           PresaleStatus = 0
+	PresaleStatus_PRESALE_STATUS_DEPOSIT_PENDING   PresaleStatus = 1 // Deposit order placed, waiting for payment.
+	PresaleStatus_PRESALE_STATUS_DEPOSIT_PAID      PresaleStatus = 2 // Deposit paid, waiting for balance window.
+	PresaleStatus_PRESALE_STATUS_BALANCE_PENDING   PresaleStatus = 3 // Balance order placed, waiting for payment.
+	PresaleStatus_PRESALE_STATUS_COMPLETED         PresaleStatus = 4 // Balance paid.
+	PresaleStatus_PRESALE_STATUS_CANCELLED         PresaleStatus = 5 // Cancelled before deposit was paid.
+	PresaleStatus_PRESALE_STATUS_DEPOSIT_FORFEITED PresaleStatus = 6 // Cancelled after deposit was paid, deposit kept.
+	PresaleStatus_PRESALE_STATUS_DEPOSIT_REFUNDING PresaleStatus = 7 // Cancelled after deposit was paid, deposit being refunded.
+	PresaleStatus_PRESALE_STATUS_DEPOSIT_REFUNDED  PresaleStatus = 8 // Deposit refunded.
+)
+
+// Enum value maps for PresaleStatus.
+var (
+	PresaleStatus_name = map[int32]string{
+		0: "PRESALE_STATUS_UNKNOWN",
+		1: "PRESALE_STATUS_DEPOSIT_PENDING",
+		2: "PRESALE_STATUS_DEPOSIT_PAID",
+		3: "PRESALE_STATUS_BALANCE_PENDING",
+		4: "PRESALE_STATUS_COMPLETED",
+		5: "PRESALE_STATUS_CANCELLED",
+		6: "PRESALE_STATUS_DEPOSIT_FORFEITED",
+		7: "PRESALE_STATUS_DEPOSIT_REFUNDING",
+		8: "PRESALE_STATUS_DEPOSIT_REFUNDED",
+	}
+	PresaleStatus_value = map[string]int32{
+		"PRESALE_STATUS_UNKNOWN":           0,
+		"PRESALE_STATUS_DEPOSIT_PENDING":   1,
+		"PRESALE_STATUS_DEPOSIT_PAID":      2,
+		"PRESALE_STATUS_BALANCE_PENDING":   3,
+		"PRESALE_STATUS_COMPLETED":         4,
+		"PRESALE_STATUS_CANCELLED":         5,
+		"PRESALE_STATUS_DEPOSIT_FORFEITED": 6,
+		"PRESALE_STATUS_DEPOSIT_REFUNDING": 7,
+		"PRESALE_STATUS_DEPOSIT_REFUNDED":  8,
+	}
+)
+
+func (x PresaleStatus) Enum() *PresaleStatus {
+	p := new(PresaleStatus)
+	*p = x
+	return p
+}
+
+func (x PresaleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresaleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[1].Descriptor()
+}
+
+func (PresaleStatus) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[1]
+}
+
+func (x PresaleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresaleStatus.Descriptor instead.
+func (PresaleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{1}
+}
+
 type OrderItemSnapshot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -140,13 +212,15 @@ func (x *OrderItemSnapshot) GetAttributes() string {
 }
 
 type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	PriceCents    int64                  `protobuf:"varint,3,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
-	Snapshot      *OrderItemSnapshot     `protobuf:"bytes,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity         int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PriceCents       int64                  `protobuf:"varint,3,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Snapshot         *OrderItemSnapshot     `protobuf:"bytes,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Amount           int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`                                             // 行金额(分，标价币种)，仅 ListCompletedOrders 返回；预售子订单为该笔订单金额
+	MerchantDiscount int64                  `protobuf:"varint,6,opt,name=merchant_discount,json=merchantDiscount,proto3" json:"merchant_discount,omitempty"` // 商家承担的优惠(分，标价币种)，商家促销与商家券按行金额分摊，仅 ListCompletedOrders 返回
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *OrderItem) Reset() {
@@ -207,6 +281,20 @@ func (x *OrderItem) GetSnapshot() *OrderItemSnapshot {
 	return nil
 }
 
+func (x *OrderItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OrderItem) GetMerchantDiscount() int64 {
+	if x != nil {
+		return x.MerchantDiscount
+	}
+	return 0
+}
+
 type Item struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -264,6 +352,7 @@ type CheckoutReq struct {
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
 	Item          *Item                  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // 支付币种，默认 CNY
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -319,19 +408,116 @@ func (x *CheckoutReq) GetItem() *Item {
 	return nil
 }
 
+func (x *CheckoutReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// 订单命中的促销活动
+type OrderPromotion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId    int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 如"满200减30"
+	DiscountAmount int64                  `protobuf:"varint,4,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	FundedBy       string                 `protobuf:"bytes,5,opt,name=funded_by,json=fundedBy,proto3" json:"funded_by,omitempty"` // PLATFORM / MERCHANT
+	MerchantId     int64                  `protobuf:"varint,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderPromotion) Reset() {
+	*x = OrderPromotion{}
+	mi := &file_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderPromotion) ProtoMessage() {}
+
+func (x *OrderPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderPromotion.ProtoReflect.Descriptor instead.
+func (*OrderPromotion) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderPromotion) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *OrderPromotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderPromotion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OrderPromotion) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *OrderPromotion) GetFundedBy() string {
+	if x != nil {
+		return x.FundedBy
+	}
+	return ""
+}
+
+func (x *OrderPromotion) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
 type CheckoutResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
-	PreorderId    int64                  `protobuf:"varint,3,opt,name=preorder_id,json=preorderId,proto3" json:"preorder_id,omitempty"`
-	ExpiredAt     int64                  `protobuf:"varint,4,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode      int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg       string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	PreorderId      int64                  `protobuf:"varint,3,opt,name=preorder_id,json=preorderId,proto3" json:"preorder_id,omitempty"`
+	ExpiredAt       int64                  `protobuf:"varint,4,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	OriginalAmount  int64                  `protobuf:"varint,5,opt,name=original_amount,json=originalAmount,proto3" json:"original_amount,omitempty"`    // 商品原价合计(分)
+	PromotionAmount int64                  `protobuf:"varint,6,opt,name=promotion_amount,json=promotionAmount,proto3" json:"promotion_amount,omitempty"` // 促销优惠(分)
+	CouponAmount    int64                  `protobuf:"varint,7,opt,name=coupon_amount,json=couponAmount,proto3" json:"coupon_amount,omitempty"`          // 优惠券优惠(分)
+	FinalAmount     int64                  `protobuf:"varint,8,opt,name=final_amount,json=finalAmount,proto3" json:"final_amount,omitempty"`             // 应付金额(分)
+	Promotions      []*OrderPromotion      `protobuf:"bytes,9,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CheckoutResp) Reset() {
 	*x = CheckoutResp{}
-	mi := &file_order_proto_msgTypes[4]
+	mi := &file_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +529,7 @@ func (x *CheckoutResp) String() string {
 func (*CheckoutResp) ProtoMessage() {}
 
 func (x *CheckoutResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[4]
+	mi := &file_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +542,7 @@ func (x *CheckoutResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckoutResp.ProtoReflect.Descriptor instead.
 func (*CheckoutResp) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{4}
+	return file_order_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CheckoutResp) GetStatusCode() int64 {
@@ -387,6 +573,41 @@ func (x *CheckoutResp) GetExpiredAt() int64 {
 	return 0
 }
 
+func (x *CheckoutResp) GetOriginalAmount() int64 {
+	if x != nil {
+		return x.OriginalAmount
+	}
+	return 0
+}
+
+func (x *CheckoutResp) GetPromotionAmount() int64 {
+	if x != nil {
+		return x.PromotionAmount
+	}
+	return 0
+}
+
+func (x *CheckoutResp) GetCouponAmount() int64 {
+	if x != nil {
+		return x.CouponAmount
+	}
+	return 0
+}
+
+func (x *CheckoutResp) GetFinalAmount() int64 {
+	if x != nil {
+		return x.FinalAmount
+	}
+	return 0
+}
+
+func (x *CheckoutResp) GetPromotions() []*OrderPromotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
 type PlaceOrderReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PreorderId    int64                  `protobuf:"varint,1,opt,name=preorder_id,json=preorderId,proto3" json:"preorder_id,omitempty"`
@@ -400,7 +621,7 @@ type PlaceOrderReq struct {
 
 func (x *PlaceOrderReq) Reset() {
 	*x = PlaceOrderReq{}
-	mi := &file_order_proto_msgTypes[5]
+	mi := &file_order_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -412,7 +633,7 @@ func (x *PlaceOrderReq) String() string {
 func (*PlaceOrderReq) ProtoMessage() {}
 
 func (x *PlaceOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[5]
+	mi := &file_order_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -425,7 +646,7 @@ func (x *PlaceOrderReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceOrderReq.ProtoReflect.Descriptor instead.
 func (*PlaceOrderReq) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{5}
+	return file_order_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PlaceOrderReq) GetPreorderId() int64 {
@@ -475,7 +696,7 @@ type PlaceOrderResp struct {
 
 func (x *PlaceOrderResp) Reset() {
 	*x = PlaceOrderResp{}
-	mi := &file_order_proto_msgTypes[6]
+	mi := &file_order_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -487,7 +708,7 @@ func (x *PlaceOrderResp) String() string {
 func (*PlaceOrderResp) ProtoMessage() {}
 
 func (x *PlaceOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[6]
+	mi := &file_order_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +721,7 @@ func (x *PlaceOrderResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceOrderResp.ProtoReflect.Descriptor instead.
 func (*PlaceOrderResp) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{6}
+	return file_order_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PlaceOrderResp) GetStatusCode() int64 {
@@ -543,7 +764,7 @@ type ConfirmPaymentReq struct {
 
 func (x *ConfirmPaymentReq) Reset() {
 	*x = ConfirmPaymentReq{}
-	mi := &file_order_proto_msgTypes[7]
+	mi := &file_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +776,7 @@ func (x *ConfirmPaymentReq) String() string {
 func (*ConfirmPaymentReq) ProtoMessage() {}
 
 func (x *ConfirmPaymentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[7]
+	mi := &file_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +789,7 @@ func (x *ConfirmPaymentReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmPaymentReq.ProtoReflect.Descriptor instead.
 func (*ConfirmPaymentReq) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{7}
+	return file_order_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ConfirmPaymentReq) GetOrderId() int64 {
@@ -610,7 +831,7 @@ type ConfirmPaymentResp struct {
 
 func (x *ConfirmPaymentResp) Reset() {
 	*x = ConfirmPaymentResp{}
-	mi := &file_order_proto_msgTypes[8]
+	mi := &file_order_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +843,7 @@ func (x *ConfirmPaymentResp) String() string {
 func (*ConfirmPaymentResp) ProtoMessage() {}
 
 func (x *ConfirmPaymentResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[8]
+	mi := &file_order_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +856,7 @@ func (x *ConfirmPaymentResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmPaymentResp.ProtoReflect.Descriptor instead.
 func (*ConfirmPaymentResp) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{8}
+	return file_order_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ConfirmPaymentResp) GetStatusCode() int64 {
@@ -669,7 +890,7 @@ type MarkPayingReq struct {
 
 func (x *MarkPayingReq) Reset() {
 	*x = MarkPayingReq{}
-	mi := &file_order_proto_msgTypes[9]
+	mi := &file_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -681,7 +902,7 @@ func (x *MarkPayingReq) String() string {
 func (*MarkPayingReq) ProtoMessage() {}
 
 func (x *MarkPayingReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[9]
+	mi := &file_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +915,7 @@ func (x *MarkPayingReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkPayingReq.ProtoReflect.Descriptor instead.
 func (*MarkPayingReq) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{9}
+	return file_order_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MarkPayingReq) GetOrderId() int64 {
@@ -722,7 +943,7 @@ type MarkPayingResp struct {
 
 func (x *MarkPayingResp) Reset() {
 	*x = MarkPayingResp{}
-	mi := &file_order_proto_msgTypes[10]
+	mi := &file_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -734,7 +955,7 @@ func (x *MarkPayingResp) String() string {
 func (*MarkPayingResp) ProtoMessage() {}
 
 func (x *MarkPayingResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[10]
+	mi := &file_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,7 +968,7 @@ func (x *MarkPayingResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkPayingResp.ProtoReflect.Descriptor instead.
 func (*MarkPayingResp) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{10}
+	return file_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MarkPayingResp) GetStatusCode() int64 {
@@ -783,7 +1004,7 @@ type CancelOrderReq struct {
 
 func (x *CancelOrderReq) Reset() {
 	*x = CancelOrderReq{}
-	mi := &file_order_proto_msgTypes[11]
+	mi := &file_order_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +1016,7 @@ func (x *CancelOrderReq) String() string {
 func (*CancelOrderReq) ProtoMessage() {}
 
 func (x *CancelOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[11]
+	mi := &file_order_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1029,7 @@ func (x *CancelOrderReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderReq.ProtoReflect.Descriptor instead.
 func (*CancelOrderReq) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{11}
+	return file_order_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CancelOrderReq) GetPreorderId() int64 {
@@ -850,7 +1071,7 @@ type CancelOrderResp struct {
 
 func (x *CancelOrderResp) Reset() {
 	*x = CancelOrderResp{}
-	mi := &file_order_proto_msgTypes[12]
+	mi := &file_order_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1083,7 @@ func (x *CancelOrderResp) String() string {
 func (*CancelOrderResp) ProtoMessage() {}
 
 func (x *CancelOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[12]
+	mi := &file_order_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1096,7 @@ func (x *CancelOrderResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderResp.ProtoReflect.Descriptor instead.
 func (*CancelOrderResp) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{12}
+	return file_order_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CancelOrderResp) GetStatusCode() int64 {
@@ -899,7 +1120,6 @@ func (x *CancelOrderResp) GetStatus() OrderStatus {
 	return OrderStatus_ORDER_STATUS_UNKNOWN
 }
 
-// 查询订单
 type GetOrderReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -910,7 +1130,7 @@ type GetOrderReq struct {
 
 func (x *GetOrderReq) Reset() {
 	*x = GetOrderReq{}
-	mi := &file_order_proto_msgTypes[13]
+	mi := &file_order_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -922,7 +1142,7 @@ func (x *GetOrderReq) String() string {
 func (*GetOrderReq) ProtoMessage() {}
 
 func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[13]
+	mi := &file_order_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,7 +1155,7 @@ func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderReq.ProtoReflect.Descriptor instead.
 func (*GetOrderReq) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{13}
+	return file_order_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetOrderReq) GetOrderId() int64 {
@@ -953,26 +1173,35 @@ func (x *GetOrderReq) GetUserId() int64 {
 }
 
 type OrderInfo struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OrderId         int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	PreorderId      int64                  `protobuf:"varint,2,opt,name=preorder_id,json=preorderId,proto3" json:"preorder_id,omitempty"`
-	UserId          int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status          OrderStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
-	TotalAmount     int64                  `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	PayAmount       int64                  `protobuf:"varint,6,opt,name=pay_amount,json=payAmount,proto3" json:"pay_amount,omitempty"`
-	CreatedAt       int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PaidAt          int64                  `protobuf:"varint,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
-	CancelledAt     int64                  `protobuf:"varint,9,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
-	PaymentMethod   string                 `protobuf:"bytes,11,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	AddressSnapshot string                 `protobuf:"bytes,12,opt,name=address_snapshot,json=addressSnapshot,proto3" json:"address_snapshot,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId                 int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PreorderId              int64                  `protobuf:"varint,2,opt,name=preorder_id,json=preorderId,proto3" json:"preorder_id,omitempty"`
+	UserId                  int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status                  OrderStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	TotalAmount             int64                  `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	PayAmount               int64                  `protobuf:"varint,6,opt,name=pay_amount,json=payAmount,proto3" json:"pay_amount,omitempty"`
+	CreatedAt               int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaidAt                  int64                  `protobuf:"varint,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	CancelledAt             int64                  `protobuf:"varint,9,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	Items                   []*OrderItem           `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
+	PaymentMethod           string                 `protobuf:"bytes,11,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	AddressSnapshot         string                 `protobuf:"bytes,12,opt,name=address_snapshot,json=addressSnapshot,proto3" json:"address_snapshot,omitempty"`
+	OrderType               string                 `protobuf:"bytes,13,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"` // NORMAL / PRESALE_DEPOSIT / PRESALE_BALANCE
+	CompletedAt             int64                  `protobuf:"varint,14,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Currency                string                 `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"`                                                                 // 支付币种，金额均以该币种计
+	PriceCurrency           string                 `protobuf:"bytes,16,opt,name=price_currency,json=priceCurrency,proto3" json:"price_currency,omitempty"`                                  // 商品标价币种
+	FxRate                  int64                  `protobuf:"varint,17,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`                                                      // 标价币种 → 支付币种汇率快照，放大 1e8 倍
+	PromotionAmount         int64                  `protobuf:"varint,18,opt,name=promotion_amount,json=promotionAmount,proto3" json:"promotion_amount,omitempty"`                           // 促销优惠(分)，不含优惠券
+	MerchantPromotionAmount int64                  `protobuf:"varint,19,opt,name=merchant_promotion_amount,json=merchantPromotionAmount,proto3" json:"merchant_promotion_amount,omitempty"` // 其中商家出资(分)
+	Promotions              []*OrderPromotion      `protobuf:"bytes,20,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	MerchantCouponAmount    int64                  `protobuf:"varint,21,opt,name=merchant_coupon_amount,json=merchantCouponAmount,proto3" json:"merchant_coupon_amount,omitempty"` // 商家券抵扣金额(分)，由出资商家承担
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *OrderInfo) Reset() {
 	*x = OrderInfo{}
-	mi := &file_order_proto_msgTypes[14]
+	mi := &file_order_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -984,7 +1213,7 @@ func (x *OrderInfo) String() string {
 func (*OrderInfo) ProtoMessage() {}
 
 func (x *OrderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[14]
+	mi := &file_order_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,7 +1226,7 @@ func (x *OrderInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderInfo.ProtoReflect.Descriptor instead.
 func (*OrderInfo) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{14}
+	return file_order_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OrderInfo) GetOrderId() int64 {
@@ -1084,6 +1313,69 @@ func (x *OrderInfo) GetAddressSnapshot() string {
 	return ""
 }
 
+func (x *OrderInfo) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetPriceCurrency() string {
+	if x != nil {
+		return x.PriceCurrency
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetFxRate() int64 {
+	if x != nil {
+		return x.FxRate
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetPromotionAmount() int64 {
+	if x != nil {
+		return x.PromotionAmount
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetMerchantPromotionAmount() int64 {
+	if x != nil {
+		return x.MerchantPromotionAmount
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetPromotions() []*OrderPromotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+func (x *OrderInfo) GetMerchantCouponAmount() int64 {
+	if x != nil {
+		return x.MerchantCouponAmount
+	}
+	return 0
+}
+
 type GetOrderResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -1095,7 +1387,7 @@ type GetOrderResp struct {
 
 func (x *GetOrderResp) Reset() {
 	*x = GetOrderResp{}
-	mi := &file_order_proto_msgTypes[15]
+	mi := &file_order_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1107,7 +1399,7 @@ func (x *GetOrderResp) String() string {
 func (*GetOrderResp) ProtoMessage() {}
 
 func (x *GetOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[15]
+	mi := &file_order_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +1412,7 @@ func (x *GetOrderResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderResp.ProtoReflect.Descriptor instead.
 func (*GetOrderResp) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{15}
+	return file_order_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetOrderResp) GetStatusCode() int64 {
@@ -1156,7 +1448,7 @@ type ListOrdersReq struct {
 
 func (x *ListOrdersReq) Reset() {
 	*x = ListOrdersReq{}
-	mi := &file_order_proto_msgTypes[16]
+	mi := &file_order_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1168,7 +1460,7 @@ func (x *ListOrdersReq) String() string {
 func (*ListOrdersReq) ProtoMessage() {}
 
 func (x *ListOrdersReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[16]
+	mi := &file_order_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1473,7 @@ func (x *ListOrdersReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersReq.ProtoReflect.Descriptor instead.
 func (*ListOrdersReq) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{16}
+	return file_order_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListOrdersReq) GetUserId() int64 {
@@ -1224,7 +1516,7 @@ type ListOrdersResp struct {
 
 func (x *ListOrdersResp) Reset() {
 	*x = ListOrdersResp{}
-	mi := &file_order_proto_msgTypes[17]
+	mi := &file_order_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1236,7 +1528,7 @@ func (x *ListOrdersResp) String() string {
 func (*ListOrdersResp) ProtoMessage() {}
 
 func (x *ListOrdersResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[17]
+	mi := &file_order_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +1541,7 @@ func (x *ListOrdersResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersResp.ProtoReflect.Descriptor instead.
 func (*ListOrdersResp) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{17}
+	return file_order_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListOrdersResp) GetStatusCode() int64 {
@@ -1280,275 +1572,2309 @@ func (x *ListOrdersResp) GetTotal() int64 {
 	return 0
 }
 
-var File_order_proto protoreflect.FileDescriptor
+// 确认收货：已支付订单置为 COMPLETED，之后进入商家结算
+type CompleteOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOrderReq) Reset() {
+	*x = CompleteOrderReq{}
+	mi := &file_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOrderReq) ProtoMessage() {}
+
+func (x *CompleteOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOrderReq.ProtoReflect.Descriptor instead.
+func (*CompleteOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CompleteOrderReq) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CompleteOrderReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CompleteOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOrderResp) Reset() {
+	*x = CompleteOrderResp{}
+	mi := &file_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOrderResp) ProtoMessage() {}
+
+func (x *CompleteOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOrderResp.ProtoReflect.Descriptor instead.
+func (*CompleteOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CompleteOrderResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CompleteOrderResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CompleteOrderResp) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNKNOWN
+}
+
+// 结算用：按确认收货时间 [completed_from, completed_to) 拉取已完成订单及明细，按 order_id 游标分页
+type ListCompletedOrdersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompletedFrom int64                  `protobuf:"varint,1,opt,name=completed_from,json=completedFrom,proto3" json:"completed_from,omitempty"`
+	CompletedTo   int64                  `protobuf:"varint,2,opt,name=completed_to,json=completedTo,proto3" json:"completed_to,omitempty"`
+	AfterOrderId  int64                  `protobuf:"varint,3,opt,name=after_order_id,json=afterOrderId,proto3" json:"after_order_id,omitempty"`
+	Limit         int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompletedOrdersReq) Reset() {
+	*x = ListCompletedOrdersReq{}
+	mi := &file_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompletedOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompletedOrdersReq) ProtoMessage() {}
+
+func (x *ListCompletedOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompletedOrdersReq.ProtoReflect.Descriptor instead.
+func (*ListCompletedOrdersReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListCompletedOrdersReq) GetCompletedFrom() int64 {
+	if x != nil {
+		return x.CompletedFrom
+	}
+	return 0
+}
+
+func (x *ListCompletedOrdersReq) GetCompletedTo() int64 {
+	if x != nil {
+		return x.CompletedTo
+	}
+	return 0
+}
+
+func (x *ListCompletedOrdersReq) GetAfterOrderId() int64 {
+	if x != nil {
+		return x.AfterOrderId
+	}
+	return 0
+}
+
+func (x *ListCompletedOrdersReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCompletedOrdersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Orders        []*OrderInfo           `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompletedOrdersResp) Reset() {
+	*x = ListCompletedOrdersResp{}
+	mi := &file_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompletedOrdersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompletedOrdersResp) ProtoMessage() {}
+
+func (x *ListCompletedOrdersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompletedOrdersResp.ProtoReflect.Descriptor instead.
+func (*ListCompletedOrdersResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCompletedOrdersResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListCompletedOrdersResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListCompletedOrdersResp) GetOrders() []*OrderInfo {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+// 券服务清理锁券用：批量查询预订单状态，不存在的预订单不返回
+type GetPreorderStatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreorderIds   []int64                `protobuf:"varint,1,rep,packed,name=preorder_ids,json=preorderIds,proto3" json:"preorder_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreorderStatesReq) Reset() {
+	*x = GetPreorderStatesReq{}
+	mi := &file_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreorderStatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreorderStatesReq) ProtoMessage() {}
+
+func (x *GetPreorderStatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreorderStatesReq.ProtoReflect.Descriptor instead.
+func (*GetPreorderStatesReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPreorderStatesReq) GetPreorderIds() []int64 {
+	if x != nil {
+		return x.PreorderIds
+	}
+	return nil
+}
+
+type PreorderState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreorderId    int64                  `protobuf:"varint,1,opt,name=preorder_id,json=preorderId,proto3" json:"preorder_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // PENDING / READY / PLACED / CANCELLED
+	ExpireAt      int64                  `protobuf:"varint,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreorderState) Reset() {
+	*x = PreorderState{}
+	mi := &file_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreorderState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreorderState) ProtoMessage() {}
+
+func (x *PreorderState) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreorderState.ProtoReflect.Descriptor instead.
+func (*PreorderState) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PreorderState) GetPreorderId() int64 {
+	if x != nil {
+		return x.PreorderId
+	}
+	return 0
+}
+
+func (x *PreorderState) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
 
-var file_order_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65,
-	0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x22, 0x9d, 0x01, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x22, 0x41, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x22, 0x64, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52,
-	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
-	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x70, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x19, 0x0a,
-	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73,
-	0x67, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43, 0x0a,
-	0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x19,
-	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x7c, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x79, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x7d, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x7d, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d,
-	0x73, 0x67, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x41,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a,
-	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0xa3, 0x03, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
-	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x70, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x76, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22,
-	0x85, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2a, 0xae, 0x01, 0x0a, 0x0b, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a,
-	0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
-	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44,
-	0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x50, 0x41, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x32, 0xae, 0x03, 0x0a, 0x0c,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x08,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x39, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x14, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18,
-	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x79, 0x69, 0x6e,
-	0x67, 0x12, 0x14, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61,
-	0x79, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
-	0x4d, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c,
-	0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x15, 0x2e,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x39, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x14, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x09, 0x5a, 0x07,
-	0x2e, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *PreorderState) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
 }
 
+type GetPreorderStatesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	States        []*PreorderState       `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreorderStatesResp) Reset() {
+	*x = GetPreorderStatesResp{}
+	mi := &file_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreorderStatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreorderStatesResp) ProtoMessage() {}
+
+func (x *GetPreorderStatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreorderStatesResp.ProtoReflect.Descriptor instead.
+func (*GetPreorderStatesResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPreorderStatesResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetPreorderStatesResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetPreorderStatesResp) GetStates() []*PreorderState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+// 券活动人群圈选用：按用户汇总已支付（未全额退款）订单数与 CNY 消费金额，无订单的用户不返回
+type GetUserOrderStatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderStatsReq) Reset() {
+	*x = GetUserOrderStatsReq{}
+	mi := &file_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderStatsReq) ProtoMessage() {}
+
+func (x *GetUserOrderStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderStatsReq.ProtoReflect.Descriptor instead.
+func (*GetUserOrderStatsReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetUserOrderStatsReq) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type UserOrderStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderCount    int64                  `protobuf:"varint,2,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	Spend         int64                  `protobuf:"varint,3,opt,name=spend,proto3" json:"spend,omitempty"` // 分，仅统计 CNY 订单
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOrderStats) Reset() {
+	*x = UserOrderStats{}
+	mi := &file_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrderStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrderStats) ProtoMessage() {}
+
+func (x *UserOrderStats) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrderStats.ProtoReflect.Descriptor instead.
+func (*UserOrderStats) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UserOrderStats) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserOrderStats) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *UserOrderStats) GetSpend() int64 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+type GetUserOrderStatsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Stats         []*UserOrderStats      `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderStatsResp) Reset() {
+	*x = GetUserOrderStatsResp{}
+	mi := &file_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderStatsResp) ProtoMessage() {}
+
+func (x *GetUserOrderStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderStatsResp.ProtoReflect.Descriptor instead.
+func (*GetUserOrderStatsResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUserOrderStatsResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetUserOrderStatsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetUserOrderStatsResp) GetStats() []*UserOrderStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 券效果统计用：汇总指定订单中已支付（未全额退款）部分的金额
+type SummarizeOrdersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderIds      []int64                `protobuf:"varint,1,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SummarizeOrdersReq) Reset() {
+	*x = SummarizeOrdersReq{}
+	mi := &file_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeOrdersReq) ProtoMessage() {}
+
+func (x *SummarizeOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeOrdersReq.ProtoReflect.Descriptor instead.
+func (*SummarizeOrdersReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SummarizeOrdersReq) GetOrderIds() []int64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type SummarizeOrdersResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode      int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg       string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	OrderCount      int64                  `protobuf:"varint,3,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	TotalAmount     int64                  `protobuf:"varint,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`             // 商品总金额(分)
+	PayableAmount   int64                  `protobuf:"varint,5,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`       // 应付金额(分)，即券带动的成交额
+	PromotionAmount int64                  `protobuf:"varint,6,opt,name=promotion_amount,json=promotionAmount,proto3" json:"promotion_amount,omitempty"` // 促销优惠(分)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SummarizeOrdersResp) Reset() {
+	*x = SummarizeOrdersResp{}
+	mi := &file_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeOrdersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeOrdersResp) ProtoMessage() {}
+
+func (x *SummarizeOrdersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeOrdersResp.ProtoReflect.Descriptor instead.
+func (*SummarizeOrdersResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SummarizeOrdersResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *SummarizeOrdersResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *SummarizeOrdersResp) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *SummarizeOrdersResp) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *SummarizeOrdersResp) GetPayableAmount() int64 {
+	if x != nil {
+		return x.PayableAmount
+	}
+	return 0
+}
+
+func (x *SummarizeOrdersResp) GetPromotionAmount() int64 {
+	if x != nil {
+		return x.PromotionAmount
+	}
+	return 0
+}
+
+type PresaleCampaign struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId        int64                  `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	MerchantId        int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ProductId         int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Title             string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	PresalePrice      int64                  `protobuf:"varint,5,opt,name=presale_price,json=presalePrice,proto3" json:"presale_price,omitempty"`
+	DepositAmount     int64                  `protobuf:"varint,6,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	DepositDeduction  int64                  `protobuf:"varint,7,opt,name=deposit_deduction,json=depositDeduction,proto3" json:"deposit_deduction,omitempty"`
+	TotalQuantity     int64                  `protobuf:"varint,8,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	ReservedQuantity  int64                  `protobuf:"varint,9,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	PerUserLimit      int64                  `protobuf:"varint,10,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	DepositStartAt    int64                  `protobuf:"varint,11,opt,name=deposit_start_at,json=depositStartAt,proto3" json:"deposit_start_at,omitempty"`
+	DepositEndAt      int64                  `protobuf:"varint,12,opt,name=deposit_end_at,json=depositEndAt,proto3" json:"deposit_end_at,omitempty"`
+	BalanceStartAt    int64                  `protobuf:"varint,13,opt,name=balance_start_at,json=balanceStartAt,proto3" json:"balance_start_at,omitempty"`
+	BalanceEndAt      int64                  `protobuf:"varint,14,opt,name=balance_end_at,json=balanceEndAt,proto3" json:"balance_end_at,omitempty"`
+	DepositRefundable bool                   `protobuf:"varint,15,opt,name=deposit_refundable,json=depositRefundable,proto3" json:"deposit_refundable,omitempty"`
+	Status            string                 `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PresaleCampaign) Reset() {
+	*x = PresaleCampaign{}
+	mi := &file_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresaleCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresaleCampaign) ProtoMessage() {}
+
+func (x *PresaleCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresaleCampaign.ProtoReflect.Descriptor instead.
+func (*PresaleCampaign) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PresaleCampaign) GetCampaignId() int64 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PresaleCampaign) GetPresalePrice() int64 {
+	if x != nil {
+		return x.PresalePrice
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetDepositAmount() int64 {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetDepositDeduction() int64 {
+	if x != nil {
+		return x.DepositDeduction
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetReservedQuantity() int64 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetPerUserLimit() int64 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetDepositStartAt() int64 {
+	if x != nil {
+		return x.DepositStartAt
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetDepositEndAt() int64 {
+	if x != nil {
+		return x.DepositEndAt
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetBalanceStartAt() int64 {
+	if x != nil {
+		return x.BalanceStartAt
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetBalanceEndAt() int64 {
+	if x != nil {
+		return x.BalanceEndAt
+	}
+	return 0
+}
+
+func (x *PresaleCampaign) GetDepositRefundable() bool {
+	if x != nil {
+		return x.DepositRefundable
+	}
+	return false
+}
+
+func (x *PresaleCampaign) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreatePresaleCampaignReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId        int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ProductId         int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Title             string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	PresalePrice      int64                  `protobuf:"varint,4,opt,name=presale_price,json=presalePrice,proto3" json:"presale_price,omitempty"`
+	DepositAmount     int64                  `protobuf:"varint,5,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	DepositDeduction  int64                  `protobuf:"varint,6,opt,name=deposit_deduction,json=depositDeduction,proto3" json:"deposit_deduction,omitempty"`
+	TotalQuantity     int64                  `protobuf:"varint,7,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	PerUserLimit      int64                  `protobuf:"varint,8,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	DepositStartAt    int64                  `protobuf:"varint,9,opt,name=deposit_start_at,json=depositStartAt,proto3" json:"deposit_start_at,omitempty"`
+	DepositEndAt      int64                  `protobuf:"varint,10,opt,name=deposit_end_at,json=depositEndAt,proto3" json:"deposit_end_at,omitempty"`
+	BalanceStartAt    int64                  `protobuf:"varint,11,opt,name=balance_start_at,json=balanceStartAt,proto3" json:"balance_start_at,omitempty"`
+	BalanceEndAt      int64                  `protobuf:"varint,12,opt,name=balance_end_at,json=balanceEndAt,proto3" json:"balance_end_at,omitempty"`
+	DepositRefundable bool                   `protobuf:"varint,13,opt,name=deposit_refundable,json=depositRefundable,proto3" json:"deposit_refundable,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreatePresaleCampaignReq) Reset() {
+	*x = CreatePresaleCampaignReq{}
+	mi := &file_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePresaleCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePresaleCampaignReq) ProtoMessage() {}
+
+func (x *CreatePresaleCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePresaleCampaignReq.ProtoReflect.Descriptor instead.
+func (*CreatePresaleCampaignReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreatePresaleCampaignReq) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePresaleCampaignReq) GetPresalePrice() int64 {
+	if x != nil {
+		return x.PresalePrice
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetDepositAmount() int64 {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetDepositDeduction() int64 {
+	if x != nil {
+		return x.DepositDeduction
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetPerUserLimit() int64 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetDepositStartAt() int64 {
+	if x != nil {
+		return x.DepositStartAt
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetDepositEndAt() int64 {
+	if x != nil {
+		return x.DepositEndAt
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetBalanceStartAt() int64 {
+	if x != nil {
+		return x.BalanceStartAt
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetBalanceEndAt() int64 {
+	if x != nil {
+		return x.BalanceEndAt
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignReq) GetDepositRefundable() bool {
+	if x != nil {
+		return x.DepositRefundable
+	}
+	return false
+}
+
+type CreatePresaleCampaignResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Campaign      *PresaleCampaign       `protobuf:"bytes,3,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePresaleCampaignResp) Reset() {
+	*x = CreatePresaleCampaignResp{}
+	mi := &file_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePresaleCampaignResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePresaleCampaignResp) ProtoMessage() {}
+
+func (x *CreatePresaleCampaignResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePresaleCampaignResp.ProtoReflect.Descriptor instead.
+func (*CreatePresaleCampaignResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreatePresaleCampaignResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CreatePresaleCampaignResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CreatePresaleCampaignResp) GetCampaign() *PresaleCampaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type CancelPresaleCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CampaignId    int64                  `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPresaleCampaignReq) Reset() {
+	*x = CancelPresaleCampaignReq{}
+	mi := &file_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPresaleCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPresaleCampaignReq) ProtoMessage() {}
+
+func (x *CancelPresaleCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPresaleCampaignReq.ProtoReflect.Descriptor instead.
+func (*CancelPresaleCampaignReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelPresaleCampaignReq) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CancelPresaleCampaignReq) GetCampaignId() int64 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+func (x *CancelPresaleCampaignReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelPresaleCampaignResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPresaleCampaignResp) Reset() {
+	*x = CancelPresaleCampaignResp{}
+	mi := &file_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPresaleCampaignResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPresaleCampaignResp) ProtoMessage() {}
+
+func (x *CancelPresaleCampaignResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPresaleCampaignResp.ProtoReflect.Descriptor instead.
+func (*CancelPresaleCampaignResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CancelPresaleCampaignResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CancelPresaleCampaignResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+type PlacePresaleDepositReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CampaignId    int64                  `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacePresaleDepositReq) Reset() {
+	*x = PlacePresaleDepositReq{}
+	mi := &file_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacePresaleDepositReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacePresaleDepositReq) ProtoMessage() {}
+
+func (x *PlacePresaleDepositReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacePresaleDepositReq.ProtoReflect.Descriptor instead.
+func (*PlacePresaleDepositReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PlacePresaleDepositReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlacePresaleDepositReq) GetCampaignId() int64 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+func (x *PlacePresaleDepositReq) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PlacePresaleDepositResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode     int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg      string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	PresaleId      int64                  `protobuf:"varint,3,opt,name=presale_id,json=presaleId,proto3" json:"presale_id,omitempty"`
+	DepositOrderId int64                  `protobuf:"varint,4,opt,name=deposit_order_id,json=depositOrderId,proto3" json:"deposit_order_id,omitempty"`
+	DepositAmount  int64                  `protobuf:"varint,5,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	ExpiredAt      int64                  `protobuf:"varint,6,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlacePresaleDepositResp) Reset() {
+	*x = PlacePresaleDepositResp{}
+	mi := &file_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacePresaleDepositResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacePresaleDepositResp) ProtoMessage() {}
+
+func (x *PlacePresaleDepositResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacePresaleDepositResp.ProtoReflect.Descriptor instead.
+func (*PlacePresaleDepositResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PlacePresaleDepositResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *PlacePresaleDepositResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *PlacePresaleDepositResp) GetPresaleId() int64 {
+	if x != nil {
+		return x.PresaleId
+	}
+	return 0
+}
+
+func (x *PlacePresaleDepositResp) GetDepositOrderId() int64 {
+	if x != nil {
+		return x.DepositOrderId
+	}
+	return 0
+}
+
+func (x *PlacePresaleDepositResp) GetDepositAmount() int64 {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return 0
+}
+
+func (x *PlacePresaleDepositResp) GetExpiredAt() int64 {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return 0
+}
+
+type PlacePresaleBalanceReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PresaleId     int64                  `protobuf:"varint,2,opt,name=presale_id,json=presaleId,proto3" json:"presale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacePresaleBalanceReq) Reset() {
+	*x = PlacePresaleBalanceReq{}
+	mi := &file_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacePresaleBalanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacePresaleBalanceReq) ProtoMessage() {}
+
+func (x *PlacePresaleBalanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacePresaleBalanceReq.ProtoReflect.Descriptor instead.
+func (*PlacePresaleBalanceReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PlacePresaleBalanceReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlacePresaleBalanceReq) GetPresaleId() int64 {
+	if x != nil {
+		return x.PresaleId
+	}
+	return 0
+}
+
+type PlacePresaleBalanceResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode     int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg      string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	BalanceOrderId int64                  `protobuf:"varint,3,opt,name=balance_order_id,json=balanceOrderId,proto3" json:"balance_order_id,omitempty"`
+	BalanceAmount  int64                  `protobuf:"varint,4,opt,name=balance_amount,json=balanceAmount,proto3" json:"balance_amount,omitempty"`
+	ExpiredAt      int64                  `protobuf:"varint,5,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlacePresaleBalanceResp) Reset() {
+	*x = PlacePresaleBalanceResp{}
+	mi := &file_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacePresaleBalanceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacePresaleBalanceResp) ProtoMessage() {}
+
+func (x *PlacePresaleBalanceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacePresaleBalanceResp.ProtoReflect.Descriptor instead.
+func (*PlacePresaleBalanceResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PlacePresaleBalanceResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *PlacePresaleBalanceResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *PlacePresaleBalanceResp) GetBalanceOrderId() int64 {
+	if x != nil {
+		return x.BalanceOrderId
+	}
+	return 0
+}
+
+func (x *PlacePresaleBalanceResp) GetBalanceAmount() int64 {
+	if x != nil {
+		return x.BalanceAmount
+	}
+	return 0
+}
+
+func (x *PlacePresaleBalanceResp) GetExpiredAt() int64 {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return 0
+}
+
+type CancelPresaleOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PresaleId     int64                  `protobuf:"varint,2,opt,name=presale_id,json=presaleId,proto3" json:"presale_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPresaleOrderReq) Reset() {
+	*x = CancelPresaleOrderReq{}
+	mi := &file_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPresaleOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPresaleOrderReq) ProtoMessage() {}
+
+func (x *CancelPresaleOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPresaleOrderReq.ProtoReflect.Descriptor instead.
+func (*CancelPresaleOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CancelPresaleOrderReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelPresaleOrderReq) GetPresaleId() int64 {
+	if x != nil {
+		return x.PresaleId
+	}
+	return 0
+}
+
+func (x *CancelPresaleOrderReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelPresaleOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Status        PresaleStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=order.PresaleStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPresaleOrderResp) Reset() {
+	*x = CancelPresaleOrderResp{}
+	mi := &file_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPresaleOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPresaleOrderResp) ProtoMessage() {}
+
+func (x *CancelPresaleOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPresaleOrderResp.ProtoReflect.Descriptor instead.
+func (*CancelPresaleOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelPresaleOrderResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CancelPresaleOrderResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CancelPresaleOrderResp) GetStatus() PresaleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresaleStatus_PRESALE_STATUS_UNKNOWN
+}
+
+type GetPresaleOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PresaleId     int64                  `protobuf:"varint,2,opt,name=presale_id,json=presaleId,proto3" json:"presale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresaleOrderReq) Reset() {
+	*x = GetPresaleOrderReq{}
+	mi := &file_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresaleOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresaleOrderReq) ProtoMessage() {}
+
+func (x *GetPresaleOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresaleOrderReq.ProtoReflect.Descriptor instead.
+func (*GetPresaleOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPresaleOrderReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPresaleOrderReq) GetPresaleId() int64 {
+	if x != nil {
+		return x.PresaleId
+	}
+	return 0
+}
+
+type PresaleOrderInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PresaleId      int64                  `protobuf:"varint,1,opt,name=presale_id,json=presaleId,proto3" json:"presale_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quantity       int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	DepositAmount  int64                  `protobuf:"varint,4,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	BalanceAmount  int64                  `protobuf:"varint,5,opt,name=balance_amount,json=balanceAmount,proto3" json:"balance_amount,omitempty"`
+	DepositOrderId int64                  `protobuf:"varint,6,opt,name=deposit_order_id,json=depositOrderId,proto3" json:"deposit_order_id,omitempty"`
+	BalanceOrderId int64                  `protobuf:"varint,7,opt,name=balance_order_id,json=balanceOrderId,proto3" json:"balance_order_id,omitempty"`
+	Status         PresaleStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=order.PresaleStatus" json:"status,omitempty"`
+	CancelReason   string                 `protobuf:"bytes,9,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Campaign       *PresaleCampaign       `protobuf:"bytes,11,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PresaleOrderInfo) Reset() {
+	*x = PresaleOrderInfo{}
+	mi := &file_order_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresaleOrderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresaleOrderInfo) ProtoMessage() {}
+
+func (x *PresaleOrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresaleOrderInfo.ProtoReflect.Descriptor instead.
+func (*PresaleOrderInfo) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PresaleOrderInfo) GetPresaleId() int64 {
+	if x != nil {
+		return x.PresaleId
+	}
+	return 0
+}
+
+func (x *PresaleOrderInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PresaleOrderInfo) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PresaleOrderInfo) GetDepositAmount() int64 {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return 0
+}
+
+func (x *PresaleOrderInfo) GetBalanceAmount() int64 {
+	if x != nil {
+		return x.BalanceAmount
+	}
+	return 0
+}
+
+func (x *PresaleOrderInfo) GetDepositOrderId() int64 {
+	if x != nil {
+		return x.DepositOrderId
+	}
+	return 0
+}
+
+func (x *PresaleOrderInfo) GetBalanceOrderId() int64 {
+	if x != nil {
+		return x.BalanceOrderId
+	}
+	return 0
+}
+
+func (x *PresaleOrderInfo) GetStatus() PresaleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresaleStatus_PRESALE_STATUS_UNKNOWN
+}
+
+func (x *PresaleOrderInfo) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *PresaleOrderInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PresaleOrderInfo) GetCampaign() *PresaleCampaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type GetPresaleOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Presale       *PresaleOrderInfo      `protobuf:"bytes,3,opt,name=presale,proto3" json:"presale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresaleOrderResp) Reset() {
+	*x = GetPresaleOrderResp{}
+	mi := &file_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresaleOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresaleOrderResp) ProtoMessage() {}
+
+func (x *GetPresaleOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresaleOrderResp.ProtoReflect.Descriptor instead.
+func (*GetPresaleOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPresaleOrderResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetPresaleOrderResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetPresaleOrderResp) GetPresale() *PresaleOrderInfo {
+	if x != nil {
+		return x.Presale
+	}
+	return nil
+}
+
+var File_order_proto protoreflect.FileDescriptor
+
+const file_order_proto_rawDesc = "" +
+	"\n" +
+	"\vorder.proto\x12\x05order\"j\n" +
+	"\x11OrderItemSnapshot\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1f\n" +
+	"\vcover_image\x18\x02 \x01(\tR\n" +
+	"coverImage\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x03 \x01(\tR\n" +
+	"attributes\"\xe2\x01\n" +
+	"\tOrderItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vprice_cents\x18\x03 \x01(\x03R\n" +
+	"priceCents\x124\n" +
+	"\bsnapshot\x18\x04 \x01(\v2\x18.order.OrderItemSnapshotR\bsnapshot\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12+\n" +
+	"\x11merchant_discount\x18\x06 \x01(\x03R\x10merchantDiscount\"A\n" +
+	"\x04Item\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\x80\x01\n" +
+	"\vCheckoutReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12\x1f\n" +
+	"\x04item\x18\x03 \x01(\v2\v.order.ItemR\x04item\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xd0\x01\n" +
+	"\x0eOrderPromotion\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\x0fdiscount_amount\x18\x04 \x01(\x03R\x0ediscountAmount\x12\x1b\n" +
+	"\tfunded_by\x18\x05 \x01(\tR\bfundedBy\x12\x1f\n" +
+	"\vmerchant_id\x18\x06 \x01(\x03R\n" +
+	"merchantId\"\xe1\x02\n" +
+	"\fCheckoutResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1f\n" +
+	"\vpreorder_id\x18\x03 \x01(\x03R\n" +
+	"preorderId\x12\x1d\n" +
+	"\n" +
+	"expired_at\x18\x04 \x01(\x03R\texpiredAt\x12'\n" +
+	"\x0foriginal_amount\x18\x05 \x01(\x03R\x0eoriginalAmount\x12)\n" +
+	"\x10promotion_amount\x18\x06 \x01(\x03R\x0fpromotionAmount\x12#\n" +
+	"\rcoupon_amount\x18\a \x01(\x03R\fcouponAmount\x12!\n" +
+	"\ffinal_amount\x18\b \x01(\x03R\vfinalAmount\x125\n" +
+	"\n" +
+	"promotions\x18\t \x03(\v2\x15.order.OrderPromotionR\n" +
+	"promotions\"\x9d\x01\n" +
+	"\rPlaceOrderReq\x12\x1f\n" +
+	"\vpreorder_id\x18\x01 \x01(\x03R\n" +
+	"preorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x03 \x01(\x03R\taddressId\x12\x1b\n" +
+	"\tcoupon_id\x18\x04 \x01(\x03R\bcouponId\x12\x16\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\"\x97\x01\n" +
+	"\x0ePlaceOrderResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x03R\aorderId\x12*\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x12.order.OrderStatusR\x06status\"\x93\x01\n" +
+	"\x11ConfirmPaymentReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12%\n" +
+	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12#\n" +
+	"\rpayment_token\x18\x04 \x01(\tR\fpaymentToken\"\x80\x01\n" +
+	"\x12ConfirmPaymentResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12*\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x12.order.OrderStatusR\x06status\"C\n" +
+	"\rMarkPayingReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"|\n" +
+	"\x0eMarkPayingResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12*\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x12.order.OrderStatusR\x06status\"}\n" +
+	"\x0eCancelOrderReq\x12\x1f\n" +
+	"\vpreorder_id\x18\x01 \x01(\x03R\n" +
+	"preorderId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\"}\n" +
+	"\x0fCancelOrderResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12*\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x12.order.OrderStatusR\x06status\"A\n" +
+	"\vGetOrderReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x95\x06\n" +
+	"\tOrderInfo\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vpreorder_id\x18\x02 \x01(\x03R\n" +
+	"preorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12*\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12!\n" +
+	"\ftotal_amount\x18\x05 \x01(\x03R\vtotalAmount\x12\x1d\n" +
+	"\n" +
+	"pay_amount\x18\x06 \x01(\x03R\tpayAmount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x17\n" +
+	"\apaid_at\x18\b \x01(\x03R\x06paidAt\x12!\n" +
+	"\fcancelled_at\x18\t \x01(\x03R\vcancelledAt\x12&\n" +
+	"\x05items\x18\n" +
+	" \x03(\v2\x10.order.OrderItemR\x05items\x12%\n" +
+	"\x0epayment_method\x18\v \x01(\tR\rpaymentMethod\x12)\n" +
+	"\x10address_snapshot\x18\f \x01(\tR\x0faddressSnapshot\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\r \x01(\tR\torderType\x12!\n" +
+	"\fcompleted_at\x18\x0e \x01(\x03R\vcompletedAt\x12\x1a\n" +
+	"\bcurrency\x18\x0f \x01(\tR\bcurrency\x12%\n" +
+	"\x0eprice_currency\x18\x10 \x01(\tR\rpriceCurrency\x12\x17\n" +
+	"\afx_rate\x18\x11 \x01(\x03R\x06fxRate\x12)\n" +
+	"\x10promotion_amount\x18\x12 \x01(\x03R\x0fpromotionAmount\x12:\n" +
+	"\x19merchant_promotion_amount\x18\x13 \x01(\x03R\x17merchantPromotionAmount\x125\n" +
+	"\n" +
+	"promotions\x18\x14 \x03(\v2\x15.order.OrderPromotionR\n" +
+	"promotions\x124\n" +
+	"\x16merchant_coupon_amount\x18\x15 \x01(\x03R\x14merchantCouponAmount\"v\n" +
+	"\fGetOrderResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12&\n" +
+	"\x05order\x18\x03 \x01(\v2\x10.order.OrderInfoR\x05order\"\x85\x01\n" +
+	"\rListOrdersReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12*\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x03R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x03R\bpageSize\"\x90\x01\n" +
+	"\x0eListOrdersResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12(\n" +
+	"\x06orders\x18\x03 \x03(\v2\x10.order.OrderInfoR\x06orders\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"F\n" +
+	"\x10CompleteOrderReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x7f\n" +
+	"\x11CompleteOrderResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12*\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x12.order.OrderStatusR\x06status\"\x9e\x01\n" +
+	"\x16ListCompletedOrdersReq\x12%\n" +
+	"\x0ecompleted_from\x18\x01 \x01(\x03R\rcompletedFrom\x12!\n" +
+	"\fcompleted_to\x18\x02 \x01(\x03R\vcompletedTo\x12$\n" +
+	"\x0eafter_order_id\x18\x03 \x01(\x03R\fafterOrderId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x03R\x05limit\"\x83\x01\n" +
+	"\x17ListCompletedOrdersResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12(\n" +
+	"\x06orders\x18\x03 \x03(\v2\x10.order.OrderInfoR\x06orders\"9\n" +
+	"\x14GetPreorderStatesReq\x12!\n" +
+	"\fpreorder_ids\x18\x01 \x03(\x03R\vpreorderIds\"e\n" +
+	"\rPreorderState\x12\x1f\n" +
+	"\vpreorder_id\x18\x01 \x01(\x03R\n" +
+	"preorderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\texpire_at\x18\x03 \x01(\x03R\bexpireAt\"\x85\x01\n" +
+	"\x15GetPreorderStatesResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12,\n" +
+	"\x06states\x18\x03 \x03(\v2\x14.order.PreorderStateR\x06states\"1\n" +
+	"\x14GetUserOrderStatsReq\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"`\n" +
+	"\x0eUserOrderStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vorder_count\x18\x02 \x01(\x03R\n" +
+	"orderCount\x12\x14\n" +
+	"\x05spend\x18\x03 \x01(\x03R\x05spend\"\x84\x01\n" +
+	"\x15GetUserOrderStatsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12+\n" +
+	"\x05stats\x18\x03 \x03(\v2\x15.order.UserOrderStatsR\x05stats\"1\n" +
+	"\x12SummarizeOrdersReq\x12\x1b\n" +
+	"\torder_ids\x18\x01 \x03(\x03R\borderIds\"\xeb\x01\n" +
+	"\x13SummarizeOrdersResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1f\n" +
+	"\vorder_count\x18\x03 \x01(\x03R\n" +
+	"orderCount\x12!\n" +
+	"\ftotal_amount\x18\x04 \x01(\x03R\vtotalAmount\x12%\n" +
+	"\x0epayable_amount\x18\x05 \x01(\x03R\rpayableAmount\x12)\n" +
+	"\x10promotion_amount\x18\x06 \x01(\x03R\x0fpromotionAmount\"\xe2\x04\n" +
+	"\x0fPresaleCampaign\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\x03R\n" +
+	"campaignId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12#\n" +
+	"\rpresale_price\x18\x05 \x01(\x03R\fpresalePrice\x12%\n" +
+	"\x0edeposit_amount\x18\x06 \x01(\x03R\rdepositAmount\x12+\n" +
+	"\x11deposit_deduction\x18\a \x01(\x03R\x10depositDeduction\x12%\n" +
+	"\x0etotal_quantity\x18\b \x01(\x03R\rtotalQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\t \x01(\x03R\x10reservedQuantity\x12$\n" +
+	"\x0eper_user_limit\x18\n" +
+	" \x01(\x03R\fperUserLimit\x12(\n" +
+	"\x10deposit_start_at\x18\v \x01(\x03R\x0edepositStartAt\x12$\n" +
+	"\x0edeposit_end_at\x18\f \x01(\x03R\fdepositEndAt\x12(\n" +
+	"\x10balance_start_at\x18\r \x01(\x03R\x0ebalanceStartAt\x12$\n" +
+	"\x0ebalance_end_at\x18\x0e \x01(\x03R\fbalanceEndAt\x12-\n" +
+	"\x12deposit_refundable\x18\x0f \x01(\bR\x11depositRefundable\x12\x16\n" +
+	"\x06status\x18\x10 \x01(\tR\x06status\"\x85\x04\n" +
+	"\x18CreatePresaleCampaignReq\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12#\n" +
+	"\rpresale_price\x18\x04 \x01(\x03R\fpresalePrice\x12%\n" +
+	"\x0edeposit_amount\x18\x05 \x01(\x03R\rdepositAmount\x12+\n" +
+	"\x11deposit_deduction\x18\x06 \x01(\x03R\x10depositDeduction\x12%\n" +
+	"\x0etotal_quantity\x18\a \x01(\x03R\rtotalQuantity\x12$\n" +
+	"\x0eper_user_limit\x18\b \x01(\x03R\fperUserLimit\x12(\n" +
+	"\x10deposit_start_at\x18\t \x01(\x03R\x0edepositStartAt\x12$\n" +
+	"\x0edeposit_end_at\x18\n" +
+	" \x01(\x03R\fdepositEndAt\x12(\n" +
+	"\x10balance_start_at\x18\v \x01(\x03R\x0ebalanceStartAt\x12$\n" +
+	"\x0ebalance_end_at\x18\f \x01(\x03R\fbalanceEndAt\x12-\n" +
+	"\x12deposit_refundable\x18\r \x01(\bR\x11depositRefundable\"\x8f\x01\n" +
+	"\x19CreatePresaleCampaignResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x122\n" +
+	"\bcampaign\x18\x03 \x01(\v2\x16.order.PresaleCampaignR\bcampaign\"t\n" +
+	"\x18CancelPresaleCampaignReq\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1f\n" +
+	"\vcampaign_id\x18\x02 \x01(\x03R\n" +
+	"campaignId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"[\n" +
+	"\x19CancelPresaleCampaignResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\"n\n" +
+	"\x16PlacePresaleDepositReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vcampaign_id\x18\x02 \x01(\x03R\n" +
+	"campaignId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\"\xe8\x01\n" +
+	"\x17PlacePresaleDepositResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x1d\n" +
+	"\n" +
+	"presale_id\x18\x03 \x01(\x03R\tpresaleId\x12(\n" +
+	"\x10deposit_order_id\x18\x04 \x01(\x03R\x0edepositOrderId\x12%\n" +
+	"\x0edeposit_amount\x18\x05 \x01(\x03R\rdepositAmount\x12\x1d\n" +
+	"\n" +
+	"expired_at\x18\x06 \x01(\x03R\texpiredAt\"P\n" +
+	"\x16PlacePresaleBalanceReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"presale_id\x18\x02 \x01(\x03R\tpresaleId\"\xc9\x01\n" +
+	"\x17PlacePresaleBalanceResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12(\n" +
+	"\x10balance_order_id\x18\x03 \x01(\x03R\x0ebalanceOrderId\x12%\n" +
+	"\x0ebalance_amount\x18\x04 \x01(\x03R\rbalanceAmount\x12\x1d\n" +
+	"\n" +
+	"expired_at\x18\x05 \x01(\x03R\texpiredAt\"g\n" +
+	"\x15CancelPresaleOrderReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"presale_id\x18\x02 \x01(\x03R\tpresaleId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x86\x01\n" +
+	"\x16CancelPresaleOrderResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12,\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x14.order.PresaleStatusR\x06status\"L\n" +
+	"\x12GetPresaleOrderReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"presale_id\x18\x02 \x01(\x03R\tpresaleId\"\xae\x03\n" +
+	"\x10PresaleOrderInfo\x12\x1d\n" +
+	"\n" +
+	"presale_id\x18\x01 \x01(\x03R\tpresaleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12%\n" +
+	"\x0edeposit_amount\x18\x04 \x01(\x03R\rdepositAmount\x12%\n" +
+	"\x0ebalance_amount\x18\x05 \x01(\x03R\rbalanceAmount\x12(\n" +
+	"\x10deposit_order_id\x18\x06 \x01(\x03R\x0edepositOrderId\x12(\n" +
+	"\x10balance_order_id\x18\a \x01(\x03R\x0ebalanceOrderId\x12,\n" +
+	"\x06status\x18\b \x01(\x0e2\x14.order.PresaleStatusR\x06status\x12#\n" +
+	"\rcancel_reason\x18\t \x01(\tR\fcancelReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x122\n" +
+	"\bcampaign\x18\v \x01(\v2\x16.order.PresaleCampaignR\bcampaign\"\x88\x01\n" +
+	"\x13GetPresaleOrderResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x121\n" +
+	"\apresale\x18\x03 \x01(\v2\x17.order.PresaleOrderInfoR\apresale*\xc9\x01\n" +
+	"\vOrderStatus\x12\x18\n" +
+	"\x14ORDER_STATUS_UNKNOWN\x10\x00\x12\x18\n" +
+	"\x14ORDER_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16ORDER_STATUS_CONFIRMED\x10\x02\x12\x1a\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x03\x12\x1a\n" +
+	"\x16ORDER_STATUS_COMPLETED\x10\x04\x12\x17\n" +
+	"\x13ORDER_STATUS_PAYING\x10\x05\x12\x19\n" +
+	"\x15ORDER_STATUS_REFUNDED\x10\x06*\xc1\x02\n" +
+	"\rPresaleStatus\x12\x1a\n" +
+	"\x16PRESALE_STATUS_UNKNOWN\x10\x00\x12\"\n" +
+	"\x1ePRESALE_STATUS_DEPOSIT_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bPRESALE_STATUS_DEPOSIT_PAID\x10\x02\x12\"\n" +
+	"\x1ePRESALE_STATUS_BALANCE_PENDING\x10\x03\x12\x1c\n" +
+	"\x18PRESALE_STATUS_COMPLETED\x10\x04\x12\x1c\n" +
+	"\x18PRESALE_STATUS_CANCELLED\x10\x05\x12$\n" +
+	" PRESALE_STATUS_DEPOSIT_FORFEITED\x10\x06\x12$\n" +
+	" PRESALE_STATUS_DEPOSIT_REFUNDING\x10\a\x12#\n" +
+	"\x1fPRESALE_STATUS_DEPOSIT_REFUNDED\x10\b2\xb3\n" +
+	"\n" +
+	"\fOrderService\x123\n" +
+	"\bCheckout\x12\x12.order.CheckoutReq\x1a\x13.order.CheckoutResp\x129\n" +
+	"\n" +
+	"PlaceOrder\x12\x14.order.PlaceOrderReq\x1a\x15.order.PlaceOrderResp\x12E\n" +
+	"\x0eConfirmPayment\x12\x18.order.ConfirmPaymentReq\x1a\x19.order.ConfirmPaymentResp\x129\n" +
+	"\n" +
+	"MarkPaying\x12\x14.order.MarkPayingReq\x1a\x15.order.MarkPayingResp\x12<\n" +
+	"\vCancelOrder\x12\x15.order.CancelOrderReq\x1a\x16.order.CancelOrderResp\x123\n" +
+	"\bGetOrder\x12\x12.order.GetOrderReq\x1a\x13.order.GetOrderResp\x129\n" +
+	"\n" +
+	"ListOrders\x12\x14.order.ListOrdersReq\x1a\x15.order.ListOrdersResp\x12B\n" +
+	"\rCompleteOrder\x12\x17.order.CompleteOrderReq\x1a\x18.order.CompleteOrderResp\x12T\n" +
+	"\x13ListCompletedOrders\x12\x1d.order.ListCompletedOrdersReq\x1a\x1e.order.ListCompletedOrdersResp\x12N\n" +
+	"\x11GetPreorderStates\x12\x1b.order.GetPreorderStatesReq\x1a\x1c.order.GetPreorderStatesResp\x12N\n" +
+	"\x11GetUserOrderStats\x12\x1b.order.GetUserOrderStatsReq\x1a\x1c.order.GetUserOrderStatsResp\x12H\n" +
+	"\x0fSummarizeOrders\x12\x19.order.SummarizeOrdersReq\x1a\x1a.order.SummarizeOrdersResp\x12Z\n" +
+	"\x15CreatePresaleCampaign\x12\x1f.order.CreatePresaleCampaignReq\x1a .order.CreatePresaleCampaignResp\x12Z\n" +
+	"\x15CancelPresaleCampaign\x12\x1f.order.CancelPresaleCampaignReq\x1a .order.CancelPresaleCampaignResp\x12T\n" +
+	"\x13PlacePresaleDeposit\x12\x1d.order.PlacePresaleDepositReq\x1a\x1e.order.PlacePresaleDepositResp\x12T\n" +
+	"\x13PlacePresaleBalance\x12\x1d.order.PlacePresaleBalanceReq\x1a\x1e.order.PlacePresaleBalanceResp\x12Q\n" +
+	"\x12CancelPresaleOrder\x12\x1c.order.CancelPresaleOrderReq\x1a\x1d.order.CancelPresaleOrderResp\x12H\n" +
+	"\x0fGetPresaleOrder\x12\x19.order.GetPresaleOrderReq\x1a\x1a.order.GetPresaleOrderRespB\tZ\a./orderb\x06proto3"
+
 var (
 	file_order_proto_rawDescOnce sync.Once
-	file_order_proto_rawDescData = file_order_proto_rawDesc
+	file_order_proto_rawDescData []byte
 )
 
 func file_order_proto_rawDescGZIP() []byte {
 	file_order_proto_rawDescOnce.Do(func() {
-		file_order_proto_rawDescData = protoimpl.X.CompressGZIP(file_order_proto_rawDescData)
+		file_order_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)))
 	})
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_order_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_order_proto_goTypes = []any{
-	(OrderStatus)(0),           // 0: order.OrderStatus
-	(*OrderItemSnapshot)(nil),  // 1: order.OrderItemSnapshot
-	(*OrderItem)(nil),          // 2: order.OrderItem
-	(*Item)(nil),               // 3: order.Item
-	(*CheckoutReq)(nil),        // 4: order.CheckoutReq
-	(*CheckoutResp)(nil),       // 5: order.CheckoutResp
-	(*PlaceOrderReq)(nil),      // 6: order.PlaceOrderReq
-	(*PlaceOrderResp)(nil),     // 7: order.PlaceOrderResp
-	(*ConfirmPaymentReq)(nil),  // 8: order.ConfirmPaymentReq
-	(*ConfirmPaymentResp)(nil), // 9: order.ConfirmPaymentResp
-	(*MarkPayingReq)(nil),      // 10: order.MarkPayingReq
-	(*MarkPayingResp)(nil),     // 11: order.MarkPayingResp
-	(*CancelOrderReq)(nil),     // 12: order.CancelOrderReq
-	(*CancelOrderResp)(nil),    // 13: order.CancelOrderResp
-	(*GetOrderReq)(nil),        // 14: order.GetOrderReq
-	(*OrderInfo)(nil),          // 15: order.OrderInfo
-	(*GetOrderResp)(nil),       // 16: order.GetOrderResp
-	(*ListOrdersReq)(nil),      // 17: order.ListOrdersReq
-	(*ListOrdersResp)(nil),     // 18: order.ListOrdersResp
+	(OrderStatus)(0),                  // 0: order.OrderStatus
+	(PresaleStatus)(0),                // 1: order.PresaleStatus
+	(*OrderItemSnapshot)(nil),         // 2: order.OrderItemSnapshot
+	(*OrderItem)(nil),                 // 3: order.OrderItem
+	(*Item)(nil),                      // 4: order.Item
+	(*CheckoutReq)(nil),               // 5: order.CheckoutReq
+	(*OrderPromotion)(nil),            // 6: order.OrderPromotion
+	(*CheckoutResp)(nil),              // 7: order.CheckoutResp
+	(*PlaceOrderReq)(nil),             // 8: order.PlaceOrderReq
+	(*PlaceOrderResp)(nil),            // 9: order.PlaceOrderResp
+	(*ConfirmPaymentReq)(nil),         // 10: order.ConfirmPaymentReq
+	(*ConfirmPaymentResp)(nil),        // 11: order.ConfirmPaymentResp
+	(*MarkPayingReq)(nil),             // 12: order.MarkPayingReq
+	(*MarkPayingResp)(nil),            // 13: order.MarkPayingResp
+	(*CancelOrderReq)(nil),            // 14: order.CancelOrderReq
+	(*CancelOrderResp)(nil),           // 15: order.CancelOrderResp
+	(*GetOrderReq)(nil),               // 16: order.GetOrderReq
+	(*OrderInfo)(nil),                 // 17: order.OrderInfo
+	(*GetOrderResp)(nil),              // 18: order.GetOrderResp
+	(*ListOrdersReq)(nil),             // 19: order.ListOrdersReq
+	(*ListOrdersResp)(nil),            // 20: order.ListOrdersResp
+	(*CompleteOrderReq)(nil),          // 21: order.CompleteOrderReq
+	(*CompleteOrderResp)(nil),         // 22: order.CompleteOrderResp
+	(*ListCompletedOrdersReq)(nil),    // 23: order.ListCompletedOrdersReq
+	(*ListCompletedOrdersResp)(nil),   // 24: order.ListCompletedOrdersResp
+	(*GetPreorderStatesReq)(nil),      // 25: order.GetPreorderStatesReq
+	(*PreorderState)(nil),             // 26: order.PreorderState
+	(*GetPreorderStatesResp)(nil),     // 27: order.GetPreorderStatesResp
+	(*GetUserOrderStatsReq)(nil),      // 28: order.GetUserOrderStatsReq
+	(*UserOrderStats)(nil),            // 29: order.UserOrderStats
+	(*GetUserOrderStatsResp)(nil),     // 30: order.GetUserOrderStatsResp
+	(*SummarizeOrdersReq)(nil),        // 31: order.SummarizeOrdersReq
+	(*SummarizeOrdersResp)(nil),       // 32: order.SummarizeOrdersResp
+	(*PresaleCampaign)(nil),           // 33: order.PresaleCampaign
+	(*CreatePresaleCampaignReq)(nil),  // 34: order.CreatePresaleCampaignReq
+	(*CreatePresaleCampaignResp)(nil), // 35: order.CreatePresaleCampaignResp
+	(*CancelPresaleCampaignReq)(nil),  // 36: order.CancelPresaleCampaignReq
+	(*CancelPresaleCampaignResp)(nil), // 37: order.CancelPresaleCampaignResp
+	(*PlacePresaleDepositReq)(nil),    // 38: order.PlacePresaleDepositReq
+	(*PlacePresaleDepositResp)(nil),   // 39: order.PlacePresaleDepositResp
+	(*PlacePresaleBalanceReq)(nil),    // 40: order.PlacePresaleBalanceReq
+	(*PlacePresaleBalanceResp)(nil),   // 41: order.PlacePresaleBalanceResp
+	(*CancelPresaleOrderReq)(nil),     // 42: order.CancelPresaleOrderReq
+	(*CancelPresaleOrderResp)(nil),    // 43: order.CancelPresaleOrderResp
+	(*GetPresaleOrderReq)(nil),        // 44: order.GetPresaleOrderReq
+	(*PresaleOrderInfo)(nil),          // 45: order.PresaleOrderInfo
+	(*GetPresaleOrderResp)(nil),       // 46: order.GetPresaleOrderResp
 }
 var file_order_proto_depIdxs = []int32{
-	1,  // 0: order.OrderItem.snapshot:type_name -> order.OrderItemSnapshot
-	3,  // 1: order.CheckoutReq.item:type_name -> order.Item
-	0,  // 2: order.PlaceOrderResp.status:type_name -> order.OrderStatus
-	0,  // 3: order.ConfirmPaymentResp.status:type_name -> order.OrderStatus
-	0,  // 4: order.MarkPayingResp.status:type_name -> order.OrderStatus
-	0,  // 5: order.CancelOrderResp.status:type_name -> order.OrderStatus
-	0,  // 6: order.OrderInfo.status:type_name -> order.OrderStatus
-	2,  // 7: order.OrderInfo.items:type_name -> order.OrderItem
-	15, // 8: order.GetOrderResp.order:type_name -> order.OrderInfo
-	0,  // 9: order.ListOrdersReq.status:type_name -> order.OrderStatus
-	15, // 10: order.ListOrdersResp.orders:type_name -> order.OrderInfo
-	4,  // 11: order.OrderService.Checkout:input_type -> order.CheckoutReq
-	6,  // 12: order.OrderService.PlaceOrder:input_type -> order.PlaceOrderReq
-	8,  // 13: order.OrderService.ConfirmPayment:input_type -> order.ConfirmPaymentReq
-	10, // 14: order.OrderService.MarkPaying:input_type -> order.MarkPayingReq
-	12, // 15: order.OrderService.CancelOrder:input_type -> order.CancelOrderReq
-	14, // 16: order.OrderService.GetOrder:input_type -> order.GetOrderReq
-	17, // 17: order.OrderService.ListOrders:input_type -> order.ListOrdersReq
-	5,  // 18: order.OrderService.Checkout:output_type -> order.CheckoutResp
-	7,  // 19: order.OrderService.PlaceOrder:output_type -> order.PlaceOrderResp
-	9,  // 20: order.OrderService.ConfirmPayment:output_type -> order.ConfirmPaymentResp
-	11, // 21: order.OrderService.MarkPaying:output_type -> order.MarkPayingResp
-	13, // 22: order.OrderService.CancelOrder:output_type -> order.CancelOrderResp
-	16, // 23: order.OrderService.GetOrder:output_type -> order.GetOrderResp
-	18, // 24: order.OrderService.ListOrders:output_type -> order.ListOrdersResp
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	2,  // 0: order.OrderItem.snapshot:type_name -> order.OrderItemSnapshot
+	4,  // 1: order.CheckoutReq.item:type_name -> order.Item
+	6,  // 2: order.CheckoutResp.promotions:type_name -> order.OrderPromotion
+	0,  // 3: order.PlaceOrderResp.status:type_name -> order.OrderStatus
+	0,  // 4: order.ConfirmPaymentResp.status:type_name -> order.OrderStatus
+	0,  // 5: order.MarkPayingResp.status:type_name -> order.OrderStatus
+	0,  // 6: order.CancelOrderResp.status:type_name -> order.OrderStatus
+	0,  // 7: order.OrderInfo.status:type_name -> order.OrderStatus
+	3,  // 8: order.OrderInfo.items:type_name -> order.OrderItem
+	6,  // 9: order.OrderInfo.promotions:type_name -> order.OrderPromotion
+	17, // 10: order.GetOrderResp.order:type_name -> order.OrderInfo
+	0,  // 11: order.ListOrdersReq.status:type_name -> order.OrderStatus
+	17, // 12: order.ListOrdersResp.orders:type_name -> order.OrderInfo
+	0,  // 13: order.CompleteOrderResp.status:type_name -> order.OrderStatus
+	17, // 14: order.ListCompletedOrdersResp.orders:type_name -> order.OrderInfo
+	26, // 15: order.GetPreorderStatesResp.states:type_name -> order.PreorderState
+	29, // 16: order.GetUserOrderStatsResp.stats:type_name -> order.UserOrderStats
+	33, // 17: order.CreatePresaleCampaignResp.campaign:type_name -> order.PresaleCampaign
+	1,  // 18: order.CancelPresaleOrderResp.status:type_name -> order.PresaleStatus
+	1,  // 19: order.PresaleOrderInfo.status:type_name -> order.PresaleStatus
+	33, // 20: order.PresaleOrderInfo.campaign:type_name -> order.PresaleCampaign
+	45, // 21: order.GetPresaleOrderResp.presale:type_name -> order.PresaleOrderInfo
+	5,  // 22: order.OrderService.Checkout:input_type -> order.CheckoutReq
+	8,  // 23: order.OrderService.PlaceOrder:input_type -> order.PlaceOrderReq
+	10, // 24: order.OrderService.ConfirmPayment:input_type -> order.ConfirmPaymentReq
+	12, // 25: order.OrderService.MarkPaying:input_type -> order.MarkPayingReq
+	14, // 26: order.OrderService.CancelOrder:input_type -> order.CancelOrderReq
+	16, // 27: order.OrderService.GetOrder:input_type -> order.GetOrderReq
+	19, // 28: order.OrderService.ListOrders:input_type -> order.ListOrdersReq
+	21, // 29: order.OrderService.CompleteOrder:input_type -> order.CompleteOrderReq
+	23, // 30: order.OrderService.ListCompletedOrders:input_type -> order.ListCompletedOrdersReq
+	25, // 31: order.OrderService.GetPreorderStates:input_type -> order.GetPreorderStatesReq
+	28, // 32: order.OrderService.GetUserOrderStats:input_type -> order.GetUserOrderStatsReq
+	31, // 33: order.OrderService.SummarizeOrders:input_type -> order.SummarizeOrdersReq
+	34, // 34: order.OrderService.CreatePresaleCampaign:input_type -> order.CreatePresaleCampaignReq
+	36, // 35: order.OrderService.CancelPresaleCampaign:input_type -> order.CancelPresaleCampaignReq
+	38, // 36: order.OrderService.PlacePresaleDeposit:input_type -> order.PlacePresaleDepositReq
+	40, // 37: order.OrderService.PlacePresaleBalance:input_type -> order.PlacePresaleBalanceReq
+	42, // 38: order.OrderService.CancelPresaleOrder:input_type -> order.CancelPresaleOrderReq
+	44, // 39: order.OrderService.GetPresaleOrder:input_type -> order.GetPresaleOrderReq
+	7,  // 40: order.OrderService.Checkout:output_type -> order.CheckoutResp
+	9,  // 41: order.OrderService.PlaceOrder:output_type -> order.PlaceOrderResp
+	11, // 42: order.OrderService.ConfirmPayment:output_type -> order.ConfirmPaymentResp
+	13, // 43: order.OrderService.MarkPaying:output_type -> order.MarkPayingResp
+	15, // 44: order.OrderService.CancelOrder:output_type -> order.CancelOrderResp
+	18, // 45: order.OrderService.GetOrder:output_type -> order.GetOrderResp
+	20, // 46: order.OrderService.ListOrders:output_type -> order.ListOrdersResp
+	22, // 47: order.OrderService.CompleteOrder:output_type -> order.CompleteOrderResp
+	24, // 48: order.OrderService.ListCompletedOrders:output_type -> order.ListCompletedOrdersResp
+	27, // 49: order.OrderService.GetPreorderStates:output_type -> order.GetPreorderStatesResp
+	30, // 50: order.OrderService.GetUserOrderStats:output_type -> order.GetUserOrderStatsResp
+	32, // 51: order.OrderService.SummarizeOrders:output_type -> order.SummarizeOrdersResp
+	35, // 52: order.OrderService.CreatePresaleCampaign:output_type -> order.CreatePresaleCampaignResp
+	37, // 53: order.OrderService.CancelPresaleCampaign:output_type -> order.CancelPresaleCampaignResp
+	39, // 54: order.OrderService.PlacePresaleDeposit:output_type -> order.PlacePresaleDepositResp
+	41, // 55: order.OrderService.PlacePresaleBalance:output_type -> order.PlacePresaleBalanceResp
+	43, // 56: order.OrderService.CancelPresaleOrder:output_type -> order.CancelPresaleOrderResp
+	46, // 57: order.OrderService.GetPresaleOrder:output_type -> order.GetPresaleOrderResp
+	40, // [40:58] is the sub-list for method output_type
+	22, // [22:40] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -1560,9 +3886,9 @@ func file_order_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_order_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   18,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1572,7 +3898,6 @@ func file_order_proto_init() {
 		MessageInfos:      file_order_proto_msgTypes,
 	}.Build()
 	File_order_proto = out.File
-	file_order_proto_rawDesc = nil
 	file_order_proto_goTypes = nil
 	file_order_proto_depIdxs = nil
 }
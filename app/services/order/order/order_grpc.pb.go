@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v5.29.2
+// - protoc             v3.19.4
 // source: order.proto
 
 package order
@@ -19,33 +19,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_Checkout_FullMethodName       = "/order.OrderService/Checkout"
-	OrderService_PlaceOrder_FullMethodName     = "/order.OrderService/PlaceOrder"
-	OrderService_ConfirmPayment_FullMethodName = "/order.OrderService/ConfirmPayment"
-	OrderService_MarkPaying_FullMethodName     = "/order.OrderService/MarkPaying"
-	OrderService_CancelOrder_FullMethodName    = "/order.OrderService/CancelOrder"
-	OrderService_GetOrder_FullMethodName       = "/order.OrderService/GetOrder"
-	OrderService_ListOrders_FullMethodName     = "/order.OrderService/ListOrders"
+	OrderService_Checkout_FullMethodName              = "/order.OrderService/Checkout"
+	OrderService_PlaceOrder_FullMethodName            = "/order.OrderService/PlaceOrder"
+	OrderService_ConfirmPayment_FullMethodName        = "/order.OrderService/ConfirmPayment"
+	OrderService_MarkPaying_FullMethodName            = "/order.OrderService/MarkPaying"
+	OrderService_CancelOrder_FullMethodName           = "/order.OrderService/CancelOrder"
+	OrderService_GetOrder_FullMethodName              = "/order.OrderService/GetOrder"
+	OrderService_ListOrders_FullMethodName            = "/order.OrderService/ListOrders"
+	OrderService_CompleteOrder_FullMethodName         = "/order.OrderService/CompleteOrder"
+	OrderService_ListCompletedOrders_FullMethodName   = "/order.OrderService/ListCompletedOrders"
+	OrderService_GetPreorderStates_FullMethodName     = "/order.OrderService/GetPreorderStates"
+	OrderService_GetUserOrderStats_FullMethodName     = "/order.OrderService/GetUserOrderStats"
+	OrderService_SummarizeOrders_FullMethodName       = "/order.OrderService/SummarizeOrders"
+	OrderService_CreatePresaleCampaign_FullMethodName = "/order.OrderService/CreatePresaleCampaign"
+	OrderService_CancelPresaleCampaign_FullMethodName = "/order.OrderService/CancelPresaleCampaign"
+	OrderService_PlacePresaleDeposit_FullMethodName   = "/order.OrderService/PlacePresaleDeposit"
+	OrderService_PlacePresaleBalance_FullMethodName   = "/order.OrderService/PlacePresaleBalance"
+	OrderService_CancelPresaleOrder_FullMethodName    = "/order.OrderService/CancelPresaleOrder"
+	OrderService_GetPresaleOrder_FullMethodName       = "/order.OrderService/GetPresaleOrder"
 )
 
 // OrderServiceClient is the client API for OrderService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderServiceClient interface {
-	// Checkout（结账，预订单）
 	Checkout(ctx context.Context, in *CheckoutReq, opts ...grpc.CallOption) (*CheckoutResp, error)
-	// 提交订单（生成正式订单）
 	PlaceOrder(ctx context.Context, in *PlaceOrderReq, opts ...grpc.CallOption) (*PlaceOrderResp, error)
-	// 支付确认（支付成功）
 	ConfirmPayment(ctx context.Context, in *ConfirmPaymentReq, opts ...grpc.CallOption) (*ConfirmPaymentResp, error)
-	// 将订单标记为支付中并启动支付超时逻辑
 	MarkPaying(ctx context.Context, in *MarkPayingReq, opts ...grpc.CallOption) (*MarkPayingResp, error)
-	// 主动取消或超时取消订单
 	CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderResp, error)
-	// 查询单个订单
 	GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderResp, error)
-	// 分页查询订单
 	ListOrders(ctx context.Context, in *ListOrdersReq, opts ...grpc.CallOption) (*ListOrdersResp, error)
+	CompleteOrder(ctx context.Context, in *CompleteOrderReq, opts ...grpc.CallOption) (*CompleteOrderResp, error)
+	ListCompletedOrders(ctx context.Context, in *ListCompletedOrdersReq, opts ...grpc.CallOption) (*ListCompletedOrdersResp, error)
+	GetPreorderStates(ctx context.Context, in *GetPreorderStatesReq, opts ...grpc.CallOption) (*GetPreorderStatesResp, error)
+	GetUserOrderStats(ctx context.Context, in *GetUserOrderStatsReq, opts ...grpc.CallOption) (*GetUserOrderStatsResp, error)
+	SummarizeOrders(ctx context.Context, in *SummarizeOrdersReq, opts ...grpc.CallOption) (*SummarizeOrdersResp, error)
+	// 预售：定金 + 尾款两阶段下单
+	CreatePresaleCampaign(ctx context.Context, in *CreatePresaleCampaignReq, opts ...grpc.CallOption) (*CreatePresaleCampaignResp, error)
+	CancelPresaleCampaign(ctx context.Context, in *CancelPresaleCampaignReq, opts ...grpc.CallOption) (*CancelPresaleCampaignResp, error)
+	PlacePresaleDeposit(ctx context.Context, in *PlacePresaleDepositReq, opts ...grpc.CallOption) (*PlacePresaleDepositResp, error)
+	PlacePresaleBalance(ctx context.Context, in *PlacePresaleBalanceReq, opts ...grpc.CallOption) (*PlacePresaleBalanceResp, error)
+	CancelPresaleOrder(ctx context.Context, in *CancelPresaleOrderReq, opts ...grpc.CallOption) (*CancelPresaleOrderResp, error)
+	GetPresaleOrder(ctx context.Context, in *GetPresaleOrderReq, opts ...grpc.CallOption) (*GetPresaleOrderResp, error)
 }
 
 type orderServiceClient struct {
@@ -126,24 +142,139 @@ func (c *orderServiceClient) ListOrders(ctx context.Context, in *ListOrdersReq,
 	return out, nil
 }
 
+func (c *orderServiceClient) CompleteOrder(ctx context.Context, in *CompleteOrderReq, opts ...grpc.CallOption) (*CompleteOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteOrderResp)
+	err := c.cc.Invoke(ctx, OrderService_CompleteOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListCompletedOrders(ctx context.Context, in *ListCompletedOrdersReq, opts ...grpc.CallOption) (*ListCompletedOrdersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompletedOrdersResp)
+	err := c.cc.Invoke(ctx, OrderService_ListCompletedOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetPreorderStates(ctx context.Context, in *GetPreorderStatesReq, opts ...grpc.CallOption) (*GetPreorderStatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreorderStatesResp)
+	err := c.cc.Invoke(ctx, OrderService_GetPreorderStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetUserOrderStats(ctx context.Context, in *GetUserOrderStatsReq, opts ...grpc.CallOption) (*GetUserOrderStatsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserOrderStatsResp)
+	err := c.cc.Invoke(ctx, OrderService_GetUserOrderStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SummarizeOrders(ctx context.Context, in *SummarizeOrdersReq, opts ...grpc.CallOption) (*SummarizeOrdersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SummarizeOrdersResp)
+	err := c.cc.Invoke(ctx, OrderService_SummarizeOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreatePresaleCampaign(ctx context.Context, in *CreatePresaleCampaignReq, opts ...grpc.CallOption) (*CreatePresaleCampaignResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePresaleCampaignResp)
+	err := c.cc.Invoke(ctx, OrderService_CreatePresaleCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CancelPresaleCampaign(ctx context.Context, in *CancelPresaleCampaignReq, opts ...grpc.CallOption) (*CancelPresaleCampaignResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPresaleCampaignResp)
+	err := c.cc.Invoke(ctx, OrderService_CancelPresaleCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PlacePresaleDeposit(ctx context.Context, in *PlacePresaleDepositReq, opts ...grpc.CallOption) (*PlacePresaleDepositResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacePresaleDepositResp)
+	err := c.cc.Invoke(ctx, OrderService_PlacePresaleDeposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PlacePresaleBalance(ctx context.Context, in *PlacePresaleBalanceReq, opts ...grpc.CallOption) (*PlacePresaleBalanceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacePresaleBalanceResp)
+	err := c.cc.Invoke(ctx, OrderService_PlacePresaleBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CancelPresaleOrder(ctx context.Context, in *CancelPresaleOrderReq, opts ...grpc.CallOption) (*CancelPresaleOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPresaleOrderResp)
+	err := c.cc.Invoke(ctx, OrderService_CancelPresaleOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetPresaleOrder(ctx context.Context, in *GetPresaleOrderReq, opts ...grpc.CallOption) (*GetPresaleOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPresaleOrderResp)
+	err := c.cc.Invoke(ctx, OrderService_GetPresaleOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
 type OrderServiceServer interface {
-	// Checkout（结账，预订单）
 	Checkout(context.Context, *CheckoutReq) (*CheckoutResp, error)
-	// 提交订单（生成正式订单）
 	PlaceOrder(context.Context, *PlaceOrderReq) (*PlaceOrderResp, error)
-	// 支付确认（支付成功）
 	ConfirmPayment(context.Context, *ConfirmPaymentReq) (*ConfirmPaymentResp, error)
-	// 将订单标记为支付中并启动支付超时逻辑
 	MarkPaying(context.Context, *MarkPayingReq) (*MarkPayingResp, error)
-	// 主动取消或超时取消订单
 	CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderResp, error)
-	// 查询单个订单
 	GetOrder(context.Context, *GetOrderReq) (*GetOrderResp, error)
-	// 分页查询订单
 	ListOrders(context.Context, *ListOrdersReq) (*ListOrdersResp, error)
+	CompleteOrder(context.Context, *CompleteOrderReq) (*CompleteOrderResp, error)
+	ListCompletedOrders(context.Context, *ListCompletedOrdersReq) (*ListCompletedOrdersResp, error)
+	GetPreorderStates(context.Context, *GetPreorderStatesReq) (*GetPreorderStatesResp, error)
+	GetUserOrderStats(context.Context, *GetUserOrderStatsReq) (*GetUserOrderStatsResp, error)
+	SummarizeOrders(context.Context, *SummarizeOrdersReq) (*SummarizeOrdersResp, error)
+	// 预售：定金 + 尾款两阶段下单
+	CreatePresaleCampaign(context.Context, *CreatePresaleCampaignReq) (*CreatePresaleCampaignResp, error)
+	CancelPresaleCampaign(context.Context, *CancelPresaleCampaignReq) (*CancelPresaleCampaignResp, error)
+	PlacePresaleDeposit(context.Context, *PlacePresaleDepositReq) (*PlacePresaleDepositResp, error)
+	PlacePresaleBalance(context.Context, *PlacePresaleBalanceReq) (*PlacePresaleBalanceResp, error)
+	CancelPresaleOrder(context.Context, *CancelPresaleOrderReq) (*CancelPresaleOrderResp, error)
+	GetPresaleOrder(context.Context, *GetPresaleOrderReq) (*GetPresaleOrderResp, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -175,6 +306,39 @@ func (UnimplementedOrderServiceServer) GetOrder(context.Context, *GetOrderReq) (
 func (UnimplementedOrderServiceServer) ListOrders(context.Context, *ListOrdersReq) (*ListOrdersResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) CompleteOrder(context.Context, *CompleteOrderReq) (*CompleteOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) ListCompletedOrders(context.Context, *ListCompletedOrdersReq) (*ListCompletedOrdersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompletedOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) GetPreorderStates(context.Context, *GetPreorderStatesReq) (*GetPreorderStatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreorderStates not implemented")
+}
+func (UnimplementedOrderServiceServer) GetUserOrderStats(context.Context, *GetUserOrderStatsReq) (*GetUserOrderStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserOrderStats not implemented")
+}
+func (UnimplementedOrderServiceServer) SummarizeOrders(context.Context, *SummarizeOrdersReq) (*SummarizeOrdersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SummarizeOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) CreatePresaleCampaign(context.Context, *CreatePresaleCampaignReq) (*CreatePresaleCampaignResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePresaleCampaign not implemented")
+}
+func (UnimplementedOrderServiceServer) CancelPresaleCampaign(context.Context, *CancelPresaleCampaignReq) (*CancelPresaleCampaignResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPresaleCampaign not implemented")
+}
+func (UnimplementedOrderServiceServer) PlacePresaleDeposit(context.Context, *PlacePresaleDepositReq) (*PlacePresaleDepositResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlacePresaleDeposit not implemented")
+}
+func (UnimplementedOrderServiceServer) PlacePresaleBalance(context.Context, *PlacePresaleBalanceReq) (*PlacePresaleBalanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlacePresaleBalance not implemented")
+}
+func (UnimplementedOrderServiceServer) CancelPresaleOrder(context.Context, *CancelPresaleOrderReq) (*CancelPresaleOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPresaleOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) GetPresaleOrder(context.Context, *GetPresaleOrderReq) (*GetPresaleOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresaleOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -322,6 +486,204 @@ func _OrderService_ListOrders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_CompleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CompleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CompleteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CompleteOrder(ctx, req.(*CompleteOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListCompletedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompletedOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListCompletedOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListCompletedOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListCompletedOrders(ctx, req.(*ListCompletedOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetPreorderStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreorderStatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetPreorderStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetPreorderStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetPreorderStates(ctx, req.(*GetPreorderStatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetUserOrderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserOrderStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetUserOrderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetUserOrderStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetUserOrderStats(ctx, req.(*GetUserOrderStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SummarizeOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SummarizeOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SummarizeOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SummarizeOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SummarizeOrders(ctx, req.(*SummarizeOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreatePresaleCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePresaleCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreatePresaleCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreatePresaleCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreatePresaleCampaign(ctx, req.(*CreatePresaleCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CancelPresaleCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPresaleCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelPresaleCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelPresaleCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelPresaleCampaign(ctx, req.(*CancelPresaleCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PlacePresaleDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlacePresaleDepositReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PlacePresaleDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PlacePresaleDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PlacePresaleDeposit(ctx, req.(*PlacePresaleDepositReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PlacePresaleBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlacePresaleBalanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PlacePresaleBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PlacePresaleBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PlacePresaleBalance(ctx, req.(*PlacePresaleBalanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CancelPresaleOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPresaleOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelPresaleOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelPresaleOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelPresaleOrder(ctx, req.(*CancelPresaleOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetPresaleOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresaleOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetPresaleOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetPresaleOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetPresaleOrder(ctx, req.(*GetPresaleOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -357,6 +719,50 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrders",
 			Handler:    _OrderService_ListOrders_Handler,
 		},
+		{
+			MethodName: "CompleteOrder",
+			Handler:    _OrderService_CompleteOrder_Handler,
+		},
+		{
+			MethodName: "ListCompletedOrders",
+			Handler:    _OrderService_ListCompletedOrders_Handler,
+		},
+		{
+			MethodName: "GetPreorderStates",
+			Handler:    _OrderService_GetPreorderStates_Handler,
+		},
+		{
+			MethodName: "GetUserOrderStats",
+			Handler:    _OrderService_GetUserOrderStats_Handler,
+		},
+		{
+			MethodName: "SummarizeOrders",
+			Handler:    _OrderService_SummarizeOrders_Handler,
+		},
+		{
+			MethodName: "CreatePresaleCampaign",
+			Handler:    _OrderService_CreatePresaleCampaign_Handler,
+		},
+		{
+			MethodName: "CancelPresaleCampaign",
+			Handler:    _OrderService_CancelPresaleCampaign_Handler,
+		},
+		{
+			MethodName: "PlacePresaleDeposit",
+			Handler:    _OrderService_PlacePresaleDeposit_Handler,
+		},
+		{
+			MethodName: "PlacePresaleBalance",
+			Handler:    _OrderService_PlacePresaleBalance_Handler,
+		},
+		{
+			MethodName: "CancelPresaleOrder",
+			Handler:    _OrderService_CancelPresaleOrder_Handler,
+		},
+		{
+			MethodName: "GetPresaleOrder",
+			Handler:    _OrderService_GetPresaleOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
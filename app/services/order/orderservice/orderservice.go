@@ -14,36 +14,72 @@ import (
 )
 
 type (
-	CancelOrderReq     = order.CancelOrderReq
-	CancelOrderResp    = order.CancelOrderResp
-	CheckoutReq        = order.CheckoutReq
-	CheckoutResp       = order.CheckoutResp
-	ConfirmPaymentReq  = order.ConfirmPaymentReq
-	ConfirmPaymentResp = order.ConfirmPaymentResp
-	GetOrderReq        = order.GetOrderReq
-	GetOrderResp       = order.GetOrderResp
-	Item               = order.Item
-	ListOrdersReq      = order.ListOrdersReq
-	ListOrdersResp     = order.ListOrdersResp
-	OrderInfo          = order.OrderInfo
-	OrderItem          = order.OrderItem
-	OrderItemSnapshot  = order.OrderItemSnapshot
-	PlaceOrderReq      = order.PlaceOrderReq
-	PlaceOrderResp     = order.PlaceOrderResp
+	CancelOrderReq            = order.CancelOrderReq
+	CancelOrderResp           = order.CancelOrderResp
+	CancelPresaleCampaignReq  = order.CancelPresaleCampaignReq
+	CancelPresaleCampaignResp = order.CancelPresaleCampaignResp
+	CancelPresaleOrderReq     = order.CancelPresaleOrderReq
+	CancelPresaleOrderResp    = order.CancelPresaleOrderResp
+	CheckoutReq               = order.CheckoutReq
+	CheckoutResp              = order.CheckoutResp
+	CompleteOrderReq          = order.CompleteOrderReq
+	CompleteOrderResp         = order.CompleteOrderResp
+	ConfirmPaymentReq         = order.ConfirmPaymentReq
+	ConfirmPaymentResp        = order.ConfirmPaymentResp
+	CreatePresaleCampaignReq  = order.CreatePresaleCampaignReq
+	CreatePresaleCampaignResp = order.CreatePresaleCampaignResp
+	GetOrderReq               = order.GetOrderReq
+	GetOrderResp              = order.GetOrderResp
+	GetPreorderStatesReq      = order.GetPreorderStatesReq
+	GetPreorderStatesResp     = order.GetPreorderStatesResp
+	GetPresaleOrderReq        = order.GetPresaleOrderReq
+	GetPresaleOrderResp       = order.GetPresaleOrderResp
+	GetUserOrderStatsReq      = order.GetUserOrderStatsReq
+	GetUserOrderStatsResp     = order.GetUserOrderStatsResp
+	Item                      = order.Item
+	ListCompletedOrdersReq    = order.ListCompletedOrdersReq
+	ListCompletedOrdersResp   = order.ListCompletedOrdersResp
+	ListOrdersReq             = order.ListOrdersReq
+	ListOrdersResp            = order.ListOrdersResp
+	MarkPayingReq             = order.MarkPayingReq
+	MarkPayingResp            = order.MarkPayingResp
+	OrderInfo                 = order.OrderInfo
+	OrderItem                 = order.OrderItem
+	OrderItemSnapshot         = order.OrderItemSnapshot
+	OrderPromotion            = order.OrderPromotion
+	PlaceOrderReq             = order.PlaceOrderReq
+	PlaceOrderResp            = order.PlaceOrderResp
+	PlacePresaleBalanceReq    = order.PlacePresaleBalanceReq
+	PlacePresaleBalanceResp   = order.PlacePresaleBalanceResp
+	PlacePresaleDepositReq    = order.PlacePresaleDepositReq
+	PlacePresaleDepositResp   = order.PlacePresaleDepositResp
+	PreorderState             = order.PreorderState
+	PresaleCampaign           = order.PresaleCampaign
+	PresaleOrderInfo          = order.PresaleOrderInfo
+	SummarizeOrdersReq        = order.SummarizeOrdersReq
+	SummarizeOrdersResp       = order.SummarizeOrdersResp
+	UserOrderStats            = order.UserOrderStats
 
 	OrderService interface {
-		// Checkout（结账，预订单）
 		Checkout(ctx context.Context, in *CheckoutReq, opts ...grpc.CallOption) (*CheckoutResp, error)
-		// 提交订单（生成正式订单）
 		PlaceOrder(ctx context.Context, in *PlaceOrderReq, opts ...grpc.CallOption) (*PlaceOrderResp, error)
-		// 支付确认（支付成功）
 		ConfirmPayment(ctx context.Context, in *ConfirmPaymentReq, opts ...grpc.CallOption) (*ConfirmPaymentResp, error)
-		// 主动取消或超时取消订单
+		MarkPaying(ctx context.Context, in *MarkPayingReq, opts ...grpc.CallOption) (*MarkPayingResp, error)
 		CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderResp, error)
-		// 查询单个订单
 		GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderResp, error)
-		// 分页查询订单
 		ListOrders(ctx context.Context, in *ListOrdersReq, opts ...grpc.CallOption) (*ListOrdersResp, error)
+		CompleteOrder(ctx context.Context, in *CompleteOrderReq, opts ...grpc.CallOption) (*CompleteOrderResp, error)
+		ListCompletedOrders(ctx context.Context, in *ListCompletedOrdersReq, opts ...grpc.CallOption) (*ListCompletedOrdersResp, error)
+		GetPreorderStates(ctx context.Context, in *GetPreorderStatesReq, opts ...grpc.CallOption) (*GetPreorderStatesResp, error)
+		GetUserOrderStats(ctx context.Context, in *GetUserOrderStatsReq, opts ...grpc.CallOption) (*GetUserOrderStatsResp, error)
+		SummarizeOrders(ctx context.Context, in *SummarizeOrdersReq, opts ...grpc.CallOption) (*SummarizeOrdersResp, error)
+		// 预售：定金 + 尾款两阶段下单
+		CreatePresaleCampaign(ctx context.Context, in *CreatePresaleCampaignReq, opts ...grpc.CallOption) (*CreatePresaleCampaignResp, error)
+		CancelPresaleCampaign(ctx context.Context, in *CancelPresaleCampaignReq, opts ...grpc.CallOption) (*CancelPresaleCampaignResp, error)
+		PlacePresaleDeposit(ctx context.Context, in *PlacePresaleDepositReq, opts ...grpc.CallOption) (*PlacePresaleDepositResp, error)
+		PlacePresaleBalance(ctx context.Context, in *PlacePresaleBalanceReq, opts ...grpc.CallOption) (*PlacePresaleBalanceResp, error)
+		CancelPresaleOrder(ctx context.Context, in *CancelPresaleOrderReq, opts ...grpc.CallOption) (*CancelPresaleOrderResp, error)
+		GetPresaleOrder(ctx context.Context, in *GetPresaleOrderReq, opts ...grpc.CallOption) (*GetPresaleOrderResp, error)
 	}
 
 	defaultOrderService struct {
@@ -57,38 +93,93 @@ func NewOrderService(cli zrpc.Client) OrderService {
 	}
 }
 
-// Checkout（结账，预订单）
 func (m *defaultOrderService) Checkout(ctx context.Context, in *CheckoutReq, opts ...grpc.CallOption) (*CheckoutResp, error) {
 	client := order.NewOrderServiceClient(m.cli.Conn())
 	return client.Checkout(ctx, in, opts...)
 }
 
-// 提交订单（生成正式订单）
 func (m *defaultOrderService) PlaceOrder(ctx context.Context, in *PlaceOrderReq, opts ...grpc.CallOption) (*PlaceOrderResp, error) {
 	client := order.NewOrderServiceClient(m.cli.Conn())
 	return client.PlaceOrder(ctx, in, opts...)
 }
 
-// 支付确认（支付成功）
 func (m *defaultOrderService) ConfirmPayment(ctx context.Context, in *ConfirmPaymentReq, opts ...grpc.CallOption) (*ConfirmPaymentResp, error) {
 	client := order.NewOrderServiceClient(m.cli.Conn())
 	return client.ConfirmPayment(ctx, in, opts...)
 }
 
-// 主动取消或超时取消订单
+func (m *defaultOrderService) MarkPaying(ctx context.Context, in *MarkPayingReq, opts ...grpc.CallOption) (*MarkPayingResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.MarkPaying(ctx, in, opts...)
+}
+
 func (m *defaultOrderService) CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderResp, error) {
 	client := order.NewOrderServiceClient(m.cli.Conn())
 	return client.CancelOrder(ctx, in, opts...)
 }
 
-// 查询单个订单
 func (m *defaultOrderService) GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderResp, error) {
 	client := order.NewOrderServiceClient(m.cli.Conn())
 	return client.GetOrder(ctx, in, opts...)
 }
 
-// 分页查询订单
 func (m *defaultOrderService) ListOrders(ctx context.Context, in *ListOrdersReq, opts ...grpc.CallOption) (*ListOrdersResp, error) {
 	client := order.NewOrderServiceClient(m.cli.Conn())
 	return client.ListOrders(ctx, in, opts...)
 }
+
+func (m *defaultOrderService) CompleteOrder(ctx context.Context, in *CompleteOrderReq, opts ...grpc.CallOption) (*CompleteOrderResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.CompleteOrder(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) ListCompletedOrders(ctx context.Context, in *ListCompletedOrdersReq, opts ...grpc.CallOption) (*ListCompletedOrdersResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.ListCompletedOrders(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) GetPreorderStates(ctx context.Context, in *GetPreorderStatesReq, opts ...grpc.CallOption) (*GetPreorderStatesResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.GetPreorderStates(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) GetUserOrderStats(ctx context.Context, in *GetUserOrderStatsReq, opts ...grpc.CallOption) (*GetUserOrderStatsResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.GetUserOrderStats(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) SummarizeOrders(ctx context.Context, in *SummarizeOrdersReq, opts ...grpc.CallOption) (*SummarizeOrdersResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.SummarizeOrders(ctx, in, opts...)
+}
+
+// 预售：定金 + 尾款两阶段下单
+func (m *defaultOrderService) CreatePresaleCampaign(ctx context.Context, in *CreatePresaleCampaignReq, opts ...grpc.CallOption) (*CreatePresaleCampaignResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.CreatePresaleCampaign(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) CancelPresaleCampaign(ctx context.Context, in *CancelPresaleCampaignReq, opts ...grpc.CallOption) (*CancelPresaleCampaignResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.CancelPresaleCampaign(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) PlacePresaleDeposit(ctx context.Context, in *PlacePresaleDepositReq, opts ...grpc.CallOption) (*PlacePresaleDepositResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.PlacePresaleDeposit(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) PlacePresaleBalance(ctx context.Context, in *PlacePresaleBalanceReq, opts ...grpc.CallOption) (*PlacePresaleBalanceResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.PlacePresaleBalance(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) CancelPresaleOrder(ctx context.Context, in *CancelPresaleOrderReq, opts ...grpc.CallOption) (*CancelPresaleOrderResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.CancelPresaleOrder(ctx, in, opts...)
+}
+
+func (m *defaultOrderService) GetPresaleOrder(ctx context.Context, in *GetPresaleOrderReq, opts ...grpc.CallOption) (*GetPresaleOrderResp, error) {
+	client := order.NewOrderServiceClient(m.cli.Conn())
+	return client.GetPresaleOrder(ctx, in, opts...)
+}
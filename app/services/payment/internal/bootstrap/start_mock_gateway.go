@@ -0,0 +1,36 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// StartMockGateway 启动模拟收银台 HTTP 服务，未启用 Mock 渠道时不做任何事。
+func StartMockGateway(sc *svc.ServiceContext) func() {
+	mock := sc.Providers.Mock()
+	if mock == nil {
+		return func() {}
+	}
+
+	srv := &http.Server{
+		Addr:    sc.Config.PayChannels.Mock.ListenOn,
+		Handler: mock.Handler(),
+	}
+	go func() {
+		logx.Infof("mock payment gateway listening on %s", srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(ctx)
+	}
+}
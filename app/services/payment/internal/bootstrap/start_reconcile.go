@@ -0,0 +1,91 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/reconcile"
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const reconcileCheckInterval = 10 * time.Minute
+
+// StartReconcile 每日对账：到点后逐渠道查找前一日对账单，已成功对账的日期不再重复执行。
+func StartReconcile(sc *svc.ServiceContext) func() {
+	conf := sc.Config.Reconcile
+	if conf.Dir == "" {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(reconcileCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				now := time.Now()
+				if now.Hour() < conf.Hour {
+					continue
+				}
+				billDate := now.AddDate(0, 0, -1)
+				for _, channel := range sc.Providers.Channels() {
+					reconcileDaily(ctx, sc, channel, billDate)
+				}
+			}
+		}
+	}()
+	return cancel
+}
+
+func reconcileDaily(ctx context.Context, sc *svc.ServiceContext, channel string, billDate time.Time) {
+	day := time.Date(billDate.Year(), billDate.Month(), billDate.Day(), 0, 0, 0, 0, time.Local)
+	if latest, err := sc.PaymentReconcileReports.FindLatest(ctx, channel, day); err == nil {
+		if latest.Status != paymentdal.ReconcileStatusFailed {
+			return
+		}
+		// 失败的对账只有对账单文件更新后才重试
+		if info, err := os.Stat(statementPath(sc, channel, day)); err != nil || !info.ModTime().After(latest.UpdatedAt) {
+			return
+		}
+	} else if !errors.Is(err, paymentdal.ErrNotFound) {
+		logx.WithContext(ctx).Errorf("find reconcile report failed: channel=%s date=%s err=%v", channel, day.Format(time.DateOnly), err)
+		return
+	}
+
+	path := statementPath(sc, channel, day)
+	f, err := os.Open(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logx.WithContext(ctx).Errorf("open statement failed: path=%s err=%v", path, err)
+		}
+		return
+	}
+	defer f.Close()
+
+	report, err := reconcile.Run(ctx, sc, reconcile.Options{
+		Channel:  channel,
+		BillDate: day,
+		FileName: filepath.Base(path),
+		AutoFix:  sc.Config.Reconcile.AutoFix,
+	}, f)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("daily reconcile failed: channel=%s date=%s err=%v", channel, day.Format(time.DateOnly), err)
+		return
+	}
+	logx.WithContext(ctx).Infof("daily reconcile done: channel=%s date=%s matched=%d missing=%d extra=%d amount_mismatch=%d status_mismatch=%d fixed=%d",
+		channel, day.Format(time.DateOnly), report.Matched, report.Missing, report.Extra, report.AmountMismatch, report.StatusMismatch, report.Fixed)
+}
+
+func statementPath(sc *svc.ServiceContext, channel string, day time.Time) string {
+	return filepath.Join(sc.Config.Reconcile.Dir, strings.ToLower(channel)+"_"+day.Format("20060102")+".csv")
+}
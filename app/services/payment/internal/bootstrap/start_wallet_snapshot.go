@@ -0,0 +1,67 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const walletSnapshotCheckInterval = 10 * time.Minute
+
+// StartWalletSnapshot 每日 Wallet.SnapshotHour 点后生成前一日各账户的日终余额快照，并做借贷试算平衡检查。
+func StartWalletSnapshot(sc *svc.ServiceContext) func() {
+	if !sc.Config.Wallet.Enabled {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(walletSnapshotCheckInterval)
+		defer ticker.Stop()
+		var lastDay string
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				now := time.Now()
+				if now.Hour() < sc.Config.Wallet.SnapshotHour {
+					continue
+				}
+				day := now.AddDate(0, 0, -1)
+				if day.Format(time.DateOnly) == lastDay {
+					continue
+				}
+				if snapshotWallet(ctx, sc, day) {
+					lastDay = day.Format(time.DateOnly)
+				}
+			}
+		}
+	}()
+	return cancel
+}
+
+func snapshotWallet(ctx context.Context, sc *svc.ServiceContext, day time.Time) bool {
+	date := day.Format(time.DateOnly)
+	rows, err := sc.WalletSnapshots.SnapshotDay(ctx, day)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("wallet snapshot failed: date=%s err=%v", date, err)
+		return false
+	}
+	debit, credit, err := sc.WalletSnapshots.SumTurnover(ctx, day)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("wallet trial balance failed: date=%s err=%v", date, err)
+		return false
+	}
+	if err := sc.Wallet.SyncPlatformBalances(ctx, day); err != nil {
+		logx.WithContext(ctx).Errorf("sync platform wallet balances failed: date=%s err=%v", date, err)
+	}
+	if debit != credit {
+		logx.WithContext(ctx).Errorf("wallet trial balance mismatch: date=%s debit=%d credit=%d", date, debit, credit)
+	}
+	logx.WithContext(ctx).Infof("wallet snapshot done: date=%s accounts=%d debit=%d credit=%d", date, rows, debit, credit)
+	return true
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"NatsumeAI/app/services/payment/internal/provider"
+
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/redis"
@@ -27,6 +29,16 @@ type Config struct {
 
 	PaymentTimeoutMinutes int
 	SnowflakeNode         int64
+
+	PayChannels provider.Conf `json:",optional"`
+
+	// KafkaConf 退款结果事件，未配置时不投递
+	KafkaConf KafkaConf `json:",optional"`
+
+	Reconcile ReconcileConf `json:",optional"`
+
+	Wallet WalletConf `json:",optional"`
+	Points PointsConf `json:",optional"`
 }
 
 type AsynqRedisConf struct {
@@ -37,3 +49,27 @@ type AsynqServerConf struct {
 	Concurrency int
 	Queues      map[string]int
 }
+
+type KafkaConf struct {
+	Broker      []string `json:",optional"`
+	RefundTopic string   `json:",optional"`
+}
+
+// ReconcileConf 每日对账：在 Hour 点之后读取 {Dir}/{channel}_{yyyyMMdd}.csv 核对前一日账单。
+type ReconcileConf struct {
+	Dir     string `json:",optional"`
+	Hour    int    `json:",default=10"`
+	AutoFix bool   `json:",optional"`
+}
+
+// WalletConf 钱包余额：启用后注册 WALLET 支付渠道，每日 SnapshotHour 点后生成前一日余额快照。
+type WalletConf struct {
+	Enabled      bool `json:",optional"`
+	SnapshotHour int  `json:",default=1"`
+}
+
+// PointsConf 积分抵扣：启用后注册 POINTS 支付渠道，1 积分抵扣 CentsPerPoint 分。
+type PointsConf struct {
+	Enabled       bool  `json:",optional"`
+	CentsPerPoint int64 `json:",default=1"`
+}
@@ -0,0 +1,110 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/split"
+	"NatsumeAI/app/services/payment/internal/svc"
+	"NatsumeAI/app/services/payment/internal/trade"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ClosePaymentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewClosePaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClosePaymentLogic {
+	return &ClosePaymentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ClosePayment 订单取消前关闭支付单。渠道侧已支付时确认订单并返回 409，订单不应再取消。
+func (l *ClosePaymentLogic) ClosePayment(in *paymentpb.ClosePaymentReq) (*paymentpb.ClosePaymentResp, error) {
+	resp := &paymentpb.ClosePaymentResp{}
+	if in == nil || in.OrderId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	po, err := l.svcCtx.PaymentOrders.FindOneByOrderId(l.ctx, in.OrderId)
+	if err != nil {
+		if err == paymentdal.ErrNotFound {
+			// 尚未发起支付，无需关闭
+			resp.StatusCode = 0
+			resp.StatusMsg = "ok"
+			return resp, nil
+		}
+		return nil, err
+	}
+	if in.UserId > 0 && po.UserId != in.UserId {
+		resp.StatusCode = 403
+		resp.StatusMsg = "forbidden"
+		return resp, nil
+	}
+
+	switch po.Status {
+	case "SUCCESS":
+		resp.StatusCode = 409
+		resp.StatusMsg = "payment already paid"
+		resp.Payment = toPaymentInfo(po)
+		return resp, nil
+	case "FAILED", "CANCELLED", "EXPIRED":
+		resp.StatusCode = 0
+		resp.StatusMsg = "ok"
+		resp.Payment = toPaymentInfo(po)
+		return resp, nil
+	}
+
+	if p, err := l.svcCtx.Providers.Get(po.Channel); err == nil {
+		if err := p.Close(l.ctx, po.PaymentNo); errors.Is(err, provider.ErrTradePaid) {
+			// 关单时用户已完成支付，以渠道结果为准确认订单
+			if _, err := trade.SyncChannel(l.ctx, l.svcCtx, po); err != nil {
+				l.Logger.Errorf("close payment sync paid trade failed: payment=%s err=%v", po.PaymentNo, err)
+			}
+			resp.StatusCode = 409
+			resp.StatusMsg = "payment already paid"
+			resp.Payment = toPaymentInfo(po)
+			return resp, nil
+		} else if err != nil {
+			// 渠道关单失败仍关闭本地支付单，之后到账的款项会自动退款
+			l.Logger.Errorf("close channel trade failed: payment=%s err=%v", po.PaymentNo, err)
+		}
+	}
+
+	updated, err := l.svcCtx.PaymentOrders.UpdateStatus(l.ctx, po.PaymentId, []string{"INIT", "PROCESSING"}, "CANCELLED")
+	if err != nil {
+		return nil, err
+	}
+	latest, err := l.svcCtx.PaymentOrders.FindOne(l.ctx, po.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+	if !updated && latest.Status == "SUCCESS" {
+		resp.StatusCode = 409
+		resp.StatusMsg = "payment already paid"
+		resp.Payment = toPaymentInfo(latest)
+		return resp, nil
+	}
+
+	if updated {
+		if err := split.Rollback(l.ctx, l.svcCtx, latest); err != nil {
+			l.Logger.Errorf("close payment rollback splits failed: payment=%s err=%v", latest.PaymentNo, err)
+		}
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Payment = toPaymentInfo(latest)
+	return resp, nil
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"strconv"
-	"strings"
 	"time"
 
+	"NatsumeAI/app/common/fx"
 	"NatsumeAI/app/common/snowflake"
 	paymentdal "NatsumeAI/app/dal/payment"
 	"NatsumeAI/app/services/order/order"
 	"NatsumeAI/app/services/payment/internal/mq"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/split"
 	"NatsumeAI/app/services/payment/internal/svc"
+	"NatsumeAI/app/services/payment/internal/trade"
+	"NatsumeAI/app/services/payment/internal/wallet"
 	paymentpb "NatsumeAI/app/services/payment/payment"
 
 	"github.com/hibiken/asynq"
@@ -35,13 +40,35 @@ func NewCreatePaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Cre
 
 func (l *CreatePaymentLogic) CreatePayment(in *paymentpb.CreatePaymentReq) (*paymentpb.CreatePaymentResp, error) {
 	resp := &paymentpb.CreatePaymentResp{}
-	if in == nil || in.OrderId <= 0 || in.UserId <= 0 || in.Amount <= 0 || in.Channel == "" {
+	if in == nil || in.OrderId <= 0 || in.UserId <= 0 || in.Amount <= 0 {
 		resp.StatusCode = 400
 		resp.StatusMsg = "invalid params"
 		return resp, nil
 	}
-	if in.Currency == "" {
-		in.Currency = "CNY"
+	in.Currency = fx.Normalize(in.Currency)
+	// 积分按 CNY 折算，外币支付不支持积分抵扣
+	if in.Points > 0 && in.Currency != fx.BaseCurrency {
+		resp.StatusCode = 400
+		resp.StatusMsg = "points only available for " + fx.BaseCurrency
+		return resp, nil
+	}
+	// 汇率快照以订单为准；未传时标价币种即支付币种
+	priceCurrency, fxRate := in.Currency, fx.RateScale
+	if in.PriceCurrency != "" && in.FxRate > 0 {
+		priceCurrency, fxRate = fx.Normalize(in.PriceCurrency), in.FxRate
+	}
+	// 组合支付：积分、钱包先行抵扣，剩余金额走主渠道
+	legs, err := split.Plan(l.svcCtx, in.Amount, in.WalletAmount, in.Points, in.Channel)
+	if err != nil {
+		resp.StatusCode = 400
+		resp.StatusMsg = err.Error()
+		return resp, nil
+	}
+	primary := split.Primary(legs)
+	if _, err := l.svcCtx.Providers.Get(primary.Channel); err != nil {
+		resp.StatusCode = 400
+		resp.StatusMsg = "unsupported channel"
+		return resp, nil
 	}
 
 	// idempotence: return existing payment order if present
@@ -51,9 +78,29 @@ func (l *CreatePaymentLogic) CreatePayment(in *paymentpb.CreatePaymentReq) (*pay
 			resp.StatusMsg = "forbidden"
 			return resp, nil
 		}
+		// 上次渠道下单失败（如钱包余额不足），允许切换渠道或抵扣方式后重新下单
+		if existing.Status == "INIT" {
+			if existing.Amount != in.Amount || existing.Currency != in.Currency {
+				resp.StatusCode = 409
+				resp.StatusMsg = "payment amount mismatch"
+				return resp, nil
+			}
+			existing.Channel = primary.Channel
+			existing.ChannelAmount = primary.Amount
+			if err := l.svcCtx.PaymentOrders.Update(l.ctx, existing); err != nil {
+				return nil, err
+			}
+			if err := split.Replace(l.ctx, l.svcCtx, existing, legs); err != nil {
+				return nil, err
+			}
+			if err := l.prepay(existing); err != nil {
+				l.prepayFailed(resp, existing, err)
+				return resp, nil
+			}
+		}
 		resp.StatusCode = 0
 		resp.StatusMsg = "ok"
-		resp.Payment = toPaymentInfo(existing)
+		resp.Payment = toPaymentInfoWithSplits(l.ctx, l.svcCtx, existing)
 		return resp, nil
 	} else if err != paymentdal.ErrNotFound {
 		return nil, err
@@ -73,7 +120,6 @@ func (l *CreatePaymentLogic) CreatePayment(in *paymentpb.CreatePaymentReq) (*pay
 		return resp, nil
 	}
 
-	channel := strings.ToUpper(in.Channel)
 	timeoutAt := time.Now().Add(l.svcCtx.PaymentTTL)
 
 	payloadData := map[string]string{
@@ -83,13 +129,17 @@ func (l *CreatePaymentLogic) CreatePayment(in *paymentpb.CreatePaymentReq) (*pay
 	payloadBytes, _ := json.Marshal(payloadData)
 
 	record := &paymentdal.PaymentOrders{
-		PaymentNo: strconv.FormatInt(snowflake.Next(), 10),
-		OrderId:   in.OrderId,
-		UserId:    in.UserId,
-		Amount:    in.Amount,
-		Currency:  strings.ToUpper(in.Currency),
-		Channel:   channel,
-		Status:    "INIT",
+		PaymentNo:     strconv.FormatInt(snowflake.Next(), 10),
+		OrderId:       in.OrderId,
+		BizType:       paymentdal.PaymentBizOrder,
+		UserId:        in.UserId,
+		Amount:        in.Amount,
+		ChannelAmount: primary.Amount,
+		Currency:      in.Currency,
+		PriceCurrency: priceCurrency,
+		FxRate:        fxRate,
+		Channel:       primary.Channel,
+		Status:        "INIT",
 		ChannelPayload: sql.NullString{
 			String: string(payloadBytes),
 			Valid:  len(payloadBytes) > 2,
@@ -112,22 +162,112 @@ func (l *CreatePaymentLogic) CreatePayment(in *paymentpb.CreatePaymentReq) (*pay
 	}
 	paymentID, _ := res.LastInsertId()
 	record.PaymentId = paymentID
+	if err := split.Replace(l.ctx, l.svcCtx, record, legs); err != nil {
+		return nil, err
+	}
 
-	if l.svcCtx.AsynqClient != nil {
-		payload, _ := json.Marshal(mq.ExpirePaymentPayload{
-			PaymentId: paymentID,
-			OrderId:   in.OrderId,
-			UserId:    in.UserId,
-		})
-		task := asynq.NewTask(mq.TaskExpirePayment, payload)
-		if _, err := l.svcCtx.AsynqClient.Enqueue(task, asynq.ProcessIn(l.svcCtx.PaymentTTL), asynq.Queue("payment")); err != nil {
-			l.Logger.Errorf("enqueue payment timeout failed: payment=%d err=%v", paymentID, err)
-		}
+	l.enqueueExpire(record)
+
+	if err := l.prepay(record); err != nil {
+		l.prepayFailed(resp, record, err)
+		return resp, nil
 	}
 
 	resp.StatusCode = 0
 	resp.StatusMsg = "ok"
-	record.Status = "INIT"
-	resp.Payment = toPaymentInfo(record)
+	resp.Payment = toPaymentInfoWithSplits(l.ctx, l.svcCtx, record)
 	return resp, nil
 }
+
+// prepay 向渠道下单，成功后保存支付凭证并置为 PROCESSING
+func (l *CreatePaymentLogic) prepay(po *paymentdal.PaymentOrders) error {
+	p, err := l.svcCtx.Providers.Get(po.Channel)
+	if err != nil {
+		return err
+	}
+	// 先冻结积分、钱包分段，主渠道下单失败时回滚
+	if err := split.Hold(l.ctx, l.svcCtx, po); err != nil {
+		return err
+	}
+
+	payload := channelPayload(po)
+	result, err := p.Create(l.ctx, &provider.CreateRequest{
+		PaymentNo: po.PaymentNo,
+		UserId:    po.UserId,
+		Amount:    po.ChannelAmount,
+		Currency:  po.Currency,
+		Subject:   payload["subject"],
+		ClientIp:  payload["client_ip"],
+		ExpireAt:  po.TimeoutAt,
+	})
+	if err != nil {
+		if rbErr := split.Rollback(l.ctx, l.svcCtx, po); rbErr != nil {
+			l.Logger.Errorf("rollback payment splits failed: payment=%d err=%v", po.PaymentId, rbErr)
+		}
+		return err
+	}
+
+	payload["credential"] = result.Credential
+	payloadBytes, _ := json.Marshal(payload)
+	updated, err := l.svcCtx.PaymentOrders.UpdateChannelPayload(l.ctx, po.PaymentId, []string{"INIT"}, "PROCESSING", string(payloadBytes))
+	if err != nil {
+		return err
+	}
+	if updated {
+		po.Status = "PROCESSING"
+		po.ChannelPayload = sql.NullString{String: string(payloadBytes), Valid: true}
+		// 钱包等同步扣款渠道直接确认支付，无需轮询；确认失败时款项已扣，投递确认任务重试且不能按成功返回
+		if result.Paid {
+			if _, err := trade.MarkPaid(l.ctx, l.svcCtx, po, result.TradeNo); err != nil {
+				l.Logger.Errorf("mark paid after synchronous payment failed: payment=%d err=%v", po.PaymentId, err)
+				if qerr := mq.EnqueueConfirmPaid(l.svcCtx, po.PaymentId, result.TradeNo); qerr != nil {
+					l.Logger.Errorf("enqueue confirm paid failed: payment=%d err=%v", po.PaymentId, qerr)
+				}
+				return errors.Join(errConfirmPending, err)
+			}
+			return nil
+		}
+		if err := mq.EnqueuePaymentPoll(l.svcCtx, po.PaymentId, 0, po.TimeoutAt); err != nil {
+			l.Logger.Errorf("enqueue payment poll failed: payment=%d err=%v", po.PaymentId, err)
+		}
+	}
+	return nil
+}
+
+// errConfirmPending 同步扣款已成功但订单尚未确认
+var errConfirmPending = errors.New("payment confirmation pending")
+
+func (l *CreatePaymentLogic) prepayFailed(resp *paymentpb.CreatePaymentResp, po *paymentdal.PaymentOrders, err error) {
+	if errors.Is(err, errConfirmPending) {
+		resp.StatusCode = 500
+		resp.StatusMsg = errConfirmPending.Error()
+		resp.Payment = toPaymentInfo(po)
+		return
+	}
+	if errors.Is(err, wallet.ErrInsufficientBalance) || errors.Is(err, wallet.ErrAccountFrozen) ||
+		errors.Is(err, wallet.ErrPointsFraction) || errors.Is(err, split.ErrInvalidPlan) {
+		resp.StatusCode = 409
+		resp.StatusMsg = err.Error()
+		resp.Payment = toPaymentInfo(po)
+		return
+	}
+	l.Logger.Errorf("channel prepay failed: payment=%d channel=%s err=%v", po.PaymentId, po.Channel, err)
+	resp.StatusCode = 500
+	resp.StatusMsg = "create channel payment failed"
+}
+
+// enqueueExpire 投递支付超时任务，到期未支付则关闭支付单
+func (l *CreatePaymentLogic) enqueueExpire(po *paymentdal.PaymentOrders) {
+	if l.svcCtx.AsynqClient == nil {
+		return
+	}
+	payload, _ := json.Marshal(mq.ExpirePaymentPayload{
+		PaymentId: po.PaymentId,
+		OrderId:   po.OrderId,
+		UserId:    po.UserId,
+	})
+	task := asynq.NewTask(mq.TaskExpirePayment, payload)
+	if _, err := l.svcCtx.AsynqClient.Enqueue(task, asynq.ProcessIn(l.svcCtx.PaymentTTL), asynq.Queue("payment")); err != nil {
+		l.Logger.Errorf("enqueue payment timeout failed: payment=%d err=%v", po.PaymentId, err)
+	}
+}
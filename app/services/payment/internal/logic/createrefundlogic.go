@@ -0,0 +1,83 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/refund"
+	"NatsumeAI/app/services/payment/internal/svc"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateRefundLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateRefundLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateRefundLogic {
+	return &CreateRefundLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateRefundLogic) CreateRefund(in *paymentpb.CreateRefundReq) (*paymentpb.CreateRefundResp, error) {
+	resp := &paymentpb.CreateRefundResp{}
+	if in == nil || (in.PaymentId <= 0 && in.OrderId <= 0) || in.Amount < 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	var (
+		po  *paymentdal.PaymentOrders
+		err error
+	)
+	if in.PaymentId > 0 {
+		po, err = l.svcCtx.PaymentOrders.FindOne(l.ctx, in.PaymentId)
+	} else {
+		po, err = l.svcCtx.PaymentOrders.FindOneByOrderId(l.ctx, in.OrderId)
+	}
+	if err != nil {
+		if err == paymentdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "payment not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	records, err := refund.Create(l.ctx, l.svcCtx, po, refund.Request{
+		Amount:    in.Amount,
+		Reason:    in.Reason,
+		RequestNo: in.RequestNo,
+		ToWallet:  in.ToWallet,
+	})
+	if err != nil {
+		if errors.Is(err, provider.ErrUnsupportedChannel) {
+			resp.StatusCode = 400
+			resp.StatusMsg = "wallet not enabled"
+			return resp, nil
+		}
+		if errors.Is(err, refund.ErrPaymentNotPaid) || errors.Is(err, refund.ErrAmountOverflow) || errors.Is(err, refund.ErrNotRefundable) {
+			resp.StatusCode = 409
+			resp.StatusMsg = err.Error()
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Refund = toRefundInfo(records[0])
+	for _, rf := range records {
+		resp.Legs = append(resp.Legs, toRefundInfo(rf))
+	}
+	return resp, nil
+}
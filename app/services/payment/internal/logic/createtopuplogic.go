@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"NatsumeAI/app/common/fx"
+	"NatsumeAI/app/common/snowflake"
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/svc"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateTopupLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateTopupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateTopupLogic {
+	return &CreateTopupLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 钱包充值：生成 TOPUP 类型的支付单，复用渠道下单、通知、查单与超时流程，到账后记入钱包
+func (l *CreateTopupLogic) CreateTopup(in *paymentpb.CreateTopupReq) (*paymentpb.CreateTopupResp, error) {
+	resp := &paymentpb.CreateTopupResp{}
+	if in == nil || in.UserId <= 0 || in.Amount <= 0 || in.Channel == "" {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	channel := strings.ToUpper(in.Channel)
+	if _, err := l.svcCtx.Providers.Get(channel); err != nil || channel == provider.ChannelWallet {
+		resp.StatusCode = 400
+		resp.StatusMsg = "unsupported channel"
+		return resp, nil
+	}
+	currency := strings.ToUpper(in.Currency)
+	if currency == "" {
+		currency = "CNY"
+	}
+
+	payloadBytes, _ := json.Marshal(map[string]string{
+		"client_ip": in.ClientIp,
+		"subject":   "NatsumeAI 钱包充值",
+	})
+	// 充值单没有订单，order_id 记为充值单号
+	record := &paymentdal.PaymentOrders{
+		PaymentNo:     strconv.FormatInt(snowflake.Next(), 10),
+		OrderId:       snowflake.Next(),
+		BizType:       paymentdal.PaymentBizTopup,
+		UserId:        in.UserId,
+		Amount:        in.Amount,
+		ChannelAmount: in.Amount,
+		Currency:      currency,
+		PriceCurrency: currency,
+		FxRate:        fx.RateScale,
+		Channel:       channel,
+		Status:        "INIT",
+		ChannelPayload: sql.NullString{
+			String: string(payloadBytes),
+			Valid:  true,
+		},
+		TimeoutAt: time.Now().Add(l.svcCtx.PaymentTTL),
+	}
+	res, err := l.svcCtx.PaymentOrders.Insert(l.ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	record.PaymentId, _ = res.LastInsertId()
+
+	pay := NewCreatePaymentLogic(l.ctx, l.svcCtx)
+	pay.enqueueExpire(record)
+	if err := pay.prepay(record); err != nil {
+		l.Logger.Errorf("topup prepay failed: payment=%d channel=%s err=%v", record.PaymentId, channel, err)
+		resp.StatusCode = 500
+		resp.StatusMsg = "create channel payment failed"
+		return resp, nil
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Payment = toPaymentInfo(record)
+	return resp, nil
+}
@@ -57,6 +57,6 @@ func (l *GetPaymentLogic) GetPayment(in *paymentpb.GetPaymentReq) (*paymentpb.Ge
 
 	resp.StatusCode = 0
 	resp.StatusMsg = "ok"
-	resp.Payment = toPaymentInfo(po)
+	resp.Payment = toPaymentInfoWithSplits(l.ctx, l.svcCtx, po)
 	return resp, nil
 }
@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/svc"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetReconcileReportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetReconcileReportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetReconcileReportLogic {
+	return &GetReconcileReportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetReconcileReportLogic) GetReconcileReport(in *paymentpb.GetReconcileReportReq) (*paymentpb.GetReconcileReportResp, error) {
+	resp := &paymentpb.GetReconcileReportResp{}
+	if in == nil || in.ReportId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	report, err := l.svcCtx.PaymentReconcileReports.FindOne(l.ctx, in.ReportId)
+	if err != nil {
+		if err == paymentdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "report not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	items, err := l.svcCtx.PaymentReconcileItems.ListByReport(l.ctx, report.ReportId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Report = toReconcileReport(report, items)
+	return resp, nil
+}
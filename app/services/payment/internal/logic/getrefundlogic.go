@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/svc"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRefundLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRefundLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRefundLogic {
+	return &GetRefundLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetRefundLogic) GetRefund(in *paymentpb.GetRefundReq) (*paymentpb.GetRefundResp, error) {
+	resp := &paymentpb.GetRefundResp{}
+	if in == nil || (in.RefundId <= 0 && in.RefundNo == "") {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	var (
+		rf  *paymentdal.PaymentRefunds
+		err error
+	)
+	if in.RefundId > 0 {
+		rf, err = l.svcCtx.PaymentRefunds.FindOne(l.ctx, in.RefundId)
+	} else {
+		rf, err = l.svcCtx.PaymentRefunds.FindOneByRefundNo(l.ctx, in.RefundNo)
+	}
+	if err != nil {
+		if err == paymentdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "refund not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Refund = toRefundInfo(rf)
+	return resp, nil
+}
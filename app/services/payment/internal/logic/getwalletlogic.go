@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"NatsumeAI/app/services/payment/internal/svc"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWalletLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetWalletLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWalletLogic {
+	return &GetWalletLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetWalletLogic) GetWallet(in *paymentpb.GetWalletReq) (*paymentpb.GetWalletResp, error) {
+	resp := &paymentpb.GetWalletResp{}
+	if in == nil || in.UserId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	currency := strings.ToUpper(in.Currency)
+	if currency == "" {
+		currency = "CNY"
+	}
+
+	acc, err := l.svcCtx.Wallet.Balance(l.ctx, in.UserId, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := l.svcCtx.Wallet.Points(l.ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Wallet = toWalletInfo(acc)
+	resp.Wallet.Points = points.Balance
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"NatsumeAI/app/services/payment/internal/svc"
+	"NatsumeAI/app/services/payment/internal/wallet"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GrantPointsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGrantPointsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GrantPointsLogic {
+	return &GrantPointsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GrantPoints 发放积分，biz_no 相同的请求只记账一次
+func (l *GrantPointsLogic) GrantPoints(in *paymentpb.GrantPointsReq) (*paymentpb.GrantPointsResp, error) {
+	resp := &paymentpb.GrantPointsResp{}
+	if in == nil || in.UserId <= 0 || in.Points <= 0 || in.BizNo == "" {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	if !l.svcCtx.Config.Points.Enabled {
+		resp.StatusCode = 400
+		resp.StatusMsg = "points not enabled"
+		return resp, nil
+	}
+
+	if _, err := l.svcCtx.Wallet.GrantPoints(l.ctx, in.UserId, in.Points, in.BizNo, in.Remark); err != nil {
+		if errors.Is(err, wallet.ErrEntryConflict) || errors.Is(err, wallet.ErrAccountFrozen) {
+			resp.StatusCode = 409
+			resp.StatusMsg = err.Error()
+			return resp, nil
+		}
+		return nil, err
+	}
+	acc, err := l.svcCtx.Wallet.Points(l.ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Balance = acc.Balance
+	return resp, nil
+}
@@ -0,0 +1,111 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/svc"
+	"NatsumeAI/app/services/payment/internal/trade"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type HandleNotifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewHandleNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *HandleNotifyLogic {
+	return &HandleNotifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 渠道异步通知：先落库原始报文，再验签、推进支付单并确认订单
+func (l *HandleNotifyLogic) HandleNotify(in *paymentpb.HandleNotifyReq) (*paymentpb.HandleNotifyResp, error) {
+	resp := &paymentpb.HandleNotifyResp{}
+	channel := strings.ToUpper(in.GetChannel())
+	p, err := l.svcCtx.Providers.Get(channel)
+	if err != nil {
+		resp.StatusCode = 400
+		resp.StatusMsg = "unsupported channel"
+		return resp, nil
+	}
+
+	record := &paymentdal.PaymentNotifications{
+		Channel: channel,
+		Result:  paymentdal.NotifyResultReceived,
+		RawBody: string(in.GetBody()),
+	}
+	if len(in.GetHeaders()) > 0 {
+		headerBytes, _ := json.Marshal(in.GetHeaders())
+		record.Headers = sql.NullString{String: string(headerBytes), Valid: true}
+	}
+
+	n, verifyErr := p.VerifyNotify(l.ctx, &provider.NotifyRequest{
+		Header: in.GetHeaders(),
+		Body:   in.GetBody(),
+	})
+	if verifyErr == nil {
+		record.Verified = 1
+		record.PaymentNo = n.PaymentNo
+		record.TradeNo = n.TradeNo
+		record.TradeState = string(n.State)
+		record.Amount = n.Amount
+	}
+
+	res, err := l.svcCtx.PaymentNotifications.Insert(l.ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	notificationID, _ := res.LastInsertId()
+
+	result, code, msg := l.process(channel, n, verifyErr)
+	if err := l.svcCtx.PaymentNotifications.UpdateResult(l.ctx, notificationID, result); err != nil {
+		l.Logger.Errorf("update notification result failed: notification=%d result=%s err=%v", notificationID, result, err)
+	}
+
+	resp.StatusCode = code
+	resp.StatusMsg = msg
+	resp.Ack = p.NotifyAck(code == 0)
+	return resp, nil
+}
+
+func (l *HandleNotifyLogic) process(channel string, n *provider.Notification, verifyErr error) (string, int64, string) {
+	if verifyErr != nil {
+		l.Logger.Errorf("payment notification verify failed: channel=%s err=%v", channel, verifyErr)
+		return paymentdal.NotifyResultInvalidSign, 400, "invalid signature"
+	}
+
+	po, err := l.svcCtx.PaymentOrders.FindOneByPaymentNo(l.ctx, n.PaymentNo)
+	if err == paymentdal.ErrNotFound {
+		return paymentdal.NotifyResultUnknownTrade, 404, "payment not found"
+	}
+	if err != nil {
+		l.Logger.Errorf("load payment for notification failed: payment_no=%s err=%v", n.PaymentNo, err)
+		return paymentdal.NotifyResultFailed, 500, "internal error"
+	}
+	if po.Channel != channel || n.Amount != po.ChannelAmount {
+		l.Logger.Errorf("payment notification mismatch: payment=%d channel=%s/%s amount=%d/%d", po.PaymentId, po.Channel, channel, po.ChannelAmount, n.Amount)
+		return paymentdal.NotifyResultMismatch, 409, "payment mismatch"
+	}
+	// 非成功通知（如交易关闭）不改变支付单状态，以超时/关单流程为准
+	if n.State != provider.TradeStateSuccess {
+		return paymentdal.NotifyResultIgnored, 0, "ok"
+	}
+
+	result, err := trade.MarkPaid(l.ctx, l.svcCtx, po, n.TradeNo)
+	if err != nil {
+		l.Logger.Errorf("mark payment paid failed: payment=%d err=%v", po.PaymentId, err)
+		return result, 500, "internal error"
+	}
+	return result, 0, "ok"
+}
@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/svc"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWalletEntriesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListWalletEntriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWalletEntriesLogic {
+	return &ListWalletEntriesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 钱包流水：用户余额账户的分录明细，按时间倒序
+func (l *ListWalletEntriesLogic) ListWalletEntries(in *paymentpb.ListWalletEntriesReq) (*paymentpb.ListWalletEntriesResp, error) {
+	resp := &paymentpb.ListWalletEntriesResp{}
+	if in == nil || in.UserId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	currency := strings.ToUpper(in.Currency)
+	if currency == "" {
+		currency = "CNY"
+	}
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	acc, err := l.svcCtx.Wallet.Balance(l.ctx, in.UserId, currency)
+	if err != nil {
+		return nil, err
+	}
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	if acc.AccountId == 0 {
+		return resp, nil
+	}
+
+	total, err := l.svcCtx.Wallet.Lines().CountByAccount(l.ctx, acc.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := l.svcCtx.Wallet.Lines().ListByAccount(l.ctx, acc.AccountId, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	entryIds := make([]int64, 0, len(lines))
+	for _, line := range lines {
+		entryIds = append(entryIds, line.EntryId)
+	}
+	entries, err := l.svcCtx.Wallet.Entries().ListByIds(l.ctx, entryIds)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[int64]*paymentdal.WalletJournalEntries, len(entries))
+	for _, e := range entries {
+		byId[e.EntryId] = e
+	}
+
+	resp.Total = total
+	resp.Entries = make([]*paymentpb.WalletEntry, 0, len(lines))
+	for _, line := range lines {
+		item := &paymentpb.WalletEntry{
+			Direction:    line.Direction,
+			Amount:       line.Amount,
+			BalanceAfter: line.BalanceAfter,
+			CreatedAt:    line.CreatedAt.Unix(),
+		}
+		if e, ok := byId[line.EntryId]; ok {
+			item.EntryNo = e.EntryNo
+			item.BizType = e.BizType
+			item.BizNo = e.BizNo
+			item.Remark = e.Remark
+		}
+		resp.Entries = append(resp.Entries, item)
+	}
+	return resp, nil
+}
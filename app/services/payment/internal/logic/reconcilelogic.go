@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"NatsumeAI/app/services/payment/internal/reconcile"
+	"NatsumeAI/app/services/payment/internal/svc"
+	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReconcileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReconcileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReconcileLogic {
+	return &ReconcileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReconcileLogic) Reconcile(in *paymentpb.ReconcileReq) (*paymentpb.ReconcileResp, error) {
+	resp := &paymentpb.ReconcileResp{}
+	if in == nil || in.Channel == "" || len(in.Statement) == 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	billDate, err := time.ParseInLocation(time.DateOnly, in.BillDate, time.Local)
+	if err != nil {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid bill date"
+		return resp, nil
+	}
+
+	report, err := reconcile.Run(l.ctx, l.svcCtx, reconcile.Options{
+		Channel:  strings.ToUpper(in.Channel),
+		BillDate: billDate,
+		FileName: in.FileName,
+		AutoFix:  in.AutoFix,
+	}, bytes.NewReader(in.Statement))
+	if err != nil {
+		if errors.Is(err, reconcile.ErrInvalidStatement) {
+			resp.StatusCode = 400
+			resp.StatusMsg = err.Error()
+			resp.Report = toReconcileReport(report, nil)
+			return resp, nil
+		}
+		if report == nil {
+			return nil, err
+		}
+		l.Logger.Errorf("reconcile failed: report=%d err=%v", report.ReportId, err)
+		resp.StatusCode = 500
+		resp.StatusMsg = "reconcile failed"
+		resp.Report = toReconcileReport(report, nil)
+		return resp, nil
+	}
+
+	items, err := l.svcCtx.PaymentReconcileItems.ListByReport(l.ctx, report.ReportId)
+	if err != nil {
+		return nil, err
+	}
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Report = toReconcileReport(report, items)
+	return resp, nil
+}
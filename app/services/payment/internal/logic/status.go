@@ -1,8 +1,15 @@
 package logic
 
 import (
+	"context"
+	"encoding/json"
+	"time"
+
 	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/svc"
 	paymentpb "NatsumeAI/app/services/payment/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
 )
 
 func toProtoStatus(s string) paymentpb.PaymentStatus {
@@ -29,20 +36,149 @@ func toPaymentInfo(po *paymentdal.PaymentOrders) *paymentpb.PaymentInfo {
 		return nil
 	}
 	return &paymentpb.PaymentInfo{
-		PaymentId: po.PaymentId,
-		PaymentNo: po.PaymentNo,
-		Status:    toProtoStatus(po.Status),
-		OrderId:   po.OrderId,
-		UserId:    po.UserId,
-		Amount:    po.Amount,
-		Currency:  po.Currency,
-		Channel:   po.Channel,
-		Credential: func() string {
-			if po.ChannelPayload.Valid {
-				return po.ChannelPayload.String
-			}
-			return ""
-		}(),
-		TimeoutAt: po.TimeoutAt.Unix(),
+		PaymentId:     po.PaymentId,
+		PaymentNo:     po.PaymentNo,
+		Status:        toProtoStatus(po.Status),
+		OrderId:       po.OrderId,
+		UserId:        po.UserId,
+		Amount:        po.Amount,
+		Currency:      po.Currency,
+		Channel:       po.Channel,
+		Credential:    channelPayload(po)["credential"],
+		TimeoutAt:     po.TimeoutAt.Unix(),
+		BizType:       po.BizType,
+		ChannelAmount: po.ChannelAmount,
+		PriceCurrency: po.PriceCurrency,
+		FxRate:        po.FxRate,
+	}
+}
+
+// toPaymentInfoWithSplits 附带组合支付分段，查询失败时只返回支付单本身
+func toPaymentInfoWithSplits(ctx context.Context, svcCtx *svc.ServiceContext, po *paymentdal.PaymentOrders) *paymentpb.PaymentInfo {
+	info := toPaymentInfo(po)
+	if info == nil {
+		return nil
+	}
+	splits, err := svcCtx.PaymentSplits.ListByPayment(ctx, po.PaymentId)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("list payment splits failed: payment=%d err=%v", po.PaymentId, err)
+		return info
+	}
+	for _, s := range splits {
+		info.Splits = append(info.Splits, &paymentpb.PaymentSplit{
+			SplitNo: s.SplitNo,
+			LegNo:   s.LegNo,
+			Channel: s.Channel,
+			Amount:  s.Amount,
+			Points:  s.Points,
+			Status:  s.Status,
+		})
+	}
+	return info
+}
+
+func toProtoRefundStatus(s string) paymentpb.RefundStatus {
+	switch s {
+	case paymentdal.RefundStatusPending:
+		return paymentpb.RefundStatus_REFUND_STATUS_PENDING
+	case paymentdal.RefundStatusProcessing:
+		return paymentpb.RefundStatus_REFUND_STATUS_PROCESSING
+	case paymentdal.RefundStatusSuccess:
+		return paymentpb.RefundStatus_REFUND_STATUS_SUCCESS
+	case paymentdal.RefundStatusFailed:
+		return paymentpb.RefundStatus_REFUND_STATUS_FAILED
+	default:
+		return paymentpb.RefundStatus_REFUND_STATUS_UNKNOWN
+	}
+}
+
+func toRefundInfo(rf *paymentdal.PaymentRefunds) *paymentpb.RefundInfo {
+	if rf == nil {
+		return nil
+	}
+	info := &paymentpb.RefundInfo{
+		RefundId:        rf.RefundId,
+		RefundNo:        rf.RefundNo,
+		RequestNo:       rf.RequestNo,
+		PaymentId:       rf.PaymentId,
+		OrderId:         rf.OrderId,
+		UserId:          rf.UserId,
+		Amount:          rf.Amount,
+		Currency:        rf.Currency,
+		Channel:         rf.Channel,
+		Status:          toProtoRefundStatus(rf.Status),
+		Reason:          rf.Reason,
+		ChannelRefundNo: rf.ChannelRefundNo,
+		SplitId:         rf.SplitId,
+		FxRate:          rf.FxRate,
+		PriceAmount:     rf.PriceAmount,
+	}
+	if !rf.CreatedAt.IsZero() {
+		info.CreatedAt = rf.CreatedAt.Unix()
+	}
+	if rf.FinishedAt.Valid {
+		info.FinishedAt = rf.FinishedAt.Time.Unix()
+	}
+	return info
+}
+
+func toReconcileReport(r *paymentdal.PaymentReconcileReports, items []*paymentdal.PaymentReconcileItems) *paymentpb.ReconcileReport {
+	if r == nil {
+		return nil
+	}
+	report := &paymentpb.ReconcileReport{
+		ReportId:       r.ReportId,
+		Channel:        r.Channel,
+		BillDate:       r.BillDate.Format(time.DateOnly),
+		FileName:       r.FileName,
+		Status:         r.Status,
+		AutoFix:        r.AutoFix == 1,
+		TotalRows:      r.TotalRows,
+		Matched:        r.Matched,
+		Missing:        r.Missing,
+		Extra:          r.Extra,
+		AmountMismatch: r.AmountMismatch,
+		StatusMismatch: r.StatusMismatch,
+		Fixed:          r.Fixed,
+		Error:          r.Error,
+		Items:          make([]*paymentpb.ReconcileItem, 0, len(items)),
+	}
+	if !r.CreatedAt.IsZero() {
+		report.CreatedAt = r.CreatedAt.Unix()
+	}
+	for _, it := range items {
+		report.Items = append(report.Items, &paymentpb.ReconcileItem{
+			PaymentNo:     it.PaymentNo,
+			Kind:          it.Kind,
+			LocalAmount:   it.LocalAmount,
+			ChannelAmount: it.ChannelAmount,
+			LocalStatus:   it.LocalStatus,
+			ChannelStatus: it.ChannelStatus,
+			TradeNo:       it.TradeNo,
+			Fixed:         it.Fixed == 1,
+			Note:          it.Note,
+		})
+	}
+	return report
+}
+
+// channel_payload 保存下单参数与渠道返回的支付凭证
+func channelPayload(po *paymentdal.PaymentOrders) map[string]string {
+	payload := make(map[string]string)
+	if po.ChannelPayload.Valid && po.ChannelPayload.String != "" {
+		_ = json.Unmarshal([]byte(po.ChannelPayload.String), &payload)
+	}
+	return payload
+}
+
+func toWalletInfo(acc *paymentdal.WalletAccounts) *paymentpb.WalletInfo {
+	if acc == nil {
+		return nil
+	}
+	return &paymentpb.WalletInfo{
+		UserId:   acc.UserId,
+		Currency: acc.Currency,
+		Balance:  acc.Balance,
+		Status:   acc.Status,
 	}
 }
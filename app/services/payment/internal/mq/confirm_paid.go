@@ -0,0 +1,50 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/svc"
+	"NatsumeAI/app/services/payment/internal/trade"
+
+	"github.com/hibiken/asynq"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 同步扣款后确认订单的重试次数，按 asynq 默认退避间隔重试
+const confirmPaidMaxRetry = 20
+
+// EnqueueConfirmPaid 同步扣款渠道（钱包、积分）已扣款但确认订单失败时投递，重新执行 MarkPaid 直到订单确认。
+func EnqueueConfirmPaid(sc *svc.ServiceContext, paymentId int64, tradeNo string) error {
+	if sc.AsynqClient == nil {
+		return errors.New("asynq client not configured")
+	}
+	payload, _ := json.Marshal(ConfirmPaidPayload{PaymentId: paymentId, TradeNo: tradeNo})
+	_, err := sc.AsynqClient.Enqueue(asynq.NewTask(TaskConfirmPaid, payload), asynq.Queue("payment"), asynq.MaxRetry(confirmPaidMaxRetry))
+	return err
+}
+
+// 确认已扣款支付单：MarkPaid 幂等，失败返回错误交由 asynq 退避重试。
+func newConfirmPaidHandler(sc *svc.ServiceContext) asynq.HandlerFunc {
+	return func(ctx context.Context, task *asynq.Task) error {
+		var payload ConfirmPaidPayload
+		if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+			return err
+		}
+
+		po, err := sc.PaymentOrders.FindOne(ctx, payload.PaymentId)
+		if err != nil {
+			if err == paymentdal.ErrNotFound {
+				return nil
+			}
+			return err
+		}
+		if _, err := trade.MarkPaid(ctx, sc, po, payload.TradeNo); err != nil {
+			logx.WithContext(ctx).Errorf("confirm paid payment failed: payment=%s err=%v", po.PaymentNo, err)
+			return err
+		}
+		return nil
+	}
+}
@@ -3,10 +3,15 @@ package mq
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
+	paymentdal "NatsumeAI/app/dal/payment"
 	"NatsumeAI/app/services/order/order"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/split"
 	"NatsumeAI/app/services/payment/internal/svc"
+	"NatsumeAI/app/services/payment/internal/trade"
 
 	"github.com/hibiken/asynq"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -31,6 +36,29 @@ func newExpirePaymentHandler(sc *svc.ServiceContext) asynq.HandlerFunc {
 			return nil
 		}
 
+		// 超时前一刻完成支付而通知尚未到达：先查单，已支付则确认订单而不是过期
+		state, err := trade.SyncChannel(ctx, sc, po)
+		switch {
+		case errors.Is(err, provider.ErrUnsupportedChannel):
+		case err != nil:
+			if !retriesExhausted(ctx) {
+				return err
+			}
+			logx.WithContext(ctx).Errorf("expire payment query channel failed, expire anyway: payment=%s err=%v", po.PaymentNo, err)
+		case state == provider.TradeStateSuccess:
+			return nil
+		default:
+			// 关闭渠道交易，避免过期后仍可支付
+			if p, perr := sc.Providers.Get(po.Channel); perr == nil {
+				if cerr := p.Close(ctx, po.PaymentNo); errors.Is(cerr, provider.ErrTradePaid) {
+					_, err := trade.SyncChannel(ctx, sc, po)
+					return err
+				} else if cerr != nil {
+					logx.WithContext(ctx).Errorf("expire payment close channel trade failed: payment=%s err=%v", po.PaymentNo, cerr)
+				}
+			}
+		}
+
 		updated, err := sc.PaymentOrders.UpdateStatus(ctx, po.PaymentId, []string{"INIT", "PROCESSING"}, "EXPIRED")
 		if err != nil {
 			return err
@@ -38,6 +66,12 @@ func newExpirePaymentHandler(sc *svc.ServiceContext) asynq.HandlerFunc {
 		if !updated {
 			return nil
 		}
+		if err := split.Rollback(ctx, sc, po); err != nil {
+			logx.WithContext(ctx).Errorf("expire payment rollback splits failed: payment=%s err=%v", po.PaymentNo, err)
+		}
+		if po.BizType == paymentdal.PaymentBizTopup {
+			return nil
+		}
 
 		_, err = sc.OrderRpc.CancelOrder(ctx, &order.CancelOrderReq{
 			OrderId: payload.OrderId,
@@ -50,3 +84,12 @@ func newExpirePaymentHandler(sc *svc.ServiceContext) asynq.HandlerFunc {
 		return nil
 	}
 }
+
+func retriesExhausted(ctx context.Context) bool {
+	retried, ok := asynq.GetRetryCount(ctx)
+	if !ok {
+		return true
+	}
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	return retried >= maxRetry
+}
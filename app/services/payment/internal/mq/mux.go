@@ -1,6 +1,7 @@
 package mq
 
 import (
+	"NatsumeAI/app/services/payment/internal/refund"
 	"NatsumeAI/app/services/payment/internal/svc"
 
 	"github.com/hibiken/asynq"
@@ -9,5 +10,8 @@ import (
 func NewAsynqMux(sc *svc.ServiceContext) *asynq.ServeMux {
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(TaskExpirePayment, newExpirePaymentHandler(sc))
+	mux.HandleFunc(refund.TaskProcess, refund.NewProcessHandler(sc))
+	mux.HandleFunc(TaskPollPayment, newPollPaymentHandler(sc))
+	mux.HandleFunc(TaskConfirmPaid, newConfirmPaidHandler(sc))
 	return mux
 }
@@ -0,0 +1,67 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/svc"
+	"NatsumeAI/app/services/payment/internal/trade"
+
+	"github.com/hibiken/asynq"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 处理中支付单的查单间隔，超过列表长度后按最后一项间隔继续，直到支付超时交由过期任务处理
+var pollDelays = []time.Duration{
+	15 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	2 * time.Minute,
+	5 * time.Minute,
+}
+
+// EnqueuePaymentPoll 安排第 attempt 次查单，超过支付超时时间则不再安排。
+func EnqueuePaymentPoll(sc *svc.ServiceContext, paymentId int64, attempt int, timeoutAt time.Time) error {
+	if sc.AsynqClient == nil {
+		return errors.New("asynq client not configured")
+	}
+	delay := pollDelays[min(attempt, len(pollDelays)-1)]
+	if !time.Now().Add(delay).Before(timeoutAt) {
+		return nil
+	}
+	payload, _ := json.Marshal(PollPaymentPayload{PaymentId: paymentId, Attempt: attempt})
+	_, err := sc.AsynqClient.Enqueue(asynq.NewTask(TaskPollPayment, payload), asynq.ProcessIn(delay), asynq.Queue("payment"), asynq.MaxRetry(0))
+	return err
+}
+
+// 主动查单：处理中的支付单在通知到达前按间隔向渠道查询，已支付则确认订单。
+func newPollPaymentHandler(sc *svc.ServiceContext) asynq.HandlerFunc {
+	return func(ctx context.Context, task *asynq.Task) error {
+		var payload PollPaymentPayload
+		if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+			return err
+		}
+
+		po, err := sc.PaymentOrders.FindOne(ctx, payload.PaymentId)
+		if err != nil {
+			return nil
+		}
+		if po.Status != "PROCESSING" {
+			return nil
+		}
+
+		state, err := trade.SyncChannel(ctx, sc, po)
+		if errors.Is(err, provider.ErrUnsupportedChannel) {
+			return nil
+		}
+		if err != nil {
+			logx.WithContext(ctx).Errorf("poll payment failed: payment=%s attempt=%d err=%v", po.PaymentNo, payload.Attempt, err)
+		} else if state == provider.TradeStateSuccess {
+			return nil
+		}
+		return EnqueuePaymentPoll(sc, po.PaymentId, payload.Attempt+1, po.TimeoutAt)
+	}
+}
@@ -1,9 +1,23 @@
 package mq
 
-const TaskExpirePayment = "payment:expire_payment"
+const (
+	TaskExpirePayment = "payment:expire_payment"
+	TaskPollPayment   = "payment:poll_payment"
+	TaskConfirmPaid   = "payment:confirm_paid"
+)
 
 type ExpirePaymentPayload struct {
 	PaymentId int64 `json:"payment_id"`
 	OrderId   int64 `json:"order_id"`
 	UserId    int64 `json:"user_id"`
 }
+
+type PollPaymentPayload struct {
+	PaymentId int64 `json:"payment_id"`
+	Attempt   int   `json:"attempt"`
+}
+
+type ConfirmPaidPayload struct {
+	PaymentId int64  `json:"payment_id"`
+	TradeNo   string `json:"trade_no"`
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	alipayTimeLayout    = "2006-01-02 15:04:05"
+	alipayCodeSuccess   = "10000"
+	alipayTradeNotExist = "ACQ.TRADE_NOT_EXIST"
+)
+
+// Alipay 支付宝开放平台适配器（电脑网站支付，RSA2 签名）。
+type Alipay struct {
+	conf       AlipayConf
+	notifyUrl  string
+	privateKey *rsa.PrivateKey
+	publicKey  *rsa.PublicKey
+	client     *http.Client
+}
+
+type alipayResponse struct {
+	Code         string `json:"code"`
+	Msg          string `json:"msg"`
+	SubCode      string `json:"sub_code"`
+	SubMsg       string `json:"sub_msg"`
+	TradeNo      string `json:"trade_no"`
+	OutTradeNo   string `json:"out_trade_no"`
+	TradeStatus  string `json:"trade_status"`
+	TotalAmount  string `json:"total_amount"`
+	SendPayDate  string `json:"send_pay_date"`
+	FundChange   string `json:"fund_change"`
+	RefundAmount string `json:"refund_fee"`
+	RefundStatus string `json:"refund_status"`
+}
+
+func NewAlipay(c AlipayConf, notifyUrl string) *Alipay {
+	return &Alipay{
+		conf:       c,
+		notifyUrl:  notifyUrl,
+		privateKey: parseRSAPrivateKey(c.PrivateKey),
+		publicKey:  parseRSAPublicKey(c.AlipayPublicKey),
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (a *Alipay) Channel() string {
+	return ChannelAlipay
+}
+
+// Create 生成电脑网站支付链接，浏览器打开即跳转支付宝收银台。
+func (a *Alipay) Create(ctx context.Context, req *CreateRequest) (*CreateResult, error) {
+	biz := map[string]any{
+		"out_trade_no": req.PaymentNo,
+		"total_amount": centsToYuan(req.Amount),
+		"subject":      subjectOrDefault(req.Subject, req.PaymentNo),
+		"product_code": "FAST_INSTANT_TRADE_PAY",
+	}
+	if !req.ExpireAt.IsZero() {
+		biz["time_expire"] = req.ExpireAt.Format(alipayTimeLayout)
+	}
+	notify := req.NotifyUrl
+	if notify == "" {
+		notify = a.notifyUrl
+	}
+	params, err := a.signedParams("alipay.trade.page.pay", biz, notify)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateResult{Credential: a.conf.Gateway + "?" + params.Encode()}, nil
+}
+
+func (a *Alipay) Query(ctx context.Context, paymentNo string) (*QueryResult, error) {
+	resp, err := a.call(ctx, "alipay.trade.query", map[string]any{"out_trade_no": paymentNo})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != alipayCodeSuccess {
+		if resp.SubCode == alipayTradeNotExist {
+			return &QueryResult{State: TradeStateNotFound}, nil
+		}
+		return nil, resp.err()
+	}
+	res := &QueryResult{
+		State:      alipayTradeState(resp.TradeStatus),
+		TradeNo:    resp.TradeNo,
+		PaidAmount: yuanToCents(resp.TotalAmount),
+	}
+	if t, err := time.ParseInLocation(alipayTimeLayout, resp.SendPayDate, time.Local); err == nil {
+		res.PaidAt = t
+	}
+	return res, nil
+}
+
+func (a *Alipay) Close(ctx context.Context, paymentNo string) error {
+	resp, err := a.call(ctx, "alipay.trade.close", map[string]any{"out_trade_no": paymentNo})
+	if err != nil {
+		return err
+	}
+	if resp.Code == alipayCodeSuccess || resp.SubCode == alipayTradeNotExist {
+		return nil
+	}
+	if resp.SubCode == "ACQ.TRADE_STATUS_ERROR" {
+		// 交易已支付或已结束，以查单结果为准
+		if q, err := a.Query(ctx, paymentNo); err == nil && q.State == TradeStateSuccess {
+			return ErrTradePaid
+		}
+		return nil
+	}
+	return resp.err()
+}
+
+func (a *Alipay) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	resp, err := a.call(ctx, "alipay.trade.refund", map[string]any{
+		"out_trade_no":   req.PaymentNo,
+		"out_request_no": req.RefundNo,
+		"refund_amount":  centsToYuan(req.RefundAmount),
+		"refund_reason":  req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != alipayCodeSuccess {
+		return &RefundResult{State: RefundStateFailed}, resp.err()
+	}
+	// 支付宝退款同步返回结果，fund_change=N 表示此前已退过（幂等重试）
+	return &RefundResult{State: RefundStateSuccess, ChannelRefund: resp.TradeNo}, nil
+}
+
+func (a *Alipay) QueryRefund(ctx context.Context, paymentNo, refundNo string) (*RefundResult, error) {
+	resp, err := a.call(ctx, "alipay.trade.fastpay.refund.query", map[string]any{
+		"out_trade_no":   paymentNo,
+		"out_request_no": refundNo,
+		"query_options":  []string{"gmt_refund_pay"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != alipayCodeSuccess {
+		return nil, resp.err()
+	}
+	// 未返回 refund_status 表示退款请求不存在或仍在处理
+	if resp.RefundStatus == "REFUND_SUCCESS" {
+		return &RefundResult{State: RefundStateSuccess, ChannelRefund: resp.TradeNo}, nil
+	}
+	return &RefundResult{State: RefundStateProcessing}, nil
+}
+
+// VerifyNotify 校验支付宝异步通知（application/x-www-form-urlencoded）。
+func (a *Alipay) VerifyNotify(ctx context.Context, req *NotifyRequest) (*Notification, error) {
+	values, err := url.ParseQuery(string(req.Body))
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string, len(values))
+	for k := range values {
+		params[k] = values.Get(k)
+	}
+	if err := a.verify(canonicalQuery(params, "sign", "sign_type"), params["sign"]); err != nil {
+		return nil, err
+	}
+	if a.conf.AppId != "" && params["app_id"] != a.conf.AppId {
+		return nil, ErrInvalidSign
+	}
+
+	n := &Notification{
+		PaymentNo: params["out_trade_no"],
+		TradeNo:   params["trade_no"],
+		State:     alipayTradeState(params["trade_status"]),
+		Amount:    yuanToCents(params["total_amount"]),
+	}
+	if t, err := time.ParseInLocation(alipayTimeLayout, params["gmt_payment"], time.Local); err == nil {
+		n.PaidAt = t
+	}
+	return n, nil
+}
+
+func (a *Alipay) NotifyAck(success bool) string {
+	if success {
+		return "success"
+	}
+	return "failure"
+}
+
+func (a *Alipay) call(ctx context.Context, method string, biz map[string]any) (*alipayResponse, error) {
+	params, err := a.signedParams(method, biz, "")
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, a.conf.Gateway, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
+
+	httpResp, err := a.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// 响应形如 {"alipay_trade_query_response": {...}, "sign": "..."}
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("alipay %s: decode response: %w", method, err)
+	}
+	raw, ok := envelope[strings.ReplaceAll(method, ".", "_")+"_response"]
+	if !ok {
+		return nil, fmt.Errorf("alipay %s: unexpected response %s", method, string(body))
+	}
+	var resp alipayResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (a *Alipay) signedParams(method string, biz map[string]any, notify string) (url.Values, error) {
+	if a.privateKey == nil {
+		return nil, errors.New("alipay private key not configured")
+	}
+	bizContent, err := json.Marshal(biz)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{
+		"app_id":      a.conf.AppId,
+		"method":      method,
+		"format":      "JSON",
+		"charset":     "utf-8",
+		"sign_type":   "RSA2",
+		"timestamp":   time.Now().Format(alipayTimeLayout),
+		"version":     "1.0",
+		"notify_url":  notify,
+		"biz_content": string(bizContent),
+	}
+	if method == "alipay.trade.page.pay" {
+		params["return_url"] = a.conf.ReturnUrl
+	}
+
+	digest := sha256.Sum256([]byte(canonicalQuery(params)))
+	sign, err := rsa.SignPKCS1v15(nil, a.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	params["sign"] = base64.StdEncoding.EncodeToString(sign)
+
+	values := make(url.Values, len(params))
+	for k, v := range params {
+		if v != "" {
+			values.Set(k, v)
+		}
+	}
+	return values, nil
+}
+
+func (a *Alipay) verify(content, sign string) error {
+	if a.publicKey == nil || sign == "" {
+		return ErrInvalidSign
+	}
+	raw, err := base64.StdEncoding.DecodeString(sign)
+	if err != nil {
+		return ErrInvalidSign
+	}
+	digest := sha256.Sum256([]byte(content))
+	if err := rsa.VerifyPKCS1v15(a.publicKey, crypto.SHA256, digest[:], raw); err != nil {
+		return ErrInvalidSign
+	}
+	return nil
+}
+
+func (r *alipayResponse) err() error {
+	return fmt.Errorf("alipay error: code=%s msg=%s sub_code=%s sub_msg=%s", r.Code, r.Msg, r.SubCode, r.SubMsg)
+}
+
+func alipayTradeState(status string) TradeState {
+	switch status {
+	case "TRADE_SUCCESS", "TRADE_FINISHED":
+		return TradeStateSuccess
+	case "TRADE_CLOSED":
+		return TradeStateClosed
+	default:
+		return TradeStatePending
+	}
+}
+
+func subjectOrDefault(subject, paymentNo string) string {
+	if subject != "" {
+		return subject
+	}
+	return "订单支付 " + paymentNo
+}
+
+// 兼容带/不带 PEM 头的密钥文本
+func pemBlock(key, kind string) *pem.Block {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil
+	}
+	if !strings.HasPrefix(key, "-----BEGIN") {
+		key = "-----BEGIN " + kind + "-----\n" + key + "\n-----END " + kind + "-----"
+	}
+	block, _ := pem.Decode([]byte(key))
+	return block
+}
+
+func parseRSAPrivateKey(key string) *rsa.PrivateKey {
+	block := pemBlock(key, "PRIVATE KEY")
+	if block == nil {
+		return nil
+	}
+	if pk, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return pk
+	}
+	if pk, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if rsaKey, ok := pk.(*rsa.PrivateKey); ok {
+			return rsaKey
+		}
+	}
+	return nil
+}
+
+func parseRSAPublicKey(key string) *rsa.PublicKey {
+	block := pemBlock(key, "PUBLIC KEY")
+	if block == nil {
+		return nil
+	}
+	if pub, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		if rsaKey, ok := pub.(*rsa.PublicKey); ok {
+			return rsaKey
+		}
+	}
+	if pub, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return pub
+	}
+	return nil
+}
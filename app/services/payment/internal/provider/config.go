@@ -0,0 +1,45 @@
+package provider
+
+type (
+	// Conf 支付渠道配置，未启用的渠道不会注册。
+	Conf struct {
+		// NotifyUrl 渠道异步通知的基础地址，实际地址为 {NotifyUrl}/{channel}
+		NotifyUrl string     `json:",optional"`
+		Alipay    AlipayConf `json:",optional"`
+		Wechat    WechatConf `json:",optional"`
+		Mock      MockConf   `json:",optional"`
+	}
+
+	AlipayConf struct {
+		Enabled bool   `json:",optional"`
+		AppId   string `json:",optional"`
+		// PrivateKey 应用私钥（PEM，PKCS1 或 PKCS8）
+		PrivateKey string `json:",optional"`
+		// AlipayPublicKey 支付宝公钥（PEM），用于通知验签
+		AlipayPublicKey string `json:",optional"`
+		Gateway         string `json:",default=https://openapi.alipay.com/gateway.do"`
+		ReturnUrl       string `json:",optional"`
+	}
+
+	WechatConf struct {
+		Enabled bool   `json:",optional"`
+		AppId   string `json:",optional"`
+		MchId   string `json:",optional"`
+		// ApiKey 商户 API 密钥，用于 HMAC-SHA256 签名
+		ApiKey  string `json:",optional"`
+		Gateway string `json:",default=https://api.mch.weixin.qq.com"`
+		// CertFile/KeyFile 退款接口需要的商户证书
+		CertFile string `json:",optional"`
+		KeyFile  string `json:",optional"`
+	}
+
+	MockConf struct {
+		Enabled bool `json:",optional"`
+		// ListenOn 模拟收银台监听地址
+		ListenOn string `json:",default=0.0.0.0:12106"`
+		// BaseUrl 浏览器访问模拟收银台的地址
+		BaseUrl string `json:",default=http://localhost:12106"`
+		// Secret 模拟通知签名密钥，开启模拟渠道时必须配置
+		Secret string `json:",optional"`
+	}
+)
@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+const mockNotifyRetries = 3
+
+// Mock 内置模拟网关：交易保存在内存中，自带收银台页面，确认支付后向通知地址推送签名通知。
+type Mock struct {
+	conf      MockConf
+	notifyUrl string
+	client    *http.Client
+
+	mu     sync.Mutex
+	trades map[string]*mockTrade
+}
+
+type mockTrade struct {
+	PaymentNo string
+	Subject   string
+	Amount    int64
+	Currency  string
+	NotifyUrl string
+	ExpireAt  time.Time
+	State     TradeState
+	TradeNo   string
+	PaidAt    time.Time
+	Refunded  int64
+	Refunds   map[string]int64
+}
+
+// MockNotification 模拟网关推送的通知报文（JSON）。
+type MockNotification struct {
+	PaymentNo string `json:"payment_no"`
+	TradeNo   string `json:"trade_no"`
+	State     string `json:"state"`
+	Amount    int64  `json:"amount"`
+	PaidAt    int64  `json:"paid_at"`
+	Sign      string `json:"sign"`
+}
+
+func NewMock(c MockConf, notifyUrl string) *Mock {
+	return &Mock{
+		conf:      c,
+		notifyUrl: notifyUrl,
+		client:    &http.Client{Timeout: 5 * time.Second},
+		trades:    make(map[string]*mockTrade),
+	}
+}
+
+func (m *Mock) Channel() string {
+	return ChannelMock
+}
+
+func (m *Mock) Create(ctx context.Context, req *CreateRequest) (*CreateResult, error) {
+	notify := req.NotifyUrl
+	if notify == "" {
+		notify = m.notifyUrl
+	}
+
+	m.mu.Lock()
+	if _, ok := m.trades[req.PaymentNo]; !ok {
+		m.trades[req.PaymentNo] = &mockTrade{
+			PaymentNo: req.PaymentNo,
+			Subject:   subjectOrDefault(req.Subject, req.PaymentNo),
+			Amount:    req.Amount,
+			Currency:  req.Currency,
+			NotifyUrl: notify,
+			ExpireAt:  req.ExpireAt,
+			State:     TradeStatePending,
+			Refunds:   make(map[string]int64),
+		}
+	}
+	m.mu.Unlock()
+
+	return &CreateResult{Credential: m.PayUrl(req.PaymentNo)}, nil
+}
+
+func (m *Mock) Query(ctx context.Context, paymentNo string) (*QueryResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.trades[paymentNo]
+	if !ok {
+		return &QueryResult{State: TradeStateNotFound}, nil
+	}
+	m.expireLocked(t)
+	res := &QueryResult{State: t.State, TradeNo: t.TradeNo, PaidAt: t.PaidAt}
+	if t.State == TradeStateSuccess {
+		res.PaidAmount = t.Amount
+	}
+	return res, nil
+}
+
+func (m *Mock) Close(ctx context.Context, paymentNo string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.trades[paymentNo]
+	if !ok {
+		return nil
+	}
+	if t.State == TradeStateSuccess {
+		return ErrTradePaid
+	}
+	t.State = TradeStateClosed
+	return nil
+}
+
+func (m *Mock) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.trades[req.PaymentNo]
+	if !ok || t.State != TradeStateSuccess {
+		return &RefundResult{State: RefundStateFailed}, errors.New("mock trade not paid")
+	}
+	// 同一退款单号重复请求视为成功
+	if _, done := t.Refunds[req.RefundNo]; done {
+		return &RefundResult{State: RefundStateSuccess, ChannelRefund: "MOCKREFUND" + req.RefundNo}, nil
+	}
+	if req.RefundAmount <= 0 || t.Refunded+req.RefundAmount > t.Amount {
+		return &RefundResult{State: RefundStateFailed}, errors.New("mock refund amount exceeds paid amount")
+	}
+	t.Refunded += req.RefundAmount
+	t.Refunds[req.RefundNo] = req.RefundAmount
+	return &RefundResult{State: RefundStateSuccess, ChannelRefund: "MOCKREFUND" + req.RefundNo}, nil
+}
+
+func (m *Mock) QueryRefund(ctx context.Context, paymentNo, refundNo string) (*RefundResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.trades[paymentNo]
+	if !ok {
+		return &RefundResult{State: RefundStateFailed}, nil
+	}
+	if _, done := t.Refunds[refundNo]; !done {
+		return &RefundResult{State: RefundStateFailed}, nil
+	}
+	return &RefundResult{State: RefundStateSuccess, ChannelRefund: "MOCKREFUND" + refundNo}, nil
+}
+
+func (m *Mock) VerifyNotify(ctx context.Context, req *NotifyRequest) (*Notification, error) {
+	var msg MockNotification
+	if err := json.Unmarshal(req.Body, &msg); err != nil {
+		return nil, err
+	}
+	if msg.Sign == "" || msg.Sign != m.sign(&msg) {
+		return nil, ErrInvalidSign
+	}
+	n := &Notification{
+		PaymentNo: msg.PaymentNo,
+		TradeNo:   msg.TradeNo,
+		State:     TradeState(msg.State),
+		Amount:    msg.Amount,
+	}
+	if msg.PaidAt > 0 {
+		n.PaidAt = time.Unix(msg.PaidAt, 0)
+	}
+	return n, nil
+}
+
+func (m *Mock) NotifyAck(success bool) string {
+	if success {
+		return "success"
+	}
+	return "fail"
+}
+
+// PayUrl returns the mock cashier page of the payment.
+func (m *Mock) PayUrl(paymentNo string) string {
+	return strings.TrimRight(m.conf.BaseUrl, "/") + "/mock/pay/" + paymentNo
+}
+
+// Handler serves the mock cashier:
+//   - GET  /mock/pay/{paymentNo}          收银台页面
+//   - POST /mock/pay/{paymentNo}/confirm  确认支付并推送成功通知
+//   - POST /mock/pay/{paymentNo}/cancel   放弃支付（交易保持待支付）
+func (m *Mock) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /mock/pay/{paymentNo}", m.servePage)
+	mux.HandleFunc("POST /mock/pay/{paymentNo}/confirm", m.serveConfirm)
+	mux.HandleFunc("POST /mock/pay/{paymentNo}/cancel", m.serveCancel)
+	return mux
+}
+
+func (m *Mock) servePage(w http.ResponseWriter, r *http.Request) {
+	paymentNo := r.PathValue("paymentNo")
+
+	m.mu.Lock()
+	t, ok := m.trades[paymentNo]
+	var view mockPageView
+	if ok {
+		m.expireLocked(t)
+		view = m.viewLocked(t, "")
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	renderMockPage(w, view)
+}
+
+func (m *Mock) serveConfirm(w http.ResponseWriter, r *http.Request) {
+	paymentNo := r.PathValue("paymentNo")
+
+	m.mu.Lock()
+	t, ok := m.trades[paymentNo]
+	if !ok {
+		m.mu.Unlock()
+		http.NotFound(w, r)
+		return
+	}
+	m.expireLocked(t)
+	message := "支付成功"
+	notify := false
+	switch t.State {
+	case TradeStatePending:
+		t.State = TradeStateSuccess
+		t.PaidAt = time.Now()
+		t.TradeNo = "MOCK" + strconv.FormatInt(t.PaidAt.UnixNano(), 10)
+		notify = true
+	case TradeStateClosed:
+		message = "交易已关闭"
+	}
+	view := m.viewLocked(t, message)
+	msg := m.notificationLocked(t)
+	notifyUrl := t.NotifyUrl
+	m.mu.Unlock()
+
+	if notify {
+		threading.GoSafe(func() {
+			m.sendNotify(notifyUrl, msg)
+		})
+	}
+	renderMockPage(w, view)
+}
+
+func (m *Mock) serveCancel(w http.ResponseWriter, r *http.Request) {
+	paymentNo := r.PathValue("paymentNo")
+
+	m.mu.Lock()
+	t, ok := m.trades[paymentNo]
+	var view mockPageView
+	if ok {
+		view = m.viewLocked(t, "已放弃支付，可在超时前重新支付")
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	renderMockPage(w, view)
+}
+
+// 推送支付成功通知，失败时按 1s/2s/4s 退避重试
+func (m *Mock) sendNotify(notifyUrl string, msg *MockNotification) {
+	if notifyUrl == "" {
+		logx.Infof("mock gateway notify skipped, no notify url: payment_no=%s", msg.PaymentNo)
+		return
+	}
+	body, _ := json.Marshal(msg)
+	backoff := time.Second
+	for i := 0; i < mockNotifyRetries; i++ {
+		resp, err := m.client.Post(notifyUrl, "application/json", bytes.NewReader(body))
+		if err == nil {
+			var buf bytes.Buffer
+			_, _ = buf.ReadFrom(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK && strings.TrimSpace(buf.String()) == m.NotifyAck(true) {
+				return
+			}
+			err = fmt.Errorf("status=%d body=%s", resp.StatusCode, buf.String())
+		}
+		logx.Errorf("mock gateway notify failed: payment_no=%s attempt=%d err=%v", msg.PaymentNo, i+1, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (m *Mock) notificationLocked(t *mockTrade) *MockNotification {
+	msg := &MockNotification{
+		PaymentNo: t.PaymentNo,
+		TradeNo:   t.TradeNo,
+		State:     string(t.State),
+		Amount:    t.Amount,
+	}
+	if !t.PaidAt.IsZero() {
+		msg.PaidAt = t.PaidAt.Unix()
+	}
+	msg.Sign = m.sign(msg)
+	return msg
+}
+
+func (m *Mock) sign(msg *MockNotification) string {
+	return hmacSHA256Hex(m.conf.Secret, canonicalQuery(map[string]string{
+		"payment_no": msg.PaymentNo,
+		"trade_no":   msg.TradeNo,
+		"state":      msg.State,
+		"amount":     strconv.FormatInt(msg.Amount, 10),
+		"paid_at":    strconv.FormatInt(msg.PaidAt, 10),
+	}))
+}
+
+func (m *Mock) expireLocked(t *mockTrade) {
+	if t.State == TradeStatePending && !t.ExpireAt.IsZero() && time.Now().After(t.ExpireAt) {
+		t.State = TradeStateClosed
+	}
+}
+
+type mockPageView struct {
+	PaymentNo string
+	Subject   string
+	Amount    string
+	Currency  string
+	State     string
+	ExpireAt  string
+	Message   string
+	Payable   bool
+}
+
+func (m *Mock) viewLocked(t *mockTrade, message string) mockPageView {
+	v := mockPageView{
+		PaymentNo: t.PaymentNo,
+		Subject:   t.Subject,
+		Amount:    centsToYuan(t.Amount),
+		Currency:  t.Currency,
+		State:     string(t.State),
+		Message:   message,
+		Payable:   t.State == TradeStatePending,
+	}
+	if !t.ExpireAt.IsZero() {
+		v.ExpireAt = t.ExpireAt.Format("2006-01-02 15:04:05")
+	}
+	return v
+}
+
+var mockPageTmpl = template.Must(template.New("mock").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>模拟收银台</title></head>
+<body style="font-family: sans-serif; max-width: 480px; margin: 40px auto;">
+  <h2>模拟收银台</h2>
+  <p>支付单号：{{.PaymentNo}}</p>
+  <p>商品：{{.Subject}}</p>
+  <p>金额：{{.Amount}} {{.Currency}}</p>
+  <p>状态：{{.State}}</p>
+  {{if .ExpireAt}}<p>过期时间：{{.ExpireAt}}</p>{{end}}
+  {{if .Message}}<p><strong>{{.Message}}</strong></p>{{end}}
+  {{if .Payable}}
+  <form method="post" action="/mock/pay/{{.PaymentNo}}/confirm" style="display: inline;">
+    <button type="submit">确认支付</button>
+  </form>
+  <form method="post" action="/mock/pay/{{.PaymentNo}}/cancel" style="display: inline;">
+    <button type="submit">放弃支付</button>
+  </form>
+  {{end}}
+</body>
+</html>`))
+
+func renderMockPage(w http.ResponseWriter, view mockPageView) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := mockPageTmpl.Execute(w, view); err != nil {
+		logx.Errorf("render mock cashier failed: %v", err)
+	}
+}
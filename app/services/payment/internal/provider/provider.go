@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 支付渠道名称，与 payment_orders.channel 保持一致（大写）
+const (
+	ChannelAlipay = "ALIPAY"
+	ChannelWechat = "WECHAT"
+	ChannelMock   = "MOCK"
+	// ChannelWallet 平台钱包余额、ChannelPoints 积分抵扣，由 wallet 包注册
+	ChannelWallet = "WALLET"
+	ChannelPoints = "POINTS"
+)
+
+var (
+	// ErrUnsupportedChannel 渠道未配置或未启用。
+	ErrUnsupportedChannel = errors.New("unsupported payment channel")
+	// ErrInvalidSign 渠道通知验签失败。
+	ErrInvalidSign = errors.New("invalid notification signature")
+	// ErrTradePaid 关闭交易时渠道侧已支付成功。
+	ErrTradePaid = errors.New("trade already paid")
+)
+
+// TradeState 渠道侧交易状态
+type TradeState string
+
+const (
+	TradeStatePending  TradeState = "PENDING"
+	TradeStateSuccess  TradeState = "SUCCESS"
+	TradeStateClosed   TradeState = "CLOSED"
+	TradeStateNotFound TradeState = "NOT_FOUND"
+)
+
+// RefundState 渠道侧退款状态
+type RefundState string
+
+const (
+	RefundStateProcessing RefundState = "PROCESSING"
+	RefundStateSuccess    RefundState = "SUCCESS"
+	RefundStateFailed     RefundState = "FAILED"
+)
+
+type (
+	// Provider 支付渠道适配器：下单、查单、关单、退款、通知验签。
+	Provider interface {
+		// Channel returns the channel name handled by the provider.
+		Channel() string
+		// Create places a prepay order at the channel and returns the credential used by the client to pay.
+		Create(ctx context.Context, req *CreateRequest) (*CreateResult, error)
+		// Query returns the channel side state of a trade.
+		Query(ctx context.Context, paymentNo string) (*QueryResult, error)
+		// Close closes an unpaid trade. Returns ErrTradePaid if the trade was paid already.
+		Close(ctx context.Context, paymentNo string) error
+		// Refund requests a (partial) refund, idempotent by RefundNo.
+		Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error)
+		// QueryRefund returns the channel side state of a refund.
+		QueryRefund(ctx context.Context, paymentNo, refundNo string) (*RefundResult, error)
+		// VerifyNotify verifies and parses an asynchronous payment notification.
+		VerifyNotify(ctx context.Context, req *NotifyRequest) (*Notification, error)
+		// NotifyAck returns the response body the channel expects after a notification.
+		NotifyAck(success bool) string
+	}
+
+	CreateRequest struct {
+		PaymentNo string
+		UserId    int64
+		Amount    int64 // 单位分
+		Currency  string
+		Subject   string
+		ClientIp  string
+		ExpireAt  time.Time
+		NotifyUrl string
+	}
+
+	CreateResult struct {
+		// Credential 客户端拉起支付所需的凭证：支付链接、二维码链接等
+		Credential string
+		// Paid 渠道下单时已同步完成扣款（如钱包余额），无需等待异步通知
+		Paid    bool
+		TradeNo string
+	}
+
+	QueryResult struct {
+		State      TradeState
+		TradeNo    string
+		PaidAmount int64
+		PaidAt     time.Time
+	}
+
+	RefundRequest struct {
+		PaymentNo    string
+		RefundNo     string
+		UserId       int64
+		Currency     string
+		TotalAmount  int64
+		RefundAmount int64
+		Reason       string
+	}
+
+	RefundResult struct {
+		State         RefundState
+		ChannelRefund string
+	}
+
+	NotifyRequest struct {
+		Header map[string]string
+		Body   []byte
+	}
+
+	Notification struct {
+		PaymentNo string
+		TradeNo   string
+		State     TradeState
+		Amount    int64
+		PaidAt    time.Time
+	}
+)
+
+// Registry 按渠道名查找已启用的支付渠道。
+type Registry struct {
+	providers map[string]Provider
+	mock      *Mock
+}
+
+// NewRegistry builds providers enabled in the config.
+func NewRegistry(c Conf) *Registry {
+	r := &Registry{providers: make(map[string]Provider)}
+	if c.Alipay.Enabled {
+		r.Register(NewAlipay(c.Alipay, notifyUrl(c.NotifyUrl, ChannelAlipay)))
+	}
+	if c.Wechat.Enabled {
+		r.Register(NewWechat(c.Wechat, notifyUrl(c.NotifyUrl, ChannelWechat)))
+	}
+	if c.Mock.Enabled {
+		// 密钥为空时任何人都能伪造模拟通知，拒绝启动
+		if c.Mock.Secret == "" {
+			panic("PayChannels.Mock.Secret is required when the mock channel is enabled")
+		}
+		r.mock = NewMock(c.Mock, notifyUrl(c.NotifyUrl, ChannelMock))
+		r.Register(r.mock)
+	}
+	return r
+}
+
+// Register adds or replaces a provider.
+func (r *Registry) Register(p Provider) {
+	r.providers[strings.ToUpper(p.Channel())] = p
+}
+
+// Get returns the provider of the channel.
+func (r *Registry) Get(channel string) (Provider, error) {
+	if p, ok := r.providers[strings.ToUpper(channel)]; ok {
+		return p, nil
+	}
+	return nil, ErrUnsupportedChannel
+}
+
+// Channels returns names of the enabled channels.
+func (r *Registry) Channels() []string {
+	channels := make([]string, 0, len(r.providers))
+	for channel := range r.providers {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
+// IsInternal reports whether the channel settles synchronously inside the platform (wallet, points).
+func IsInternal(channel string) bool {
+	switch strings.ToUpper(channel) {
+	case ChannelWallet, ChannelPoints:
+		return true
+	}
+	return false
+}
+
+// Mock returns the built-in mock gateway, nil when disabled.
+func (r *Registry) Mock() *Mock {
+	return r.mock
+}
+
+// 通知地址按渠道区分：{NotifyUrl}/{channel}
+func notifyUrl(base, channel string) string {
+	if base == "" {
+		return ""
+	}
+	return strings.TrimRight(base, "/") + "/" + strings.ToLower(channel)
+}
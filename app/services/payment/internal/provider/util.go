@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 按 key 字典序拼接 k=v&k=v，跳过空值与排除的 key
+func canonicalQuery(params map[string]string, exclude ...string) string {
+	keys := make([]string, 0, len(params))
+	for k, v := range params {
+		if v == "" || contains(exclude, k) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(params[k])
+	}
+	return b.String()
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func hmacSHA256Hex(secret, content string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(content))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func nonceStr() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// 分 -> 元，保留两位小数
+func centsToYuan(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// 元 -> 分，兼容 "12"、"12.3"、"12.34"
+func yuanToCents(yuan string) int64 {
+	yuan = strings.TrimSpace(yuan)
+	if yuan == "" {
+		return 0
+	}
+	intPart, fracPart, _ := strings.Cut(yuan, ".")
+	yuanVal, _ := strconv.ParseInt(intPart, 10, 64)
+	fracPart = (fracPart + "00")[:2]
+	centVal, _ := strconv.ParseInt(fracPart, 10, 64)
+	return yuanVal*100 + centVal
+}
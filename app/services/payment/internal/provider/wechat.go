@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const wechatTimeLayout = "20060102150405"
+
+// Wechat 微信支付 V2 适配器（Native 扫码支付，HMAC-SHA256 签名，XML 报文）。
+type Wechat struct {
+	conf       WechatConf
+	notifyUrl  string
+	client     *http.Client
+	certClient *http.Client
+}
+
+func NewWechat(c WechatConf, notifyUrl string) *Wechat {
+	w := &Wechat{
+		conf:      c,
+		notifyUrl: notifyUrl,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+	// 退款接口需要双向证书
+	if c.CertFile != "" && c.KeyFile != "" {
+		if cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile); err == nil {
+			w.certClient = &http.Client{
+				Timeout: 10 * time.Second,
+				Transport: &http.Transport{
+					TLSClientConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+				},
+			}
+		}
+	}
+	return w
+}
+
+func (w *Wechat) Channel() string {
+	return ChannelWechat
+}
+
+// Create 统一下单（NATIVE），返回二维码链接 code_url。
+func (w *Wechat) Create(ctx context.Context, req *CreateRequest) (*CreateResult, error) {
+	notify := req.NotifyUrl
+	if notify == "" {
+		notify = w.notifyUrl
+	}
+	params := map[string]string{
+		"body":             subjectOrDefault(req.Subject, req.PaymentNo),
+		"out_trade_no":     req.PaymentNo,
+		"total_fee":        strconv.FormatInt(req.Amount, 10),
+		"spbill_create_ip": req.ClientIp,
+		"notify_url":       notify,
+		"trade_type":       "NATIVE",
+		"product_id":       req.PaymentNo,
+	}
+	if req.Currency != "" {
+		params["fee_type"] = req.Currency
+	}
+	if !req.ExpireAt.IsZero() {
+		params["time_expire"] = req.ExpireAt.Format(wechatTimeLayout)
+	}
+	resp, err := w.call(ctx, w.client, "/pay/unifiedorder", params)
+	if err != nil {
+		return nil, err
+	}
+	if err := wechatResultErr(resp); err != nil {
+		return nil, err
+	}
+	return &CreateResult{Credential: resp["code_url"]}, nil
+}
+
+func (w *Wechat) Query(ctx context.Context, paymentNo string) (*QueryResult, error) {
+	resp, err := w.call(ctx, w.client, "/pay/orderquery", map[string]string{"out_trade_no": paymentNo})
+	if err != nil {
+		return nil, err
+	}
+	if resp["err_code"] == "ORDERNOTEXIST" {
+		return &QueryResult{State: TradeStateNotFound}, nil
+	}
+	if err := wechatResultErr(resp); err != nil {
+		return nil, err
+	}
+	res := &QueryResult{
+		State:   wechatTradeState(resp["trade_state"]),
+		TradeNo: resp["transaction_id"],
+	}
+	res.PaidAmount, _ = strconv.ParseInt(resp["total_fee"], 10, 64)
+	if t, err := time.ParseInLocation(wechatTimeLayout, resp["time_end"], time.Local); err == nil {
+		res.PaidAt = t
+	}
+	return res, nil
+}
+
+func (w *Wechat) Close(ctx context.Context, paymentNo string) error {
+	resp, err := w.call(ctx, w.client, "/pay/closeorder", map[string]string{"out_trade_no": paymentNo})
+	if err != nil {
+		return err
+	}
+	switch resp["err_code"] {
+	case "ORDERPAID":
+		return ErrTradePaid
+	case "ORDERCLOSED", "ORDERNOTEXIST":
+		return nil
+	}
+	return wechatResultErr(resp)
+}
+
+// Refund 申请退款，微信退款为异步处理，受理成功即返回 PROCESSING。
+func (w *Wechat) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	if w.certClient == nil {
+		return nil, errors.New("wechat refund requires merchant certificate")
+	}
+	resp, err := w.call(ctx, w.certClient, "/secapi/pay/refund", map[string]string{
+		"out_trade_no":  req.PaymentNo,
+		"out_refund_no": req.RefundNo,
+		"total_fee":     strconv.FormatInt(req.TotalAmount, 10),
+		"refund_fee":    strconv.FormatInt(req.RefundAmount, 10),
+		"refund_desc":   req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := wechatResultErr(resp); err != nil {
+		return &RefundResult{State: RefundStateFailed}, err
+	}
+	return &RefundResult{State: RefundStateProcessing, ChannelRefund: resp["refund_id"]}, nil
+}
+
+func (w *Wechat) QueryRefund(ctx context.Context, paymentNo, refundNo string) (*RefundResult, error) {
+	resp, err := w.call(ctx, w.client, "/pay/refundquery", map[string]string{
+		"out_trade_no":  paymentNo,
+		"out_refund_no": refundNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := wechatResultErr(resp); err != nil {
+		return nil, err
+	}
+	res := &RefundResult{ChannelRefund: resp["refund_id_0"]}
+	switch resp["refund_status_0"] {
+	case "SUCCESS":
+		res.State = RefundStateSuccess
+	case "CHANGE", "REFUNDCLOSE":
+		res.State = RefundStateFailed
+	default:
+		res.State = RefundStateProcessing
+	}
+	return res, nil
+}
+
+func (w *Wechat) VerifyNotify(ctx context.Context, req *NotifyRequest) (*Notification, error) {
+	params, err := parseWechatXML(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if params["sign"] == "" || params["sign"] != w.sign(params) {
+		return nil, ErrInvalidSign
+	}
+	if w.conf.MchId != "" && params["mch_id"] != w.conf.MchId {
+		return nil, ErrInvalidSign
+	}
+
+	n := &Notification{
+		PaymentNo: params["out_trade_no"],
+		TradeNo:   params["transaction_id"],
+		State:     TradeStatePending,
+	}
+	if params["return_code"] == "SUCCESS" && params["result_code"] == "SUCCESS" {
+		n.State = TradeStateSuccess
+	}
+	n.Amount, _ = strconv.ParseInt(params["total_fee"], 10, 64)
+	if t, err := time.ParseInLocation(wechatTimeLayout, params["time_end"], time.Local); err == nil {
+		n.PaidAt = t
+	}
+	return n, nil
+}
+
+func (w *Wechat) NotifyAck(success bool) string {
+	if success {
+		return "<xml><return_code><![CDATA[SUCCESS]]></return_code><return_msg><![CDATA[OK]]></return_msg></xml>"
+	}
+	return "<xml><return_code><![CDATA[FAIL]]></return_code><return_msg><![CDATA[FAIL]]></return_msg></xml>"
+}
+
+func (w *Wechat) call(ctx context.Context, client *http.Client, path string, params map[string]string) (map[string]string, error) {
+	if w.conf.ApiKey == "" {
+		return nil, errors.New("wechat api key not configured")
+	}
+	params["appid"] = w.conf.AppId
+	params["mch_id"] = w.conf.MchId
+	params["nonce_str"] = nonceStr()
+	params["sign_type"] = "HMAC-SHA256"
+	params["sign"] = w.sign(params)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(w.conf.Gateway, "/")+path, bytes.NewReader(buildWechatXML(params)))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "text/xml; charset=utf-8")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := parseWechatXML(body)
+	if err != nil {
+		return nil, fmt.Errorf("wechat %s: decode response: %w", path, err)
+	}
+	if resp["return_code"] != "SUCCESS" {
+		return nil, fmt.Errorf("wechat %s: %s", path, resp["return_msg"])
+	}
+	return resp, nil
+}
+
+func (w *Wechat) sign(params map[string]string) string {
+	content := canonicalQuery(params, "sign") + "&key=" + w.conf.ApiKey
+	return strings.ToUpper(hmacSHA256Hex(w.conf.ApiKey, content))
+}
+
+func wechatResultErr(resp map[string]string) error {
+	if resp["result_code"] == "SUCCESS" {
+		return nil
+	}
+	return fmt.Errorf("wechat error: err_code=%s err_code_des=%s", resp["err_code"], resp["err_code_des"])
+}
+
+func wechatTradeState(state string) TradeState {
+	switch state {
+	case "SUCCESS", "REFUND":
+		return TradeStateSuccess
+	case "CLOSED", "REVOKED", "PAYERROR":
+		return TradeStateClosed
+	default:
+		return TradeStatePending
+	}
+}
+
+func buildWechatXML(params map[string]string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("<xml>")
+	for k, v := range params {
+		buf.WriteString("<" + k + "><![CDATA[")
+		buf.WriteString(strings.ReplaceAll(v, "]]>", "]]]]><![CDATA[>"))
+		buf.WriteString("]]></" + k + ">")
+	}
+	buf.WriteString("</xml>")
+	return buf.Bytes()
+}
+
+// 微信报文为单层 XML，直接展开为 map
+func parseWechatXML(body []byte) (map[string]string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	params := make(map[string]string)
+	var (
+		key   string
+		depth int
+	)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 {
+				key = t.Name.Local
+				params[key] = ""
+			}
+		case xml.CharData:
+			if depth == 2 && key != "" {
+				params[key] += string(t)
+			}
+		case xml.EndElement:
+			depth--
+			if depth < 2 {
+				key = ""
+			}
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,186 @@
+package reconcile
+
+import (
+	"context"
+	"io"
+	"time"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/svc"
+	"NatsumeAI/app/services/payment/internal/trade"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const lookupBatch = 200
+
+// Options 一次对账的参数。
+type Options struct {
+	Channel  string
+	BillDate time.Time
+	FileName string
+	// AutoFix 对账单已成功而本地仍在 PROCESSING 的支付单按渠道结果补单
+	AutoFix bool
+}
+
+// Run 导入对账单并与本地支付单比对，报告与差异明细落库。
+// 解析或比对失败时报告置为 FAILED 并返回错误。
+func Run(ctx context.Context, sc *svc.ServiceContext, opt Options, statement io.Reader) (*paymentdal.PaymentReconcileReports, error) {
+	report := &paymentdal.PaymentReconcileReports{
+		Channel:  opt.Channel,
+		BillDate: opt.BillDate,
+		FileName: opt.FileName,
+		Status:   paymentdal.ReconcileStatusRunning,
+	}
+	if opt.AutoFix {
+		report.AutoFix = 1
+	}
+	res, err := sc.PaymentReconcileReports.Insert(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+	report.ReportId, _ = res.LastInsertId()
+
+	items, err := compare(ctx, sc, opt, report, statement)
+	if err == nil {
+		for _, item := range items {
+			item.ReportId = report.ReportId
+			if _, err = sc.PaymentReconcileItems.Insert(ctx, item); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		report.Status = paymentdal.ReconcileStatusFailed
+		report.Error = err.Error()
+		if len(report.Error) > 512 {
+			report.Error = report.Error[:512]
+		}
+	} else {
+		report.Status = paymentdal.ReconcileStatusDone
+	}
+	if uerr := sc.PaymentReconcileReports.Update(ctx, report); uerr != nil {
+		logx.WithContext(ctx).Errorf("update reconcile report failed: report=%d err=%v", report.ReportId, uerr)
+	}
+	return report, err
+}
+
+func compare(ctx context.Context, sc *svc.ServiceContext, opt Options, report *paymentdal.PaymentReconcileReports, statement io.Reader) ([]*paymentdal.PaymentReconcileItems, error) {
+	rows, err := ParseStatement(statement)
+	if err != nil {
+		return nil, err
+	}
+	report.TotalRows = int64(len(rows))
+
+	// 同一支付单在账单中可能出现多行（如支付 + 退款），以成功行为准
+	statementRows := make(map[string]*StatementRow, len(rows))
+	paymentNos := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if prev, ok := statementRows[row.PaymentNo]; ok {
+			if prev.Status != ChannelStatusSuccess && row.Status == ChannelStatusSuccess {
+				statementRows[row.PaymentNo] = row
+			}
+			continue
+		}
+		statementRows[row.PaymentNo] = row
+		paymentNos = append(paymentNos, row.PaymentNo)
+	}
+
+	locals := make(map[string]*paymentdal.PaymentOrders, len(paymentNos))
+	for start := 0; start < len(paymentNos); start += lookupBatch {
+		end := min(start+lookupBatch, len(paymentNos))
+		found, err := sc.PaymentOrders.ListByPaymentNos(ctx, paymentNos[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, po := range found {
+			locals[po.PaymentNo] = po
+		}
+	}
+
+	var items []*paymentdal.PaymentReconcileItems
+	for _, no := range paymentNos {
+		row := statementRows[no]
+		po, ok := locals[no]
+		if !ok {
+			report.Extra++
+			items = append(items, &paymentdal.PaymentReconcileItems{
+				PaymentNo:     no,
+				Kind:          paymentdal.ReconcileKindExtra,
+				ChannelAmount: row.Amount,
+				ChannelStatus: row.Status,
+				TradeNo:       row.TradeNo,
+			})
+			continue
+		}
+		item := &paymentdal.PaymentReconcileItems{
+			PaymentNo:     no,
+			LocalAmount:   po.ChannelAmount,
+			ChannelAmount: row.Amount,
+			LocalStatus:   po.Status,
+			ChannelStatus: row.Status,
+			TradeNo:       row.TradeNo,
+		}
+		switch {
+		case po.Channel != opt.Channel:
+			report.StatusMismatch++
+			item.Kind = paymentdal.ReconcileKindStatusMismatch
+			item.Note = "channel mismatch: local " + po.Channel
+		case po.ChannelAmount != row.Amount:
+			report.AmountMismatch++
+			item.Kind = paymentdal.ReconcileKindAmountMismatch
+		case (po.Status == "SUCCESS") != (row.Status == ChannelStatusSuccess):
+			report.StatusMismatch++
+			item.Kind = paymentdal.ReconcileKindStatusMismatch
+			if opt.AutoFix && po.Status == "PROCESSING" {
+				fix(ctx, sc, po, item)
+				if item.Fixed == 1 {
+					report.Fixed++
+				}
+			}
+		default:
+			report.Matched++
+			continue
+		}
+		items = append(items, item)
+	}
+
+	// 本地当日支付成功但对账单中没有的记录
+	from := time.Date(opt.BillDate.Year(), opt.BillDate.Month(), opt.BillDate.Day(), 0, 0, 0, 0, time.Local)
+	succeeded, err := sc.PaymentOrders.ListByChannelStatusUpdated(ctx, opt.Channel, "SUCCESS", from, from.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	for _, po := range succeeded {
+		if _, ok := statementRows[po.PaymentNo]; ok {
+			continue
+		}
+		report.Missing++
+		items = append(items, &paymentdal.PaymentReconcileItems{
+			PaymentNo:   po.PaymentNo,
+			Kind:        paymentdal.ReconcileKindMissing,
+			LocalAmount: po.ChannelAmount,
+			LocalStatus: po.Status,
+		})
+	}
+	return items, nil
+}
+
+// 渠道已成功、本地仍在处理中：按通知成功的路径补单
+func fix(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders, item *paymentdal.PaymentReconcileItems) {
+	result, err := trade.MarkPaid(ctx, sc, po, item.TradeNo)
+	if err != nil {
+		item.Note = "auto fix failed: " + err.Error()
+		if len(item.Note) > 255 {
+			item.Note = item.Note[:255]
+		}
+		logx.WithContext(ctx).Errorf("reconcile auto fix failed: payment=%s err=%v", po.PaymentNo, err)
+		return
+	}
+	if result == paymentdal.NotifyResultConfirmed {
+		item.Fixed = 1
+		item.Note = "auto fixed: payment confirmed"
+		return
+	}
+	item.Note = "auto fix: " + result
+}
@@ -0,0 +1,137 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// 对账单中的交易状态，统一归一为 SUCCESS / CLOSED，其余原样保留
+const (
+	ChannelStatusSuccess = "SUCCESS"
+	ChannelStatusClosed  = "CLOSED"
+)
+
+var ErrInvalidStatement = errors.New("invalid statement file")
+
+// StatementRow 渠道对账单中的一笔交易。
+type StatementRow struct {
+	PaymentNo string
+	TradeNo   string
+	Amount    int64 // 单位分
+	Status    string
+	PaidAt    string
+}
+
+// 表头别名：兼容我们自己的导出格式与渠道原始账单的常见列名
+var columnAliases = map[string]string{
+	"payment_no":     "payment_no",
+	"out_trade_no":   "payment_no",
+	"商户订单号":          "payment_no",
+	"trade_no":       "trade_no",
+	"transaction_id": "trade_no",
+	"支付宝交易号":         "trade_no",
+	"微信订单号":          "trade_no",
+	"amount":         "amount",
+	"total_amount":   "amount",
+	"订单金额":           "amount",
+	"status":         "status",
+	"trade_status":   "status",
+	"交易状态":           "status",
+	"paid_at":        "paid_at",
+	"gmt_payment":    "paid_at",
+	"交易时间":           "paid_at",
+}
+
+// ParseStatement 解析 CSV 对账单，首行为表头，金额以元为单位。
+func ParseStatement(r io.Reader) ([]*StatementRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: read header: %v", ErrInvalidStatement, err)
+	}
+	index := make(map[string]int, len(header))
+	for i, col := range header {
+		col = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(col, "\ufeff")))
+		if name, ok := columnAliases[col]; ok {
+			index[name] = i
+		}
+	}
+	for _, required := range []string{"payment_no", "amount"} {
+		if _, ok := index[required]; !ok {
+			return nil, fmt.Errorf("%w: missing column %s", ErrInvalidStatement, required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := index[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		// 渠道账单常以 ` 前缀防止科学计数法
+		return strings.TrimPrefix(strings.TrimSpace(record[i]), "`")
+	}
+
+	var rows []*StatementRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidStatement, line, err)
+		}
+		paymentNo := field(record, "payment_no")
+		if paymentNo == "" || strings.HasPrefix(paymentNo, "#") {
+			continue
+		}
+		amount, err := parseYuan(field(record, "amount"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidStatement, line, err)
+		}
+		rows = append(rows, &StatementRow{
+			PaymentNo: paymentNo,
+			TradeNo:   field(record, "trade_no"),
+			Amount:    amount,
+			Status:    normalizeStatus(field(record, "status")),
+			PaidAt:    field(record, "paid_at"),
+		})
+	}
+	return rows, nil
+}
+
+// 未提供状态列时视为成功交易（渠道日账单默认只包含成功交易）
+func normalizeStatus(s string) string {
+	switch strings.ToUpper(s) {
+	case "", "SUCCESS", "TRADE_SUCCESS", "TRADE_FINISHED", "REFUND", "PAID", "交易成功":
+		return ChannelStatusSuccess
+	case "CLOSED", "TRADE_CLOSED", "REVOKED", "交易关闭":
+		return ChannelStatusClosed
+	default:
+		return strings.ToUpper(s)
+	}
+}
+
+// 元转分，最多两位小数
+func parseYuan(s string) (int64, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "¥")
+	if s == "" {
+		return 0, errors.New("empty amount")
+	}
+	yuan, frac, _ := strings.Cut(s, ".")
+	if len(frac) > 2 {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	frac += strings.Repeat("0", 2-len(frac))
+	cents, err := strconv.ParseInt(yuan+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	return cents, nil
+}
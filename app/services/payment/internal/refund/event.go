@@ -0,0 +1,41 @@
+package refund
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Event 退款结果事件（SUCCESS/FAILED），订单服务据此推进订单与预售单状态。
+type Event struct {
+	RefundId      int64  `json:"refund_id"`
+	RefundNo      string `json:"refund_no"`
+	RequestNo     string `json:"request_no"`
+	PaymentId     int64  `json:"payment_id"`
+	OrderId       int64  `json:"order_id"`
+	UserId        int64  `json:"user_id"`
+	Amount        int64  `json:"amount"`
+	Status        string `json:"status"`
+	RefundedTotal int64  `json:"refunded_total"` // 该支付单累计退款成功金额
+	PaidAmount    int64  `json:"paid_amount"`
+	FinishedAt    int64  `json:"finished_at"`
+}
+
+// PublishEvent 投递退款结果事件，按订单号分区保证同一订单的事件有序。
+func PublishEvent(ctx context.Context, sc *svc.ServiceContext, evt Event) error {
+	if sc.KafkaWriter == nil {
+		return nil
+	}
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	return sc.KafkaWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatInt(evt.OrderId, 10)),
+		Value: body,
+	})
+}
@@ -0,0 +1,173 @@
+package refund
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/split"
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/hibiken/asynq"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// TaskProcess 退款处理任务
+const TaskProcess = "payment:process_refund"
+
+type ProcessPayload struct {
+	RefundId int64 `json:"refund_id"`
+}
+
+const (
+	// 渠道请求失败的重试次数，耗尽后退款单置为 FAILED
+	refundMaxRetry = 8
+	// 渠道异步退款（如微信）受理后按固定间隔查询结果
+	refundPollInterval = time.Minute
+	refundMaxAttempts  = 60
+)
+
+// Enqueue 投递退款处理任务，delay 为 0 时立即处理。
+func Enqueue(sc *svc.ServiceContext, refundId int64, delay time.Duration) error {
+	if sc.AsynqClient == nil {
+		return errors.New("asynq client not configured")
+	}
+	payload, _ := json.Marshal(ProcessPayload{RefundId: refundId})
+	opts := []asynq.Option{asynq.Queue("payment"), asynq.MaxRetry(refundMaxRetry)}
+	if delay > 0 {
+		opts = append(opts, asynq.ProcessIn(delay))
+	}
+	_, err := sc.AsynqClient.Enqueue(asynq.NewTask(TaskProcess, payload), opts...)
+	return err
+}
+
+// 退款处理：PENDING 向渠道发起退款，PROCESSING 查询渠道退款结果；
+// 失败返回错误交由 asynq 退避重试，重试耗尽后置为 FAILED。
+func NewProcessHandler(sc *svc.ServiceContext) asynq.HandlerFunc {
+	return func(ctx context.Context, task *asynq.Task) error {
+		var payload ProcessPayload
+		if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+			return err
+		}
+
+		rf, err := sc.PaymentRefunds.FindOne(ctx, payload.RefundId)
+		if err != nil {
+			if err == paymentdal.ErrNotFound {
+				return nil
+			}
+			return err
+		}
+		switch rf.Status {
+		case paymentdal.RefundStatusSuccess, paymentdal.RefundStatusFailed:
+			// 已是终态（上次事件投递失败后的重试），补发事件
+			return publishRefundResult(ctx, sc, rf)
+		}
+
+		p, err := sc.Providers.Get(rf.Channel)
+		if err != nil {
+			return finishRefund(ctx, sc, rf, paymentdal.RefundStatusFailed, "", err.Error())
+		}
+		po, err := sc.PaymentOrders.FindOne(ctx, rf.PaymentId)
+		if err != nil {
+			return err
+		}
+
+		// 组合支付的分段退款以分段单号、分段金额向渠道发起
+		tradeNo, tradeAmount, err := split.ChannelTrade(ctx, sc, po, rf.SplitId)
+		if err != nil {
+			return err
+		}
+
+		var res *provider.RefundResult
+		if rf.Status == paymentdal.RefundStatusProcessing {
+			res, err = p.QueryRefund(ctx, tradeNo, rf.RefundNo)
+		} else {
+			res, err = p.Refund(ctx, &provider.RefundRequest{
+				PaymentNo:    tradeNo,
+				RefundNo:     rf.RefundNo,
+				UserId:       rf.UserId,
+				Currency:     rf.Currency,
+				TotalAmount:  tradeAmount,
+				RefundAmount: rf.Amount,
+				Reason:       rf.Reason,
+			})
+		}
+
+		if err == nil && res.State == provider.RefundStateSuccess {
+			return finishRefund(ctx, sc, rf, paymentdal.RefundStatusSuccess, res.ChannelRefund, "")
+		}
+		if err == nil && res.State == provider.RefundStateProcessing {
+			if _, err := sc.PaymentRefunds.UpdateProgress(ctx, rf.RefundId, []string{paymentdal.RefundStatusPending, paymentdal.RefundStatusProcessing}, paymentdal.RefundStatusProcessing, res.ChannelRefund, ""); err != nil {
+				return err
+			}
+			if rf.Attempts+1 >= refundMaxAttempts {
+				// 长时间未出结果，留给对账处理
+				logx.WithContext(ctx).Errorf("refund still processing after %d attempts: refund=%s payment=%s", rf.Attempts+1, rf.RefundNo, rf.PaymentNo)
+				return nil
+			}
+			return Enqueue(sc, rf.RefundId, refundPollInterval)
+		}
+
+		msg := "channel refund failed"
+		if err != nil {
+			msg = err.Error()
+		}
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			logx.WithContext(ctx).Errorf("refund failed after retries: refund=%s payment=%s err=%s", rf.RefundNo, rf.PaymentNo, msg)
+			return finishRefund(ctx, sc, rf, paymentdal.RefundStatusFailed, "", msg)
+		}
+		if _, err := sc.PaymentRefunds.UpdateProgress(ctx, rf.RefundId, []string{rf.Status}, rf.Status, "", msg); err != nil {
+			logx.WithContext(ctx).Errorf("record refund attempt failed: refund=%s err=%v", rf.RefundNo, err)
+		}
+		return fmt.Errorf("refund %s: %s", rf.RefundNo, msg)
+	}
+}
+
+func finishRefund(ctx context.Context, sc *svc.ServiceContext, rf *paymentdal.PaymentRefunds, status, channelRefundNo, lastError string) error {
+	if _, err := sc.PaymentRefunds.UpdateProgress(ctx, rf.RefundId, []string{paymentdal.RefundStatusPending, paymentdal.RefundStatusProcessing}, status, channelRefundNo, lastError); err != nil {
+		return err
+	}
+	latest, err := sc.PaymentRefunds.FindOne(ctx, rf.RefundId)
+	if err != nil {
+		return err
+	}
+	return publishRefundResult(ctx, sc, latest)
+}
+
+func publishRefundResult(ctx context.Context, sc *svc.ServiceContext, rf *paymentdal.PaymentRefunds) error {
+	po, err := sc.PaymentOrders.FindOne(ctx, rf.PaymentId)
+	if err != nil {
+		return err
+	}
+	refunded, err := sc.PaymentRefunds.SumAmount(ctx, rf.PaymentId, []string{paymentdal.RefundStatusSuccess})
+	if err != nil {
+		return err
+	}
+
+	evt := Event{
+		RefundId:      rf.RefundId,
+		RefundNo:      rf.RefundNo,
+		RequestNo:     rf.RequestNo,
+		PaymentId:     rf.PaymentId,
+		OrderId:       rf.OrderId,
+		UserId:        rf.UserId,
+		Amount:        rf.Amount,
+		Status:        rf.Status,
+		RefundedTotal: refunded,
+		PaidAmount:    po.Amount,
+	}
+	if rf.FinishedAt.Valid {
+		evt.FinishedAt = rf.FinishedAt.Time.Unix()
+	}
+	if err := PublishEvent(ctx, sc, evt); err != nil {
+		logx.WithContext(ctx).Errorf("publish refund event failed: refund=%s err=%v", rf.RefundNo, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"NatsumeAI/app/common/fx"
+	"NatsumeAI/app/common/snowflake"
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/split"
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var (
+	ErrPaymentNotPaid = errors.New("payment not paid")
+	ErrAmountOverflow = errors.New("refund amount exceeds refundable amount")
+	ErrNotRefundable  = errors.New("topup payment is not refundable")
+
+	activeStatuses = []string{paymentdal.RefundStatusPending, paymentdal.RefundStatusProcessing, paymentdal.RefundStatusSuccess}
+)
+
+// Request 退款申请
+type Request struct {
+	// Amount 退款金额（分），为 0 时退还剩余可退金额
+	Amount int64
+	Reason string
+	// RequestNo 同一支付单内幂等，为空时每次生成新的退款单
+	RequestNo string
+	// ToWallet 退回钱包余额而不是原路退回
+	ToWallet bool
+}
+
+// Create 创建退款单并投递异步处理任务。
+// 组合支付按支付分段逆序分配退款金额（先退外部渠道，再退钱包、积分），每段生成一张退款单，
+// 第一张的请求号为 RequestNo，其余为 "{RequestNo}-{leg_no}"，各张的 parent_request_no 均为 RequestNo。
+// 同一 RequestNo 重复申请返回已有退款单；累计退款（含处理中）不超过实付金额。
+func Create(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders, req Request) ([]*paymentdal.PaymentRefunds, error) {
+	if req.RequestNo != "" {
+		existing, err := sc.PaymentRefunds.ListByRequest(ctx, po.PaymentId, req.RequestNo)
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return existing, nil
+		}
+	}
+	if po.BizType == paymentdal.PaymentBizTopup {
+		return nil, ErrNotRefundable
+	}
+	if po.Status != "SUCCESS" {
+		return nil, ErrPaymentNotPaid
+	}
+	if req.ToWallet {
+		if _, err := sc.Providers.Get(provider.ChannelWallet); err != nil {
+			return nil, err
+		}
+	}
+	legs, err := split.Legs(ctx, sc, po)
+	if err != nil {
+		return nil, err
+	}
+
+	requestNo := req.RequestNo
+	if requestNo == "" {
+		requestNo = strconv.FormatInt(snowflake.Next(), 10)
+	}
+
+	var records []*paymentdal.PaymentRefunds
+	// 锁住支付单串行化同一支付单的退款申请
+	err = sc.DB.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		records = records[:0]
+		locked, err := sc.PaymentOrders.FindOneForUpdateWithSession(ctx, session, po.PaymentId)
+		if err != nil {
+			return err
+		}
+		if locked.Status != "SUCCESS" {
+			return ErrPaymentNotPaid
+		}
+		refunded, err := sc.PaymentRefunds.SumAmountBySplitWithSession(ctx, session, po.PaymentId, activeStatuses)
+		if err != nil {
+			return err
+		}
+		var remaining int64
+		for _, leg := range legs {
+			remaining += leg.Amount - refunded[leg.SplitId]
+		}
+		amount := req.Amount
+		if amount == 0 {
+			amount = remaining
+		}
+		if amount <= 0 || amount > remaining {
+			return ErrAmountOverflow
+		}
+
+		left := amount
+		for i := len(legs) - 1; i >= 0 && left > 0; i-- {
+			leg := legs[i]
+			available := leg.Amount - refunded[leg.SplitId]
+			if available <= 0 {
+				continue
+			}
+			part := min(left, available)
+			channel := leg.Channel
+			if req.ToWallet && !provider.IsInternal(channel) {
+				channel = provider.ChannelWallet
+			}
+			record := &paymentdal.PaymentRefunds{
+				RefundNo:  strconv.FormatInt(snowflake.Next(), 10),
+				RequestNo: requestNo,
+				PaymentId: po.PaymentId,
+				PaymentNo: po.PaymentNo,
+				OrderId:   po.OrderId,
+				SplitId:   leg.SplitId,
+				UserId:    po.UserId,
+				Amount:    part,
+				Currency:  po.Currency,
+				// 退款沿用支付时的汇率快照，不受之后汇率变动影响
+				FxRate:      po.FxRate,
+				PriceAmount: fx.Revert(part, po.FxRate),
+				Channel:     channel,
+				Status:      paymentdal.RefundStatusPending,
+				Reason:      req.Reason,
+			}
+			// 同一申请的各分段退款单共用 parent_request_no，重复申请按它精确查找
+			record.ParentRequestNo = requestNo
+			if len(records) > 0 {
+				record.RequestNo = requestNo + "-" + strconv.FormatInt(leg.LegNo, 10)
+			}
+			res, err := sc.PaymentRefunds.InsertWithSession(ctx, session, record)
+			if err != nil {
+				return err
+			}
+			record.RefundId, _ = res.LastInsertId()
+			records = append(records, record)
+			left -= part
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrPaymentNotPaid) || errors.Is(err, ErrAmountOverflow) {
+			return nil, err
+		}
+		// 并发提交同一请求号时唯一键冲突，返回先写入的退款单
+		if req.RequestNo != "" {
+			if existing, findErr := sc.PaymentRefunds.ListByRequest(ctx, po.PaymentId, req.RequestNo); findErr == nil && len(existing) > 0 {
+				return existing, nil
+			}
+		}
+		return nil, err
+	}
+
+	for _, record := range records {
+		if err := Enqueue(sc, record.RefundId, 0); err != nil {
+			// 退款单保持 PENDING，由对账补偿
+			logx.WithContext(ctx).Errorf("enqueue refund failed: refund=%s payment=%d err=%v", record.RefundNo, po.PaymentId, err)
+		}
+	}
+	return records, nil
+}
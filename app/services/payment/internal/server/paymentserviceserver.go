@@ -32,3 +32,53 @@ func (s *PaymentServiceServer) GetPayment(ctx context.Context, in *payment.GetPa
 	l := logic.NewGetPaymentLogic(ctx, s.svcCtx)
 	return l.GetPayment(in)
 }
+
+func (s *PaymentServiceServer) HandleNotify(ctx context.Context, in *payment.HandleNotifyReq) (*payment.HandleNotifyResp, error) {
+	l := logic.NewHandleNotifyLogic(ctx, s.svcCtx)
+	return l.HandleNotify(in)
+}
+
+func (s *PaymentServiceServer) ClosePayment(ctx context.Context, in *payment.ClosePaymentReq) (*payment.ClosePaymentResp, error) {
+	l := logic.NewClosePaymentLogic(ctx, s.svcCtx)
+	return l.ClosePayment(in)
+}
+
+func (s *PaymentServiceServer) CreateRefund(ctx context.Context, in *payment.CreateRefundReq) (*payment.CreateRefundResp, error) {
+	l := logic.NewCreateRefundLogic(ctx, s.svcCtx)
+	return l.CreateRefund(in)
+}
+
+func (s *PaymentServiceServer) GetRefund(ctx context.Context, in *payment.GetRefundReq) (*payment.GetRefundResp, error) {
+	l := logic.NewGetRefundLogic(ctx, s.svcCtx)
+	return l.GetRefund(in)
+}
+
+func (s *PaymentServiceServer) Reconcile(ctx context.Context, in *payment.ReconcileReq) (*payment.ReconcileResp, error) {
+	l := logic.NewReconcileLogic(ctx, s.svcCtx)
+	return l.Reconcile(in)
+}
+
+func (s *PaymentServiceServer) GetReconcileReport(ctx context.Context, in *payment.GetReconcileReportReq) (*payment.GetReconcileReportResp, error) {
+	l := logic.NewGetReconcileReportLogic(ctx, s.svcCtx)
+	return l.GetReconcileReport(in)
+}
+
+func (s *PaymentServiceServer) GetWallet(ctx context.Context, in *payment.GetWalletReq) (*payment.GetWalletResp, error) {
+	l := logic.NewGetWalletLogic(ctx, s.svcCtx)
+	return l.GetWallet(in)
+}
+
+func (s *PaymentServiceServer) CreateTopup(ctx context.Context, in *payment.CreateTopupReq) (*payment.CreateTopupResp, error) {
+	l := logic.NewCreateTopupLogic(ctx, s.svcCtx)
+	return l.CreateTopup(in)
+}
+
+func (s *PaymentServiceServer) ListWalletEntries(ctx context.Context, in *payment.ListWalletEntriesReq) (*payment.ListWalletEntriesResp, error) {
+	l := logic.NewListWalletEntriesLogic(ctx, s.svcCtx)
+	return l.ListWalletEntries(in)
+}
+
+func (s *PaymentServiceServer) GrantPoints(ctx context.Context, in *payment.GrantPointsReq) (*payment.GrantPointsResp, error) {
+	l := logic.NewGrantPointsLogic(ctx, s.svcCtx)
+	return l.GrantPoints(in)
+}
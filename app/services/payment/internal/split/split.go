@@ -0,0 +1,258 @@
+package split
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"NatsumeAI/app/common/snowflake"
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ErrInvalidPlan 组合支付金额不合法：抵扣超过应付金额、渠道缺失或未启用。
+var ErrInvalidPlan = errors.New("invalid payment split")
+
+// Leg 支付分段：积分、钱包余额、外部渠道依次支付，最后一段为主支付段。
+type Leg struct {
+	SplitId   int64
+	LegNo     int64
+	Channel   string
+	Amount    int64
+	Points    int64
+	PaymentNo string
+}
+
+// Plan 拆分应付金额：先积分抵扣，再钱包余额，剩余部分走 channel。
+// 只有一段时即普通支付，不落分段记录。
+func Plan(sc *svc.ServiceContext, amount, walletAmount, points int64, channel string) ([]Leg, error) {
+	channel = strings.ToUpper(channel)
+	if walletAmount < 0 || points < 0 {
+		return nil, ErrInvalidPlan
+	}
+
+	var legs []Leg
+	remaining := amount
+	if points > 0 {
+		if _, err := sc.Providers.Get(provider.ChannelPoints); err != nil {
+			return nil, fmt.Errorf("%w: points not enabled", ErrInvalidPlan)
+		}
+		cents := points * sc.Config.Points.CentsPerPoint
+		if cents > remaining {
+			return nil, fmt.Errorf("%w: points exceed payable amount", ErrInvalidPlan)
+		}
+		legs = append(legs, Leg{Channel: provider.ChannelPoints, Amount: cents, Points: points})
+		remaining -= cents
+	}
+	// 剩余部分指定钱包支付时并入钱包分段
+	if channel == provider.ChannelWallet && remaining > walletAmount {
+		walletAmount = remaining
+	}
+	if walletAmount > 0 {
+		if _, err := sc.Providers.Get(provider.ChannelWallet); err != nil {
+			return nil, fmt.Errorf("%w: wallet not enabled", ErrInvalidPlan)
+		}
+		if walletAmount > remaining {
+			return nil, fmt.Errorf("%w: wallet amount exceeds payable amount", ErrInvalidPlan)
+		}
+		legs = append(legs, Leg{Channel: provider.ChannelWallet, Amount: walletAmount})
+		remaining -= walletAmount
+	}
+	if remaining > 0 {
+		if channel == "" || provider.IsInternal(channel) {
+			return nil, fmt.Errorf("%w: channel required for remaining amount", ErrInvalidPlan)
+		}
+		legs = append(legs, Leg{Channel: channel, Amount: remaining})
+	}
+	if len(legs) == 0 {
+		return nil, ErrInvalidPlan
+	}
+	for i := range legs {
+		legs[i].LegNo = int64(i + 1)
+	}
+	return legs, nil
+}
+
+// Primary returns the last leg which is paid through the regular payment flow.
+func Primary(legs []Leg) Leg {
+	return legs[len(legs)-1]
+}
+
+// Replace 替换未支付支付单的分段计划：退回已冻结的前置分段，删除未结算分段并写入新计划。
+// 主支付段使用支付单号，前置分段生成独立的分段单号作为渠道侧单号。
+func Replace(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders, legs []Leg) error {
+	if err := Rollback(ctx, sc, po); err != nil {
+		return err
+	}
+	if err := sc.PaymentSplits.DeleteUnsettled(ctx, po.PaymentId); err != nil {
+		return err
+	}
+	if len(legs) <= 1 {
+		return nil
+	}
+	for i, leg := range legs {
+		splitNo := po.PaymentNo
+		if i < len(legs)-1 {
+			splitNo = strconv.FormatInt(snowflake.Next(), 10)
+		}
+		if _, err := sc.PaymentSplits.Insert(ctx, &paymentdal.PaymentSplits{
+			SplitNo:   splitNo,
+			PaymentId: po.PaymentId,
+			LegNo:     leg.LegNo,
+			Channel:   leg.Channel,
+			Amount:    leg.Amount,
+			Points:    leg.Points,
+			Status:    paymentdal.SplitStatusPending,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Hold 同步扣减前置分段（积分、钱包）并冻结为 HELD，任一分段失败则回滚已扣减的分段。
+func Hold(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders) error {
+	splits, err := sc.PaymentSplits.ListByPayment(ctx, po.PaymentId)
+	if err != nil {
+		return err
+	}
+	for _, s := range splits {
+		if s.SplitNo == po.PaymentNo || s.Status != paymentdal.SplitStatusPending {
+			continue
+		}
+		if err := hold(ctx, sc, po, s); err != nil {
+			if rbErr := Rollback(ctx, sc, po); rbErr != nil {
+				logx.WithContext(ctx).Errorf("rollback payment splits failed: payment=%s err=%v", po.PaymentNo, rbErr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func hold(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders, s *paymentdal.PaymentSplits) error {
+	p, err := sc.Providers.Get(s.Channel)
+	if err != nil {
+		return err
+	}
+	res, err := p.Create(ctx, &provider.CreateRequest{
+		PaymentNo: s.SplitNo,
+		UserId:    po.UserId,
+		Amount:    s.Amount,
+		Currency:  po.Currency,
+	})
+	if err != nil {
+		return err
+	}
+	if !res.Paid {
+		return fmt.Errorf("split channel %s is not synchronous", s.Channel)
+	}
+	_, err = sc.PaymentSplits.UpdateStatus(ctx, s, []string{paymentdal.SplitStatusPending}, paymentdal.SplitStatusHeld, res.TradeNo)
+	return err
+}
+
+// Commit 支付成功后提交全部分段。
+func Commit(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders) error {
+	splits, err := sc.PaymentSplits.ListByPayment(ctx, po.PaymentId)
+	if err != nil {
+		return err
+	}
+	for _, s := range splits {
+		if s.Status != paymentdal.SplitStatusPending && s.Status != paymentdal.SplitStatusHeld {
+			continue
+		}
+		if _, err := sc.PaymentSplits.UpdateStatus(ctx, s, []string{paymentdal.SplitStatusPending, paymentdal.SplitStatusHeld}, paymentdal.SplitStatusCommitted, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback 支付关闭或下单失败时退回已冻结的前置分段，退回记账按分段单号幂等。
+// 主支付段保持 PENDING，关单后渠道仍到账时由迟到支付退款退回。
+func Rollback(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders) error {
+	splits, err := sc.PaymentSplits.ListByPayment(ctx, po.PaymentId)
+	if err != nil {
+		return err
+	}
+	var firstErr error
+	for _, s := range splits {
+		if s.Status != paymentdal.SplitStatusHeld {
+			continue
+		}
+		if err := rollback(ctx, sc, po, s); err != nil {
+			logx.WithContext(ctx).Errorf("rollback payment split failed: payment=%s split=%s channel=%s err=%v", po.PaymentNo, s.SplitNo, s.Channel, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func rollback(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders, s *paymentdal.PaymentSplits) error {
+	p, err := sc.Providers.Get(s.Channel)
+	if err != nil {
+		return err
+	}
+	res, err := p.Refund(ctx, &provider.RefundRequest{
+		PaymentNo:    s.SplitNo,
+		RefundNo:     s.SplitNo + "-rollback",
+		UserId:       po.UserId,
+		Currency:     po.Currency,
+		TotalAmount:  s.Amount,
+		RefundAmount: s.Amount,
+		Reason:       "payment closed",
+	})
+	if err != nil {
+		return err
+	}
+	if res.State != provider.RefundStateSuccess {
+		return fmt.Errorf("split rollback not finished: state=%s", res.State)
+	}
+	_, err = sc.PaymentSplits.UpdateStatus(ctx, s, []string{paymentdal.SplitStatusHeld}, paymentdal.SplitStatusRolledBack, "")
+	return err
+}
+
+// Legs 可退款的分段，按支付顺序排列；非组合支付视为一段。已回滚的分段不参与退款。
+func Legs(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders) ([]Leg, error) {
+	splits, err := sc.PaymentSplits.ListByPayment(ctx, po.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(splits) == 0 {
+		return []Leg{{LegNo: 1, Channel: po.Channel, Amount: po.Amount, PaymentNo: po.PaymentNo}}, nil
+	}
+	legs := make([]Leg, 0, len(splits))
+	for _, s := range splits {
+		if s.Status == paymentdal.SplitStatusRolledBack {
+			continue
+		}
+		legs = append(legs, Leg{
+			SplitId:   s.SplitId,
+			LegNo:     s.LegNo,
+			Channel:   s.Channel,
+			Amount:    s.Amount,
+			Points:    s.Points,
+			PaymentNo: s.SplitNo,
+		})
+	}
+	return legs, nil
+}
+
+// ChannelTrade returns the channel side trade number and amount a refund is made against.
+func ChannelTrade(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders, splitId int64) (string, int64, error) {
+	if splitId <= 0 {
+		return po.PaymentNo, po.ChannelAmount, nil
+	}
+	s, err := sc.PaymentSplits.FindOne(ctx, splitId)
+	if err != nil {
+		return "", 0, err
+	}
+	return s.SplitNo, s.Amount, nil
+}
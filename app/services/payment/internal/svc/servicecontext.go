@@ -7,8 +7,11 @@ import (
 	paymentdal "NatsumeAI/app/dal/payment"
 	"NatsumeAI/app/services/order/order"
 	"NatsumeAI/app/services/payment/internal/config"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/wallet"
 
 	"github.com/hibiken/asynq"
+	"github.com/segmentio/kafka-go"
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -17,11 +20,23 @@ import (
 type ServiceContext struct {
 	Config config.Config
 
-	DB            sqlx.SqlConn
-	PaymentOrders paymentdal.PaymentOrdersModel
+	DB                   sqlx.SqlConn
+	PaymentOrders        paymentdal.PaymentOrdersModel
+	PaymentNotifications paymentdal.PaymentNotificationsModel
+	PaymentRefunds       paymentdal.PaymentRefundsModel
+	PaymentSplits        paymentdal.PaymentSplitsModel
+
+	PaymentReconcileReports paymentdal.PaymentReconcileReportsModel
+	PaymentReconcileItems   paymentdal.PaymentReconcileItemsModel
+
+	WalletSnapshots paymentdal.WalletBalanceSnapshotsModel
+	Wallet          *wallet.Ledger
 
 	OrderRpc    order.OrderServiceClient
 	AsynqClient *asynq.Client
+	KafkaWriter *kafka.Writer
+
+	Providers *provider.Registry
 
 	PaymentTTL time.Duration
 }
@@ -45,6 +60,31 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		ttl = 15 * time.Minute
 	}
 
+	var kw *kafka.Writer
+	if len(c.KafkaConf.Broker) > 0 && c.KafkaConf.RefundTopic != "" {
+		kw = &kafka.Writer{
+			Addr:                   kafka.TCP(c.KafkaConf.Broker...),
+			Topic:                  c.KafkaConf.RefundTopic,
+			RequiredAcks:           kafka.RequireOne,
+			Balancer:               &kafka.Hash{},
+			AllowAutoTopicCreation: true,
+			BatchTimeout:           5 * time.Millisecond,
+		}
+	}
+
+	ledger := wallet.NewLedger(db,
+		paymentdal.NewWalletAccountsModel(db, c.CacheConf),
+		paymentdal.NewWalletJournalEntriesModel(db, c.CacheConf),
+		paymentdal.NewWalletLedgerLinesModel(db, c.CacheConf),
+	)
+	providers := provider.NewRegistry(c.PayChannels)
+	if c.Wallet.Enabled {
+		providers.Register(wallet.NewProvider(ledger))
+	}
+	if c.Points.Enabled {
+		providers.Register(wallet.NewPointsProvider(ledger, c.Points.CentsPerPoint))
+	}
+
 	if c.SnowflakeNode > 0 {
 		if err := snowflake.SetNodeID(c.SnowflakeNode); err != nil {
 			logx.Errorf("failed to set snowflake node id: %v", err)
@@ -52,11 +92,23 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	}
 
 	return &ServiceContext{
-		Config:        c,
-		DB:            db,
-		PaymentOrders: pOrders,
-		OrderRpc:      orderCli,
-		AsynqClient:   asynqClient,
-		PaymentTTL:    ttl,
+		Config:               c,
+		DB:                   db,
+		PaymentOrders:        pOrders,
+		PaymentNotifications: paymentdal.NewPaymentNotificationsModel(db, c.CacheConf),
+		PaymentRefunds:       paymentdal.NewPaymentRefundsModel(db, c.CacheConf),
+		PaymentSplits:        paymentdal.NewPaymentSplitsModel(db, c.CacheConf),
+
+		PaymentReconcileReports: paymentdal.NewPaymentReconcileReportsModel(db, c.CacheConf),
+		PaymentReconcileItems:   paymentdal.NewPaymentReconcileItemsModel(db, c.CacheConf),
+
+		WalletSnapshots: paymentdal.NewWalletBalanceSnapshotsModel(db, c.CacheConf),
+		Wallet:          ledger,
+
+		OrderRpc:             orderCli,
+		AsynqClient:          asynqClient,
+		KafkaWriter:          kw,
+		Providers:            providers,
+		PaymentTTL:           ttl,
 	}
 }
@@ -0,0 +1,110 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/order/order"
+	"NatsumeAI/app/services/payment/internal/refund"
+	"NatsumeAI/app/services/payment/internal/split"
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 用户取消或超时关单后渠道仍收到付款时使用的退款请求号，同一支付单只退一次
+const latePaymentRequestNo = "late-payment"
+
+// MarkPaid 渠道确认支付成功：支付单置为 SUCCESS 并确认订单，充值单则入账到钱包。
+// 可重复调用（通知重试、查单补偿），订单侧 ConfirmPayment 与钱包记账均幂等。
+// 返回处理结果：CONFIRMED 或 CLOSED（支付单/订单已关闭，款项原路退回）。
+// 订单拒绝确认但并未取消时返回 FAILED 与错误，由渠道重试通知。
+func MarkPaid(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders, tradeNo string) (string, error) {
+	if po.Status != "SUCCESS" {
+		updated, err := sc.PaymentOrders.UpdateStatus(ctx, po.PaymentId, []string{"INIT", "PROCESSING"}, "SUCCESS")
+		if err != nil {
+			return paymentdal.NotifyResultFailed, err
+		}
+		if !updated {
+			latest, err := sc.PaymentOrders.FindOne(ctx, po.PaymentId)
+			if err != nil {
+				return paymentdal.NotifyResultFailed, err
+			}
+			*po = *latest
+			if po.Status != "SUCCESS" && po.BizType == paymentdal.PaymentBizTopup {
+				// 充值单过期后到账仍入账，不做退款
+				if _, err := sc.PaymentOrders.UpdateStatus(ctx, po.PaymentId, []string{"FAILED", "CANCELLED", "EXPIRED"}, "SUCCESS"); err != nil {
+					return paymentdal.NotifyResultFailed, err
+				}
+				po.Status = "SUCCESS"
+			}
+			if po.Status != "SUCCESS" {
+				logx.WithContext(ctx).Errorf("payment paid after closed: payment=%d status=%s trade_no=%s", po.PaymentId, po.Status, tradeNo)
+				return refundLatePayment(ctx, sc, po)
+			}
+		} else {
+			po.Status = "SUCCESS"
+		}
+	}
+
+	if po.BizType == paymentdal.PaymentBizTopup {
+		return creditTopup(ctx, sc, po)
+	}
+	// 组合支付：主支付段到账后一并提交已冻结的积分、钱包分段
+	if err := split.Commit(ctx, sc, po); err != nil {
+		return paymentdal.NotifyResultFailed, err
+	}
+
+	confirmResp, err := sc.OrderRpc.ConfirmPayment(ctx, &order.ConfirmPaymentReq{
+		OrderId:       po.OrderId,
+		UserId:        po.UserId,
+		PaymentMethod: po.Channel,
+		PaymentToken:  tradeNo,
+	})
+	if err != nil {
+		return paymentdal.NotifyResultFailed, err
+	}
+	if confirmResp.GetStatusCode() != 0 {
+		logx.WithContext(ctx).Errorf("confirm order rejected after payment success: payment=%d order=%d code=%d status=%s msg=%s", po.PaymentId, po.OrderId, confirmResp.GetStatusCode(), confirmResp.GetStatus(), confirmResp.GetStatusMsg())
+		// 只有订单明确已取消才原路退款；参数错误、状态竞争等返回失败，由渠道重试通知
+		if confirmResp.GetStatus() == order.OrderStatus_ORDER_STATUS_CANCELLED {
+			return refundLatePayment(ctx, sc, po)
+		}
+		return paymentdal.NotifyResultFailed, fmt.Errorf("confirm order %d rejected: %s", po.OrderId, confirmResp.GetStatusMsg())
+	}
+	return paymentdal.NotifyResultConfirmed, nil
+}
+
+// refundLatePayment 关单后到账的款项：支付单记为 SUCCESS（与渠道账单一致）并全额退款。
+func refundLatePayment(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders) (string, error) {
+	if po.Status != "SUCCESS" {
+		if _, err := sc.PaymentOrders.UpdateStatus(ctx, po.PaymentId, []string{"FAILED", "CANCELLED", "EXPIRED"}, "SUCCESS"); err != nil {
+			return paymentdal.NotifyResultFailed, err
+		}
+		po.Status = "SUCCESS"
+	}
+	records, err := refund.Create(ctx, sc, po, refund.Request{
+		Reason:    "order closed before payment arrived",
+		RequestNo: latePaymentRequestNo,
+	})
+	if err != nil && !errors.Is(err, refund.ErrAmountOverflow) {
+		return paymentdal.NotifyResultFailed, err
+	}
+	for _, rf := range records {
+		logx.WithContext(ctx).Infof("late payment auto refund: payment=%d order=%d refund=%s channel=%s amount=%d", po.PaymentId, po.OrderId, rf.RefundNo, rf.Channel, rf.Amount)
+	}
+	return paymentdal.NotifyResultClosed, nil
+}
+
+// creditTopup 充值到账记入用户钱包，按支付单号幂等。
+func creditTopup(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders) (string, error) {
+	entry, err := sc.Wallet.Topup(ctx, po.UserId, po.Currency, po.Amount, po.PaymentNo)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("credit wallet topup failed: payment=%s user=%d amount=%d err=%v", po.PaymentNo, po.UserId, po.Amount, err)
+		return paymentdal.NotifyResultFailed, err
+	}
+	logx.WithContext(ctx).Infof("wallet topup credited: payment=%s user=%d amount=%d entry=%s", po.PaymentNo, po.UserId, po.Amount, entry.EntryNo)
+	return paymentdal.NotifyResultConfirmed, nil
+}
@@ -0,0 +1,36 @@
+package trade
+
+import (
+	"context"
+	"fmt"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+	"NatsumeAI/app/services/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// SyncChannel 主动向渠道查单，渠道已支付时按通知成功的路径确认订单，用于通知延迟或丢失的兜底。
+// 返回渠道侧交易状态。
+func SyncChannel(ctx context.Context, sc *svc.ServiceContext, po *paymentdal.PaymentOrders) (provider.TradeState, error) {
+	p, err := sc.Providers.Get(po.Channel)
+	if err != nil {
+		return "", err
+	}
+	q, err := p.Query(ctx, po.PaymentNo)
+	if err != nil {
+		return "", err
+	}
+	if q.State != provider.TradeStateSuccess {
+		return q.State, nil
+	}
+	if q.PaidAmount > 0 && q.PaidAmount != po.ChannelAmount {
+		logx.WithContext(ctx).Errorf("channel paid amount mismatch: payment=%s expected=%d paid=%d", po.PaymentNo, po.ChannelAmount, q.PaidAmount)
+		return q.State, fmt.Errorf("paid amount mismatch: payment=%s", po.PaymentNo)
+	}
+	if _, err := MarkPaid(ctx, sc, po, q.TradeNo); err != nil {
+		return q.State, err
+	}
+	return q.State, nil
+}
@@ -0,0 +1,290 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"NatsumeAI/app/common/snowflake"
+	paymentdal "NatsumeAI/app/dal/payment"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var (
+	// ErrInsufficientBalance 用户余额不足。
+	ErrInsufficientBalance = errors.New("insufficient wallet balance")
+	// ErrAccountFrozen 账户已冻结。
+	ErrAccountFrozen = errors.New("wallet account frozen")
+	// ErrEntryConflict 同一业务单号已按不同用户或金额记账。
+	ErrEntryConflict = errors.New("wallet entry conflicts with existing entry")
+)
+
+// 各科目的余额方向：用户余额、待结算款、用户积分是平台负债（贷方），渠道清算款与积分发放是借方科目
+var subjectSides = map[string]string{
+	paymentdal.WalletSubjectUserBalance:     paymentdal.WalletSideCredit,
+	paymentdal.WalletSubjectChannelClearing: paymentdal.WalletSideDebit,
+	paymentdal.WalletSubjectOrderSettlement: paymentdal.WalletSideCredit,
+	paymentdal.WalletSubjectUserPoints:      paymentdal.WalletSideCredit,
+	paymentdal.WalletSubjectPointsIssued:    paymentdal.WalletSideDebit,
+	paymentdal.WalletSubjectPointsRedeemed:  paymentdal.WalletSideCredit,
+}
+
+// posting 一条借或贷分录，UserId 为 0 表示平台科目
+type posting struct {
+	UserId  int64
+	Subject string
+	Side    string
+}
+
+// Ledger 复式记账：每笔业务生成一张凭证与一借一贷两条分录，账户余额只随分录变动。
+// 凭证按 (biz_type, biz_no) 幂等，重复记账返回已有凭证。
+// 用户账户加行锁实时维护余额；平台科目被所有用户共用，分录只追加不锁账户行，余额由日终快照汇总。
+type Ledger struct {
+	db       sqlx.SqlConn
+	accounts paymentdal.WalletAccountsModel
+	entries  paymentdal.WalletJournalEntriesModel
+	lines    paymentdal.WalletLedgerLinesModel
+
+	// 平台科目账户 ID，按 subject/currency 缓存
+	platform sync.Map
+}
+
+func NewLedger(db sqlx.SqlConn, accounts paymentdal.WalletAccountsModel, entries paymentdal.WalletJournalEntriesModel, lines paymentdal.WalletLedgerLinesModel) *Ledger {
+	return &Ledger{db: db, accounts: accounts, entries: entries, lines: lines}
+}
+
+// Topup 充值到账：借 渠道清算款，贷 用户余额。bizNo 为充值支付单号。
+func (l *Ledger) Topup(ctx context.Context, userId int64, currency string, amount int64, bizNo string) (*paymentdal.WalletJournalEntries, error) {
+	return l.post(ctx, paymentdal.WalletBizTopup, bizNo, userId, currency, amount, "钱包充值",
+		posting{Subject: paymentdal.WalletSubjectChannelClearing, Side: paymentdal.WalletSideDebit},
+		posting{UserId: userId, Subject: paymentdal.WalletSubjectUserBalance, Side: paymentdal.WalletSideCredit},
+	)
+}
+
+// Pay 余额支付：借 用户余额，贷 订单待结算款。bizNo 为支付单号，余额不足返回 ErrInsufficientBalance。
+func (l *Ledger) Pay(ctx context.Context, userId int64, currency string, amount int64, bizNo string) (*paymentdal.WalletJournalEntries, error) {
+	return l.post(ctx, paymentdal.WalletBizPayment, bizNo, userId, currency, amount, "余额支付",
+		posting{UserId: userId, Subject: paymentdal.WalletSubjectUserBalance, Side: paymentdal.WalletSideDebit},
+		posting{Subject: paymentdal.WalletSubjectOrderSettlement, Side: paymentdal.WalletSideCredit},
+	)
+}
+
+// Refund 退款到余额：借 订单待结算款，贷 用户余额。bizNo 为退款单号。
+func (l *Ledger) Refund(ctx context.Context, userId int64, currency string, amount int64, bizNo string) (*paymentdal.WalletJournalEntries, error) {
+	return l.post(ctx, paymentdal.WalletBizRefund, bizNo, userId, currency, amount, "退款到余额",
+		posting{Subject: paymentdal.WalletSubjectOrderSettlement, Side: paymentdal.WalletSideDebit},
+		posting{UserId: userId, Subject: paymentdal.WalletSubjectUserBalance, Side: paymentdal.WalletSideCredit},
+	)
+}
+
+// GrantPoints 发放积分：借 积分发放，贷 用户积分。积分账户独立记账，币种为 POINT。
+func (l *Ledger) GrantPoints(ctx context.Context, userId, points int64, bizNo, remark string) (*paymentdal.WalletJournalEntries, error) {
+	if remark == "" {
+		remark = "积分发放"
+	}
+	return l.post(ctx, paymentdal.WalletBizGrant, bizNo, userId, paymentdal.WalletCurrencyPoint, points, remark,
+		posting{Subject: paymentdal.WalletSubjectPointsIssued, Side: paymentdal.WalletSideDebit},
+		posting{UserId: userId, Subject: paymentdal.WalletSubjectUserPoints, Side: paymentdal.WalletSideCredit},
+	)
+}
+
+// RedeemPoints 积分抵扣：借 用户积分，贷 积分核销。积分不足返回 ErrInsufficientBalance。
+func (l *Ledger) RedeemPoints(ctx context.Context, userId, points int64, bizNo string) (*paymentdal.WalletJournalEntries, error) {
+	return l.post(ctx, paymentdal.WalletBizPayment, bizNo, userId, paymentdal.WalletCurrencyPoint, points, "积分抵扣",
+		posting{UserId: userId, Subject: paymentdal.WalletSubjectUserPoints, Side: paymentdal.WalletSideDebit},
+		posting{Subject: paymentdal.WalletSubjectPointsRedeemed, Side: paymentdal.WalletSideCredit},
+	)
+}
+
+// RefundPoints 积分退回：借 积分核销，贷 用户积分。
+func (l *Ledger) RefundPoints(ctx context.Context, userId, points int64, bizNo string) (*paymentdal.WalletJournalEntries, error) {
+	return l.post(ctx, paymentdal.WalletBizRefund, bizNo, userId, paymentdal.WalletCurrencyPoint, points, "积分退回",
+		posting{Subject: paymentdal.WalletSubjectPointsRedeemed, Side: paymentdal.WalletSideDebit},
+		posting{UserId: userId, Subject: paymentdal.WalletSubjectUserPoints, Side: paymentdal.WalletSideCredit},
+	)
+}
+
+// Find returns the entry of a business document, ErrNotFound if not posted.
+func (l *Ledger) Find(ctx context.Context, bizType, bizNo string) (*paymentdal.WalletJournalEntries, error) {
+	return l.entries.FindOneByBizTypeBizNo(ctx, bizType, bizNo)
+}
+
+// Balance returns the balance account of a user, a zero account is returned if never used.
+func (l *Ledger) Balance(ctx context.Context, userId int64, currency string) (*paymentdal.WalletAccounts, error) {
+	return l.userAccount(ctx, userId, paymentdal.WalletSubjectUserBalance, currency)
+}
+
+// Points returns the points account of a user, a zero account is returned if never used.
+func (l *Ledger) Points(ctx context.Context, userId int64) (*paymentdal.WalletAccounts, error) {
+	return l.userAccount(ctx, userId, paymentdal.WalletSubjectUserPoints, paymentdal.WalletCurrencyPoint)
+}
+
+func (l *Ledger) userAccount(ctx context.Context, userId int64, subject, currency string) (*paymentdal.WalletAccounts, error) {
+	acc, err := l.accounts.FindOneByUserIdSubjectCurrency(ctx, userId, subject, currency)
+	if err == paymentdal.ErrNotFound {
+		return &paymentdal.WalletAccounts{
+			UserId:     userId,
+			Subject:    subject,
+			Currency:   currency,
+			NormalSide: paymentdal.WalletSideCredit,
+			Status:     paymentdal.WalletAccountActive,
+		}, nil
+	}
+	return acc, err
+}
+
+func (l *Ledger) post(ctx context.Context, bizType, bizNo string, userId int64, currency string, amount int64, remark string, debit, credit posting) (*paymentdal.WalletJournalEntries, error) {
+	if amount <= 0 || bizNo == "" {
+		return nil, errors.New("invalid wallet entry")
+	}
+	if existing, err := l.checkPosted(ctx, bizType, bizNo, userId, amount); existing != nil || err != nil {
+		return existing, err
+	}
+
+	postings := []posting{debit, credit}
+	platformIds := make(map[string]int64, len(postings))
+	var locks []posting
+	for _, p := range postings {
+		if p.UserId == 0 {
+			id, err := l.platformAccount(ctx, p.Subject, currency)
+			if err != nil {
+				return nil, err
+			}
+			platformIds[p.Subject] = id
+			continue
+		}
+		if err := l.accounts.EnsureAccount(ctx, p.UserId, p.Subject, currency, subjectSides[p.Subject]); err != nil {
+			return nil, err
+		}
+		locks = append(locks, p)
+	}
+	// 固定加锁顺序，避免并发记账死锁
+	sort.Slice(locks, func(i, j int) bool {
+		if locks[i].UserId != locks[j].UserId {
+			return locks[i].UserId < locks[j].UserId
+		}
+		return locks[i].Subject < locks[j].Subject
+	})
+
+	entry := &paymentdal.WalletJournalEntries{
+		EntryNo:  strconv.FormatInt(snowflake.Next(), 10),
+		BizType:  bizType,
+		BizNo:    bizNo,
+		UserId:   userId,
+		Amount:   amount,
+		Currency: currency,
+		Remark:   remark,
+	}
+	err := l.db.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		accounts := make(map[posting]*paymentdal.WalletAccounts, len(locks))
+		for _, p := range locks {
+			acc, err := l.accounts.FindOneForUpdateWithSession(ctx, session, p.UserId, p.Subject, currency)
+			if err != nil {
+				return err
+			}
+			accounts[posting{UserId: p.UserId, Subject: p.Subject}] = acc
+		}
+
+		res, err := l.entries.InsertWithSession(ctx, session, entry)
+		if err != nil {
+			return err
+		}
+		entry.EntryId, _ = res.LastInsertId()
+
+		for _, p := range postings {
+			line := &paymentdal.WalletLedgerLines{
+				EntryId:   entry.EntryId,
+				Direction: p.Side,
+				Amount:    amount,
+			}
+			if p.UserId == 0 {
+				// 平台科目不维护记账后余额，允许为负（如先退款后入账）
+				line.AccountId = platformIds[p.Subject]
+			} else {
+				acc := accounts[posting{UserId: p.UserId, Subject: p.Subject}]
+				if p.Side == acc.NormalSide {
+					acc.Balance += amount
+				} else {
+					acc.Balance -= amount
+				}
+				// 用户余额不可透支
+				if acc.Status != paymentdal.WalletAccountActive {
+					return ErrAccountFrozen
+				}
+				if acc.Balance < 0 {
+					return ErrInsufficientBalance
+				}
+				if err := l.accounts.UpdateBalanceWithSession(ctx, session, acc); err != nil {
+					return err
+				}
+				line.AccountId = acc.AccountId
+				line.BalanceAfter = acc.Balance
+			}
+			if _, err := l.lines.InsertWithSession(ctx, session, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrInsufficientBalance) || errors.Is(err, ErrAccountFrozen) {
+			return nil, err
+		}
+		// 并发记账同一业务单号时唯一键冲突，返回先写入的凭证
+		if existing, findErr := l.checkPosted(ctx, bizType, bizNo, userId, amount); existing != nil || findErr != nil {
+			return existing, findErr
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// platformAccount 返回平台科目账户 ID，首次使用时建户，之后从进程内缓存读取
+func (l *Ledger) platformAccount(ctx context.Context, subject, currency string) (int64, error) {
+	key := subject + ":" + currency
+	if id, ok := l.platform.Load(key); ok {
+		return id.(int64), nil
+	}
+	if err := l.accounts.EnsureAccount(ctx, 0, subject, currency, subjectSides[subject]); err != nil {
+		return 0, err
+	}
+	acc, err := l.accounts.FindOneByUserIdSubjectCurrency(ctx, 0, subject, currency)
+	if err != nil {
+		return 0, err
+	}
+	l.platform.Store(key, acc.AccountId)
+	return acc.AccountId, nil
+}
+
+func (l *Ledger) checkPosted(ctx context.Context, bizType, bizNo string, userId, amount int64) (*paymentdal.WalletJournalEntries, error) {
+	existing, err := l.entries.FindOneByBizTypeBizNo(ctx, bizType, bizNo)
+	if err == paymentdal.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if existing.UserId != userId || existing.Amount != amount {
+		return nil, ErrEntryConflict
+	}
+	return existing, nil
+}
+
+// SyncPlatformBalances 把日终快照余额写回平台科目账户。
+func (l *Ledger) SyncPlatformBalances(ctx context.Context, day time.Time) error {
+	return l.accounts.SyncPlatformBalances(ctx, day)
+}
+
+// Entries exposes the journal entry model for statement queries.
+func (l *Ledger) Entries() paymentdal.WalletJournalEntriesModel {
+	return l.entries
+}
+
+// Lines exposes the ledger line model for statement queries.
+func (l *Ledger) Lines() paymentdal.WalletLedgerLinesModel {
+	return l.lines
+}
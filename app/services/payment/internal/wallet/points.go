@@ -0,0 +1,105 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+)
+
+// ErrPointsFraction 金额不能按积分兑换比例整除。
+var ErrPointsFraction = errors.New("amount is not a whole number of points")
+
+// PointsProvider 积分抵扣渠道：金额按 centsPerPoint 折算为积分，同步扣减与退回。
+type PointsProvider struct {
+	ledger        *Ledger
+	centsPerPoint int64
+}
+
+func NewPointsProvider(ledger *Ledger, centsPerPoint int64) *PointsProvider {
+	if centsPerPoint <= 0 {
+		centsPerPoint = 1
+	}
+	return &PointsProvider{ledger: ledger, centsPerPoint: centsPerPoint}
+}
+
+func (p *PointsProvider) Channel() string {
+	return provider.ChannelPoints
+}
+
+func (p *PointsProvider) Create(ctx context.Context, req *provider.CreateRequest) (*provider.CreateResult, error) {
+	if req.UserId <= 0 {
+		return nil, errors.New("points payment requires user id")
+	}
+	if req.Amount%p.centsPerPoint != 0 {
+		return nil, ErrPointsFraction
+	}
+	entry, err := p.ledger.RedeemPoints(ctx, req.UserId, req.Amount/p.centsPerPoint, req.PaymentNo)
+	if err != nil {
+		return nil, err
+	}
+	return &provider.CreateResult{Credential: entry.EntryNo, Paid: true, TradeNo: entry.EntryNo}, nil
+}
+
+func (p *PointsProvider) Query(ctx context.Context, paymentNo string) (*provider.QueryResult, error) {
+	entry, err := p.ledger.Find(ctx, paymentdal.WalletBizPayment, paymentNo)
+	if err == paymentdal.ErrNotFound {
+		return &provider.QueryResult{State: provider.TradeStateNotFound}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &provider.QueryResult{
+		State:      provider.TradeStateSuccess,
+		TradeNo:    entry.EntryNo,
+		PaidAmount: entry.Amount * p.centsPerPoint,
+		PaidAt:     entry.CreatedAt,
+	}, nil
+}
+
+func (p *PointsProvider) Close(ctx context.Context, paymentNo string) error {
+	_, err := p.ledger.Find(ctx, paymentdal.WalletBizPayment, paymentNo)
+	if err == paymentdal.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return provider.ErrTradePaid
+}
+
+// Refund 按比例退回积分，不足一积分的部分向下取整。
+func (p *PointsProvider) Refund(ctx context.Context, req *provider.RefundRequest) (*provider.RefundResult, error) {
+	if req.UserId <= 0 {
+		return nil, errors.New("points refund requires user id")
+	}
+	points := req.RefundAmount / p.centsPerPoint
+	if points <= 0 {
+		return &provider.RefundResult{State: provider.RefundStateSuccess}, nil
+	}
+	entry, err := p.ledger.RefundPoints(ctx, req.UserId, points, req.RefundNo)
+	if err != nil {
+		return nil, err
+	}
+	return &provider.RefundResult{State: provider.RefundStateSuccess, ChannelRefund: entry.EntryNo}, nil
+}
+
+func (p *PointsProvider) QueryRefund(ctx context.Context, paymentNo, refundNo string) (*provider.RefundResult, error) {
+	entry, err := p.ledger.Find(ctx, paymentdal.WalletBizRefund, refundNo)
+	if err == paymentdal.ErrNotFound {
+		return &provider.RefundResult{State: provider.RefundStateProcessing}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &provider.RefundResult{State: provider.RefundStateSuccess, ChannelRefund: entry.EntryNo}, nil
+}
+
+func (p *PointsProvider) VerifyNotify(ctx context.Context, req *provider.NotifyRequest) (*provider.Notification, error) {
+	return nil, provider.ErrInvalidSign
+}
+
+func (p *PointsProvider) NotifyAck(success bool) string {
+	return ""
+}
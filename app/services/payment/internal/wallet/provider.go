@@ -0,0 +1,91 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+
+	paymentdal "NatsumeAI/app/dal/payment"
+	"NatsumeAI/app/services/payment/internal/provider"
+)
+
+// Provider 余额支付渠道：下单即同步扣款，退款同步退回余额，没有异步通知。
+type Provider struct {
+	ledger *Ledger
+}
+
+func NewProvider(ledger *Ledger) *Provider {
+	return &Provider{ledger: ledger}
+}
+
+func (p *Provider) Channel() string {
+	return provider.ChannelWallet
+}
+
+func (p *Provider) Create(ctx context.Context, req *provider.CreateRequest) (*provider.CreateResult, error) {
+	if req.UserId <= 0 {
+		return nil, errors.New("wallet payment requires user id")
+	}
+	entry, err := p.ledger.Pay(ctx, req.UserId, req.Currency, req.Amount, req.PaymentNo)
+	if err != nil {
+		return nil, err
+	}
+	return &provider.CreateResult{Credential: entry.EntryNo, Paid: true, TradeNo: entry.EntryNo}, nil
+}
+
+func (p *Provider) Query(ctx context.Context, paymentNo string) (*provider.QueryResult, error) {
+	entry, err := p.ledger.Find(ctx, paymentdal.WalletBizPayment, paymentNo)
+	if err == paymentdal.ErrNotFound {
+		return &provider.QueryResult{State: provider.TradeStateNotFound}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &provider.QueryResult{
+		State:      provider.TradeStateSuccess,
+		TradeNo:    entry.EntryNo,
+		PaidAmount: entry.Amount,
+		PaidAt:     entry.CreatedAt,
+	}, nil
+}
+
+// Close 未扣款的支付单无需关闭，已扣款返回 ErrTradePaid。
+func (p *Provider) Close(ctx context.Context, paymentNo string) error {
+	_, err := p.ledger.Find(ctx, paymentdal.WalletBizPayment, paymentNo)
+	if err == paymentdal.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return provider.ErrTradePaid
+}
+
+func (p *Provider) Refund(ctx context.Context, req *provider.RefundRequest) (*provider.RefundResult, error) {
+	if req.UserId <= 0 {
+		return nil, errors.New("wallet refund requires user id")
+	}
+	entry, err := p.ledger.Refund(ctx, req.UserId, req.Currency, req.RefundAmount, req.RefundNo)
+	if err != nil {
+		return nil, err
+	}
+	return &provider.RefundResult{State: provider.RefundStateSuccess, ChannelRefund: entry.EntryNo}, nil
+}
+
+func (p *Provider) QueryRefund(ctx context.Context, paymentNo, refundNo string) (*provider.RefundResult, error) {
+	entry, err := p.ledger.Find(ctx, paymentdal.WalletBizRefund, refundNo)
+	if err == paymentdal.ErrNotFound {
+		return &provider.RefundResult{State: provider.RefundStateProcessing}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &provider.RefundResult{State: provider.RefundStateSuccess, ChannelRefund: entry.EntryNo}, nil
+}
+
+func (p *Provider) VerifyNotify(ctx context.Context, req *provider.NotifyRequest) (*provider.Notification, error) {
+	return nil, provider.ErrInvalidSign
+}
+
+func (p *Provider) NotifyAck(success bool) string {
+	return ""
+}
@@ -39,6 +39,15 @@ func main() {
 	stopAsynq := bootstrap.StartAsynq(ctx)
 	defer stopAsynq()
 
+	stopMockGateway := bootstrap.StartMockGateway(ctx)
+	defer stopMockGateway()
+
+	stopReconcile := bootstrap.StartReconcile(ctx)
+	defer stopReconcile()
+
+	stopWalletSnapshot := bootstrap.StartWalletSnapshot(ctx)
+	defer stopWalletSnapshot()
+
 	if err := consul.RegisterService(c.ListenOn, c.Consul); err != nil {
 		logx.Errorw("register service error", logx.Field("err", err))
 		panic(err)
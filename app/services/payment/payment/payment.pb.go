@@ -82,18 +82,82 @@ func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
 	return file_payment_proto_rawDescGZIP(), []int{0}
 }
 
+type RefundStatus int32
+
+const (
+	RefundStatus_REFUND_STATUS_UNKNOWN    RefundStatus = 0
+	RefundStatus_REFUND_STATUS_PENDING    RefundStatus = 1
+	RefundStatus_REFUND_STATUS_PROCESSING RefundStatus = 2
+	RefundStatus_REFUND_STATUS_SUCCESS    RefundStatus = 3
+	RefundStatus_REFUND_STATUS_FAILED     RefundStatus = 4
+)
+
+// Enum value maps for RefundStatus.
+var (
+	RefundStatus_name = map[int32]string{
+		0: "REFUND_STATUS_UNKNOWN",
+		1: "REFUND_STATUS_PENDING",
+		2: "REFUND_STATUS_PROCESSING",
+		3: "REFUND_STATUS_SUCCESS",
+		4: "REFUND_STATUS_FAILED",
+	}
+	RefundStatus_value = map[string]int32{
+		"REFUND_STATUS_UNKNOWN":    0,
+		"REFUND_STATUS_PENDING":    1,
+		"REFUND_STATUS_PROCESSING": 2,
+		"REFUND_STATUS_SUCCESS":    3,
+		"REFUND_STATUS_FAILED":     4,
+	}
+)
+
+func (x RefundStatus) Enum() *RefundStatus {
+	p := new(RefundStatus)
+	*p = x
+	return p
+}
+
+func (x RefundStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (RefundStatus) Type() protoreflect.EnumType {
+	return &file_payment_proto_enumTypes[1]
+}
+
+func (x RefundStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundStatus.Descriptor instead.
+func (RefundStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{1}
+}
+
 type PaymentInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	PaymentNo     string                 `protobuf:"bytes,2,opt,name=payment_no,json=paymentNo,proto3" json:"payment_no,omitempty"`
-	Status        PaymentStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=payment.PaymentStatus" json:"status,omitempty"`
-	OrderId       int64                  `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	Channel       string                 `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel,omitempty"`
-	Credential    string                 `protobuf:"bytes,9,opt,name=credential,proto3" json:"credential,omitempty"`
-	TimeoutAt     int64                  `protobuf:"varint,10,opt,name=timeout_at,json=timeoutAt,proto3" json:"timeout_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId  int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	PaymentNo  string                 `protobuf:"bytes,2,opt,name=payment_no,json=paymentNo,proto3" json:"payment_no,omitempty"`
+	Status     PaymentStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=payment.PaymentStatus" json:"status,omitempty"`
+	OrderId    int64                  `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId     int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount     int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency   string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Channel    string                 `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel,omitempty"`
+	Credential string                 `protobuf:"bytes,9,opt,name=credential,proto3" json:"credential,omitempty"`
+	TimeoutAt  int64                  `protobuf:"varint,10,opt,name=timeout_at,json=timeoutAt,proto3" json:"timeout_at,omitempty"`
+	// ORDER / TOPUP
+	BizType string `protobuf:"bytes,11,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
+	// 主渠道应付金额，组合支付时为扣除积分、钱包后的剩余部分
+	ChannelAmount int64 `protobuf:"varint,12,opt,name=channel_amount,json=channelAmount,proto3" json:"channel_amount,omitempty"`
+	// 组合支付分段，单一渠道支付时为空
+	Splits []*PaymentSplit `protobuf:"bytes,13,rep,name=splits,proto3" json:"splits,omitempty"`
+	// 商品标价币种与标价币种 → 支付币种的汇率快照（放大 1e8 倍），取自订单
+	PriceCurrency string `protobuf:"bytes,14,opt,name=price_currency,json=priceCurrency,proto3" json:"price_currency,omitempty"`
+	FxRate        int64  `protobuf:"varint,15,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -198,22 +262,150 @@ func (x *PaymentInfo) GetTimeoutAt() int64 {
 	return 0
 }
 
+func (x *PaymentInfo) GetBizType() string {
+	if x != nil {
+		return x.BizType
+	}
+	return ""
+}
+
+func (x *PaymentInfo) GetChannelAmount() int64 {
+	if x != nil {
+		return x.ChannelAmount
+	}
+	return 0
+}
+
+func (x *PaymentInfo) GetSplits() []*PaymentSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *PaymentInfo) GetPriceCurrency() string {
+	if x != nil {
+		return x.PriceCurrency
+	}
+	return ""
+}
+
+func (x *PaymentInfo) GetFxRate() int64 {
+	if x != nil {
+		return x.FxRate
+	}
+	return 0
+}
+
+type PaymentSplit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SplitNo string                 `protobuf:"bytes,1,opt,name=split_no,json=splitNo,proto3" json:"split_no,omitempty"`
+	LegNo   int64                  `protobuf:"varint,2,opt,name=leg_no,json=legNo,proto3" json:"leg_no,omitempty"`
+	Channel string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Amount  int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 积分分段消耗的积分数
+	Points int64 `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	// PENDING / HELD / COMMITTED / ROLLED_BACK
+	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentSplit) Reset() {
+	*x = PaymentSplit{}
+	mi := &file_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSplit) ProtoMessage() {}
+
+func (x *PaymentSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSplit.ProtoReflect.Descriptor instead.
+func (*PaymentSplit) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentSplit) GetSplitNo() string {
+	if x != nil {
+		return x.SplitNo
+	}
+	return ""
+}
+
+func (x *PaymentSplit) GetLegNo() int64 {
+	if x != nil {
+		return x.LegNo
+	}
+	return 0
+}
+
+func (x *PaymentSplit) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PaymentSplit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentSplit) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *PaymentSplit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type CreatePaymentReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	Channel       string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
-	ClientIp      string                 `protobuf:"bytes,6,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
-	Subject       string                 `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OrderId  int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId   int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount   int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Channel  string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	ClientIp string                 `protobuf:"bytes,6,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Subject  string                 `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`
+	// 使用钱包余额抵扣的金额（分）
+	WalletAmount int64 `protobuf:"varint,8,opt,name=wallet_amount,json=walletAmount,proto3" json:"wallet_amount,omitempty"`
+	// 使用的积分数，按 Points.CentsPerPoint 折算
+	Points int64 `protobuf:"varint,9,opt,name=points,proto3" json:"points,omitempty"`
+	// 订单上的汇率快照，未传时视为标价币种与支付币种相同
+	PriceCurrency string `protobuf:"bytes,10,opt,name=price_currency,json=priceCurrency,proto3" json:"price_currency,omitempty"`
+	FxRate        int64  `protobuf:"varint,11,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePaymentReq) Reset() {
 	*x = CreatePaymentReq{}
-	mi := &file_payment_proto_msgTypes[1]
+	mi := &file_payment_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +417,7 @@ func (x *CreatePaymentReq) String() string {
 func (*CreatePaymentReq) ProtoMessage() {}
 
 func (x *CreatePaymentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[1]
+	mi := &file_payment_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +430,7 @@ func (x *CreatePaymentReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentReq.ProtoReflect.Descriptor instead.
 func (*CreatePaymentReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{1}
+	return file_payment_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreatePaymentReq) GetOrderId() int64 {
@@ -290,6 +482,34 @@ func (x *CreatePaymentReq) GetSubject() string {
 	return ""
 }
 
+func (x *CreatePaymentReq) GetWalletAmount() int64 {
+	if x != nil {
+		return x.WalletAmount
+	}
+	return 0
+}
+
+func (x *CreatePaymentReq) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CreatePaymentReq) GetPriceCurrency() string {
+	if x != nil {
+		return x.PriceCurrency
+	}
+	return ""
+}
+
+func (x *CreatePaymentReq) GetFxRate() int64 {
+	if x != nil {
+		return x.FxRate
+	}
+	return 0
+}
+
 type CreatePaymentResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -301,7 +521,7 @@ type CreatePaymentResp struct {
 
 func (x *CreatePaymentResp) Reset() {
 	*x = CreatePaymentResp{}
-	mi := &file_payment_proto_msgTypes[2]
+	mi := &file_payment_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +533,7 @@ func (x *CreatePaymentResp) String() string {
 func (*CreatePaymentResp) ProtoMessage() {}
 
 func (x *CreatePaymentResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[2]
+	mi := &file_payment_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +546,7 @@ func (x *CreatePaymentResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentResp.ProtoReflect.Descriptor instead.
 func (*CreatePaymentResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{2}
+	return file_payment_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePaymentResp) GetStatusCode() int64 {
@@ -361,7 +581,7 @@ type GetPaymentReq struct {
 
 func (x *GetPaymentReq) Reset() {
 	*x = GetPaymentReq{}
-	mi := &file_payment_proto_msgTypes[3]
+	mi := &file_payment_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -373,7 +593,7 @@ func (x *GetPaymentReq) String() string {
 func (*GetPaymentReq) ProtoMessage() {}
 
 func (x *GetPaymentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[3]
+	mi := &file_payment_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +606,7 @@ func (x *GetPaymentReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentReq.ProtoReflect.Descriptor instead.
 func (*GetPaymentReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{3}
+	return file_payment_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetPaymentReq) GetPaymentId() int64 {
@@ -421,7 +641,7 @@ type GetPaymentResp struct {
 
 func (x *GetPaymentResp) Reset() {
 	*x = GetPaymentResp{}
-	mi := &file_payment_proto_msgTypes[4]
+	mi := &file_payment_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -433,7 +653,7 @@ func (x *GetPaymentResp) String() string {
 func (*GetPaymentResp) ProtoMessage() {}
 
 func (x *GetPaymentResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[4]
+	mi := &file_payment_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +666,7 @@ func (x *GetPaymentResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentResp.ProtoReflect.Descriptor instead.
 func (*GetPaymentResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{4}
+	return file_payment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetPaymentResp) GetStatusCode() int64 {
@@ -470,54 +690,2153 @@ func (x *GetPaymentResp) GetPayment() *PaymentInfo {
 	return nil
 }
 
-var File_payment_proto protoreflect.FileDescriptor
+// 渠道异步通知：原样转发请求头与报文，由支付服务验签处理
+type HandleNotifyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body          []byte                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_payment_proto_rawDesc = "" +
-	"\n" +
-	"\rpayment.proto\x12\apayment\"\xbc\x02\n" +
-	"\vPaymentInfo\x12\x1d\n" +
-	"\n" +
-	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x1d\n" +
-	"\n" +
-	"payment_no\x18\x02 \x01(\tR\tpaymentNo\x12.\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x16.payment.PaymentStatusR\x06status\x12\x19\n" +
-	"\border_id\x18\x04 \x01(\x03R\aorderId\x12\x17\n" +
-	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x18\n" +
-	"\achannel\x18\b \x01(\tR\achannel\x12\x1e\n" +
-	"\n" +
-	"credential\x18\t \x01(\tR\n" +
-	"credential\x12\x1d\n" +
-	"\n" +
-	"timeout_at\x18\n" +
-	" \x01(\x03R\ttimeoutAt\"\xcb\x01\n" +
-	"\x10CreatePaymentReq\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x18\n" +
-	"\achannel\x18\x05 \x01(\tR\achannel\x12\x1b\n" +
-	"\tclient_ip\x18\x06 \x01(\tR\bclientIp\x12\x18\n" +
-	"\asubject\x18\a \x01(\tR\asubject\"\x83\x01\n" +
-	"\x11CreatePaymentResp\x12\x1f\n" +
-	"\vstatus_code\x18\x01 \x01(\x03R\n" +
-	"statusCode\x12\x1d\n" +
-	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12.\n" +
-	"\apayment\x18\x03 \x01(\v2\x14.payment.PaymentInfoR\apayment\"h\n" +
-	"\rGetPaymentReq\x12\x1d\n" +
-	"\n" +
-	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x1d\n" +
-	"\n" +
-	"payment_no\x18\x02 \x01(\tR\tpaymentNo\x12\x19\n" +
-	"\border_id\x18\x03 \x01(\x03R\aorderId\"\x80\x01\n" +
-	"\x0eGetPaymentResp\x12\x1f\n" +
-	"\vstatus_code\x18\x01 \x01(\x03R\n" +
-	"statusCode\x12\x1d\n" +
-	"\n" +
-	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12.\n" +
-	"\apayment\x18\x03 \x01(\v2\x14.payment.PaymentInfoR\apayment*\xd4\x01\n" +
+func (x *HandleNotifyReq) Reset() {
+	*x = HandleNotifyReq{}
+	mi := &file_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleNotifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleNotifyReq) ProtoMessage() {}
+
+func (x *HandleNotifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleNotifyReq.ProtoReflect.Descriptor instead.
+func (*HandleNotifyReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HandleNotifyReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *HandleNotifyReq) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HandleNotifyReq) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type HandleNotifyResp struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg  string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	// 返回给渠道的应答报文
+	Ack           string `protobuf:"bytes,3,opt,name=ack,proto3" json:"ack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleNotifyResp) Reset() {
+	*x = HandleNotifyResp{}
+	mi := &file_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleNotifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleNotifyResp) ProtoMessage() {}
+
+func (x *HandleNotifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleNotifyResp.ProtoReflect.Descriptor instead.
+func (*HandleNotifyResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HandleNotifyResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *HandleNotifyResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *HandleNotifyResp) GetAck() string {
+	if x != nil {
+		return x.Ack
+	}
+	return ""
+}
+
+// 订单取消时关闭支付单：关闭渠道交易并置为 CANCELLED，关单后到账的款项自动退款
+type ClosePaymentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePaymentReq) Reset() {
+	*x = ClosePaymentReq{}
+	mi := &file_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePaymentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePaymentReq) ProtoMessage() {}
+
+func (x *ClosePaymentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePaymentReq.ProtoReflect.Descriptor instead.
+func (*ClosePaymentReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClosePaymentReq) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ClosePaymentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClosePaymentReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ClosePaymentResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Payment       *PaymentInfo           `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePaymentResp) Reset() {
+	*x = ClosePaymentResp{}
+	mi := &file_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePaymentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePaymentResp) ProtoMessage() {}
+
+func (x *ClosePaymentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePaymentResp.ProtoReflect.Descriptor instead.
+func (*ClosePaymentResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ClosePaymentResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ClosePaymentResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ClosePaymentResp) GetPayment() *PaymentInfo {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type RefundInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RefundId        int64                  `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	RefundNo        string                 `protobuf:"bytes,2,opt,name=refund_no,json=refundNo,proto3" json:"refund_no,omitempty"`
+	RequestNo       string                 `protobuf:"bytes,3,opt,name=request_no,json=requestNo,proto3" json:"request_no,omitempty"`
+	PaymentId       int64                  `protobuf:"varint,4,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	OrderId         int64                  `protobuf:"varint,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId          int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount          int64                  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency        string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	Channel         string                 `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	Status          RefundStatus           `protobuf:"varint,10,opt,name=status,proto3,enum=payment.RefundStatus" json:"status,omitempty"`
+	Reason          string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChannelRefundNo string                 `protobuf:"bytes,12,opt,name=channel_refund_no,json=channelRefundNo,proto3" json:"channel_refund_no,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt      int64                  `protobuf:"varint,14,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// 组合支付时对应的支付分段
+	SplitId int64 `protobuf:"varint,15,opt,name=split_id,json=splitId,proto3" json:"split_id,omitempty"`
+	// 沿用支付单的汇率快照，price_amount 为按原汇率折回标价币种的金额
+	FxRate        int64 `protobuf:"varint,16,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
+	PriceAmount   int64 `protobuf:"varint,17,opt,name=price_amount,json=priceAmount,proto3" json:"price_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundInfo) Reset() {
+	*x = RefundInfo{}
+	mi := &file_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundInfo) ProtoMessage() {}
+
+func (x *RefundInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundInfo.ProtoReflect.Descriptor instead.
+func (*RefundInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RefundInfo) GetRefundId() int64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetRefundNo() string {
+	if x != nil {
+		return x.RefundNo
+	}
+	return ""
+}
+
+func (x *RefundInfo) GetRequestNo() string {
+	if x != nil {
+		return x.RequestNo
+	}
+	return ""
+}
+
+func (x *RefundInfo) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RefundInfo) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RefundInfo) GetStatus() RefundStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RefundStatus_REFUND_STATUS_UNKNOWN
+}
+
+func (x *RefundInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundInfo) GetChannelRefundNo() string {
+	if x != nil {
+		return x.ChannelRefundNo
+	}
+	return ""
+}
+
+func (x *RefundInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetSplitId() int64 {
+	if x != nil {
+		return x.SplitId
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetFxRate() int64 {
+	if x != nil {
+		return x.FxRate
+	}
+	return 0
+}
+
+func (x *RefundInfo) GetPriceAmount() int64 {
+	if x != nil {
+		return x.PriceAmount
+	}
+	return 0
+}
+
+// 退款：按 payment_id 或 order_id 定位支付单，同一支付单可多次部分退款，累计不超过实付金额
+type CreateRefundReq struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	OrderId   int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// 退款金额（分），为 0 时退还剩余可退金额
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 业务请求号，同一支付单内幂等；为空时每次请求都会生成新的退款单
+	RequestNo string `protobuf:"bytes,5,opt,name=request_no,json=requestNo,proto3" json:"request_no,omitempty"`
+	// 退回钱包余额而不是原路退回
+	ToWallet      bool `protobuf:"varint,6,opt,name=to_wallet,json=toWallet,proto3" json:"to_wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRefundReq) Reset() {
+	*x = CreateRefundReq{}
+	mi := &file_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRefundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRefundReq) ProtoMessage() {}
+
+func (x *CreateRefundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRefundReq.ProtoReflect.Descriptor instead.
+func (*CreateRefundReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateRefundReq) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *CreateRefundReq) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateRefundReq) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateRefundReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateRefundReq) GetRequestNo() string {
+	if x != nil {
+		return x.RequestNo
+	}
+	return ""
+}
+
+func (x *CreateRefundReq) GetToWallet() bool {
+	if x != nil {
+		return x.ToWallet
+	}
+	return false
+}
+
+type CreateRefundResp struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg  string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Refund     *RefundInfo            `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	// 组合支付按分段拆分的全部退款单，refund 为其中第一笔
+	Legs          []*RefundInfo `protobuf:"bytes,4,rep,name=legs,proto3" json:"legs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRefundResp) Reset() {
+	*x = CreateRefundResp{}
+	mi := &file_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRefundResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRefundResp) ProtoMessage() {}
+
+func (x *CreateRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRefundResp.ProtoReflect.Descriptor instead.
+func (*CreateRefundResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateRefundResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CreateRefundResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CreateRefundResp) GetRefund() *RefundInfo {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *CreateRefundResp) GetLegs() []*RefundInfo {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+type GetRefundReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      int64                  `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	RefundNo      string                 `protobuf:"bytes,2,opt,name=refund_no,json=refundNo,proto3" json:"refund_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundReq) Reset() {
+	*x = GetRefundReq{}
+	mi := &file_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundReq) ProtoMessage() {}
+
+func (x *GetRefundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundReq.ProtoReflect.Descriptor instead.
+func (*GetRefundReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRefundReq) GetRefundId() int64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *GetRefundReq) GetRefundNo() string {
+	if x != nil {
+		return x.RefundNo
+	}
+	return ""
+}
+
+type GetRefundResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Refund        *RefundInfo            `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundResp) Reset() {
+	*x = GetRefundResp{}
+	mi := &file_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundResp) ProtoMessage() {}
+
+func (x *GetRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundResp.ProtoReflect.Descriptor instead.
+func (*GetRefundResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetRefundResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetRefundResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetRefundResp) GetRefund() *RefundInfo {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+// 对账：导入渠道对账单（CSV），按 payment_no 与本地支付单比对
+type ReconcileReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 账单日期 yyyy-MM-dd，本地侧取当日支付成功的支付单
+	BillDate string `protobuf:"bytes,2,opt,name=bill_date,json=billDate,proto3" json:"bill_date,omitempty"`
+	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// CSV 内容，首行为表头：payment_no,trade_no,amount(元),status[,paid_at]
+	Statement []byte `protobuf:"bytes,4,opt,name=statement,proto3" json:"statement,omitempty"`
+	// 对账单已成功但本地仍处于 PROCESSING 的支付单自动补单
+	AutoFix       bool `protobuf:"varint,5,opt,name=auto_fix,json=autoFix,proto3" json:"auto_fix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileReq) Reset() {
+	*x = ReconcileReq{}
+	mi := &file_payment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileReq) ProtoMessage() {}
+
+func (x *ReconcileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileReq.ProtoReflect.Descriptor instead.
+func (*ReconcileReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReconcileReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ReconcileReq) GetBillDate() string {
+	if x != nil {
+		return x.BillDate
+	}
+	return ""
+}
+
+func (x *ReconcileReq) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ReconcileReq) GetStatement() []byte {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+func (x *ReconcileReq) GetAutoFix() bool {
+	if x != nil {
+		return x.AutoFix
+	}
+	return false
+}
+
+type ReconcileItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PaymentNo string                 `protobuf:"bytes,1,opt,name=payment_no,json=paymentNo,proto3" json:"payment_no,omitempty"`
+	// MISSING / EXTRA / AMOUNT_MISMATCH / STATUS_MISMATCH
+	Kind          string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	LocalAmount   int64  `protobuf:"varint,3,opt,name=local_amount,json=localAmount,proto3" json:"local_amount,omitempty"`
+	ChannelAmount int64  `protobuf:"varint,4,opt,name=channel_amount,json=channelAmount,proto3" json:"channel_amount,omitempty"`
+	LocalStatus   string `protobuf:"bytes,5,opt,name=local_status,json=localStatus,proto3" json:"local_status,omitempty"`
+	ChannelStatus string `protobuf:"bytes,6,opt,name=channel_status,json=channelStatus,proto3" json:"channel_status,omitempty"`
+	TradeNo       string `protobuf:"bytes,7,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no,omitempty"`
+	Fixed         bool   `protobuf:"varint,8,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Note          string `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileItem) Reset() {
+	*x = ReconcileItem{}
+	mi := &file_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileItem) ProtoMessage() {}
+
+func (x *ReconcileItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileItem.ProtoReflect.Descriptor instead.
+func (*ReconcileItem) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReconcileItem) GetPaymentNo() string {
+	if x != nil {
+		return x.PaymentNo
+	}
+	return ""
+}
+
+func (x *ReconcileItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconcileItem) GetLocalAmount() int64 {
+	if x != nil {
+		return x.LocalAmount
+	}
+	return 0
+}
+
+func (x *ReconcileItem) GetChannelAmount() int64 {
+	if x != nil {
+		return x.ChannelAmount
+	}
+	return 0
+}
+
+func (x *ReconcileItem) GetLocalStatus() string {
+	if x != nil {
+		return x.LocalStatus
+	}
+	return ""
+}
+
+func (x *ReconcileItem) GetChannelStatus() string {
+	if x != nil {
+		return x.ChannelStatus
+	}
+	return ""
+}
+
+func (x *ReconcileItem) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *ReconcileItem) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+func (x *ReconcileItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReconcileReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReportId       int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Channel        string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	BillDate       string                 `protobuf:"bytes,3,opt,name=bill_date,json=billDate,proto3" json:"bill_date,omitempty"`
+	FileName       string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	AutoFix        bool                   `protobuf:"varint,6,opt,name=auto_fix,json=autoFix,proto3" json:"auto_fix,omitempty"`
+	TotalRows      int64                  `protobuf:"varint,7,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	Matched        int64                  `protobuf:"varint,8,opt,name=matched,proto3" json:"matched,omitempty"`
+	Missing        int64                  `protobuf:"varint,9,opt,name=missing,proto3" json:"missing,omitempty"`
+	Extra          int64                  `protobuf:"varint,10,opt,name=extra,proto3" json:"extra,omitempty"`
+	AmountMismatch int64                  `protobuf:"varint,11,opt,name=amount_mismatch,json=amountMismatch,proto3" json:"amount_mismatch,omitempty"`
+	StatusMismatch int64                  `protobuf:"varint,12,opt,name=status_mismatch,json=statusMismatch,proto3" json:"status_mismatch,omitempty"`
+	Fixed          int64                  `protobuf:"varint,13,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Error          string                 `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	Items          []*ReconcileItem       `protobuf:"bytes,15,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReconcileReport) Reset() {
+	*x = ReconcileReport{}
+	mi := &file_payment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileReport) ProtoMessage() {}
+
+func (x *ReconcileReport) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileReport.ProtoReflect.Descriptor instead.
+func (*ReconcileReport) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReconcileReport) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ReconcileReport) GetBillDate() string {
+	if x != nil {
+		return x.BillDate
+	}
+	return ""
+}
+
+func (x *ReconcileReport) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ReconcileReport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReconcileReport) GetAutoFix() bool {
+	if x != nil {
+		return x.AutoFix
+	}
+	return false
+}
+
+func (x *ReconcileReport) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetMatched() int64 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetMissing() int64 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetExtra() int64 {
+	if x != nil {
+		return x.Extra
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetAmountMismatch() int64 {
+	if x != nil {
+		return x.AmountMismatch
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetStatusMismatch() int64 {
+	if x != nil {
+		return x.StatusMismatch
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetFixed() int64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+func (x *ReconcileReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ReconcileReport) GetItems() []*ReconcileItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReconcileReport) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ReconcileResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Report        *ReconcileReport       `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileResp) Reset() {
+	*x = ReconcileResp{}
+	mi := &file_payment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileResp) ProtoMessage() {}
+
+func (x *ReconcileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileResp.ProtoReflect.Descriptor instead.
+func (*ReconcileResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReconcileResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ReconcileResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ReconcileResp) GetReport() *ReconcileReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type GetReconcileReportReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconcileReportReq) Reset() {
+	*x = GetReconcileReportReq{}
+	mi := &file_payment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconcileReportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconcileReportReq) ProtoMessage() {}
+
+func (x *GetReconcileReportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconcileReportReq.ProtoReflect.Descriptor instead.
+func (*GetReconcileReportReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetReconcileReportReq) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+type GetReconcileReportResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Report        *ReconcileReport       `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconcileReportResp) Reset() {
+	*x = GetReconcileReportResp{}
+	mi := &file_payment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconcileReportResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconcileReportResp) ProtoMessage() {}
+
+func (x *GetReconcileReportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconcileReportResp.ProtoReflect.Descriptor instead.
+func (*GetReconcileReportResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetReconcileReportResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetReconcileReportResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetReconcileReportResp) GetReport() *ReconcileReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// 钱包：复式记账，余额只随记账凭证变动
+type WalletInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance  int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// ACTIVE / FROZEN
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// 积分余额
+	Points        int64 `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletInfo) Reset() {
+	*x = WalletInfo{}
+	mi := &file_payment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletInfo) ProtoMessage() {}
+
+func (x *WalletInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
+func (*WalletInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WalletInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WalletInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *WalletInfo) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *WalletInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WalletInfo) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type GetWalletReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletReq) Reset() {
+	*x = GetWalletReq{}
+	mi := &file_payment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletReq) ProtoMessage() {}
+
+func (x *GetWalletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletReq.ProtoReflect.Descriptor instead.
+func (*GetWalletReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetWalletReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetWalletReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetWalletResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Wallet        *WalletInfo            `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletResp) Reset() {
+	*x = GetWalletResp{}
+	mi := &file_payment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletResp) ProtoMessage() {}
+
+func (x *GetWalletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletResp.ProtoReflect.Descriptor instead.
+func (*GetWalletResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetWalletResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetWalletResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetWalletResp) GetWallet() *WalletInfo {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+// 充值：通过外部渠道支付，到账后记入钱包余额
+type CreateTopupReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTopupReq) Reset() {
+	*x = CreateTopupReq{}
+	mi := &file_payment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTopupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopupReq) ProtoMessage() {}
+
+func (x *CreateTopupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopupReq.ProtoReflect.Descriptor instead.
+func (*CreateTopupReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateTopupReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateTopupReq) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateTopupReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateTopupReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CreateTopupReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type CreateTopupResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Payment       *PaymentInfo           `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTopupResp) Reset() {
+	*x = CreateTopupResp{}
+	mi := &file_payment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTopupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopupResp) ProtoMessage() {}
+
+func (x *CreateTopupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopupResp.ProtoReflect.Descriptor instead.
+func (*CreateTopupResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateTopupResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CreateTopupResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *CreateTopupResp) GetPayment() *PaymentInfo {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type WalletEntry struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EntryNo string                 `protobuf:"bytes,1,opt,name=entry_no,json=entryNo,proto3" json:"entry_no,omitempty"`
+	// TOPUP / PAYMENT / REFUND
+	BizType string `protobuf:"bytes,2,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
+	BizNo   string `protobuf:"bytes,3,opt,name=biz_no,json=bizNo,proto3" json:"biz_no,omitempty"`
+	// DEBIT 支出 / CREDIT 收入
+	Direction     string `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Amount        int64  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceAfter  int64  `protobuf:"varint,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	Remark        string `protobuf:"bytes,7,opt,name=remark,proto3" json:"remark,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletEntry) Reset() {
+	*x = WalletEntry{}
+	mi := &file_payment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletEntry) ProtoMessage() {}
+
+func (x *WalletEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletEntry.ProtoReflect.Descriptor instead.
+func (*WalletEntry) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WalletEntry) GetEntryNo() string {
+	if x != nil {
+		return x.EntryNo
+	}
+	return ""
+}
+
+func (x *WalletEntry) GetBizType() string {
+	if x != nil {
+		return x.BizType
+	}
+	return ""
+}
+
+func (x *WalletEntry) GetBizNo() string {
+	if x != nil {
+		return x.BizNo
+	}
+	return ""
+}
+
+func (x *WalletEntry) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *WalletEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WalletEntry) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *WalletEntry) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *WalletEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListWalletEntriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Page          int64                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int64                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletEntriesReq) Reset() {
+	*x = ListWalletEntriesReq{}
+	mi := &file_payment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletEntriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletEntriesReq) ProtoMessage() {}
+
+func (x *ListWalletEntriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletEntriesReq.ProtoReflect.Descriptor instead.
+func (*ListWalletEntriesReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListWalletEntriesReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListWalletEntriesReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListWalletEntriesReq) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWalletEntriesReq) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListWalletEntriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Entries       []*WalletEntry         `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletEntriesResp) Reset() {
+	*x = ListWalletEntriesResp{}
+	mi := &file_payment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletEntriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletEntriesResp) ProtoMessage() {}
+
+func (x *ListWalletEntriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletEntriesResp.ProtoReflect.Descriptor instead.
+func (*ListWalletEntriesResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListWalletEntriesResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListWalletEntriesResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListWalletEntriesResp) GetEntries() []*WalletEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListWalletEntriesResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 发放积分：biz_no 幂等，重复发放返回当前余额
+type GrantPointsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Points        int64                  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	BizNo         string                 `protobuf:"bytes,3,opt,name=biz_no,json=bizNo,proto3" json:"biz_no,omitempty"`
+	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantPointsReq) Reset() {
+	*x = GrantPointsReq{}
+	mi := &file_payment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPointsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPointsReq) ProtoMessage() {}
+
+func (x *GrantPointsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPointsReq.ProtoReflect.Descriptor instead.
+func (*GrantPointsReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GrantPointsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GrantPointsReq) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GrantPointsReq) GetBizNo() string {
+	if x != nil {
+		return x.BizNo
+	}
+	return ""
+}
+
+func (x *GrantPointsReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type GrantPointsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantPointsResp) Reset() {
+	*x = GrantPointsResp{}
+	mi := &file_payment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPointsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPointsResp) ProtoMessage() {}
+
+func (x *GrantPointsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPointsResp.ProtoReflect.Descriptor instead.
+func (*GrantPointsResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GrantPointsResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GrantPointsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GrantPointsResp) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+var File_payment_proto protoreflect.FileDescriptor
+
+const file_payment_proto_rawDesc = "" +
+	"\n" +
+	"\rpayment.proto\x12\apayment\"\xed\x03\n" +
+	"\vPaymentInfo\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"payment_no\x18\x02 \x01(\tR\tpaymentNo\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.payment.PaymentStatusR\x06status\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x18\n" +
+	"\achannel\x18\b \x01(\tR\achannel\x12\x1e\n" +
+	"\n" +
+	"credential\x18\t \x01(\tR\n" +
+	"credential\x12\x1d\n" +
+	"\n" +
+	"timeout_at\x18\n" +
+	" \x01(\x03R\ttimeoutAt\x12\x19\n" +
+	"\bbiz_type\x18\v \x01(\tR\abizType\x12%\n" +
+	"\x0echannel_amount\x18\f \x01(\x03R\rchannelAmount\x12-\n" +
+	"\x06splits\x18\r \x03(\v2\x15.payment.PaymentSplitR\x06splits\x12%\n" +
+	"\x0eprice_currency\x18\x0e \x01(\tR\rpriceCurrency\x12\x17\n" +
+	"\afx_rate\x18\x0f \x01(\x03R\x06fxRate\"\xa2\x01\n" +
+	"\fPaymentSplit\x12\x19\n" +
+	"\bsplit_no\x18\x01 \x01(\tR\asplitNo\x12\x15\n" +
+	"\x06leg_no\x18\x02 \x01(\x03R\x05legNo\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x03R\x06points\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"\xc8\x02\n" +
+	"\x10CreatePaymentReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\x12\x1b\n" +
+	"\tclient_ip\x18\x06 \x01(\tR\bclientIp\x12\x18\n" +
+	"\asubject\x18\a \x01(\tR\asubject\x12#\n" +
+	"\rwallet_amount\x18\b \x01(\x03R\fwalletAmount\x12\x16\n" +
+	"\x06points\x18\t \x01(\x03R\x06points\x12%\n" +
+	"\x0eprice_currency\x18\n" +
+	" \x01(\tR\rpriceCurrency\x12\x17\n" +
+	"\afx_rate\x18\v \x01(\x03R\x06fxRate\"\x83\x01\n" +
+	"\x11CreatePaymentResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12.\n" +
+	"\apayment\x18\x03 \x01(\v2\x14.payment.PaymentInfoR\apayment\"h\n" +
+	"\rGetPaymentReq\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"payment_no\x18\x02 \x01(\tR\tpaymentNo\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x03R\aorderId\"\x80\x01\n" +
+	"\x0eGetPaymentResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12.\n" +
+	"\apayment\x18\x03 \x01(\v2\x14.payment.PaymentInfoR\apayment\"\xbc\x01\n" +
+	"\x0fHandleNotifyReq\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12?\n" +
+	"\aheaders\x18\x02 \x03(\v2%.payment.HandleNotifyReq.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\fR\x04body\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
+	"\x10HandleNotifyResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x10\n" +
+	"\x03ack\x18\x03 \x01(\tR\x03ack\"]\n" +
+	"\x0fClosePaymentReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x82\x01\n" +
+	"\x10ClosePaymentResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12.\n" +
+	"\apayment\x18\x03 \x01(\v2\x14.payment.PaymentInfoR\apayment\"\x90\x04\n" +
+	"\n" +
+	"RefundInfo\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\x03R\brefundId\x12\x1b\n" +
+	"\trefund_no\x18\x02 \x01(\tR\brefundNo\x12\x1d\n" +
+	"\n" +
+	"request_no\x18\x03 \x01(\tR\trequestNo\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x04 \x01(\x03R\tpaymentId\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x18\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12-\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x15.payment.RefundStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\v \x01(\tR\x06reason\x12*\n" +
+	"\x11channel_refund_no\x18\f \x01(\tR\x0fchannelRefundNo\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x0e \x01(\x03R\n" +
+	"finishedAt\x12\x19\n" +
+	"\bsplit_id\x18\x0f \x01(\x03R\asplitId\x12\x17\n" +
+	"\afx_rate\x18\x10 \x01(\x03R\x06fxRate\x12!\n" +
+	"\fprice_amount\x18\x11 \x01(\x03R\vpriceAmount\"\xb7\x01\n" +
+	"\x0fCreateRefundReq\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"request_no\x18\x05 \x01(\tR\trequestNo\x12\x1b\n" +
+	"\tto_wallet\x18\x06 \x01(\bR\btoWallet\"\xa8\x01\n" +
+	"\x10CreateRefundResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12+\n" +
+	"\x06refund\x18\x03 \x01(\v2\x13.payment.RefundInfoR\x06refund\x12'\n" +
+	"\x04legs\x18\x04 \x03(\v2\x13.payment.RefundInfoR\x04legs\"H\n" +
+	"\fGetRefundReq\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\x03R\brefundId\x12\x1b\n" +
+	"\trefund_no\x18\x02 \x01(\tR\brefundNo\"|\n" +
+	"\rGetRefundResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12+\n" +
+	"\x06refund\x18\x03 \x01(\v2\x13.payment.RefundInfoR\x06refund\"\x9b\x01\n" +
+	"\fReconcileReq\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x1b\n" +
+	"\tbill_date\x18\x02 \x01(\tR\bbillDate\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x1c\n" +
+	"\tstatement\x18\x04 \x01(\fR\tstatement\x12\x19\n" +
+	"\bauto_fix\x18\x05 \x01(\bR\aautoFix\"\x9b\x02\n" +
+	"\rReconcileItem\x12\x1d\n" +
+	"\n" +
+	"payment_no\x18\x01 \x01(\tR\tpaymentNo\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\flocal_amount\x18\x03 \x01(\x03R\vlocalAmount\x12%\n" +
+	"\x0echannel_amount\x18\x04 \x01(\x03R\rchannelAmount\x12!\n" +
+	"\flocal_status\x18\x05 \x01(\tR\vlocalStatus\x12%\n" +
+	"\x0echannel_status\x18\x06 \x01(\tR\rchannelStatus\x12\x19\n" +
+	"\btrade_no\x18\a \x01(\tR\atradeNo\x12\x14\n" +
+	"\x05fixed\x18\b \x01(\bR\x05fixed\x12\x12\n" +
+	"\x04note\x18\t \x01(\tR\x04note\"\xe9\x03\n" +
+	"\x0fReconcileReport\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x1b\n" +
+	"\tbill_date\x18\x03 \x01(\tR\bbillDate\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x19\n" +
+	"\bauto_fix\x18\x06 \x01(\bR\aautoFix\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\a \x01(\x03R\ttotalRows\x12\x18\n" +
+	"\amatched\x18\b \x01(\x03R\amatched\x12\x18\n" +
+	"\amissing\x18\t \x01(\x03R\amissing\x12\x14\n" +
+	"\x05extra\x18\n" +
+	" \x01(\x03R\x05extra\x12'\n" +
+	"\x0famount_mismatch\x18\v \x01(\x03R\x0eamountMismatch\x12'\n" +
+	"\x0fstatus_mismatch\x18\f \x01(\x03R\x0estatusMismatch\x12\x14\n" +
+	"\x05fixed\x18\r \x01(\x03R\x05fixed\x12\x14\n" +
+	"\x05error\x18\x0e \x01(\tR\x05error\x12,\n" +
+	"\x05items\x18\x0f \x03(\v2\x16.payment.ReconcileItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\x03R\tcreatedAt\"\x81\x01\n" +
+	"\rReconcileResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x120\n" +
+	"\x06report\x18\x03 \x01(\v2\x18.payment.ReconcileReportR\x06report\"4\n" +
+	"\x15GetReconcileReportReq\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\"\x8a\x01\n" +
+	"\x16GetReconcileReportResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x120\n" +
+	"\x06report\x18\x03 \x01(\v2\x18.payment.ReconcileReportR\x06report\"\x8b\x01\n" +
+	"\n" +
+	"WalletInfo\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x03R\x06points\"C\n" +
+	"\fGetWalletReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"|\n" +
+	"\rGetWalletResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12+\n" +
+	"\x06wallet\x18\x03 \x01(\v2\x13.payment.WalletInfoR\x06wallet\"\x94\x01\n" +
+	"\x0eCreateTopupReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\"\x81\x01\n" +
+	"\x0fCreateTopupResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12.\n" +
+	"\apayment\x18\x03 \x01(\v2\x14.payment.PaymentInfoR\apayment\"\xec\x01\n" +
+	"\vWalletEntry\x12\x19\n" +
+	"\bentry_no\x18\x01 \x01(\tR\aentryNo\x12\x19\n" +
+	"\bbiz_type\x18\x02 \x01(\tR\abizType\x12\x15\n" +
+	"\x06biz_no\x18\x03 \x01(\tR\x05bizNo\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x06 \x01(\x03R\fbalanceAfter\x12\x16\n" +
+	"\x06remark\x18\a \x01(\tR\x06remark\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"|\n" +
+	"\x14ListWalletEntriesReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x03R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x03R\bpageSize\"\x9d\x01\n" +
+	"\x15ListWalletEntriesResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12.\n" +
+	"\aentries\x18\x03 \x03(\v2\x14.payment.WalletEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"p\n" +
+	"\x0eGrantPointsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06points\x18\x02 \x01(\x03R\x06points\x12\x15\n" +
+	"\x06biz_no\x18\x03 \x01(\tR\x05bizNo\x12\x16\n" +
+	"\x06remark\x18\x04 \x01(\tR\x06remark\"k\n" +
+	"\x0fGrantPointsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance*\xd4\x01\n" +
 	"\rPaymentStatus\x12\x1a\n" +
 	"\x16PAYMENT_STATUS_UNKNOWN\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_STATUS_INIT\x10\x01\x12\x1d\n" +
@@ -525,11 +2844,27 @@ const file_payment_proto_rawDesc = "" +
 	"\x16PAYMENT_STATUS_SUCCESS\x10\x03\x12\x19\n" +
 	"\x15PAYMENT_STATUS_FAILED\x10\x04\x12\x1c\n" +
 	"\x18PAYMENT_STATUS_CANCELLED\x10\x05\x12\x1a\n" +
-	"\x16PAYMENT_STATUS_EXPIRED\x10\x062\x97\x01\n" +
+	"\x16PAYMENT_STATUS_EXPIRED\x10\x06*\x97\x01\n" +
+	"\fRefundStatus\x12\x19\n" +
+	"\x15REFUND_STATUS_UNKNOWN\x10\x00\x12\x19\n" +
+	"\x15REFUND_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18REFUND_STATUS_PROCESSING\x10\x02\x12\x19\n" +
+	"\x15REFUND_STATUS_SUCCESS\x10\x03\x12\x18\n" +
+	"\x14REFUND_STATUS_FAILED\x10\x042\xc9\x06\n" +
 	"\x0ePaymentService\x12F\n" +
 	"\rCreatePayment\x12\x19.payment.CreatePaymentReq\x1a\x1a.payment.CreatePaymentResp\x12=\n" +
 	"\n" +
-	"GetPayment\x12\x16.payment.GetPaymentReq\x1a\x17.payment.GetPaymentRespB\vZ\t./paymentb\x06proto3"
+	"GetPayment\x12\x16.payment.GetPaymentReq\x1a\x17.payment.GetPaymentResp\x12C\n" +
+	"\fHandleNotify\x12\x18.payment.HandleNotifyReq\x1a\x19.payment.HandleNotifyResp\x12C\n" +
+	"\fClosePayment\x12\x18.payment.ClosePaymentReq\x1a\x19.payment.ClosePaymentResp\x12C\n" +
+	"\fCreateRefund\x12\x18.payment.CreateRefundReq\x1a\x19.payment.CreateRefundResp\x12:\n" +
+	"\tGetRefund\x12\x15.payment.GetRefundReq\x1a\x16.payment.GetRefundResp\x12:\n" +
+	"\tReconcile\x12\x15.payment.ReconcileReq\x1a\x16.payment.ReconcileResp\x12U\n" +
+	"\x12GetReconcileReport\x12\x1e.payment.GetReconcileReportReq\x1a\x1f.payment.GetReconcileReportResp\x12:\n" +
+	"\tGetWallet\x12\x15.payment.GetWalletReq\x1a\x16.payment.GetWalletResp\x12@\n" +
+	"\vCreateTopup\x12\x17.payment.CreateTopupReq\x1a\x18.payment.CreateTopupResp\x12R\n" +
+	"\x11ListWalletEntries\x12\x1d.payment.ListWalletEntriesReq\x1a\x1e.payment.ListWalletEntriesResp\x12@\n" +
+	"\vGrantPoints\x12\x17.payment.GrantPointsReq\x1a\x18.payment.GrantPointsRespB\vZ\t./paymentb\x06proto3"
 
 var (
 	file_payment_proto_rawDescOnce sync.Once
@@ -543,29 +2878,90 @@ func file_payment_proto_rawDescGZIP() []byte {
 	return file_payment_proto_rawDescData
 }
 
-var file_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_payment_proto_goTypes = []any{
-	(PaymentStatus)(0),        // 0: payment.PaymentStatus
-	(*PaymentInfo)(nil),       // 1: payment.PaymentInfo
-	(*CreatePaymentReq)(nil),  // 2: payment.CreatePaymentReq
-	(*CreatePaymentResp)(nil), // 3: payment.CreatePaymentResp
-	(*GetPaymentReq)(nil),     // 4: payment.GetPaymentReq
-	(*GetPaymentResp)(nil),    // 5: payment.GetPaymentResp
+	(PaymentStatus)(0),             // 0: payment.PaymentStatus
+	(RefundStatus)(0),              // 1: payment.RefundStatus
+	(*PaymentInfo)(nil),            // 2: payment.PaymentInfo
+	(*PaymentSplit)(nil),           // 3: payment.PaymentSplit
+	(*CreatePaymentReq)(nil),       // 4: payment.CreatePaymentReq
+	(*CreatePaymentResp)(nil),      // 5: payment.CreatePaymentResp
+	(*GetPaymentReq)(nil),          // 6: payment.GetPaymentReq
+	(*GetPaymentResp)(nil),         // 7: payment.GetPaymentResp
+	(*HandleNotifyReq)(nil),        // 8: payment.HandleNotifyReq
+	(*HandleNotifyResp)(nil),       // 9: payment.HandleNotifyResp
+	(*ClosePaymentReq)(nil),        // 10: payment.ClosePaymentReq
+	(*ClosePaymentResp)(nil),       // 11: payment.ClosePaymentResp
+	(*RefundInfo)(nil),             // 12: payment.RefundInfo
+	(*CreateRefundReq)(nil),        // 13: payment.CreateRefundReq
+	(*CreateRefundResp)(nil),       // 14: payment.CreateRefundResp
+	(*GetRefundReq)(nil),           // 15: payment.GetRefundReq
+	(*GetRefundResp)(nil),          // 16: payment.GetRefundResp
+	(*ReconcileReq)(nil),           // 17: payment.ReconcileReq
+	(*ReconcileItem)(nil),          // 18: payment.ReconcileItem
+	(*ReconcileReport)(nil),        // 19: payment.ReconcileReport
+	(*ReconcileResp)(nil),          // 20: payment.ReconcileResp
+	(*GetReconcileReportReq)(nil),  // 21: payment.GetReconcileReportReq
+	(*GetReconcileReportResp)(nil), // 22: payment.GetReconcileReportResp
+	(*WalletInfo)(nil),             // 23: payment.WalletInfo
+	(*GetWalletReq)(nil),           // 24: payment.GetWalletReq
+	(*GetWalletResp)(nil),          // 25: payment.GetWalletResp
+	(*CreateTopupReq)(nil),         // 26: payment.CreateTopupReq
+	(*CreateTopupResp)(nil),        // 27: payment.CreateTopupResp
+	(*WalletEntry)(nil),            // 28: payment.WalletEntry
+	(*ListWalletEntriesReq)(nil),   // 29: payment.ListWalletEntriesReq
+	(*ListWalletEntriesResp)(nil),  // 30: payment.ListWalletEntriesResp
+	(*GrantPointsReq)(nil),         // 31: payment.GrantPointsReq
+	(*GrantPointsResp)(nil),        // 32: payment.GrantPointsResp
+	nil,                            // 33: payment.HandleNotifyReq.HeadersEntry
 }
 var file_payment_proto_depIdxs = []int32{
-	0, // 0: payment.PaymentInfo.status:type_name -> payment.PaymentStatus
-	1, // 1: payment.CreatePaymentResp.payment:type_name -> payment.PaymentInfo
-	1, // 2: payment.GetPaymentResp.payment:type_name -> payment.PaymentInfo
-	2, // 3: payment.PaymentService.CreatePayment:input_type -> payment.CreatePaymentReq
-	4, // 4: payment.PaymentService.GetPayment:input_type -> payment.GetPaymentReq
-	3, // 5: payment.PaymentService.CreatePayment:output_type -> payment.CreatePaymentResp
-	5, // 6: payment.PaymentService.GetPayment:output_type -> payment.GetPaymentResp
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: payment.PaymentInfo.status:type_name -> payment.PaymentStatus
+	3,  // 1: payment.PaymentInfo.splits:type_name -> payment.PaymentSplit
+	2,  // 2: payment.CreatePaymentResp.payment:type_name -> payment.PaymentInfo
+	2,  // 3: payment.GetPaymentResp.payment:type_name -> payment.PaymentInfo
+	33, // 4: payment.HandleNotifyReq.headers:type_name -> payment.HandleNotifyReq.HeadersEntry
+	2,  // 5: payment.ClosePaymentResp.payment:type_name -> payment.PaymentInfo
+	1,  // 6: payment.RefundInfo.status:type_name -> payment.RefundStatus
+	12, // 7: payment.CreateRefundResp.refund:type_name -> payment.RefundInfo
+	12, // 8: payment.CreateRefundResp.legs:type_name -> payment.RefundInfo
+	12, // 9: payment.GetRefundResp.refund:type_name -> payment.RefundInfo
+	18, // 10: payment.ReconcileReport.items:type_name -> payment.ReconcileItem
+	19, // 11: payment.ReconcileResp.report:type_name -> payment.ReconcileReport
+	19, // 12: payment.GetReconcileReportResp.report:type_name -> payment.ReconcileReport
+	23, // 13: payment.GetWalletResp.wallet:type_name -> payment.WalletInfo
+	2,  // 14: payment.CreateTopupResp.payment:type_name -> payment.PaymentInfo
+	28, // 15: payment.ListWalletEntriesResp.entries:type_name -> payment.WalletEntry
+	4,  // 16: payment.PaymentService.CreatePayment:input_type -> payment.CreatePaymentReq
+	6,  // 17: payment.PaymentService.GetPayment:input_type -> payment.GetPaymentReq
+	8,  // 18: payment.PaymentService.HandleNotify:input_type -> payment.HandleNotifyReq
+	10, // 19: payment.PaymentService.ClosePayment:input_type -> payment.ClosePaymentReq
+	13, // 20: payment.PaymentService.CreateRefund:input_type -> payment.CreateRefundReq
+	15, // 21: payment.PaymentService.GetRefund:input_type -> payment.GetRefundReq
+	17, // 22: payment.PaymentService.Reconcile:input_type -> payment.ReconcileReq
+	21, // 23: payment.PaymentService.GetReconcileReport:input_type -> payment.GetReconcileReportReq
+	24, // 24: payment.PaymentService.GetWallet:input_type -> payment.GetWalletReq
+	26, // 25: payment.PaymentService.CreateTopup:input_type -> payment.CreateTopupReq
+	29, // 26: payment.PaymentService.ListWalletEntries:input_type -> payment.ListWalletEntriesReq
+	31, // 27: payment.PaymentService.GrantPoints:input_type -> payment.GrantPointsReq
+	5,  // 28: payment.PaymentService.CreatePayment:output_type -> payment.CreatePaymentResp
+	7,  // 29: payment.PaymentService.GetPayment:output_type -> payment.GetPaymentResp
+	9,  // 30: payment.PaymentService.HandleNotify:output_type -> payment.HandleNotifyResp
+	11, // 31: payment.PaymentService.ClosePayment:output_type -> payment.ClosePaymentResp
+	14, // 32: payment.PaymentService.CreateRefund:output_type -> payment.CreateRefundResp
+	16, // 33: payment.PaymentService.GetRefund:output_type -> payment.GetRefundResp
+	20, // 34: payment.PaymentService.Reconcile:output_type -> payment.ReconcileResp
+	22, // 35: payment.PaymentService.GetReconcileReport:output_type -> payment.GetReconcileReportResp
+	25, // 36: payment.PaymentService.GetWallet:output_type -> payment.GetWalletResp
+	27, // 37: payment.PaymentService.CreateTopup:output_type -> payment.CreateTopupResp
+	30, // 38: payment.PaymentService.ListWalletEntries:output_type -> payment.ListWalletEntriesResp
+	32, // 39: payment.PaymentService.GrantPoints:output_type -> payment.GrantPointsResp
+	28, // [28:40] is the sub-list for method output_type
+	16, // [16:28] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_payment_proto_init() }
@@ -578,8 +2974,8 @@ func file_payment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_proto_rawDesc), len(file_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
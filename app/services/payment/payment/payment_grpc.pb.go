@@ -19,8 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_CreatePayment_FullMethodName = "/payment.PaymentService/CreatePayment"
-	PaymentService_GetPayment_FullMethodName    = "/payment.PaymentService/GetPayment"
+	PaymentService_CreatePayment_FullMethodName      = "/payment.PaymentService/CreatePayment"
+	PaymentService_GetPayment_FullMethodName         = "/payment.PaymentService/GetPayment"
+	PaymentService_HandleNotify_FullMethodName       = "/payment.PaymentService/HandleNotify"
+	PaymentService_ClosePayment_FullMethodName       = "/payment.PaymentService/ClosePayment"
+	PaymentService_CreateRefund_FullMethodName       = "/payment.PaymentService/CreateRefund"
+	PaymentService_GetRefund_FullMethodName          = "/payment.PaymentService/GetRefund"
+	PaymentService_Reconcile_FullMethodName          = "/payment.PaymentService/Reconcile"
+	PaymentService_GetReconcileReport_FullMethodName = "/payment.PaymentService/GetReconcileReport"
+	PaymentService_GetWallet_FullMethodName          = "/payment.PaymentService/GetWallet"
+	PaymentService_CreateTopup_FullMethodName        = "/payment.PaymentService/CreateTopup"
+	PaymentService_ListWalletEntries_FullMethodName  = "/payment.PaymentService/ListWalletEntries"
+	PaymentService_GrantPoints_FullMethodName        = "/payment.PaymentService/GrantPoints"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -29,6 +39,16 @@ const (
 type PaymentServiceClient interface {
 	CreatePayment(ctx context.Context, in *CreatePaymentReq, opts ...grpc.CallOption) (*CreatePaymentResp, error)
 	GetPayment(ctx context.Context, in *GetPaymentReq, opts ...grpc.CallOption) (*GetPaymentResp, error)
+	HandleNotify(ctx context.Context, in *HandleNotifyReq, opts ...grpc.CallOption) (*HandleNotifyResp, error)
+	ClosePayment(ctx context.Context, in *ClosePaymentReq, opts ...grpc.CallOption) (*ClosePaymentResp, error)
+	CreateRefund(ctx context.Context, in *CreateRefundReq, opts ...grpc.CallOption) (*CreateRefundResp, error)
+	GetRefund(ctx context.Context, in *GetRefundReq, opts ...grpc.CallOption) (*GetRefundResp, error)
+	Reconcile(ctx context.Context, in *ReconcileReq, opts ...grpc.CallOption) (*ReconcileResp, error)
+	GetReconcileReport(ctx context.Context, in *GetReconcileReportReq, opts ...grpc.CallOption) (*GetReconcileReportResp, error)
+	GetWallet(ctx context.Context, in *GetWalletReq, opts ...grpc.CallOption) (*GetWalletResp, error)
+	CreateTopup(ctx context.Context, in *CreateTopupReq, opts ...grpc.CallOption) (*CreateTopupResp, error)
+	ListWalletEntries(ctx context.Context, in *ListWalletEntriesReq, opts ...grpc.CallOption) (*ListWalletEntriesResp, error)
+	GrantPoints(ctx context.Context, in *GrantPointsReq, opts ...grpc.CallOption) (*GrantPointsResp, error)
 }
 
 type paymentServiceClient struct {
@@ -59,12 +79,122 @@ func (c *paymentServiceClient) GetPayment(ctx context.Context, in *GetPaymentReq
 	return out, nil
 }
 
+func (c *paymentServiceClient) HandleNotify(ctx context.Context, in *HandleNotifyReq, opts ...grpc.CallOption) (*HandleNotifyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandleNotifyResp)
+	err := c.cc.Invoke(ctx, PaymentService_HandleNotify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ClosePayment(ctx context.Context, in *ClosePaymentReq, opts ...grpc.CallOption) (*ClosePaymentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClosePaymentResp)
+	err := c.cc.Invoke(ctx, PaymentService_ClosePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreateRefund(ctx context.Context, in *CreateRefundReq, opts ...grpc.CallOption) (*CreateRefundResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRefundResp)
+	err := c.cc.Invoke(ctx, PaymentService_CreateRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetRefund(ctx context.Context, in *GetRefundReq, opts ...grpc.CallOption) (*GetRefundResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRefundResp)
+	err := c.cc.Invoke(ctx, PaymentService_GetRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) Reconcile(ctx context.Context, in *ReconcileReq, opts ...grpc.CallOption) (*ReconcileResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileResp)
+	err := c.cc.Invoke(ctx, PaymentService_Reconcile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetReconcileReport(ctx context.Context, in *GetReconcileReportReq, opts ...grpc.CallOption) (*GetReconcileReportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconcileReportResp)
+	err := c.cc.Invoke(ctx, PaymentService_GetReconcileReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetWallet(ctx context.Context, in *GetWalletReq, opts ...grpc.CallOption) (*GetWalletResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResp)
+	err := c.cc.Invoke(ctx, PaymentService_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreateTopup(ctx context.Context, in *CreateTopupReq, opts ...grpc.CallOption) (*CreateTopupResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTopupResp)
+	err := c.cc.Invoke(ctx, PaymentService_CreateTopup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListWalletEntries(ctx context.Context, in *ListWalletEntriesReq, opts ...grpc.CallOption) (*ListWalletEntriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWalletEntriesResp)
+	err := c.cc.Invoke(ctx, PaymentService_ListWalletEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GrantPoints(ctx context.Context, in *GrantPointsReq, opts ...grpc.CallOption) (*GrantPointsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantPointsResp)
+	err := c.cc.Invoke(ctx, PaymentService_GrantPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 type PaymentServiceServer interface {
 	CreatePayment(context.Context, *CreatePaymentReq) (*CreatePaymentResp, error)
 	GetPayment(context.Context, *GetPaymentReq) (*GetPaymentResp, error)
+	HandleNotify(context.Context, *HandleNotifyReq) (*HandleNotifyResp, error)
+	ClosePayment(context.Context, *ClosePaymentReq) (*ClosePaymentResp, error)
+	CreateRefund(context.Context, *CreateRefundReq) (*CreateRefundResp, error)
+	GetRefund(context.Context, *GetRefundReq) (*GetRefundResp, error)
+	Reconcile(context.Context, *ReconcileReq) (*ReconcileResp, error)
+	GetReconcileReport(context.Context, *GetReconcileReportReq) (*GetReconcileReportResp, error)
+	GetWallet(context.Context, *GetWalletReq) (*GetWalletResp, error)
+	CreateTopup(context.Context, *CreateTopupReq) (*CreateTopupResp, error)
+	ListWalletEntries(context.Context, *ListWalletEntriesReq) (*ListWalletEntriesResp, error)
+	GrantPoints(context.Context, *GrantPointsReq) (*GrantPointsResp, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -81,6 +211,36 @@ func (UnimplementedPaymentServiceServer) CreatePayment(context.Context, *CreateP
 func (UnimplementedPaymentServiceServer) GetPayment(context.Context, *GetPaymentReq) (*GetPaymentResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
 }
+func (UnimplementedPaymentServiceServer) HandleNotify(context.Context, *HandleNotifyReq) (*HandleNotifyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleNotify not implemented")
+}
+func (UnimplementedPaymentServiceServer) ClosePayment(context.Context, *ClosePaymentReq) (*ClosePaymentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateRefund(context.Context, *CreateRefundReq) (*CreateRefundResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRefund not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetRefund(context.Context, *GetRefundReq) (*GetRefundResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefund not implemented")
+}
+func (UnimplementedPaymentServiceServer) Reconcile(context.Context, *ReconcileReq) (*ReconcileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetReconcileReport(context.Context, *GetReconcileReportReq) (*GetReconcileReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconcileReport not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetWallet(context.Context, *GetWalletReq) (*GetWalletResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateTopup(context.Context, *CreateTopupReq) (*CreateTopupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTopup not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListWalletEntries(context.Context, *ListWalletEntriesReq) (*ListWalletEntriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalletEntries not implemented")
+}
+func (UnimplementedPaymentServiceServer) GrantPoints(context.Context, *GrantPointsReq) (*GrantPointsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPoints not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -138,6 +298,186 @@ func _PaymentService_GetPayment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_HandleNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleNotifyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).HandleNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_HandleNotify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).HandleNotify(ctx, req.(*HandleNotifyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ClosePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePaymentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ClosePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ClosePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ClosePayment(ctx, req.(*ClosePaymentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRefundReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateRefund(ctx, req.(*CreateRefundReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetRefund(ctx, req.(*GetRefundReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_Reconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).Reconcile(ctx, req.(*ReconcileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetReconcileReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconcileReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetReconcileReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetReconcileReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetReconcileReport(ctx, req.(*GetReconcileReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetWallet(ctx, req.(*GetWalletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateTopup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTopupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateTopup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateTopup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateTopup(ctx, req.(*CreateTopupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListWalletEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletEntriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListWalletEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListWalletEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListWalletEntries(ctx, req.(*ListWalletEntriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GrantPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantPointsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GrantPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GrantPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GrantPoints(ctx, req.(*GrantPointsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +493,46 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPayment",
 			Handler:    _PaymentService_GetPayment_Handler,
 		},
+		{
+			MethodName: "HandleNotify",
+			Handler:    _PaymentService_HandleNotify_Handler,
+		},
+		{
+			MethodName: "ClosePayment",
+			Handler:    _PaymentService_ClosePayment_Handler,
+		},
+		{
+			MethodName: "CreateRefund",
+			Handler:    _PaymentService_CreateRefund_Handler,
+		},
+		{
+			MethodName: "GetRefund",
+			Handler:    _PaymentService_GetRefund_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _PaymentService_Reconcile_Handler,
+		},
+		{
+			MethodName: "GetReconcileReport",
+			Handler:    _PaymentService_GetReconcileReport_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _PaymentService_GetWallet_Handler,
+		},
+		{
+			MethodName: "CreateTopup",
+			Handler:    _PaymentService_CreateTopup_Handler,
+		},
+		{
+			MethodName: "ListWalletEntries",
+			Handler:    _PaymentService_ListWalletEntries_Handler,
+		},
+		{
+			MethodName: "GrantPoints",
+			Handler:    _PaymentService_GrantPoints_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment.proto",
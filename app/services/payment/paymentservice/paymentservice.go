@@ -14,15 +14,51 @@ import (
 )
 
 type (
-	CreatePaymentReq  = payment.CreatePaymentReq
-	CreatePaymentResp = payment.CreatePaymentResp
-	GetPaymentReq     = payment.GetPaymentReq
-	GetPaymentResp    = payment.GetPaymentResp
-	PaymentInfo       = payment.PaymentInfo
+	ClosePaymentReq        = payment.ClosePaymentReq
+	ClosePaymentResp       = payment.ClosePaymentResp
+	CreatePaymentReq       = payment.CreatePaymentReq
+	CreatePaymentResp      = payment.CreatePaymentResp
+	CreateRefundReq        = payment.CreateRefundReq
+	CreateRefundResp       = payment.CreateRefundResp
+	CreateTopupReq         = payment.CreateTopupReq
+	CreateTopupResp        = payment.CreateTopupResp
+	GetPaymentReq          = payment.GetPaymentReq
+	GetPaymentResp         = payment.GetPaymentResp
+	GetReconcileReportReq  = payment.GetReconcileReportReq
+	GetReconcileReportResp = payment.GetReconcileReportResp
+	GetRefundReq           = payment.GetRefundReq
+	GetRefundResp          = payment.GetRefundResp
+	GetWalletReq           = payment.GetWalletReq
+	GetWalletResp          = payment.GetWalletResp
+	GrantPointsReq         = payment.GrantPointsReq
+	GrantPointsResp        = payment.GrantPointsResp
+	HandleNotifyReq        = payment.HandleNotifyReq
+	HandleNotifyResp       = payment.HandleNotifyResp
+	ListWalletEntriesReq   = payment.ListWalletEntriesReq
+	ListWalletEntriesResp  = payment.ListWalletEntriesResp
+	PaymentInfo            = payment.PaymentInfo
+	PaymentSplit           = payment.PaymentSplit
+	ReconcileItem          = payment.ReconcileItem
+	ReconcileReport        = payment.ReconcileReport
+	ReconcileReq           = payment.ReconcileReq
+	ReconcileResp          = payment.ReconcileResp
+	RefundInfo             = payment.RefundInfo
+	WalletEntry            = payment.WalletEntry
+	WalletInfo             = payment.WalletInfo
 
 	PaymentService interface {
 		CreatePayment(ctx context.Context, in *CreatePaymentReq, opts ...grpc.CallOption) (*CreatePaymentResp, error)
 		GetPayment(ctx context.Context, in *GetPaymentReq, opts ...grpc.CallOption) (*GetPaymentResp, error)
+		HandleNotify(ctx context.Context, in *HandleNotifyReq, opts ...grpc.CallOption) (*HandleNotifyResp, error)
+		ClosePayment(ctx context.Context, in *ClosePaymentReq, opts ...grpc.CallOption) (*ClosePaymentResp, error)
+		CreateRefund(ctx context.Context, in *CreateRefundReq, opts ...grpc.CallOption) (*CreateRefundResp, error)
+		GetRefund(ctx context.Context, in *GetRefundReq, opts ...grpc.CallOption) (*GetRefundResp, error)
+		Reconcile(ctx context.Context, in *ReconcileReq, opts ...grpc.CallOption) (*ReconcileResp, error)
+		GetReconcileReport(ctx context.Context, in *GetReconcileReportReq, opts ...grpc.CallOption) (*GetReconcileReportResp, error)
+		GetWallet(ctx context.Context, in *GetWalletReq, opts ...grpc.CallOption) (*GetWalletResp, error)
+		CreateTopup(ctx context.Context, in *CreateTopupReq, opts ...grpc.CallOption) (*CreateTopupResp, error)
+		ListWalletEntries(ctx context.Context, in *ListWalletEntriesReq, opts ...grpc.CallOption) (*ListWalletEntriesResp, error)
+		GrantPoints(ctx context.Context, in *GrantPointsReq, opts ...grpc.CallOption) (*GrantPointsResp, error)
 	}
 
 	defaultPaymentService struct {
@@ -45,3 +81,53 @@ func (m *defaultPaymentService) GetPayment(ctx context.Context, in *GetPaymentRe
 	client := payment.NewPaymentServiceClient(m.cli.Conn())
 	return client.GetPayment(ctx, in, opts...)
 }
+
+func (m *defaultPaymentService) HandleNotify(ctx context.Context, in *HandleNotifyReq, opts ...grpc.CallOption) (*HandleNotifyResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.HandleNotify(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) ClosePayment(ctx context.Context, in *ClosePaymentReq, opts ...grpc.CallOption) (*ClosePaymentResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.ClosePayment(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) CreateRefund(ctx context.Context, in *CreateRefundReq, opts ...grpc.CallOption) (*CreateRefundResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.CreateRefund(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) GetRefund(ctx context.Context, in *GetRefundReq, opts ...grpc.CallOption) (*GetRefundResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.GetRefund(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) Reconcile(ctx context.Context, in *ReconcileReq, opts ...grpc.CallOption) (*ReconcileResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.Reconcile(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) GetReconcileReport(ctx context.Context, in *GetReconcileReportReq, opts ...grpc.CallOption) (*GetReconcileReportResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.GetReconcileReport(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) GetWallet(ctx context.Context, in *GetWalletReq, opts ...grpc.CallOption) (*GetWalletResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.GetWallet(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) CreateTopup(ctx context.Context, in *CreateTopupReq, opts ...grpc.CallOption) (*CreateTopupResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.CreateTopup(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) ListWalletEntries(ctx context.Context, in *ListWalletEntriesReq, opts ...grpc.CallOption) (*ListWalletEntriesResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.ListWalletEntries(ctx, in, opts...)
+}
+
+func (m *defaultPaymentService) GrantPoints(ctx context.Context, in *GrantPointsReq, opts ...grpc.CallOption) (*GrantPointsResp, error) {
+	client := payment.NewPaymentServiceClient(m.cli.Conn())
+	return client.GrantPoints(ctx, in, opts...)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
 	productmodel "NatsumeAI/app/dal/product"
 	inventorypb "NatsumeAI/app/services/inventory/inventory"
 	"NatsumeAI/app/services/product/internal/svc"
@@ -53,12 +54,25 @@ func (l *CreateProductLogic) CreateProduct(in *product.CreateProductReq) (*produ
 		return resp, nil
 	}
 
+	// 标价币种需已配置汇率，才能换算为用户币种
+	currency := fx.Normalize(in.GetCurrency())
+	if _, err := fxRate(l.ctx, l.svcCtx, currency); err != nil {
+		if err == productmodel.ErrNotFound {
+			resp.StatusCode = errno.CurrencyUnsupported
+			resp.StatusMsg = "currency not supported"
+			return resp, nil
+		}
+		l.Logger.Errorf("create product find fx rate failed: %v", err)
+		return resp, err
+	}
+
 	record := &productmodel.Products{
 		MerchantId:  merchantID,
 		Name:        name,
 		Description: description,
 		Picture:     picture,
 		Price:       price,
+		Currency:    currency,
 	}
 
 	result, err := l.svcCtx.ProductModel.Insert(l.ctx, record)
@@ -73,6 +73,16 @@ func (l *GetProductLogic) GetProduct(in *product.GetProductReq) (*product.GetPro
 		protoProduct.Categories = categoriesFromRecords(categoryRecords)
 	}
 
+	if err := applyDisplayCurrency(l.ctx, l.svcCtx, protoProduct, in.GetCurrency()); err != nil {
+		if err == productmodel.ErrNotFound {
+			resp.StatusCode = errno.CurrencyUnsupported
+			resp.StatusMsg = "currency not supported"
+			return resp, nil
+		}
+		l.Logger.Errorf("get product convert currency failed: %v", err)
+		return resp, err
+	}
+
 	if resp, err := l.svcCtx.InventoryRpc.GetInventory(l.ctx, &inventory.GetInventoryReq{
 		ProductIds: []int64{in.ProductId},
 	}); err == nil && resp.StatusCode == errno.StatusOK && len(resp.Items) > 0 {
@@ -1,10 +1,13 @@
 package logic
 
 import (
+	"context"
 	"strings"
 	"time"
 
+	"NatsumeAI/app/common/fx"
 	productmodel "NatsumeAI/app/dal/product"
+	"NatsumeAI/app/services/product/internal/svc"
 	"NatsumeAI/app/services/product/product"
 )
 
@@ -28,6 +31,7 @@ func productModelToProto(model *productmodel.Products) (*product.Product, error)
 		Description: model.Description,
 		Picture:     model.Picture,
 		Price:       model.Price,
+		Currency:    fx.Normalize(model.Currency),
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
 	}, nil
@@ -83,3 +87,57 @@ func categoriesFromRecords(records []*productmodel.ProductCategories) []string {
 	}
 	return result
 }
+
+// fxRate 查询币种对 CNY 的汇率，币种未配置汇率时返回 ErrNotFound
+func fxRate(ctx context.Context, svcCtx *svc.ServiceContext, currency string) (int64, error) {
+	if currency == fx.BaseCurrency {
+		return fx.RateScale, nil
+	}
+	record, err := svcCtx.FxRatesModel.FindOne(ctx, currency)
+	if err != nil {
+		return 0, err
+	}
+	return record.Rate, nil
+}
+
+// applyDisplayCurrency 按展示币种换算售价，并带上标价币种到展示币种的汇率快照；
+// 未指定展示币种时按标价币种展示
+func applyDisplayCurrency(ctx context.Context, svcCtx *svc.ServiceContext, p *product.Product, currency string) error {
+	target := p.Currency
+	if strings.TrimSpace(currency) != "" {
+		target = fx.Normalize(currency)
+	}
+	p.DisplayCurrency = target
+	if target == p.Currency {
+		p.DisplayPrice = p.Price
+		p.FxRate = fx.RateScale
+		return nil
+	}
+
+	from, err := fxRate(ctx, svcCtx, p.Currency)
+	if err != nil {
+		return err
+	}
+	to, err := fxRate(ctx, svcCtx, target)
+	if err != nil {
+		return err
+	}
+	rate, err := fx.CrossRate(from, to)
+	if err != nil {
+		return err
+	}
+	p.FxRate = rate
+	p.DisplayPrice = fx.Convert(p.Price, rate)
+	return nil
+}
+
+func fxRateToProto(record *productmodel.ProductFxRates) *product.FxRate {
+	if record == nil {
+		return nil
+	}
+	return &product.FxRate{
+		Currency:  record.Currency,
+		Rate:      record.Rate,
+		UpdatedAt: record.UpdatedAt.UTC().Format(productTimeFormat),
+	}
+}
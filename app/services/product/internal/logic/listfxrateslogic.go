@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
+	"NatsumeAI/app/services/product/internal/svc"
+	"NatsumeAI/app/services/product/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFxRatesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFxRatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFxRatesLogic {
+	return &ListFxRatesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 汇率列表
+func (l *ListFxRatesLogic) ListFxRates(in *product.ListFxRatesReq) (*product.ListFxRatesResp, error) {
+	resp := &product.ListFxRatesResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  "internal error",
+	}
+
+	records, err := l.svcCtx.FxRatesModel.ListAll(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("list fx rates failed: %v", err)
+		return resp, err
+	}
+
+	resp.Rates = make([]*product.FxRate, 0, len(records))
+	for _, record := range records {
+		resp.Rates = append(resp.Rates, fxRateToProto(record))
+	}
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.BaseCurrency = fx.BaseCurrency
+	return resp, nil
+}
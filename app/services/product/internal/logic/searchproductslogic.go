@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
 	"NatsumeAI/app/services/product/internal/svc"
 	"NatsumeAI/app/services/product/product"
 
@@ -145,6 +146,7 @@ func (l *SearchProductsLogic) SearchProducts(in *product.SearchProductsReq) (*pr
 				Description: src.Description,
 				Picture:     src.Picture,
 				Price:       src.Price,
+				Currency:    fx.Normalize(src.Currency),
 				Categories:  append([]string{}, src.Categories...),
 				MerchantId:  src.MerchantID,
 			})
@@ -215,6 +217,7 @@ type esHitSource struct {
 	Description string   `json:"description"`
 	Picture     string   `json:"picture"`
 	Price       int64    `json:"price"`
+	Currency    string   `json:"currency"`
 	Categories  []string `json:"categories"`
 }
 
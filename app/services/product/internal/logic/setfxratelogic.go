@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
+	"NatsumeAI/app/services/product/internal/svc"
+	"NatsumeAI/app/services/product/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetFxRateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetFxRateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetFxRateLogic {
+	return &SetFxRateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 设置币种汇率（对 CNY，放大 1e8 倍）
+func (l *SetFxRateLogic) SetFxRate(in *product.SetFxRateReq) (*product.SetFxRateResp, error) {
+	resp := &product.SetFxRateResp{
+		StatusCode: errno.InternalError,
+		StatusMsg:  "internal error",
+	}
+
+	if in == nil || in.GetRate() <= 0 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid fx rate"
+		return resp, nil
+	}
+	currency := fx.Normalize(in.GetCurrency())
+	if currency == fx.BaseCurrency || len(currency) != 3 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid currency"
+		return resp, nil
+	}
+
+	// 已下单的订单、支付单保存了汇率快照，这里更新只影响之后的换算
+	if err := l.svcCtx.FxRatesModel.Upsert(l.ctx, currency, in.GetRate()); err != nil {
+		l.Logger.Errorf("set fx rate failed: currency=%s err=%v", currency, err)
+		return resp, err
+	}
+	record, err := l.svcCtx.FxRatesModel.FindOne(l.ctx, currency)
+	if err != nil {
+		l.Logger.Errorf("set fx rate refetch failed: currency=%s err=%v", currency, err)
+		return resp, err
+	}
+
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	resp.Rate = fxRateToProto(record)
+	return resp, nil
+}
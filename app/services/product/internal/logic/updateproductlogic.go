@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/fx"
 	productmodel "NatsumeAI/app/dal/product"
 	"NatsumeAI/app/services/product/internal/svc"
 	"NatsumeAI/app/services/product/product"
@@ -72,6 +73,20 @@ func (l *UpdateProductLogic) UpdateProduct(in *product.UpdateProductReq) (*produ
 	record.Description = description
 	record.Picture = picture
 	record.Price = price
+	// 未传币种时保持原标价币种
+	if strings.TrimSpace(in.GetCurrency()) != "" {
+		currency := fx.Normalize(in.GetCurrency())
+		if _, err := fxRate(l.ctx, l.svcCtx, currency); err != nil {
+			if err == productmodel.ErrNotFound {
+				resp.StatusCode = errno.CurrencyUnsupported
+				resp.StatusMsg = "currency not supported"
+				return resp, nil
+			}
+			l.Logger.Errorf("update product find fx rate failed: %v", err)
+			return resp, err
+		}
+		record.Currency = currency
+	}
 
 	if err := l.svcCtx.ProductModel.Update(l.ctx, record); err != nil {
 		l.Logger.Errorf("update product failed: %v", err)
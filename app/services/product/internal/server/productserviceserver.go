@@ -64,3 +64,15 @@ func (s *ProductServiceServer) SearchProducts(ctx context.Context, in *product.S
 	l := logic.NewSearchProductsLogic(ctx, s.svcCtx)
 	return l.SearchProducts(in)
 }
+
+// 设置币种汇率（对 CNY，放大 1e8 倍）
+func (s *ProductServiceServer) SetFxRate(ctx context.Context, in *product.SetFxRateReq) (*product.SetFxRateResp, error) {
+	l := logic.NewSetFxRateLogic(ctx, s.svcCtx)
+	return l.SetFxRate(in)
+}
+
+// 汇率列表
+func (s *ProductServiceServer) ListFxRates(ctx context.Context, in *product.ListFxRatesReq) (*product.ListFxRatesResp, error) {
+	l := logic.NewListFxRatesLogic(ctx, s.svcCtx)
+	return l.ListFxRates(in)
+}
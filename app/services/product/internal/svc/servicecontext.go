@@ -24,6 +24,7 @@ type ServiceContext struct {
 	InventoryRpc           inventory.InventoryServiceClient
 	ProductModel           product.ProductsModel
 	ProductCategoriesModel product.ProductCategoriesModel
+	FxRatesModel           product.ProductFxRatesModel
 	Bloom                  *bloom.Filter
 	ESClient               *elasticsearch.Client
 	Embedder               *embeddingark.Embedder
@@ -42,6 +43,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		InventoryRpc:           inventoryservice.NewInventoryService(zrpc.MustNewClient(c.InventoryRpc)),
 		ProductModel:           ProductsModel,
 		ProductCategoriesModel: product.NewProductCategoriesModel(sqlx.MustNewConn(c.MysqlConf), c.CacheConf),
+		FxRatesModel:           product.NewProductFxRatesModel(sqlx.MustNewConn(c.MysqlConf), c.CacheConf),
 		Bloom:                  bf,
 	}
 
@@ -22,20 +22,24 @@ const (
 )
 
 type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Picture       string                 `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
-	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
-	Sold          int64                  `protobuf:"varint,7,opt,name=sold,proto3" json:"sold,omitempty"`
-	Categories    []string               `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	MerchantId    int64                  `protobuf:"varint,11,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Picture         string                 `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
+	Price           int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Stock           int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	Sold            int64                  `protobuf:"varint,7,opt,name=sold,proto3" json:"sold,omitempty"`
+	Categories      []string               `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	MerchantId      int64                  `protobuf:"varint,11,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Currency        string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	DisplayPrice    int64                  `protobuf:"varint,13,opt,name=display_price,json=displayPrice,proto3" json:"display_price,omitempty"`
+	DisplayCurrency string                 `protobuf:"bytes,14,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	FxRate          int64                  `protobuf:"varint,15,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -145,6 +149,34 @@ func (x *Product) GetMerchantId() int64 {
 	return 0
 }
 
+func (x *Product) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Product) GetDisplayPrice() int64 {
+	if x != nil {
+		return x.DisplayPrice
+	}
+	return 0
+}
+
+func (x *Product) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+func (x *Product) GetFxRate() int64 {
+	if x != nil {
+		return x.FxRate
+	}
+	return 0
+}
+
 type ProductSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -154,6 +186,7 @@ type ProductSummary struct {
 	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
 	Categories    []string               `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
 	MerchantId    int64                  `protobuf:"varint,7,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -237,10 +270,18 @@ func (x *ProductSummary) GetMerchantId() int64 {
 	return 0
 }
 
+func (x *ProductSummary) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type GetProductReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -289,6 +330,13 @@ func (x *GetProductReq) GetUserId() int64 {
 	return 0
 }
 
+func (x *GetProductReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type GetProductResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -358,6 +406,7 @@ type CreateProductReq struct {
 	Stock         int64                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
 	Categories    []string               `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
 	MerchantId    int64                  `protobuf:"varint,7,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -441,6 +490,13 @@ func (x *CreateProductReq) GetMerchantId() int64 {
 	return 0
 }
 
+func (x *CreateProductReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CreateProductResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -509,6 +565,7 @@ type UpdateProductReq struct {
 	Picture       string                 `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
 	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
 	MerchantId    int64                  `protobuf:"varint,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -585,6 +642,13 @@ func (x *UpdateProductReq) GetMerchantId() int64 {
 	return 0
 }
 
+func (x *UpdateProductReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type UpdateProductResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -1165,11 +1229,287 @@ func (x *SearchProductsResp) GetTotalCount() int64 {
 	return 0
 }
 
+type FxRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Rate          int64                  `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FxRate) Reset() {
+	*x = FxRate{}
+	mi := &file_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FxRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FxRate) ProtoMessage() {}
+
+func (x *FxRate) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FxRate.ProtoReflect.Descriptor instead.
+func (*FxRate) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FxRate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FxRate) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *FxRate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SetFxRateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Rate          int64                  `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFxRateReq) Reset() {
+	*x = SetFxRateReq{}
+	mi := &file_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFxRateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFxRateReq) ProtoMessage() {}
+
+func (x *SetFxRateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFxRateReq.ProtoReflect.Descriptor instead.
+func (*SetFxRateReq) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetFxRateReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetFxRateReq) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type SetFxRateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Rate          *FxRate                `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFxRateResp) Reset() {
+	*x = SetFxRateResp{}
+	mi := &file_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFxRateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFxRateResp) ProtoMessage() {}
+
+func (x *SetFxRateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFxRateResp.ProtoReflect.Descriptor instead.
+func (*SetFxRateResp) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetFxRateResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *SetFxRateResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *SetFxRateResp) GetRate() *FxRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+type ListFxRatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFxRatesReq) Reset() {
+	*x = ListFxRatesReq{}
+	mi := &file_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFxRatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFxRatesReq) ProtoMessage() {}
+
+func (x *ListFxRatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFxRatesReq.ProtoReflect.Descriptor instead.
+func (*ListFxRatesReq) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{19}
+}
+
+type ListFxRatesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,3,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	Rates         []*FxRate              `protobuf:"bytes,4,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFxRatesResp) Reset() {
+	*x = ListFxRatesResp{}
+	mi := &file_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFxRatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFxRatesResp) ProtoMessage() {}
+
+func (x *ListFxRatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFxRatesResp.ProtoReflect.Descriptor instead.
+func (*ListFxRatesResp) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListFxRatesResp) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListFxRatesResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListFxRatesResp) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ListFxRatesResp) GetRates() []*FxRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
 var File_product_proto protoreflect.FileDescriptor
 
 const file_product_proto_rawDesc = "" +
 	"\n" +
-	"\rproduct.proto\x12\aproduct\"\xa8\x02\n" +
+	"\rproduct.proto\x12\aproduct\"\xad\x03\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1187,7 +1527,11 @@ const file_product_proto_rawDesc = "" +
 	"updated_at\x18\n" +
 	" \x01(\tR\tupdatedAt\x12\x1f\n" +
 	"\vmerchant_id\x18\v \x01(\x03R\n" +
-	"merchantId\"\xc7\x01\n" +
+	"merchantId\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12#\n" +
+	"\rdisplay_price\x18\r \x01(\x03R\fdisplayPrice\x12)\n" +
+	"\x10display_currency\x18\x0e \x01(\tR\x0fdisplayCurrency\x12\x17\n" +
+	"\afx_rate\x18\x0f \x01(\x03R\x06fxRate\"\xe3\x01\n" +
 	"\x0eProductSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1198,17 +1542,19 @@ const file_product_proto_rawDesc = "" +
 	"categories\x18\x06 \x03(\tR\n" +
 	"categories\x12\x1f\n" +
 	"\vmerchant_id\x18\a \x01(\x03R\n" +
-	"merchantId\"G\n" +
+	"merchantId\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"c\n" +
 	"\rGetProductReq\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"|\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"|\n" +
 	"\x0eGetProductResp\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12\x1d\n" +
 	"\n" +
 	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12*\n" +
-	"\aproduct\x18\x03 \x01(\v2\x10.product.ProductR\aproduct\"\xcf\x01\n" +
+	"\aproduct\x18\x03 \x01(\v2\x10.product.ProductR\aproduct\"\xeb\x01\n" +
 	"\x10CreateProductReq\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
@@ -1219,13 +1565,14 @@ const file_product_proto_rawDesc = "" +
 	"categories\x18\x06 \x03(\tR\n" +
 	"categories\x12\x1f\n" +
 	"\vmerchant_id\x18\a \x01(\x03R\n" +
-	"merchantId\"\x7f\n" +
+	"merchantId\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"\x7f\n" +
 	"\x11CreateProductResp\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12\x1d\n" +
 	"\n" +
 	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12*\n" +
-	"\aproduct\x18\x03 \x01(\v2\x10.product.ProductR\aproduct\"\xb8\x01\n" +
+	"\aproduct\x18\x03 \x01(\v2\x10.product.ProductR\aproduct\"\xd4\x01\n" +
 	"\x10UpdateProductReq\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
@@ -1234,7 +1581,8 @@ const file_product_proto_rawDesc = "" +
 	"\apicture\x18\x04 \x01(\tR\apicture\x12\x14\n" +
 	"\x05price\x18\x05 \x01(\x03R\x05price\x12\x1f\n" +
 	"\vmerchant_id\x18\x06 \x01(\x03R\n" +
-	"merchantId\"\x7f\n" +
+	"merchantId\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"\x7f\n" +
 	"\x11UpdateProductResp\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x05R\n" +
 	"statusCode\x12\x1d\n" +
@@ -1296,7 +1644,29 @@ const file_product_proto_rawDesc = "" +
 	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x123\n" +
 	"\bproducts\x18\x03 \x03(\v2\x17.product.ProductSummaryR\bproducts\x12\x1f\n" +
 	"\vtotal_count\x18\x04 \x01(\x03R\n" +
-	"totalCount2\xff\x03\n" +
+	"totalCount\"W\n" +
+	"\x06FxRate\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x03R\x04rate\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\">\n" +
+	"\fSetFxRateReq\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x03R\x04rate\"t\n" +
+	"\rSetFxRateResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12#\n" +
+	"\x04rate\x18\x03 \x01(\v2\x0f.product.FxRateR\x04rate\"\x10\n" +
+	"\x0eListFxRatesReq\"\x9d\x01\n" +
+	"\x0fListFxRatesResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12#\n" +
+	"\rbase_currency\x18\x03 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x05rates\x18\x04 \x03(\v2\x0f.product.FxRateR\x05rates2\xfd\x04\n" +
 	"\x0eProductService\x12F\n" +
 	"\rCreateProduct\x12\x19.product.CreateProductReq\x1a\x1a.product.CreateProductResp\x12=\n" +
 	"\n" +
@@ -1305,7 +1675,9 @@ const file_product_proto_rawDesc = "" +
 	"\rAddCategories\x12\x19.product.AddCategoriesReq\x1a\x1a.product.AddCategoriesResp\x12F\n" +
 	"\rDeleteProduct\x12\x19.product.DeleteProductReq\x1a\x1a.product.DeleteProductResp\x12C\n" +
 	"\fFeedProducts\x12\x18.product.FeedProductsReq\x1a\x19.product.FeedProductsResp\x12I\n" +
-	"\x0eSearchProducts\x12\x1a.product.SearchProductsReq\x1a\x1b.product.SearchProductsRespB\vZ\t./productb\x06proto3"
+	"\x0eSearchProducts\x12\x1a.product.SearchProductsReq\x1a\x1b.product.SearchProductsResp\x12:\n" +
+	"\tSetFxRate\x12\x15.product.SetFxRateReq\x1a\x16.product.SetFxRateResp\x12@\n" +
+	"\vListFxRates\x12\x17.product.ListFxRatesReq\x1a\x18.product.ListFxRatesRespB\vZ\t./productb\x06proto3"
 
 var (
 	file_product_proto_rawDescOnce sync.Once
@@ -1319,7 +1691,7 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_product_proto_goTypes = []any{
 	(*Product)(nil),            // 0: product.Product
 	(*ProductSummary)(nil),     // 1: product.ProductSummary
@@ -1337,6 +1709,11 @@ var file_product_proto_goTypes = []any{
 	(*FeedProductsResp)(nil),   // 13: product.FeedProductsResp
 	(*SearchProductsReq)(nil),  // 14: product.SearchProductsReq
 	(*SearchProductsResp)(nil), // 15: product.SearchProductsResp
+	(*FxRate)(nil),             // 16: product.FxRate
+	(*SetFxRateReq)(nil),       // 17: product.SetFxRateReq
+	(*SetFxRateResp)(nil),      // 18: product.SetFxRateResp
+	(*ListFxRatesReq)(nil),     // 19: product.ListFxRatesReq
+	(*ListFxRatesResp)(nil),    // 20: product.ListFxRatesResp
 }
 var file_product_proto_depIdxs = []int32{
 	0,  // 0: product.GetProductResp.product:type_name -> product.Product
@@ -1344,25 +1721,31 @@ var file_product_proto_depIdxs = []int32{
 	0,  // 2: product.UpdateProductResp.product:type_name -> product.Product
 	1,  // 3: product.FeedProductsResp.products:type_name -> product.ProductSummary
 	1,  // 4: product.SearchProductsResp.products:type_name -> product.ProductSummary
-	4,  // 5: product.ProductService.CreateProduct:input_type -> product.CreateProductReq
-	2,  // 6: product.ProductService.GetProduct:input_type -> product.GetProductReq
-	6,  // 7: product.ProductService.UpdateProduct:input_type -> product.UpdateProductReq
-	8,  // 8: product.ProductService.AddCategories:input_type -> product.AddCategoriesReq
-	10, // 9: product.ProductService.DeleteProduct:input_type -> product.DeleteProductReq
-	12, // 10: product.ProductService.FeedProducts:input_type -> product.FeedProductsReq
-	14, // 11: product.ProductService.SearchProducts:input_type -> product.SearchProductsReq
-	5,  // 12: product.ProductService.CreateProduct:output_type -> product.CreateProductResp
-	3,  // 13: product.ProductService.GetProduct:output_type -> product.GetProductResp
-	7,  // 14: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResp
-	9,  // 15: product.ProductService.AddCategories:output_type -> product.AddCategoriesResp
-	11, // 16: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResp
-	13, // 17: product.ProductService.FeedProducts:output_type -> product.FeedProductsResp
-	15, // 18: product.ProductService.SearchProducts:output_type -> product.SearchProductsResp
-	12, // [12:19] is the sub-list for method output_type
-	5,  // [5:12] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	16, // 5: product.SetFxRateResp.rate:type_name -> product.FxRate
+	16, // 6: product.ListFxRatesResp.rates:type_name -> product.FxRate
+	4,  // 7: product.ProductService.CreateProduct:input_type -> product.CreateProductReq
+	2,  // 8: product.ProductService.GetProduct:input_type -> product.GetProductReq
+	6,  // 9: product.ProductService.UpdateProduct:input_type -> product.UpdateProductReq
+	8,  // 10: product.ProductService.AddCategories:input_type -> product.AddCategoriesReq
+	10, // 11: product.ProductService.DeleteProduct:input_type -> product.DeleteProductReq
+	12, // 12: product.ProductService.FeedProducts:input_type -> product.FeedProductsReq
+	14, // 13: product.ProductService.SearchProducts:input_type -> product.SearchProductsReq
+	17, // 14: product.ProductService.SetFxRate:input_type -> product.SetFxRateReq
+	19, // 15: product.ProductService.ListFxRates:input_type -> product.ListFxRatesReq
+	5,  // 16: product.ProductService.CreateProduct:output_type -> product.CreateProductResp
+	3,  // 17: product.ProductService.GetProduct:output_type -> product.GetProductResp
+	7,  // 18: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResp
+	9,  // 19: product.ProductService.AddCategories:output_type -> product.AddCategoriesResp
+	11, // 20: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResp
+	13, // 21: product.ProductService.FeedProducts:output_type -> product.FeedProductsResp
+	15, // 22: product.ProductService.SearchProducts:output_type -> product.SearchProductsResp
+	18, // 23: product.ProductService.SetFxRate:output_type -> product.SetFxRateResp
+	20, // 24: product.ProductService.ListFxRates:output_type -> product.ListFxRatesResp
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -1376,7 +1759,7 @@ func file_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
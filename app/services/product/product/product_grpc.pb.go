@@ -26,6 +26,8 @@ const (
 	ProductService_DeleteProduct_FullMethodName  = "/product.ProductService/DeleteProduct"
 	ProductService_FeedProducts_FullMethodName   = "/product.ProductService/FeedProducts"
 	ProductService_SearchProducts_FullMethodName = "/product.ProductService/SearchProducts"
+	ProductService_SetFxRate_FullMethodName      = "/product.ProductService/SetFxRate"
+	ProductService_ListFxRates_FullMethodName    = "/product.ProductService/ListFxRates"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -46,6 +48,10 @@ type ProductServiceClient interface {
 	FeedProducts(ctx context.Context, in *FeedProductsReq, opts ...grpc.CallOption) (*FeedProductsResp, error)
 	// 搜索商品
 	SearchProducts(ctx context.Context, in *SearchProductsReq, opts ...grpc.CallOption) (*SearchProductsResp, error)
+	// 设置币种汇率（对 CNY，放大 1e8 倍）
+	SetFxRate(ctx context.Context, in *SetFxRateReq, opts ...grpc.CallOption) (*SetFxRateResp, error)
+	// 汇率列表
+	ListFxRates(ctx context.Context, in *ListFxRatesReq, opts ...grpc.CallOption) (*ListFxRatesResp, error)
 }
 
 type productServiceClient struct {
@@ -126,6 +132,26 @@ func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchPro
 	return out, nil
 }
 
+func (c *productServiceClient) SetFxRate(ctx context.Context, in *SetFxRateReq, opts ...grpc.CallOption) (*SetFxRateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFxRateResp)
+	err := c.cc.Invoke(ctx, ProductService_SetFxRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListFxRates(ctx context.Context, in *ListFxRatesReq, opts ...grpc.CallOption) (*ListFxRatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFxRatesResp)
+	err := c.cc.Invoke(ctx, ProductService_ListFxRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -144,6 +170,10 @@ type ProductServiceServer interface {
 	FeedProducts(context.Context, *FeedProductsReq) (*FeedProductsResp, error)
 	// 搜索商品
 	SearchProducts(context.Context, *SearchProductsReq) (*SearchProductsResp, error)
+	// 设置币种汇率（对 CNY，放大 1e8 倍）
+	SetFxRate(context.Context, *SetFxRateReq) (*SetFxRateResp, error)
+	// 汇率列表
+	ListFxRates(context.Context, *ListFxRatesReq) (*ListFxRatesResp, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -175,6 +205,12 @@ func (UnimplementedProductServiceServer) FeedProducts(context.Context, *FeedProd
 func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsReq) (*SearchProductsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
 }
+func (UnimplementedProductServiceServer) SetFxRate(context.Context, *SetFxRateReq) (*SetFxRateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFxRate not implemented")
+}
+func (UnimplementedProductServiceServer) ListFxRates(context.Context, *ListFxRatesReq) (*ListFxRatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFxRates not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -322,6 +358,42 @@ func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_SetFxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFxRateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetFxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetFxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetFxRate(ctx, req.(*SetFxRateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListFxRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFxRatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListFxRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListFxRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListFxRates(ctx, req.(*ListFxRatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -357,6 +429,14 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchProducts",
 			Handler:    _ProductService_SearchProducts_Handler,
 		},
+		{
+			MethodName: "SetFxRate",
+			Handler:    _ProductService_SetFxRate_Handler,
+		},
+		{
+			MethodName: "ListFxRates",
+			Handler:    _ProductService_ListFxRates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product.proto",
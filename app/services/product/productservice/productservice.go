@@ -22,12 +22,17 @@ type (
 	DeleteProductResp  = product.DeleteProductResp
 	FeedProductsReq    = product.FeedProductsReq
 	FeedProductsResp   = product.FeedProductsResp
+	FxRate             = product.FxRate
 	GetProductReq      = product.GetProductReq
 	GetProductResp     = product.GetProductResp
+	ListFxRatesReq     = product.ListFxRatesReq
+	ListFxRatesResp    = product.ListFxRatesResp
 	Product            = product.Product
 	ProductSummary     = product.ProductSummary
 	SearchProductsReq  = product.SearchProductsReq
 	SearchProductsResp = product.SearchProductsResp
+	SetFxRateReq       = product.SetFxRateReq
+	SetFxRateResp      = product.SetFxRateResp
 	UpdateProductReq   = product.UpdateProductReq
 	UpdateProductResp  = product.UpdateProductResp
 
@@ -46,6 +51,10 @@ type (
 		FeedProducts(ctx context.Context, in *FeedProductsReq, opts ...grpc.CallOption) (*FeedProductsResp, error)
 		// 搜索商品
 		SearchProducts(ctx context.Context, in *SearchProductsReq, opts ...grpc.CallOption) (*SearchProductsResp, error)
+		// 设置币种汇率（对 CNY，放大 1e8 倍）
+		SetFxRate(ctx context.Context, in *SetFxRateReq, opts ...grpc.CallOption) (*SetFxRateResp, error)
+		// 汇率列表
+		ListFxRates(ctx context.Context, in *ListFxRatesReq, opts ...grpc.CallOption) (*ListFxRatesResp, error)
 	}
 
 	defaultProductService struct {
@@ -100,3 +109,15 @@ func (m *defaultProductService) SearchProducts(ctx context.Context, in *SearchPr
 	client := product.NewProductServiceClient(m.cli.Conn())
 	return client.SearchProducts(ctx, in, opts...)
 }
+
+// 设置币种汇率（对 CNY，放大 1e8 倍）
+func (m *defaultProductService) SetFxRate(ctx context.Context, in *SetFxRateReq, opts ...grpc.CallOption) (*SetFxRateResp, error) {
+	client := product.NewProductServiceClient(m.cli.Conn())
+	return client.SetFxRate(ctx, in, opts...)
+}
+
+// 汇率列表
+func (m *defaultProductService) ListFxRates(ctx context.Context, in *ListFxRatesReq, opts ...grpc.CallOption) (*ListFxRatesResp, error) {
+	client := product.NewProductServiceClient(m.cli.Conn())
+	return client.ListFxRates(ctx, in, opts...)
+}
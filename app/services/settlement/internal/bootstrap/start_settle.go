@@ -0,0 +1,51 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/services/settlement/internal/settle"
+	"NatsumeAI/app/services/settlement/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const settleCheckInterval = 10 * time.Minute
+
+// StartSettle 自动结算：到点后生成上一周期的结算单，同一周期成功一次后不再重复执行。
+func StartSettle(sc *svc.ServiceContext) func() {
+	conf := sc.Config.Settle
+	if !conf.Enabled {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(settleCheckInterval)
+		defer ticker.Stop()
+		var settled time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				now := time.Now()
+				if now.Hour() < conf.Hour {
+					continue
+				}
+				p := settle.PreviousPeriod(conf.Cycle, now)
+				if p.Start.Equal(settled) {
+					continue
+				}
+				statements, err := settle.Run(ctx, sc, p, 0)
+				if err != nil {
+					logx.WithContext(ctx).Errorf("auto settle failed: period=%s~%s err=%v", p.Start.Format(time.DateOnly), p.End.Format(time.DateOnly), err)
+					continue
+				}
+				settled = p.Start
+				logx.WithContext(ctx).Infof("auto settle done: period=%s~%s statements=%d", p.Start.Format(time.DateOnly), p.End.Format(time.DateOnly), len(statements))
+			}
+		}
+	}()
+	return cancel
+}
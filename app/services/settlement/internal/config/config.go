@@ -0,0 +1,36 @@
+package config
+
+import (
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
+	"github.com/zeromicro/zero-contrib/zrpc/registry/consul"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+
+	Consul consul.Conf
+
+	MysqlConf sqlx.SqlConf
+	CacheConf cache.CacheConf
+
+	OrderRpc   zrpc.RpcClientConf
+	ProductRpc zrpc.RpcClientConf
+
+	LogConf logx.LogConf
+
+	SnowflakeNode int64
+
+	Settle SettleConf `json:",optional"`
+}
+
+// SettleConf 自动结算：每个 Cycle 周期结束后，在 Hour 点之后生成上一周期的结算单。
+// 类目未配置佣金费率时按 DefaultRateBp（万分比）计佣。
+type SettleConf struct {
+	Enabled       bool   `json:",optional"`
+	Cycle         string `json:",default=WEEKLY,options=DAILY|WEEKLY|MONTHLY"`
+	Hour          int    `json:",default=2"`
+	DefaultRateBp int64  `json:",default=500"`
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"NatsumeAI/app/services/settlement/internal/settle"
+	"NatsumeAI/app/services/settlement/internal/svc"
+	settlementpb "NatsumeAI/app/services/settlement/settlement"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GenerateStatementsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGenerateStatementsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateStatementsLogic {
+	return &GenerateStatementsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GenerateStatements 手动生成指定周期的结算单，用于补结算或自定义周期
+func (l *GenerateStatementsLogic) GenerateStatements(in *settlementpb.GenerateStatementsReq) (*settlementpb.GenerateStatementsResp, error) {
+	resp := &settlementpb.GenerateStatementsResp{}
+	if in == nil || in.MerchantId < 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	start, err1 := time.ParseInLocation(time.DateOnly, in.PeriodStart, time.Local)
+	end, err2 := time.ParseInLocation(time.DateOnly, in.PeriodEnd, time.Local)
+	if err1 != nil || err2 != nil {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid period"
+		return resp, nil
+	}
+
+	statements, err := settle.Run(l.ctx, l.svcCtx, settle.Period{Start: start, End: end}, in.MerchantId)
+	if err != nil {
+		if errors.Is(err, settle.ErrInvalidPeriod) {
+			resp.StatusCode = 400
+			resp.StatusMsg = err.Error()
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	for _, st := range statements {
+		resp.Statements = append(resp.Statements, toStatement(st))
+	}
+	return resp, nil
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+
+	settlementdal "NatsumeAI/app/dal/settlement"
+	"NatsumeAI/app/services/settlement/internal/svc"
+	settlementpb "NatsumeAI/app/services/settlement/settlement"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetStatementLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetStatementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetStatementLogic {
+	return &GetStatementLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetStatementLogic) GetStatement(in *settlementpb.GetStatementReq) (*settlementpb.GetStatementResp, error) {
+	resp := &settlementpb.GetStatementResp{}
+	if in == nil || in.StatementId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+
+	st, err := l.svcCtx.Statements.FindOne(l.ctx, in.StatementId)
+	if err != nil {
+		if err == settlementdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "statement not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	if in.MerchantId > 0 && st.MerchantId != in.MerchantId {
+		resp.StatusCode = 403
+		resp.StatusMsg = "forbidden"
+		return resp, nil
+	}
+	items, err := l.svcCtx.Items.ListByStatement(l.ctx, st.StatementId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Statement = toStatement(st)
+	for _, it := range items {
+		resp.Items = append(resp.Items, toStatementItem(it))
+	}
+	return resp, nil
+}
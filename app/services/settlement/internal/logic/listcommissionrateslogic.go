@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+
+	"NatsumeAI/app/services/settlement/internal/svc"
+	settlementpb "NatsumeAI/app/services/settlement/settlement"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListCommissionRatesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListCommissionRatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCommissionRatesLogic {
+	return &ListCommissionRatesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListCommissionRatesLogic) ListCommissionRates(in *settlementpb.ListCommissionRatesReq) (*settlementpb.ListCommissionRatesResp, error) {
+	resp := &settlementpb.ListCommissionRatesResp{}
+
+	rows, err := l.svcCtx.CommissionRates.ListAll(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.DefaultRateBp = l.svcCtx.Config.Settle.DefaultRateBp
+	for _, r := range rows {
+		resp.Rates = append(resp.Rates, &settlementpb.CommissionRate{Category: r.Category, RateBp: r.RateBp})
+	}
+	return resp, nil
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"NatsumeAI/app/services/settlement/internal/svc"
+	settlementpb "NatsumeAI/app/services/settlement/settlement"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxStatementPageSize = 100
+
+type ListStatementsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListStatementsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListStatementsLogic {
+	return &ListStatementsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListStatementsLogic) ListStatements(in *settlementpb.ListStatementsReq) (*settlementpb.ListStatementsResp, error) {
+	resp := &settlementpb.ListStatementsResp{}
+	if in == nil || in.MerchantId < 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	page, size := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > maxStatementPageSize {
+		size = 20
+	}
+	status := strings.ToUpper(in.Status)
+
+	rows, err := l.svcCtx.Statements.List(l.ctx, in.MerchantId, status, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+	total, err := l.svcCtx.Statements.Count(l.ctx, in.MerchantId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Total = total
+	for _, st := range rows {
+		resp.Statements = append(resp.Statements, toStatement(st))
+	}
+	return resp, nil
+}
@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	settlementdal "NatsumeAI/app/dal/settlement"
+	"NatsumeAI/app/services/settlement/internal/svc"
+	settlementpb "NatsumeAI/app/services/settlement/settlement"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetCommissionRateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetCommissionRateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetCommissionRateLogic {
+	return &SetCommissionRateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetCommissionRate 设置类目佣金费率，只影响之后生成的结算单
+func (l *SetCommissionRateLogic) SetCommissionRate(in *settlementpb.SetCommissionRateReq) (*settlementpb.SetCommissionRateResp, error) {
+	resp := &settlementpb.SetCommissionRateResp{}
+	if in == nil || strings.TrimSpace(in.Category) == "" || in.RateBp < 0 || in.RateBp > 10000 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	category := strings.TrimSpace(in.Category)
+
+	rate, err := l.svcCtx.CommissionRates.FindOneByCategory(l.ctx, category)
+	switch {
+	case err == nil:
+		rate.RateBp = in.RateBp
+		if err := l.svcCtx.CommissionRates.Update(l.ctx, rate); err != nil {
+			return nil, err
+		}
+	case err == settlementdal.ErrNotFound:
+		if _, err := l.svcCtx.CommissionRates.Insert(l.ctx, &settlementdal.SettlementCommissionRates{
+			Category: category,
+			RateBp:   in.RateBp,
+		}); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Rate = &settlementpb.CommissionRate{Category: category, RateBp: in.RateBp}
+	return resp, nil
+}
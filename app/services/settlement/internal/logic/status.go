@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"time"
+
+	settlementdal "NatsumeAI/app/dal/settlement"
+	settlementpb "NatsumeAI/app/services/settlement/settlement"
+)
+
+func toStatement(st *settlementdal.SettlementStatements) *settlementpb.Statement {
+	if st == nil {
+		return nil
+	}
+	info := &settlementpb.Statement{
+		StatementId:      st.StatementId,
+		StatementNo:      st.StatementNo,
+		MerchantId:       st.MerchantId,
+		PeriodStart:      st.PeriodStart.Format(time.DateOnly),
+		PeriodEnd:        st.PeriodEnd.Format(time.DateOnly),
+		OrderCount:       st.OrderCount,
+		ItemCount:        st.ItemCount,
+		GrossAmount:      st.GrossAmount,
+		CommissionAmount: st.CommissionAmount,
+		DiscountAmount:   st.DiscountAmount,
+		NetAmount:        st.NetAmount,
+		Status:           st.Status,
+		PayoutNo:         st.PayoutNo,
+		FailReason:       st.FailReason,
+	}
+	if st.PaidAt.Valid {
+		info.PaidAt = st.PaidAt.Time.Unix()
+	}
+	if !st.CreatedAt.IsZero() {
+		info.CreatedAt = st.CreatedAt.Unix()
+	}
+	return info
+}
+
+func toStatementItem(it *settlementdal.SettlementItems) *settlementpb.StatementItem {
+	return &settlementpb.StatementItem{
+		OrderId:          it.OrderId,
+		ProductId:        it.ProductId,
+		Category:         it.Category,
+		Quantity:         it.Quantity,
+		Amount:           it.Amount,
+		RateBp:           it.RateBp,
+		Commission:       it.Commission,
+		MerchantDiscount: it.MerchantDiscount,
+		CompletedAt:      it.CompletedAt.Unix(),
+	}
+}
@@ -0,0 +1,114 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	settlementdal "NatsumeAI/app/dal/settlement"
+	"NatsumeAI/app/services/settlement/internal/svc"
+	settlementpb "NatsumeAI/app/services/settlement/settlement"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// payoutTransitions 打款状态流转：目标状态 -> 允许的当前状态
+var payoutTransitions = map[string][]string{
+	settlementdal.StatementStatusPaying: {settlementdal.StatementStatusPending, settlementdal.StatementStatusFailed},
+	settlementdal.StatementStatusPaid:   {settlementdal.StatementStatusPaying},
+	settlementdal.StatementStatusFailed: {settlementdal.StatementStatusPaying},
+}
+
+type UpdatePayoutLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdatePayoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePayoutLogic {
+	return &UpdatePayoutLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdatePayout 记录打款进度：发起打款置为 PAYING，银行/渠道回执后置为 PAID 或 FAILED，失败可重新发起
+func (l *UpdatePayoutLogic) UpdatePayout(in *settlementpb.UpdatePayoutReq) (*settlementpb.UpdatePayoutResp, error) {
+	resp := &settlementpb.UpdatePayoutResp{}
+	if in == nil || in.StatementId <= 0 {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid params"
+		return resp, nil
+	}
+	status := strings.ToUpper(in.Status)
+	from, ok := payoutTransitions[status]
+	if !ok {
+		resp.StatusCode = 400
+		resp.StatusMsg = "invalid payout status"
+		return resp, nil
+	}
+	if status != settlementdal.StatementStatusFailed && in.PayoutNo == "" {
+		resp.StatusCode = 400
+		resp.StatusMsg = "payout_no required"
+		return resp, nil
+	}
+
+	st, err := l.svcCtx.Statements.FindOne(l.ctx, in.StatementId)
+	if err != nil {
+		if err == settlementdal.ErrNotFound {
+			resp.StatusCode = 404
+			resp.StatusMsg = "statement not found"
+			return resp, nil
+		}
+		return nil, err
+	}
+	// 重复回执直接返回当前状态
+	if st.Status == status && (in.PayoutNo == "" || st.PayoutNo == in.PayoutNo) {
+		resp.StatusCode = 0
+		resp.StatusMsg = "ok"
+		resp.Statement = toStatement(st)
+		return resp, nil
+	}
+	if status == settlementdal.StatementStatusPaid && st.PayoutNo != in.PayoutNo {
+		resp.StatusCode = 409
+		resp.StatusMsg = "payout_no mismatch"
+		resp.Statement = toStatement(st)
+		return resp, nil
+	}
+
+	next := *st
+	next.Status = status
+	next.FailReason = ""
+	switch status {
+	case settlementdal.StatementStatusPaying:
+		next.PayoutNo = in.PayoutNo
+	case settlementdal.StatementStatusPaid:
+		next.PaidAt = sql.NullTime{Time: time.Now(), Valid: true}
+	case settlementdal.StatementStatusFailed:
+		next.FailReason = in.Reason
+		if len(next.FailReason) > 255 {
+			next.FailReason = next.FailReason[:255]
+		}
+	}
+	updated, err := l.svcCtx.Statements.UpdatePayout(l.ctx, &next, from)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := l.svcCtx.Statements.FindOne(l.ctx, st.StatementId)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		resp.StatusCode = 409
+		resp.StatusMsg = "statement status changed"
+		resp.Statement = toStatement(latest)
+		return resp, nil
+	}
+
+	resp.StatusCode = 0
+	resp.StatusMsg = "ok"
+	resp.Statement = toStatement(latest)
+	return resp, nil
+}
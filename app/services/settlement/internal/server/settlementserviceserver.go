@@ -0,0 +1,54 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.2
+// Source: settlement.proto
+
+package server
+
+import (
+	"context"
+
+	"NatsumeAI/app/services/settlement/internal/logic"
+	"NatsumeAI/app/services/settlement/internal/svc"
+	"NatsumeAI/app/services/settlement/settlement"
+)
+
+type SettlementServiceServer struct {
+	svcCtx *svc.ServiceContext
+	settlement.UnimplementedSettlementServiceServer
+}
+
+func NewSettlementServiceServer(svcCtx *svc.ServiceContext) *SettlementServiceServer {
+	return &SettlementServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+func (s *SettlementServiceServer) GenerateStatements(ctx context.Context, in *settlement.GenerateStatementsReq) (*settlement.GenerateStatementsResp, error) {
+	l := logic.NewGenerateStatementsLogic(ctx, s.svcCtx)
+	return l.GenerateStatements(in)
+}
+
+func (s *SettlementServiceServer) GetStatement(ctx context.Context, in *settlement.GetStatementReq) (*settlement.GetStatementResp, error) {
+	l := logic.NewGetStatementLogic(ctx, s.svcCtx)
+	return l.GetStatement(in)
+}
+
+func (s *SettlementServiceServer) ListStatements(ctx context.Context, in *settlement.ListStatementsReq) (*settlement.ListStatementsResp, error) {
+	l := logic.NewListStatementsLogic(ctx, s.svcCtx)
+	return l.ListStatements(in)
+}
+
+func (s *SettlementServiceServer) UpdatePayout(ctx context.Context, in *settlement.UpdatePayoutReq) (*settlement.UpdatePayoutResp, error) {
+	l := logic.NewUpdatePayoutLogic(ctx, s.svcCtx)
+	return l.UpdatePayout(in)
+}
+
+func (s *SettlementServiceServer) SetCommissionRate(ctx context.Context, in *settlement.SetCommissionRateReq) (*settlement.SetCommissionRateResp, error) {
+	l := logic.NewSetCommissionRateLogic(ctx, s.svcCtx)
+	return l.SetCommissionRate(in)
+}
+
+func (s *SettlementServiceServer) ListCommissionRates(ctx context.Context, in *settlement.ListCommissionRatesReq) (*settlement.ListCommissionRatesResp, error) {
+	l := logic.NewListCommissionRatesLogic(ctx, s.svcCtx)
+	return l.ListCommissionRates(in)
+}
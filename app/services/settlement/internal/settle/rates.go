@@ -0,0 +1,39 @@
+package settle
+
+import (
+	"context"
+
+	"NatsumeAI/app/services/settlement/internal/svc"
+)
+
+type rateTable struct {
+	byCategory map[string]int64
+	defaultBp  int64
+}
+
+func loadRates(ctx context.Context, sc *svc.ServiceContext) (*rateTable, error) {
+	rows, err := sc.CommissionRates.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t := &rateTable{byCategory: make(map[string]int64, len(rows)), defaultBp: sc.Config.Settle.DefaultRateBp}
+	for _, r := range rows {
+		t.byCategory[r.Category] = r.RateBp
+	}
+	return t, nil
+}
+
+// pick 商品属于多个类目时取最高费率，均未配置时使用默认费率且类目为空。
+func (t *rateTable) pick(categories []string) (string, int64) {
+	category, rate, found := "", t.defaultBp, false
+	for _, c := range categories {
+		r, ok := t.byCategory[c]
+		if !ok {
+			continue
+		}
+		if !found || r > rate {
+			category, rate, found = c, r, true
+		}
+	}
+	return category, rate
+}
@@ -0,0 +1,246 @@
+package settle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	"NatsumeAI/app/common/snowflake"
+	settlementdal "NatsumeAI/app/dal/settlement"
+	"NatsumeAI/app/services/order/orderservice"
+	"NatsumeAI/app/services/product/productservice"
+	"NatsumeAI/app/services/settlement/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	orderPageSize = 200
+
+	CycleDaily   = "DAILY"
+	CycleWeekly  = "WEEKLY"
+	CycleMonthly = "MONTHLY"
+)
+
+// ErrInvalidPeriod 结算周期不合法：结束日期不晚于开始日期或尚未结束。
+var ErrInvalidPeriod = errors.New("invalid settlement period")
+
+// errNothingSettled 商家在周期内的明细均已在其他结算单中结算，回滚空结算单。
+var errNothingSettled = errors.New("nothing settled")
+
+// Period 结算周期 [Start, End)，均为本地时间零点。
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// PreviousPeriod returns the latest finished period of cycle before now, weeks start on Monday.
+func PreviousPeriod(cycle string, now time.Time) Period {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	switch cycle {
+	case CycleDaily:
+		return Period{Start: today.AddDate(0, 0, -1), End: today}
+	case CycleMonthly:
+		first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+		return Period{Start: first.AddDate(0, -1, 0), End: first}
+	default:
+		monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		return Period{Start: monday.AddDate(0, 0, -7), End: monday}
+	}
+}
+
+type line struct {
+	orderId     int64
+	productId   int64
+	category    string
+	quantity    int64
+	amount      int64
+	rateBp      int64
+	commission  int64
+	discount    int64
+	completedAt time.Time
+}
+
+type productInfo struct {
+	merchantId int64
+	categories []string
+}
+
+// Run 生成周期内的结算单：拉取确认收货时间落在周期内的订单明细，按商品归属商家汇总并按类目计佣，
+// 商家出资的促销与商家券优惠从应付商家金额中扣除。明细行金额由订单服务按实付分摊，已扣除平台出资的优惠与部分退款。
+// 已生成的商家周期直接返回原结算单；已在其他结算单中出现的订单明细不重复结算。
+// merchantId 不为 0 时只结算该商家。
+func Run(ctx context.Context, sc *svc.ServiceContext, p Period, merchantId int64) ([]*settlementdal.SettlementStatements, error) {
+	if !p.End.After(p.Start) || p.End.After(time.Now()) {
+		return nil, ErrInvalidPeriod
+	}
+	rates, err := loadRates(ctx, sc)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make(map[int64]*productInfo)
+	lines := make(map[int64][]line)
+	var afterOrderId int64
+	for {
+		out, err := sc.OrderRpc.ListCompletedOrders(ctx, &orderservice.ListCompletedOrdersReq{
+			CompletedFrom: p.Start.Unix(),
+			CompletedTo:   p.End.Unix(),
+			AfterOrderId:  afterOrderId,
+			Limit:         orderPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if out.StatusCode != 0 {
+			return nil, fmt.Errorf("list completed orders: %s", out.StatusMsg)
+		}
+		for _, o := range out.Orders {
+			afterOrderId = o.OrderId
+			for _, it := range o.Items {
+				pi, err := lookupProduct(ctx, sc, products, it.ProductId)
+				if err != nil {
+					return nil, err
+				}
+				if pi == nil {
+					logx.WithContext(ctx).Errorf("settle skip item of unknown product: order=%d product=%d", o.OrderId, it.ProductId)
+					continue
+				}
+				if merchantId > 0 && pi.merchantId != merchantId {
+					continue
+				}
+				category, rate := rates.pick(pi.categories)
+				lines[pi.merchantId] = append(lines[pi.merchantId], line{
+					orderId:     o.OrderId,
+					productId:   it.ProductId,
+					category:    category,
+					quantity:    it.Quantity,
+					amount:      it.Amount,
+					rateBp:      rate,
+					commission:  commission(it.Amount, rate),
+					discount:    it.MerchantDiscount,
+					completedAt: time.Unix(o.CompletedAt, 0),
+				})
+			}
+		}
+		if len(out.Orders) < orderPageSize {
+			break
+		}
+	}
+
+	merchants := make([]int64, 0, len(lines))
+	for m := range lines {
+		merchants = append(merchants, m)
+	}
+	sort.Slice(merchants, func(i, j int) bool { return merchants[i] < merchants[j] })
+
+	statements := make([]*settlementdal.SettlementStatements, 0, len(merchants))
+	for _, m := range merchants {
+		st, err := writeStatement(ctx, sc, p, m, lines[m])
+		if err != nil {
+			return nil, err
+		}
+		if st != nil {
+			statements = append(statements, st)
+		}
+	}
+	return statements, nil
+}
+
+func writeStatement(ctx context.Context, sc *svc.ServiceContext, p Period, merchantId int64, lines []line) (*settlementdal.SettlementStatements, error) {
+	if existing, err := sc.Statements.FindOneByMerchantIdPeriodStartPeriodEnd(ctx, merchantId, p.Start, p.End); err == nil {
+		return existing, nil
+	} else if !errors.Is(err, settlementdal.ErrNotFound) {
+		return nil, err
+	}
+
+	st := &settlementdal.SettlementStatements{
+		StatementNo: strconv.FormatInt(snowflake.Next(), 10),
+		MerchantId:  merchantId,
+		PeriodStart: p.Start,
+		PeriodEnd:   p.End,
+		Status:      settlementdal.StatementStatusPending,
+	}
+	err := sc.DB.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		res, err := sc.Statements.InsertWithSession(ctx, session, st)
+		if err != nil {
+			return err
+		}
+		st.StatementId, _ = res.LastInsertId()
+
+		orders := make(map[int64]struct{})
+		for _, l := range lines {
+			inserted, err := sc.Items.InsertIgnoreWithSession(ctx, session, &settlementdal.SettlementItems{
+				StatementId:      st.StatementId,
+				MerchantId:       merchantId,
+				OrderId:          l.orderId,
+				ProductId:        l.productId,
+				Category:         l.category,
+				Quantity:         l.quantity,
+				Amount:           l.amount,
+				RateBp:           l.rateBp,
+				Commission:       l.commission,
+				MerchantDiscount: l.discount,
+				CompletedAt:      l.completedAt,
+			})
+			if err != nil {
+				return err
+			}
+			if !inserted {
+				continue
+			}
+			orders[l.orderId] = struct{}{}
+			st.ItemCount++
+			st.GrossAmount += l.amount
+			st.CommissionAmount += l.commission
+			st.DiscountAmount += l.discount
+		}
+		if st.ItemCount == 0 {
+			return errNothingSettled
+		}
+		st.OrderCount = int64(len(orders))
+		st.NetAmount = st.GrossAmount - st.CommissionAmount - st.DiscountAmount
+		return sc.Statements.UpdateTotalsWithSession(ctx, session, st)
+	})
+	if errors.Is(err, errNothingSettled) {
+		return nil, nil
+	}
+	if err != nil {
+		// 并发生成同一商家周期时以先写入的为准
+		if existing, ferr := sc.Statements.FindOneByMerchantIdPeriodStartPeriodEnd(ctx, merchantId, p.Start, p.End); ferr == nil {
+			return existing, nil
+		}
+		return nil, err
+	}
+	return st, nil
+}
+
+func lookupProduct(ctx context.Context, sc *svc.ServiceContext, cache map[int64]*productInfo, productId int64) (*productInfo, error) {
+	if pi, ok := cache[productId]; ok {
+		return pi, nil
+	}
+	out, err := sc.ProductRpc.GetProduct(ctx, &productservice.GetProductReq{ProductId: productId})
+	if err != nil {
+		return nil, err
+	}
+	var pi *productInfo
+	switch {
+	case out.StatusCode == errno.StatusOK && out.Product != nil:
+		pi = &productInfo{merchantId: out.Product.MerchantId, categories: out.Product.Categories}
+	case out.StatusCode == errno.ProductNotFound:
+	default:
+		return nil, fmt.Errorf("get product %d: %s", productId, out.StatusMsg)
+	}
+	cache[productId] = pi
+	return pi, nil
+}
+
+// commission 按万分比计佣，四舍五入到分。
+func commission(amount, rateBp int64) int64 {
+	return (amount*rateBp + 5000) / 10000
+}
@@ -0,0 +1,48 @@
+package svc
+
+import (
+	"NatsumeAI/app/common/snowflake"
+	settlementdal "NatsumeAI/app/dal/settlement"
+	"NatsumeAI/app/services/order/orderservice"
+	"NatsumeAI/app/services/product/productservice"
+	"NatsumeAI/app/services/settlement/internal/config"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type ServiceContext struct {
+	Config config.Config
+
+	DB              sqlx.SqlConn
+	Statements      settlementdal.SettlementStatementsModel
+	Items           settlementdal.SettlementItemsModel
+	CommissionRates settlementdal.SettlementCommissionRatesModel
+
+	OrderRpc   orderservice.OrderService
+	ProductRpc productservice.ProductService
+}
+
+func NewServiceContext(c config.Config) *ServiceContext {
+	logx.MustSetup(c.LogConf)
+
+	db := sqlx.NewMysql(c.MysqlConf.DataSource)
+
+	if c.SnowflakeNode > 0 {
+		if err := snowflake.SetNodeID(c.SnowflakeNode); err != nil {
+			logx.Errorf("failed to set snowflake node id: %v", err)
+		}
+	}
+
+	return &ServiceContext{
+		Config:          c,
+		DB:              db,
+		Statements:      settlementdal.NewSettlementStatementsModel(db, c.CacheConf),
+		Items:           settlementdal.NewSettlementItemsModel(db, c.CacheConf),
+		CommissionRates: settlementdal.NewSettlementCommissionRatesModel(db, c.CacheConf),
+
+		OrderRpc:   orderservice.NewOrderService(zrpc.MustNewClient(c.OrderRpc)),
+		ProductRpc: productservice.NewProductService(zrpc.MustNewClient(c.ProductRpc)),
+	}
+}
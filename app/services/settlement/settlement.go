@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"NatsumeAI/app/services/settlement/internal/bootstrap"
+	"NatsumeAI/app/services/settlement/internal/config"
+	"NatsumeAI/app/services/settlement/internal/server"
+	"NatsumeAI/app/services/settlement/internal/svc"
+	"NatsumeAI/app/services/settlement/settlement"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/zrpc"
+	"github.com/zeromicro/zero-contrib/zrpc/registry/consul"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+var configFile = flag.String("f", "etc/settlement.yaml", "the config file")
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	ctx := svc.NewServiceContext(c)
+
+	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
+		settlement.RegisterSettlementServiceServer(grpcServer, server.NewSettlementServiceServer(ctx))
+
+		if c.Mode == service.DevMode || c.Mode == service.TestMode {
+			reflection.Register(grpcServer)
+		}
+	})
+
+	stopSettle := bootstrap.StartSettle(ctx)
+	defer stopSettle()
+
+	if err := consul.RegisterService(c.ListenOn, c.Consul); err != nil {
+		logx.Errorw("register service error", logx.Field("err", err))
+		panic(err)
+	}
+	defer s.Stop()
+
+	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
+	s.Start()
+}
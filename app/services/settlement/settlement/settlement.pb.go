@@ -0,0 +1,1286 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.19.4
+// source: settlement.proto
+
+package settlement
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 结算单：按商家、结算周期汇总已确认收货且未退款的订单明细，扣除平台佣金与商家承担的优惠后打款给商家
+type Statement struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StatementId int64                  `protobuf:"varint,1,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	StatementNo string                 `protobuf:"bytes,2,opt,name=statement_no,json=statementNo,proto3" json:"statement_no,omitempty"`
+	MerchantId  int64                  `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	// 结算周期 [period_start, period_end)，yyyy-MM-dd
+	PeriodStart string `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   string `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	OrderCount  int64  `protobuf:"varint,6,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	ItemCount   int64  `protobuf:"varint,7,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	// 商品金额（分）
+	GrossAmount int64 `protobuf:"varint,8,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	// 平台佣金（分）
+	CommissionAmount int64 `protobuf:"varint,9,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`
+	// 应付商家（分）= gross_amount - commission_amount - discount_amount
+	NetAmount int64 `protobuf:"varint,10,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	// PENDING / PAYING / PAID / FAILED
+	Status     string `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	PayoutNo   string `protobuf:"bytes,12,opt,name=payout_no,json=payoutNo,proto3" json:"payout_no,omitempty"`
+	FailReason string `protobuf:"bytes,13,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	PaidAt     int64  `protobuf:"varint,14,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 商家承担的优惠（分）：商家出资的促销与商家券
+	DiscountAmount int64 `protobuf:"varint,16,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Statement) Reset() {
+	*x = Statement{}
+	mi := &file_settlement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Statement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statement) ProtoMessage() {}
+
+func (x *Statement) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statement.ProtoReflect.Descriptor instead.
+func (*Statement) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Statement) GetStatementId() int64 {
+	if x != nil {
+		return x.StatementId
+	}
+	return 0
+}
+
+func (x *Statement) GetStatementNo() string {
+	if x != nil {
+		return x.StatementNo
+	}
+	return ""
+}
+
+func (x *Statement) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Statement) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *Statement) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *Statement) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *Statement) GetItemCount() int64 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Statement) GetGrossAmount() int64 {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return 0
+}
+
+func (x *Statement) GetCommissionAmount() int64 {
+	if x != nil {
+		return x.CommissionAmount
+	}
+	return 0
+}
+
+func (x *Statement) GetNetAmount() int64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *Statement) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Statement) GetPayoutNo() string {
+	if x != nil {
+		return x.PayoutNo
+	}
+	return ""
+}
+
+func (x *Statement) GetFailReason() string {
+	if x != nil {
+		return x.FailReason
+	}
+	return ""
+}
+
+func (x *Statement) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *Statement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Statement) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+type StatementItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Category  string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Quantity  int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount    int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 佣金费率，万分比
+	RateBp      int64 `protobuf:"varint,6,opt,name=rate_bp,json=rateBp,proto3" json:"rate_bp,omitempty"`
+	Commission  int64 `protobuf:"varint,7,opt,name=commission,proto3" json:"commission,omitempty"`
+	CompletedAt int64 `protobuf:"varint,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// 商家承担的优惠（分）
+	MerchantDiscount int64 `protobuf:"varint,9,opt,name=merchant_discount,json=merchantDiscount,proto3" json:"merchant_discount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StatementItem) Reset() {
+	*x = StatementItem{}
+	mi := &file_settlement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementItem) ProtoMessage() {}
+
+func (x *StatementItem) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementItem.ProtoReflect.Descriptor instead.
+func (*StatementItem) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatementItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *StatementItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StatementItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *StatementItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StatementItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StatementItem) GetRateBp() int64 {
+	if x != nil {
+		return x.RateBp
+	}
+	return 0
+}
+
+func (x *StatementItem) GetCommission() int64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+func (x *StatementItem) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *StatementItem) GetMerchantDiscount() int64 {
+	if x != nil {
+		return x.MerchantDiscount
+	}
+	return 0
+}
+
+// 手动结算：生成指定周期的结算单，已生成的商家周期直接跳过
+type GenerateStatementsReq struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   string                 `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// 只结算该商家，0 表示全部商家
+	MerchantId    int64 `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateStatementsReq) Reset() {
+	*x = GenerateStatementsReq{}
+	mi := &file_settlement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateStatementsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateStatementsReq) ProtoMessage() {}
+
+func (x *GenerateStatementsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateStatementsReq.ProtoReflect.Descriptor instead.
+func (*GenerateStatementsReq) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateStatementsReq) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *GenerateStatementsReq) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *GenerateStatementsReq) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GenerateStatementsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Statements    []*Statement           `protobuf:"bytes,3,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateStatementsResp) Reset() {
+	*x = GenerateStatementsResp{}
+	mi := &file_settlement_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateStatementsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateStatementsResp) ProtoMessage() {}
+
+func (x *GenerateStatementsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateStatementsResp.ProtoReflect.Descriptor instead.
+func (*GenerateStatementsResp) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerateStatementsResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GenerateStatementsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GenerateStatementsResp) GetStatements() []*Statement {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+// merchant_id 不为 0 时只能查看本商家的结算单
+type GetStatementReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatementId   int64                  `protobuf:"varint,1,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementReq) Reset() {
+	*x = GetStatementReq{}
+	mi := &file_settlement_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementReq) ProtoMessage() {}
+
+func (x *GetStatementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementReq.ProtoReflect.Descriptor instead.
+func (*GetStatementReq) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetStatementReq) GetStatementId() int64 {
+	if x != nil {
+		return x.StatementId
+	}
+	return 0
+}
+
+func (x *GetStatementReq) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GetStatementResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Statement     *Statement             `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	Items         []*StatementItem       `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementResp) Reset() {
+	*x = GetStatementResp{}
+	mi := &file_settlement_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementResp) ProtoMessage() {}
+
+func (x *GetStatementResp) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementResp.ProtoReflect.Descriptor instead.
+func (*GetStatementResp) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetStatementResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GetStatementResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *GetStatementResp) GetStatement() *Statement {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+func (x *GetStatementResp) GetItems() []*StatementItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListStatementsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 表示全部商家
+	MerchantId    int64  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int64  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int64  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatementsReq) Reset() {
+	*x = ListStatementsReq{}
+	mi := &file_settlement_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatementsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatementsReq) ProtoMessage() {}
+
+func (x *ListStatementsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatementsReq.ProtoReflect.Descriptor instead.
+func (*ListStatementsReq) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListStatementsReq) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ListStatementsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListStatementsReq) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListStatementsReq) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListStatementsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Statements    []*Statement           `protobuf:"bytes,3,rep,name=statements,proto3" json:"statements,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatementsResp) Reset() {
+	*x = ListStatementsResp{}
+	mi := &file_settlement_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatementsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatementsResp) ProtoMessage() {}
+
+func (x *ListStatementsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatementsResp.ProtoReflect.Descriptor instead.
+func (*ListStatementsResp) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListStatementsResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListStatementsResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListStatementsResp) GetStatements() []*Statement {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+func (x *ListStatementsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 打款状态：PENDING/FAILED -> PAYING -> PAID/FAILED
+type UpdatePayoutReq struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StatementId int64                  `protobuf:"varint,1,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	Status      string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 打款流水号，PAYING/PAID 时必填
+	PayoutNo      string `protobuf:"bytes,3,opt,name=payout_no,json=payoutNo,proto3" json:"payout_no,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePayoutReq) Reset() {
+	*x = UpdatePayoutReq{}
+	mi := &file_settlement_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePayoutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePayoutReq) ProtoMessage() {}
+
+func (x *UpdatePayoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePayoutReq.ProtoReflect.Descriptor instead.
+func (*UpdatePayoutReq) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePayoutReq) GetStatementId() int64 {
+	if x != nil {
+		return x.StatementId
+	}
+	return 0
+}
+
+func (x *UpdatePayoutReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdatePayoutReq) GetPayoutNo() string {
+	if x != nil {
+		return x.PayoutNo
+	}
+	return ""
+}
+
+func (x *UpdatePayoutReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdatePayoutResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Statement     *Statement             `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePayoutResp) Reset() {
+	*x = UpdatePayoutResp{}
+	mi := &file_settlement_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePayoutResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePayoutResp) ProtoMessage() {}
+
+func (x *UpdatePayoutResp) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePayoutResp.ProtoReflect.Descriptor instead.
+func (*UpdatePayoutResp) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdatePayoutResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *UpdatePayoutResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *UpdatePayoutResp) GetStatement() *Statement {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+// 类目佣金费率，未配置的类目使用默认费率；商品属于多个类目时取最高费率
+type CommissionRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	RateBp        int64                  `protobuf:"varint,2,opt,name=rate_bp,json=rateBp,proto3" json:"rate_bp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommissionRate) Reset() {
+	*x = CommissionRate{}
+	mi := &file_settlement_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionRate) ProtoMessage() {}
+
+func (x *CommissionRate) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionRate.ProtoReflect.Descriptor instead.
+func (*CommissionRate) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CommissionRate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CommissionRate) GetRateBp() int64 {
+	if x != nil {
+		return x.RateBp
+	}
+	return 0
+}
+
+type SetCommissionRateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	RateBp        int64                  `protobuf:"varint,2,opt,name=rate_bp,json=rateBp,proto3" json:"rate_bp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCommissionRateReq) Reset() {
+	*x = SetCommissionRateReq{}
+	mi := &file_settlement_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommissionRateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommissionRateReq) ProtoMessage() {}
+
+func (x *SetCommissionRateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommissionRateReq.ProtoReflect.Descriptor instead.
+func (*SetCommissionRateReq) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetCommissionRateReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SetCommissionRateReq) GetRateBp() int64 {
+	if x != nil {
+		return x.RateBp
+	}
+	return 0
+}
+
+type SetCommissionRateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Rate          *CommissionRate        `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCommissionRateResp) Reset() {
+	*x = SetCommissionRateResp{}
+	mi := &file_settlement_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommissionRateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommissionRateResp) ProtoMessage() {}
+
+func (x *SetCommissionRateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommissionRateResp.ProtoReflect.Descriptor instead.
+func (*SetCommissionRateResp) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetCommissionRateResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *SetCommissionRateResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *SetCommissionRateResp) GetRate() *CommissionRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+type ListCommissionRatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommissionRatesReq) Reset() {
+	*x = ListCommissionRatesReq{}
+	mi := &file_settlement_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionRatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionRatesReq) ProtoMessage() {}
+
+func (x *ListCommissionRatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionRatesReq.ProtoReflect.Descriptor instead.
+func (*ListCommissionRatesReq) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{13}
+}
+
+type ListCommissionRatesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	Rates         []*CommissionRate      `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"`
+	DefaultRateBp int64                  `protobuf:"varint,4,opt,name=default_rate_bp,json=defaultRateBp,proto3" json:"default_rate_bp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommissionRatesResp) Reset() {
+	*x = ListCommissionRatesResp{}
+	mi := &file_settlement_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionRatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionRatesResp) ProtoMessage() {}
+
+func (x *ListCommissionRatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionRatesResp.ProtoReflect.Descriptor instead.
+func (*ListCommissionRatesResp) Descriptor() ([]byte, []int) {
+	return file_settlement_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCommissionRatesResp) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListCommissionRatesResp) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ListCommissionRatesResp) GetRates() []*CommissionRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *ListCommissionRatesResp) GetDefaultRateBp() int64 {
+	if x != nil {
+		return x.DefaultRateBp
+	}
+	return 0
+}
+
+var File_settlement_proto protoreflect.FileDescriptor
+
+const file_settlement_proto_rawDesc = "" +
+	"\n" +
+	"\x10settlement.proto\x12\n" +
+	"settlement\"\x9a\x04\n" +
+	"\tStatement\x12!\n" +
+	"\fstatement_id\x18\x01 \x01(\x03R\vstatementId\x12!\n" +
+	"\fstatement_no\x18\x02 \x01(\tR\vstatementNo\x12\x1f\n" +
+	"\vmerchant_id\x18\x03 \x01(\x03R\n" +
+	"merchantId\x12!\n" +
+	"\fperiod_start\x18\x04 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\tR\tperiodEnd\x12\x1f\n" +
+	"\vorder_count\x18\x06 \x01(\x03R\n" +
+	"orderCount\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\a \x01(\x03R\titemCount\x12!\n" +
+	"\fgross_amount\x18\b \x01(\x03R\vgrossAmount\x12+\n" +
+	"\x11commission_amount\x18\t \x01(\x03R\x10commissionAmount\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\n" +
+	" \x01(\x03R\tnetAmount\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1b\n" +
+	"\tpayout_no\x18\f \x01(\tR\bpayoutNo\x12\x1f\n" +
+	"\vfail_reason\x18\r \x01(\tR\n" +
+	"failReason\x12\x17\n" +
+	"\apaid_at\x18\x0e \x01(\x03R\x06paidAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\x03R\tcreatedAt\x12'\n" +
+	"\x0fdiscount_amount\x18\x10 \x01(\x03R\x0ediscountAmount\"\xa2\x02\n" +
+	"\rStatementItem\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x17\n" +
+	"\arate_bp\x18\x06 \x01(\x03R\x06rateBp\x12\x1e\n" +
+	"\n" +
+	"commission\x18\a \x01(\x03R\n" +
+	"commission\x12!\n" +
+	"\fcompleted_at\x18\b \x01(\x03R\vcompletedAt\x12+\n" +
+	"\x11merchant_discount\x18\t \x01(\x03R\x10merchantDiscount\"z\n" +
+	"\x15GenerateStatementsReq\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\tR\tperiodEnd\x12\x1f\n" +
+	"\vmerchant_id\x18\x03 \x01(\x03R\n" +
+	"merchantId\"\x8f\x01\n" +
+	"\x16GenerateStatementsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x125\n" +
+	"\n" +
+	"statements\x18\x03 \x03(\v2\x15.settlement.StatementR\n" +
+	"statements\"U\n" +
+	"\x0fGetStatementReq\x12!\n" +
+	"\fstatement_id\x18\x01 \x01(\x03R\vstatementId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\"\xb8\x01\n" +
+	"\x10GetStatementResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x123\n" +
+	"\tstatement\x18\x03 \x01(\v2\x15.settlement.StatementR\tstatement\x12/\n" +
+	"\x05items\x18\x04 \x03(\v2\x19.settlement.StatementItemR\x05items\"}\n" +
+	"\x11ListStatementsReq\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x03R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x03R\bpageSize\"\xa1\x01\n" +
+	"\x12ListStatementsResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x125\n" +
+	"\n" +
+	"statements\x18\x03 \x03(\v2\x15.settlement.StatementR\n" +
+	"statements\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"\x81\x01\n" +
+	"\x0fUpdatePayoutReq\x12!\n" +
+	"\fstatement_id\x18\x01 \x01(\x03R\vstatementId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpayout_no\x18\x03 \x01(\tR\bpayoutNo\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x87\x01\n" +
+	"\x10UpdatePayoutResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x123\n" +
+	"\tstatement\x18\x03 \x01(\v2\x15.settlement.StatementR\tstatement\"E\n" +
+	"\x0eCommissionRate\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x17\n" +
+	"\arate_bp\x18\x02 \x01(\x03R\x06rateBp\"K\n" +
+	"\x14SetCommissionRateReq\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x17\n" +
+	"\arate_bp\x18\x02 \x01(\x03R\x06rateBp\"\x87\x01\n" +
+	"\x15SetCommissionRateResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12.\n" +
+	"\x04rate\x18\x03 \x01(\v2\x1a.settlement.CommissionRateR\x04rate\"\x18\n" +
+	"\x16ListCommissionRatesReq\"\xb3\x01\n" +
+	"\x17ListCommissionRatesResp\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x120\n" +
+	"\x05rates\x18\x03 \x03(\v2\x1a.settlement.CommissionRateR\x05rates\x12&\n" +
+	"\x0fdefault_rate_bp\x18\x04 \x01(\x03R\rdefaultRateBp2\x91\x04\n" +
+	"\x11SettlementService\x12[\n" +
+	"\x12GenerateStatements\x12!.settlement.GenerateStatementsReq\x1a\".settlement.GenerateStatementsResp\x12I\n" +
+	"\fGetStatement\x12\x1b.settlement.GetStatementReq\x1a\x1c.settlement.GetStatementResp\x12O\n" +
+	"\x0eListStatements\x12\x1d.settlement.ListStatementsReq\x1a\x1e.settlement.ListStatementsResp\x12I\n" +
+	"\fUpdatePayout\x12\x1b.settlement.UpdatePayoutReq\x1a\x1c.settlement.UpdatePayoutResp\x12X\n" +
+	"\x11SetCommissionRate\x12 .settlement.SetCommissionRateReq\x1a!.settlement.SetCommissionRateResp\x12^\n" +
+	"\x13ListCommissionRates\x12\".settlement.ListCommissionRatesReq\x1a#.settlement.ListCommissionRatesRespB\x0eZ\f./settlementb\x06proto3"
+
+var (
+	file_settlement_proto_rawDescOnce sync.Once
+	file_settlement_proto_rawDescData []byte
+)
+
+func file_settlement_proto_rawDescGZIP() []byte {
+	file_settlement_proto_rawDescOnce.Do(func() {
+		file_settlement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_settlement_proto_rawDesc), len(file_settlement_proto_rawDesc)))
+	})
+	return file_settlement_proto_rawDescData
+}
+
+var file_settlement_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_settlement_proto_goTypes = []any{
+	(*Statement)(nil),               // 0: settlement.Statement
+	(*StatementItem)(nil),           // 1: settlement.StatementItem
+	(*GenerateStatementsReq)(nil),   // 2: settlement.GenerateStatementsReq
+	(*GenerateStatementsResp)(nil),  // 3: settlement.GenerateStatementsResp
+	(*GetStatementReq)(nil),         // 4: settlement.GetStatementReq
+	(*GetStatementResp)(nil),        // 5: settlement.GetStatementResp
+	(*ListStatementsReq)(nil),       // 6: settlement.ListStatementsReq
+	(*ListStatementsResp)(nil),      // 7: settlement.ListStatementsResp
+	(*UpdatePayoutReq)(nil),         // 8: settlement.UpdatePayoutReq
+	(*UpdatePayoutResp)(nil),        // 9: settlement.UpdatePayoutResp
+	(*CommissionRate)(nil),          // 10: settlement.CommissionRate
+	(*SetCommissionRateReq)(nil),    // 11: settlement.SetCommissionRateReq
+	(*SetCommissionRateResp)(nil),   // 12: settlement.SetCommissionRateResp
+	(*ListCommissionRatesReq)(nil),  // 13: settlement.ListCommissionRatesReq
+	(*ListCommissionRatesResp)(nil), // 14: settlement.ListCommissionRatesResp
+}
+var file_settlement_proto_depIdxs = []int32{
+	0,  // 0: settlement.GenerateStatementsResp.statements:type_name -> settlement.Statement
+	0,  // 1: settlement.GetStatementResp.statement:type_name -> settlement.Statement
+	1,  // 2: settlement.GetStatementResp.items:type_name -> settlement.StatementItem
+	0,  // 3: settlement.ListStatementsResp.statements:type_name -> settlement.Statement
+	0,  // 4: settlement.UpdatePayoutResp.statement:type_name -> settlement.Statement
+	10, // 5: settlement.SetCommissionRateResp.rate:type_name -> settlement.CommissionRate
+	10, // 6: settlement.ListCommissionRatesResp.rates:type_name -> settlement.CommissionRate
+	2,  // 7: settlement.SettlementService.GenerateStatements:input_type -> settlement.GenerateStatementsReq
+	4,  // 8: settlement.SettlementService.GetStatement:input_type -> settlement.GetStatementReq
+	6,  // 9: settlement.SettlementService.ListStatements:input_type -> settlement.ListStatementsReq
+	8,  // 10: settlement.SettlementService.UpdatePayout:input_type -> settlement.UpdatePayoutReq
+	11, // 11: settlement.SettlementService.SetCommissionRate:input_type -> settlement.SetCommissionRateReq
+	13, // 12: settlement.SettlementService.ListCommissionRates:input_type -> settlement.ListCommissionRatesReq
+	3,  // 13: settlement.SettlementService.GenerateStatements:output_type -> settlement.GenerateStatementsResp
+	5,  // 14: settlement.SettlementService.GetStatement:output_type -> settlement.GetStatementResp
+	7,  // 15: settlement.SettlementService.ListStatements:output_type -> settlement.ListStatementsResp
+	9,  // 16: settlement.SettlementService.UpdatePayout:output_type -> settlement.UpdatePayoutResp
+	12, // 17: settlement.SettlementService.SetCommissionRate:output_type -> settlement.SetCommissionRateResp
+	14, // 18: settlement.SettlementService.ListCommissionRates:output_type -> settlement.ListCommissionRatesResp
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_settlement_proto_init() }
+func file_settlement_proto_init() {
+	if File_settlement_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_settlement_proto_rawDesc), len(file_settlement_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_settlement_proto_goTypes,
+		DependencyIndexes: file_settlement_proto_depIdxs,
+		MessageInfos:      file_settlement_proto_msgTypes,
+	}.Build()
+	File_settlement_proto = out.File
+	file_settlement_proto_goTypes = nil
+	file_settlement_proto_depIdxs = nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.4
+// source: settlement.proto
+
+package settlement
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SettlementService_GenerateStatements_FullMethodName  = "/settlement.SettlementService/GenerateStatements"
+	SettlementService_GetStatement_FullMethodName        = "/settlement.SettlementService/GetStatement"
+	SettlementService_ListStatements_FullMethodName      = "/settlement.SettlementService/ListStatements"
+	SettlementService_UpdatePayout_FullMethodName        = "/settlement.SettlementService/UpdatePayout"
+	SettlementService_SetCommissionRate_FullMethodName   = "/settlement.SettlementService/SetCommissionRate"
+	SettlementService_ListCommissionRates_FullMethodName = "/settlement.SettlementService/ListCommissionRates"
+)
+
+// SettlementServiceClient is the client API for SettlementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SettlementServiceClient interface {
+	GenerateStatements(ctx context.Context, in *GenerateStatementsReq, opts ...grpc.CallOption) (*GenerateStatementsResp, error)
+	GetStatement(ctx context.Context, in *GetStatementReq, opts ...grpc.CallOption) (*GetStatementResp, error)
+	ListStatements(ctx context.Context, in *ListStatementsReq, opts ...grpc.CallOption) (*ListStatementsResp, error)
+	UpdatePayout(ctx context.Context, in *UpdatePayoutReq, opts ...grpc.CallOption) (*UpdatePayoutResp, error)
+	SetCommissionRate(ctx context.Context, in *SetCommissionRateReq, opts ...grpc.CallOption) (*SetCommissionRateResp, error)
+	ListCommissionRates(ctx context.Context, in *ListCommissionRatesReq, opts ...grpc.CallOption) (*ListCommissionRatesResp, error)
+}
+
+type settlementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettlementServiceClient(cc grpc.ClientConnInterface) SettlementServiceClient {
+	return &settlementServiceClient{cc}
+}
+
+func (c *settlementServiceClient) GenerateStatements(ctx context.Context, in *GenerateStatementsReq, opts ...grpc.CallOption) (*GenerateStatementsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateStatementsResp)
+	err := c.cc.Invoke(ctx, SettlementService_GenerateStatements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) GetStatement(ctx context.Context, in *GetStatementReq, opts ...grpc.CallOption) (*GetStatementResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatementResp)
+	err := c.cc.Invoke(ctx, SettlementService_GetStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) ListStatements(ctx context.Context, in *ListStatementsReq, opts ...grpc.CallOption) (*ListStatementsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStatementsResp)
+	err := c.cc.Invoke(ctx, SettlementService_ListStatements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) UpdatePayout(ctx context.Context, in *UpdatePayoutReq, opts ...grpc.CallOption) (*UpdatePayoutResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePayoutResp)
+	err := c.cc.Invoke(ctx, SettlementService_UpdatePayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) SetCommissionRate(ctx context.Context, in *SetCommissionRateReq, opts ...grpc.CallOption) (*SetCommissionRateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCommissionRateResp)
+	err := c.cc.Invoke(ctx, SettlementService_SetCommissionRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) ListCommissionRates(ctx context.Context, in *ListCommissionRatesReq, opts ...grpc.CallOption) (*ListCommissionRatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommissionRatesResp)
+	err := c.cc.Invoke(ctx, SettlementService_ListCommissionRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettlementServiceServer is the server API for SettlementService service.
+// All implementations must embed UnimplementedSettlementServiceServer
+// for forward compatibility.
+type SettlementServiceServer interface {
+	GenerateStatements(context.Context, *GenerateStatementsReq) (*GenerateStatementsResp, error)
+	GetStatement(context.Context, *GetStatementReq) (*GetStatementResp, error)
+	ListStatements(context.Context, *ListStatementsReq) (*ListStatementsResp, error)
+	UpdatePayout(context.Context, *UpdatePayoutReq) (*UpdatePayoutResp, error)
+	SetCommissionRate(context.Context, *SetCommissionRateReq) (*SetCommissionRateResp, error)
+	ListCommissionRates(context.Context, *ListCommissionRatesReq) (*ListCommissionRatesResp, error)
+	mustEmbedUnimplementedSettlementServiceServer()
+}
+
+// UnimplementedSettlementServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSettlementServiceServer struct{}
+
+func (UnimplementedSettlementServiceServer) GenerateStatements(context.Context, *GenerateStatementsReq) (*GenerateStatementsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateStatements not implemented")
+}
+func (UnimplementedSettlementServiceServer) GetStatement(context.Context, *GetStatementReq) (*GetStatementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedSettlementServiceServer) ListStatements(context.Context, *ListStatementsReq) (*ListStatementsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatements not implemented")
+}
+func (UnimplementedSettlementServiceServer) UpdatePayout(context.Context, *UpdatePayoutReq) (*UpdatePayoutResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePayout not implemented")
+}
+func (UnimplementedSettlementServiceServer) SetCommissionRate(context.Context, *SetCommissionRateReq) (*SetCommissionRateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommissionRate not implemented")
+}
+func (UnimplementedSettlementServiceServer) ListCommissionRates(context.Context, *ListCommissionRatesReq) (*ListCommissionRatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommissionRates not implemented")
+}
+func (UnimplementedSettlementServiceServer) mustEmbedUnimplementedSettlementServiceServer() {}
+func (UnimplementedSettlementServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSettlementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettlementServiceServer will
+// result in compilation errors.
+type UnsafeSettlementServiceServer interface {
+	mustEmbedUnimplementedSettlementServiceServer()
+}
+
+func RegisterSettlementServiceServer(s grpc.ServiceRegistrar, srv SettlementServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSettlementServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SettlementService_ServiceDesc, srv)
+}
+
+func _SettlementService_GenerateStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateStatementsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).GenerateStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_GenerateStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).GenerateStatements(ctx, req.(*GenerateStatementsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_GetStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).GetStatement(ctx, req.(*GetStatementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_ListStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatementsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).ListStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_ListStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).ListStatements(ctx, req.(*ListStatementsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_UpdatePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePayoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).UpdatePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_UpdatePayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).UpdatePayout(ctx, req.(*UpdatePayoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_SetCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommissionRateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).SetCommissionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_SetCommissionRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).SetCommissionRate(ctx, req.(*SetCommissionRateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_ListCommissionRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommissionRatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).ListCommissionRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_ListCommissionRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).ListCommissionRates(ctx, req.(*ListCommissionRatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettlementService_ServiceDesc is the grpc.ServiceDesc for SettlementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettlementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "settlement.SettlementService",
+	HandlerType: (*SettlementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateStatements",
+			Handler:    _SettlementService_GenerateStatements_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _SettlementService_GetStatement_Handler,
+		},
+		{
+			MethodName: "ListStatements",
+			Handler:    _SettlementService_ListStatements_Handler,
+		},
+		{
+			MethodName: "UpdatePayout",
+			Handler:    _SettlementService_UpdatePayout_Handler,
+		},
+		{
+			MethodName: "SetCommissionRate",
+			Handler:    _SettlementService_SetCommissionRate_Handler,
+		},
+		{
+			MethodName: "ListCommissionRates",
+			Handler:    _SettlementService_ListCommissionRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "settlement.proto",
+}
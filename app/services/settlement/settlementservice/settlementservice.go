@@ -0,0 +1,81 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.2
+// Source: settlement.proto
+
+package settlementservice
+
+import (
+	"context"
+
+	"NatsumeAI/app/services/settlement/settlement"
+
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+)
+
+type (
+	CommissionRate          = settlement.CommissionRate
+	GenerateStatementsReq   = settlement.GenerateStatementsReq
+	GenerateStatementsResp  = settlement.GenerateStatementsResp
+	GetStatementReq         = settlement.GetStatementReq
+	GetStatementResp        = settlement.GetStatementResp
+	ListCommissionRatesReq  = settlement.ListCommissionRatesReq
+	ListCommissionRatesResp = settlement.ListCommissionRatesResp
+	ListStatementsReq       = settlement.ListStatementsReq
+	ListStatementsResp      = settlement.ListStatementsResp
+	SetCommissionRateReq    = settlement.SetCommissionRateReq
+	SetCommissionRateResp   = settlement.SetCommissionRateResp
+	Statement               = settlement.Statement
+	StatementItem           = settlement.StatementItem
+	UpdatePayoutReq         = settlement.UpdatePayoutReq
+	UpdatePayoutResp        = settlement.UpdatePayoutResp
+
+	SettlementService interface {
+		GenerateStatements(ctx context.Context, in *GenerateStatementsReq, opts ...grpc.CallOption) (*GenerateStatementsResp, error)
+		GetStatement(ctx context.Context, in *GetStatementReq, opts ...grpc.CallOption) (*GetStatementResp, error)
+		ListStatements(ctx context.Context, in *ListStatementsReq, opts ...grpc.CallOption) (*ListStatementsResp, error)
+		UpdatePayout(ctx context.Context, in *UpdatePayoutReq, opts ...grpc.CallOption) (*UpdatePayoutResp, error)
+		SetCommissionRate(ctx context.Context, in *SetCommissionRateReq, opts ...grpc.CallOption) (*SetCommissionRateResp, error)
+		ListCommissionRates(ctx context.Context, in *ListCommissionRatesReq, opts ...grpc.CallOption) (*ListCommissionRatesResp, error)
+	}
+
+	defaultSettlementService struct {
+		cli zrpc.Client
+	}
+)
+
+func NewSettlementService(cli zrpc.Client) SettlementService {
+	return &defaultSettlementService{
+		cli: cli,
+	}
+}
+
+func (m *defaultSettlementService) GenerateStatements(ctx context.Context, in *GenerateStatementsReq, opts ...grpc.CallOption) (*GenerateStatementsResp, error) {
+	client := settlement.NewSettlementServiceClient(m.cli.Conn())
+	return client.GenerateStatements(ctx, in, opts...)
+}
+
+func (m *defaultSettlementService) GetStatement(ctx context.Context, in *GetStatementReq, opts ...grpc.CallOption) (*GetStatementResp, error) {
+	client := settlement.NewSettlementServiceClient(m.cli.Conn())
+	return client.GetStatement(ctx, in, opts...)
+}
+
+func (m *defaultSettlementService) ListStatements(ctx context.Context, in *ListStatementsReq, opts ...grpc.CallOption) (*ListStatementsResp, error) {
+	client := settlement.NewSettlementServiceClient(m.cli.Conn())
+	return client.ListStatements(ctx, in, opts...)
+}
+
+func (m *defaultSettlementService) UpdatePayout(ctx context.Context, in *UpdatePayoutReq, opts ...grpc.CallOption) (*UpdatePayoutResp, error) {
+	client := settlement.NewSettlementServiceClient(m.cli.Conn())
+	return client.UpdatePayout(ctx, in, opts...)
+}
+
+func (m *defaultSettlementService) SetCommissionRate(ctx context.Context, in *SetCommissionRateReq, opts ...grpc.CallOption) (*SetCommissionRateResp, error) {
+	client := settlement.NewSettlementServiceClient(m.cli.Conn())
+	return client.SetCommissionRate(ctx, in, opts...)
+}
+
+func (m *defaultSettlementService) ListCommissionRates(ctx context.Context, in *ListCommissionRatesReq, opts ...grpc.CallOption) (*ListCommissionRatesResp, error) {
+	client := settlement.NewSettlementServiceClient(m.cli.Conn())
+	return client.ListCommissionRates(ctx, in, opts...)
+}
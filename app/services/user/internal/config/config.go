@@ -15,6 +15,11 @@ type Config struct {
 
 	AuthRpc zrpc.RpcClientConf
 
+	// CouponRpc 配置后注册成功时触发注册券活动
+	CouponRpc zrpc.RpcClientConf `json:",optional"`
+	// CartRpc 配置后登录成功时合并游客购物车
+	CartRpc zrpc.RpcClientConf `json:",optional"`
+
 	ChatModel ModelConf
 
 	Consul consul.Conf
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"time"
 
 	model "NatsumeAI/app/dal/user"
 	"NatsumeAI/app/services/user/user"
@@ -11,12 +12,16 @@ func userToProfile(u *model.Users) *user.UserProfile {
 	if u == nil {
 		return nil
 	}
-	return &user.UserProfile{
+	profile := &user.UserProfile{
 		UserId:    int64(u.Id),
 		Username:  u.Username,
 		CreatedAt: u.CreatedAt.Unix(),
 		UpdatedAt: u.UpdatedAt.Unix(),
 	}
+	if u.Birthday.Valid {
+		profile.Birthday = u.Birthday.Time.Format(time.DateOnly)
+	}
+	return profile
 }
 
 func addressToProto(addr *model.UserAddresses) *user.Address {
@@ -3,10 +3,12 @@ package logic
 import (
 	"context"
 	"strings"
+	"time"
 
 	"NatsumeAI/app/common/consts/errno"
 	model "NatsumeAI/app/dal/user"
 	"NatsumeAI/app/services/auth/auth"
+	cartpb "NatsumeAI/app/services/cart/cart"
 	"NatsumeAI/app/services/user/internal/svc"
 	"NatsumeAI/app/services/user/user"
 
@@ -92,6 +94,11 @@ func (l *LoginUserLogic) LoginUser(in *user.LoginUserRequest) (*user.LoginUserRe
 		}, nil
 	}
 
+	if err := l.svcCtx.UserModel.TouchLastActive(l.ctx, dbUser.Id, time.Now()); err != nil {
+		l.Logger.Errorf("touch user last active failed: user=%d err=%v", dbUser.Id, err)
+	}
+	l.mergeGuestCart(int64(dbUser.Id), in.GuestToken)
+
 	resp.StatusCode = errno.StatusOK
 	resp.StatusMsg = "ok"
 	resp.AccessToken = tokenResp.Token.GetAccessToken()
@@ -101,3 +108,21 @@ func (l *LoginUserLogic) LoginUser(in *user.LoginUserRequest) (*user.LoginUserRe
 
 	return resp, nil
 }
+
+// mergeGuestCart 合并游客购物车，失败不影响登录结果
+func (l *LoginUserLogic) mergeGuestCart(userId int64, guestToken string) {
+	if l.svcCtx.CartRpc == nil || guestToken == "" {
+		return
+	}
+	res, err := l.svcCtx.CartRpc.MergeGuestCart(l.ctx, &cartpb.MergeGuestCartReq{
+		UserId:     userId,
+		GuestToken: guestToken,
+	})
+	if err != nil {
+		l.Logger.Errorf("merge guest cart failed: user=%d err=%v", userId, err)
+		return
+	}
+	if res.StatusCode != errno.StatusOK {
+		l.Logger.Errorf("merge guest cart failed: user=%d msg=%s", userId, res.StatusMsg)
+	}
+}
@@ -2,12 +2,15 @@ package logic
 
 import (
 	"context"
+	"database/sql"
 	"strconv"
 	"strings"
+	"time"
 
 	"NatsumeAI/app/common/consts/errno"
 	"NatsumeAI/app/common/snowflake"
 	model "NatsumeAI/app/dal/user"
+	couponpb "NatsumeAI/app/services/coupon/coupon"
 	"NatsumeAI/app/services/user/internal/svc"
 	"NatsumeAI/app/services/user/user"
 
@@ -49,6 +52,17 @@ func (l *RegisterUserLogic) RegisterUser(in *user.RegisterUserRequest) (*user.Re
 		return resp, nil
 	}
 
+	var birthday sql.NullTime
+	if in.Birthday != "" {
+		t, err := time.ParseInLocation(time.DateOnly, in.Birthday, time.Local)
+		if err != nil || t.After(time.Now()) {
+			resp.StatusCode = errno.InvalidParam
+			resp.StatusMsg = "invalid birthday"
+			return resp, nil
+		}
+		birthday = sql.NullTime{Time: t, Valid: true}
+	}
+
 	// 布隆过滤器快速过滤，如果布隆过滤器没找到，说明一定没有这个用户
 	if l.svcCtx.Bloom != nil {
 		exists, err := l.svcCtx.Bloom.Exists([]byte(username))
@@ -84,6 +98,7 @@ func (l *RegisterUserLogic) RegisterUser(in *user.RegisterUserRequest) (*user.Re
         Id:       uint64(newId),
         Username: username,
         Password: string(hashedPwd),
+        Birthday: birthday,
     }); err != nil {
         if strings.Contains(strings.ToLower(err.Error()), "duplicate") {
             resp.StatusCode = errno.UserAlreadyExists
@@ -113,9 +128,29 @@ func (l *RegisterUserLogic) RegisterUser(in *user.RegisterUserRequest) (*user.Re
 		}
 	}
 
+	l.triggerRegisterCampaigns(newId)
+
     resp.StatusCode = errno.StatusOK
 	resp.StatusMsg = "ok"
 	resp.User = userToProfile(created)
 
 	return resp, nil
 }
+
+// triggerRegisterCampaigns 发放注册券，失败不影响注册结果
+func (l *RegisterUserLogic) triggerRegisterCampaigns(userId int64) {
+	if l.svcCtx.CouponRpc == nil {
+		return
+	}
+	res, err := l.svcCtx.CouponRpc.TriggerCampaigns(l.ctx, &couponpb.TriggerCampaignsReq{
+		Trigger: couponpb.CampaignTrigger_CAMPAIGN_TRIGGER_REGISTER,
+		UserId:  userId,
+	})
+	if err != nil {
+		l.Logger.Errorf("trigger register campaigns failed: user=%d err=%v", userId, err)
+		return
+	}
+	if res.StatusCode != errno.StatusOK {
+		l.Logger.Errorf("trigger register campaigns failed: user=%d msg=%s", userId, res.StatusMsg)
+	}
+}
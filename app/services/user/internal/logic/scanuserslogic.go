@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"NatsumeAI/app/common/consts/errno"
+	model "NatsumeAI/app/dal/user"
+
+	"NatsumeAI/app/services/user/internal/svc"
+	"NatsumeAI/app/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxScanUsersLimit = 1000
+
+type ScanUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewScanUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ScanUsersLogic {
+	return &ScanUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 人群圈选（券活动按生日、活跃度发券）
+func (l *ScanUsersLogic) ScanUsers(in *user.ScanUsersRequest) (*user.ScanUsersResponse, error) {
+	resp := &user.ScanUsersResponse{
+		StatusCode: errno.InternalError,
+		StatusMsg:  "internal error",
+	}
+
+	if in == nil || in.AfterId < 0 || in.Limit <= 0 || in.Limit > maxScanUsersLimit ||
+		in.BirthdayMonth < 0 || in.BirthdayMonth > 12 || in.BirthdayDay < 0 || in.BirthdayDay > 31 {
+		resp.StatusCode = errno.InvalidParam
+		resp.StatusMsg = "invalid scan params"
+		return resp, nil
+	}
+
+	filter := model.ScanFilter{
+		AfterId:       uint64(in.AfterId),
+		Limit:         int(in.Limit),
+		BirthdayMonth: int(in.BirthdayMonth),
+		BirthdayDay:   int(in.BirthdayDay),
+	}
+	if in.ActiveAfter > 0 {
+		filter.ActiveAfter = time.Unix(in.ActiveAfter, 0)
+	}
+	ids, err := l.svcCtx.UserModel.ScanIds(l.ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.UserIds = make([]int64, 0, len(ids))
+	for _, id := range ids {
+		resp.UserIds = append(resp.UserIds, int64(id))
+	}
+	resp.StatusCode = errno.StatusOK
+	resp.StatusMsg = "ok"
+	return resp, nil
+}
@@ -62,3 +62,8 @@ func (s *UserServiceServer) GetMerchantApplicationStatus(ctx context.Context, in
 	l := logic.NewGetMerchantApplicationStatusLogic(ctx, s.svcCtx)
 	return l.GetMerchantApplicationStatus(in)
 }
+
+func (s *UserServiceServer) ScanUsers(ctx context.Context, in *user.ScanUsersRequest) (*user.ScanUsersResponse, error) {
+	l := logic.NewScanUsersLogic(ctx, s.svcCtx)
+	return l.ScanUsers(in)
+}
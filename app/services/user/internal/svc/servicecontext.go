@@ -6,6 +6,8 @@ import (
 	usermodel "NatsumeAI/app/dal/user"
 	"NatsumeAI/app/services/auth/auth"
 	"NatsumeAI/app/services/auth/authservice"
+	"NatsumeAI/app/services/cart/cartservice"
+	"NatsumeAI/app/services/coupon/couponservice"
 	"NatsumeAI/app/services/user/internal/config"
 	"context"
 	"time"
@@ -23,6 +25,10 @@ import (
 type ServiceContext struct {
 	Config  config.Config
 	AuthRpc auth.AuthServiceClient
+	// 可选，未配置时不触发注册券活动
+	CouponRpc couponservice.CouponService
+	// 可选，未配置时登录不合并游客购物车
+	CartRpc cartservice.CartService
 	// Casbin enforcer for policy changes (e.g., bind roles)
 	Casbin *casbin.DistributedEnforcer
 
@@ -60,6 +66,16 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		enforcer = c.CasbinMiddleware.MustNewDistributedEnforcer()
 	}
 
+	var couponRpc couponservice.CouponService
+	if c.CouponRpc.Target != "" {
+		couponRpc = couponservice.NewCouponService(zrpc.MustNewClient(c.CouponRpc))
+	}
+
+	var cartRpc cartservice.CartService
+	if c.CartRpc.Target != "" {
+		cartRpc = cartservice.NewCartService(zrpc.MustNewClient(c.CartRpc))
+	}
+
 	var chatModel *ark.ChatModel
 	if c.ChatModel.BaseUrl != "" && c.ChatModel.APIKey != "" && c.ChatModel.Model != "" {
 		cm, err := ark.NewChatModel(context.Background(), &ark.ChatModelConfig{
@@ -78,6 +94,8 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	return &ServiceContext{
 		Config:           c,
 		AuthRpc:          authservice.NewAuthService(zrpc.MustNewClient(c.AuthRpc)),
+		CouponRpc:        couponRpc,
+		CartRpc:          cartRpc,
 		Casbin:           enforcer,
 		MysqlConn:        conn,
 		UserModel:        userModel,
@@ -27,6 +27,7 @@ type UserProfile struct {
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Birthday      string                 `protobuf:"bytes,5,opt,name=birthday,proto3" json:"birthday,omitempty"` // YYYY-MM-DD，未填写为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,10 +90,18 @@ func (x *UserProfile) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *UserProfile) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
 type RegisterUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Birthday      string                 `protobuf:"bytes,3,opt,name=birthday,proto3" json:"birthday,omitempty"` // 可选，YYYY-MM-DD
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,6 +150,13 @@ func (x *RegisterUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterUserRequest) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
 type RegisterUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -205,6 +221,7 @@ type LoginUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,3,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"` // 游客购物车令牌，登录成功后合并到用户购物车
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -253,6 +270,13 @@ func (x *LoginUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginUserRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
 type LoginUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
@@ -1259,31 +1283,172 @@ func (x *GetMerchantApplicationStatusResponse) GetReviewedAt() int64 {
 	return 0
 }
 
+// 人群圈选：按 user_id 游标分页返回满足条件的用户，条件为零值时不限
+type ScanUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterId       int64                  `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	BirthdayMonth int32                  `protobuf:"varint,3,opt,name=birthday_month,json=birthdayMonth,proto3" json:"birthday_month,omitempty"`
+	BirthdayDay   int32                  `protobuf:"varint,4,opt,name=birthday_day,json=birthdayDay,proto3" json:"birthday_day,omitempty"`
+	ActiveAfter   int64                  `protobuf:"varint,5,opt,name=active_after,json=activeAfter,proto3" json:"active_after,omitempty"` // 最近登录时间不早于该时间戳
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanUsersRequest) Reset() {
+	*x = ScanUsersRequest{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanUsersRequest) ProtoMessage() {}
+
+func (x *ScanUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanUsersRequest.ProtoReflect.Descriptor instead.
+func (*ScanUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScanUsersRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ScanUsersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ScanUsersRequest) GetBirthdayMonth() int32 {
+	if x != nil {
+		return x.BirthdayMonth
+	}
+	return 0
+}
+
+func (x *ScanUsersRequest) GetBirthdayDay() int32 {
+	if x != nil {
+		return x.BirthdayDay
+	}
+	return 0
+}
+
+func (x *ScanUsersRequest) GetActiveAfter() int64 {
+	if x != nil {
+		return x.ActiveAfter
+	}
+	return 0
+}
+
+type ScanUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int64                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMsg     string                 `protobuf:"bytes,2,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	UserIds       []int64                `protobuf:"varint,3,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanUsersResponse) Reset() {
+	*x = ScanUsersResponse{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanUsersResponse) ProtoMessage() {}
+
+func (x *ScanUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanUsersResponse.ProtoReflect.Descriptor instead.
+func (*ScanUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ScanUsersResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ScanUsersResponse) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *ScanUsersResponse) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x04user\"\x80\x01\n" +
+	"user.proto\x12\x04user\"\x9c\x01\n" +
 	"\vUserProfile\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\"M\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\x12\x1a\n" +
+	"\bbirthday\x18\x05 \x01(\tR\bbirthday\"i\n" +
 	"\x13RegisterUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"}\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1a\n" +
+	"\bbirthday\x18\x03 \x01(\tR\bbirthday\"}\n" +
 	"\x14RegisterUserResponse\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x03R\n" +
 	"statusCode\x12\x1d\n" +
 	"\n" +
 	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12%\n" +
-	"\x04user\x18\x03 \x01(\v2\x11.user.UserProfileR\x04user\"J\n" +
+	"\x04user\x18\x03 \x01(\v2\x11.user.UserProfileR\x04user\"k\n" +
 	"\x10LoginUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xe1\x01\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vguest_token\x18\x03 \x01(\tR\n" +
+	"guestToken\"\xe1\x01\n" +
 	"\x11LoginUserResponse\x12\x1f\n" +
 	"\vstatus_code\x18\x01 \x01(\x03R\n" +
 	"statusCode\x12\x1d\n" +
@@ -1375,7 +1540,19 @@ const file_user_proto_rawDesc = "" +
 	"\x12application_status\x18\x04 \x01(\tR\x11applicationStatus\x12#\n" +
 	"\rreject_reason\x18\x05 \x01(\tR\frejectReason\x12\x1f\n" +
 	"\vreviewed_at\x18\x06 \x01(\x03R\n" +
-	"reviewedAt2\xfb\x04\n" +
+	"reviewedAt\"\xb0\x01\n" +
+	"\x10ScanUsersRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12%\n" +
+	"\x0ebirthday_month\x18\x03 \x01(\x05R\rbirthdayMonth\x12!\n" +
+	"\fbirthday_day\x18\x04 \x01(\x05R\vbirthdayDay\x12!\n" +
+	"\factive_after\x18\x05 \x01(\x03R\vactiveAfter\"n\n" +
+	"\x11ScanUsersResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x03R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"status_msg\x18\x02 \x01(\tR\tstatusMsg\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\x03R\auserIds2\xb9\x05\n" +
 	"\vUserService\x12E\n" +
 	"\fRegisterUser\x12\x19.user.RegisterUserRequest\x1a\x1a.user.RegisterUserResponse\x12<\n" +
 	"\tLoginUser\x12\x16.user.LoginUserRequest\x1a\x17.user.LoginUserResponse\x12H\n" +
@@ -1384,7 +1561,8 @@ const file_user_proto_rawDesc = "" +
 	"\rDeleteAddress\x12\x1a.user.DeleteAddressRequest\x1a\x1b.user.DeleteAddressResponse\x12H\n" +
 	"\rListAddresses\x12\x1a.user.ListAddressesRequest\x1a\x1b.user.ListAddressesResponse\x12H\n" +
 	"\rApplyMerchant\x12\x1a.user.ApplyMerchantRequest\x1a\x1b.user.ApplyMerchantResponse\x12u\n" +
-	"\x1cGetMerchantApplicationStatus\x12).user.GetMerchantApplicationStatusRequest\x1a*.user.GetMerchantApplicationStatusResponseB\bZ\x06./userb\x06proto3"
+	"\x1cGetMerchantApplicationStatus\x12).user.GetMerchantApplicationStatusRequest\x1a*.user.GetMerchantApplicationStatusResponse\x12<\n" +
+	"\tScanUsers\x12\x16.user.ScanUsersRequest\x1a\x17.user.ScanUsersResponseB\bZ\x06./userb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -1398,7 +1576,7 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_user_proto_goTypes = []any{
 	(*UserProfile)(nil),                          // 0: user.UserProfile
 	(*RegisterUserRequest)(nil),                  // 1: user.RegisterUserRequest
@@ -1420,6 +1598,8 @@ var file_user_proto_goTypes = []any{
 	(*ApplyMerchantResponse)(nil),                // 17: user.ApplyMerchantResponse
 	(*GetMerchantApplicationStatusRequest)(nil),  // 18: user.GetMerchantApplicationStatusRequest
 	(*GetMerchantApplicationStatusResponse)(nil), // 19: user.GetMerchantApplicationStatusResponse
+	(*ScanUsersRequest)(nil),                     // 20: user.ScanUsersRequest
+	(*ScanUsersResponse)(nil),                    // 21: user.ScanUsersResponse
 }
 var file_user_proto_depIdxs = []int32{
 	0,  // 0: user.RegisterUserResponse.user:type_name -> user.UserProfile
@@ -1438,16 +1618,18 @@ var file_user_proto_depIdxs = []int32{
 	13, // 13: user.UserService.ListAddresses:input_type -> user.ListAddressesRequest
 	16, // 14: user.UserService.ApplyMerchant:input_type -> user.ApplyMerchantRequest
 	18, // 15: user.UserService.GetMerchantApplicationStatus:input_type -> user.GetMerchantApplicationStatusRequest
-	2,  // 16: user.UserService.RegisterUser:output_type -> user.RegisterUserResponse
-	4,  // 17: user.UserService.LoginUser:output_type -> user.LoginUserResponse
-	8,  // 18: user.UserService.CreateAddress:output_type -> user.CreateAddressResponse
-	10, // 19: user.UserService.UpdateAddress:output_type -> user.UpdateAddressResponse
-	12, // 20: user.UserService.DeleteAddress:output_type -> user.DeleteAddressResponse
-	14, // 21: user.UserService.ListAddresses:output_type -> user.ListAddressesResponse
-	17, // 22: user.UserService.ApplyMerchant:output_type -> user.ApplyMerchantResponse
-	19, // 23: user.UserService.GetMerchantApplicationStatus:output_type -> user.GetMerchantApplicationStatusResponse
-	16, // [16:24] is the sub-list for method output_type
-	8,  // [8:16] is the sub-list for method input_type
+	20, // 16: user.UserService.ScanUsers:input_type -> user.ScanUsersRequest
+	2,  // 17: user.UserService.RegisterUser:output_type -> user.RegisterUserResponse
+	4,  // 18: user.UserService.LoginUser:output_type -> user.LoginUserResponse
+	8,  // 19: user.UserService.CreateAddress:output_type -> user.CreateAddressResponse
+	10, // 20: user.UserService.UpdateAddress:output_type -> user.UpdateAddressResponse
+	12, // 21: user.UserService.DeleteAddress:output_type -> user.DeleteAddressResponse
+	14, // 22: user.UserService.ListAddresses:output_type -> user.ListAddressesResponse
+	17, // 23: user.UserService.ApplyMerchant:output_type -> user.ApplyMerchantResponse
+	19, // 24: user.UserService.GetMerchantApplicationStatus:output_type -> user.GetMerchantApplicationStatusResponse
+	21, // 25: user.UserService.ScanUsers:output_type -> user.ScanUsersResponse
+	17, // [17:26] is the sub-list for method output_type
+	8,  // [8:17] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -1464,7 +1646,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
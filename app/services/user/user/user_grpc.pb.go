@@ -27,6 +27,7 @@ const (
 	UserService_ListAddresses_FullMethodName                = "/user.UserService/ListAddresses"
 	UserService_ApplyMerchant_FullMethodName                = "/user.UserService/ApplyMerchant"
 	UserService_GetMerchantApplicationStatus_FullMethodName = "/user.UserService/GetMerchantApplicationStatus"
+	UserService_ScanUsers_FullMethodName                    = "/user.UserService/ScanUsers"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -41,6 +42,7 @@ type UserServiceClient interface {
 	ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error)
 	ApplyMerchant(ctx context.Context, in *ApplyMerchantRequest, opts ...grpc.CallOption) (*ApplyMerchantResponse, error)
 	GetMerchantApplicationStatus(ctx context.Context, in *GetMerchantApplicationStatusRequest, opts ...grpc.CallOption) (*GetMerchantApplicationStatusResponse, error)
+	ScanUsers(ctx context.Context, in *ScanUsersRequest, opts ...grpc.CallOption) (*ScanUsersResponse, error)
 }
 
 type userServiceClient struct {
@@ -131,6 +133,16 @@ func (c *userServiceClient) GetMerchantApplicationStatus(ctx context.Context, in
 	return out, nil
 }
 
+func (c *userServiceClient) ScanUsers(ctx context.Context, in *ScanUsersRequest, opts ...grpc.CallOption) (*ScanUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ScanUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -143,6 +155,7 @@ type UserServiceServer interface {
 	ListAddresses(context.Context, *ListAddressesRequest) (*ListAddressesResponse, error)
 	ApplyMerchant(context.Context, *ApplyMerchantRequest) (*ApplyMerchantResponse, error)
 	GetMerchantApplicationStatus(context.Context, *GetMerchantApplicationStatusRequest) (*GetMerchantApplicationStatusResponse, error)
+	ScanUsers(context.Context, *ScanUsersRequest) (*ScanUsersResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -177,6 +190,9 @@ func (UnimplementedUserServiceServer) ApplyMerchant(context.Context, *ApplyMerch
 func (UnimplementedUserServiceServer) GetMerchantApplicationStatus(context.Context, *GetMerchantApplicationStatusRequest) (*GetMerchantApplicationStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantApplicationStatus not implemented")
 }
+func (UnimplementedUserServiceServer) ScanUsers(context.Context, *ScanUsersRequest) (*ScanUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanUsers not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -342,6 +358,24 @@ func _UserService_GetMerchantApplicationStatus_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ScanUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ScanUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ScanUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ScanUsers(ctx, req.(*ScanUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +415,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMerchantApplicationStatus",
 			Handler:    _UserService_GetMerchantApplicationStatus_Handler,
 		},
+		{
+			MethodName: "ScanUsers",
+			Handler:    _UserService_ScanUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
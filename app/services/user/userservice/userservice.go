@@ -31,6 +31,8 @@ type (
 	MerchantApplicationInput             = user.MerchantApplicationInput
 	RegisterUserRequest                  = user.RegisterUserRequest
 	RegisterUserResponse                 = user.RegisterUserResponse
+	ScanUsersRequest                     = user.ScanUsersRequest
+	ScanUsersResponse                    = user.ScanUsersResponse
 	UpdateAddressRequest                 = user.UpdateAddressRequest
 	UpdateAddressResponse                = user.UpdateAddressResponse
 	UserProfile                          = user.UserProfile
@@ -44,6 +46,7 @@ type (
 		ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error)
 		ApplyMerchant(ctx context.Context, in *ApplyMerchantRequest, opts ...grpc.CallOption) (*ApplyMerchantResponse, error)
 		GetMerchantApplicationStatus(ctx context.Context, in *GetMerchantApplicationStatusRequest, opts ...grpc.CallOption) (*GetMerchantApplicationStatusResponse, error)
+		ScanUsers(ctx context.Context, in *ScanUsersRequest, opts ...grpc.CallOption) (*ScanUsersResponse, error)
 	}
 
 	defaultUserService struct {
@@ -96,3 +99,8 @@ func (m *defaultUserService) GetMerchantApplicationStatus(ctx context.Context, i
 	client := user.NewUserServiceClient(m.cli.Conn())
 	return client.GetMerchantApplicationStatus(ctx, in, opts...)
 }
+
+func (m *defaultUserService) ScanUsers(ctx context.Context, in *ScanUsersRequest, opts ...grpc.CallOption) (*ScanUsersResponse, error) {
+	client := user.NewUserServiceClient(m.cli.Conn())
+	return client.ScanUsers(ctx, in, opts...)
+}
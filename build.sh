@@ -20,6 +20,8 @@ TARGETS=(
   "cart-rpc:app/services/cart:app/services/cart"
   "coupon-rpc:app/services/coupon:app/services/coupon"
   "order-rpc:app/services/order:app/services/order"
+  "payment-rpc:app/services/payment:app/services/payment"
+  "settlement-rpc:app/services/settlement:app/services/settlement"
   "agent-rpc:app/services/agent:app/services/agent"
   "indexer:app/services/indexer:app/services/indexer"
   "user-api:app/api/user:app/api/user"
@@ -28,6 +30,7 @@ TARGETS=(
   "cart-api:app/api/cart:app/api/cart"
   "coupon-api:app/api/coupon:app/api/coupon"
   "order-api:app/api/order:app/api/order"
+  "payment-api:app/api/payment:app/api/payment"
   "agent-api:app/api/agent:app/api/agent"
 )
 
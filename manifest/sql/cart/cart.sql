@@ -3,6 +3,9 @@ CREATE TABLE IF NOT EXISTS `cart` (
     `product_id` BIGINT NOT NULL COMMENT '关联的商品id',
     `user_id` BIGINT NOT NULL COMMENT '关联的用户id',
     `quantity` BIGINT NOT NULL COMMENT '商品数量',
+    `added_price` BIGINT NOT NULL DEFAULT 0 COMMENT '加入购物车时的单价(分，CNY)，0 表示未记录',
+    `selected` TINYINT NOT NULL DEFAULT 1 COMMENT '是否勾选结算',
+    `saved_for_later` TINYINT NOT NULL DEFAULT 0 COMMENT '是否移入稍后再买',
     PRIMARY KEY (`id`),
     KEY `idx_cart_user_product` (`user_id`, `product_id`)
 );
@@ -11,10 +11,14 @@ CREATE TABLE IF NOT EXISTS `coupons` (
     `end_at`           DATETIME         NOT NULL COMMENT '有效期结束时间',
     `source`           VARCHAR(64)      NOT NULL DEFAULT '' COMMENT '来源',
     `remarks`          VARCHAR(255)     NOT NULL DEFAULT '' COMMENT '备注',
+    `issuer_type`      ENUM('PLATFORM','MERCHANT') NOT NULL DEFAULT 'PLATFORM' COMMENT '出资方',
+    `issuer_id`        BIGINT           NOT NULL DEFAULT 0 COMMENT '出资商家ID，平台券为0',
+    `revoked_at`       DATETIME         NULL COMMENT '撤销时间',
     `created_at`       DATETIME         NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `updated_at`       DATETIME         NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
-    KEY `idx_time_range` (`start_at`,`end_at`)
+    KEY `idx_time_range` (`start_at`,`end_at`),
+    KEY `idx_issuer` (`issuer_type`,`issuer_id`)
 );
 
 CREATE TABLE IF NOT EXISTS `coupon_instances` (
@@ -24,10 +28,120 @@ CREATE TABLE IF NOT EXISTS `coupon_instances` (
     `status`          ENUM('UNUSED','LOCKED','USED', 'EXPIRED') NOT NULL DEFAULT 'UNUSED' COMMENT '实例状态',
     `locked_preorder` BIGINT NOT NULL DEFAULT 0 COMMENT '锁定的预订单ID',
     `locked_at`       DATETIME        NULL COMMENT '锁定时间',
+    `locked_amount`   BIGINT NOT NULL DEFAULT 0 COMMENT '锁定时参与优惠的商品金额(分)，限定范围的券核销时按此校验门槛',
     `used_order_id`   BIGINT NOT NULL DEFAULT 0 COMMENT '使用的订单ID',
     `used_at`         DATETIME        NULL COMMENT '使用时间',
+    `claim_no`        VARCHAR(32)     NULL COMMENT '领取流水号，异步领券落库幂等',
     `created_at`      DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `updated_at`      DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
-    KEY `idx_user_status` (`user_id`,`status`)
+    UNIQUE KEY `uk_claim_no` (`claim_no`),
+    KEY `idx_user_status` (`user_id`,`status`),
+    KEY `idx_status_locked_at` (`status`,`locked_at`),
+    KEY `idx_coupon_user` (`coupon_id`,`user_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `coupon_scopes` (
+    `id`          BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键',
+    `coupon_id`   BIGINT NOT NULL COMMENT '券模板ID',
+    `scope_type`  ENUM('PRODUCT','CATEGORY','MERCHANT') NOT NULL COMMENT '适用维度：商品/类目/商家',
+    `effect`      ENUM('INCLUDE','EXCLUDE') NOT NULL DEFAULT 'INCLUDE' COMMENT '包含/排除',
+    `scope_value` VARCHAR(64) NOT NULL COMMENT '商品ID、类目名称或商家ID',
+    `created_at`  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_coupon_scope` (`coupon_id`,`scope_type`,`effect`,`scope_value`)
+);
+
+CREATE TABLE IF NOT EXISTS `coupon_campaigns` (
+    `id`             BIGINT       NOT NULL AUTO_INCREMENT COMMENT '活动ID',
+    `name`           VARCHAR(128) NOT NULL COMMENT '活动名称',
+    `coupon_id`      BIGINT       NOT NULL COMMENT '发放的券模板ID',
+    `trigger_type`   ENUM('REGISTER','FIRST_ORDER','BIRTHDAY','SEGMENT') NOT NULL COMMENT '触发方式：注册/首单/生日/人群',
+    `segment`        JSON         NULL COMMENT '人群圈选条件，仅 SEGMENT 使用',
+    `status`         ENUM('SCHEDULED','RUNNING','COMPLETED','CANCELLED') NOT NULL DEFAULT 'SCHEDULED' COMMENT '状态',
+    `start_at`       DATETIME     NOT NULL COMMENT '生效时间，SEGMENT 为计划执行时间',
+    `end_at`         DATETIME     NOT NULL COMMENT '结束时间',
+    `cursor_user_id` BIGINT       NOT NULL DEFAULT 0 COMMENT 'SEGMENT 已扫描到的用户ID，中断后从此处继续',
+    `last_run_date`  DATE         NULL COMMENT 'BIRTHDAY 最近一次执行的日期',
+    `scanned_count`  BIGINT       NOT NULL DEFAULT 0 COMMENT '已扫描用户数',
+    `issued_count`   BIGINT       NOT NULL DEFAULT 0 COMMENT '已发券数',
+    `skipped_count`  BIGINT       NOT NULL DEFAULT 0 COMMENT '不满足条件或已发过',
+    `failed_count`   BIGINT       NOT NULL DEFAULT 0 COMMENT '发券失败数',
+    `last_error`     VARCHAR(255) NOT NULL DEFAULT '' COMMENT '最近一次失败原因',
+    `finished_at`    DATETIME     NULL COMMENT '完成/取消时间',
+    `created_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_trigger_status` (`trigger_type`,`status`)
+);
+
+CREATE TABLE IF NOT EXISTS `coupon_campaign_issues` (
+    `id`          BIGINT      NOT NULL AUTO_INCREMENT COMMENT '主键',
+    `campaign_id` BIGINT      NOT NULL COMMENT '活动ID',
+    `user_id`     BIGINT      NOT NULL COMMENT '用户ID',
+    `biz_key`     VARCHAR(32) NOT NULL DEFAULT '' COMMENT '幂等维度，生日券为年份，其余为空',
+    `instance_id` BIGINT      NOT NULL DEFAULT 0 COMMENT '发放的券实例ID',
+    `created_at`  DATETIME    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_campaign_user` (`campaign_id`,`user_id`,`biz_key`)
+);
+
+CREATE TABLE IF NOT EXISTS `coupon_code_batches` (
+    `id`             BIGINT       NOT NULL AUTO_INCREMENT COMMENT '兑换码批次ID',
+    `coupon_id`      BIGINT       NOT NULL COMMENT '兑换的券模板ID',
+    `name`           VARCHAR(128) NOT NULL COMMENT '批次名称',
+    `code_type`      ENUM('SHARED','UNIQUE') NOT NULL COMMENT 'SHARED：公共码多人可用，UNIQUE：一码一用',
+    `shared_code`    VARCHAR(32)  NULL COMMENT '公共码，仅 SHARED 使用',
+    `total_quantity` BIGINT       NOT NULL COMMENT 'SHARED 为可兑换次数上限，UNIQUE 为生成的码数量',
+    `redeemed_count` BIGINT       NOT NULL DEFAULT 0 COMMENT '已兑换次数',
+    `status`         ENUM('ACTIVE','DISABLED') NOT NULL DEFAULT 'ACTIVE' COMMENT '状态',
+    `created_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_shared_code` (`shared_code`),
+    KEY `idx_coupon_id` (`coupon_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `coupon_codes` (
+    `id`          BIGINT      NOT NULL AUTO_INCREMENT COMMENT '主键',
+    `batch_id`    BIGINT      NOT NULL COMMENT '兑换码批次ID',
+    `code`        VARCHAR(32) NOT NULL COMMENT '一次性兑换码',
+    `status`      ENUM('UNUSED','REDEEMED') NOT NULL DEFAULT 'UNUSED' COMMENT '状态',
+    `user_id`     BIGINT      NOT NULL DEFAULT 0 COMMENT '兑换用户ID',
+    `redeemed_at` DATETIME    NULL COMMENT '兑换时间',
+    `created_at`  DATETIME    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_code` (`code`),
+    KEY `idx_batch_id` (`batch_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `coupon_code_redemptions` (
+    `id`          BIGINT      NOT NULL AUTO_INCREMENT COMMENT '主键',
+    `batch_id`    BIGINT      NOT NULL COMMENT '兑换码批次ID',
+    `user_id`     BIGINT      NOT NULL COMMENT '兑换用户ID',
+    `code`        VARCHAR(32) NOT NULL COMMENT '兑换使用的码',
+    `instance_id` BIGINT      NOT NULL DEFAULT 0 COMMENT '发放的券实例ID',
+    `created_at`  DATETIME    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_batch_user` (`batch_id`,`user_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `promotions` (
+    `id`                BIGINT       NOT NULL AUTO_INCREMENT COMMENT '促销活动ID',
+    `name`              VARCHAR(128) NOT NULL COMMENT '活动名称，结算时展示',
+    `promo_type`        ENUM('FULL_REDUCTION','MULTI_BUY','BUNDLE') NOT NULL COMMENT '满减/多件折扣/组合价',
+    `funded_by`         ENUM('PLATFORM','MERCHANT') NOT NULL DEFAULT 'PLATFORM' COMMENT '出资方',
+    `merchant_id`       BIGINT       NOT NULL DEFAULT 0 COMMENT '出资商家ID，商家出资时仅对该商家商品生效',
+    `rules`             JSON         NOT NULL COMMENT '优惠规则与适用商品',
+    `mutex_group`       VARCHAR(32)  NOT NULL DEFAULT '' COMMENT '互斥组，同组活动只取优惠最大的一个，空表示不互斥',
+    `stack_with_coupon` TINYINT(1)   NOT NULL DEFAULT 1 COMMENT '是否可与优惠券叠加',
+    `priority`          INT          NOT NULL DEFAULT 0 COMMENT '计算顺序，越大越先计算',
+    `status`            ENUM('ACTIVE','DISABLED') NOT NULL DEFAULT 'ACTIVE' COMMENT '状态',
+    `start_at`          DATETIME     NOT NULL COMMENT '开始时间',
+    `end_at`            DATETIME     NOT NULL COMMENT '结束时间',
+    `created_at`        DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`        DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_status_end` (`status`,`end_at`),
+    KEY `idx_merchant` (`merchant_id`)
 );
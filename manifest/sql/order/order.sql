@@ -4,6 +4,13 @@ CREATE TABLE IF NOT EXISTS `order_preorders` (
     `coupon_id`         BIGINT NOT NULL COMMENT '优惠券ID',
     `original_amount`   BIGINT NOT NULL COMMENT '原始金额',
     `final_amount`      BIGINT NOT NULL COMMENT '最终金额',
+    `promotion_amount`  BIGINT NOT NULL DEFAULT 0 COMMENT '促销优惠金额(分)，不含优惠券',
+    `merchant_promotion_amount` BIGINT NOT NULL DEFAULT 0 COMMENT '其中商家出资的促销优惠(分)',
+    `merchant_coupon_amount` BIGINT NOT NULL DEFAULT 0 COMMENT '商家券抵扣金额(分)，由出资商家承担',
+    `promotion_detail`  JSON   NULL COMMENT '命中的促销活动快照',
+    `currency`          VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '支付币种，金额均以该币种计',
+    `price_currency`    VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '商品标价币种',
+    `fx_rate`           BIGINT NOT NULL DEFAULT 100000000 COMMENT '标价币种 → 支付币种汇率快照，放大 1e8 倍',
     `status`            ENUM('PENDING','READY','PLACED','CANCELLED') NOT NULL DEFAULT 'PENDING' COMMENT '状态：待处理/就绪/已下单/取消',
     `expire_at`         DATETIME        NOT NULL COMMENT '预订单过期时间',
     `created_at`        DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -30,16 +37,26 @@ CREATE TABLE IF NOT EXISTS `orders` (
     `preorder_id`       BIGINT NOT NULL COMMENT '预订单ID',
     `user_id`           BIGINT NOT NULL COMMENT '用户ID',
     `coupon_id`         BIGINT NOT NULL COMMENT '优惠券ID',
+    `order_type`        ENUM('NORMAL','PRESALE_DEPOSIT','PRESALE_BALANCE') NOT NULL DEFAULT 'NORMAL' COMMENT '订单类型：普通/预售定金/预售尾款',
     `status`            ENUM('PENDING_PAYMENT','PAYING','PAID','CANCELLED','COMPLETED','REFUNDED') NOT NULL DEFAULT 'PENDING_PAYMENT' COMMENT '订单状态',
     
 
     `total_amount`      BIGINT NOT NULL DEFAULT 0 COMMENT '订单商品总金额(分)',
     `payable_amount`    BIGINT NOT NULL DEFAULT 0 COMMENT '应付金额(分)',
+    `promotion_amount`  BIGINT NOT NULL DEFAULT 0 COMMENT '促销优惠金额(分)，不含优惠券',
+    `merchant_promotion_amount` BIGINT NOT NULL DEFAULT 0 COMMENT '其中商家出资的促销优惠(分)',
+    `merchant_coupon_amount` BIGINT NOT NULL DEFAULT 0 COMMENT '商家券抵扣金额(分)，由出资商家承担',
+    `promotion_detail`  JSON   NULL COMMENT '命中的促销活动快照',
     `paid_amount`       BIGINT NOT NULL DEFAULT 0 COMMENT '实际支付金额(分)',
+    `refunded_amount`   BIGINT NOT NULL DEFAULT 0 COMMENT '累计退款成功金额(分)',
+    `currency`          VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '支付币种，金额均以该币种计',
+    `price_currency`    VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '商品标价币种',
+    `fx_rate`           BIGINT NOT NULL DEFAULT 100000000 COMMENT '标价币种 → 支付币种汇率快照，放大 1e8 倍',
 
 
     `payment_method`    VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '支付方式',
     `payment_at`        DATETIME        NULL COMMENT '支付时间',
+    `completed_at`      DATETIME        NULL COMMENT '确认收货时间',
 
     `expire_time`     BIGINT       NOT NULL COMMENT '过期时间戳',
     `cancel_reason`     VARCHAR(255)    NOT NULL DEFAULT '' COMMENT '取消原因',
@@ -51,7 +68,8 @@ CREATE TABLE IF NOT EXISTS `orders` (
     PRIMARY KEY (`order_id`),
     UNIQUE KEY `uk_preorder_id` (`preorder_id`),
     KEY `idx_user_status` (`user_id`,`status`),
-    KEY `idx_expire_time` (`expire_time`)
+    KEY `idx_expire_time` (`expire_time`),
+    KEY `idx_status_completed` (`status`,`completed_at`)
 );
 
 CREATE TABLE IF NOT EXISTS `order_items` (
@@ -65,3 +83,48 @@ CREATE TABLE IF NOT EXISTS `order_items` (
     PRIMARY KEY (`id`),
     KEY `idx_order` (`order_id`)
 );
+
+CREATE TABLE IF NOT EXISTS `presale_campaigns` (
+    `campaign_id`        BIGINT NOT NULL AUTO_INCREMENT COMMENT '预售活动ID',
+    `merchant_id`        BIGINT NOT NULL COMMENT '商家ID',
+    `product_id`         BIGINT NOT NULL COMMENT '商品ID',
+    `title`              VARCHAR(128) NOT NULL DEFAULT '' COMMENT '活动标题',
+    `presale_price`      BIGINT NOT NULL COMMENT '预售单价(分)',
+    `deposit_amount`     BIGINT NOT NULL COMMENT '单件定金(分)',
+    `deposit_deduction`  BIGINT NOT NULL COMMENT '单件定金可抵扣金额(分)，大于定金即定金膨胀',
+    `total_quantity`     BIGINT NOT NULL DEFAULT 0 COMMENT '预售总量，0 表示不限',
+    `reserved_quantity`  BIGINT NOT NULL DEFAULT 0 COMMENT '已被预订的数量',
+    `per_user_limit`     BIGINT NOT NULL DEFAULT 0 COMMENT '每人限购，0 表示不限',
+    `deposit_start_at`   DATETIME NOT NULL COMMENT '定金开始时间',
+    `deposit_end_at`     DATETIME NOT NULL COMMENT '定金截止时间',
+    `balance_start_at`   DATETIME NOT NULL COMMENT '尾款开始时间',
+    `balance_end_at`     DATETIME NOT NULL COMMENT '尾款截止时间',
+    `deposit_refundable` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '用户主动取消时定金是否可退',
+    `status`             ENUM('ACTIVE','CANCELLED') NOT NULL DEFAULT 'ACTIVE' COMMENT '活动状态',
+    `created_at`         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`campaign_id`),
+    KEY `idx_merchant` (`merchant_id`),
+    KEY `idx_product` (`product_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `presale_orders` (
+    `presale_id`        BIGINT NOT NULL AUTO_INCREMENT COMMENT '预售单ID',
+    `campaign_id`       BIGINT NOT NULL COMMENT '预售活动ID',
+    `user_id`           BIGINT NOT NULL COMMENT '用户ID',
+    `product_id`        BIGINT NOT NULL COMMENT '商品ID',
+    `quantity`          BIGINT NOT NULL COMMENT '购买数量',
+    `deposit_amount`    BIGINT NOT NULL COMMENT '定金总额(分)',
+    `balance_amount`    BIGINT NOT NULL COMMENT '尾款总额(分)，已扣除定金抵扣',
+    `deposit_order_id`  BIGINT NOT NULL DEFAULT 0 COMMENT '定金订单ID',
+    `balance_order_id`  BIGINT NOT NULL DEFAULT 0 COMMENT '尾款订单ID',
+    `status`            ENUM('DEPOSIT_PENDING','DEPOSIT_PAID','BALANCE_PENDING','COMPLETED','CANCELLED','DEPOSIT_FORFEITED','DEPOSIT_REFUNDING','DEPOSIT_REFUNDED') NOT NULL DEFAULT 'DEPOSIT_PENDING' COMMENT '预售单状态',
+    `cancel_reason`     VARCHAR(255) NOT NULL DEFAULT '' COMMENT '取消原因',
+    `created_at`        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`presale_id`),
+    KEY `idx_campaign_user` (`campaign_id`,`user_id`),
+    KEY `idx_user` (`user_id`),
+    KEY `idx_deposit_order` (`deposit_order_id`),
+    KEY `idx_balance_order` (`balance_order_id`)
+);
@@ -1,11 +1,15 @@
 CREATE TABLE IF NOT EXISTS `payment_orders` (
     `payment_id`      BIGINT NOT NULL AUTO_INCREMENT COMMENT '支付记录ID',
     `payment_no`      VARCHAR(64) NOT NULL COMMENT '支付单号',
-    `order_id`        BIGINT NOT NULL COMMENT '关联订单ID',
+    `order_id`        BIGINT NOT NULL COMMENT '关联订单ID，充值单为充值单号',
+    `biz_type`        ENUM('ORDER','TOPUP') NOT NULL DEFAULT 'ORDER' COMMENT '业务类型：订单支付/钱包充值',
     `user_id`         BIGINT NOT NULL COMMENT '用户ID',
     `amount`          BIGINT NOT NULL COMMENT '支付金额，单位分',
     `currency`        VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '币种',
-    `channel`         VARCHAR(32) NOT NULL COMMENT '支付渠道',
+    `price_currency`  VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '商品标价币种，取自订单',
+    `fx_rate`         BIGINT NOT NULL DEFAULT 100000000 COMMENT '标价币种 → 支付币种汇率快照，取自订单，放大 1e8 倍',
+    `channel`         VARCHAR(32) NOT NULL COMMENT '支付渠道，组合支付时为最后一段（主支付段）的渠道',
+    `channel_amount`  BIGINT NOT NULL COMMENT '主支付段金额，单位分；非组合支付与 amount 相同',
     `status`          ENUM('INIT','PROCESSING','SUCCESS','FAILED','CANCELLED','EXPIRED') NOT NULL DEFAULT 'INIT' COMMENT '支付状态',
     `channel_payload` JSON NULL COMMENT '渠道请求载荷',
     `timeout_at`      DATETIME NOT NULL COMMENT '支付超时时间',
@@ -17,3 +21,169 @@ CREATE TABLE IF NOT EXISTS `payment_orders` (
     UNIQUE KEY `uk_order_id` (`order_id`),
     KEY `idx_user_status` (`user_id`,`status`)
 );
+
+CREATE TABLE IF NOT EXISTS `payment_notifications` (
+    `notification_id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '通知记录ID',
+    `channel`         VARCHAR(32) NOT NULL COMMENT '支付渠道',
+    `payment_no`      VARCHAR(64) NOT NULL DEFAULT '' COMMENT '支付单号（验签通过后解析）',
+    `trade_no`        VARCHAR(64) NOT NULL DEFAULT '' COMMENT '渠道交易号',
+    `trade_state`     VARCHAR(16) NOT NULL DEFAULT '' COMMENT '渠道交易状态',
+    `amount`          BIGINT NOT NULL DEFAULT 0 COMMENT '通知金额，单位分',
+    `verified`        TINYINT(1) NOT NULL DEFAULT 0 COMMENT '验签是否通过',
+    `result`          VARCHAR(32) NOT NULL DEFAULT 'RECEIVED' COMMENT '处理结果',
+    `headers`         JSON NULL COMMENT '原始请求头',
+    `raw_body`        MEDIUMTEXT NOT NULL COMMENT '原始通知报文',
+    `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`notification_id`),
+    KEY `idx_payment_no` (`payment_no`),
+    KEY `idx_channel_created` (`channel`,`created_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `payment_refunds` (
+    `refund_id`         BIGINT NOT NULL AUTO_INCREMENT COMMENT '退款记录ID',
+    `refund_no`         VARCHAR(64) NOT NULL COMMENT '退款单号，作为渠道侧退款请求号',
+    `request_no`        VARCHAR(64) NOT NULL COMMENT '业务请求号，同一支付单内幂等',
+    `parent_request_no` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '所属退款申请的请求号，组合支付拆出的分段退款单与首张相同',
+    `payment_id`        BIGINT NOT NULL COMMENT '支付记录ID',
+    `payment_no`        VARCHAR(64) NOT NULL COMMENT '支付单号',
+    `order_id`          BIGINT NOT NULL COMMENT '关联订单ID',
+    `split_id`          BIGINT NOT NULL DEFAULT 0 COMMENT '退款对应的支付分段ID，非组合支付为 0',
+    `user_id`           BIGINT NOT NULL COMMENT '用户ID',
+    `amount`            BIGINT NOT NULL COMMENT '退款金额，单位分',
+    `currency`          VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '币种',
+    `fx_rate`           BIGINT NOT NULL DEFAULT 100000000 COMMENT '支付单的汇率快照，退款沿用原汇率',
+    `price_amount`      BIGINT NOT NULL DEFAULT 0 COMMENT '按原汇率折回标价币种的退款金额',
+    `channel`           VARCHAR(32) NOT NULL COMMENT '支付渠道',
+    `status`            ENUM('PENDING','PROCESSING','SUCCESS','FAILED') NOT NULL DEFAULT 'PENDING' COMMENT '退款状态',
+    `reason`            VARCHAR(255) NOT NULL DEFAULT '' COMMENT '退款原因',
+    `channel_refund_no` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '渠道退款单号',
+    `attempts`          INT NOT NULL DEFAULT 0 COMMENT '渠道请求次数',
+    `last_error`        VARCHAR(512) NOT NULL DEFAULT '' COMMENT '最近一次失败原因',
+    `finished_at`       DATETIME NULL COMMENT '完成时间',
+    `created_at`        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`refund_id`),
+    UNIQUE KEY `uk_refund_no` (`refund_no`),
+    UNIQUE KEY `uk_payment_request` (`payment_id`,`request_no`),
+    KEY `idx_payment_parent_request` (`payment_id`,`parent_request_no`),
+    KEY `idx_order_id` (`order_id`),
+    KEY `idx_status_updated` (`status`,`updated_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `payment_reconcile_reports` (
+    `report_id`       BIGINT NOT NULL AUTO_INCREMENT COMMENT '对账报告ID',
+    `channel`         VARCHAR(32) NOT NULL COMMENT '支付渠道',
+    `bill_date`       DATE NOT NULL COMMENT '账单日期',
+    `file_name`       VARCHAR(255) NOT NULL DEFAULT '' COMMENT '对账单文件名',
+    `status`          ENUM('RUNNING','DONE','FAILED') NOT NULL DEFAULT 'RUNNING' COMMENT '对账状态',
+    `auto_fix`        TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否自动修复处理中的支付单',
+    `total_rows`      INT NOT NULL DEFAULT 0 COMMENT '对账单行数',
+    `matched`         INT NOT NULL DEFAULT 0 COMMENT '一致笔数',
+    `missing`         INT NOT NULL DEFAULT 0 COMMENT '本地成功但对账单缺失',
+    `extra`           INT NOT NULL DEFAULT 0 COMMENT '对账单存在但本地缺失',
+    `amount_mismatch` INT NOT NULL DEFAULT 0 COMMENT '金额不一致',
+    `status_mismatch` INT NOT NULL DEFAULT 0 COMMENT '状态不一致',
+    `fixed`           INT NOT NULL DEFAULT 0 COMMENT '自动修复笔数',
+    `error`           VARCHAR(512) NOT NULL DEFAULT '' COMMENT '失败原因',
+    `created_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`report_id`),
+    KEY `idx_channel_date` (`channel`,`bill_date`)
+);
+
+CREATE TABLE IF NOT EXISTS `payment_reconcile_items` (
+    `item_id`        BIGINT NOT NULL AUTO_INCREMENT COMMENT '差异明细ID',
+    `report_id`      BIGINT NOT NULL COMMENT '对账报告ID',
+    `payment_no`     VARCHAR(64) NOT NULL COMMENT '支付单号',
+    `kind`           ENUM('MISSING','EXTRA','AMOUNT_MISMATCH','STATUS_MISMATCH') NOT NULL COMMENT '差异类型',
+    `local_amount`   BIGINT NOT NULL DEFAULT 0 COMMENT '本地金额，单位分',
+    `channel_amount` BIGINT NOT NULL DEFAULT 0 COMMENT '对账单金额，单位分',
+    `local_status`   VARCHAR(16) NOT NULL DEFAULT '' COMMENT '本地支付状态',
+    `channel_status` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '对账单交易状态',
+    `trade_no`       VARCHAR(64) NOT NULL DEFAULT '' COMMENT '渠道交易号',
+    `fixed`          TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否已自动修复',
+    `note`           VARCHAR(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `created_at`     DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`item_id`),
+    KEY `idx_report_id` (`report_id`)
+);
+
+-- 组合支付分段：积分、钱包余额、外部渠道依次支付，最后一段为主支付段（split_no 即支付单号）。
+-- 前置分段下单时同步扣减并冻结在 HELD，支付成功后一起 COMMITTED，支付关闭时一起 ROLLED_BACK
+CREATE TABLE IF NOT EXISTS `payment_splits` (
+    `split_id`    BIGINT NOT NULL AUTO_INCREMENT COMMENT '分段ID',
+    `split_no`    VARCHAR(64) NOT NULL COMMENT '分段单号，作为渠道侧支付单号',
+    `payment_id`  BIGINT NOT NULL COMMENT '支付记录ID',
+    `leg_no`      INT NOT NULL COMMENT '分段顺序，退款按逆序退回',
+    `channel`     VARCHAR(32) NOT NULL COMMENT '支付渠道',
+    `amount`      BIGINT NOT NULL COMMENT '分段金额，单位分',
+    `points`      BIGINT NOT NULL DEFAULT 0 COMMENT '积分分段抵扣的积分数',
+    `status`      ENUM('PENDING','HELD','COMMITTED','ROLLED_BACK') NOT NULL DEFAULT 'PENDING' COMMENT '分段状态',
+    `trade_no`    VARCHAR(64) NOT NULL DEFAULT '' COMMENT '渠道交易号/记账凭证号',
+    `created_at`  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`split_id`),
+    UNIQUE KEY `uk_split_no` (`split_no`),
+    KEY `idx_payment_id` (`payment_id`)
+);
+
+-- 钱包账户：用户余额账户（贷方余额）与平台科目账户（借方余额），余额只能通过记账分录变动
+CREATE TABLE IF NOT EXISTS `wallet_accounts` (
+    `account_id`   BIGINT NOT NULL AUTO_INCREMENT COMMENT '账户ID',
+    `user_id`      BIGINT NOT NULL DEFAULT 0 COMMENT '用户ID，平台科目为 0',
+    `subject`      VARCHAR(32) NOT NULL COMMENT '科目：USER_BALANCE/CHANNEL_CLEARING/ORDER_SETTLEMENT/USER_POINTS/POINTS_ISSUED/POINTS_REDEEMED',
+    `currency`     VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '币种，积分账户为 POINT',
+    `normal_side`  ENUM('DEBIT','CREDIT') NOT NULL COMMENT '余额方向',
+    `balance`      BIGINT NOT NULL DEFAULT 0 COMMENT '余额，单位分',
+    `status`       ENUM('ACTIVE','FROZEN') NOT NULL DEFAULT 'ACTIVE' COMMENT '账户状态',
+    `created_at`   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`account_id`),
+    UNIQUE KEY `uk_user_subject_currency` (`user_id`,`subject`,`currency`)
+);
+
+-- 记账凭证：按业务类型+业务单号幂等，借贷金额必须相等
+CREATE TABLE IF NOT EXISTS `wallet_journal_entries` (
+    `entry_id`     BIGINT NOT NULL AUTO_INCREMENT COMMENT '凭证ID',
+    `entry_no`     VARCHAR(64) NOT NULL COMMENT '凭证号',
+    `biz_type`     ENUM('TOPUP','PAYMENT','REFUND','GRANT') NOT NULL COMMENT '业务类型',
+    `biz_no`       VARCHAR(64) NOT NULL COMMENT '业务单号：支付单号/退款单号',
+    `user_id`      BIGINT NOT NULL COMMENT '用户ID',
+    `amount`       BIGINT NOT NULL COMMENT '金额，单位分',
+    `currency`     VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '币种',
+    `remark`       VARCHAR(255) NOT NULL DEFAULT '' COMMENT '摘要',
+    `created_at`   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`entry_id`),
+    UNIQUE KEY `uk_entry_no` (`entry_no`),
+    UNIQUE KEY `uk_biz` (`biz_type`,`biz_no`),
+    KEY `idx_user_created` (`user_id`,`created_at`)
+);
+
+-- 分录明细：每张凭证至少一借一贷
+CREATE TABLE IF NOT EXISTS `wallet_ledger_lines` (
+    `line_id`       BIGINT NOT NULL AUTO_INCREMENT COMMENT '分录ID',
+    `entry_id`      BIGINT NOT NULL COMMENT '凭证ID',
+    `account_id`    BIGINT NOT NULL COMMENT '账户ID',
+    `direction`     ENUM('DEBIT','CREDIT') NOT NULL COMMENT '借贷方向',
+    `amount`        BIGINT NOT NULL COMMENT '金额，单位分',
+    `balance_after` BIGINT NOT NULL COMMENT '记账后账户余额',
+    `created_at`    DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`line_id`),
+    KEY `idx_entry_id` (`entry_id`),
+    KEY `idx_account_line` (`account_id`,`line_id`)
+);
+
+-- 日终余额快照，同时记录当日借贷发生额用于试算平衡
+CREATE TABLE IF NOT EXISTS `wallet_balance_snapshots` (
+    `snapshot_id`   BIGINT NOT NULL AUTO_INCREMENT COMMENT '快照ID',
+    `account_id`    BIGINT NOT NULL COMMENT '账户ID',
+    `snapshot_date` DATE NOT NULL COMMENT '快照日期',
+    `balance`       BIGINT NOT NULL COMMENT '日终余额，单位分',
+    `debit_total`   BIGINT NOT NULL DEFAULT 0 COMMENT '当日借方发生额',
+    `credit_total`  BIGINT NOT NULL DEFAULT 0 COMMENT '当日贷方发生额',
+    `created_at`    DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`snapshot_id`),
+    UNIQUE KEY `uk_account_date` (`account_id`,`snapshot_date`),
+    KEY `idx_snapshot_date` (`snapshot_date`)
+);
@@ -5,6 +5,7 @@ CREATE TABLE IF NOT EXISTS `products` (
     `description` TEXT NOT NULL COMMENT '商品描述',
     `picture`     VARCHAR(255) NOT NULL COMMENT '商品主图地址',
     `price`       BIGINT NOT NULL COMMENT '商品售价，单位分',
+    `currency`    VARCHAR(8) NOT NULL DEFAULT 'CNY' COMMENT '标价币种，商家以该币种定价与结算',
     `created_at`  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at`  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     PRIMARY KEY (`id`),
@@ -20,3 +21,11 @@ CREATE TABLE IF NOT EXISTS `product_categories` (
     UNIQUE KEY `uk_product_categories_product_id_category` (`product_id`, `category`),
     KEY `idx_product_categories_category` (`category`)
 );
+
+CREATE TABLE IF NOT EXISTS `product_fx_rates` (
+    `currency`   VARCHAR(8) NOT NULL COMMENT '币种，基准币 CNY 不入表',
+    `rate`       BIGINT NOT NULL COMMENT '1 单位该币种折合 CNY 的数量，放大 1e8 倍',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`currency`)
+);
@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS `settlement_commission_rates` (
+    `id`         BIGINT NOT NULL AUTO_INCREMENT,
+    `category`   VARCHAR(64) NOT NULL COMMENT '类目名称',
+    `rate_bp`    BIGINT NOT NULL COMMENT '佣金费率，万分比',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_category` (`category`)
+);
+
+CREATE TABLE IF NOT EXISTS `settlement_statements` (
+    `statement_id`      BIGINT NOT NULL AUTO_INCREMENT COMMENT '结算单ID',
+    `statement_no`      VARCHAR(64) NOT NULL COMMENT '结算单号',
+    `merchant_id`       BIGINT NOT NULL COMMENT '商家ID',
+    `period_start`      DATE NOT NULL COMMENT '结算周期开始（含）',
+    `period_end`        DATE NOT NULL COMMENT '结算周期结束（不含）',
+    `order_count`       BIGINT NOT NULL DEFAULT 0 COMMENT '订单数',
+    `item_count`        BIGINT NOT NULL DEFAULT 0 COMMENT '明细行数',
+    `gross_amount`      BIGINT NOT NULL DEFAULT 0 COMMENT '商品金额，单位分',
+    `commission_amount` BIGINT NOT NULL DEFAULT 0 COMMENT '平台佣金，单位分',
+    `discount_amount`   BIGINT NOT NULL DEFAULT 0 COMMENT '商家承担的优惠（商家促销与商家券），单位分',
+    `net_amount`        BIGINT NOT NULL DEFAULT 0 COMMENT '应付商家，单位分',
+    `status`            ENUM('PENDING','PAYING','PAID','FAILED') NOT NULL DEFAULT 'PENDING' COMMENT '打款状态',
+    `payout_no`         VARCHAR(64) NOT NULL DEFAULT '' COMMENT '打款流水号',
+    `fail_reason`       VARCHAR(255) NOT NULL DEFAULT '' COMMENT '打款失败原因',
+    `paid_at`           DATETIME NULL COMMENT '打款完成时间',
+    `created_at`        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at`        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`statement_id`),
+    UNIQUE KEY `uk_statement_no` (`statement_no`),
+    UNIQUE KEY `uk_merchant_period` (`merchant_id`,`period_start`,`period_end`),
+    KEY `idx_status` (`status`)
+);
+
+CREATE TABLE IF NOT EXISTS `settlement_items` (
+    `id`           BIGINT NOT NULL AUTO_INCREMENT,
+    `statement_id` BIGINT NOT NULL COMMENT '结算单ID',
+    `merchant_id`  BIGINT NOT NULL COMMENT '商家ID',
+    `order_id`     BIGINT NOT NULL COMMENT '订单ID',
+    `product_id`   BIGINT NOT NULL COMMENT '商品ID',
+    `category`     VARCHAR(64) NOT NULL DEFAULT '' COMMENT '计佣类目，未配置费率时为空',
+    `quantity`     BIGINT NOT NULL COMMENT '数量',
+    `amount`       BIGINT NOT NULL COMMENT '行金额，单位分',
+    `rate_bp`      BIGINT NOT NULL COMMENT '佣金费率，万分比',
+    `commission`   BIGINT NOT NULL COMMENT '佣金，单位分',
+    `merchant_discount` BIGINT NOT NULL DEFAULT 0 COMMENT '商家承担的优惠，单位分',
+    `completed_at` DATETIME NOT NULL COMMENT '确认收货时间',
+    `created_at`   DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_order_product` (`order_id`,`product_id`),
+    KEY `idx_statement` (`statement_id`)
+);
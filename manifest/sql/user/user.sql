@@ -2,10 +2,13 @@ CREATE TABLE IF NOT EXISTS `users` (
     `id`            BIGINT UNSIGNED NOT NULL COMMENT '唯一主键，雪花执行',
     `username`      VARCHAR(64) NOT NULL COMMENT '唯一的用户名',
     `password`      VARCHAR(255) NOT NULL COMMENT '密码这块，肯定是加密过的密码',
+    `birthday`      DATE NULL COMMENT '生日，生日券用',
+    `last_active_at` DATETIME NULL COMMENT '最近登录时间，人群圈选用',
     `created_at`    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at`    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最近更新时间',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `uk_users_username` (`username`)
+    UNIQUE KEY `uk_users_username` (`username`),
+    KEY `idx_users_last_active_at` (`last_active_at`)
 );
 
 CREATE TABLE IF NOT EXISTS `user_addresses` (